@@ -18,6 +18,7 @@ package components
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -66,3 +67,8 @@ func (s *DataCoord) Stop() error {
 func (s *DataCoord) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
 	return s.svr.GetComponentStates(ctx, request)
 }
+
+// DashboardHandler returns the http handler serving the dashboard payload of DataCoord
+func (s *DataCoord) DashboardHandler() http.Handler {
+	return s.svr.DashboardHandler()
+}
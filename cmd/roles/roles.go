@@ -220,6 +220,9 @@ func (mr *MilvusRoles) runDataCoord(ctx context.Context, localMsg bool) *compone
 		if !localMsg {
 			http.Handle(healthz.HealthzRouterPath, &componentsHealthzHandler{component: ds})
 		}
+		if h := ds.DashboardHandler(); h != nil {
+			http.Handle(datacoord.DashboardRouterPath, h)
+		}
 		wg.Done()
 		_ = ds.Run()
 	}()
@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"path"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+const (
+	// channelHistoryPrefix is the kv prefix where channel event logs are persisted
+	channelHistoryPrefix = "channel-history"
+	// maxChannelHistoryEvents is the number of latest events kept for each channel
+	maxChannelHistoryEvents = 1000
+)
+
+// channelEventRing is a bounded ring buffer of channel events
+type channelEventRing struct {
+	events []*datapb.ChannelEvent
+	next   int // position to write next event when buffer is full
+}
+
+func (r *channelEventRing) append(event *datapb.ChannelEvent, capacity int) {
+	if len(r.events) < capacity {
+		r.events = append(r.events, event)
+		return
+	}
+	r.events[r.next] = event
+	r.next = (r.next + 1) % capacity
+}
+
+// list returns events from the oldest to the latest
+func (r *channelEventRing) list() []*datapb.ChannelEvent {
+	ret := make([]*datapb.ChannelEvent, 0, len(r.events))
+	ret = append(ret, r.events[r.next:]...)
+	ret = append(ret, r.events[:r.next]...)
+	return ret
+}
+
+// channelHistory records the timeline of watcher assignments for every channel
+type channelHistory struct {
+	mu       sync.RWMutex
+	kv       kv.TxnKV
+	capacity int
+	rings    map[string]*channelEventRing // channel name => events
+}
+
+func newChannelHistory(kv kv.TxnKV) *channelHistory {
+	return &channelHistory{
+		kv:       kv,
+		capacity: maxChannelHistoryEvents,
+		rings:    make(map[string]*channelEventRing),
+	}
+}
+
+// record appends an event of the channel
+func (h *channelHistory) record(channelName string, nodeID int64, action datapb.ChannelEventType) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ring, ok := h.rings[channelName]
+	if !ok {
+		ring = &channelEventRing{}
+		h.rings[channelName] = ring
+	}
+	ring.append(&datapb.ChannelEvent{
+		ChannelName: channelName,
+		NodeID:      nodeID,
+		Action:      action,
+		Timestamp:   time.Now().UnixNano(),
+	}, h.capacity)
+}
+
+// recordUpdates appends events for all the channels in the operations
+func (h *channelHistory) recordUpdates(updates ChannelOpSet) {
+	for _, op := range updates {
+		action := datapb.ChannelEventType_ChannelWatched
+		if op.Type == Delete {
+			action = datapb.ChannelEventType_ChannelReleased
+		}
+		for _, ch := range op.Channels {
+			h.record(ch.Name, op.NodeID, action)
+		}
+	}
+}
+
+// get returns the events of the channel from the oldest to the latest
+func (h *channelHistory) get(channelName string) []*datapb.ChannelEvent {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	ring, ok := h.rings[channelName]
+	if !ok {
+		return []*datapb.ChannelEvent{}
+	}
+	return ring.list()
+}
+
+// save persists the events of all channels into kv
+func (h *channelHistory) save() error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	kvs := make(map[string]string)
+	for name, ring := range h.rings {
+		v, err := proto.Marshal(&datapb.ChannelEventLog{Events: ring.list()})
+		if err != nil {
+			return err
+		}
+		kvs[path.Join(channelHistoryPrefix, name)] = string(v)
+		if len(kvs) == maxOperationsPerTxn {
+			if err := h.kv.MultiSave(kvs); err != nil {
+				return err
+			}
+			kvs = make(map[string]string)
+		}
+	}
+	if len(kvs) == 0 {
+		return nil
+	}
+	return h.kv.MultiSave(kvs)
+}
+
+// reload restores the persisted events from kv
+func (h *channelHistory) reload() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, values, err := h.kv.LoadWithPrefix(channelHistoryPrefix)
+	if err != nil {
+		return err
+	}
+	for _, v := range values {
+		eventLog := &datapb.ChannelEventLog{}
+		if err := proto.Unmarshal([]byte(v), eventLog); err != nil {
+			return err
+		}
+		for _, event := range eventLog.GetEvents() {
+			ring, ok := h.rings[event.GetChannelName()]
+			if !ok {
+				ring = &channelEventRing{}
+				h.rings[event.GetChannelName()] = ring
+			}
+			ring.append(event, h.capacity)
+		}
+	}
+	return nil
+}
@@ -18,12 +18,15 @@ package datacoord
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"go.uber.org/zap"
 	"stathat.com/c/consistent"
 )
@@ -44,6 +47,9 @@ type ChannelManager struct {
 	assignPolicy     ChannelAssignPolicy
 	reassignPolicy   ChannelReassignPolicy
 	bgChecker        ChannelBGChecker
+	history          *channelHistory
+	quotas           *resourceQuotas
+	segmentCounter   SegmentCounter
 }
 
 type channel struct {
@@ -67,12 +73,22 @@ func NewChannelManager(kv kv.TxnKV, posProvider positionProvider, options ...Cha
 		posProvider: posProvider,
 		factory:     NewChannelPolicyFactoryV1(kv),
 		store:       NewChannelStore(kv),
+		history:     newChannelHistory(kv),
+		quotas:      newResourceQuotas(kv),
 	}
 
 	if err := c.store.Reload(); err != nil {
 		return nil, err
 	}
 
+	if err := c.history.reload(); err != nil {
+		return nil, err
+	}
+
+	if err := c.quotas.reload(); err != nil {
+		return nil, err
+	}
+
 	for _, opt := range options {
 		opt(c)
 	}
@@ -132,7 +148,7 @@ func (c *ChannelManager) bgCheckChannelsWork(ctx context.Context) {
 				continue
 			}
 
-			updates := c.reassignPolicy(c.store, reallocs)
+			updates := c.applyResourceQuotas(c.reassignPolicy(c.store, reallocs))
 			log.Debug("channel manager bg check reassign", zap.Array("updates", updates))
 			for _, update := range updates {
 				if update.Type == Add {
@@ -142,6 +158,9 @@ func (c *ChannelManager) bgCheckChannelsWork(ctx context.Context) {
 
 			if err := c.store.Update(updates); err != nil {
 				log.Warn("channel store update error", zap.Error(err))
+			} else {
+				c.history.recordUpdates(updates)
+				c.updateQuotaHeadroom()
 			}
 
 			c.mu.Unlock()
@@ -184,7 +203,7 @@ func (c *ChannelManager) AddNode(nodeID int64) error {
 
 	c.store.Add(nodeID)
 
-	updates := c.registerPolicy(c.store, nodeID)
+	updates := c.applyResourceQuotas(c.registerPolicy(c.store, nodeID))
 	log.Debug("register node",
 		zap.Int64("registered node", nodeID),
 		zap.Array("updates", updates))
@@ -194,7 +213,12 @@ func (c *ChannelManager) AddNode(nodeID int64) error {
 			c.fillChannelPosition(v)
 		}
 	}
-	return c.store.Update(updates)
+	if err := c.store.Update(updates); err != nil {
+		return err
+	}
+	c.history.recordUpdates(updates)
+	c.updateQuotaHeadroom()
+	return nil
 }
 
 // DeleteNode delete the node whose id is nodeID
@@ -202,7 +226,7 @@ func (c *ChannelManager) DeleteNode(nodeID int64) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	updates := c.deregisterPolicy(c.store, nodeID)
+	updates := c.applyResourceQuotas(c.deregisterPolicy(c.store, nodeID), nodeID)
 	log.Debug("deregister node",
 		zap.Int64("unregistered node", nodeID),
 		zap.Array("updates", updates))
@@ -215,29 +239,68 @@ func (c *ChannelManager) DeleteNode(nodeID int64) error {
 	if err := c.store.Update(updates); err != nil {
 		return err
 	}
+	c.history.recordUpdates(updates)
 	_, err := c.store.Delete(nodeID)
+	c.updateQuotaHeadroom()
 	return err
 }
 
-// Watch try to add the channel to cluster. If the channel already exists, do nothing
+// Watch try to add the channel to cluster. If the channel already exists, do nothing.
+// The channel is assigned to the least loaded node with headroom instead if the node chosen by policy is at quota
 func (c *ChannelManager) Watch(ch *channel) error {
+	return c.WatchFromPosition(ch, nil)
+}
+
+// WatchFromPosition is the same as Watch, except that the DataNode assigned seeks to seekPosition
+// instead of the position recovered from segment checkpoints when seekPosition is not nil.
+// Error is returned if seekPosition can't be applied since the channel is watched already
+func (c *ChannelManager) WatchFromPosition(ch *channel, seekPosition *internalpb.MsgPosition) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	updates := c.assignPolicy(c.store, []*channel{ch})
+	updates := c.applyResourceQuotas(c.assignPolicy(c.store, []*channel{ch}))
 	if len(updates) == 0 {
+		if seekPosition != nil {
+			return fmt.Errorf("channel %s is watched already, can't seek to position %d", ch.Name, seekPosition.GetTimestamp())
+		}
 		return nil
 	}
 	log.Debug("watch channel",
 		zap.Any("channel", ch),
 		zap.Array("updates", updates))
 
+	seeked := false
 	for _, v := range updates {
 		if v.Type == Add {
 			c.fillChannelPosition(v)
+			if seekPosition != nil && c.overrideSeekPosition(v, ch.Name, seekPosition) {
+				seeked = true
+			}
+		}
+	}
+	if seekPosition != nil && !seeked {
+		return fmt.Errorf("channel %s is not assigned, can't seek to position %d", ch.Name, seekPosition.GetTimestamp())
+	}
+	if err := c.store.Update(updates); err != nil {
+		return err
+	}
+	c.history.recordUpdates(updates)
+	c.updateQuotaHeadroom()
+	return nil
+}
+
+// overrideSeekPosition replaces the seek position of the channel in the update,
+// it's not kept in channel store so that channels reassigned later are still watched from checkpoints.
+// false is returned if the channel is not in the update
+func (c *ChannelManager) overrideSeekPosition(update *ChannelOp, channelName string, seekPosition *internalpb.MsgPosition) bool {
+	overridden := false
+	for _, info := range update.ChannelWatchInfos {
+		if info.GetVchan().GetChannelName() == channelName {
+			info.Vchan.SeekPosition = proto.Clone(seekPosition).(*internalpb.MsgPosition)
+			overridden = true
 		}
 	}
-	return c.store.Update(updates)
+	return overridden
 }
 
 func (c *ChannelManager) fillChannelPosition(update *ChannelOp) {
@@ -325,9 +388,62 @@ func (c *ChannelManager) RemoveChannel(channelName string) error {
 	if err := c.store.Update(op); err != nil {
 		return err
 	}
+	c.history.recordUpdates(op)
+	c.updateQuotaHeadroom()
 	return nil
 }
 
+// Migrate moves the channel from node `from` to node `to` in a single store update, and returns the position
+// the new watcher seeks to. seekPosition overrides the position recovered from segment checkpoints if not nil.
+// It fails if the channel is no longer watched by `from`
+func (c *ChannelManager) Migrate(channelName string, from, to int64, seekPosition *internalpb.MsgPosition) (*internalpb.MsgPosition, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nodeID, ch := c.findChannel(channelName)
+	if ch == nil {
+		return nil, errChannelNotWatched
+	}
+	if nodeID != from {
+		return nil, fmt.Errorf("channel %s is watched by node %d instead of %d", channelName, nodeID, from)
+	}
+	if c.store.GetNode(to) == nil {
+		return nil, fmt.Errorf("node %d is not registered", to)
+	}
+	if !c.quotas.get(to).admits(c.loadOf(c.store.GetNode(to))) {
+		return nil, fmt.Errorf("node %d is at resource quota", to)
+	}
+
+	var updates ChannelOpSet
+	updates.Delete(from, []*channel{ch})
+	updates.Add(to, []*channel{ch})
+	log.Debug("migrate channel",
+		zap.String("channel", channelName),
+		zap.Array("updates", updates))
+
+	add := updates[1]
+	c.fillChannelPosition(add)
+	if seekPosition != nil {
+		c.overrideSeekPosition(add, channelName, seekPosition)
+	}
+	if err := c.store.Update(updates); err != nil {
+		return nil, err
+	}
+	c.history.recordUpdates(updates)
+	c.updateQuotaHeadroom()
+	return add.ChannelWatchInfos[0].GetVchan().GetSeekPosition(), nil
+}
+
+// GetHistory returns the watcher assignment events of the channel
+func (c *ChannelManager) GetHistory(channelName string) []*datapb.ChannelEvent {
+	return c.history.get(channelName)
+}
+
+// SaveHistory persists the watcher assignment events of all channels
+func (c *ChannelManager) SaveHistory() error {
+	return c.history.save()
+}
+
 func (c *ChannelManager) findChannel(channelName string) (int64, *channel) {
 	infos := c.store.GetNodesChannels()
 	for _, info := range infos {
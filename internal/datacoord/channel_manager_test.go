@@ -19,7 +19,10 @@ package datacoord
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/stretchr/testify/assert"
 	"stathat.com/c/consistent"
 )
@@ -46,6 +49,80 @@ func TestReload(t *testing.T) {
 	})
 }
 
+func TestChannelManager_WatchFromPosition(t *testing.T) {
+	Params.Init()
+	kv := memkv.NewMemoryKV()
+	cm, err := NewChannelManager(kv, &dummyPosProvider{}, withFactory(NewConsistentHashChannelPolicyFactory(consistent.New())))
+	assert.Nil(t, err)
+	assert.Nil(t, cm.AddNode(1))
+
+	getWatchInfo := func(nodeID int64, channelName string) *datapb.ChannelWatchInfo {
+		v, err := kv.Load(buildChannelKey(nodeID, channelName))
+		assert.Nil(t, err)
+		info := &datapb.ChannelWatchInfo{}
+		assert.Nil(t, proto.Unmarshal([]byte(v), info))
+		return info
+	}
+
+	pos := &internalpb.MsgPosition{ChannelName: "channel1", MsgID: []byte{1, 2, 3}, Timestamp: 100}
+	assert.Nil(t, cm.WatchFromPosition(&channel{"channel1", 1}, pos))
+	assert.True(t, proto.Equal(pos, getWatchInfo(1, "channel1").GetVchan().GetSeekPosition()))
+
+	// seek position can't be applied to the channel watched already
+	assert.NotNil(t, cm.WatchFromPosition(&channel{"channel1", 1}, pos))
+	assert.Nil(t, cm.Watch(&channel{"channel1", 1}))
+
+	// nil seek position is the same as Watch
+	assert.Nil(t, cm.WatchFromPosition(&channel{"channel2", 1}, nil))
+	assert.Nil(t, getWatchInfo(1, "channel2").GetVchan().GetSeekPosition())
+
+	// channel reassigned is watched from checkpoints instead of the seek position
+	assert.Nil(t, cm.AddNode(2))
+	assert.Nil(t, cm.DeleteNode(1))
+	assert.True(t, cm.Match(2, "channel1"))
+	assert.Nil(t, getWatchInfo(2, "channel1").GetVchan().GetSeekPosition())
+}
+
+func TestChannelManager_Migrate(t *testing.T) {
+	Params.Init()
+	kv := memkv.NewMemoryKV()
+	cm, err := NewChannelManager(kv, &dummyPosProvider{}, withFactory(NewConsistentHashChannelPolicyFactory(consistent.New())))
+	assert.Nil(t, err)
+	assert.Nil(t, cm.AddNode(1))
+	assert.Nil(t, cm.Watch(&channel{"channel1", 1}))
+	assert.Nil(t, cm.AddNode(2))
+	from, err := cm.FindWatcher("channel1")
+	assert.Nil(t, err)
+	to := 3 - from
+
+	pos := &internalpb.MsgPosition{ChannelName: "channel1", MsgID: []byte{1, 2, 3}, Timestamp: 100}
+	seekPosition, err := cm.Migrate("channel1", from, to, pos)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(pos, seekPosition))
+	assert.True(t, cm.Match(to, "channel1"))
+	assert.False(t, cm.Match(from, "channel1"))
+
+	v, err := kv.Load(buildChannelKey(to, "channel1"))
+	assert.Nil(t, err)
+	info := &datapb.ChannelWatchInfo{}
+	assert.Nil(t, proto.Unmarshal([]byte(v), info))
+	assert.True(t, proto.Equal(pos, info.GetVchan().GetSeekPosition()))
+	v, err = kv.Load(buildChannelKey(from, "channel1"))
+	assert.Nil(t, err)
+	assert.Empty(t, v)
+
+	// the channel is no longer watched by from
+	_, err = cm.Migrate("channel1", from, to, nil)
+	assert.NotNil(t, err)
+	// unknown channel
+	_, err = cm.Migrate("channel2", to, from, nil)
+	assert.Equal(t, errChannelNotWatched, err)
+	// unregistered target
+	_, err = cm.Migrate("channel1", to, 100, nil)
+	assert.NotNil(t, err)
+	assert.True(t, cm.Match(to, "channel1"))
+}
+
 func TestChannelManager_RemoveChannel(t *testing.T) {
 	type fields struct {
 		store RWChannelStore
@@ -83,7 +160,8 @@ func TestChannelManager_RemoveChannel(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &ChannelManager{
-				store: tt.fields.store,
+				store:   tt.fields.store,
+				history: newChannelHistory(memkv.NewMemoryKV()),
 			}
 			err := c.RemoveChannel(tt.args.channelName)
 			assert.Equal(t, tt.wantErr, err != nil)
@@ -92,3 +170,44 @@ func TestChannelManager_RemoveChannel(t *testing.T) {
 		})
 	}
 }
+
+func TestChannelManager_History(t *testing.T) {
+	Params.Init()
+	kv := memkv.NewMemoryKV()
+	hash := consistent.New()
+	cm, err := NewChannelManager(kv, &dummyPosProvider{}, withFactory(NewConsistentHashChannelPolicyFactory(hash)))
+	assert.Nil(t, err)
+	assert.Nil(t, cm.AddNode(1))
+	assert.Nil(t, cm.Watch(&channel{"channel1", 1}))
+	assert.Nil(t, cm.RemoveChannel("channel1"))
+
+	events := cm.GetHistory("channel1")
+	assert.EqualValues(t, 2, len(events))
+	assert.EqualValues(t, 1, events[0].GetNodeID())
+	assert.EqualValues(t, datapb.ChannelEventType_ChannelWatched, events[0].GetAction())
+	assert.EqualValues(t, datapb.ChannelEventType_ChannelReleased, events[1].GetAction())
+	assert.Empty(t, cm.GetHistory("channel2"))
+
+	// history is restored after restart
+	assert.Nil(t, cm.SaveHistory())
+	cm2, err := NewChannelManager(kv, &dummyPosProvider{}, withFactory(NewConsistentHashChannelPolicyFactory(consistent.New())))
+	assert.Nil(t, err)
+	restored := cm2.GetHistory("channel1")
+	assert.EqualValues(t, len(events), len(restored))
+	for i := range events {
+		assert.True(t, proto.Equal(events[i], restored[i]))
+	}
+}
+
+func TestChannelHistory_Bounded(t *testing.T) {
+	h := newChannelHistory(memkv.NewMemoryKV())
+	h.capacity = 3
+	for i := 0; i < 5; i++ {
+		h.record("channel1", int64(i), datapb.ChannelEventType_ChannelWatched)
+	}
+	events := h.get("channel1")
+	assert.EqualValues(t, 3, len(events))
+	for i, event := range events {
+		assert.EqualValues(t, i+2, event.GetNodeID())
+	}
+}
@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"go.uber.org/zap"
+)
+
+var (
+	// migrateChannelTimeout limits the time to wait for the segments of a migrating channel to be flushed
+	migrateChannelTimeout = 60 * time.Second
+	// migrateChannelCheckInterval is the interval to check whether the segments of a migrating channel are flushed
+	migrateChannelCheckInterval = 100 * time.Millisecond
+)
+
+// channelLocker makes sure a channel is migrated by one request at a time
+type channelLocker struct {
+	mu     sync.Mutex
+	locked map[string]struct{}
+}
+
+func newChannelLocker() *channelLocker {
+	return &channelLocker{
+		locked: make(map[string]struct{}),
+	}
+}
+
+// tryLock locks the channel, returns false if it's already locked
+func (l *channelLocker) tryLock(channel string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.locked[channel]; ok {
+		return false
+	}
+	l.locked[channel] = struct{}{}
+	return true
+}
+
+func (l *channelLocker) unlock(channel string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.locked, channel)
+}
+
+// chooseMigrationTarget validates the target node of migration, or picks the node watching the fewest channels
+// except the current watcher when target is not positive
+func (s *Server) chooseMigrationTarget(from, target int64) (int64, error) {
+	infos := s.channelManager.GetChannels()
+	if target > 0 {
+		if target == from {
+			return 0, fmt.Errorf("channel is already watched by node %d", target)
+		}
+		for _, info := range infos {
+			if info.NodeID == target {
+				return target, nil
+			}
+		}
+		return 0, fmt.Errorf("node %d is not registered", target)
+	}
+
+	var chosen *NodeChannelInfo
+	for _, info := range infos {
+		if info.NodeID == from {
+			continue
+		}
+		if chosen == nil || len(info.Channels) < len(chosen.Channels) ||
+			(len(info.Channels) == len(chosen.Channels) && info.NodeID < chosen.NodeID) {
+			chosen = info
+		}
+	}
+	if chosen == nil {
+		return 0, fmt.Errorf("no other node available to migrate channel to")
+	}
+	return chosen.NodeID, nil
+}
+
+// drainChannel seals the segments of the channel and waits until all of them are flushed
+func (s *Server) drainChannel(ctx context.Context, channel string) error {
+	sealed, err := s.segmentManager.SealChannelSegments(ctx, channel)
+	if err != nil {
+		return err
+	}
+	log.Debug("migrating channel sealed segments", zap.String("channel", channel), zap.Int64s("segmentIDs", sealed))
+
+	timer := time.NewTimer(migrateChannelTimeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(migrateChannelCheckInterval)
+	defer ticker.Stop()
+	for {
+		if s.segmentsFlushed(sealed) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return fmt.Errorf("wait for segments of channel %s to flush timeout", channel)
+		case <-ticker.C:
+		}
+	}
+}
+
+// segmentsFlushed returns true if all segments are flushed or dropped
+func (s *Server) segmentsFlushed(segmentIDs []UniqueID) bool {
+	for _, id := range segmentIDs {
+		segment := s.meta.GetSegment(id)
+		if segment == nil {
+			continue
+		}
+		state := segment.GetState()
+		if state != commonpb.SegmentState_Flushing && state != commonpb.SegmentState_Flushed &&
+			state != commonpb.SegmentState_Dropped {
+			return false
+		}
+	}
+	return true
+}
+
+// migrationSeekPosition returns the checkpoint of the last flushed segment of the channel, or the earliest
+// position of unflushed segments if there is one before it. nil is returned if no position is found
+func (s *Server) migrationSeekPosition(channel string) *internalpb.MsgPosition {
+	var flushedPos, unflushedPos *internalpb.MsgPosition
+	for _, segment := range s.meta.GetSegmentsByChannel(channel) {
+		pos := segment.GetDmlPosition()
+		if pos == nil {
+			pos = segment.GetStartPosition()
+		}
+		if pos == nil {
+			continue
+		}
+		if segment.GetState() == commonpb.SegmentState_Flushing || segment.GetState() == commonpb.SegmentState_Flushed {
+			if flushedPos == nil || pos.GetTimestamp() > flushedPos.GetTimestamp() {
+				flushedPos = pos
+			}
+		} else if unflushedPos == nil || pos.GetTimestamp() < unflushedPos.GetTimestamp() {
+			unflushedPos = pos
+		}
+	}
+	if unflushedPos != nil && (flushedPos == nil || unflushedPos.GetTimestamp() < flushedPos.GetTimestamp()) {
+		return unflushedPos
+	}
+	return flushedPos
+}
@@ -17,6 +17,7 @@
 package datacoord
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -48,6 +49,10 @@ func (m *mockTxnKv) MultiSave(kvs map[string]string) error {
 	panic("not implemented") // TODO: Implement
 }
 
+func (m *mockTxnKv) MultiSaveWithContext(ctx context.Context, kvs map[string]string) error {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *mockTxnKv) Remove(key string) error {
 	panic("not implemented") // TODO: Implement
 }
@@ -122,6 +122,15 @@ func (c *Cluster) Flush(ctx context.Context, segments []*datapb.SegmentInfo, mar
 	}
 }
 
+// SampleSegment samples the segment in the DataNode watching its channel
+func (c *Cluster) SampleSegment(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	nodeID, err := c.channelManager.FindWatcher(req.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+	return c.sessionManager.SampleSegment(ctx, nodeID, req)
+}
+
 // GetSessions returns all sessions
 func (c *Cluster) GetSessions() []*Session {
 	return c.sessionManager.GetSessions()
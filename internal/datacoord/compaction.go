@@ -9,6 +9,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"go.uber.org/zap"
 )
@@ -18,6 +19,8 @@ const (
 	maxParallelCompactionTaskNum      = 100
 	compactionTimeout                 = 10 * time.Second
 	compactionExpirationCheckInterval = 60 * time.Second
+	compactionMetaRetryTimes          = 3
+	compactionMetaRetryInterval       = 100 * time.Millisecond
 )
 
 type compactionPlanContext interface {
@@ -35,6 +38,12 @@ type compactionPlanContext interface {
 	isFull() bool
 	// get compaction tasks by signal id
 	getCompactionTasksBySignalID(signalID int64) []*compactionTask
+	// get all compaction tasks
+	getCompactionTasks() []*compactionTask
+	// reclaimCompaction marks a failed compaction as reclaimed after its output binlogs are removed
+	reclaimCompaction(planID int64) error
+	// cancelCompaction marks an executing compaction as cancelled and returns the task updated
+	cancelCompaction(planID int64) (*compactionTask, error)
 }
 
 type compactionTaskState int8
@@ -43,8 +52,30 @@ const (
 	executing compactionTaskState = iota + 1
 	completed
 	timeout
+	failed    // result is received but fails to be applied, output binlogs are left in storage
+	reclaimed // output binlogs of failed compaction are removed
+	cancelled // result is rejected once the plan is cancelled
 )
 
+func (s compactionTaskState) String() string {
+	switch s {
+	case executing:
+		return "executing"
+	case completed:
+		return "completed"
+	case timeout:
+		return "timeout"
+	case failed:
+		return "failed"
+	case reclaimed:
+		return "reclaimed"
+	case cancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
 var (
 	errChannelNotWatched = errors.New("channel is not watched")
 	errChannelInBuffer   = errors.New("channel is in buffer")
@@ -56,6 +87,10 @@ type compactionTask struct {
 	state       compactionTaskState
 	dataNodeID  int64
 	result      *datapb.CompactionResult
+	createTime  time.Time // time the plan is submitted
+	endTime     time.Time // time the plan is completed or timeout
+	bytesIn     int64     // estimated size of the input segments, set once the plan is completed
+	bytesOut    int64     // estimated size of the output segment, set once the plan is completed
 }
 
 func (t *compactionTask) shadowClone(opts ...compactionTaskOpt) *compactionTask {
@@ -64,6 +99,11 @@ func (t *compactionTask) shadowClone(opts ...compactionTaskOpt) *compactionTask
 		plan:        t.plan,
 		state:       t.state,
 		dataNodeID:  t.dataNodeID,
+		result:      t.result,
+		createTime:  t.createTime,
+		endTime:     t.endTime,
+		bytesIn:     t.bytesIn,
+		bytesOut:    t.bytesOut,
 	}
 	for _, opt := range opts {
 		opt(task)
@@ -84,6 +124,10 @@ type compactionPlanHandler struct {
 	quit             chan struct{}
 	wg               sync.WaitGroup
 	flushCh          chan UniqueID
+	segmentSizer     *AdaptiveSegmentSizer     // observes merge compactions completed, nil if segment size is not adaptive
+	history          *compactionHistory        // records compactions completed or failed, nil if not persisted
+	deps             *PlanDependencyGraph      // dependencies of plans, nil until a plan with dependencies is submitted
+	waiting          map[int64]*compactionTask // planid -> task waiting for the plans it depends on to complete
 }
 
 func newCompactionPlanHandler(sessions *SessionManager, cm *ChannelManager, meta *meta,
@@ -131,11 +175,48 @@ func (c *compactionPlanHandler) stop() {
 	c.wg.Wait()
 }
 
-// execCompactionPlan start to execute plan and return immediately
+// execCompactionPlan start to execute plan and return immediately, plan with dependencies waits until all the plans
+// it depends on are completed
 func (c *compactionPlanHandler) execCompactionPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	var err error
+	if len(plan.GetDependsOn()) > 0 {
+		err = c.addDependentPlan(signal, plan)
+	} else {
+		err = c.submitPlan(signal, plan)
+	}
+	if err != nil {
+		c.cancelDependentsOfRejected(plan.GetPlanID(), err)
+	}
+	return err
+}
+
+// cancelDependentsOfRejected cancels the waiting plans depending on the plan rejected, which never completes. Plans
+// depended on may be added after the plans depending on them
+func (c *compactionPlanHandler) cancelDependentsOfRejected(planID int64, err error) {
+	if c.deps == nil {
+		return
+	}
+	// the plan is submitted before, it's rejected as a duplicate
+	if _, ok := c.plans[planID]; ok {
+		return
+	}
+	if _, ok := c.waiting[planID]; ok {
+		return
+	}
+	for _, id := range append([]int64(nil), c.deps.Dependents(planID)...) {
+		if task, ok := c.waiting[id]; ok {
+			log.Warn("compaction plan cancelled for the plan it depends on rejected", zap.Int64("planID", id),
+				zap.Int64("dependency", planID), zap.Error(err))
+			c.endWaitingPlan(task, cancelled)
+		}
+	}
+}
+
+// submitPlan sends the plan to the DataNode watching its channel
+func (c *compactionPlanHandler) submitPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error {
 	nodeID, err := c.chManager.FindWatcher(plan.GetChannel())
 	if err != nil {
 		return err
@@ -151,12 +232,109 @@ func (c *compactionPlanHandler) execCompactionPlan(signal *compactionSignal, pla
 		plan:        plan,
 		state:       executing,
 		dataNodeID:  nodeID,
+		createTime:  time.Now(),
 	}
 	c.plans[plan.PlanID] = task
 	c.executingTaskNum++
 	return nil
 }
 
+// addDependentPlan adds the plan into the dependency graph, the plan is submitted at once if all the plans it depends
+// on are completed, otherwise it waits with its segments marked compacting. Plans leave the graph once submitted
+func (c *compactionPlanHandler) addDependentPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error {
+	if c.deps == nil {
+		c.deps = newPlanDependencyGraph()
+		c.waiting = make(map[int64]*compactionTask)
+	}
+	planID := plan.GetPlanID()
+	if err := c.deps.AddPlan(planID, plan.GetDependsOn()); err != nil {
+		return err
+	}
+	ready, err := c.checkDependencies(planID)
+	if err == nil && ready {
+		err = c.submitPlan(signal, plan)
+	}
+	if err != nil || ready {
+		c.deps.removePlan(planID)
+	}
+	if err != nil {
+		return err
+	}
+	if !ready {
+		c.setSegmentsCompacting(plan, true)
+		c.waiting[planID] = &compactionTask{
+			triggerInfo: signal,
+			plan:        plan,
+			state:       executing,
+			createTime:  time.Now(),
+		}
+	}
+	return nil
+}
+
+// checkDependencies returns whether all the plans the plan depends on are completed,
+// or an error if any of them ends without completion
+func (c *compactionPlanHandler) checkDependencies(planID int64) (bool, error) {
+	ready := true
+	for _, dep := range c.deps.DependsOn(planID) {
+		task, ok := c.plans[dep]
+		if !ok {
+			// waiting or not submitted yet
+			ready = false
+			continue
+		}
+		switch task.state {
+		case completed:
+		case executing:
+			ready = false
+		default:
+			return false, fmt.Errorf("plan %d depends on plan %d of state %v", planID, dep, task.state)
+		}
+	}
+	return ready, nil
+}
+
+// releaseDependents submits the waiting plans depending on the plan once all their dependencies are completed,
+// and cancels them if the plan ends without completion
+func (c *compactionPlanHandler) releaseDependents(planID int64) {
+	if c.deps == nil {
+		return
+	}
+	// plans released leave the graph, which changes the dependents of the plan
+	dependents := append([]int64(nil), c.deps.Dependents(planID)...)
+	for _, id := range dependents {
+		task, ok := c.waiting[id]
+		if !ok {
+			continue
+		}
+		ready, err := c.checkDependencies(id)
+		if err == nil && !ready {
+			continue
+		}
+		if err == nil {
+			// the timeout of the plan counts from its submission
+			task.plan.StartTime = tsoutil.AddPhysicalTimeOnTs(time.Since(task.createTime).Milliseconds(), task.plan.GetStartTime())
+			if err = c.submitPlan(task.triggerInfo, task.plan); err == nil {
+				delete(c.waiting, id)
+				c.deps.removePlan(id)
+				continue
+			}
+		}
+		log.Warn("compaction plan cancelled for its dependencies", zap.Int64("planID", id), zap.Error(err))
+		c.endWaitingPlan(task, cancelled)
+	}
+}
+
+// endWaitingPlan ends the waiting plan in state, the plans depending on it are cancelled
+func (c *compactionPlanHandler) endWaitingPlan(task *compactionTask, state compactionTaskState) {
+	planID := task.plan.GetPlanID()
+	delete(c.waiting, planID)
+	c.deps.removePlan(planID)
+	c.setSegmentsCompacting(task.plan, false)
+	c.plans[planID] = task.shadowClone(setState(state), setEndTime(time.Now()))
+	c.releaseDependents(planID)
+}
+
 func (c *compactionPlanHandler) setSegmentsCompacting(plan *datapb.CompactionPlan, compacting bool) {
 	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
 		c.meta.SetSegmentCompacting(segmentBinlogs.GetSegmentID(), compacting)
@@ -178,41 +356,93 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 	}
 
 	plan := c.plans[planID].plan
-	switch plan.GetType() {
-	case datapb.CompactionType_InnerCompaction:
-		if err := c.handleInnerCompactionResult(plan, result); err != nil {
-			return err
-		}
-	case datapb.CompactionType_MergeCompaction:
-		if err := c.handleMergeCompactionResult(plan, result); err != nil {
-			return err
+	// input segments are estimated before they're replaced by the result
+	var bytesIn int64
+	for _, seg := range plan.GetSegmentBinlogs() {
+		bytesIn += estimateSegmentBytes(c.meta, c.meta.GetSegment(seg.GetSegmentID()))
+	}
+	// meta is updated only if it's saved, so that a save failed with a transient etcd error is retried as a whole
+	err := retry.Do(context.TODO(), func() error {
+		switch plan.GetType() {
+		case datapb.CompactionType_InnerCompaction:
+			return c.handleInnerCompactionResult(plan, result)
+		case datapb.CompactionType_MergeCompaction:
+			return c.handleMergeCompactionResult(plan, result)
+		default:
+			return retry.Unrecoverable(errors.New("unknown compaction type"))
 		}
-	default:
-		return errors.New("unknown compaction type")
+	}, retry.Attempts(compactionMetaRetryTimes), retry.Sleep(compactionMetaRetryInterval))
+	if err != nil {
+		// the result is kept so that the output binlogs can be reclaimed
+		c.setSegmentsCompacting(plan, false)
+		c.plans[planID] = c.plans[planID].shadowClone(setState(failed), setResult(result), setEndTime(time.Now()))
+		c.executingTaskNum--
+		c.recordHistory(c.plans[planID])
+		c.releaseDependents(planID)
+		return err
 	}
-	c.plans[planID] = c.plans[planID].shadowClone(setState(completed), setResult(result))
+	bytesOut := estimateSegmentBytes(c.meta, c.meta.GetSegment(result.GetSegmentID()))
+	c.plans[planID] = c.plans[planID].shadowClone(setState(completed), setResult(result), setEndTime(time.Now()),
+		setBytes(bytesIn, bytesOut))
 	c.executingTaskNum--
+	c.recordHistory(c.plans[planID])
 	if c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction {
 		c.flushCh <- result.GetSegmentID()
 	}
+	c.releaseDependents(planID)
 	// TODO: when to clean task list
 
 	return nil
 }
 
+// recordHistory records the compaction task finished into the history of its collection,
+// segments compacted are kept in meta until garbage collected
+func (c *compactionPlanHandler) recordHistory(task *compactionTask) {
+	if c.history == nil {
+		return
+	}
+	var collectionID UniqueID
+	if task.triggerInfo != nil {
+		collectionID = task.triggerInfo.collectionID
+	}
+	for _, seg := range task.plan.GetSegmentBinlogs() {
+		if segment := c.meta.GetSegment(seg.GetSegmentID()); segment != nil {
+			collectionID = segment.GetCollectionID()
+			break
+		}
+	}
+	c.history.record(collectionID, task)
+}
+
 func (c *compactionPlanHandler) handleInnerCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
 	return c.meta.CompleteInnerCompaction(plan.GetSegmentBinlogs()[0], result)
 }
 
 func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
-	return c.meta.CompleteMergeCompaction(plan.GetSegmentBinlogs(), result)
+	// input segments are dropped once the compaction is completed
+	var inputRows int64
+	for _, seg := range plan.GetSegmentBinlogs() {
+		if segment := c.meta.GetSegment(seg.GetSegmentID()); segment != nil {
+			inputRows += segment.GetNumOfRows()
+		}
+	}
+	if err := c.meta.CompleteMergeCompaction(plan.GetSegmentBinlogs(), result); err != nil {
+		return err
+	}
+	if c.segmentSizer != nil {
+		c.segmentSizer.observe(inputRows, result.GetNumOfRows())
+	}
+	return nil
 }
 
-// getCompaction return compaction task. If planId does not exist, return nil.
+// getCompaction return compaction task, waiting plan is regarded as executing. If planId does not exist, return nil.
 func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
+	if task, ok := c.waiting[planID]; ok {
+		return task
+	}
 	return c.plans[planID]
 }
 
@@ -227,11 +457,20 @@ func (c *compactionPlanHandler) expireCompaction(ts Timestamp) error {
 			continue
 		}
 
-		c.setSegmentsCompacting(task.plan, false)
-
 		planID := task.plan.PlanID
-		c.plans[planID] = c.plans[planID].shadowClone(setState(timeout))
+		if waiting, ok := c.waiting[planID]; ok {
+			c.endWaitingPlan(waiting, timeout)
+			continue
+		}
+		// cancelled as the dependent of a plan expired before
+		if c.plans[planID].state != executing {
+			continue
+		}
+
+		c.setSegmentsCompacting(task.plan, false)
+		c.plans[planID] = c.plans[planID].shadowClone(setState(timeout), setEndTime(time.Now()))
 		c.executingTaskNum--
+		c.releaseDependents(planID)
 	}
 
 	return nil
@@ -251,31 +490,92 @@ func (c *compactionPlanHandler) isFull() bool {
 	return c.executingTaskNum >= maxParallelCompactionTaskNum
 }
 
+// getExecutingCompactions returns the compaction tasks executing, waiting plans are regarded as executing
 func (c *compactionPlanHandler) getExecutingCompactions() []*compactionTask {
-	tasks := make([]*compactionTask, 0, len(c.plans))
+	tasks := make([]*compactionTask, 0, len(c.plans)+len(c.waiting))
 	for _, plan := range c.plans {
 		if plan.state == executing {
 			tasks = append(tasks, plan)
 		}
 	}
+	for _, plan := range c.waiting {
+		tasks = append(tasks, plan)
+	}
 	return tasks
 }
 
-// get compaction tasks by signal id
+// get compaction tasks by signal id, waiting plans are regarded as executing
 func (c *compactionPlanHandler) getCompactionTasksBySignalID(signalID int64) []*compactionTask {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
 	var tasks []*compactionTask
-	for _, t := range c.plans {
-		if t.triggerInfo.id != signalID {
-			continue
+	for _, plans := range []map[int64]*compactionTask{c.plans, c.waiting} {
+		for _, t := range plans {
+			if t.triggerInfo.id != signalID {
+				continue
+			}
+			tasks = append(tasks, t)
 		}
+	}
+	return tasks
+}
+
+// getCompactionTasks returns all the compaction tasks, waiting plans are regarded as executing
+func (c *compactionPlanHandler) getCompactionTasks() []*compactionTask {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	tasks := make([]*compactionTask, 0, len(c.plans)+len(c.waiting))
+	for _, t := range c.plans {
+		tasks = append(tasks, t)
+	}
+	for _, t := range c.waiting {
 		tasks = append(tasks, t)
 	}
 	return tasks
 }
 
+// reclaimCompaction marks a failed compaction as reclaimed after its output binlogs are removed
+func (c *compactionPlanHandler) reclaimCompaction(planID int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	task, ok := c.plans[planID]
+	if !ok {
+		return fmt.Errorf("plan %d is not found", planID)
+	}
+	if task.state != failed {
+		return fmt.Errorf("plan %d's state is %v", planID, task.state)
+	}
+	c.plans[planID] = task.shadowClone(setState(reclaimed))
+	return nil
+}
+
+// cancelCompaction marks an executing compaction as cancelled, so that its result is rejected. Plans not executing
+// are kept as is, the task returned tells the final state
+func (c *compactionPlanHandler) cancelCompaction(planID int64) (*compactionTask, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if task, ok := c.waiting[planID]; ok {
+		c.endWaitingPlan(task, cancelled)
+		return c.plans[planID], nil
+	}
+	task, ok := c.plans[planID]
+	if !ok {
+		return nil, fmt.Errorf("plan %d is not found", planID)
+	}
+	if task.state != executing {
+		return task, nil
+	}
+	c.setSegmentsCompacting(task.plan, false)
+	c.plans[planID] = task.shadowClone(setState(cancelled), setEndTime(time.Now()))
+	c.executingTaskNum--
+	c.releaseDependents(planID)
+	return c.plans[planID], nil
+}
+
 type compactionTaskOpt func(task *compactionTask)
 
 func setState(state compactionTaskState) compactionTaskOpt {
@@ -289,3 +589,16 @@ func setResult(result *datapb.CompactionResult) compactionTaskOpt {
 		task.result = result
 	}
 }
+
+func setEndTime(endTime time.Time) compactionTaskOpt {
+	return func(task *compactionTask) {
+		task.endTime = endTime
+	}
+}
+
+func setBytes(bytesIn, bytesOut int64) compactionTaskOpt {
+	return func(task *compactionTask) {
+		task.bytesIn = bytesIn
+		task.bytesOut = bytesOut
+	}
+}
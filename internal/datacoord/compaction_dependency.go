@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PlanDependencyGraph records the dependencies between compaction plans, a plan starts only after all the plans
+// it depends on are completed, e.g. L1 to L2 compaction waits for the L0 to L1 compaction producing its input.
+// Plans depended on may be added after the plans depending on them
+type PlanDependencyGraph struct {
+	dependsOn  map[int64][]int64 // plan id => ids of plans it depends on
+	dependents map[int64][]int64 // plan id => ids of plans depending on it
+}
+
+func newPlanDependencyGraph() *PlanDependencyGraph {
+	return &PlanDependencyGraph{
+		dependsOn:  make(map[int64][]int64),
+		dependents: make(map[int64][]int64),
+	}
+}
+
+// AddPlan adds the plan depending on the plans of dependsOn, the graph is left unchanged and an error is returned
+// if the plan is added already or the dependencies are cyclic
+func (g *PlanDependencyGraph) AddPlan(planID int64, dependsOn []int64) error {
+	if _, ok := g.dependsOn[planID]; ok {
+		return fmt.Errorf("dependencies of plan %d are added already", planID)
+	}
+	deps := make([]int64, 0, len(dependsOn))
+	seen := make(map[int64]struct{}, len(dependsOn))
+	for _, dep := range dependsOn {
+		if _, ok := seen[dep]; !ok {
+			seen[dep] = struct{}{}
+			deps = append(deps, dep)
+		}
+	}
+
+	g.dependsOn[planID] = deps
+	for _, dep := range deps {
+		g.dependents[dep] = append(g.dependents[dep], planID)
+	}
+	if err := g.DetectCycle(); err != nil {
+		g.removePlan(planID)
+		return err
+	}
+	return nil
+}
+
+func (g *PlanDependencyGraph) removePlan(planID int64) {
+	for _, dep := range g.dependsOn[planID] {
+		dependents := g.dependents[dep]
+		for i, id := range dependents {
+			if id == planID {
+				dependents = append(dependents[:i], dependents[i+1:]...)
+				break
+			}
+		}
+		if len(dependents) == 0 {
+			delete(g.dependents, dep)
+		} else {
+			g.dependents[dep] = dependents
+		}
+	}
+	delete(g.dependsOn, planID)
+}
+
+// DependsOn returns the ids of plans the plan depends on
+func (g *PlanDependencyGraph) DependsOn(planID int64) []int64 {
+	return g.dependsOn[planID]
+}
+
+// Dependents returns the ids of plans depending on the plan
+func (g *PlanDependencyGraph) Dependents(planID int64) []int64 {
+	return g.dependents[planID]
+}
+
+// DetectCycle returns an error describing a cycle of dependencies if any
+func (g *PlanDependencyGraph) DetectCycle() error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	states := make(map[int64]int, len(g.dependsOn))
+	var path []int64
+	var visit func(planID int64) []int64
+	visit = func(planID int64) []int64 {
+		switch states[planID] {
+		case visiting:
+			for i, id := range path {
+				if id == planID {
+					return append(append([]int64{}, path[i:]...), planID)
+				}
+			}
+		case visited:
+			return nil
+		}
+		states[planID] = visiting
+		path = append(path, planID)
+		for _, dep := range g.dependsOn[planID] {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		states[planID] = visited
+		return nil
+	}
+
+	planIDs := make([]int64, 0, len(g.dependsOn))
+	for planID := range g.dependsOn {
+		planIDs = append(planIDs, planID)
+	}
+	sort.Slice(planIDs, func(i, j int) bool { return planIDs[i] < planIDs[j] })
+	for _, planID := range planIDs {
+		if cycle := visit(planID); cycle != nil {
+			ids := make([]string, 0, len(cycle))
+			for _, id := range cycle {
+				ids = append(ids, fmt.Sprint(id))
+			}
+			return fmt.Errorf("cyclic compaction plan dependencies: %s", strings.Join(ids, " -> "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanDependencyGraph(t *testing.T) {
+	t.Run("linear", func(t *testing.T) {
+		g := newPlanDependencyGraph()
+		assert.NoError(t, g.AddPlan(2, []int64{1}))
+		assert.NoError(t, g.AddPlan(3, []int64{2, 2}))
+		assert.NoError(t, g.DetectCycle())
+		assert.Equal(t, []int64{2}, g.DependsOn(3))
+		assert.Equal(t, []int64{3}, g.Dependents(2))
+		assert.Equal(t, []int64{2}, g.Dependents(1))
+		assert.Empty(t, g.DependsOn(1))
+
+		assert.Error(t, g.AddPlan(3, []int64{1}))
+		// 1 -> 3 -> 2 -> 1
+		assert.EqualError(t, g.AddPlan(1, []int64{3}), "cyclic compaction plan dependencies: 1 -> 3 -> 2 -> 1")
+		assert.Empty(t, g.DependsOn(1))
+		assert.Equal(t, []int64{3}, g.Dependents(2))
+		assert.Empty(t, g.Dependents(3))
+		assert.NoError(t, g.DetectCycle())
+
+		assert.Error(t, g.AddPlan(4, []int64{4}))
+		assert.Empty(t, g.Dependents(4))
+	})
+
+	t.Run("diamond", func(t *testing.T) {
+		g := newPlanDependencyGraph()
+		assert.NoError(t, g.AddPlan(4, []int64{2, 3}))
+		assert.NoError(t, g.AddPlan(2, []int64{1}))
+		assert.NoError(t, g.AddPlan(3, []int64{1}))
+		assert.NoError(t, g.DetectCycle())
+		assert.ElementsMatch(t, []int64{2, 3}, g.Dependents(1))
+		assert.Equal(t, []int64{4}, g.Dependents(2))
+		assert.Equal(t, []int64{4}, g.Dependents(3))
+
+		assert.Error(t, g.AddPlan(1, []int64{4}))
+		assert.NoError(t, g.AddPlan(1, []int64{0}))
+	})
+}
+
+// newDependencyTestHandler creates a compaction handler with flushed segments of the ids, plans are sent to
+// the DataNode watching channel ch1
+func newDependencyTestHandler(t *testing.T, segmentIDs ...UniqueID) (*compactionPlanHandler, chan interface{}) {
+	meta, err := newMemoryMeta(nil)
+	require.NoError(t, err)
+	for _, segmentID := range segmentIDs {
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: segmentID, NumOfRows: 1,
+			State: commonpb.SegmentState_Flushed, InsertChannel: "ch1"})))
+	}
+	ch := make(chan interface{}, 10)
+	c := &compactionPlanHandler{
+		plans: make(map[int64]*compactionTask),
+		sessions: &SessionManager{
+			sessions: struct {
+				sync.RWMutex
+				data map[int64]*Session
+			}{
+				data: map[int64]*Session{1: {client: &mockDataNodeClient{ch: ch}}},
+			},
+		},
+		chManager: &ChannelManager{
+			store: &ChannelStore{
+				channelsInfo: map[int64]*NodeChannelInfo{
+					1:        {NodeID: 1, Channels: []*channel{{Name: "ch1"}}},
+					bufferID: {NodeID: bufferID},
+				},
+			},
+		},
+		meta:    meta,
+		flushCh: make(chan UniqueID, 10),
+	}
+	return c, ch
+}
+
+func newDependentPlan(planID int64, segmentIDs []UniqueID, dependsOn ...int64) *datapb.CompactionPlan {
+	plan := &datapb.CompactionPlan{
+		PlanID:           planID,
+		Channel:          "ch1",
+		Type:             datapb.CompactionType_MergeCompaction,
+		TimeoutInSeconds: 10,
+		DependsOn:        dependsOn,
+	}
+	for _, segmentID := range segmentIDs {
+		plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{SegmentID: segmentID})
+	}
+	return plan
+}
+
+func Test_compactionPlanHandler_dependencies(t *testing.T) {
+	signal := &compactionSignal{id: 100}
+	isWaiting := func(c *compactionPlanHandler, planID int64) bool {
+		_, ok := c.waiting[planID]
+		return ok
+	}
+
+	t.Run("linear", func(t *testing.T) {
+		// L0 -> L1 -> L2, output segment of each plan is the input of the next one
+		c, ch := newDependencyTestHandler(t, 1)
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(3, []UniqueID{12}, 2)))
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(2, []UniqueID{11}, 1)))
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(1, []UniqueID{1})))
+		<-ch
+		assert.True(t, isWaiting(c, 2))
+		assert.True(t, isWaiting(c, 3))
+		assert.Equal(t, executing, c.getCompaction(2).state)
+		assert.Equal(t, 1, c.executingTaskNum)
+		assert.True(t, c.meta.GetSegment(1).isCompacting)
+		assert.Len(t, c.getCompactionTasksBySignalID(100), 3)
+		assert.Len(t, c.getCompactionTasks(), 3)
+
+		require.NoError(t, c.completeCompaction(&datapb.CompactionResult{PlanID: 1, SegmentID: 11, NumOfRows: 1}))
+		<-ch
+		assert.False(t, isWaiting(c, 2))
+		assert.True(t, isWaiting(c, 3))
+		assert.True(t, c.meta.GetSegment(11).isCompacting)
+
+		require.NoError(t, c.completeCompaction(&datapb.CompactionResult{PlanID: 2, SegmentID: 12, NumOfRows: 1}))
+		<-ch
+		assert.False(t, isWaiting(c, 3))
+		assert.Equal(t, executing, c.getCompaction(3).state)
+		assert.Equal(t, 1, c.executingTaskNum)
+
+		// dependencies of plan completed are submitted at once
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(4, nil, 1)))
+		<-ch
+		assert.False(t, isWaiting(c, 4))
+		assert.Equal(t, 2, c.executingTaskNum)
+
+		// plans submitted leave the graph
+		assert.Empty(t, c.deps.dependsOn)
+		assert.Empty(t, c.deps.dependents)
+
+		// cyclic dependencies are rejected, and the plans waiting for the plan rejected are cancelled
+		assert.Error(t, c.execCompactionPlan(signal, newDependentPlan(5, nil, 5)))
+		assert.NoError(t, c.execCompactionPlan(signal, newDependentPlan(6, nil, 7)))
+		assert.Error(t, c.execCompactionPlan(signal, newDependentPlan(6, nil, 8)))
+		assert.True(t, isWaiting(c, 6))
+		assert.Error(t, c.execCompactionPlan(signal, newDependentPlan(7, nil, 6)))
+		assert.Nil(t, c.getCompaction(7))
+		assert.Equal(t, cancelled, c.getCompaction(6).state)
+		assert.Empty(t, c.deps.dependsOn)
+		assert.Empty(t, c.deps.dependents)
+	})
+
+	t.Run("dependency failed to submit", func(t *testing.T) {
+		c, _ := newDependencyTestHandler(t, 1, 2)
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(2, []UniqueID{2}, 1)))
+		assert.True(t, c.meta.GetSegment(2).isCompacting)
+
+		// no DataNode watches the channel of plan 1
+		plan := newDependentPlan(1, []UniqueID{1})
+		plan.Channel = "ch2"
+		assert.Error(t, c.execCompactionPlan(signal, plan))
+		assert.Nil(t, c.getCompaction(1))
+		assert.Equal(t, cancelled, c.getCompaction(2).state)
+		assert.False(t, c.meta.GetSegment(2).isCompacting)
+		assert.Empty(t, c.waiting)
+	})
+
+	t.Run("waiting plan expired", func(t *testing.T) {
+		c, ch := newDependencyTestHandler(t, 1, 2, 3)
+		now := tsoutil.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0)
+		plan := newDependentPlan(1, []UniqueID{1})
+		plan.StartTime = now
+		require.NoError(t, c.execCompactionPlan(signal, plan))
+		<-ch
+		plan = newDependentPlan(2, []UniqueID{2}, 1)
+		plan.StartTime = now
+		plan.TimeoutInSeconds = 1
+		require.NoError(t, c.execCompactionPlan(signal, plan))
+		plan = newDependentPlan(3, []UniqueID{3}, 2)
+		plan.StartTime = now
+		require.NoError(t, c.execCompactionPlan(signal, plan))
+		assert.Len(t, c.getExecutingCompactions(), 3)
+
+		// plan 2 times out while waiting, plan 3 depending on it is cancelled
+		require.NoError(t, c.expireCompaction(tsoutil.AddPhysicalTimeOnTs(2000, now)))
+		assert.Equal(t, executing, c.getCompaction(1).state)
+		assert.Equal(t, timeout, c.getCompaction(2).state)
+		assert.Equal(t, cancelled, c.getCompaction(3).state)
+		assert.False(t, c.meta.GetSegment(2).isCompacting)
+		assert.False(t, c.meta.GetSegment(3).isCompacting)
+		assert.Empty(t, c.waiting)
+		assert.Empty(t, c.deps.dependsOn)
+		assert.Equal(t, 1, c.executingTaskNum)
+	})
+
+	t.Run("diamond", func(t *testing.T) {
+		c, ch := newDependencyTestHandler(t, 1, 2, 3)
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(1, []UniqueID{1})))
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(2, []UniqueID{2}, 1)))
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(3, []UniqueID{3}, 1)))
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(4, []UniqueID{12, 13}, 2, 3)))
+		<-ch
+		assert.True(t, c.meta.GetSegment(2).isCompacting)
+
+		require.NoError(t, c.completeCompaction(&datapb.CompactionResult{PlanID: 1, SegmentID: 11, NumOfRows: 1}))
+		<-ch
+		<-ch
+		assert.False(t, isWaiting(c, 2))
+		assert.False(t, isWaiting(c, 3))
+		assert.True(t, isWaiting(c, 4))
+		assert.Equal(t, 2, c.executingTaskNum)
+
+		// waits for all the plans it depends on
+		require.NoError(t, c.completeCompaction(&datapb.CompactionResult{PlanID: 2, SegmentID: 12, NumOfRows: 1}))
+		assert.True(t, isWaiting(c, 4))
+		require.NoError(t, c.completeCompaction(&datapb.CompactionResult{PlanID: 3, SegmentID: 13, NumOfRows: 1}))
+		<-ch
+		assert.False(t, isWaiting(c, 4))
+		assert.Equal(t, executing, c.getCompaction(4).state)
+	})
+
+	t.Run("dependency not completed", func(t *testing.T) {
+		c, ch := newDependencyTestHandler(t, 1, 2, 3)
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(1, []UniqueID{1})))
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(2, []UniqueID{2}, 1)))
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(3, []UniqueID{3}, 2)))
+		<-ch
+
+		// plans depending on the plan cancelled are cancelled transitively
+		_, err := c.cancelCompaction(1)
+		require.NoError(t, err)
+		assert.Equal(t, cancelled, c.getCompaction(2).state)
+		assert.Equal(t, cancelled, c.getCompaction(3).state)
+		assert.False(t, c.meta.GetSegment(2).isCompacting)
+		assert.False(t, c.meta.GetSegment(3).isCompacting)
+		assert.Empty(t, c.waiting)
+		assert.Equal(t, 0, c.executingTaskNum)
+
+		assert.Error(t, c.execCompactionPlan(signal, newDependentPlan(4, nil, 1)))
+		assert.Nil(t, c.getCompaction(4))
+
+		// waiting plan cancelled
+		require.NoError(t, c.execCompactionPlan(signal, newDependentPlan(5, []UniqueID{2}, 6)))
+		task, err := c.cancelCompaction(5)
+		require.NoError(t, err)
+		assert.Equal(t, cancelled, task.state)
+		assert.False(t, c.meta.GetSegment(2).isCompacting)
+	})
+}
@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// estimateCompactionPlans converts the plans proposed in a dry run into merge infos, and estimates the size of
+// the source segments and the target segments. The size of a segment is estimated by the rows and the schema
+// plus its deltalogs, and the target segments keep only the rows not deleted before the timetravel of plan
+func estimateCompactionPlans(m *meta, plans []*datapb.CompactionPlan) ([]*milvuspb.CompactionMergeInfo, int64, int64) {
+	sizePerRow := make(map[UniqueID]int64)
+	mergeInfos := make([]*milvuspb.CompactionMergeInfo, 0, len(plans))
+	var sourceSize, targetSize int64
+	for _, plan := range plans {
+		sources := make([]int64, 0, len(plan.GetSegmentBinlogs()))
+		for _, binlogs := range plan.GetSegmentBinlogs() {
+			sources = append(sources, binlogs.GetSegmentID())
+			segment := m.GetSegment(binlogs.GetSegmentID())
+			if segment == nil {
+				continue
+			}
+			size, ok := sizePerRow[segment.GetCollectionID()]
+			if !ok {
+				if s, err := typeutil.EstimateSizePerRecord(m.GetCollection(segment.GetCollectionID()).GetSchema()); err == nil {
+					size = int64(s)
+				}
+				sizePerRow[segment.GetCollectionID()] = size
+			}
+
+			deletedRows, _ := sumDeltalogs(segment, &timetravel{plan.GetTimetravel()})
+			rows := segment.GetNumOfRows() - int64(deletedRows)
+			if rows < 0 {
+				rows = 0
+			}
+			sourceSize += size * segment.GetNumOfRows()
+			for _, l := range segment.GetDeltalogs() {
+				sourceSize += l.GetDeltaLogSize()
+			}
+			targetSize += size * rows
+		}
+		mergeInfos = append(mergeInfos, &milvuspb.CompactionMergeInfo{Sources: sources})
+	}
+	return mergeInfos, sourceSize, targetSize
+}
@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDryRunTestMeta returns a meta of collection 1 with flushed segments 1, 2 and 3 of 100 rows in 8 bytes,
+// segment 1 has 5 rows deleted before timetravel 200
+func newDryRunTestMeta(t *testing.T) *meta {
+	meta, err := newMemoryMeta(newMockAllocator())
+	require.NoError(t, err)
+	meta.AddCollection(&datapb.CollectionInfo{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}},
+		},
+	})
+	for _, id := range []UniqueID{1, 2, 3} {
+		segment := &datapb.SegmentInfo{
+			ID:             id,
+			CollectionID:   1,
+			PartitionID:    1,
+			LastExpireTime: 100,
+			NumOfRows:      100,
+			MaxRowNum:      300,
+			InsertChannel:  "ch1",
+			State:          commonpb.SegmentState_Flushed,
+			Binlogs:        []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"log"}}},
+		}
+		if id == 1 {
+			segment.Deltalogs = []*datapb.DeltaLogInfo{{RecordEntries: 5, TimestampTo: 100, DeltaLogSize: 10, DeltaLogPath: "deltalog"}}
+		}
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+	return meta
+}
+
+func Test_compactionTrigger_dryRunForceCompaction(t *testing.T) {
+	meta := newDryRunTestMeta(t)
+	handler := newCompactionPlanHandler(nil, nil, meta, newMockAllocator(), nil)
+	tr := newCompactionTrigger(meta, handler, newMockAllocator())
+	tr.mergeCompactionPolicy = (mergeCompactionFunc)(greedyMergeCompaction)
+
+	plans := tr.dryRunForceCompaction(1, &timetravel{time: 200})
+	require.Equal(t, 2, len(plans))
+	// segment 1 is in the single compaction plan only, as if it's compacting
+	assert.Equal(t, datapb.CompactionType_InnerCompaction, plans[0].GetType())
+	assert.Equal(t, []UniqueID{1}, getPlanSegmentIDs(plans[0]))
+	assert.Equal(t, datapb.CompactionType_MergeCompaction, plans[1].GetType())
+	assert.ElementsMatch(t, []UniqueID{2, 3}, getPlanSegmentIDs(plans[1]))
+	for _, plan := range plans {
+		assert.EqualValues(t, 0, plan.GetPlanID())
+	}
+
+	// nothing is executed
+	assert.Empty(t, handler.plans)
+	for _, id := range []UniqueID{1, 2, 3} {
+		assert.False(t, meta.GetSegment(id).isCompacting)
+	}
+
+	mergeInfos, sourceSize, targetSize := estimateCompactionPlans(meta, plans)
+	assert.Equal(t, 2, len(mergeInfos))
+	assert.Equal(t, &milvuspb.CompactionMergeInfo{Sources: []int64{1}}, mergeInfos[0])
+	assert.EqualValues(t, 8*300+10, sourceSize)
+	assert.EqualValues(t, 8*295, targetSize)
+}
@@ -0,0 +1,276 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"go.uber.org/zap"
+)
+
+// maxQueuedCompactionPlanNum is the max number of compaction plans waiting in the fair queue
+const maxQueuedCompactionPlanNum = 1000
+
+type compactionPartitionKey struct {
+	collectionID UniqueID
+	partitionID  UniqueID
+}
+
+type queuedCompactionPlan struct {
+	signal      *compactionSignal
+	plan        *datapb.CompactionPlan
+	enqueueTime time.Time
+}
+
+var _ compactionPlanContext = (*FairQueueCompactionHandler)(nil)
+
+// FairQueueCompactionHandler keeps one queue of compaction plans per partition, and dispatches plans to
+// the underlying handler round-robin across non-empty queues, so that a hot partition generating plans
+// faster than they complete will not starve other partitions
+type FairQueueCompactionHandler struct {
+	compactionPlanContext
+	meta *meta
+
+	mu        sync.Mutex
+	queues    map[compactionPartitionKey][]*queuedCompactionPlan
+	keys      []compactionPartitionKey // partitions with non-empty queue in round-robin order
+	next      int                      // index in keys of the partition to dispatch next
+	queuedNum int
+}
+
+func newFairQueueCompactionHandler(handler compactionPlanContext, meta *meta) *FairQueueCompactionHandler {
+	return &FairQueueCompactionHandler{
+		compactionPlanContext: handler,
+		meta:                  meta,
+		queues:                make(map[compactionPartitionKey][]*queuedCompactionPlan),
+	}
+}
+
+// execCompactionPlan puts the plan into the queue of its partition and return immediately,
+// segments of the plan are marked compacting until the plan is dispatched
+func (h *FairQueueCompactionHandler) execCompactionPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error {
+	h.mu.Lock()
+	key := h.partitionKeyOf(signal, plan)
+	if _, ok := h.queues[key]; !ok {
+		h.keys = append(h.keys, key)
+	}
+	h.queues[key] = append(h.queues[key], &queuedCompactionPlan{
+		signal:      signal,
+		plan:        plan,
+		enqueueTime: time.Now(),
+	})
+	h.queuedNum++
+	h.setSegmentsCompacting(plan, true)
+	h.mu.Unlock()
+
+	h.dispatch()
+	return nil
+}
+
+// completeCompaction record the result of a compaction and dispatch queued plans
+func (h *FairQueueCompactionHandler) completeCompaction(result *datapb.CompactionResult) error {
+	err := h.compactionPlanContext.completeCompaction(result)
+	h.dispatch()
+	return err
+}
+
+// expireCompaction set the compaction state to expired and dispatch queued plans
+func (h *FairQueueCompactionHandler) expireCompaction(ts Timestamp) error {
+	err := h.compactionPlanContext.expireCompaction(ts)
+	h.dispatch()
+	return err
+}
+
+// getCompaction return compaction task, queued plan is regarded as executing
+func (h *FairQueueCompactionHandler) getCompaction(planID int64) *compactionTask {
+	if task := h.compactionPlanContext.getCompaction(planID); task != nil {
+		return task
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, queue := range h.queues {
+		for _, queued := range queue {
+			if queued.plan.GetPlanID() == planID {
+				return queued.task()
+			}
+		}
+	}
+	return nil
+}
+
+// cancelCompaction removes the plan from the queue if it's not dispatched yet, otherwise cancels it in the underlying handler
+func (h *FairQueueCompactionHandler) cancelCompaction(planID int64) (*compactionTask, error) {
+	h.mu.Lock()
+	queued := h.remove(planID)
+	h.mu.Unlock()
+	if queued != nil {
+		h.setSegmentsCompacting(queued.plan, false)
+		return queued.task().shadowClone(setState(cancelled), setEndTime(time.Now())), nil
+	}
+
+	task, err := h.compactionPlanContext.cancelCompaction(planID)
+	h.dispatch()
+	return task, err
+}
+
+// isFull return true if the queue is full
+func (h *FairQueueCompactionHandler) isFull() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.queuedNum >= maxQueuedCompactionPlanNum
+}
+
+// get compaction tasks by signal id, queued plans are regarded as executing
+func (h *FairQueueCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*compactionTask {
+	tasks := h.compactionPlanContext.getCompactionTasksBySignalID(signalID)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, queue := range h.queues {
+		for _, queued := range queue {
+			if queued.signal.id == signalID {
+				tasks = append(tasks, queued.task())
+			}
+		}
+	}
+	return tasks
+}
+
+// getCompactionTasks returns all the compaction tasks, queued plans are regarded as executing
+func (h *FairQueueCompactionHandler) getCompactionTasks() []*compactionTask {
+	tasks := h.compactionPlanContext.getCompactionTasks()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, queue := range h.queues {
+		for _, queued := range queue {
+			tasks = append(tasks, queued.task())
+		}
+	}
+	return tasks
+}
+
+// dispatch moves queued plans to the underlying handler until it's full
+func (h *FairQueueCompactionHandler) dispatch() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for h.queuedNum > 0 && !h.compactionPlanContext.isFull() {
+		queued := h.pop()
+		if err := h.compactionPlanContext.execCompactionPlan(queued.signal, queued.plan); err != nil {
+			log.Warn("failed to dispatch compaction plan", zap.Int64("planID", queued.plan.GetPlanID()), zap.Error(err))
+			h.setSegmentsCompacting(queued.plan, false)
+		}
+	}
+	metrics.DataCoordCompactionQueueFairness.Set(h.fairness(time.Now()))
+}
+
+// pop removes the first plan of the next partition in round-robin order
+func (h *FairQueueCompactionHandler) pop() *queuedCompactionPlan {
+	key := h.keys[h.next]
+	queue := h.queues[key]
+	queued := queue[0]
+	queue[0] = nil
+	if len(queue) == 1 {
+		delete(h.queues, key)
+		// the next partition takes the place of the removed one
+		h.keys = append(h.keys[:h.next], h.keys[h.next+1:]...)
+	} else {
+		h.queues[key] = queue[1:]
+		h.next++
+	}
+	if h.next >= len(h.keys) {
+		h.next = 0
+	}
+	h.queuedNum--
+	return queued
+}
+
+// remove removes the plan from its queue, returns nil if the plan is not queued
+func (h *FairQueueCompactionHandler) remove(planID int64) *queuedCompactionPlan {
+	for i, key := range h.keys {
+		queue := h.queues[key]
+		for j, queued := range queue {
+			if queued.plan.GetPlanID() != planID {
+				continue
+			}
+			if len(queue) == 1 {
+				delete(h.queues, key)
+				h.keys = append(h.keys[:i], h.keys[i+1:]...)
+				if i < h.next {
+					h.next--
+				}
+				if h.next >= len(h.keys) {
+					h.next = 0
+				}
+			} else {
+				h.queues[key] = append(queue[:j], queue[j+1:]...)
+			}
+			h.queuedNum--
+			return queued
+		}
+	}
+	return nil
+}
+
+// fairness returns the ratio of the longest to the shortest wait time of the oldest plans across partitions,
+// 1 means all partitions have waited for the same time
+func (h *FairQueueCompactionHandler) fairness(now time.Time) float64 {
+	if len(h.keys) < 2 {
+		return 1
+	}
+	var maxWait, minWait time.Duration
+	for i, key := range h.keys {
+		wait := now.Sub(h.queues[key][0].enqueueTime)
+		if i == 0 || wait > maxWait {
+			maxWait = wait
+		}
+		if i == 0 || wait < minWait {
+			minWait = wait
+		}
+	}
+	if minWait <= 0 {
+		minWait = 1
+	}
+	return float64(maxWait) / float64(minWait)
+}
+
+func (h *FairQueueCompactionHandler) partitionKeyOf(signal *compactionSignal, plan *datapb.CompactionPlan) compactionPartitionKey {
+	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
+		if segment := h.meta.GetSegment(segmentBinlogs.GetSegmentID()); segment != nil {
+			return compactionPartitionKey{collectionID: segment.GetCollectionID(), partitionID: segment.GetPartitionID()}
+		}
+	}
+	return compactionPartitionKey{collectionID: signal.collectionID, partitionID: signal.partitionID}
+}
+
+func (h *FairQueueCompactionHandler) setSegmentsCompacting(plan *datapb.CompactionPlan, compacting bool) {
+	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
+		h.meta.SetSegmentCompacting(segmentBinlogs.GetSegmentID(), compacting)
+	}
+}
+
+func (q *queuedCompactionPlan) task() *compactionTask {
+	return &compactionTask{
+		triggerInfo: q.signal,
+		plan:        q.plan,
+		state:       executing,
+		createTime:  q.enqueueTime,
+	}
+}
@@ -0,0 +1,245 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+)
+
+// capacityCompactionHandler executes at most capacity plans at the same time
+type capacityCompactionHandler struct {
+	compactionPlanContext
+	capacity   int
+	executing  map[int64]*datapb.CompactionPlan
+	dispatched []int64
+	err        error
+}
+
+func newCapacityCompactionHandler(capacity int) *capacityCompactionHandler {
+	return &capacityCompactionHandler{
+		capacity:  capacity,
+		executing: make(map[int64]*datapb.CompactionPlan),
+	}
+}
+
+func (h *capacityCompactionHandler) execCompactionPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error {
+	if h.err != nil {
+		return h.err
+	}
+	h.executing[plan.GetPlanID()] = plan
+	h.dispatched = append(h.dispatched, plan.GetPlanID())
+	return nil
+}
+
+func (h *capacityCompactionHandler) completeCompaction(result *datapb.CompactionResult) error {
+	delete(h.executing, result.GetPlanID())
+	return nil
+}
+
+func (h *capacityCompactionHandler) cancelCompaction(planID int64) (*compactionTask, error) {
+	plan, ok := h.executing[planID]
+	if !ok {
+		return nil, errors.New("plan not found")
+	}
+	delete(h.executing, planID)
+	return &compactionTask{plan: plan, state: cancelled}, nil
+}
+
+func (h *capacityCompactionHandler) getCompaction(planID int64) *compactionTask {
+	return nil
+}
+
+func (h *capacityCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*compactionTask {
+	return nil
+}
+
+func (h *capacityCompactionHandler) getCompactionTasks() []*compactionTask {
+	return nil
+}
+
+func (h *capacityCompactionHandler) isFull() bool {
+	return len(h.executing) >= h.capacity
+}
+
+func newFairQueueTestMeta(segmentPartitions map[UniqueID]UniqueID) *meta {
+	segments := NewSegmentsInfo()
+	for segmentID, partitionID := range segmentPartitions {
+		segments.SetSegment(segmentID, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           segmentID,
+			CollectionID: 1,
+			PartitionID:  partitionID,
+			State:        commonpb.SegmentState_Flushed,
+		}))
+	}
+	return &meta{segments: segments}
+}
+
+func newFairQueueTestPlan(planID int64, segmentID UniqueID) *datapb.CompactionPlan {
+	return &datapb.CompactionPlan{
+		PlanID: planID,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: segmentID},
+		},
+	}
+}
+
+func TestFairQueueCompactionHandler_Dispatch(t *testing.T) {
+	// segment id => partition id, plan i compacts segment i
+	m := newFairQueueTestMeta(map[UniqueID]UniqueID{1: 1, 2: 1, 3: 1, 4: 1, 5: 1, 6: 2, 7: 3})
+	inner := newCapacityCompactionHandler(1)
+	h := newFairQueueCompactionHandler(inner, m)
+
+	signal := &compactionSignal{id: 100}
+	for i := int64(1); i <= 7; i++ {
+		err := h.execCompactionPlan(signal, newFairQueueTestPlan(i, i))
+		assert.Nil(t, err)
+		assert.True(t, m.GetSegment(i).isCompacting)
+	}
+	assert.Equal(t, []int64{1}, inner.dispatched)
+	assert.False(t, h.isFull())
+
+	// queued plans are regarded as executing
+	assert.Equal(t, 6, len(h.getCompactionTasksBySignalID(100)))
+	tasks := h.getCompactionTasks()
+	assert.Equal(t, 6, len(tasks))
+	for _, task := range tasks {
+		assert.Equal(t, executing, task.state)
+		assert.False(t, task.createTime.IsZero())
+	}
+	task := h.getCompaction(7)
+	assert.NotNil(t, task)
+	assert.Equal(t, executing, task.state)
+	assert.Nil(t, h.getCompaction(8))
+
+	for i := 0; i < 7; i++ {
+		for planID := range inner.executing {
+			err := h.completeCompaction(&datapb.CompactionResult{PlanID: planID})
+			assert.Nil(t, err)
+		}
+	}
+	// quiet partition 2 and 3 are not starved by hot partition 1
+	assert.Equal(t, []int64{1, 2, 6, 7, 3, 4, 5}, inner.dispatched)
+	assert.Equal(t, 0, len(h.getCompactionTasksBySignalID(100)))
+}
+
+func TestFairQueueCompactionHandler_DispatchFailed(t *testing.T) {
+	m := newFairQueueTestMeta(map[UniqueID]UniqueID{1: 1})
+	inner := newCapacityCompactionHandler(1)
+	inner.err = errors.New("mock error")
+	h := newFairQueueCompactionHandler(inner, m)
+
+	err := h.execCompactionPlan(&compactionSignal{id: 100}, newFairQueueTestPlan(1, 1))
+	assert.Nil(t, err)
+	assert.False(t, m.GetSegment(1).isCompacting)
+	assert.Nil(t, h.getCompaction(1))
+}
+
+func TestFairQueueCompactionHandler_Cancel(t *testing.T) {
+	m := newFairQueueTestMeta(map[UniqueID]UniqueID{1: 1, 2: 1, 3: 2, 4: 3})
+	inner := newCapacityCompactionHandler(1)
+	h := newFairQueueCompactionHandler(inner, m)
+
+	signal := &compactionSignal{id: 100}
+	for i := int64(1); i <= 4; i++ {
+		assert.Nil(t, h.execCompactionPlan(signal, newFairQueueTestPlan(i, i)))
+	}
+
+	// queued plan is removed from its queue
+	task, err := h.cancelCompaction(3)
+	assert.Nil(t, err)
+	assert.Equal(t, cancelled, task.state)
+	assert.False(t, m.GetSegment(3).isCompacting)
+	assert.Nil(t, h.getCompaction(3))
+
+	// cancelling the dispatched plan frees a slot for the next queued one
+	task, err = h.cancelCompaction(1)
+	assert.Nil(t, err)
+	assert.Equal(t, cancelled, task.state)
+	assert.Equal(t, []int64{1, 2}, inner.dispatched)
+
+	for planID := range inner.executing {
+		assert.Nil(t, h.completeCompaction(&datapb.CompactionResult{PlanID: planID}))
+	}
+	assert.Equal(t, []int64{1, 2, 4}, inner.dispatched)
+	assert.Equal(t, 0, len(h.getCompactionTasks()))
+
+	_, err = h.cancelCompaction(5)
+	assert.Error(t, err)
+}
+
+func TestFairQueueCompactionHandler_Fairness(t *testing.T) {
+	h := newFairQueueCompactionHandler(newCapacityCompactionHandler(0), newFairQueueTestMeta(nil))
+	now := time.Now()
+	assert.Equal(t, 1.0, h.fairness(now))
+
+	h.keys = []compactionPartitionKey{{partitionID: 1}, {partitionID: 2}}
+	h.queues[h.keys[0]] = []*queuedCompactionPlan{{enqueueTime: now.Add(-4 * time.Second)}}
+	h.queues[h.keys[1]] = []*queuedCompactionPlan{{enqueueTime: now.Add(-time.Second)}}
+	assert.Equal(t, 4.0, h.fairness(now))
+}
+
+// BenchmarkFairQueueCompactionHandler compares the max wait of quiet partitions, counted by plans dispatched
+// before them, when they share the compaction queue with a hot partition
+func BenchmarkFairQueueCompactionHandler(b *testing.B) {
+	const (
+		hotPlanNum        = 100
+		quietPartitionNum = 10
+	)
+	segmentPartitions := make(map[UniqueID]UniqueID)
+	for i := 1; i <= hotPlanNum; i++ {
+		segmentPartitions[UniqueID(i)] = 1
+	}
+	for i := 1; i <= quietPartitionNum; i++ {
+		segmentPartitions[UniqueID(hotPlanNum+i)] = UniqueID(1 + i)
+	}
+
+	run := func(b *testing.B, fair bool) {
+		var maxWait int
+		for n := 0; n < b.N; n++ {
+			m := newFairQueueTestMeta(segmentPartitions)
+			if !fair {
+				// plans of segments not found in meta are put in the same queue of the signal's partition
+				m = newFairQueueTestMeta(nil)
+			}
+			inner := newCapacityCompactionHandler(1)
+			h := newFairQueueCompactionHandler(inner, m)
+			for i := 1; i <= hotPlanNum+quietPartitionNum; i++ {
+				_ = h.execCompactionPlan(&compactionSignal{}, newFairQueueTestPlan(int64(i), UniqueID(i)))
+			}
+			for len(inner.executing) > 0 {
+				for planID := range inner.executing {
+					_ = h.completeCompaction(&datapb.CompactionResult{PlanID: planID})
+				}
+			}
+			// all plans are enqueued at the beginning, so the wait of a plan is its dispatch position
+			for pos, planID := range inner.dispatched {
+				if segmentPartitions[planID] != 1 && pos > maxWait {
+					maxWait = pos
+				}
+			}
+		}
+		b.ReportMetric(float64(maxWait), "max-wait-plans")
+	}
+	b.Run("fifo", func(b *testing.B) { run(b, false) })
+	b.Run("fair", func(b *testing.B) { run(b, true) })
+}
@@ -0,0 +1,212 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// compactionHistoryPrefix is the prefix of compaction history records, which are keyed by collection id
+// and zero-padded plan id, so that the records of a collection are listed from the oldest plan
+const compactionHistoryPrefix = metaPrefix + "/compaction-history"
+
+// maxCompactionHistoryRemovedPerTxn limits the records removed in an etcd txn, since etcd limits the operations of a txn
+const maxCompactionHistoryRemovedPerTxn = 128
+
+// compactionHistoryRecord is the JSON record of a compaction plan completed
+type compactionHistoryRecord struct {
+	PlanID          int64   `json:"planID"`
+	CollectionID    int64   `json:"collectionID"`
+	Type            string  `json:"type"`
+	Channel         string  `json:"channel"`
+	SegmentIDs      []int64 `json:"segmentIDs"`
+	ResultSegmentID int64   `json:"resultSegmentID"`
+	NumOfRows       int64   `json:"numOfRows"`
+	State           string  `json:"state"`
+	CreateTime      int64   `json:"createTime"` // milliseconds
+	EndTime         int64   `json:"endTime"`    // milliseconds
+	BytesIn         int64   `json:"bytesIn"`    // estimated size of the input segments
+	BytesOut        int64   `json:"bytesOut"`   // estimated size of the output segment
+}
+
+func compactionHistoryCollectionPrefix(collectionID UniqueID) string {
+	return fmt.Sprintf("%s/%d/", compactionHistoryPrefix, collectionID)
+}
+
+func compactionHistoryKey(collectionID, planID UniqueID) string {
+	return fmt.Sprintf("%s%020d", compactionHistoryCollectionPrefix(collectionID), planID)
+}
+
+// compactionHistory persists the compaction plans completed, and the compactionHistoryCleaner goroutine keeps
+// at most limit records per collection by removing the oldest ones after each completion
+type compactionHistory struct {
+	kv    kv.TxnKV
+	limit int64 // non-positive means unlimited
+	clean chan UniqueID
+	quit  chan struct{}
+	wg    sync.WaitGroup
+}
+
+func newCompactionHistory(kv kv.TxnKV, limit int64) *compactionHistory {
+	return &compactionHistory{
+		kv:    kv,
+		limit: limit,
+		clean: make(chan UniqueID, maxParallelCompactionTaskNum),
+		quit:  make(chan struct{}),
+	}
+}
+
+func (h *compactionHistory) start() {
+	h.wg.Add(1)
+	go h.compactionHistoryCleaner()
+}
+
+func (h *compactionHistory) stop() {
+	close(h.quit)
+	h.wg.Wait()
+}
+
+// record saves the record of a completed or failed compaction, and notifies the cleaner of the collection
+func (h *compactionHistory) record(collectionID UniqueID, task *compactionTask) {
+	record := &compactionHistoryRecord{
+		PlanID:          task.plan.GetPlanID(),
+		CollectionID:    collectionID,
+		Type:            task.plan.GetType().String(),
+		Channel:         task.plan.GetChannel(),
+		ResultSegmentID: task.result.GetSegmentID(),
+		NumOfRows:       task.result.GetNumOfRows(),
+		State:           task.state.String(),
+		CreateTime:      task.createTime.UnixNano() / int64(time.Millisecond),
+		EndTime:         task.endTime.UnixNano() / int64(time.Millisecond),
+		BytesIn:         task.bytesIn,
+		BytesOut:        task.bytesOut,
+	}
+	for _, segment := range task.plan.GetSegmentBinlogs() {
+		record.SegmentIDs = append(record.SegmentIDs, segment.GetSegmentID())
+	}
+	value, err := json.Marshal(record)
+	if err == nil {
+		err = h.kv.Save(compactionHistoryKey(collectionID, record.PlanID), string(value))
+	}
+	if err != nil {
+		log.Warn("failed to save compaction history", zap.Int64("planID", record.PlanID), zap.Error(err))
+		return
+	}
+
+	// the collection is cleaned by its next completion if the cleaner is busy
+	select {
+	case h.clean <- collectionID:
+	default:
+	}
+}
+
+// load returns the records of the collection, or of all collections if collectionID is 0
+func (h *compactionHistory) load(collectionID UniqueID) ([]*compactionHistoryRecord, error) {
+	prefix := compactionHistoryPrefix + "/"
+	if collectionID != 0 {
+		prefix = compactionHistoryCollectionPrefix(collectionID)
+	}
+	keys, values, err := h.kv.LoadWithPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*compactionHistoryRecord, 0, len(values))
+	for i, value := range values {
+		record := &compactionHistoryRecord{}
+		if err := json.Unmarshal([]byte(value), record); err != nil {
+			log.Warn("invalid compaction history record", zap.String("key", keys[i]), zap.Error(err))
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// compactionHistoryCleaner removes the oldest records of collections exceeding the limit
+func (h *compactionHistory) compactionHistoryCleaner() {
+	defer h.wg.Done()
+	for {
+		select {
+		case <-h.quit:
+			log.Info("compaction history cleaner quit")
+			return
+		case collectionID := <-h.clean:
+			if err := h.cleanCollection(collectionID); err != nil {
+				log.Warn("failed to clean compaction history", zap.Int64("collectionID", collectionID), zap.Error(err))
+			}
+		}
+	}
+}
+
+// cleanCollection removes the oldest records of the collection exceeding the limit, and updates the history size metric
+func (h *compactionHistory) cleanCollection(collectionID UniqueID) error {
+	keys, _, err := h.kv.LoadWithPrefix(compactionHistoryCollectionPrefix(collectionID))
+	if err != nil {
+		return err
+	}
+	size := int64(len(keys))
+	label := strconv.FormatInt(collectionID, 10)
+	defer func() { metrics.DataCoordCompactionHistorySize.WithLabelValues(label).Set(float64(size)) }()
+	if h.limit <= 0 || size <= h.limit {
+		return nil
+	}
+
+	// keys loaded may contain the root path of kv, so keys removed are rebuilt from the plan ids
+	planIDs := make([]UniqueID, 0, len(keys))
+	for _, key := range keys {
+		planID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			log.Warn("invalid compaction history key", zap.String("key", key))
+			continue
+		}
+		planIDs = append(planIDs, planID)
+	}
+	sort.Slice(planIDs, func(i, j int) bool { return planIDs[i] < planIDs[j] })
+	if int64(len(planIDs)) <= h.limit {
+		return nil
+	}
+
+	removals := planIDs[:int64(len(planIDs))-h.limit]
+	for len(removals) > 0 {
+		n := len(removals)
+		if n > maxCompactionHistoryRemovedPerTxn {
+			n = maxCompactionHistoryRemovedPerTxn
+		}
+		batch := make([]string, 0, n)
+		for _, planID := range removals[:n] {
+			batch = append(batch, compactionHistoryKey(collectionID, planID))
+		}
+		if err := h.kv.MultiRemove(batch); err != nil {
+			return err
+		}
+		size -= int64(n)
+		removals = removals[n:]
+	}
+	log.Info("compaction history cleaned", zap.Int64("collectionID", collectionID), zap.Int64("size", size))
+	return nil
+}
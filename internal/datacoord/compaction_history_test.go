@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCompactionHistoryTask(planID int64) *compactionTask {
+	return &compactionTask{
+		plan: &datapb.CompactionPlan{
+			PlanID:         planID,
+			Type:           datapb.CompactionType_MergeCompaction,
+			Channel:        "ch1",
+			SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 2}},
+		},
+		result:     &datapb.CompactionResult{PlanID: planID, SegmentID: 3, NumOfRows: 100},
+		state:      completed,
+		createTime: time.Now(),
+		endTime:    time.Now(),
+		bytesIn:    2000,
+		bytesOut:   800,
+	}
+}
+
+func TestCompactionHistory(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	h := newCompactionHistory(kv, 3)
+	// plan ids of different digits are ordered by the zero-padded keys
+	for _, planID := range []int64{9, 10, 100, 8, 11} {
+		h.record(1, newTestCompactionHistoryTask(planID))
+	}
+	h.record(2, newTestCompactionHistoryTask(1))
+	assert.Equal(t, 6, len(h.clean))
+
+	value, err := kv.Load(compactionHistoryKey(1, 100))
+	require.NoError(t, err)
+	record := &compactionHistoryRecord{}
+	require.NoError(t, json.Unmarshal([]byte(value), record))
+	assert.Equal(t, int64(100), record.PlanID)
+	assert.Equal(t, int64(1), record.CollectionID)
+	assert.Equal(t, "MergeCompaction", record.Type)
+	assert.Equal(t, []int64{1, 2}, record.SegmentIDs)
+	assert.Equal(t, int64(3), record.ResultSegmentID)
+	assert.Equal(t, "completed", record.State)
+	assert.Equal(t, int64(2000), record.BytesIn)
+	assert.Equal(t, int64(800), record.BytesOut)
+
+	records, err := h.load(1)
+	require.NoError(t, err)
+	assert.Equal(t, 5, len(records))
+	records, err = h.load(0)
+	require.NoError(t, err)
+	assert.Equal(t, 6, len(records))
+
+	require.NoError(t, h.cleanCollection(1))
+	keys, _, err := kv.LoadWithPrefix(compactionHistoryCollectionPrefix(1))
+	require.NoError(t, err)
+	assert.Equal(t, []string{compactionHistoryKey(1, 10), compactionHistoryKey(1, 11), compactionHistoryKey(1, 100)}, keys)
+	assert.Equal(t, float64(3), testutil.ToFloat64(metrics.DataCoordCompactionHistorySize.WithLabelValues("1")))
+
+	// other collections are not affected
+	require.NoError(t, h.cleanCollection(2))
+	keys, _, err = kv.LoadWithPrefix(compactionHistoryCollectionPrefix(2))
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(keys))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.DataCoordCompactionHistorySize.WithLabelValues("2")))
+
+	t.Run("removed in batches", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		h := newCompactionHistory(kv, 1)
+		for planID := int64(1); planID <= 2*maxCompactionHistoryRemovedPerTxn+2; planID++ {
+			h.record(3, newTestCompactionHistoryTask(planID))
+		}
+		require.NoError(t, h.cleanCollection(3))
+		keys, _, err := kv.LoadWithPrefix(compactionHistoryCollectionPrefix(3))
+		require.NoError(t, err)
+		assert.Equal(t, []string{compactionHistoryKey(3, 2*maxCompactionHistoryRemovedPerTxn+2)}, keys)
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		h := newCompactionHistory(kv, 0)
+		for planID := int64(1); planID <= 5; planID++ {
+			h.record(4, newTestCompactionHistoryTask(planID))
+		}
+		require.NoError(t, h.cleanCollection(4))
+		keys, _, err := kv.LoadWithPrefix(compactionHistoryCollectionPrefix(4))
+		require.NoError(t, err)
+		assert.Equal(t, 5, len(keys))
+	})
+}
+
+func TestCompactionHistoryCleaner(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	h := newCompactionHistory(kv, 2)
+	h.start()
+	defer h.stop()
+
+	c := &compactionPlanHandler{
+		plans:   map[int64]*compactionTask{},
+		meta:    &meta{client: memkv.NewMemoryKV(), segments: NewSegmentsInfo()},
+		flushCh: make(chan UniqueID, 10),
+		history: h,
+	}
+	for planID := int64(1); planID <= 4; planID++ {
+		c.plans[planID] = &compactionTask{
+			triggerInfo: &compactionSignal{collectionID: 5},
+			state:       executing,
+			plan:        &datapb.CompactionPlan{PlanID: planID, Type: datapb.CompactionType_UndefinedCompaction},
+		}
+		c.executingTaskNum++
+		// compaction of unknown type fails, which is recorded as well
+		assert.Error(t, c.completeCompaction(&datapb.CompactionResult{PlanID: planID}))
+	}
+
+	assert.Eventually(t, func() bool {
+		keys, _, err := kv.LoadWithPrefix(compactionHistoryCollectionPrefix(5))
+		return err == nil && len(keys) == 2 && keys[0] == compactionHistoryKey(5, 3)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	value, err := kv.Load(compactionHistoryKey(5, 4))
+	require.NoError(t, err)
+	record := &compactionHistoryRecord{}
+	require.NoError(t, json.Unmarshal([]byte(value), record))
+	assert.Equal(t, "failed", record.State)
+	assert.Equal(t, int64(5), record.CollectionID)
+}
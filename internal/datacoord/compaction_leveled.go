@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"go.uber.org/zap"
+)
+
+// names of merge compaction policies
+const (
+	greedyCompactionPolicyName  = "greedy"
+	leveledCompactionPolicyName = "leveled"
+)
+
+// levels of segments in leveled compaction
+const (
+	compactionL0 = iota // flushed segments never compacted
+	compactionL1
+	compactionL2
+	compactionL3 // segments as large as the max row number, never merged again
+	compactionLevelNum
+)
+
+// newMergeCompactionPolicy returns the merge compaction policy of the name, the greedy policy if the name is unknown
+func newMergeCompactionPolicy(name string) mergeCompactionPolicy {
+	switch name {
+	case leveledCompactionPolicyName:
+		return newLeveledCompactionScheduler(Params.CompactionLevelMultiplier)
+	case greedyCompactionPolicyName, "":
+	default:
+		log.Warn("unknown merge compaction policy, use greedy policy", zap.String("policy", name))
+	}
+	return (mergeCompactionFunc)(greedyMergeCompaction)
+}
+
+// LeveledCompactionScheduler is a merge compaction policy assigning segments to levels L0 to L3 like the leveled
+// compaction of RocksDB. Flushed segments never compacted are in L0, compacted segments are in the lowest level
+// whose target size holds their rows, where the target size of L3 is the max row number of segments and each
+// level above is multiplier times smaller. A level overflows once it holds multiplier segments, then its segments
+// are merged down into segments of the next level. Rows are rewritten once per level rather than every time small
+// segments are merged, which bounds the write amplification by the number of levels
+type LeveledCompactionScheduler struct {
+	multiplier int64
+}
+
+var _ mergeCompactionPolicy = (*LeveledCompactionScheduler)(nil)
+
+func newLeveledCompactionScheduler(multiplier int64) *LeveledCompactionScheduler {
+	if multiplier < 2 {
+		multiplier = 2
+	}
+	return &LeveledCompactionScheduler{multiplier: multiplier}
+}
+
+// targetRows returns the target number of rows of segments of each level
+func (s *LeveledCompactionScheduler) targetRows(maxRowNum int64) [compactionLevelNum]int64 {
+	var targets [compactionLevelNum]int64
+	targets[compactionL3] = maxRowNum
+	for level := compactionL2; level >= compactionL0; level-- {
+		targets[level] = targets[level+1] / s.multiplier
+	}
+	return targets
+}
+
+// level returns the level of the segment
+func (s *LeveledCompactionScheduler) level(segment *SegmentInfo, targets [compactionLevelNum]int64) int {
+	if len(segment.GetCompactionFrom()) == 0 {
+		return compactionL0
+	}
+	for level := compactionL1; level < compactionL3; level++ {
+		if segment.GetNumOfRows() <= targets[level] {
+			return level
+		}
+	}
+	return compactionL3
+}
+
+// levels assigns the segments to levels, segments of each level are sorted from the oldest
+func (s *LeveledCompactionScheduler) levels(segments []*SegmentInfo, targets [compactionLevelNum]int64) [compactionLevelNum][]*SegmentInfo {
+	var levels [compactionLevelNum][]*SegmentInfo
+	for _, segment := range segments {
+		level := s.level(segment, targets)
+		levels[level] = append(levels[level], segment)
+	}
+	for _, segs := range levels {
+		sort.Slice(segs, func(i, j int) bool { return segs[i].GetID() < segs[j].GetID() })
+	}
+	return levels
+}
+
+// generatePlan merges the segments of each overflowing level into segments of the next level, at most multiplier
+// segments are merged by a plan and the rows merged are no more than the target size of the next level
+func (s *LeveledCompactionScheduler) generatePlan(segments []*SegmentInfo, timetravel *timetravel) []*datapb.CompactionPlan {
+	if len(segments) == 0 {
+		return nil
+	}
+	targets := s.targetRows(segments[0].GetMaxRowNum())
+	levels := s.levels(segments, targets)
+
+	var plans []*datapb.CompactionPlan
+	for level := compactionL0; level < compactionL3; level++ {
+		segs := levels[level]
+		for len(segs) >= int(s.multiplier) {
+			var group []*SegmentInfo
+			var rows int64
+			for len(segs) > 0 && len(group) < int(s.multiplier) {
+				if len(group) > 0 && rows+segs[0].GetNumOfRows() > targets[level+1] {
+					break
+				}
+				rows += segs[0].GetNumOfRows()
+				group = append(group, segs[0])
+				segs = segs[1:]
+			}
+			if len(group) < 2 {
+				continue
+			}
+			plans = append(plans, s.newPlan(group, timetravel))
+		}
+	}
+	return plans
+}
+
+func (s *LeveledCompactionScheduler) newPlan(segments []*SegmentInfo, timetravel *timetravel) *datapb.CompactionPlan {
+	plan := &datapb.CompactionPlan{
+		Timetravel: timetravel.time,
+		Type:       datapb.CompactionType_MergeCompaction,
+		Channel:    segments[0].GetInsertChannel(),
+	}
+	for _, segment := range segments {
+		plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:           segment.GetID(),
+			FieldBinlogs:        segment.GetBinlogs(),
+			Field2StatslogPaths: segment.GetStatslogs(),
+			Deltalogs:           segment.GetDeltalogs(),
+		})
+	}
+	return plan
+}
@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLeveledTestSegment(id UniqueID, rows int64, compactionFrom ...UniqueID) *SegmentInfo {
+	return NewSegmentInfo(&datapb.SegmentInfo{
+		ID:             id,
+		NumOfRows:      rows,
+		MaxRowNum:      6400,
+		State:          commonpb.SegmentState_Flushed,
+		InsertChannel:  "ch1",
+		CompactionFrom: compactionFrom,
+	})
+}
+
+func getPlanSegmentIDs(plan *datapb.CompactionPlan) []UniqueID {
+	ids := make([]UniqueID, 0, len(plan.GetSegmentBinlogs()))
+	for _, binlogs := range plan.GetSegmentBinlogs() {
+		ids = append(ids, binlogs.GetSegmentID())
+	}
+	return ids
+}
+
+func TestLeveledCompactionScheduler(t *testing.T) {
+	s := newLeveledCompactionScheduler(4)
+	targets := s.targetRows(6400)
+	assert.Equal(t, [compactionLevelNum]int64{100, 400, 1600, 6400}, targets)
+	assert.Equal(t, compactionL0, s.level(newLeveledTestSegment(1, 1000), targets))
+	assert.Equal(t, compactionL1, s.level(newLeveledTestSegment(1, 400, 0), targets))
+	assert.Equal(t, compactionL2, s.level(newLeveledTestSegment(1, 401, 0), targets))
+	assert.Equal(t, compactionL3, s.level(newLeveledTestSegment(1, 1601, 0), targets))
+
+	t.Run("no level overflows", func(t *testing.T) {
+		segments := []*SegmentInfo{
+			newLeveledTestSegment(1, 100), newLeveledTestSegment(2, 100), newLeveledTestSegment(3, 100),
+			newLeveledTestSegment(4, 400, 0), newLeveledTestSegment(5, 6400, 0),
+		}
+		assert.Empty(t, s.generatePlan(segments, &timetravel{}))
+		assert.Empty(t, s.generatePlan(nil, &timetravel{}))
+	})
+
+	t.Run("merge into next level", func(t *testing.T) {
+		segments := []*SegmentInfo{
+			// L0, segment 4 doesn't fit in a L1 segment with 1, 2 and 3
+			newLeveledTestSegment(9, 100), newLeveledTestSegment(1, 100), newLeveledTestSegment(2, 100),
+			newLeveledTestSegment(3, 100), newLeveledTestSegment(4, 300), newLeveledTestSegment(5, 300),
+			// L1
+			newLeveledTestSegment(11, 400, 0), newLeveledTestSegment(12, 400, 0), newLeveledTestSegment(13, 400, 0),
+			newLeveledTestSegment(14, 400, 0), newLeveledTestSegment(15, 400, 0),
+			// L3
+			newLeveledTestSegment(31, 6400, 0), newLeveledTestSegment(32, 6400, 0), newLeveledTestSegment(33, 6400, 0),
+			newLeveledTestSegment(34, 6400, 0),
+		}
+		plans := s.generatePlan(segments, &timetravel{time: 100})
+		require.Len(t, plans, 2)
+		assert.Equal(t, []UniqueID{1, 2, 3}, getPlanSegmentIDs(plans[0]))
+		assert.Equal(t, []UniqueID{11, 12, 13, 14}, getPlanSegmentIDs(plans[1]))
+		assert.Equal(t, datapb.CompactionType_MergeCompaction, plans[0].GetType())
+		assert.Equal(t, Timestamp(100), plans[0].GetTimetravel())
+		assert.Equal(t, "ch1", plans[0].GetChannel())
+	})
+}
+
+func TestNewMergeCompactionPolicy(t *testing.T) {
+	_, ok := newMergeCompactionPolicy(leveledCompactionPolicyName).(*LeveledCompactionScheduler)
+	assert.True(t, ok)
+	_, ok = newMergeCompactionPolicy(greedyCompactionPolicyName).(mergeCompactionFunc)
+	assert.True(t, ok)
+	_, ok = newMergeCompactionPolicy("unknown").(mergeCompactionFunc)
+	assert.True(t, ok)
+}
+
+// simulateWriteAmplification flushes segments of flushRows rows into a partition, the merge compaction is triggered
+// after each flush like compactionTrigger does, and the plans are completed at once. Returns the rows written by
+// flushes and compactions over the rows flushed
+func simulateWriteAmplification(policy mergeCompactionPolicy, flushRows int64, flushes int) float64 {
+	var segments []*SegmentInfo
+	var nextID UniqueID
+	var written int64
+	for i := 0; i < flushes; i++ {
+		nextID++
+		segments = append(segments, newLeveledTestSegment(nextID, flushRows))
+		written += flushRows
+		if countLittleSegments(segments) < maxLittleSegmentNum {
+			continue
+		}
+
+		for _, plan := range policy.generatePlan(append([]*SegmentInfo{}, segments...), &timetravel{}) {
+			merged := make(map[UniqueID]int64)
+			for _, binlogs := range plan.GetSegmentBinlogs() {
+				merged[binlogs.GetSegmentID()] = 0
+			}
+			var rows int64
+			left := segments[:0]
+			for _, segment := range segments {
+				if _, ok := merged[segment.GetID()]; ok {
+					rows += segment.GetNumOfRows()
+					continue
+				}
+				left = append(left, segment)
+			}
+			nextID++
+			segments = append(left, newLeveledTestSegment(nextID, rows, getPlanSegmentIDs(plan)...))
+			written += rows
+		}
+	}
+	return float64(written) / float64(flushRows*int64(flushes))
+}
+
+func TestCompactionWriteAmplification(t *testing.T) {
+	greedy := simulateWriteAmplification((mergeCompactionFunc)(greedyMergeCompaction), 100, 1000)
+	leveled := simulateWriteAmplification(newLeveledCompactionScheduler(4), 100, 1000)
+	t.Logf("write amplification, greedy: %.2f, leveled: %.2f", greedy, leveled)
+	// rows are rewritten at most once per level
+	assert.LessOrEqual(t, leveled, float64(compactionLevelNum))
+	assert.Less(t, leveled, greedy)
+}
@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// computeCompactionROI aggregates the completed plans in the records ended in [startTime, endTime),
+// 0 means the bound is unbounded
+func computeCompactionROI(records []*compactionHistoryRecord, startTime, endTime int64) *datapb.GetCompactionROIResponse {
+	resp := &datapb.GetCompactionROIResponse{}
+	var totalDuration int64
+	for _, record := range records {
+		if record.State != completed.String() ||
+			(startTime != 0 && record.EndTime < startTime) || (endTime != 0 && record.EndTime >= endTime) {
+			continue
+		}
+		resp.TotalBytesIn += record.BytesIn
+		resp.TotalBytesOut += record.BytesOut
+		resp.PlanCount++
+		totalDuration += record.EndTime - record.CreateTime
+	}
+	if resp.GetTotalBytesOut() > 0 {
+		resp.RoiRatio = float64(resp.GetTotalBytesIn()) / float64(resp.GetTotalBytesOut())
+	}
+	if resp.GetPlanCount() > 0 {
+		resp.AverageDuration = totalDuration / resp.GetPlanCount()
+	}
+	return resp
+}
+
+type compactionROICacheKey struct {
+	collectionID UniqueID
+	startTime    int64
+	endTime      int64
+}
+
+type compactionROICacheEntry struct {
+	resp     *datapb.GetCompactionROIResponse
+	expireAt time.Time
+}
+
+// compactionROICache keeps the compaction ROI for a ttl, so that frequent queries don't load all history records
+type compactionROICache struct {
+	mu      sync.Mutex
+	entries map[compactionROICacheKey]*compactionROICacheEntry
+}
+
+func newCompactionROICache() *compactionROICache {
+	return &compactionROICache{
+		entries: make(map[compactionROICacheKey]*compactionROICacheEntry),
+	}
+}
+
+// get returns the cached ROI of the collection and time range, nil if not cached or expired
+func (c *compactionROICache) get(collectionID UniqueID, startTime, endTime int64, now time.Time) *datapb.GetCompactionROIResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[compactionROICacheKey{collectionID, startTime, endTime}]
+	if !ok || !now.Before(entry.expireAt) {
+		return nil
+	}
+	return entry.resp
+}
+
+// put caches the ROI for ttl, expired entries are evicted meanwhile
+func (c *compactionROICache) put(collectionID UniqueID, startTime, endTime int64, resp *datapb.GetCompactionROIResponse,
+	now time.Time, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		if !now.Before(entry.expireAt) {
+			delete(c.entries, key)
+		}
+	}
+	if ttl <= 0 {
+		return
+	}
+	c.entries[compactionROICacheKey{collectionID, startTime, endTime}] = &compactionROICacheEntry{resp: resp, expireAt: now.Add(ttl)}
+}
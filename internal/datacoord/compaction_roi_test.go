@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeCompactionROI(t *testing.T) {
+	records := []*compactionHistoryRecord{
+		{PlanID: 1, State: "completed", CreateTime: 1000, EndTime: 2000, BytesIn: 300, BytesOut: 100},
+		{PlanID: 2, State: "completed", CreateTime: 2000, EndTime: 5000, BytesIn: 100, BytesOut: 100},
+		// failed plans are not counted
+		{PlanID: 3, State: "failed", CreateTime: 2000, EndTime: 3000, BytesIn: 100},
+		{PlanID: 4, State: "completed", CreateTime: 8000, EndTime: 10000, BytesIn: 100, BytesOut: 200},
+	}
+
+	resp := computeCompactionROI(records, 0, 0)
+	assert.Equal(t, int64(500), resp.GetTotalBytesIn())
+	assert.Equal(t, int64(400), resp.GetTotalBytesOut())
+	assert.Equal(t, 1.25, resp.GetRoiRatio())
+	assert.Equal(t, int64(3), resp.GetPlanCount())
+	assert.Equal(t, int64(2000), resp.GetAverageDuration())
+
+	// plans ended in [2000, 10000)
+	resp = computeCompactionROI(records, 2000, 10000)
+	assert.Equal(t, int64(2), resp.GetPlanCount())
+	assert.Equal(t, 2.0, resp.GetRoiRatio())
+	assert.Equal(t, int64(2000), resp.GetAverageDuration())
+
+	resp = computeCompactionROI(records, 10001, 0)
+	assert.Equal(t, int64(0), resp.GetPlanCount())
+	assert.Equal(t, 0.0, resp.GetRoiRatio())
+	assert.Equal(t, int64(0), resp.GetAverageDuration())
+}
+
+func TestCompactionROICache(t *testing.T) {
+	c := newCompactionROICache()
+	now := time.Now()
+	resp := &datapb.GetCompactionROIResponse{PlanCount: 1}
+	assert.Nil(t, c.get(0, 0, 0, now))
+
+	c.put(0, 0, 0, resp, now, time.Minute)
+	assert.Same(t, resp, c.get(0, 0, 0, now.Add(time.Second)))
+	assert.Nil(t, c.get(1, 0, 0, now))
+	assert.Nil(t, c.get(0, 1, 0, now))
+	assert.Nil(t, c.get(0, 0, 0, now.Add(time.Minute)))
+
+	// expired entries are evicted
+	c.put(1, 0, 0, &datapb.GetCompactionROIResponse{}, now.Add(2*time.Minute), time.Minute)
+	assert.Equal(t, 1, len(c.entries))
+
+	// not cached without ttl
+	c.put(2, 0, 0, &datapb.GetCompactionROIResponse{}, now, 0)
+	assert.Nil(t, c.get(2, 0, 0, now))
+}
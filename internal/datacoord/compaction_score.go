@@ -0,0 +1,235 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// defaultChannelStatsTopN is the number of eligible segments returned by GetChannelSegmentStats by default
+const defaultChannelStatsTopN = 10
+
+// getScoreCards runs the segment through the scoring step of every compaction policy
+func (t *compactionTrigger) getScoreCards(segment *SegmentInfo, timetravel *timetravel) []*datapb.CompactionScoreCard {
+	return []*datapb.CompactionScoreCard{
+		t.scoreSingleCompaction(segment, timetravel),
+		t.scoreMergeCompaction(segment),
+	}
+}
+
+// checkCompactable returns the reason why the segment can not be compacted by any policy,
+// an empty string means the segment is compactable
+func checkCompactable(segment *SegmentInfo) string {
+	switch {
+	case !isSegmentHealthy(segment):
+		return fmt.Sprintf("segment is %s", segment.GetState().String())
+	case segment.GetState() != commonpb.SegmentState_Flushed:
+		return fmt.Sprintf("segment is %s, only flushed segment can be compacted", segment.GetState().String())
+	case segment.isCompacting:
+		return "segment is in an executing compaction plan"
+	}
+	return ""
+}
+
+// scoreSingleCompaction scores the segment with the delete ratio and delta log size policy,
+// the segment is eligible when score reaches 1
+func (t *compactionTrigger) scoreSingleCompaction(segment *SegmentInfo, timetravel *timetravel) *datapb.CompactionScoreCard {
+	card := &datapb.CompactionScoreCard{
+		PolicyName: datapb.CompactionType_InnerCompaction.String(),
+	}
+	if reason := checkCompactable(segment); reason != "" {
+		card.Reason = reason
+		return card
+	}
+	// single compaction only merge insert and delta log beyond the timetravel
+	// segment's insert binlogs dont have time range info, so we wait until the segment's last expire time is less than timetravel
+	// to ensure that all insert logs is beyond the timetravel.
+	if segment.LastExpireTime >= timetravel.time {
+		card.Reason = fmt.Sprintf("segment last expire time %d is not before timetravel %d", segment.LastExpireTime, timetravel.time)
+		return card
+	}
+
+	totalDeletedRows, totalDeleteLogSize := sumDeltalogs(segment, timetravel)
+
+	var deleteRatio float64
+	if segment.GetNumOfRows() > 0 {
+		deleteRatio = float64(totalDeletedRows) / float64(segment.GetNumOfRows())
+	}
+	card.Score = deleteRatio / singleCompactionRatioThreshold
+	if sizeScore := float64(totalDeleteLogSize) / singleCompactionDeltaLogMaxSize; sizeScore > card.Score {
+		card.Score = sizeScore
+	}
+
+	// currently delta log size and delete ratio policy is applied
+	card.Eligible = float32(deleteRatio) >= singleCompactionRatioThreshold || totalDeleteLogSize > singleCompactionDeltaLogMaxSize
+	card.Reason = fmt.Sprintf("deleted rows ratio %.4f (threshold %.2f), delta log size %d bytes (threshold %d bytes)",
+		deleteRatio, singleCompactionRatioThreshold, totalDeleteLogSize, singleCompactionDeltaLogMaxSize)
+	return card
+}
+
+// scoreMergeCompaction scores the segment with the little segments number of its channel and partition,
+// the segment is eligible when score reaches 1. the score is raised by the overlap of primary key ranges
+// between the candidate segments, if their ranges are known from ComputeSegmentOverlap
+func (t *compactionTrigger) scoreMergeCompaction(segment *SegmentInfo) *datapb.CompactionScoreCard {
+	card := &datapb.CompactionScoreCard{
+		PolicyName: datapb.CompactionType_MergeCompaction.String(),
+	}
+	if reason := checkCompactable(segment); reason != "" {
+		card.Reason = reason
+		return card
+	}
+	if segment.GetPinned() {
+		card.Reason = "segment is pinned"
+		return card
+	}
+
+	candidates := t.getCandidateSegments(segment.GetInsertChannel(), segment.GetPartitionID())
+	littleSegmentNum := countLittleSegments(candidates)
+	card.Score = float64(littleSegmentNum) / float64(t.mergeCompactionSegmentThreshold)
+	card.Eligible = t.shouldDoMergeCompaction(candidates)
+	card.Reason = fmt.Sprintf("%d of %d candidate segments in channel %s partition %d are less than half full (threshold %d)",
+		littleSegmentNum, len(candidates), segment.GetInsertChannel(), segment.GetPartitionID(), t.mergeCompactionSegmentThreshold)
+	if overlap, n := t.candidatesOverlap(candidates); n > 1 {
+		if overlapScore := overlap / overlapMergeThreshold; overlapScore > card.Score {
+			card.Score = overlapScore
+		}
+		card.Reason += fmt.Sprintf(", primary key ranges of %d candidate segments overlap by %.4f (threshold %.2f)",
+			n, overlap, overlapMergeThreshold)
+	}
+	return card
+}
+
+// candidatesOverlap returns the mean pairwise overlap of primary key ranges between the candidates with known ranges,
+// and the number of such candidates
+func (t *compactionTrigger) candidatesOverlap(candidates []*SegmentInfo) (float64, int) {
+	ranges := make(map[UniqueID]pkRange)
+	segmentIDs := make([]UniqueID, 0, len(candidates))
+	for _, candidate := range candidates {
+		if r, ok := t.pkRanges.get(candidate.GetID()); ok {
+			ranges[candidate.GetID()] = r
+			segmentIDs = append(segmentIDs, candidate.GetID())
+		}
+	}
+	return meanOverlap(segmentIDs, ranges), len(segmentIDs)
+}
+
+// countLittleSegments returns the number of segments whose rows are less than half of max row num
+func countLittleSegments(segments []*SegmentInfo) int {
+	littleSegmentNum := 0
+	for _, s := range segments {
+		if s.GetNumOfRows() < s.GetMaxRowNum()/2 {
+			littleSegmentNum++
+		}
+	}
+	return littleSegmentNum
+}
+
+// sumDeltalogs returns the deleted rows and delta log size of the segment before timetravel
+func sumDeltalogs(segment *SegmentInfo, timetravel *timetravel) (int, int64) {
+	totalDeletedRows := 0
+	totalDeleteLogSize := int64(0)
+	for _, l := range segment.GetDeltalogs() {
+		if l.TimestampTo < timetravel.time {
+			totalDeletedRows += int(l.GetRecordEntries())
+			totalDeleteLogSize += l.GetDeltaLogSize()
+		}
+	}
+	return totalDeletedRows, totalDeleteLogSize
+}
+
+// getChannelSegmentStats summarizes the healthy segments of the channel for compaction scheduling, the eligible
+// segments are ranked by their highest score among policies and the first topN of them are returned
+func (t *compactionTrigger) getChannelSegmentStats(channel string, timetravel *timetravel, now time.Time, topN int) *datapb.GetChannelSegmentStatsResponse {
+	resp := &datapb.GetChannelSegmentStatsResponse{}
+	sizePerRow := make(map[UniqueID]int64)
+	var eligible []*datapb.SegmentCompactionScore
+	var oldest time.Time
+	for _, segment := range t.meta.GetSegmentsByChannel(channel) {
+		resp.TotalSegments++
+		if segment.GetState() == commonpb.SegmentState_Flushed {
+			resp.FlushedSegments++
+		}
+		resp.TotalRows += segment.GetNumOfRows()
+		size, ok := sizePerRow[segment.GetCollectionID()]
+		if !ok {
+			if s, err := typeutil.EstimateSizePerRecord(t.meta.GetCollection(segment.GetCollectionID()).GetSchema()); err == nil {
+				size = int64(s)
+			}
+			sizePerRow[segment.GetCollectionID()] = size
+		}
+		resp.TotalBytes += size * segment.GetNumOfRows()
+		for _, l := range segment.GetDeltalogs() {
+			resp.TotalBytes += l.GetDeltaLogSize()
+		}
+
+		var best *datapb.CompactionScoreCard
+		for _, card := range t.getScoreCards(segment, timetravel) {
+			if card.GetEligible() && (best == nil || card.GetScore() > best.GetScore()) {
+				best = card
+			}
+		}
+		if best == nil {
+			continue
+		}
+		eligible = append(eligible, &datapb.SegmentCompactionScore{
+			SegmentID:   segment.GetID(),
+			PartitionID: segment.GetPartitionID(),
+			NumRows:     segment.GetNumOfRows(),
+			Score:       best.GetScore(),
+			PolicyName:  best.GetPolicyName(),
+		})
+		if start, ok := segmentStartTime(segment); ok && (oldest.IsZero() || start.Before(oldest)) {
+			oldest = start
+		}
+	}
+
+	resp.CompactionEligibleCount = int64(len(eligible))
+	if !oldest.IsZero() && now.After(oldest) {
+		resp.OldestEligibleSegmentAge = int64(now.Sub(oldest) / time.Second)
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		if eligible[i].GetScore() != eligible[j].GetScore() {
+			return eligible[i].GetScore() > eligible[j].GetScore()
+		}
+		return eligible[i].GetSegmentID() < eligible[j].GetSegmentID()
+	})
+	if topN <= 0 {
+		topN = defaultChannelStatsTopN
+	}
+	if len(eligible) > topN {
+		eligible = eligible[:topN]
+	}
+	resp.TopNEligibleSegments = eligible
+	return resp
+}
+
+// segmentStartTime returns the time of the earliest data in the segment, false if unknown
+func segmentStartTime(segment *SegmentInfo) (time.Time, bool) {
+	ts := segment.GetStartPosition().GetTimestamp()
+	if ts == 0 {
+		return segmentEndTime(segment)
+	}
+	t, _ := tsoutil.ParseTS(ts)
+	return t, true
+}
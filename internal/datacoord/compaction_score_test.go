@@ -0,0 +1,203 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newScoreTestSegment(id UniqueID, state commonpb.SegmentState, numOfRows int64, deletedRows int64) *SegmentInfo {
+	return NewSegmentInfo(&datapb.SegmentInfo{
+		ID:             id,
+		CollectionID:   1,
+		PartitionID:    1,
+		InsertChannel:  "ch1",
+		State:          state,
+		LastExpireTime: 100,
+		NumOfRows:      numOfRows,
+		MaxRowNum:      300,
+		Deltalogs: []*datapb.DeltaLogInfo{
+			{RecordEntries: uint64(deletedRows), TimestampTo: 100, DeltaLogSize: 100},
+		},
+	})
+}
+
+func Test_compactionTrigger_getScoreCards(t *testing.T) {
+	segments := NewSegmentsInfo()
+	flushed := newScoreTestSegment(1, commonpb.SegmentState_Flushed, 100, 50)
+	dropped := newScoreTestSegment(2, commonpb.SegmentState_Dropped, 100, 50)
+	growing := newScoreTestSegment(3, commonpb.SegmentState_Growing, 100, 50)
+	compacting := newScoreTestSegment(4, commonpb.SegmentState_Flushed, 100, 50)
+	compacting.isCompacting = true
+	littleDeleted := newScoreTestSegment(5, commonpb.SegmentState_Flushed, 200, 1)
+	for _, s := range []*SegmentInfo{flushed, dropped, growing, compacting, littleDeleted} {
+		segments.SetSegment(s.GetID(), s)
+	}
+	trigger := &compactionTrigger{
+		meta:                            &meta{segments: segments},
+		mergeCompactionSegmentThreshold: 2,
+	}
+	tt := &timetravel{time: 200}
+
+	t.Run("test unhealthy segment", func(t *testing.T) {
+		cards := trigger.getScoreCards(dropped, tt)
+		assert.Equal(t, 2, len(cards))
+		for _, card := range cards {
+			assert.False(t, card.GetEligible())
+			assert.Equal(t, "segment is Dropped", card.GetReason())
+		}
+	})
+
+	t.Run("test growing segment", func(t *testing.T) {
+		cards := trigger.getScoreCards(growing, tt)
+		assert.Equal(t, 2, len(cards))
+		for _, card := range cards {
+			assert.False(t, card.GetEligible())
+			assert.Equal(t, "segment is Growing, only flushed segment can be compacted", card.GetReason())
+		}
+	})
+
+	t.Run("test compacting segment", func(t *testing.T) {
+		cards := trigger.getScoreCards(compacting, tt)
+		assert.Equal(t, 2, len(cards))
+		for _, card := range cards {
+			assert.False(t, card.GetEligible())
+			assert.Equal(t, "segment is in an executing compaction plan", card.GetReason())
+		}
+	})
+
+	t.Run("test eligible segment", func(t *testing.T) {
+		cards := trigger.getScoreCards(flushed, tt)
+		assert.Equal(t, 2, len(cards))
+
+		assert.Equal(t, datapb.CompactionType_InnerCompaction.String(), cards[0].GetPolicyName())
+		assert.True(t, cards[0].GetEligible())
+		assert.InDelta(t, 2.5, cards[0].GetScore(), 1e-6)
+		assert.Equal(t, trigger.shouldDoSingleCompaction(flushed, tt), cards[0].GetEligible())
+
+		// segment 1 and 5 are candidates, only segment 1 is less than half full
+		assert.Equal(t, datapb.CompactionType_MergeCompaction.String(), cards[1].GetPolicyName())
+		assert.False(t, cards[1].GetEligible())
+		assert.InDelta(t, 0.5, cards[1].GetScore(), 1e-6)
+	})
+
+	t.Run("test pinned segment", func(t *testing.T) {
+		pinned := newScoreTestSegment(6, commonpb.SegmentState_Flushed, 100, 50)
+		pinned.Pinned = true
+		card := trigger.scoreMergeCompaction(pinned)
+		assert.False(t, card.GetEligible())
+		assert.Equal(t, "segment is pinned", card.GetReason())
+
+		// pinned segment is not a merge candidate of other segments
+		segments.SetSegment(pinned.GetID(), pinned)
+		defer segments.DropSegment(pinned.GetID())
+		assert.Equal(t, 2, len(trigger.getCandidateSegments("ch1", 1)))
+	})
+
+	t.Run("test segment with few deleted rows", func(t *testing.T) {
+		card := trigger.scoreSingleCompaction(littleDeleted, tt)
+		assert.False(t, card.GetEligible())
+		assert.Less(t, card.GetScore(), 1.0)
+	})
+
+	t.Run("test segment not expired before timetravel", func(t *testing.T) {
+		card := trigger.scoreSingleCompaction(flushed, &timetravel{time: 50})
+		assert.False(t, card.GetEligible())
+		assert.Equal(t, "segment last expire time 100 is not before timetravel 50", card.GetReason())
+	})
+
+	t.Run("test overlapping candidates", func(t *testing.T) {
+		trigger.pkRanges = newSegmentPKRangeCache()
+		defer func() { trigger.pkRanges = nil }()
+		// range of segment 5 is unknown, which is left out
+		card := trigger.scoreMergeCompaction(flushed)
+		assert.InDelta(t, 0.5, card.GetScore(), 1e-6)
+		assert.NotContains(t, card.GetReason(), "overlap")
+
+		trigger.pkRanges.ranges[1] = pkRange{0, 99}
+		trigger.pkRanges.ranges[5] = pkRange{0, 99}
+		card = trigger.scoreMergeCompaction(flushed)
+		assert.False(t, card.GetEligible())
+		assert.InDelta(t, 1/overlapMergeThreshold, card.GetScore(), 1e-6)
+		assert.Contains(t, card.GetReason(), "primary key ranges of 2 candidate segments overlap by 1.0000")
+	})
+}
+
+func Test_compactionTrigger_getChannelSegmentStats(t *testing.T) {
+	now := time.Now()
+	startedAt := func(d time.Duration) *internalpb.MsgPosition {
+		return &internalpb.MsgPosition{Timestamp: tsoutil.ComposeTS(now.Add(-d).UnixNano()/int64(time.Millisecond), 0)}
+	}
+	segments := NewSegmentsInfo()
+	eligible := newScoreTestSegment(1, commonpb.SegmentState_Flushed, 100, 50)
+	eligible.StartPosition = startedAt(time.Hour)
+	mostDeleted := newScoreTestSegment(2, commonpb.SegmentState_Flushed, 100, 90)
+	mostDeleted.StartPosition = startedAt(time.Minute)
+	growing := newScoreTestSegment(3, commonpb.SegmentState_Growing, 100, 50)
+	dropped := newScoreTestSegment(4, commonpb.SegmentState_Dropped, 100, 50)
+	littleDeleted := newScoreTestSegment(5, commonpb.SegmentState_Flushed, 200, 1)
+	otherChannel := newScoreTestSegment(6, commonpb.SegmentState_Flushed, 100, 50)
+	otherChannel.InsertChannel = "ch2"
+	for _, s := range []*SegmentInfo{eligible, mostDeleted, growing, dropped, littleDeleted, otherChannel} {
+		segments.SetSegment(s.GetID(), s)
+	}
+	trigger := &compactionTrigger{
+		meta: &meta{
+			segments: segments,
+			collections: map[UniqueID]*datapb.CollectionInfo{
+				1: {ID: 1, Schema: &schemapb.CollectionSchema{
+					Fields: []*schemapb.FieldSchema{{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true}},
+				}},
+			},
+		},
+		mergeCompactionSegmentThreshold: 10,
+	}
+	tt := &timetravel{time: 200}
+
+	stats := trigger.getChannelSegmentStats("ch1", tt, now, 0)
+	assert.EqualValues(t, 4, stats.GetTotalSegments())
+	assert.EqualValues(t, 3, stats.GetFlushedSegments())
+	assert.EqualValues(t, 2, stats.GetCompactionEligibleCount())
+	assert.EqualValues(t, 500, stats.GetTotalRows())
+	// 8 bytes of the int64 field per row, and 100 bytes of delta logs per segment
+	assert.EqualValues(t, 8*500+4*100, stats.GetTotalBytes())
+	assert.EqualValues(t, 3600, stats.GetOldestEligibleSegmentAge())
+	require.Equal(t, 2, len(stats.GetTopNEligibleSegments()))
+	assert.EqualValues(t, 2, stats.GetTopNEligibleSegments()[0].GetSegmentID())
+	assert.InDelta(t, 4.5, stats.GetTopNEligibleSegments()[0].GetScore(), 1e-6)
+	assert.Equal(t, datapb.CompactionType_InnerCompaction.String(), stats.GetTopNEligibleSegments()[0].GetPolicyName())
+	assert.EqualValues(t, 1, stats.GetTopNEligibleSegments()[1].GetSegmentID())
+
+	stats = trigger.getChannelSegmentStats("ch1", tt, now, 1)
+	assert.EqualValues(t, 2, stats.GetCompactionEligibleCount())
+	require.Equal(t, 1, len(stats.GetTopNEligibleSegments()))
+	assert.EqualValues(t, 2, stats.GetTopNEligibleSegments()[0].GetSegmentID())
+
+	stats = trigger.getChannelSegmentStats("ch3", tt, now, 1)
+	assert.EqualValues(t, 0, stats.GetTotalSegments())
+	assert.EqualValues(t, 0, stats.GetOldestEligibleSegmentAge())
+	assert.Empty(t, stats.GetTopNEligibleSegments())
+}
@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"go.uber.org/zap"
+)
+
+// startSmallSegmentMergeLoop periodically merges flushed segments having fewer rows than Params.MinSegmentRowCount
+func (t *compactionTrigger) startSmallSegmentMergeLoop() {
+	defer logutil.LogPanic()
+	defer t.wg.Done()
+
+	ticker := time.NewTicker(time.Duration(Params.SmallSegmentMergeInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.quit:
+			log.Info("small segment merge loop exit")
+			return
+		case <-ticker.C:
+			if paused, _ := t.isPaused(); paused {
+				continue
+			}
+			cctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			tt, err := getTimetravelReverseTime(cctx, t.allocator)
+			cancel()
+			if err != nil {
+				log.Warn("unable to get compaction timetravel", zap.Error(err))
+				continue
+			}
+			id, err := t.allocSignalID()
+			if err != nil {
+				log.Warn("unable to alloc compaction signal id", zap.Error(err))
+				continue
+			}
+			signal := &compactionSignal{
+				id:         id,
+				isForce:    false,
+				isGlobal:   true,
+				timetravel: tt,
+			}
+			plans := t.mergeSmallSegments(signal)
+			if len(plans) != 0 {
+				log.Debug("small segment merge plans", zap.Int64("signalID", signal.id), zap.Int64s("plans", getPlanIDs(plans)))
+			}
+		}
+	}
+}
+
+// mergeSmallSegments pairs each flushed segment having fewer rows than Params.MinSegmentRowCount with the smallest
+// other segment in the same channel and partition, and executes a merge compaction plan for each pair
+func (t *compactionTrigger) mergeSmallSegments(signal *compactionSignal) []*datapb.CompactionPlan {
+	t.forceMu.Lock()
+	defer t.forceMu.Unlock()
+
+	m := t.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) &&
+			segment.State == commonpb.SegmentState_Flushed && // flushed only
+			!segment.isCompacting && // not compacting now
+			!segment.GetPinned() // pinned segment is never merged
+	})
+	plans := make([]*datapb.CompactionPlan, 0)
+	for _, group := range m {
+		segments := group.segments
+		sort.Slice(segments, func(i, j int) bool {
+			return segments[i].GetNumOfRows() < segments[j].GetNumOfRows()
+		})
+
+		// segments are sorted by rows, so the smallest neighbor of a small segment is the next one not merged yet
+		for i := 0; i+1 < len(segments); i += 2 {
+			small, neighbor := segments[i], segments[i+1]
+			if small.GetNumOfRows() >= Params.MinSegmentRowCount {
+				break
+			}
+			// neighbors left are even larger
+			if small.GetNumOfRows()+neighbor.GetNumOfRows() > neighbor.GetMaxRowNum() {
+				break
+			}
+			if t.compactionHandler.isFull() {
+				return plans
+			}
+
+			plan := &datapb.CompactionPlan{
+				SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+					{
+						SegmentID:           small.GetID(),
+						FieldBinlogs:        small.GetBinlogs(),
+						Field2StatslogPaths: small.GetStatslogs(),
+						Deltalogs:           small.GetDeltalogs(),
+					},
+					{
+						SegmentID:           neighbor.GetID(),
+						FieldBinlogs:        neighbor.GetBinlogs(),
+						Field2StatslogPaths: neighbor.GetStatslogs(),
+						Deltalogs:           neighbor.GetDeltalogs(),
+					},
+				},
+				Type:       datapb.CompactionType_MergeCompaction,
+				Timetravel: signal.timetravel.time,
+				Channel:    group.channelName,
+			}
+			if err := t.fillOriginPlan(plan); err != nil {
+				log.Warn("failed to fill plan", zap.Error(err))
+				continue
+			}
+			log.Debug("exec small segment merge plan", zap.Int64("segmentID", small.GetID()),
+				zap.Int64("numOfRows", small.GetNumOfRows()), zap.Int64("neighborID", neighbor.GetID()),
+				zap.Int64("planID", plan.GetPlanID()))
+			if err := t.compactionHandler.execCompactionPlan(signal, plan); err != nil {
+				log.Warn("failed to execute compaction plan", zap.Error(err))
+				continue
+			}
+			metrics.DataCoordSmallSegmentMergeCounter.Inc()
+			plans = append(plans, plan)
+		}
+	}
+	return plans
+}
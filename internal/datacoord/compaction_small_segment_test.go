@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSmallMergeTestSegment(id, partitionID, numOfRows int64, state commonpb.SegmentState) *SegmentInfo {
+	return &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  2,
+			PartitionID:   partitionID,
+			NumOfRows:     numOfRows,
+			MaxRowNum:     2000,
+			InsertChannel: "ch1",
+			State:         state,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []string{"log1"}},
+			},
+		},
+	}
+}
+
+func Test_compactionTrigger_mergeSmallSegments(t *testing.T) {
+	defer func(origin int64) { Params.MinSegmentRowCount = origin }(Params.MinSegmentRowCount)
+	Params.MinSegmentRowCount = 100
+
+	m := &meta{
+		segments: &SegmentsInfo{
+			map[int64]*SegmentInfo{
+				1: newSmallMergeTestSegment(1, 1, 20, commonpb.SegmentState_Flushed),
+				2: newSmallMergeTestSegment(2, 1, 10, commonpb.SegmentState_Flushed),
+				3: newSmallMergeTestSegment(3, 1, 1000, commonpb.SegmentState_Flushed),
+				4: newSmallMergeTestSegment(4, 1, 500, commonpb.SegmentState_Flushed),
+				// the only segment of partition 2 has nothing to merge with
+				5: newSmallMergeTestSegment(5, 2, 10, commonpb.SegmentState_Flushed),
+				// dropped and growing segments are skipped
+				6: newSmallMergeTestSegment(6, 2, 10, commonpb.SegmentState_Dropped),
+				7: newSmallMergeTestSegment(7, 2, 10, commonpb.SegmentState_Growing),
+			},
+		},
+	}
+	handler := &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 10)}
+	tr := &compactionTrigger{
+		meta:              m,
+		allocator:         newMockAllocator(),
+		compactionHandler: handler,
+	}
+
+	plans := tr.mergeSmallSegments(&compactionSignal{timetravel: &timetravel{200}})
+	assert.Equal(t, 1, len(plans))
+	plan := plans[0]
+	assert.Equal(t, datapb.CompactionType_MergeCompaction, plan.GetType())
+	assert.EqualValues(t, 200, plan.GetTimetravel())
+	assert.Equal(t, "ch1", plan.GetChannel())
+	assert.NotZero(t, plan.GetPlanID())
+	assert.Equal(t, 2, len(plan.GetSegmentBinlogs()))
+	assert.EqualValues(t, 2, plan.GetSegmentBinlogs()[0].GetSegmentID())
+	assert.EqualValues(t, 1, plan.GetSegmentBinlogs()[1].GetSegmentID())
+	assert.Equal(t, 1, len(handler.spyChan))
+
+	t.Run("merged segment exceeds max rows", func(t *testing.T) {
+		m.segments.segments[1].MaxRowNum = 25
+		defer func() { m.segments.segments[1].MaxRowNum = 2000 }()
+		plans := tr.mergeSmallSegments(&compactionSignal{timetravel: &timetravel{200}})
+		assert.Empty(t, plans)
+	})
+
+	t.Run("pinned segment is skipped", func(t *testing.T) {
+		m.segments.segments[2].Pinned = true
+		defer func() { m.segments.segments[2].Pinned = false }()
+		plans := tr.mergeSmallSegments(&compactionSignal{timetravel: &timetravel{200}})
+		assert.Equal(t, 1, len(plans))
+		assert.EqualValues(t, 1, plans[0].GetSegmentBinlogs()[0].GetSegmentID())
+		assert.EqualValues(t, 4, plans[0].GetSegmentBinlogs()[1].GetSegmentID())
+	})
+
+	t.Run("no small segment", func(t *testing.T) {
+		Params.MinSegmentRowCount = 10
+		plans := tr.mergeSmallSegments(&compactionSignal{timetravel: &timetravel{200}})
+		assert.Empty(t, plans)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
@@ -397,6 +398,18 @@ func Test_newCompactionPlanHandler(t *testing.T) {
 	}
 }
 
+func Test_compactionPlanHandler_getCompactionTasks(t *testing.T) {
+	c := &compactionPlanHandler{
+		plans: map[int64]*compactionTask{
+			1: {triggerInfo: &compactionSignal{id: 1}, state: executing},
+			2: {triggerInfo: &compactionSignal{id: 2}, state: completed},
+		},
+	}
+	tasks := c.getCompactionTasks()
+	assert.Equal(t, 2, len(tasks))
+	assert.ElementsMatch(t, []*compactionTask{c.plans[1], c.plans[2]}, tasks)
+}
+
 func Test_getCompactionTasksBySignalID(t *testing.T) {
 	type fields struct {
 		plans map[int64]*compactionTask
@@ -456,3 +469,184 @@ func Test_getCompactionTasksBySignalID(t *testing.T) {
 		})
 	}
 }
+
+func Test_compactionPlanHandler_completeCompactionFailed(t *testing.T) {
+	c := &compactionPlanHandler{
+		plans: map[int64]*compactionTask{
+			1: {
+				triggerInfo: &compactionSignal{id: 1},
+				state:       executing,
+				plan: &datapb.CompactionPlan{
+					PlanID:         1,
+					SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}},
+				},
+			},
+		},
+		meta: &meta{
+			client: memkv.NewMemoryKV(),
+			segments: &SegmentsInfo{
+				map[int64]*SegmentInfo{
+					1: {SegmentInfo: &datapb.SegmentInfo{ID: 1}, isCompacting: true},
+				},
+			},
+		},
+		executingTaskNum: 1,
+	}
+	result := &datapb.CompactionResult{
+		PlanID:     1,
+		SegmentID:  2,
+		InsertLogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}},
+	}
+
+	// plan of unknown type fails to be applied
+	err := c.completeCompaction(result)
+	assert.Error(t, err)
+	task := c.getCompaction(1)
+	assert.Equal(t, failed, task.state)
+	assert.Equal(t, result, task.result)
+	assert.False(t, task.endTime.IsZero())
+	assert.Equal(t, 0, c.executingTaskNum)
+	assert.False(t, c.meta.GetSegment(1).isCompacting)
+}
+
+func Test_compactionPlanHandler_completeCompactionBytes(t *testing.T) {
+	meta, err := newMemoryMeta(nil)
+	assert.Nil(t, err)
+	for _, segment := range []*datapb.SegmentInfo{
+		{ID: 1, NumOfRows: 10, State: commonpb.SegmentState_Flushed, Binlogs: []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []string{"log1"}}}},
+		{ID: 2, NumOfRows: 10, State: commonpb.SegmentState_Flushed, Binlogs: []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []string{"log2"}}},
+			Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "delta1", DeltaLogSize: 20}}},
+	} {
+		assert.Nil(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+	c := &compactionPlanHandler{
+		plans: map[int64]*compactionTask{
+			1: {
+				triggerInfo: &compactionSignal{id: 1},
+				state:       executing,
+				plan: &datapb.CompactionPlan{
+					PlanID: 1,
+					SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+						{SegmentID: 1, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []string{"log1"}}}},
+						{SegmentID: 2, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []string{"log2"}}}},
+					},
+					Type: datapb.CompactionType_MergeCompaction,
+				},
+			},
+		},
+		meta:             meta,
+		flushCh:          make(chan UniqueID, 1),
+		executingTaskNum: 1,
+	}
+
+	assert.Nil(t, c.completeCompaction(&datapb.CompactionResult{
+		PlanID:     1,
+		SegmentID:  3,
+		NumOfRows:  15,
+		InsertLogs: []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []string{"log3"}}},
+	}))
+	task := c.getCompaction(1)
+	assert.Equal(t, completed, task.state)
+	// 8 bytes per row of the row id field, and delta logs of the input segments are kept by the output segment
+	assert.EqualValues(t, 80+80+20, task.bytesIn)
+	assert.EqualValues(t, 120+20, task.bytesOut)
+}
+
+func Test_compactionPlanHandler_completeCompactionRetry(t *testing.T) {
+	newHandler := func(failures int) *compactionPlanHandler {
+		return &compactionPlanHandler{
+			plans: map[int64]*compactionTask{
+				1: {
+					triggerInfo: &compactionSignal{id: 1},
+					state:       executing,
+					plan: &datapb.CompactionPlan{
+						PlanID:         1,
+						Type:           datapb.CompactionType_InnerCompaction,
+						SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}},
+					},
+				},
+			},
+			meta: &meta{
+				client: &flakySaveKV{TxnKV: memkv.NewMemoryKV(), failures: failures},
+				segments: &SegmentsInfo{
+					map[int64]*SegmentInfo{
+						1: {SegmentInfo: &datapb.SegmentInfo{ID: 1}, isCompacting: true},
+					},
+				},
+			},
+			executingTaskNum: 1,
+		}
+	}
+	result := &datapb.CompactionResult{
+		PlanID:     1,
+		SegmentID:  1,
+		InsertLogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}},
+	}
+
+	// transient failures of etcd are retried
+	c := newHandler(compactionMetaRetryTimes - 1)
+	assert.NoError(t, c.completeCompaction(result))
+	assert.Equal(t, completed, c.getCompaction(1).state)
+	assert.Equal(t, []string{"log2"}, c.meta.GetSegment(1).GetBinlogs()[0].GetBinlogs())
+
+	c = newHandler(compactionMetaRetryTimes)
+	assert.Error(t, c.completeCompaction(result))
+	assert.Equal(t, failed, c.getCompaction(1).state)
+	assert.Empty(t, c.meta.GetSegment(1).GetBinlogs())
+}
+
+func Test_compactionPlanHandler_reclaimCompaction(t *testing.T) {
+	result := &datapb.CompactionResult{PlanID: 1}
+	c := &compactionPlanHandler{
+		plans: map[int64]*compactionTask{
+			1: {state: failed, result: result},
+			2: {state: completed},
+		},
+	}
+
+	assert.NoError(t, c.reclaimCompaction(1))
+	assert.Equal(t, reclaimed, c.getCompaction(1).state)
+	assert.Equal(t, result, c.getCompaction(1).result)
+
+	// only failed plan can be reclaimed
+	assert.Error(t, c.reclaimCompaction(1))
+	assert.Error(t, c.reclaimCompaction(2))
+	assert.Error(t, c.reclaimCompaction(3))
+}
+
+func Test_compactionPlanHandler_cancelCompaction(t *testing.T) {
+	m := &meta{segments: NewSegmentsInfo()}
+	m.segments.SetSegment(1, NewSegmentInfo(&datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Flushed}))
+	m.SetSegmentCompacting(1, true)
+	c := &compactionPlanHandler{
+		plans: map[int64]*compactionTask{
+			1: {state: executing, plan: &datapb.CompactionPlan{PlanID: 1, SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}}}},
+			2: {state: completed},
+		},
+		meta:             m,
+		executingTaskNum: 1,
+	}
+
+	task, err := c.cancelCompaction(1)
+	assert.NoError(t, err)
+	assert.Equal(t, cancelled, task.state)
+	assert.False(t, task.endTime.IsZero())
+	assert.Equal(t, cancelled, c.getCompaction(1).state)
+	assert.Equal(t, 0, c.executingTaskNum)
+	assert.False(t, m.GetSegment(1).isCompacting)
+
+	// result of cancelled plan is rejected
+	assert.Error(t, c.completeCompaction(&datapb.CompactionResult{PlanID: 1}))
+
+	// plans not executing are kept as is
+	task, err = c.cancelCompaction(2)
+	assert.NoError(t, err)
+	assert.Equal(t, completed, task.state)
+	task, err = c.cancelCompaction(1)
+	assert.NoError(t, err)
+	assert.Equal(t, cancelled, task.state)
+	assert.Equal(t, 0, c.executingTaskNum)
+
+	_, err = c.cancelCompaction(3)
+	assert.Error(t, err)
+}
@@ -2,7 +2,9 @@ package datacoord
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -34,6 +36,18 @@ type trigger interface {
 	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string, timetravel *timetravel) error
 	// forceTriggerCompaction force to start a compaction
 	forceTriggerCompaction(collectionID int64, timetravel *timetravel) (UniqueID, error)
+	// dryRunForceCompaction returns the plans forceTriggerCompaction would execute without executing them
+	dryRunForceCompaction(collectionID int64, timetravel *timetravel) []*datapb.CompactionPlan
+	// getScoreCards explains whether the segment would be selected by each compaction policy
+	getScoreCards(segment *SegmentInfo, timetravel *timetravel) []*datapb.CompactionScoreCard
+	// getChannelSegmentStats summarizes the segments of the channel and ranks the ones eligible for compaction
+	getChannelSegmentStats(channel string, timetravel *timetravel, now time.Time, topN int) *datapb.GetChannelSegmentStatsResponse
+	// pause stops dispatching new plans until resume is called, plans executing are not aborted
+	pause(reason string)
+	// resume dispatches new plans again, returns false if not paused
+	resume() bool
+	// isPaused returns whether compaction is paused and the reason of pause
+	isPaused() (bool, string)
 }
 
 type compactionSignal struct {
@@ -45,6 +59,22 @@ type compactionSignal struct {
 	segmentID    UniqueID
 	channel      string
 	timetravel   *timetravel
+	// plans of a dry run signal are not filled or executed, the segments of them are recorded in planned instead
+	dryRun  bool
+	planned map[UniqueID]struct{}
+}
+
+// isPlanned returns whether the segment is in a plan of the dry run signal, which is not compacting since not executed
+func (s *compactionSignal) isPlanned(segmentID UniqueID) bool {
+	_, ok := s.planned[segmentID]
+	return ok
+}
+
+// recordPlan records the segments of the plan generated for the dry run signal
+func (s *compactionSignal) recordPlan(plan *datapb.CompactionPlan) {
+	for _, seg := range plan.GetSegmentBinlogs() {
+		s.planned[seg.GetSegmentID()] = struct{}{}
+	}
 }
 
 var _ trigger = (*compactionTrigger)(nil)
@@ -59,6 +89,9 @@ type compactionTrigger struct {
 	globalTrigger                   *time.Ticker
 	forceMu                         sync.Mutex
 	mergeCompactionSegmentThreshold int
+	pkRanges                        *segmentPKRangeCache // primary key ranges of segments scored by overlap, nil if unknown
+	paused                          int32                // 1 if paused by PauseCompaction, accessed atomically
+	pauseReason                     atomic.Value         // string
 	quit                            chan struct{}
 	wg                              sync.WaitGroup
 }
@@ -69,7 +102,7 @@ func newCompactionTrigger(meta *meta, compactionHandler compactionPlanContext, a
 		allocator:                       allocator,
 		signals:                         make(chan *compactionSignal, signalBufferSize),
 		singleCompactionPolicy:          (singleCompactionFunc)(chooseAllBinlogs),
-		mergeCompactionPolicy:           (mergeCompactionFunc)(greedyMergeCompaction),
+		mergeCompactionPolicy:           newMergeCompactionPolicy(Params.CompactionPolicy),
 		compactionHandler:               compactionHandler,
 		mergeCompactionSegmentThreshold: maxLittleSegmentNum,
 	}
@@ -101,6 +134,11 @@ func (t *compactionTrigger) start() {
 	}()
 
 	go t.startGlobalCompactionLoop()
+
+	if Params.MinSegmentRowCount > 0 {
+		t.wg.Add(1)
+		go t.startSmallSegmentMergeLoop()
+	}
 }
 
 func (t *compactionTrigger) startGlobalCompactionLoop() {
@@ -134,6 +172,9 @@ func (t *compactionTrigger) stop() {
 
 // triggerCompaction trigger a compaction if any compaction condition satisfy.
 func (t *compactionTrigger) triggerCompaction(timetravel *timetravel) error {
+	if paused, _ := t.isPaused(); paused {
+		return nil
+	}
 	id, err := t.allocSignalID()
 	if err != nil {
 		return err
@@ -150,6 +191,9 @@ func (t *compactionTrigger) triggerCompaction(timetravel *timetravel) error {
 
 // triggerSingleCompaction triger a compaction bundled with collection-partiiton-channel-segment
 func (t *compactionTrigger) triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string, timetravel *timetravel) error {
+	if paused, _ := t.isPaused(); paused {
+		return nil
+	}
 	id, err := t.allocSignalID()
 	if err != nil {
 		return err
@@ -170,6 +214,9 @@ func (t *compactionTrigger) triggerSingleCompaction(collectionID, partitionID, s
 
 // forceTriggerCompaction force to start a compaction
 func (t *compactionTrigger) forceTriggerCompaction(collectionID int64, timetravel *timetravel) (UniqueID, error) {
+	if paused, reason := t.isPaused(); paused {
+		return -1, fmt.Errorf("%w: %s", errCompactionPaused, reason)
+	}
 	id, err := t.allocSignalID()
 	if err != nil {
 		return -1, err
@@ -185,13 +232,27 @@ func (t *compactionTrigger) forceTriggerCompaction(collectionID int64, timetrave
 	return id, nil
 }
 
+// dryRunForceCompaction generates the plans of a force compaction of the collection, no plan id is allocated
+// and no segment is marked compacting
+func (t *compactionTrigger) dryRunForceCompaction(collectionID int64, timetravel *timetravel) []*datapb.CompactionPlan {
+	signal := &compactionSignal{
+		isForce:      true,
+		isGlobal:     false,
+		collectionID: collectionID,
+		timetravel:   timetravel,
+		dryRun:       true,
+		planned:      make(map[UniqueID]struct{}),
+	}
+	return t.handleForceSignal(signal)
+}
+
 func (t *compactionTrigger) allocSignalID() (UniqueID, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	return t.allocator.allocID(ctx)
 }
 
-func (t *compactionTrigger) handleForceSignal(signal *compactionSignal) {
+func (t *compactionTrigger) handleForceSignal(signal *compactionSignal) []*datapb.CompactionPlan {
 	t.forceMu.Lock()
 	defer t.forceMu.Unlock()
 
@@ -208,7 +269,8 @@ func (t *compactionTrigger) handleForceSignal(signal *compactionSignal) {
 		log.Debug("force merge compaction plans", zap.Int64("signalID", signal.id), zap.Int64s("planIDs", getPlanIDs(mergeCompactionPlans)))
 	}
 	log.Info("handle force signal cost", zap.Int64("milliseconds", time.Since(t1).Milliseconds()),
-		zap.Int64("collectionID", signal.collectionID), zap.Int64("signalID", signal.id))
+		zap.Int64("collectionID", signal.collectionID), zap.Int64("signalID", signal.id), zap.Bool("dryRun", signal.dryRun))
+	return append(singleCompactionPlans, mergeCompactionPlans...)
 }
 
 func getPlanIDs(plans []*datapb.CompactionPlan) []int64 {
@@ -225,7 +287,7 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 
 	// 1. try global single compaction
 	t1 := time.Now()
-	if t.compactionHandler.isFull() {
+	if paused, _ := t.isPaused(); paused || t.compactionHandler.isFull() {
 		return
 	}
 	segments := t.meta.segments.GetSegments()
@@ -253,7 +315,7 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 
 	t1 := time.Now()
 	// 1. check whether segment's binlogs should be compacted or not
-	if t.compactionHandler.isFull() {
+	if paused, _ := t.isPaused(); paused || t.compactionHandler.isFull() {
 		return
 	}
 
@@ -295,7 +357,9 @@ func (t *compactionTrigger) globalMergeCompaction(signal *compactionSignal, isFo
 		return (has || len(collections) == 0) && // if filters collection
 			isSegmentHealthy(segment) &&
 			segment.State == commonpb.SegmentState_Flushed && // flushed only
-			!segment.isCompacting // not compacting now
+			!segment.isCompacting && // not compacting now
+			!signal.isPlanned(segment.GetID()) && // not in a plan of the dry run
+			!segment.GetPinned() // pinned segment is never merged
 	}) // m is list of chanPartSegments, which is channel-partition organized segments
 	plans := make([]*datapb.CompactionPlan, 0)
 	for _, segments := range m {
@@ -325,6 +389,12 @@ func (t *compactionTrigger) mergeCompaction(segments []*SegmentInfo, signal *com
 			return nil
 		}
 
+		if signal.dryRun {
+			signal.recordPlan(plan)
+			res = append(res, plan)
+			continue
+		}
+
 		if err := t.fillOriginPlan(plan); err != nil {
 			log.Warn("failed to fill plan", zap.Error(err))
 			continue
@@ -345,7 +415,7 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 	res := make([]*SegmentInfo, 0)
 	for _, s := range segments {
 		if s.GetState() != commonpb.SegmentState_Flushed || s.GetInsertChannel() != channel ||
-			s.GetPartitionID() != partitionID || s.isCompacting {
+			s.GetPartitionID() != partitionID || s.isCompacting || s.GetPinned() {
 			continue
 		}
 		res = append(res, s)
@@ -354,13 +424,7 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 }
 
 func (t *compactionTrigger) shouldDoMergeCompaction(segments []*SegmentInfo) bool {
-	littleSegmentNum := 0
-	for _, s := range segments {
-		if s.GetNumOfRows() < s.GetMaxRowNum()/2 {
-			littleSegmentNum++
-		}
-	}
-	return littleSegmentNum >= t.mergeCompactionSegmentThreshold
+	return countLittleSegments(segments) >= t.mergeCompactionSegmentThreshold
 }
 
 func (t *compactionTrigger) fillOriginPlan(plan *datapb.CompactionPlan) error {
@@ -383,14 +447,7 @@ func (t *compactionTrigger) shouldDoSingleCompaction(segment *SegmentInfo, timet
 		return false
 	}
 
-	totalDeletedRows := 0
-	totalDeleteLogSize := int64(0)
-	for _, l := range segment.GetDeltalogs() {
-		if l.TimestampTo < timetravel.time {
-			totalDeletedRows += int(l.GetRecordEntries())
-			totalDeleteLogSize += l.GetDeltaLogSize()
-		}
-	}
+	totalDeletedRows, totalDeleteLogSize := sumDeltalogs(segment, timetravel)
 
 	// currently delta log size and delete ratio policy is applied
 	return float32(totalDeletedRows)/float32(segment.NumOfRows) >= singleCompactionRatioThreshold || totalDeleteLogSize > singleCompactionDeltaLogMaxSize
@@ -429,8 +486,33 @@ func (t *compactionTrigger) singleCompaction(segment *SegmentInfo, isForce bool,
 		return nil, nil
 	}
 
+	if signal.dryRun {
+		signal.recordPlan(plan)
+		return plan, nil
+	}
+
 	if err := t.fillOriginPlan(plan); err != nil {
 		return nil, err
 	}
 	return plan, t.compactionHandler.execCompactionPlan(signal, plan)
 }
+
+// pause stops dispatching new plans, signals queued are dropped when handled and plans executing are not aborted
+func (t *compactionTrigger) pause(reason string) {
+	t.pauseReason.Store(reason)
+	atomic.StoreInt32(&t.paused, 1)
+}
+
+// resume dispatches new plans again, returns false if not paused
+func (t *compactionTrigger) resume() bool {
+	return atomic.CompareAndSwapInt32(&t.paused, 1, 0)
+}
+
+// isPaused returns whether compaction is paused and the reason of pause
+func (t *compactionTrigger) isPaused() (bool, string) {
+	if atomic.LoadInt32(&t.paused) == 0 {
+		return false, ""
+	}
+	reason, _ := t.pauseReason.Load().(string)
+	return true, reason
+}
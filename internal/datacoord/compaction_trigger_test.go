@@ -47,6 +47,21 @@ func (h *spyCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*c
 	panic("not implemented") // TODO: Implement
 }
 
+// get all compaction tasks
+func (h *spyCompactionHandler) getCompactionTasks() []*compactionTask {
+	panic("not implemented") // TODO: Implement
+}
+
+// reclaimCompaction marks a failed compaction as reclaimed
+func (h *spyCompactionHandler) reclaimCompaction(planID int64) error {
+	panic("not implemented") // TODO: Implement
+}
+
+// cancelCompaction marks an executing compaction as cancelled
+func (h *spyCompactionHandler) cancelCompaction(planID int64) (*compactionTask, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (h *spyCompactionHandler) start() {}
 
 func (h *spyCompactionHandler) stop() {}
@@ -759,3 +774,34 @@ func Test_newCompactionTrigger(t *testing.T) {
 		})
 	}
 }
+
+func Test_compactionTrigger_pause(t *testing.T) {
+	tr := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &spyCompactionHandler{}, newMockAllocator())
+
+	paused, _ := tr.isPaused()
+	assert.False(t, paused)
+	assert.False(t, tr.resume())
+
+	tr.pause("maintenance")
+	paused, reason := tr.isPaused()
+	assert.True(t, paused)
+	assert.Equal(t, "maintenance", reason)
+
+	// no signal is queued while paused
+	err := tr.triggerSingleCompaction(1, 1, 1, "ch1", &timetravel{time: 200})
+	assert.Nil(t, err)
+	err = tr.triggerCompaction(&timetravel{time: 200})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tr.signals))
+	_, err = tr.forceTriggerCompaction(1, &timetravel{time: 200})
+	assert.ErrorIs(t, err, errCompactionPaused)
+
+	assert.True(t, tr.resume())
+	paused, _ = tr.isPaused()
+	assert.False(t, paused)
+	err = tr.triggerSingleCompaction(1, 1, 1, "ch1", &timetravel{time: 200})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tr.signals))
+	signal := <-tr.signals
+	assert.EqualValues(t, 1, signal.segmentID)
+}
@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"go.uber.org/zap"
+)
+
+// DashboardRouterPath is the path of the dashboard payload on the http server of metrics
+const DashboardRouterPath = "/dashboard"
+
+// collectionGrowthRate is the binlog growth rate of a collection estimated by TimeSeriesCollector
+type collectionGrowthRate struct {
+	CollectionID   UniqueID `json:"collectionID"`
+	FilesPerMinute float64  `json:"filesPerMinute"`
+}
+
+// dashboardLeader is the DataCoord serving, only one DataCoord is active with its session exclusive
+type dashboardLeader struct {
+	NodeID    UniqueID  `json:"nodeID"`
+	Address   string    `json:"address"`
+	StartTime time.Time `json:"startTime"`
+}
+
+// dashboardPayload is the JSON served at DashboardRouterPath
+type dashboardPayload struct {
+	Timestamp            int64                  `json:"timestamp"` // unix milliseconds when the payload is computed
+	SegmentCountByState  map[string]int         `json:"segmentCountByState"`
+	CompactionQueueDepth int                    `json:"compactionQueueDepth"` // compactions executing, queued or waiting for their dependencies
+	ChannelAssignment    map[UniqueID][]string  `json:"channelAssignment"`    // DataNode id => vchannels
+	TopBinlogGrowth      []collectionGrowthRate `json:"topCollectionsByBinlogGrowth"`
+	SegmentMaxSize       float64                `json:"segmentMaxSize,omitempty"` // MB, only if the segment size is adaptive
+	Leader               dashboardLeader        `json:"leader"`
+}
+
+// dashboardCache keeps the dashboard payload computed last, so that requests are served without any computation
+type dashboardCache struct {
+	payload atomic.Value // []byte
+}
+
+func newDashboardCache() *dashboardCache {
+	return &dashboardCache{}
+}
+
+func (c *dashboardCache) set(payload *dashboardPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	c.payload.Store(data)
+	return nil
+}
+
+// ServeHTTP writes the payload cached, StatusServiceUnavailable is returned before the first payload is computed
+func (c *dashboardCache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, ok := c.payload.Load().([]byte)
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("dashboard payload is not ready"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// DashboardHandler returns the handler serving the dashboard payload
+func (s *Server) DashboardHandler() http.Handler {
+	return s.dashboard
+}
+
+// buildDashboard computes the dashboard payload at now
+func (s *Server) buildDashboard(now time.Time) *dashboardPayload {
+	payload := &dashboardPayload{
+		Timestamp:           now.UnixNano() / int64(time.Millisecond),
+		SegmentCountByState: make(map[string]int),
+		ChannelAssignment:   make(map[UniqueID][]string),
+		TopBinlogGrowth:     []collectionGrowthRate{},
+		Leader: dashboardLeader{
+			NodeID:    Params.NodeID,
+			Address:   Params.Address,
+			StartTime: Params.CreatedTime,
+		},
+	}
+
+	for _, segment := range s.meta.SelectSegments(func(*SegmentInfo) bool { return true }) {
+		payload.SegmentCountByState[segment.GetState().String()]++
+	}
+
+	if s.compactionHandler != nil {
+		for _, task := range s.compactionHandler.getCompactionTasks() {
+			if task.state == executing {
+				payload.CompactionQueueDepth++
+			}
+		}
+	}
+
+	for _, info := range s.channelManager.GetChannels() {
+		channels := make([]string, 0, len(info.Channels))
+		for _, ch := range info.Channels {
+			channels = append(channels, ch.Name)
+		}
+		sort.Strings(channels)
+		payload.ChannelAssignment[info.NodeID] = channels
+	}
+
+	if s.statsCollector != nil {
+		for _, collectionID := range s.meta.ListCollectionIDs() {
+			if rate, ok := s.statsCollector.getGrowthRate(collectionID); ok {
+				payload.TopBinlogGrowth = append(payload.TopBinlogGrowth, collectionGrowthRate{CollectionID: collectionID, FilesPerMinute: rate})
+			}
+		}
+		sort.Slice(payload.TopBinlogGrowth, func(i, j int) bool {
+			return payload.TopBinlogGrowth[i].FilesPerMinute > payload.TopBinlogGrowth[j].FilesPerMinute
+		})
+		if topN := int(Params.DashboardTopN); topN >= 0 && len(payload.TopBinlogGrowth) > topN {
+			payload.TopBinlogGrowth = payload.TopBinlogGrowth[:topN]
+		}
+	}
+
+	if s.segmentSizer != nil {
+		payload.SegmentMaxSize = s.segmentSizer.getMaxSize()
+	}
+	return payload
+}
+
+func (s *Server) refreshDashboard(now time.Time) {
+	if err := s.dashboard.set(s.buildDashboard(now)); err != nil {
+		log.Warn("failed to refresh dashboard payload", zap.Error(err))
+	}
+}
+
+// startDashboardLoop computes the dashboard payload at once and every Params.DashboardRefreshIntervalSeconds
+func (s *Server) startDashboardLoop(ctx context.Context) {
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		s.refreshDashboard(time.Now())
+		interval := time.Duration(Params.DashboardRefreshIntervalSeconds) * time.Second
+		if interval <= 0 {
+			interval = time.Second
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug("dashboard loop shut down")
+				return
+			case now := <-ticker.C:
+				s.refreshDashboard(now)
+			}
+		}
+	}()
+}
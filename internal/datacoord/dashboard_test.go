@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDashboardCache(t *testing.T) {
+	c := newDashboardCache()
+	w := httptest.NewRecorder()
+	c.ServeHTTP(w, httptest.NewRequest(http.MethodGet, DashboardRouterPath, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	err := c.set(&dashboardPayload{Timestamp: 100, CompactionQueueDepth: 2})
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	c.ServeHTTP(w, httptest.NewRequest(http.MethodGet, DashboardRouterPath, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	payload := &dashboardPayload{}
+	err = json.Unmarshal(w.Body.Bytes(), payload)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, payload.Timestamp)
+	assert.Equal(t, 2, payload.CompactionQueueDepth)
+}
+
+func TestServer_Dashboard(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+
+	for _, segment := range []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 0, InsertChannel: "ch1", State: commonpb.SegmentState_Growing},
+		{ID: 2, CollectionID: 0, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed},
+		{ID: 3, CollectionID: 0, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed},
+	} {
+		err := svr.meta.AddSegment(NewSegmentInfo(segment))
+		require.NoError(t, err)
+	}
+	err := svr.channelManager.AddNode(1)
+	require.NoError(t, err)
+	err = svr.channelManager.Watch(&channel{"ch1", 0})
+	require.NoError(t, err)
+	svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0})
+	svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1})
+	svr.meta.AddCollection(&datapb.CollectionInfo{ID: 2})
+	require.NotNil(t, svr.statsCollector)
+	svr.statsCollector.mu.Lock()
+	svr.statsCollector.rates[0] = 1.5
+	svr.statsCollector.rates[1] = 3
+	svr.statsCollector.mu.Unlock()
+
+	now := time.Now()
+	svr.refreshDashboard(now)
+	w := httptest.NewRecorder()
+	svr.DashboardHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, DashboardRouterPath, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	payload := &dashboardPayload{}
+	err = json.Unmarshal(w.Body.Bytes(), payload)
+	require.NoError(t, err)
+	assert.Equal(t, now.UnixNano()/int64(time.Millisecond), payload.Timestamp)
+	assert.Equal(t, map[string]int{commonpb.SegmentState_Growing.String(): 1, commonpb.SegmentState_Flushed.String(): 2}, payload.SegmentCountByState)
+	assert.Equal(t, 0, payload.CompactionQueueDepth)
+	assert.Equal(t, map[UniqueID][]string{1: {"ch1"}}, payload.ChannelAssignment)
+	assert.Equal(t, []collectionGrowthRate{{CollectionID: 1, FilesPerMinute: 3}, {CollectionID: 0, FilesPerMinute: 1.5}}, payload.TopBinlogGrowth)
+	assert.Equal(t, Params.NodeID, payload.Leader.NodeID)
+	assert.Equal(t, Params.Address, payload.Leader.Address)
+}
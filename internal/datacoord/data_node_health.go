@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import "sync"
+
+// dataNodeHealth keeps the errors of vchannels DataNodes failed to start, which are shown in the metrics of DataNodes.
+// Errors are kept in memory only
+type dataNodeHealth struct {
+	mu            sync.Mutex
+	channelErrors map[UniqueID]map[string]string // node id => vchannel => error
+}
+
+func newDataNodeHealth() *dataNodeHealth {
+	return &dataNodeHealth{
+		channelErrors: make(map[UniqueID]map[string]string),
+	}
+}
+
+// report records the errors of vchannels of the node, and clears the errors of the healthy vchannels
+func (h *dataNodeHealth) report(nodeID UniqueID, channelErrors map[string]string, healthyChannels []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	errs, ok := h.channelErrors[nodeID]
+	if !ok {
+		errs = make(map[string]string)
+		h.channelErrors[nodeID] = errs
+	}
+	for channel, reason := range channelErrors {
+		errs[channel] = reason
+	}
+	for _, channel := range healthyChannels {
+		delete(errs, channel)
+	}
+	if len(errs) == 0 {
+		delete(h.channelErrors, nodeID)
+	}
+}
+
+// errors returns a copy of the vchannel errors of the node, nil if there is none
+func (h *dataNodeHealth) errors(nodeID UniqueID) map[string]string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	errs, ok := h.channelErrors[nodeID]
+	if !ok {
+		return nil
+	}
+	ret := make(map[string]string, len(errs))
+	for channel, reason := range errs {
+		ret[channel] = reason
+	}
+	return ret
+}
+
+// remove clears the errors of a node left the cluster
+func (h *dataNodeHealth) remove(nodeID UniqueID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.channelErrors, nodeID)
+}
@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataNodeHealth(t *testing.T) {
+	h := newDataNodeHealth()
+	assert.Nil(t, h.errors(1))
+
+	h.report(1, map[string]string{"ch1": "timeout", "ch2": "seek failed"}, nil)
+	h.report(2, map[string]string{"ch3": "timeout"}, nil)
+	assert.Equal(t, map[string]string{"ch1": "timeout", "ch2": "seek failed"}, h.errors(1))
+
+	// errors returned are copies
+	h.errors(1)["ch4"] = "error"
+	assert.Len(t, h.errors(1), 2)
+
+	h.report(1, map[string]string{"ch2": "timeout"}, []string{"ch1"})
+	assert.Equal(t, map[string]string{"ch2": "timeout"}, h.errors(1))
+
+	h.report(1, nil, []string{"ch2"})
+	assert.Nil(t, h.errors(1))
+	assert.Empty(t, h.channelErrors[1])
+
+	h.remove(2)
+	assert.Nil(t, h.errors(2))
+}
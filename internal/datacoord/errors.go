@@ -24,6 +24,12 @@ import (
 // errNilKvClient stands for a nil kv client is detected when initialized
 var errNilKvClient = errors.New("kv client not initialized")
 
+// errSegmentTooSmall stands for a new segment would hold fewer rows than Params.MinSegmentRowCount
+var errSegmentTooSmall = errors.New("segment too small")
+
+// errCompactionPaused stands for no compaction plan is dispatched since PauseCompaction is called
+var errCompactionPaused = errors.New("compaction paused")
+
 // serverNotServingErrMsg used for Status Reason when datacoord is not healthy
 const serverNotServingErrMsg = "DataCoord is not serving"
 
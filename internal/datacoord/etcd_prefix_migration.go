@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"go.uber.org/zap"
+)
+
+// etcdPrefixMigrationRecordPrefix is the absolute etcd key prefix of etcd prefix migration records,
+// records are kept after migrations complete for audit
+const etcdPrefixMigrationRecordPrefix = "datacoord-prefix-migration"
+
+// migrateEtcdPrefixBatchSize is the number of keys moved in an etcd transaction, each key takes a put and a delete
+// guarded by two compares, which is under the default limit of operations in a transaction, 128
+const migrateEtcdPrefixBatchSize = 64
+
+// migrateEtcdPrefixMaxConflicts is the number of times in a row a batch may be modified during its move before
+// the migration gives up
+const migrateEtcdPrefixMaxConflicts = 10
+
+// etcdPrefixMigrationKV is the kv etcd prefix migrations work on, it must have no root path so that prefixes are absolute
+type etcdPrefixMigrationKV interface {
+	Save(key, value string) error
+	LoadWithPrefix(key string) ([]string, []string, error)
+	LoadKeysWithPrefix(key string, limit int64) ([]string, error)
+	LoadWithPrefixAndModRevisions(key string, limit int64) ([]string, []string, []int64, error)
+	MultiSaveAndRemoveWithModRevisions(saves map[string]string, removals []string, revisions map[string]int64) (bool, error)
+}
+
+// etcdPrefixMigration is the record of migrating keys under OldPrefix to NewPrefix
+type etcdPrefixMigration struct {
+	OldPrefix string `json:"oldPrefix"`
+	NewPrefix string `json:"newPrefix"`
+	SourceID  int64  `json:"sourceID"`
+	StartTime int64  `json:"startTime"` // unix seconds
+}
+
+// isUnderPrefix returns whether key is prefix itself or in the directory of prefix,
+// e.g. by-dev/meta is under by-dev while by-dev2/meta is not
+func isUnderPrefix(key, prefix string) bool {
+	return key == prefix || strings.HasPrefix(key, prefix+"/")
+}
+
+func validateEtcdPrefixes(oldPrefix, newPrefix string) error {
+	if oldPrefix == "" || newPrefix == "" {
+		return fmt.Errorf("empty etcd prefix, old: %q, new: %q", oldPrefix, newPrefix)
+	}
+	if isUnderPrefix(oldPrefix, newPrefix) || isUnderPrefix(newPrefix, oldPrefix) {
+		return fmt.Errorf("etcd prefix %s and %s overlap", oldPrefix, newPrefix)
+	}
+	for _, prefix := range []string{oldPrefix, newPrefix} {
+		if isUnderPrefix(prefix, etcdPrefixMigrationRecordPrefix) || isUnderPrefix(etcdPrefixMigrationRecordPrefix, prefix) {
+			return fmt.Errorf("etcd prefix %s overlaps migration records", prefix)
+		}
+	}
+	return nil
+}
+
+// hasKeysUnderPrefix returns whether any key is in the directory of prefix, without loading values
+func hasKeysUnderPrefix(migrationKV etcdPrefixMigrationKV, prefix string) (bool, error) {
+	keys, err := migrationKV.LoadKeysWithPrefix(prefix+"/", 1)
+	if err != nil {
+		return false, err
+	}
+	return len(keys) > 0, nil
+}
+
+// checkEtcdPrefixInUse returns an error if prefix overlaps any of usedPrefixes,
+// or any component keeps its session under prefix, which means the component is using it
+func checkEtcdPrefixInUse(migrationKV etcdPrefixMigrationKV, prefix string, usedPrefixes ...string) error {
+	prefix = path.Clean(prefix)
+	for _, used := range usedPrefixes {
+		used = path.Clean(used)
+		if isUnderPrefix(prefix, used) || isUnderPrefix(used, prefix) {
+			return fmt.Errorf("etcd prefix %s is in use by this server as %s", prefix, used)
+		}
+	}
+	keys, err := migrationKV.LoadKeysWithPrefix(prefix+"/", 0)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if strings.Contains(key, "/"+sessionutil.DefaultServiceRoot) {
+			return fmt.Errorf("etcd prefix %s is in use by the component of session %s", prefix, key)
+		}
+	}
+	return nil
+}
+
+// migrateEtcdPrefix moves the keys under m.OldPrefix to m.NewPrefix in batches.
+// Each batch is copied and removed in one transaction guarded on the mod revision of every key moved,
+// a batch modified concurrently is reloaded and moved again, so no write to the old prefix is lost.
+// The migration is recorded before any key is moved, so that a partial migration can be detected on startup
+func migrateEtcdPrefix(migrationKV etcdPrefixMigrationKV, m *etcdPrefixMigration) (int, error) {
+	m.OldPrefix, m.NewPrefix = path.Clean(m.OldPrefix), path.Clean(m.NewPrefix)
+	if err := validateEtcdPrefixes(m.OldPrefix, m.NewPrefix); err != nil {
+		return 0, err
+	}
+	exist, err := hasKeysUnderPrefix(migrationKV, m.NewPrefix)
+	if err != nil {
+		return 0, err
+	}
+	if exist {
+		return 0, fmt.Errorf("etcd prefix %s is not empty", m.NewPrefix)
+	}
+
+	record, err := json.Marshal(m)
+	if err != nil {
+		return 0, err
+	}
+	recordKey := path.Join(etcdPrefixMigrationRecordPrefix, strconv.FormatInt(time.Now().UnixNano(), 10))
+	if err := migrationKV.Save(recordKey, string(record)); err != nil {
+		return 0, err
+	}
+
+	migrated, conflicts := 0, 0
+	for {
+		keys, values, revisions, err := migrationKV.LoadWithPrefixAndModRevisions(m.OldPrefix+"/", migrateEtcdPrefixBatchSize)
+		if err != nil {
+			return migrated, err
+		}
+		if len(keys) == 0 {
+			break
+		}
+		saves := make(map[string]string, len(keys))
+		guards := make(map[string]int64, len(keys)*2)
+		for i, key := range keys {
+			newKey := m.NewPrefix + strings.TrimPrefix(key, m.OldPrefix)
+			saves[newKey] = values[i]
+			guards[key] = revisions[i]
+			// the new key must not exist
+			guards[newKey] = 0
+		}
+		moved, err := migrationKV.MultiSaveAndRemoveWithModRevisions(saves, keys, guards)
+		if err != nil {
+			return migrated, err
+		}
+		if !moved {
+			conflicts++
+			if conflicts >= migrateEtcdPrefixMaxConflicts {
+				return migrated, fmt.Errorf("keys under etcd prefix %s keep being modified during migration", m.OldPrefix)
+			}
+			log.Warn("keys modified during etcd prefix migration, retry the batch", zap.String("oldPrefix", m.OldPrefix),
+				zap.Int("conflicts", conflicts))
+			continue
+		}
+		conflicts = 0
+		migrated += len(keys)
+	}
+
+	log.Info("audit: etcd prefix migrated", zap.String("oldPrefix", m.OldPrefix), zap.String("newPrefix", m.NewPrefix),
+		zap.Int64("sourceID", m.SourceID), zap.Int("keys", migrated), zap.String("record", recordKey),
+		zap.Duration("elapse", time.Since(time.Unix(m.StartTime, 0))))
+	return migrated, nil
+}
+
+// checkEtcdPrefixMigrations returns an error if keys exist under both prefixes of any migration recorded,
+// which means the migration is not completed
+func checkEtcdPrefixMigrations(migrationKV etcdPrefixMigrationKV) error {
+	recordKeys, records, err := migrationKV.LoadWithPrefix(etcdPrefixMigrationRecordPrefix)
+	if err != nil {
+		return err
+	}
+	for i, value := range records {
+		m := &etcdPrefixMigration{}
+		if err := json.Unmarshal([]byte(value), m); err != nil {
+			return fmt.Errorf("failed to unmarshal etcd prefix migration %s: %w", recordKeys[i], err)
+		}
+		oldExist, err := hasKeysUnderPrefix(migrationKV, m.OldPrefix)
+		if err != nil {
+			return err
+		}
+		newExist, err := hasKeysUnderPrefix(migrationKV, m.NewPrefix)
+		if err != nil {
+			return err
+		}
+		if oldExist && newExist {
+			return fmt.Errorf("etcd prefix migration from %s to %s is partial, keys remain under the old prefix",
+				m.OldPrefix, m.NewPrefix)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockEtcdPrefixMigrationKV is a memory kv keeping the mod revision of each key saved
+type mockEtcdPrefixMigrationKV struct {
+	*memkv.MemoryKV
+	revision  int64
+	revisions map[string]int64
+	beforeTxn func() // called before each transaction, e.g. to write keys concurrently
+}
+
+func newMockEtcdPrefixMigrationKV() *mockEtcdPrefixMigrationKV {
+	return &mockEtcdPrefixMigrationKV{
+		MemoryKV:  memkv.NewMemoryKV(),
+		revisions: make(map[string]int64),
+	}
+}
+
+func (kv *mockEtcdPrefixMigrationKV) Save(key, value string) error {
+	kv.revision++
+	kv.revisions[key] = kv.revision
+	return kv.MemoryKV.Save(key, value)
+}
+
+func (kv *mockEtcdPrefixMigrationKV) MultiSave(kvs map[string]string) error {
+	for key, value := range kvs {
+		if err := kv.Save(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (kv *mockEtcdPrefixMigrationKV) Remove(key string) error {
+	delete(kv.revisions, key)
+	return kv.MemoryKV.Remove(key)
+}
+
+func (kv *mockEtcdPrefixMigrationKV) LoadKeysWithPrefix(key string, limit int64) ([]string, error) {
+	keys, _, _, err := kv.LoadWithPrefixAndModRevisions(key, limit)
+	return keys, err
+}
+
+func (kv *mockEtcdPrefixMigrationKV) LoadWithPrefixAndModRevisions(key string, limit int64) ([]string, []string, []int64, error) {
+	keys, values, err := kv.LoadWithPrefix(key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if limit > 0 && int64(len(keys)) > limit {
+		keys, values = keys[:limit], values[:limit]
+	}
+	revisions := make([]int64, 0, len(keys))
+	for _, key := range keys {
+		revisions = append(revisions, kv.revisions[key])
+	}
+	return keys, values, revisions, nil
+}
+
+func (kv *mockEtcdPrefixMigrationKV) MultiSaveAndRemoveWithModRevisions(saves map[string]string, removals []string, revisions map[string]int64) (bool, error) {
+	if kv.beforeTxn != nil {
+		kv.beforeTxn()
+	}
+	for key, revision := range revisions {
+		if kv.revisions[key] != revision {
+			return false, nil
+		}
+	}
+	if err := kv.MultiSave(saves); err != nil {
+		return false, err
+	}
+	for _, key := range removals {
+		if err := kv.Remove(key); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func Test_validateEtcdPrefixes(t *testing.T) {
+	assert.NoError(t, validateEtcdPrefixes("by-dev/meta", "by-dev2/meta"))
+	assert.NoError(t, validateEtcdPrefixes("by-dev", "by-dev2"))
+	assert.Error(t, validateEtcdPrefixes("", "by-dev"))
+	assert.Error(t, validateEtcdPrefixes("by-dev", "by-dev"))
+	assert.Error(t, validateEtcdPrefixes("by-dev", "by-dev/tenant"))
+	assert.Error(t, validateEtcdPrefixes("by-dev/tenant", "by-dev"))
+	assert.Error(t, validateEtcdPrefixes(etcdPrefixMigrationRecordPrefix+"/1", "by-dev"))
+}
+
+func Test_migrateEtcdPrefix(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		txnKV := newMockEtcdPrefixMigrationKV()
+		kvs := map[string]string{"by-dev2/meta": "unrelated"}
+		for i := 0; i < migrateEtcdPrefixBatchSize*2+1; i++ {
+			kvs[fmt.Sprintf("by-dev/meta/%d", i)] = fmt.Sprintf("value-%d", i)
+		}
+		require.NoError(t, txnKV.MultiSave(kvs))
+
+		migrated, err := migrateEtcdPrefix(txnKV, &etcdPrefixMigration{OldPrefix: "by-dev/", NewPrefix: "tenant/by-dev"})
+		require.NoError(t, err)
+		assert.Equal(t, migrateEtcdPrefixBatchSize*2+1, migrated)
+
+		keys, _, err := txnKV.LoadWithPrefix("by-dev")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"by-dev2/meta"}, keys)
+		for i := 0; i < migrateEtcdPrefixBatchSize*2+1; i++ {
+			value, err := txnKV.Load(fmt.Sprintf("tenant/by-dev/meta/%d", i))
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("value-%d", i), value)
+		}
+
+		// the migration is recorded and completed
+		_, records, err := txnKV.LoadWithPrefix(etcdPrefixMigrationRecordPrefix)
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(records))
+		assert.NoError(t, checkEtcdPrefixMigrations(txnKV))
+	})
+
+	t.Run("new prefix not empty", func(t *testing.T) {
+		txnKV := newMockEtcdPrefixMigrationKV()
+		require.NoError(t, txnKV.MultiSave(map[string]string{"old/a": "1", "new/a": "2"}))
+		_, err := migrateEtcdPrefix(txnKV, &etcdPrefixMigration{OldPrefix: "old", NewPrefix: "new"})
+		assert.Error(t, err)
+		value, err := txnKV.Load("old/a")
+		assert.NoError(t, err)
+		assert.Equal(t, "1", value)
+	})
+
+	t.Run("keys modified concurrently", func(t *testing.T) {
+		txnKV := newMockEtcdPrefixMigrationKV()
+		require.NoError(t, txnKV.MultiSave(map[string]string{"old/a": "1", "old/b": "2"}))
+		// a key is updated and another is added between loading and moving the first batch
+		txnKV.beforeTxn = func() {
+			txnKV.beforeTxn = nil
+			require.NoError(t, txnKV.MultiSave(map[string]string{"old/a": "3", "old/c": "4"}))
+		}
+
+		migrated, err := migrateEtcdPrefix(txnKV, &etcdPrefixMigration{OldPrefix: "old", NewPrefix: "new"})
+		require.NoError(t, err)
+		assert.Equal(t, 3, migrated)
+		keys, values, err := txnKV.LoadWithPrefix("new/")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"new/a", "new/b", "new/c"}, keys)
+		assert.Equal(t, []string{"3", "2", "4"}, values)
+		keys, _, err = txnKV.LoadWithPrefix("old/")
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("keys keep being modified", func(t *testing.T) {
+		txnKV := newMockEtcdPrefixMigrationKV()
+		require.NoError(t, txnKV.Save("old/a", "1"))
+		txnKV.beforeTxn = func() {
+			require.NoError(t, txnKV.Save("old/a", "2"))
+		}
+		_, err := migrateEtcdPrefix(txnKV, &etcdPrefixMigration{OldPrefix: "old", NewPrefix: "new"})
+		assert.Error(t, err)
+		value, err := txnKV.Load("old/a")
+		assert.NoError(t, err)
+		assert.Equal(t, "2", value)
+	})
+
+	t.Run("invalid prefixes", func(t *testing.T) {
+		_, err := migrateEtcdPrefix(newMockEtcdPrefixMigrationKV(), &etcdPrefixMigration{OldPrefix: "old", NewPrefix: "old/new"})
+		assert.Error(t, err)
+	})
+}
+
+func Test_checkEtcdPrefixInUse(t *testing.T) {
+	txnKV := newMockEtcdPrefixMigrationKV()
+	require.NoError(t, txnKV.MultiSave(map[string]string{
+		"old/meta/segment/1":          "1",
+		"other/meta/session/datanode": "2",
+	}))
+	assert.NoError(t, checkEtcdPrefixInUse(txnKV, "old", "by-dev/meta", "by-dev/kv"))
+	assert.Error(t, checkEtcdPrefixInUse(txnKV, "by-dev", "by-dev/meta", "by-dev/kv"))
+	assert.Error(t, checkEtcdPrefixInUse(txnKV, "by-dev/kv/sub", "by-dev/meta", "by-dev/kv"))
+	assert.Error(t, checkEtcdPrefixInUse(txnKV, "other/", "by-dev/meta"))
+	assert.True(t, strings.Contains(checkEtcdPrefixInUse(txnKV, "other", "by-dev/meta").Error(), "session"))
+}
+
+func Test_checkEtcdPrefixMigrations(t *testing.T) {
+	txnKV := newMockEtcdPrefixMigrationKV()
+	assert.NoError(t, checkEtcdPrefixMigrations(txnKV))
+
+	// keys remain under both prefixes
+	require.NoError(t, txnKV.MultiSave(map[string]string{
+		etcdPrefixMigrationRecordPrefix + "/1": `{"oldPrefix":"old","newPrefix":"new"}`,
+		"old/a":                                "1",
+		"new/b":                                "2",
+	}))
+	assert.Error(t, checkEtcdPrefixMigrations(txnKV))
+
+	require.NoError(t, txnKV.Remove("old/a"))
+	assert.NoError(t, checkEtcdPrefixMigrations(txnKV))
+
+	require.NoError(t, txnKV.Save(etcdPrefixMigrationRecordPrefix+"/2", "not json"))
+	assert.Error(t, checkEtcdPrefixMigrations(txnKV))
+}
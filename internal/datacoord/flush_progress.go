@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// segmentFlushProgress returns the flush progress in percentage of a segment in the state,
+// a segment goes through Growing, Sealed, Flushing and Flushed. Dropped segments have nothing left to flush
+func segmentFlushProgress(state commonpb.SegmentState) float64 {
+	switch state {
+	case commonpb.SegmentState_Sealed:
+		return 100.0 / 3
+	case commonpb.SegmentState_Flushing:
+		return 200.0 / 3
+	case commonpb.SegmentState_Flushed, commonpb.SegmentState_Dropped:
+		return 100
+	default:
+		return 0
+	}
+}
+
+// getFlushProgress returns the flush progress of the segments of the collection, weighted by the number of rows.
+// Segments compacted away are regarded as flushed, since only flushed segments are compacted, they are found
+// in the compaction sources of the segments in meta even if removed by garbage collection
+func getFlushProgress(m *meta, collectionID UniqueID, segmentIDs []UniqueID) (float64, []*datapb.SegmentFlushProgress, error) {
+	// segments of the collection including dropped ones, and the compaction sources of them,
+	// only collected if any segment is not healthy
+	var all map[UniqueID]*SegmentInfo
+	var compacted map[UniqueID]struct{}
+	lookup := func(segmentID UniqueID) (*SegmentInfo, bool) {
+		if all == nil {
+			all = make(map[UniqueID]*SegmentInfo)
+			compacted = make(map[UniqueID]struct{})
+			m.SelectSegments(func(segment *SegmentInfo) bool {
+				if segment.GetCollectionID() == collectionID {
+					all[segment.GetID()] = segment
+					for _, from := range segment.GetCompactionFrom() {
+						compacted[from] = struct{}{}
+					}
+				}
+				return false
+			})
+		}
+		_, ok := compacted[segmentID]
+		return all[segmentID], ok
+	}
+
+	var weighted, total float64
+	segments := make([]*datapb.SegmentFlushProgress, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		segment := m.GetSegment(segmentID)
+		var isCompacted bool
+		if segment == nil {
+			segment, isCompacted = lookup(segmentID)
+			if segment == nil && !isCompacted {
+				return 0, nil, fmt.Errorf("segment %d not found", segmentID)
+			}
+		}
+		if segment != nil && segment.GetCollectionID() != collectionID {
+			return 0, nil, fmt.Errorf("segment %d doesn't belong to collection %d", segmentID, collectionID)
+		}
+
+		progress := &datapb.SegmentFlushProgress{SegmentID: segmentID, State: commonpb.SegmentState_Dropped, Compacted: isCompacted}
+		if segment != nil {
+			progress.State = segment.GetState()
+			progress.NumRows = segment.GetNumOfRows()
+			if segment.currRows > progress.NumRows {
+				progress.NumRows = segment.currRows
+			}
+		}
+		progress.Progress = segmentFlushProgress(progress.GetState())
+		segments = append(segments, progress)
+
+		// segments without rows counted are weighted as a row
+		weight := float64(progress.GetNumRows())
+		if weight < 1 {
+			weight = 1
+		}
+		weighted += weight * progress.GetProgress()
+		total += weight
+	}
+
+	if total == 0 {
+		return 100, segments, nil
+	}
+	return weighted / total, segments, nil
+}
@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFlushProgress(t *testing.T) {
+	meta, err := newMemoryMeta(newMockAllocator())
+	require.NoError(t, err)
+	for _, segment := range []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 100, NumOfRows: 100, State: commonpb.SegmentState_Growing},
+		{ID: 2, CollectionID: 100, NumOfRows: 100, State: commonpb.SegmentState_Sealed},
+		{ID: 3, CollectionID: 100, NumOfRows: 100, State: commonpb.SegmentState_Flushing},
+		{ID: 4, CollectionID: 100, NumOfRows: 300, State: commonpb.SegmentState_Flushed},
+		// 5 is compacted into 6, and 7 is removed by garbage collection after compacted into 8
+		{ID: 5, CollectionID: 100, NumOfRows: 100, State: commonpb.SegmentState_Dropped},
+		{ID: 6, CollectionID: 100, NumOfRows: 100, State: commonpb.SegmentState_Flushed, CompactionFrom: []int64{5}},
+		{ID: 8, CollectionID: 100, NumOfRows: 100, State: commonpb.SegmentState_Flushed, CompactionFrom: []int64{7}},
+		{ID: 9, CollectionID: 200, NumOfRows: 100, State: commonpb.SegmentState_Flushed},
+	} {
+		err := meta.AddSegment(NewSegmentInfo(segment))
+		require.NoError(t, err)
+	}
+
+	t.Run("partial flush", func(t *testing.T) {
+		progress, segments, err := getFlushProgress(meta, 100, []UniqueID{1, 2, 3, 4})
+		require.NoError(t, err)
+		// (100 * 0 + 100 * 100/3 + 100 * 200/3 + 300 * 100) / 600
+		assert.InDelta(t, 66.67, progress, 0.01)
+		require.Equal(t, 4, len(segments))
+		assert.Equal(t, commonpb.SegmentState_Growing, segments[0].GetState())
+		assert.Equal(t, float64(0), segments[0].GetProgress())
+		assert.InDelta(t, 33.33, segments[1].GetProgress(), 0.01)
+		assert.InDelta(t, 66.67, segments[2].GetProgress(), 0.01)
+		assert.Equal(t, float64(100), segments[3].GetProgress())
+		assert.EqualValues(t, 300, segments[3].GetNumRows())
+	})
+
+	t.Run("full flush", func(t *testing.T) {
+		progress, segments, err := getFlushProgress(meta, 100, []UniqueID{4, 6})
+		require.NoError(t, err)
+		assert.Equal(t, float64(100), progress)
+		assert.Equal(t, 2, len(segments))
+
+		progress, segments, err = getFlushProgress(meta, 100, nil)
+		require.NoError(t, err)
+		assert.Equal(t, float64(100), progress)
+		assert.Empty(t, segments)
+	})
+
+	t.Run("compacted segments", func(t *testing.T) {
+		progress, segments, err := getFlushProgress(meta, 100, []UniqueID{5, 7})
+		require.NoError(t, err)
+		assert.Equal(t, float64(100), progress)
+		require.Equal(t, 2, len(segments))
+		assert.True(t, segments[0].GetCompacted())
+		assert.Equal(t, commonpb.SegmentState_Dropped, segments[0].GetState())
+		assert.EqualValues(t, 100, segments[0].GetNumRows())
+		assert.True(t, segments[1].GetCompacted())
+		assert.EqualValues(t, 0, segments[1].GetNumRows())
+
+		progress, _, err = getFlushProgress(meta, 100, []UniqueID{1, 7})
+		require.NoError(t, err)
+		// segment removed from meta is weighted as a row
+		assert.InDelta(t, 100.0/101, progress, 0.01)
+	})
+
+	t.Run("invalid segments", func(t *testing.T) {
+		_, _, err := getFlushProgress(meta, 100, []UniqueID{1, 10})
+		assert.Error(t, err)
+		_, _, err = getFlushProgress(meta, 100, []UniqueID{9})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+)
+
+// flushedSegmentsPageToken is the position where the next page of GetFlushedSegments starts,
+// the page starts after the last segment of the previous page rather than at an offset,
+// so that segments flushed or compacted between pages don't shift the pages
+type flushedSegmentsPageToken struct {
+	collectionID  UniqueID
+	partitionID   UniqueID
+	lastSegmentID UniqueID
+}
+
+func (t flushedSegmentsPageToken) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d/%d/%d", t.collectionID, t.partitionID, t.lastSegmentID)))
+}
+
+// decodeFlushedSegmentsPageToken decodes the token of the collection and partition, empty token starts from the first segment
+func decodeFlushedSegmentsPageToken(token string, collectionID, partitionID UniqueID) (flushedSegmentsPageToken, error) {
+	t := flushedSegmentsPageToken{collectionID: collectionID, partitionID: partitionID}
+	if token == "" {
+		return t, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return t, fmt.Errorf("invalid page token %s: %w", token, err)
+	}
+	var decoded flushedSegmentsPageToken
+	if _, err := fmt.Sscanf(string(data), "%d/%d/%d", &decoded.collectionID, &decoded.partitionID, &decoded.lastSegmentID); err != nil {
+		return t, fmt.Errorf("invalid page token %s: %w", token, err)
+	}
+	if decoded.collectionID != collectionID || decoded.partitionID != partitionID {
+		return t, fmt.Errorf("page token %s is not issued for collection %d partition %d", token, collectionID, partitionID)
+	}
+	return decoded, nil
+}
+
+// pageFlushedSegments sorts segmentIDs and returns at most limit of them after the page token,
+// along with the token of the next page, which is empty if no segment is left. limit 0 returns all of them
+func pageFlushedSegments(segmentIDs []UniqueID, collectionID, partitionID UniqueID, limit int64, token string) ([]UniqueID, string, error) {
+	if limit < 0 {
+		return nil, "", fmt.Errorf("invalid limit %d", limit)
+	}
+	t, err := decodeFlushedSegmentsPageToken(token, collectionID, partitionID)
+	if err != nil {
+		return nil, "", err
+	}
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+	start := 0
+	if token != "" {
+		start = sort.Search(len(segmentIDs), func(i int) bool { return segmentIDs[i] > t.lastSegmentID })
+	}
+	page := segmentIDs[start:]
+	if limit == 0 || int64(len(page)) <= limit {
+		return page, "", nil
+	}
+	page = page[:limit]
+	t.lastSegmentID = page[len(page)-1]
+	return page, t.encode(), nil
+}
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageFlushedSegments(t *testing.T) {
+	listAll := func(t *testing.T, segmentIDs func() []UniqueID, limit int64, afterPage func(page int)) []UniqueID {
+		var ret []UniqueID
+		token := ""
+		for page := 0; ; page++ {
+			ids, next, err := pageFlushedSegments(segmentIDs(), 1, -1, limit, token)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, int64(len(ids)), limit)
+			ret = append(ret, ids...)
+			if next == "" {
+				return ret
+			}
+			token = next
+			if afterPage != nil {
+				afterPage(page)
+			}
+		}
+	}
+
+	t.Run("all pages", func(t *testing.T) {
+		segmentIDs := []UniqueID{7, 3, 1, 5, 2, 4, 6}
+		all, next, err := pageFlushedSegments(append([]UniqueID{}, segmentIDs...), 1, -1, 0, "")
+		require.NoError(t, err)
+		assert.Empty(t, next)
+		assert.Equal(t, []UniqueID{1, 2, 3, 4, 5, 6, 7}, all)
+
+		for _, limit := range []int64{1, 2, 3, 7, 8} {
+			paged := listAll(t, func() []UniqueID { return append([]UniqueID{}, segmentIDs...) }, limit, nil)
+			assert.Equal(t, all, paged, "limit %d", limit)
+		}
+	})
+
+	t.Run("segments flushed between pages", func(t *testing.T) {
+		segmentIDs := []UniqueID{2, 4, 6, 8}
+		paged := listAll(t, func() []UniqueID { return append([]UniqueID{}, segmentIDs...) }, 2, func(page int) {
+			if page == 0 {
+				// flushed before and after the cursor, and the first one compacted away
+				segmentIDs = []UniqueID{3, 4, 6, 8, 9}
+			}
+		})
+		// segments listed are not repeated or skipped because of the change
+		assert.Equal(t, []UniqueID{2, 4, 6, 8, 9}, paged)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, next, err := pageFlushedSegments([]UniqueID{1, 2, 3}, 1, -1, 1, "")
+		require.NoError(t, err)
+		require.NotEmpty(t, next)
+
+		_, _, err = pageFlushedSegments([]UniqueID{1, 2, 3}, 2, -1, 1, next)
+		assert.Error(t, err)
+		_, _, err = pageFlushedSegments([]UniqueID{1, 2, 3}, 1, 1, 1, next)
+		assert.Error(t, err)
+		_, _, err = pageFlushedSegments([]UniqueID{1, 2, 3}, 1, -1, 1, "!")
+		assert.Error(t, err)
+		_, _, err = pageFlushedSegments([]UniqueID{1, 2, 3}, 1, -1, 1, "YWJj")
+		assert.Error(t, err)
+		_, _, err = pageFlushedSegments([]UniqueID{1, 2, 3}, 1, -1, -1, "")
+		assert.Error(t, err)
+	})
+}
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/minio/minio-go/v7"
 	"go.uber.org/zap"
 )
@@ -43,6 +45,7 @@ type GcOption struct {
 	dropTolerance    time.Duration // dropped segment related key tolerance time
 	bucketName       string
 	rootPath         string
+	events           *gcEventLog // records the removed objects, nil means not recorded
 }
 
 // garbageCollector handles garbage files in object storage
@@ -115,6 +118,7 @@ func (gc *garbageCollector) scan() {
 	for i, k := range dropped {
 		dm[k] = droppedAt[i]
 	}
+	compacted := gc.compactedFiles()
 
 	for info := range gc.option.cli.ListObjects(context.TODO(), gc.option.bucketName, minio.ListObjectsOptions{
 		Prefix:    gc.option.rootPath,
@@ -134,8 +138,11 @@ func (gc *garbageCollector) scan() {
 			// check file last modified time exceeds tolerance duration
 			if time.Since(droppedTime) > gc.option.dropTolerance {
 				e++
-				// ignore error since it could be cleaned up next time
-				_ = gc.option.cli.RemoveObject(context.TODO(), gc.option.bucketName, info.Key, minio.RemoveObjectOptions{})
+				reason := gcReasonDropped
+				if _, ok := compacted[info.Key]; ok {
+					reason = gcReasonCompacted
+				}
+				gc.removeObject(info, reason)
 			}
 			continue
 		}
@@ -143,9 +150,48 @@ func (gc *garbageCollector) scan() {
 		// not found in meta, check last modified time exceeds tolerance duration
 		if time.Since(info.LastModified) > gc.option.missingTolerance {
 			e++
-			// ignore error since it could be cleaned up next time
-			_ = gc.option.cli.RemoveObject(context.TODO(), gc.option.bucketName, info.Key, minio.RemoveObjectOptions{})
+			gc.removeObject(info, gcReasonOrphaned)
 		}
 	}
 	log.Warn("scan result", zap.Int("valid", v), zap.Int("dropped", d), zap.Int("missing", m), zap.Int("removed", e))
 }
+
+// removeObject removes the object and records the removal
+func (gc *garbageCollector) removeObject(info minio.ObjectInfo, reason string) {
+	// ignore error since it could be cleaned up next time
+	if err := gc.option.cli.RemoveObject(context.TODO(), gc.option.bucketName, info.Key, minio.RemoveObjectOptions{}); err != nil {
+		return
+	}
+	gc.option.events.record(info.Key, info.Size, reason)
+}
+
+// compactedFiles returns the files of dropped segments which are compacted into other segments
+func (gc *garbageCollector) compactedFiles() map[string]struct{} {
+	compactedIDs := make(map[UniqueID]struct{})
+	for _, segment := range gc.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return len(segment.GetCompactionFrom()) > 0
+	}) {
+		for _, id := range segment.GetCompactionFrom() {
+			compactedIDs[id] = struct{}{}
+		}
+	}
+
+	files := make(map[string]struct{})
+	for _, segment := range gc.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		_, ok := compactedIDs[segment.GetID()]
+		return ok && segment.GetState() == commonpb.SegmentState_Dropped
+	}) {
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs(), segment.GetSketchlogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					files[binlog] = struct{}{}
+				}
+			}
+		}
+		for _, deltaLog := range segment.GetDeltalogs() {
+			files[deltaLog.GetDeltaLogPath()] = struct{}{}
+			files[deltaLog.GetDeltaLogPath()+deltaLogIndexSuffix] = struct{}{}
+		}
+	}
+	return files
+}
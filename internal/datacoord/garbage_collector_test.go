@@ -194,6 +194,72 @@ func Test_garbageCollector_scan(t *testing.T) {
 	cleanupOSS(cli, bucketName, rootPath)
 }
 
+func Test_garbageCollector_events(t *testing.T) {
+	bucketName := `datacoord-ut` + strings.ToLower(funcutil.RandomString(8))
+	rootPath := `gc` + funcutil.RandomString(8)
+	cli, files, err := initUtOSSEnv(bucketName, rootPath, 5)
+	require.NoError(t, err)
+	defer cleanupOSS(cli, bucketName, rootPath)
+
+	meta, err := newMemoryMeta(newMockAllocator())
+	require.NoError(t, err)
+	droppedAt := uint64(time.Now().Add(-time.Hour).UnixNano())
+	for _, segment := range []*datapb.SegmentInfo{
+		// segment 1 is compacted into segment 2
+		{ID: 1, State: commonpb.SegmentState_Dropped, DroppedAt: droppedAt,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []string{files[0]}}}},
+		{ID: 2, State: commonpb.SegmentState_Flushed, CompactionFrom: []int64{1},
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []string{files[1]}}}},
+		{ID: 3, State: commonpb.SegmentState_Dropped, DroppedAt: droppedAt,
+			Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: files[2]}}},
+	} {
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+
+	logFile := path.Join(t.TempDir(), "gc.log")
+	events := newGCEventLog(logFile)
+	gc := newGarbageCollector(meta, GcOption{
+		cli:              cli,
+		enabled:          true,
+		checkInterval:    time.Minute * 30,
+		missingTolerance: 0,
+		dropTolerance:    0,
+		bucketName:       bucketName,
+		rootPath:         rootPath,
+		events:           events,
+	})
+	gc.scan()
+
+	expected := map[string]string{
+		files[0]: gcReasonCompacted,
+		files[2]: gcReasonDropped,
+		files[3]: gcReasonOrphaned,
+		files[4]: gcReasonOrphaned,
+	}
+	records := readGCEventRecords(t, logFile)
+	actual := make(map[string]string, len(records))
+	for _, record := range records {
+		assert.EqualValues(t, len("test"), record.SizeBytes)
+		actual[record.ObjectPath] = record.Reason
+	}
+	assert.Equal(t, expected, actual)
+
+	svr := &Server{gcEvents: events}
+	svr.isServing = ServerStateHealthy
+	resp, err := svr.GetGCEvents(context.TODO(), &datapb.GetGCEventsRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	actual = make(map[string]string, len(resp.GetEvents()))
+	for _, event := range resp.GetEvents() {
+		actual[event.GetObjectPath()] = event.GetReason()
+	}
+	assert.Equal(t, expected, actual)
+
+	resp, err = svr.GetGCEvents(context.TODO(), &datapb.GetGCEventsRequest{Reason: gcReasonOrphaned})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(resp.GetEvents()))
+}
+
 // initialize unit test sso env
 func initUtOSSEnv(bucket, root string, n int) (*minio.Client, []string, error) {
 	Params.Init()
@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"go.uber.org/zap"
+)
+
+// reasons of removing objects
+const (
+	gcReasonOrphaned  = "orphaned"  // referenced by no segment in meta
+	gcReasonCompacted = "compacted" // belongs to a segment compacted into another one
+	gcReasonDropped   = "dropped"   // belongs to a dropped segment
+)
+
+// maxGCEventsKept limits the events kept in memory for GetGCEvents
+const maxGCEventsKept = 10000
+
+// gcEventRecord is the JSON record of a gc event appended to the log file
+type gcEventRecord struct {
+	Timestamp  int64  `json:"timestamp"`
+	ObjectPath string `json:"objectPath"`
+	SizeBytes  int64  `json:"sizeBytes"`
+	Reason     string `json:"reason"`
+}
+
+// gcEventLog records the objects removed by garbage collection and storage audit, so that the removal is auditable.
+// Each event is appended to the log file as a JSON line, or logged if no file is specified
+type gcEventLog struct {
+	mu     sync.Mutex
+	path   string
+	events []*datapb.GCEvent // the latest events in time order
+}
+
+func newGCEventLog(path string) *gcEventLog {
+	return &gcEventLog{path: path}
+}
+
+// record records the removal of an object, it does nothing with nil log
+func (l *gcEventLog) record(objectPath string, size int64, reason string) {
+	if l == nil {
+		return
+	}
+	event := &datapb.GCEvent{
+		Timestamp:  time.Now().UnixNano() / int64(time.Millisecond),
+		ObjectPath: objectPath,
+		SizeBytes:  size,
+		Reason:     reason,
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, event)
+	// trim the events in batch to avoid copying on each record
+	if len(l.events) >= 2*maxGCEventsKept {
+		l.events = append([]*datapb.GCEvent(nil), l.events[len(l.events)-maxGCEventsKept:]...)
+	}
+
+	if l.path == "" {
+		log.Info("object removed by garbage collection", zap.String("objectPath", objectPath),
+			zap.Int64("sizeBytes", size), zap.String("reason", reason))
+		return
+	}
+	if err := l.write(event); err != nil {
+		log.Warn("failed to write gc event log", zap.String("path", l.path), zap.String("objectPath", objectPath), zap.Error(err))
+	}
+}
+
+// write appends the event to the log file as a JSON line
+func (l *gcEventLog) write(event *datapb.GCEvent) error {
+	line, err := json.Marshal(&gcEventRecord{
+		Timestamp:  event.GetTimestamp(),
+		ObjectPath: event.GetObjectPath(),
+		SizeBytes:  event.GetSizeBytes(),
+		Reason:     event.GetReason(),
+	})
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// list returns at most limit latest events in time order, which are within [start, end] and of the reason.
+// Zero start or end means no bound, empty reason means all reasons, non-positive limit means no limit
+func (l *gcEventLog) list(start, end int64, reason string, limit int64) []*datapb.GCEvent {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var events []*datapb.GCEvent
+	for i := len(l.events) - 1; i >= 0 && len(l.events)-i <= maxGCEventsKept; i-- {
+		if limit > 0 && int64(len(events)) >= limit {
+			break
+		}
+		event := l.events[i]
+		if (start != 0 && event.GetTimestamp() < start) || (end != 0 && event.GetTimestamp() > end) ||
+			(reason != "" && event.GetReason() != reason) {
+			continue
+		}
+		events = append(events, event)
+	}
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events
+}
@@ -0,0 +1,119 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readGCEventRecords reads the JSON records of the gc event log file
+func readGCEventRecords(t *testing.T, file string) []*gcEventRecord {
+	content, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	var records []*gcEventRecord
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		record := &gcEventRecord{}
+		require.NoError(t, json.Unmarshal([]byte(line), record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestGCEventLog(t *testing.T) {
+	file := path.Join(t.TempDir(), "gc.log")
+	l := newGCEventLog(file)
+	l.record("a", 1, gcReasonOrphaned)
+	l.record("b", 2, gcReasonCompacted)
+	l.record("c", 3, gcReasonDropped)
+
+	records := readGCEventRecords(t, file)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, "a", records[0].ObjectPath)
+	assert.EqualValues(t, 1, records[0].SizeBytes)
+	assert.Equal(t, gcReasonOrphaned, records[0].Reason)
+	assert.Equal(t, gcReasonDropped, records[2].Reason)
+
+	events := l.list(0, 0, "", 0)
+	require.Equal(t, 3, len(events))
+	assert.Equal(t, "c", events[2].GetObjectPath())
+	assert.Equal(t, records[1].Timestamp, events[1].GetTimestamp())
+
+	t.Run("filter", func(t *testing.T) {
+		events := l.list(0, 0, gcReasonCompacted, 0)
+		require.Equal(t, 1, len(events))
+		assert.Equal(t, "b", events[0].GetObjectPath())
+
+		// the latest ones are returned
+		events = l.list(0, 0, "", 2)
+		assert.Equal(t, []string{"b", "c"}, []string{events[0].GetObjectPath(), events[1].GetObjectPath()})
+
+		now := time.Now().UnixNano() / int64(time.Millisecond)
+		assert.Equal(t, 3, len(l.list(now-time.Minute.Milliseconds(), now+1, "", 0)))
+		assert.Empty(t, l.list(now+1, 0, "", 0))
+		assert.Empty(t, l.list(0, now-time.Minute.Milliseconds(), "", 0))
+	})
+
+	t.Run("without file", func(t *testing.T) {
+		l := newGCEventLog("")
+		for i := 0; i < 2*maxGCEventsKept+1; i++ {
+			l.record("a", 1, gcReasonOrphaned)
+		}
+		assert.Equal(t, maxGCEventsKept+1, len(l.events))
+		assert.Equal(t, maxGCEventsKept, len(l.list(0, 0, "", 0)))
+	})
+
+	t.Run("write failed", func(t *testing.T) {
+		l := newGCEventLog(path.Join(t.TempDir(), "not_exist", "gc.log"))
+		l.record("a", 1, gcReasonOrphaned)
+		assert.Equal(t, 1, len(l.list(0, 0, "", 0)))
+	})
+
+	t.Run("nil log", func(t *testing.T) {
+		var l *gcEventLog
+		assert.NotPanics(t, func() { l.record("a", 1, gcReasonOrphaned) })
+		assert.Nil(t, l.list(0, 0, "", 0))
+	})
+}
+
+func TestStorageAuditor_events(t *testing.T) {
+	old := time.Now().Add(-2 * time.Hour)
+	auditor, removed := newTestStorageAuditor(t, []minio.ObjectInfo{
+		{Key: "files/insert_log/1/1/1/1/1", Size: 10, LastModified: old},
+		{Key: "files/insert_log/1/1/3/1/1", Size: 20, LastModified: old},
+	})
+	file := path.Join(t.TempDir(), "gc.log")
+	auditor.events = newGCEventLog(file)
+
+	_, err := auditor.audit(context.TODO(), false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"files/insert_log/1/1/3/1/1"}, *removed)
+
+	records := readGCEventRecords(t, file)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, gcEventRecord{Timestamp: records[0].Timestamp, ObjectPath: "files/insert_log/1/1/3/1/1",
+		SizeBytes: 20, Reason: gcReasonOrphaned}, *records[0])
+}
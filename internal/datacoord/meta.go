@@ -19,6 +19,7 @@ package datacoord
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -37,6 +38,8 @@ const (
 	metaPrefix           = "datacoord-meta"
 	segmentPrefix        = metaPrefix + "/s"
 	handoffSegmentPrefix = "querycoord-handoff"
+	// deltaLogIndexSuffix is the suffix of the index file of a delta log, the same as storage.DeltaLogIndexSuffix
+	deltaLogIndexSuffix = ".idx"
 )
 
 type meta struct {
@@ -79,6 +82,49 @@ func (m *meta) reloadFromKV() error {
 	return nil
 }
 
+// unreferencedBinlogs returns the paths referenced by no segment. Segments saved in kv are checked besides the ones
+// in memory, for a save failed with an etcd error may be applied after all
+func (m *meta) unreferencedBinlogs(paths []string) ([]string, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	referenced := make(map[string]struct{})
+	addSegment := func(segment *datapb.SegmentInfo) {
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs(), segment.GetSketchlogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, path := range fieldBinlog.GetBinlogs() {
+					referenced[path] = struct{}{}
+				}
+			}
+		}
+		for _, deltalog := range segment.GetDeltalogs() {
+			referenced[deltalog.GetDeltaLogPath()] = struct{}{}
+		}
+	}
+	for _, segment := range m.segments.GetSegments() {
+		addSegment(segment.SegmentInfo)
+	}
+	_, values, err := m.client.LoadWithPrefix(segmentPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		segmentInfo := &datapb.SegmentInfo{}
+		if err := proto.Unmarshal([]byte(value), segmentInfo); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal segment info: %w", err)
+		}
+		addSegment(segmentInfo)
+	}
+
+	unreferenced := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if _, ok := referenced[path]; !ok {
+			unreferenced = append(unreferenced, path)
+		}
+	}
+	return unreferenced, nil
+}
+
 // AddCollection add collection into meta
 // Note that collection info is just for caching and will not be set into etcd from datacoord
 func (m *meta) AddCollection(collection *datapb.CollectionInfo) {
@@ -98,6 +144,25 @@ func (m *meta) GetCollection(collectionID UniqueID) *datapb.CollectionInfo {
 	return collection
 }
 
+// ListCollectionIDs returns ids of all the collections in meta, including the ones only known from segments
+func (m *meta) ListCollectionIDs() []UniqueID {
+	m.RLock()
+	defer m.RUnlock()
+	ids := make(map[UniqueID]struct{}, len(m.collections))
+	for id := range m.collections {
+		ids[id] = struct{}{}
+	}
+	for _, segment := range m.segments.GetSegments() {
+		ids[segment.GetCollectionID()] = struct{}{}
+	}
+	ret := make([]UniqueID, 0, len(ids))
+	for id := range ids {
+		ret = append(ret, id)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret
+}
+
 type chanPartSegments struct {
 	collecionID UniqueID
 	partitionID UniqueID
@@ -149,6 +214,26 @@ func (m *meta) GetNumRowsOfCollection(collectionID UniqueID) int64 {
 	return ret
 }
 
+// GetBinlogCountOfCollection returns the number of binlog files of healthy segments in the collection,
+// including insert, stats, delta and sketch logs
+func (m *meta) GetBinlogCountOfCollection(collectionID UniqueID) int64 {
+	m.RLock()
+	defer m.RUnlock()
+	var ret int64
+	for _, segment := range m.segments.GetSegments() {
+		if !isSegmentHealthy(segment) || segment.GetCollectionID() != collectionID {
+			continue
+		}
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs(), segment.GetSketchlogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				ret += int64(len(fieldBinlog.GetBinlogs()))
+			}
+		}
+		ret += int64(len(segment.GetDeltalogs()))
+	}
+	return ret
+}
+
 // AddSegment records segment info, persisting info into kv store
 func (m *meta) AddSegment(segment *SegmentInfo) error {
 	m.Lock()
@@ -160,6 +245,26 @@ func (m *meta) AddSegment(segment *SegmentInfo) error {
 	return nil
 }
 
+// AddSegments records the segments in memory and saves them into kv store in one transaction,
+// none of them is added if the transaction fails
+func (m *meta) AddSegments(segments []*SegmentInfo) error {
+	m.Lock()
+	defer m.Unlock()
+	kvs := make(map[string]string)
+	for _, segment := range segments {
+		if err := m.segmentInfoKvs(segment, kvs); err != nil {
+			return err
+		}
+	}
+	if err := m.saveKvTxn(kvs); err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	return nil
+}
+
 // Deprecated
 // DropSegment remove segment with provided id, etcd persistence also removed
 func (m *meta) DropSegment(segmentID UniqueID) error {
@@ -199,17 +304,45 @@ func (m *meta) SetState(segmentID UniqueID, state commonpb.SegmentState) error {
 	return nil
 }
 
+// segmentVersionMismatchError is returned when the version of segment meta is not the expected one
+type segmentVersionMismatchError struct {
+	segmentID UniqueID
+	expected  int64
+	current   int64
+}
+
+func (e *segmentVersionMismatchError) Error() string {
+	return fmt.Sprintf("segment %d is modified concurrently, expected version %d, current version %d",
+		e.segmentID, e.expected, e.current)
+}
+
+// checkSegmentVersion returns segmentVersionMismatchError if expectedVersion is not 0 and not the version of segment
+func checkSegmentVersion(segment *SegmentInfo, expectedVersion int64) error {
+	if expectedVersion != 0 && expectedVersion != segment.GetVersion() {
+		return &segmentVersionMismatchError{
+			segmentID: segment.GetID(),
+			expected:  expectedVersion,
+			current:   segment.GetVersion(),
+		}
+	}
+	return nil
+}
+
 // UpdateFlushSegmentsInfo update segment partial/completed flush info
 // `flushed` parameter indicating whether segment is flushed completely or partially
 // `binlogs`, `checkpoints` and `statPositions` are persistence data for segment
+// `expectedVersion` is checked against the version of segment unless it's 0
+// `rebuilt` parameter indicating the binlogs replace the recorded ones, and the checkpoint of segment may move backward
 func (m *meta) UpdateFlushSegmentsInfo(
 	segmentID UniqueID,
 	flushed bool,
 	dropped bool,
-	binlogs, statslogs []*datapb.FieldBinlog,
+	rebuilt bool,
+	binlogs, statslogs, sketchlogs []*datapb.FieldBinlog,
 	deltalogs []*datapb.DeltaLogInfo,
 	checkpoints []*datapb.CheckPoint,
 	startPositions []*datapb.SegmentStartPosition,
+	expectedVersion int64,
 ) error {
 	m.Lock()
 	defer m.Unlock()
@@ -218,6 +351,9 @@ func (m *meta) UpdateFlushSegmentsInfo(
 	if segment == nil || !isSegmentHealthy(segment) {
 		return nil
 	}
+	if err := checkSegmentVersion(segment, expectedVersion); err != nil {
+		return err
+	}
 
 	clonedSegment := segment.Clone()
 
@@ -235,39 +371,18 @@ func (m *meta) UpdateFlushSegmentsInfo(
 		modSegments[segmentID] = clonedSegment
 	}
 
-	currBinlogs := clonedSegment.GetBinlogs()
-
-	var getFieldBinlogs = func(id UniqueID, binlogs []*datapb.FieldBinlog) *datapb.FieldBinlog {
-		for _, binlog := range binlogs {
-			if id == binlog.GetFieldID() {
-				return binlog
-			}
-		}
-		return nil
+	// paths already recorded are skipped, since DataNode may retry SaveBinlogPaths after a partial write
+	if rebuilt {
+		// binlogs replaced become orphans, which are removed by garbage collection
+		clonedSegment.Binlogs = mergeFieldBinlogs(nil, binlogs)
+		clonedSegment.Statslogs = mergeFieldBinlogs(nil, statslogs)
+		clonedSegment.Sketchlogs = mergeFieldBinlogs(nil, sketchlogs)
+	} else {
+		clonedSegment.Binlogs = mergeFieldBinlogs(clonedSegment.GetBinlogs(), binlogs)
+		clonedSegment.Statslogs = mergeFieldBinlogs(clonedSegment.GetStatslogs(), statslogs)
+		clonedSegment.Sketchlogs = mergeFieldBinlogs(clonedSegment.GetSketchlogs(), sketchlogs)
 	}
-	// binlogs
-	for _, tBinlogs := range binlogs {
-		fieldBinlogs := getFieldBinlogs(tBinlogs.GetFieldID(), currBinlogs)
-		if fieldBinlogs == nil {
-			currBinlogs = append(currBinlogs, tBinlogs)
-		} else {
-			fieldBinlogs.Binlogs = append(fieldBinlogs.Binlogs, tBinlogs.Binlogs...)
-		}
-	}
-	clonedSegment.Binlogs = currBinlogs
-	// statlogs
-	currStatsLogs := clonedSegment.GetStatslogs()
-	for _, tStatsLogs := range statslogs {
-		fieldStatsLog := getFieldBinlogs(tStatsLogs.GetFieldID(), currStatsLogs)
-		if fieldStatsLog == nil {
-			currStatsLogs = append(currStatsLogs, tStatsLogs)
-		} else {
-			fieldStatsLog.Binlogs = append(fieldStatsLog.Binlogs, tStatsLogs.Binlogs...)
-		}
-	}
-	clonedSegment.Statslogs = currStatsLogs
-	// deltalogs
-	clonedSegment.Deltalogs = append(clonedSegment.Deltalogs, deltalogs...)
+	clonedSegment.Deltalogs = mergeDeltalogs(clonedSegment.GetDeltalogs(), deltalogs)
 
 	modSegments[segmentID] = clonedSegment
 
@@ -300,7 +415,9 @@ func (m *meta) UpdateFlushSegmentsInfo(
 			continue
 		}
 
-		if s.DmlPosition != nil && s.DmlPosition.Timestamp >= cp.Position.Timestamp {
+		// the checkpoint of a rebuilt segment restarts from its start position
+		rewound := rebuilt && cp.GetSegmentID() == segmentID
+		if !rewound && s.DmlPosition != nil && s.DmlPosition.Timestamp >= cp.Position.Timestamp {
 			// segment position in etcd is larger than checkpoint, then dont change it
 			continue
 		}
@@ -311,6 +428,7 @@ func (m *meta) UpdateFlushSegmentsInfo(
 	}
 
 	for _, segment := range modSegments {
+		segment.Version++
 		segBytes, err := proto.Marshal(segment.SegmentInfo)
 		if err != nil {
 			return fmt.Errorf("DataCoord UpdateFlushSegmentsInfo segmentID:%d, marshal failed:%w", segment.GetID(), err)
@@ -358,13 +476,24 @@ func (m *meta) ListSegmentFiles() (valid []string, dropped []string, droppedAt [
 			}
 		}
 
-		for _, deltaLog := range segment.GetDeltalogs() {
+		for _, sketchLog := range segment.GetSketchlogs() {
 			if segment.State != commonpb.SegmentState_Dropped {
-				valid = append(valid, deltaLog.GetDeltaLogPath())
+				valid = append(valid, sketchLog.Binlogs...)
 			} else {
-				dropped = append(dropped, deltaLog.GetDeltaLogPath())
+				dropped = append(dropped, sketchLog.Binlogs...)
 				droppedAt = append(droppedAt, segment.DroppedAt)
 			}
+		}
+
+		for _, deltaLog := range segment.GetDeltalogs() {
+			// the index file is written alongside the delta log in flush
+			paths := []string{deltaLog.GetDeltaLogPath(), deltaLog.GetDeltaLogPath() + deltaLogIndexSuffix}
+			if segment.State != commonpb.SegmentState_Dropped {
+				valid = append(valid, paths...)
+			} else {
+				dropped = append(dropped, paths...)
+				droppedAt = append(droppedAt, segment.DroppedAt, segment.DroppedAt)
+			}
 
 		}
 	}
@@ -386,6 +515,19 @@ func (m *meta) GetSegmentsByChannel(dmlCh string) []*SegmentInfo {
 	return infos
 }
 
+// GetNumOfGrowingSegmentsByChannel returns the number of healthy growing segments of the insert channel
+func (m *meta) GetNumOfGrowingSegmentsByChannel(dmlCh string) int {
+	m.RLock()
+	defer m.RUnlock()
+	num := 0
+	for _, segment := range m.segments.GetSegments() {
+		if isSegmentHealthy(segment) && segment.GetState() == commonpb.SegmentState_Growing && segment.InsertChannel == dmlCh {
+			num++
+		}
+	}
+	return num
+}
+
 // GetSegmentsOfCollection get all segments of collection
 func (m *meta) GetSegmentsOfCollection(collectionID UniqueID) []*SegmentInfo {
 	m.RLock()
@@ -528,6 +670,94 @@ func (m *meta) SetSegmentCompacting(segmentID UniqueID, compacting bool) {
 	m.segments.SetIsCompacting(segmentID, compacting)
 }
 
+// SetSegmentsPinned sets the pinned flag of segments of the collection, and persists them in one transaction
+// error is returned and nothing is changed if any of the segments is not found in the collection
+func (m *meta) SetSegmentsPinned(collectionID UniqueID, segmentIDs []UniqueID, pinned bool) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segments := make([]*SegmentInfo, 0, len(segmentIDs))
+	data := make(map[string]string)
+	for _, segmentID := range segmentIDs {
+		segment := m.segments.GetSegment(segmentID)
+		if segment == nil || !isSegmentHealthy(segment) || segment.GetCollectionID() != collectionID {
+			return fmt.Errorf("segment %d is not found in collection %d", segmentID, collectionID)
+		}
+		cloned := segment.Clone()
+		cloned.Pinned = pinned
+		k, v, err := m.marshal(cloned)
+		if err != nil {
+			return err
+		}
+		data[k] = v
+		segments = append(segments, cloned)
+	}
+
+	if err := m.saveKvTxn(data); err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	return nil
+}
+
+// SetFlushStarted marks the sealed segment returned as flushable and persists it, so that its flush can't be
+// cancelled even after restart
+func (m *meta) SetFlushStarted(segmentID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || !isSegmentHealthy(segment) {
+		return fmt.Errorf("segment %d is not found", segmentID)
+	}
+	if segment.GetFlushStarted() {
+		return nil
+	}
+	cloned := segment.Clone()
+	cloned.FlushStarted = true
+	if err := m.saveSegmentInfo(cloned); err != nil {
+		return err
+	}
+	m.segments.SetSegment(segmentID, cloned)
+	return nil
+}
+
+// SetSegmentsDropped marks flushed segments dropped, and persists them in one transaction, so that their binlogs
+// are removed by garbage collection. error is returned and nothing is changed if any of the segments is not flushed
+func (m *meta) SetSegmentsDropped(segmentIDs []UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+
+	droppedAt := uint64(time.Now().UnixNano())
+	segments := make([]*SegmentInfo, 0, len(segmentIDs))
+	data := make(map[string]string)
+	for _, segmentID := range segmentIDs {
+		segment := m.segments.GetSegment(segmentID)
+		if segment == nil || segment.GetState() != commonpb.SegmentState_Flushed {
+			return fmt.Errorf("segment %d is not flushed", segmentID)
+		}
+		cloned := segment.Clone()
+		cloned.State = commonpb.SegmentState_Dropped
+		cloned.DroppedAt = droppedAt
+		k, v, err := m.marshal(cloned)
+		if err != nil {
+			return err
+		}
+		data[k] = v
+		segments = append(segments, cloned)
+	}
+
+	if err := m.saveKvTxn(data); err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	return nil
+}
+
 func (m *meta) CompleteMergeCompaction(compactionLogs []*datapb.CompactionSegmentBinlogs, result *datapb.CompactionResult) error {
 	m.Lock()
 	defer m.Unlock()
@@ -700,7 +930,9 @@ func (m *meta) updateDeltalogs(origin []*datapb.DeltaLogInfo, removes []*datapb.
 	return res
 }
 
+// marshal increments the version of segment and marshals it into the kv pair to save
 func (m *meta) marshal(segment *SegmentInfo) (string, string, error) {
+	segment.Version++
 	segBytes, err := proto.Marshal(segment.SegmentInfo)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to marshal segment info, %v", err)
@@ -709,14 +941,23 @@ func (m *meta) marshal(segment *SegmentInfo) (string, string, error) {
 	return key, string(segBytes), nil
 }
 
-// saveSegmentInfo utility function saving segment info into kv store
+// saveSegmentInfo utility function saving segment info into kv store, the version of segment is incremented
 func (m *meta) saveSegmentInfo(segment *SegmentInfo) error {
+	kvs := make(map[string]string)
+	if err := m.segmentInfoKvs(segment, kvs); err != nil {
+		return err
+	}
+	return m.client.MultiSave(kvs)
+}
+
+// segmentInfoKvs increments the version of segment and puts the kv pairs saving it into kvs
+func (m *meta) segmentInfoKvs(segment *SegmentInfo, kvs map[string]string) error {
+	segment.Version++
 	segBytes, err := proto.Marshal(segment.SegmentInfo)
 	if err != nil {
 		log.Error("DataCoord saveSegmentInfo marshal failed", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
 		return fmt.Errorf("DataCoord saveSegmentInfo segmentID:%d, marshal failed:%w", segment.GetID(), err)
 	}
-	kvs := make(map[string]string)
 	dataKey := buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
 	kvs[dataKey] = string(segBytes)
 	if segment.State == commonpb.SegmentState_Flushed {
@@ -737,8 +978,7 @@ func (m *meta) saveSegmentInfo(segment *SegmentInfo) error {
 		queryKey := buildQuerySegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
 		kvs[queryKey] = string(handoffSegBytes)
 	}
-
-	return m.client.MultiSave(kvs)
+	return nil
 }
 
 // removeSegmentInfo utility function removing segment info from kv store
@@ -780,3 +1020,48 @@ func isSegmentHealthy(segment *SegmentInfo) bool {
 	return segment.GetState() != commonpb.SegmentState_NotExist &&
 		segment.GetState() != commonpb.SegmentState_Dropped
 }
+
+// mergeFieldBinlogs appends the paths of added to the binlogs of the same field in curr, paths already in curr are skipped
+func mergeFieldBinlogs(curr, added []*datapb.FieldBinlog) []*datapb.FieldBinlog {
+	for _, tBinlogs := range added {
+		var fieldBinlogs *datapb.FieldBinlog
+		for _, binlog := range curr {
+			if binlog.GetFieldID() == tBinlogs.GetFieldID() {
+				fieldBinlogs = binlog
+				break
+			}
+		}
+		if fieldBinlogs == nil {
+			fieldBinlogs = &datapb.FieldBinlog{FieldID: tBinlogs.GetFieldID()}
+			curr = append(curr, fieldBinlogs)
+		}
+		existed := make(map[string]struct{}, len(fieldBinlogs.GetBinlogs()))
+		for _, path := range fieldBinlogs.GetBinlogs() {
+			existed[path] = struct{}{}
+		}
+		for _, path := range tBinlogs.GetBinlogs() {
+			if _, ok := existed[path]; ok {
+				continue
+			}
+			existed[path] = struct{}{}
+			fieldBinlogs.Binlogs = append(fieldBinlogs.Binlogs, path)
+		}
+	}
+	return curr
+}
+
+// mergeDeltalogs appends the delta logs of added to curr, delta logs with path already in curr are skipped
+func mergeDeltalogs(curr, added []*datapb.DeltaLogInfo) []*datapb.DeltaLogInfo {
+	existed := make(map[string]struct{}, len(curr))
+	for _, deltalog := range curr {
+		existed[deltalog.GetDeltaLogPath()] = struct{}{}
+	}
+	for _, deltalog := range added {
+		if _, ok := existed[deltalog.GetDeltaLogPath()]; ok {
+			continue
+		}
+		existed[deltalog.GetDeltaLogPath()] = struct{}{}
+		curr = append(curr, deltalog)
+	}
+	return curr
+}
@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
 	"strconv"
 	"testing"
@@ -241,10 +242,11 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		err = meta.AddSegment(segment1)
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, true, false, []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"binlog1"}}},
+		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"binlog1"}}},
 			[]*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"statslog1"}}},
+			[]*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"sketchlog1"}}},
 			[]*datapb.DeltaLogInfo{{RecordEntries: 1, TimestampFrom: 100, TimestampTo: 200, DeltaLogSize: 1000}},
-			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
+			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}}, 0)
 		assert.Nil(t, err)
 
 		updated := meta.GetSegment(1)
@@ -253,16 +255,74 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 			StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}},
 			Binlogs:       []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"binlog0", "binlog1"}}},
 			Statslogs:     []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"statslog0", "statslog1"}}},
+			Sketchlogs:    []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"sketchlog1"}}},
 			Deltalogs:     []*datapb.DeltaLogInfo{{RecordEntries: 1, TimestampFrom: 100, TimestampTo: 200, DeltaLogSize: 1000}},
+			Version:       2,
 		}}
 		assert.True(t, proto.Equal(expected, updated))
 	})
 
+	t.Run("duplicate paths", func(t *testing.T) {
+		meta, err := newMeta(memkv.NewMemoryKV())
+		assert.Nil(t, err)
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"binlog0"}}}}}
+		err = meta.AddSegment(segment1)
+		assert.Nil(t, err)
+
+		// paths recorded by a partial write are not added again by the retry
+		for i := 0; i < 2; i++ {
+			err = meta.UpdateFlushSegmentsInfo(1, false, false, false,
+				[]*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"binlog0", "binlog1", "binlog1"}}, {FieldID: 2, Binlogs: []string{"binlog2", "binlog2"}}},
+				[]*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"statslog1"}}},
+				[]*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"sketchlog1"}}},
+				[]*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog1"}, {DeltaLogPath: "deltalog1"}},
+				nil, nil, 0)
+			assert.Nil(t, err)
+		}
+
+		updated := meta.GetSegment(1)
+		expected := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID: 1, State: commonpb.SegmentState_Growing,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []string{"binlog0", "binlog1"}},
+				{FieldID: 2, Binlogs: []string{"binlog2"}},
+			},
+			Statslogs:  []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"statslog1"}}},
+			Sketchlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"sketchlog1"}}},
+			Deltalogs:  []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog1"}},
+			Version:    3,
+		}}
+		assert.True(t, proto.Equal(expected, updated))
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		meta, err := newMeta(memkv.NewMemoryKV())
+		assert.Nil(t, err)
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing}}
+		err = meta.AddSegment(segment1)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, meta.GetSegment(1).GetVersion())
+
+		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, nil, nil, nil, nil, nil, nil, 2)
+		var mismatch *segmentVersionMismatchError
+		assert.True(t, errors.As(err, &mismatch))
+		assert.EqualValues(t, 1, mismatch.current)
+		assert.Equal(t, commonpb.SegmentState_Growing, meta.GetSegment(1).GetState())
+
+		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, nil, nil, nil, nil, nil, nil, 1)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 2, meta.GetSegment(1).GetVersion())
+		assert.Equal(t, commonpb.SegmentState_Flushing, meta.GetSegment(1).GetState())
+	})
+
 	t.Run("update non-existed segment", func(t *testing.T) {
 		meta, err := newMeta(memkv.NewMemoryKV())
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, false, false, nil, nil, nil, nil, nil)
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, nil, nil, nil, 0)
 		assert.Nil(t, err)
 	})
 
@@ -274,13 +334,48 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		err = meta.AddSegment(segment1)
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, false, false, nil, nil, nil, []*datapb.CheckPoint{{SegmentID: 2, NumOfRows: 10}},
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, nil, []*datapb.CheckPoint{{SegmentID: 2, NumOfRows: 10}},
 
-			[]*datapb.SegmentStartPosition{{SegmentID: 2, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
+			[]*datapb.SegmentStartPosition{{SegmentID: 2, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}}, 0)
 		assert.Nil(t, err)
 		assert.Nil(t, meta.GetSegment(2))
 	})
 
+	t.Run("rebuilt binlogs replace recorded ones", func(t *testing.T) {
+		meta, err := newMeta(memkv.NewMemoryKV())
+		assert.Nil(t, err)
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing,
+			Binlogs:     []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"binlog0"}}},
+			Statslogs:   []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"statslog0"}}},
+			Deltalogs:   []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog0"}},
+			DmlPosition: &internalpb.MsgPosition{Timestamp: 200}, NumOfRows: 20}}
+		err = meta.AddSegment(segment1)
+		assert.Nil(t, err)
+
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, true,
+			[]*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"binlog1"}}, {FieldID: 2, Binlogs: []string{"binlog2"}}},
+			[]*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"statslog1"}}}, nil, nil,
+			[]*datapb.CheckPoint{{SegmentID: 1, Position: &internalpb.MsgPosition{Timestamp: 100}, NumOfRows: 5}}, nil, 0)
+		assert.Nil(t, err)
+
+		updated := meta.GetSegment(1)
+		expected := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID: 1, State: commonpb.SegmentState_Growing,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []string{"binlog1"}},
+				{FieldID: 2, Binlogs: []string{"binlog2"}},
+			},
+			Statslogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"statslog1"}}},
+			Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog0"}},
+			// checkpoint moves backward to the replayed position
+			DmlPosition: &internalpb.MsgPosition{Timestamp: 100},
+			NumOfRows:   5,
+			Version:     2,
+		}}
+		assert.True(t, proto.Equal(expected, updated))
+	})
+
 	t.Run("test save etcd failed", func(t *testing.T) {
 		kv := memkv.NewMemoryKV()
 		failedKv := &saveFailKV{kv}
@@ -296,10 +391,11 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		}
 		meta.segments.SetSegment(1, segmentInfo)
 
-		err = meta.UpdateFlushSegmentsInfo(1, true, false, []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"binlog"}}},
+		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"binlog"}}},
 			[]*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"statslog"}}},
+			nil,
 			[]*datapb.DeltaLogInfo{{RecordEntries: 1, TimestampFrom: 100, TimestampTo: 200, DeltaLogSize: 1000}},
-			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
+			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}}, 0)
 		assert.NotNil(t, err)
 		assert.Equal(t, "mocked fail", err.Error())
 		segmentInfo = meta.GetSegment(1)
@@ -333,6 +429,65 @@ func TestSaveHandoffMeta(t *testing.T) {
 	assert.Equal(t, 100, int(segmentID))
 }
 
+func Test_meta_unreferencedBinlogs(t *testing.T) {
+	memoryKV := memkv.NewMemoryKV()
+	m, err := newMeta(memoryKV)
+	assert.NoError(t, err)
+	err = m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:        1,
+		Binlogs:   []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"binlog1"}}},
+		Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog1"}},
+	}))
+	assert.NoError(t, err)
+	// saved in kv but not applied in memory
+	value, err := proto.Marshal(&datapb.SegmentInfo{
+		ID:        2,
+		Statslogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"statslog2"}}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, memoryKV.Save(buildSegmentPath(0, 0, 2), string(value)))
+
+	paths, err := m.unreferencedBinlogs([]string{"binlog1", "deltalog1", "statslog2", "binlog3"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"binlog3"}, paths)
+
+	m.client = &loadPrefixFailKV{TxnKV: memoryKV}
+	_, err = m.unreferencedBinlogs([]string{"binlog3"})
+	assert.Error(t, err)
+}
+
+func Test_meta_AddSegments(t *testing.T) {
+	newSegments := func() []*SegmentInfo {
+		return []*SegmentInfo{
+			NewSegmentInfo(&datapb.SegmentInfo{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Flushed}),
+			NewSegmentInfo(&datapb.SegmentInfo{ID: 2, CollectionID: 1, State: commonpb.SegmentState_Flushed}),
+		}
+	}
+
+	t.Run("all added", func(t *testing.T) {
+		meta, err := newMeta(memkv.NewMemoryKV())
+		assert.Nil(t, err)
+		assert.Nil(t, meta.AddSegments(newSegments()))
+		assert.NotNil(t, meta.GetSegment(1))
+		assert.NotNil(t, meta.GetSegment(2))
+
+		reloaded, err := newMeta(meta.client)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(reloaded.GetSegmentsOfCollection(1)))
+		keys, _, err := meta.client.LoadWithPrefix(handoffSegmentPrefix)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(keys))
+	})
+
+	t.Run("none added if save failed", func(t *testing.T) {
+		meta, err := newMeta(&saveFailKV{TxnKV: memkv.NewMemoryKV()})
+		assert.Nil(t, err)
+		assert.NotNil(t, meta.AddSegments(newSegments()))
+		assert.Nil(t, meta.GetSegment(1))
+		assert.Nil(t, meta.GetSegment(2))
+	})
+}
+
 func Test_meta_CompleteMergeCompaction(t *testing.T) {
 	type fields struct {
 		client      kv.TxnKV
@@ -541,6 +696,78 @@ func Test_meta_SetSegmentCompacting(t *testing.T) {
 	}
 }
 
+func Test_meta_SetSegmentsPinned(t *testing.T) {
+	m, err := newMeta(memkv.NewMemoryKV())
+	assert.Nil(t, err)
+	assert.Nil(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Flushed})))
+	assert.Nil(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 2, CollectionID: 1, State: commonpb.SegmentState_Flushed})))
+	assert.Nil(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 3, CollectionID: 2, State: commonpb.SegmentState_Flushed})))
+
+	err = m.SetSegmentsPinned(1, []UniqueID{1, 2}, true)
+	assert.Nil(t, err)
+	assert.True(t, m.GetSegment(1).GetPinned())
+	assert.True(t, m.GetSegment(2).GetPinned())
+
+	// pinned flag is persisted
+	reloaded, err := newMeta(m.client)
+	assert.Nil(t, err)
+	assert.True(t, reloaded.GetSegment(1).GetPinned())
+
+	// segment of another collection fails the whole request
+	err = m.SetSegmentsPinned(1, []UniqueID{1, 3}, false)
+	assert.NotNil(t, err)
+	assert.True(t, m.GetSegment(1).GetPinned())
+	assert.False(t, m.GetSegment(3).GetPinned())
+
+	err = m.SetSegmentsPinned(1, []UniqueID{1}, false)
+	assert.Nil(t, err)
+	assert.False(t, m.GetSegment(1).GetPinned())
+	assert.True(t, m.GetSegment(2).GetPinned())
+}
+
+func Test_meta_ListCollectionIDs(t *testing.T) {
+	m, err := newMeta(memkv.NewMemoryKV())
+	assert.Nil(t, err)
+	assert.Empty(t, m.ListCollectionIDs())
+
+	m.AddCollection(&datapb.CollectionInfo{ID: 3})
+	assert.Nil(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, CollectionID: 2, State: commonpb.SegmentState_Flushed})))
+	assert.Nil(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 2, CollectionID: 3, State: commonpb.SegmentState_Growing})))
+	assert.Nil(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 3, CollectionID: 1, State: commonpb.SegmentState_Dropped})))
+	assert.Equal(t, []UniqueID{1, 2, 3}, m.ListCollectionIDs())
+}
+
+func Test_meta_GetBinlogCountOfCollection(t *testing.T) {
+	m, err := newMeta(memkv.NewMemoryKV())
+	assert.Nil(t, err)
+	assert.EqualValues(t, 0, m.GetBinlogCountOfCollection(1))
+
+	assert.Nil(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           1,
+		CollectionID: 1,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs:      []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"b1", "b2"}}, {FieldID: 101, Binlogs: []string{"b3"}}},
+		Statslogs:    []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"s1"}}},
+		Deltalogs:    []*datapb.DeltaLogInfo{{DeltaLogPath: "d1"}},
+		Sketchlogs:   []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"k1"}}},
+	})))
+	// dropped segments and segments of other collections are not counted
+	assert.Nil(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           2,
+		CollectionID: 1,
+		State:        commonpb.SegmentState_Dropped,
+		Binlogs:      []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"b4"}}},
+	})))
+	assert.Nil(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           3,
+		CollectionID: 2,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs:      []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"b5"}}},
+	})))
+	assert.EqualValues(t, 6, m.GetBinlogCountOfCollection(1))
+	assert.EqualValues(t, 1, m.GetBinlogCountOfCollection(2))
+}
+
 func Test_meta_GetSegmentsOfCollection(t *testing.T) {
 	type fields struct {
 		segments *SegmentsInfo
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"os"
+	"sort"
 
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
@@ -57,6 +58,7 @@ func (s *Server) getSystemInfoMetrics(
 			log.Warn("fails to get datanode metrics", zap.Error(err))
 			continue
 		}
+		infos.ChannelErrors = s.nodeHealth.errors(node.info.NodeID)
 		clusterTopology.ConnectedNodes = append(clusterTopology.ConnectedNodes, infos)
 	}
 
@@ -112,9 +114,25 @@ func (s *Server) getDataCoordMetrics() metricsinfo.DataCoordInfos {
 			ID:          s.session.ServerID,
 		},
 		SystemConfigurations: metricsinfo.DataCoordConfiguration{
-			SegmentMaxSize: Params.SegmentMaxSize,
+			SegmentMaxSize: s.getSegmentMaxSize(),
 		},
+		CompactionOverview: metricsinfo.DataCoordCompactionOverview{
+			PinnedSegments: s.getPinnedSegmentIDs(),
+		},
+	}
+}
+
+// getPinnedSegmentIDs returns the sorted IDs of healthy pinned segments
+func (s *Server) getPinnedSegmentIDs() []int64 {
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) && segment.GetPinned()
+	})
+	ids := make([]int64, 0, len(segments))
+	for _, segment := range segments {
+		ids = append(ids, segment.GetID())
 	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
 }
 
 // getDataNodeMetrics composes data node infos
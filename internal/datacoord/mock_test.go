@@ -86,6 +86,20 @@ func (kv *saveFailKV) MultiSave(kvs map[string]string) error {
 	return errors.New("mocked fail")
 }
 
+// a mock kv that fails the first `MultiSave` calls
+type flakySaveKV struct {
+	kv.TxnKV
+	failures int
+}
+
+func (kv *flakySaveKV) MultiSave(kvs map[string]string) error {
+	if kv.failures > 0 {
+		kv.failures--
+		return errors.New("mocked fail")
+	}
+	return kv.TxnKV.MultiSave(kvs)
+}
+
 // a mock kv that always fail when do `Remove`
 type removeFailKV struct{ kv.TxnKV }
 
@@ -200,6 +214,38 @@ func (c *mockDataNodeClient) Compaction(ctx context.Context, req *datapb.Compact
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "not implemented"}, nil
 }
 
+func (c *mockDataNodeClient) FlushAll(ctx context.Context, req *datapb.FlushAllRequest) (*datapb.FlushAllResponse, error) {
+	return &datapb.FlushAllResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (c *mockDataNodeClient) SampleSegment(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	if c.ch != nil {
+		c.ch <- struct{}{}
+	}
+	return &datapb.SampleSegmentResponse{
+		Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		SegmentID:   req.GetSegmentID(),
+		NumRows:     100,
+		SampledRows: int64(req.GetSampleRate() * 100),
+	}, nil
+}
+
+// CancelCompaction stops plans at once, the output segment of a plan shares the plan id
+func (c *mockDataNodeClient) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	if c.ch != nil {
+		c.ch <- req
+	}
+	return &datapb.CancelCompactionResponse{
+		Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		State:     datapb.CompactionPlanState_PlanCancelled,
+		SegmentID: req.GetPlanID(),
+	}, nil
+}
+
+func (c *mockDataNodeClient) RestoreSegmentFromSnapshot(ctx context.Context, req *datapb.RestoreSegmentRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (c *mockDataNodeClient) Stop() error {
 	c.state = internalpb.StateCode_Abnormal
 	return nil
@@ -531,6 +577,36 @@ func (h *mockCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*
 	panic("not implemented")
 }
 
+// get all compaction tasks
+func (h *mockCompactionHandler) getCompactionTasks() []*compactionTask {
+	if f, ok := h.methods["getCompactionTasks"]; ok {
+		if ff, ok := f.(func() []*compactionTask); ok {
+			return ff()
+		}
+	}
+	panic("not implemented")
+}
+
+// reclaimCompaction marks a failed compaction as reclaimed
+func (h *mockCompactionHandler) reclaimCompaction(planID int64) error {
+	if f, ok := h.methods["reclaimCompaction"]; ok {
+		if ff, ok := f.(func(planID int64) error); ok {
+			return ff(planID)
+		}
+	}
+	panic("not implemented")
+}
+
+// cancelCompaction marks an executing compaction as cancelled
+func (h *mockCompactionHandler) cancelCompaction(planID int64) (*compactionTask, error) {
+	if f, ok := h.methods["cancelCompaction"]; ok {
+		if ff, ok := f.(func(planID int64) (*compactionTask, error)); ok {
+			return ff(planID)
+		}
+	}
+	panic("not implemented")
+}
+
 type mockCompactionTrigger struct {
 	methods map[string]interface{}
 }
@@ -565,6 +641,67 @@ func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64, tt *t
 	panic("not implemented")
 }
 
+// dryRunForceCompaction returns the plans forceTriggerCompaction would execute without executing them
+func (t *mockCompactionTrigger) dryRunForceCompaction(collectionID int64, tt *timetravel) []*datapb.CompactionPlan {
+	if f, ok := t.methods["dryRunForceCompaction"]; ok {
+		if ff, ok := f.(func(collectionID int64, tt *timetravel) []*datapb.CompactionPlan); ok {
+			return ff(collectionID, tt)
+		}
+	}
+	panic("not implemented")
+}
+
+// getScoreCards explains whether the segment would be selected by each compaction policy
+func (t *mockCompactionTrigger) getScoreCards(segment *SegmentInfo, tt *timetravel) []*datapb.CompactionScoreCard {
+	if f, ok := t.methods["getScoreCards"]; ok {
+		if ff, ok := f.(func(segment *SegmentInfo, tt *timetravel) []*datapb.CompactionScoreCard); ok {
+			return ff(segment, tt)
+		}
+	}
+	panic("not implemented")
+}
+
+// getChannelSegmentStats summarizes the segments of the channel and ranks the ones eligible for compaction
+func (t *mockCompactionTrigger) getChannelSegmentStats(channel string, tt *timetravel, now time.Time, topN int) *datapb.GetChannelSegmentStatsResponse {
+	if f, ok := t.methods["getChannelSegmentStats"]; ok {
+		if ff, ok := f.(func(channel string, tt *timetravel, now time.Time, topN int) *datapb.GetChannelSegmentStatsResponse); ok {
+			return ff(channel, tt, now, topN)
+		}
+	}
+	panic("not implemented")
+}
+
+// pause stops dispatching new plans until resume is called
+func (t *mockCompactionTrigger) pause(reason string) {
+	if f, ok := t.methods["pause"]; ok {
+		if ff, ok := f.(func(reason string)); ok {
+			ff(reason)
+			return
+		}
+	}
+	panic("not implemented")
+}
+
+// resume dispatches new plans again, returns false if not paused
+func (t *mockCompactionTrigger) resume() bool {
+	if f, ok := t.methods["resume"]; ok {
+		if ff, ok := f.(func() bool); ok {
+			return ff()
+		}
+	}
+	panic("not implemented")
+}
+
+// isPaused returns whether compaction is paused and the reason of pause
+func (t *mockCompactionTrigger) isPaused() (bool, string) {
+	if f, ok := t.methods["isPaused"]; ok {
+		if ff, ok := f.(func() (bool, string)); ok {
+			return ff()
+		}
+	}
+	return false, ""
+}
+
 func (t *mockCompactionTrigger) start() {
 	if f, ok := t.methods["start"]; ok {
 		if ff, ok := f.(func()); ok {
@@ -65,6 +65,28 @@ type ParamTable struct {
 	SegmentMaxSize          float64
 	SegmentSealProportion   float64
 	SegAssignmentExpiration int64
+	MinSegmentRowCount      int64
+	FingerprintBloomSize    uint
+
+	// --- Adaptive segment size ---
+	EnableAdaptiveSegmentSize     bool
+	CompactionEfficiencyThreshold float64
+	AdaptiveSegmentSizeRounds     int
+	AdaptiveSegmentSizeStep       float64
+	AdaptiveSegmentSizeInterval   int64
+	MinSegmentMaxSize             float64
+	MaxSegmentMaxSize             float64
+
+	// --- Rate limit ---
+	AssignSegmentRatePerSec float64
+	AssignSegmentBurstSize  int
+	EnableRedisRateLimiter  bool
+	RedisAddress            string
+
+	// --- Binlog growth rate ---
+	StatsCollectionIntervalSeconds int64
+	BinlogGrowthRateWindowSeconds  int64
+	BinlogGrowthRateAlertThreshold float64
 
 	// --- Channels ---
 	ClusterChannelPrefix      string
@@ -81,6 +103,46 @@ type ParamTable struct {
 	EnableGarbageCollection bool
 
 	CompactionRetentionDuration int64
+	EnableFairCompactionQueue   bool
+	SmallSegmentMergeInterval   int64
+	CompactionPolicy            string
+	CompactionLevelMultiplier   int64
+
+	StorageAuditListRatePerSec int64
+	// prefix of the temporary binlogs written by DataNode flushes, all of them are orphans to the storage audit
+	BinlogTempPathPrefix string
+
+	SampleCacheTTLSeconds int64
+
+	SegmentLeaseDuration int64
+
+	StorageUsageCacheTTLSeconds int64
+	StorageUsageTopN            int64
+
+	GCLogPath        string
+	GCEventMaxReturn int64
+
+	MaxCompactionHistoryPerCollection int64
+	CompactionROICacheTTLSeconds      int64
+
+	RetentionScanIntervalSeconds int64
+
+	EnableBlobStorageAuth     bool
+	BlobStorageAuthTTLSeconds int64
+
+	AdminToken           string
+	ReadSegmentBatchSize int64
+
+	ImportManifestHosts          []string
+	ImportManifestTimeoutSeconds int64
+
+	DashboardRefreshIntervalSeconds int64
+	DashboardTopN                   int64
+
+	// Comma separated collectionID/fieldID:keyID of the fields whose insert binlogs are encrypted with the column key
+	ColumnEncryptionFieldKeys string
+	// Comma separated keyID:base64 encoded AES-256 key of the column keys
+	ColumnEncryptionKeys string
 }
 
 // Params is a package scoped variable of type ParamTable.
@@ -107,6 +169,24 @@ func (p *ParamTable) Init() {
 	p.initSegmentMaxSize()
 	p.initSegmentSealProportion()
 	p.initSegAssignmentExpiration()
+	p.initMinSegmentRowCount()
+	p.initFingerprintBloomSize()
+	p.initEnableAdaptiveSegmentSize()
+	p.initCompactionEfficiencyThreshold()
+	p.initAdaptiveSegmentSizeRounds()
+	p.initAdaptiveSegmentSizeStep()
+	p.initAdaptiveSegmentSizeInterval()
+	p.initMinSegmentMaxSize()
+	p.initMaxSegmentMaxSize()
+
+	p.initAssignSegmentRatePerSec()
+	p.initAssignSegmentBurstSize()
+	p.initEnableRedisRateLimiter()
+	p.initRedisAddress()
+
+	p.initStatsCollectionIntervalSeconds()
+	p.initBinlogGrowthRateWindowSeconds()
+	p.initBinlogGrowthRateAlertThreshold()
 
 	// Has to init global msgchannel prefix before other channel names
 	p.initClusterMsgChannelPrefix()
@@ -130,6 +210,40 @@ func (p *ParamTable) Init() {
 	p.initMinioRootPath()
 
 	p.initCompactionRetentionDuration()
+	p.initEnableFairCompactionQueue()
+	p.initSmallSegmentMergeInterval()
+	p.initCompactionPolicy()
+	p.initCompactionLevelMultiplier()
+
+	p.initStorageAuditListRatePerSec()
+	p.initBinlogTempPathPrefix()
+	p.initSampleCacheTTLSeconds()
+	p.initSegmentLeaseDuration()
+
+	p.initStorageUsageCacheTTLSeconds()
+	p.initStorageUsageTopN()
+
+	p.initGCLogPath()
+	p.initGCEventMaxReturn()
+
+	p.initMaxCompactionHistoryPerCollection()
+	p.initCompactionROICacheTTLSeconds()
+
+	p.initRetentionScanIntervalSeconds()
+
+	p.initEnableBlobStorageAuth()
+	p.initBlobStorageAuthTTLSeconds()
+
+	p.initAdminToken()
+	p.initReadSegmentBatchSize()
+	p.initImportManifestHosts()
+	p.initImportManifestTimeoutSeconds()
+
+	p.initDashboardRefreshIntervalSeconds()
+	p.initDashboardTopN()
+
+	p.initColumnEncryptionFieldKeys()
+	p.initColumnEncryptionKeys()
 }
 
 // InitOnce ensures param table is a singleton
@@ -215,6 +329,70 @@ func (p *ParamTable) initSegAssignmentExpiration() {
 	p.SegAssignmentExpiration = p.ParseInt64WithDefault("dataCoord.segment.assignmentExpiration", 2000)
 }
 
+func (p *ParamTable) initMinSegmentRowCount() {
+	p.MinSegmentRowCount = p.ParseInt64WithDefault("dataCoord.segment.minRowCount", 0)
+}
+
+func (p *ParamTable) initFingerprintBloomSize() {
+	p.FingerprintBloomSize = uint(p.ParseInt64WithDefault("dataCoord.segment.fingerprintBloomSize", 8388608))
+}
+
+func (p *ParamTable) initEnableAdaptiveSegmentSize() {
+	p.EnableAdaptiveSegmentSize = p.ParseBool("dataCoord.segment.adaptive.enabled", false)
+}
+
+func (p *ParamTable) initCompactionEfficiencyThreshold() {
+	p.CompactionEfficiencyThreshold = p.ParseFloatWithDefault("dataCoord.segment.adaptive.compactionEfficiencyThreshold", 0.9)
+}
+
+func (p *ParamTable) initAdaptiveSegmentSizeRounds() {
+	p.AdaptiveSegmentSizeRounds = p.ParseIntWithDefault("dataCoord.segment.adaptive.rounds", 3)
+}
+
+func (p *ParamTable) initAdaptiveSegmentSizeStep() {
+	p.AdaptiveSegmentSizeStep = p.ParseFloatWithDefault("dataCoord.segment.adaptive.step", 64)
+}
+
+func (p *ParamTable) initAdaptiveSegmentSizeInterval() {
+	p.AdaptiveSegmentSizeInterval = p.ParseInt64WithDefault("dataCoord.segment.adaptive.interval", 600)
+}
+
+func (p *ParamTable) initMinSegmentMaxSize() {
+	p.MinSegmentMaxSize = p.ParseFloatWithDefault("dataCoord.segment.adaptive.minMaxSize", 128)
+}
+
+func (p *ParamTable) initMaxSegmentMaxSize() {
+	p.MaxSegmentMaxSize = p.ParseFloatWithDefault("dataCoord.segment.adaptive.maxMaxSize", 2048)
+}
+
+func (p *ParamTable) initStatsCollectionIntervalSeconds() {
+	p.StatsCollectionIntervalSeconds = p.ParseInt64WithDefault("dataCoord.binlogGrowthRate.collectionInterval", 60)
+}
+
+func (p *ParamTable) initBinlogGrowthRateWindowSeconds() {
+	p.BinlogGrowthRateWindowSeconds = p.ParseInt64WithDefault("dataCoord.binlogGrowthRate.window", 600)
+}
+
+func (p *ParamTable) initBinlogGrowthRateAlertThreshold() {
+	p.BinlogGrowthRateAlertThreshold = p.ParseFloatWithDefault("dataCoord.binlogGrowthRate.alertThreshold", 0)
+}
+
+func (p *ParamTable) initAssignSegmentRatePerSec() {
+	p.AssignSegmentRatePerSec = p.ParseFloatWithDefault("dataCoord.assignRateLimit.maxRatePerSec", 0)
+}
+
+func (p *ParamTable) initAssignSegmentBurstSize() {
+	p.AssignSegmentBurstSize = p.ParseIntWithDefault("dataCoord.assignRateLimit.burstSize", 100)
+}
+
+func (p *ParamTable) initEnableRedisRateLimiter() {
+	p.EnableRedisRateLimiter = p.ParseBool("dataCoord.assignRateLimit.enableRedis", false)
+}
+
+func (p *ParamTable) initRedisAddress() {
+	p.RedisAddress = p.LoadWithDefault("dataCoord.assignRateLimit.redisAddress", "localhost:6379")
+}
+
 func (p *ParamTable) initClusterMsgChannelPrefix() {
 	config, err := p.Load("msgChannel.chanNamePrefix.cluster")
 	if err != nil {
@@ -354,3 +532,119 @@ func (p *ParamTable) initMinioRootPath() {
 func (p *ParamTable) initCompactionRetentionDuration() {
 	p.CompactionRetentionDuration = p.ParseInt64WithDefault("dataCoord.compaction.retentionDuration", 432000)
 }
+
+// initMaxCompactionHistoryPerCollection loads the limit of compaction history records per collection,
+// the oldest records exceeding it are removed, non-positive value means unlimited
+func (p *ParamTable) initMaxCompactionHistoryPerCollection() {
+	p.MaxCompactionHistoryPerCollection = p.ParseInt64WithDefault("dataCoord.compaction.maxHistoryPerCollection", 1000)
+}
+
+func (p *ParamTable) initCompactionROICacheTTLSeconds() {
+	p.CompactionROICacheTTLSeconds = p.ParseInt64WithDefault("dataCoord.compaction.roiCacheTTL", 60)
+}
+
+func (p *ParamTable) initRetentionScanIntervalSeconds() {
+	p.RetentionScanIntervalSeconds = p.ParseInt64WithDefault("dataCoord.retention.scanInterval", 600)
+}
+
+func (p *ParamTable) initEnableBlobStorageAuth() {
+	p.EnableBlobStorageAuth = p.ParseBool("dataCoord.blobStorageAuth.enabled", false)
+}
+
+// maxBlobStorageAuthTTLSeconds is the longest validity of presigned urls allowed by S3
+const maxBlobStorageAuthTTLSeconds = 7 * 24 * 3600
+
+func (p *ParamTable) initBlobStorageAuthTTLSeconds() {
+	p.BlobStorageAuthTTLSeconds = p.ParseInt64WithDefault("dataCoord.blobStorageAuth.ttl", 3600)
+	if p.BlobStorageAuthTTLSeconds > maxBlobStorageAuthTTLSeconds {
+		p.BlobStorageAuthTTLSeconds = maxBlobStorageAuthTTLSeconds
+	}
+	if p.BlobStorageAuthTTLSeconds < 1 {
+		p.BlobStorageAuthTTLSeconds = 1
+	}
+}
+
+func (p *ParamTable) initAdminToken() {
+	p.AdminToken = p.LoadWithDefault("dataCoord.admin.token", "")
+}
+
+func (p *ParamTable) initReadSegmentBatchSize() {
+	p.ReadSegmentBatchSize = p.ParseInt64WithDefault("dataCoord.readSegment.batchSize", 1000)
+}
+
+func (p *ParamTable) initImportManifestHosts() {
+	p.ImportManifestHosts = nil
+	for _, host := range strings.Split(p.LoadWithDefault("dataCoord.import.manifestHosts", ""), ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			p.ImportManifestHosts = append(p.ImportManifestHosts, host)
+		}
+	}
+}
+
+func (p *ParamTable) initImportManifestTimeoutSeconds() {
+	p.ImportManifestTimeoutSeconds = p.ParseInt64WithDefault("dataCoord.import.manifestTimeout", 30)
+}
+
+func (p *ParamTable) initDashboardRefreshIntervalSeconds() {
+	p.DashboardRefreshIntervalSeconds = p.ParseInt64WithDefault("dataCoord.dashboard.refreshInterval", 10)
+}
+
+func (p *ParamTable) initDashboardTopN() {
+	p.DashboardTopN = p.ParseInt64WithDefault("dataCoord.dashboard.topN", 10)
+}
+
+func (p *ParamTable) initColumnEncryptionFieldKeys() {
+	p.ColumnEncryptionFieldKeys = p.LoadWithDefault("encryption.fieldKeys", "")
+}
+
+func (p *ParamTable) initColumnEncryptionKeys() {
+	p.ColumnEncryptionKeys = p.LoadWithDefault("encryption.keys", "")
+}
+
+func (p *ParamTable) initEnableFairCompactionQueue() {
+	p.EnableFairCompactionQueue = p.ParseBool("dataCoord.compaction.enableFairQueue", false)
+}
+
+func (p *ParamTable) initCompactionPolicy() {
+	p.CompactionPolicy = p.LoadWithDefault("dataCoord.compaction.policy", greedyCompactionPolicyName)
+}
+
+func (p *ParamTable) initCompactionLevelMultiplier() {
+	p.CompactionLevelMultiplier = p.ParseInt64WithDefault("dataCoord.compaction.levelMultiplier", 4)
+}
+
+func (p *ParamTable) initSmallSegmentMergeInterval() {
+	p.SmallSegmentMergeInterval = p.ParseInt64WithDefault("dataCoord.compaction.smallSegmentMergeInterval", 60)
+}
+
+func (p *ParamTable) initStorageAuditListRatePerSec() {
+	p.StorageAuditListRatePerSec = p.ParseInt64WithDefault("dataCoord.storageAudit.listRatePerSec", 1000)
+}
+
+func (p *ParamTable) initBinlogTempPathPrefix() {
+	p.BinlogTempPathPrefix = p.LoadWithDefault("dataNode.flush.binlogTempPathPrefix", "")
+}
+
+func (p *ParamTable) initSampleCacheTTLSeconds() {
+	p.SampleCacheTTLSeconds = p.ParseInt64WithDefault("dataCoord.sampleCacheTTL", 300)
+}
+
+func (p *ParamTable) initSegmentLeaseDuration() {
+	p.SegmentLeaseDuration = p.ParseInt64WithDefault("dataCoord.segment.leaseDuration", 0)
+}
+
+func (p *ParamTable) initStorageUsageCacheTTLSeconds() {
+	p.StorageUsageCacheTTLSeconds = p.ParseInt64WithDefault("dataCoord.storageUsage.cacheTTL", 60)
+}
+
+func (p *ParamTable) initStorageUsageTopN() {
+	p.StorageUsageTopN = p.ParseInt64WithDefault("dataCoord.storageUsage.topN", 10)
+}
+
+func (p *ParamTable) initGCLogPath() {
+	p.GCLogPath = p.LoadWithDefault("dataCoord.gc.logPath", "")
+}
+
+func (p *ParamTable) initGCEventMaxReturn() {
+	p.GCEventMaxReturn = p.ParseInt64WithDefault("dataCoord.gc.eventMaxReturn", 1000)
+}
@@ -41,4 +41,54 @@ func TestParamTable(t *testing.T) {
 	assert.Equal(t, Params.DataCoordSubscriptionName, "by-dev-dataCoord")
 	t.Logf("data coord subscription channel = %s", Params.DataCoordSubscriptionName)
 
+	assert.False(t, Params.EnableFairCompactionQueue)
+
+	assert.Equal(t, int64(0), Params.MinSegmentRowCount)
+	assert.Equal(t, uint(8388608), Params.FingerprintBloomSize)
+	assert.Equal(t, int64(60), Params.SmallSegmentMergeInterval)
+	assert.Equal(t, "greedy", Params.CompactionPolicy)
+	assert.Equal(t, int64(4), Params.CompactionLevelMultiplier)
+
+	assert.False(t, Params.EnableAdaptiveSegmentSize)
+	assert.Equal(t, 0.9, Params.CompactionEfficiencyThreshold)
+	assert.Equal(t, 3, Params.AdaptiveSegmentSizeRounds)
+	assert.Equal(t, float64(64), Params.AdaptiveSegmentSizeStep)
+	assert.Equal(t, int64(600), Params.AdaptiveSegmentSizeInterval)
+	assert.Equal(t, float64(128), Params.MinSegmentMaxSize)
+	assert.Equal(t, float64(2048), Params.MaxSegmentMaxSize)
+
+	assert.Equal(t, float64(0), Params.AssignSegmentRatePerSec)
+	assert.Equal(t, 100, Params.AssignSegmentBurstSize)
+	assert.False(t, Params.EnableRedisRateLimiter)
+	assert.Equal(t, "localhost:6379", Params.RedisAddress)
+
+	assert.Equal(t, int64(60), Params.StatsCollectionIntervalSeconds)
+	assert.Equal(t, int64(600), Params.BinlogGrowthRateWindowSeconds)
+	assert.Equal(t, float64(0), Params.BinlogGrowthRateAlertThreshold)
+
+	assert.Equal(t, "", Params.GCLogPath)
+	assert.Equal(t, int64(1000), Params.GCEventMaxReturn)
+	assert.Equal(t, int64(1000), Params.MaxCompactionHistoryPerCollection)
+	assert.Equal(t, int64(60), Params.CompactionROICacheTTLSeconds)
+	assert.Equal(t, "", Params.BinlogTempPathPrefix)
+
+	assert.Equal(t, int64(600), Params.RetentionScanIntervalSeconds)
+
+	assert.False(t, Params.EnableBlobStorageAuth)
+	assert.Equal(t, int64(3600), Params.BlobStorageAuthTTLSeconds)
+	// ttl of signed urls is clamped to 7 days
+	err := Params.Save("dataCoord.blobStorageAuth.ttl", "1000000")
+	assert.Nil(t, err)
+	Params.initBlobStorageAuthTTLSeconds()
+	assert.Equal(t, int64(maxBlobStorageAuthTTLSeconds), Params.BlobStorageAuthTTLSeconds)
+	err = Params.Save("dataCoord.blobStorageAuth.ttl", "3600")
+	assert.Nil(t, err)
+	Params.initBlobStorageAuthTTLSeconds()
+
+	assert.Equal(t, "", Params.AdminToken)
+	assert.Equal(t, int64(1000), Params.ReadSegmentBatchSize)
+
+	assert.Equal(t, int64(10), Params.DashboardRefreshIntervalSeconds)
+	assert.Equal(t, int64(10), Params.DashboardTopN)
+
 }
@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// frozenPartitionsStatsKey is the key of the frozen partition ids in the response of GetCollectionStatistics
+const frozenPartitionsStatsKey = "frozen_partitions"
+
+type frozenPartitionKey struct {
+	collectionID UniqueID
+	partitionID  UniqueID
+}
+
+// partitionFreezer keeps the partitions frozen during DDL operations, new segments of which are not allocated.
+// Segments allocated before the freeze are still written and flushed. Freezes are kept in memory only,
+// no partition is frozen after DataCoord restarts
+type partitionFreezer struct {
+	mu     sync.Mutex
+	frozen map[frozenPartitionKey]time.Time // => time the partition is unfrozen automatically, zero means never
+}
+
+func newPartitionFreezer() *partitionFreezer {
+	return &partitionFreezer{
+		frozen: make(map[frozenPartitionKey]time.Time),
+	}
+}
+
+// freeze freezes the partition until it's unfrozen, or for timeout if positive. Freezing a frozen partition
+// replaces its timeout
+func (f *partitionFreezer) freeze(collectionID, partitionID UniqueID, timeout time.Duration, now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var expireAt time.Time
+	if timeout > 0 {
+		expireAt = now.Add(timeout)
+	}
+	f.frozen[frozenPartitionKey{collectionID, partitionID}] = expireAt
+}
+
+// unfreeze unfreezes the partition, false is returned if it's not frozen
+func (f *partitionFreezer) unfreeze(collectionID, partitionID UniqueID, now time.Time) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := frozenPartitionKey{collectionID, partitionID}
+	expireAt, ok := f.frozen[key]
+	delete(f.frozen, key)
+	return ok && (expireAt.IsZero() || now.Before(expireAt))
+}
+
+// isFrozen returns whether the partition is frozen, the freeze timed out is removed meanwhile
+func (f *partitionFreezer) isFrozen(collectionID, partitionID UniqueID, now time.Time) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := frozenPartitionKey{collectionID, partitionID}
+	expireAt, ok := f.frozen[key]
+	if !ok {
+		return false
+	}
+	if !expireAt.IsZero() && !now.Before(expireAt) {
+		delete(f.frozen, key)
+		return false
+	}
+	return true
+}
+
+// frozenPartitions returns the ids of the frozen partitions of the collection in ascending order
+func (f *partitionFreezer) frozenPartitions(collectionID UniqueID, now time.Time) []UniqueID {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	partitionIDs := make([]UniqueID, 0)
+	for key, expireAt := range f.frozen {
+		if !expireAt.IsZero() && !now.Before(expireAt) {
+			delete(f.frozen, key)
+			continue
+		}
+		if key.collectionID == collectionID {
+			partitionIDs = append(partitionIDs, key.partitionID)
+		}
+	}
+	sort.Slice(partitionIDs, func(i, j int) bool { return partitionIDs[i] < partitionIDs[j] })
+	return partitionIDs
+}
@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionFreezer(t *testing.T) {
+	now := time.Now()
+	f := newPartitionFreezer()
+	assert.False(t, f.isFrozen(1, 10, now))
+
+	f.freeze(1, 10, 0, now)
+	f.freeze(1, 12, time.Minute, now)
+	f.freeze(2, 10, time.Minute, now)
+	assert.True(t, f.isFrozen(1, 10, now))
+	assert.False(t, f.isFrozen(1, 11, now))
+	assert.Equal(t, []UniqueID{10, 12}, f.frozenPartitions(1, now))
+
+	// freezes time out, except the ones without timeout
+	later := now.Add(time.Minute)
+	assert.True(t, f.isFrozen(1, 10, later))
+	assert.False(t, f.isFrozen(1, 12, later))
+	assert.Equal(t, []UniqueID{10}, f.frozenPartitions(1, later))
+	assert.Empty(t, f.frozenPartitions(2, later))
+	assert.Equal(t, 1, len(f.frozen))
+
+	// freezing again replaces the timeout
+	f.freeze(1, 10, time.Minute, now)
+	assert.False(t, f.isFrozen(1, 10, later))
+
+	f.freeze(1, 10, 0, now)
+	assert.True(t, f.unfreeze(1, 10, now))
+	assert.False(t, f.isFrozen(1, 10, now))
+	assert.False(t, f.unfreeze(1, 10, now))
+	f.freeze(1, 10, time.Minute, now)
+	assert.False(t, f.unfreeze(1, 10, later))
+}
@@ -0,0 +1,230 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+// redisRateLimitKeyPrefix is the prefix of sliding window counters kept in redis
+const redisRateLimitKeyPrefix = "datacoord-assign-rate-limit"
+
+// bucketEvictInterval is the interval token buckets of idle collections are evicted at
+const bucketEvictInterval = time.Minute
+
+// tokenBucket is a non-blocking token bucket rate limiter
+type tokenBucket struct {
+	rate   float64 // tokens refilled per second
+	burst  float64 // capacity of the bucket
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// full returns whether the bucket is refilled to its capacity at now, which is the same as a new bucket
+func (b *tokenBucket) full(now time.Time) bool {
+	return b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst
+}
+
+// take takes a token if there is one, caller must make sure no concurrent call
+func (b *tokenBucket) take(now time.Time) bool {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// windowCounter counts requests in fixed windows shared by all the DataCoord replicas
+type windowCounter interface {
+	// incr increases the counter of key by one, and returns the new value together with the value of prevKey
+	incr(ctx context.Context, key, prevKey string, expiration time.Duration) (int64, int64, error)
+}
+
+// redisWindowCounter keeps window counters in redis with INCR and EXPIRE
+type redisWindowCounter struct {
+	client *redis.Client
+}
+
+func newRedisWindowCounter(address string) *redisWindowCounter {
+	return &redisWindowCounter{
+		client: redis.NewClient(&redis.Options{Addr: address}),
+	}
+}
+
+func (c *redisWindowCounter) incr(ctx context.Context, key, prevKey string, expiration time.Duration) (int64, int64, error) {
+	var cur *redis.IntCmd
+	var prev *redis.StringCmd
+	_, err := c.client.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) error {
+		cur = pipe.Incr(key)
+		pipe.Expire(key, expiration)
+		prev = pipe.Get(prevKey)
+		return nil
+	})
+	// previous window counter may not exist
+	if err != nil && err != redis.Nil {
+		return 0, 0, err
+	}
+	prevCount, err := prev.Int64()
+	if err != nil && err != redis.Nil {
+		return 0, 0, err
+	}
+	return cur.Val(), prevCount, nil
+}
+
+func (c *redisWindowCounter) close() error {
+	return c.client.Close()
+}
+
+// slidingWindowLimiter limits requests per window of a collection across DataCoord replicas,
+// the count of the sliding window is estimated with counters of the current and the previous fixed window
+type slidingWindowLimiter struct {
+	counter windowCounter
+	limit   float64 // requests allowed per window
+	window  time.Duration
+}
+
+// allow counts the request into the window and returns whether the sliding window is within the limit
+func (l *slidingWindowLimiter) allow(ctx context.Context, collectionID UniqueID, now time.Time) (bool, error) {
+	index := now.UnixNano() / int64(l.window)
+	key := fmt.Sprintf("%s/%d/%d", redisRateLimitKeyPrefix, collectionID, index)
+	prevKey := fmt.Sprintf("%s/%d/%d", redisRateLimitKeyPrefix, collectionID, index-1)
+	cur, prev, err := l.counter.incr(ctx, key, prevKey, 2*l.window)
+	if err != nil {
+		return false, err
+	}
+	// the part of the previous window still in the sliding window
+	overlap := 1 - float64(now.UnixNano()%int64(l.window))/float64(l.window)
+	return float64(prev)*overlap+float64(cur) <= l.limit, nil
+}
+
+// assignRateLimiter limits AssignSegmentID requests per collection.
+// Requests are allowed by a local token bucket of each collection first, and the shared sliding window
+// is checked only if the local bucket is exhausted, so that replicas share the capacity left by each other.
+// Requests allowed locally are counted into the shared window as well
+type assignRateLimiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       int
+	buckets     map[UniqueID]*tokenBucket
+	lastEvicted time.Time
+	remote      *slidingWindowLimiter // nil if redis is not enabled
+}
+
+// newAssignRateLimiter creates an assignRateLimiter from Params, nil is returned if there is no limit
+func newAssignRateLimiter() *assignRateLimiter {
+	if Params.AssignSegmentRatePerSec <= 0 {
+		return nil
+	}
+	l := &assignRateLimiter{
+		rate:    Params.AssignSegmentRatePerSec,
+		burst:   Params.AssignSegmentBurstSize,
+		buckets: make(map[UniqueID]*tokenBucket),
+	}
+	if Params.EnableRedisRateLimiter {
+		l.remote = &slidingWindowLimiter{
+			counter: newRedisWindowCounter(Params.RedisAddress),
+			limit:   Params.AssignSegmentRatePerSec,
+			window:  time.Second,
+		}
+	}
+	return l
+}
+
+// allow returns whether an AssignSegmentID request of the collection is allowed
+func (l *assignRateLimiter) allow(ctx context.Context, collectionID UniqueID) bool {
+	if l == nil {
+		return true
+	}
+	now := time.Now()
+	l.mu.Lock()
+	bucket, ok := l.buckets[collectionID]
+	if !ok {
+		bucket = newTokenBucket(l.rate, l.burst)
+		l.buckets[collectionID] = bucket
+	}
+	allowed := bucket.take(now)
+	l.evictIdleBuckets(now)
+	l.mu.Unlock()
+	if l.remote == nil {
+		return allowed
+	}
+
+	sharedAllowed, err := l.remote.allow(ctx, collectionID, now)
+	if allowed {
+		if err != nil {
+			log.Warn("failed to count request allowed locally into shared rate limit",
+				zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+		return true
+	}
+	if err != nil {
+		log.Warn("failed to check shared rate limit", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return false
+	}
+	return sharedAllowed
+}
+
+// evictIdleBuckets removes the buckets refilled to their capacity every bucketEvictInterval, so that buckets of
+// collections no longer assigned are not kept forever. Caller must hold the lock
+func (l *assignRateLimiter) evictIdleBuckets(now time.Time) {
+	if now.Sub(l.lastEvicted) < bucketEvictInterval {
+		return
+	}
+	l.lastEvicted = now
+	for collectionID, bucket := range l.buckets {
+		if bucket.full(now) {
+			delete(l.buckets, collectionID)
+		}
+	}
+}
+
+func (l *assignRateLimiter) close() {
+	if l == nil || l.remote == nil {
+		return
+	}
+	if c, ok := l.remote.counter.(*redisWindowCounter); ok {
+		if err := c.close(); err != nil {
+			log.Warn("failed to close redis client", zap.Error(err))
+		}
+	}
+}
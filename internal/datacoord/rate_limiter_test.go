@@ -0,0 +1,222 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryWindowCounter is a windowCounter shared by limiters in the same process, expiration is ignored
+type memoryWindowCounter struct {
+	mu       sync.Mutex
+	counters map[string]int64
+	err      error
+}
+
+func newMemoryWindowCounter() *memoryWindowCounter {
+	return &memoryWindowCounter{counters: make(map[string]int64)}
+}
+
+func (c *memoryWindowCounter) incr(ctx context.Context, key, prevKey string, expiration time.Duration) (int64, int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return 0, 0, c.err
+	}
+	c.counters[key]++
+	return c.counters[key], c.counters[prevKey], nil
+}
+
+func TestTokenBucket_take(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(10, 2)
+	b.last = now
+	assert.True(t, b.take(now))
+	assert.True(t, b.take(now))
+	assert.False(t, b.take(now))
+
+	// one token is refilled every 100ms
+	assert.True(t, b.take(now.Add(100*time.Millisecond)))
+	assert.False(t, b.take(now.Add(100*time.Millisecond)))
+
+	// never more than burst
+	assert.True(t, b.take(now.Add(time.Hour)))
+	assert.True(t, b.take(now.Add(time.Hour)))
+	assert.False(t, b.take(now.Add(time.Hour)))
+}
+
+func TestSlidingWindowLimiter(t *testing.T) {
+	ctx := context.Background()
+	counter := newMemoryWindowCounter()
+	l := &slidingWindowLimiter{counter: counter, limit: 4, window: time.Second}
+
+	start := time.Unix(100, 0)
+	for i := 0; i < 4; i++ {
+		allowed, err := l.allow(ctx, 1, start)
+		assert.Nil(t, err)
+		assert.True(t, allowed)
+	}
+	allowed, err := l.allow(ctx, 1, start)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+
+	// other collections are not affected
+	allowed, err = l.allow(ctx, 2, start)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+
+	// half of the previous window is still in the sliding window, 5*0.5 + 1 <= 4
+	allowed, err = l.allow(ctx, 1, start.Add(1500*time.Millisecond))
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+	// 5*0.5 + 2 > 4
+	allowed, err = l.allow(ctx, 1, start.Add(1500*time.Millisecond))
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+
+	counter.err = errors.New("mock error")
+	_, err = l.allow(ctx, 1, start.Add(10*time.Second))
+	assert.NotNil(t, err)
+}
+
+func TestAssignRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	defer func(rate float64, burst int) {
+		Params.AssignSegmentRatePerSec = rate
+		Params.AssignSegmentBurstSize = burst
+	}(Params.AssignSegmentRatePerSec, Params.AssignSegmentBurstSize)
+
+	t.Run("no limit", func(t *testing.T) {
+		Params.AssignSegmentRatePerSec = 0
+		l := newAssignRateLimiter()
+		assert.Nil(t, l)
+		for i := 0; i < 100; i++ {
+			assert.True(t, l.allow(ctx, 1))
+		}
+		l.close()
+	})
+
+	t.Run("local limit", func(t *testing.T) {
+		Params.AssignSegmentRatePerSec = 0.001
+		Params.AssignSegmentBurstSize = 2
+		l := newAssignRateLimiter()
+		assert.Nil(t, l.remote)
+		assert.True(t, l.allow(ctx, 1))
+		assert.True(t, l.allow(ctx, 1))
+		assert.False(t, l.allow(ctx, 1))
+		// buckets are per collection
+		assert.True(t, l.allow(ctx, 2))
+	})
+
+	t.Run("shared limit", func(t *testing.T) {
+		counter := newMemoryWindowCounter()
+		newLimiter := func() *assignRateLimiter {
+			return &assignRateLimiter{
+				rate:    0.001,
+				burst:   1,
+				buckets: make(map[UniqueID]*tokenBucket),
+				remote:  &slidingWindowLimiter{counter: counter, limit: 4, window: time.Hour},
+			}
+		}
+		// two replicas share the counter
+		l1, l2 := newLimiter(), newLimiter()
+		// local buckets allow the first request, which is counted into the shared counter
+		assert.True(t, l1.allow(ctx, 1))
+		assert.True(t, l2.allow(ctx, 1))
+		var total int64
+		for _, count := range counter.counters {
+			total += count
+		}
+		assert.EqualValues(t, 2, total)
+
+		assert.True(t, l1.allow(ctx, 1))
+		assert.True(t, l2.allow(ctx, 1))
+		assert.False(t, l1.allow(ctx, 1))
+		assert.False(t, l2.allow(ctx, 1))
+
+		// rejected if the shared counter is not available
+		counter.err = errors.New("mock error")
+		assert.False(t, l1.allow(ctx, 1))
+		// allowed by local bucket even if it's not counted
+		assert.True(t, l2.allow(ctx, 2))
+	})
+
+	t.Run("idle buckets evicted", func(t *testing.T) {
+		l := &assignRateLimiter{
+			rate:    1,
+			burst:   10,
+			buckets: make(map[UniqueID]*tokenBucket),
+		}
+		assert.True(t, l.allow(ctx, 1))
+		assert.True(t, l.allow(ctx, 2))
+		assert.Len(t, l.buckets, 2)
+
+		// bucket of collection 1 is refilled, while collection 2 keeps being assigned
+		now := time.Now().Add(bucketEvictInterval)
+		l.buckets[2].last = now
+		l.buckets[2].tokens = 0
+		l.mu.Lock()
+		l.evictIdleBuckets(now)
+		l.mu.Unlock()
+		assert.Len(t, l.buckets, 1)
+		assert.NotNil(t, l.buckets[2])
+	})
+}
+
+// BenchmarkAssignRateLimiter measures the latency added to AssignSegmentID by the local bucket
+// and by the redis sliding window, the redis case is skipped if Params.RedisAddress is not reachable
+func BenchmarkAssignRateLimiter(b *testing.B) {
+	Params.Init()
+	ctx := context.Background()
+
+	b.Run("local", func(b *testing.B) {
+		l := &assignRateLimiter{
+			rate:    1e12,
+			burst:   1 << 30,
+			buckets: make(map[UniqueID]*tokenBucket),
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.allow(ctx, 1)
+		}
+	})
+
+	b.Run("redis", func(b *testing.B) {
+		counter := newRedisWindowCounter(Params.RedisAddress)
+		defer counter.close()
+		if err := counter.client.Ping().Err(); err != nil {
+			b.Skipf("redis is not available at %s: %v", Params.RedisAddress, err)
+		}
+		// local bucket is always exhausted, so every request goes to redis
+		l := &assignRateLimiter{
+			rate:    1e-9,
+			burst:   1,
+			buckets: map[UniqueID]*tokenBucket{1: {rate: 1e-9, burst: 1, last: time.Now()}},
+			remote:  &slidingWindowLimiter{counter: counter, limit: 1e12, window: time.Second},
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.allow(ctx, 1)
+		}
+	})
+}
@@ -0,0 +1,281 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// resourceQuotaPrefix is the kv prefix where resource quotas of DataNodes are persisted
+const resourceQuotaPrefix = "datanode-resource-quota"
+
+// ResourceQuota limits the resources assigned to a DataNode, non-positive value means unlimited
+type ResourceQuota struct {
+	MaxSegments int64 `json:"maxSegments"`
+	MaxChannels int64 `json:"maxChannels"`
+}
+
+// nodeLoad is the resources assigned to a DataNode
+type nodeLoad struct {
+	nodeID   int64
+	channels int64
+	segments int64
+}
+
+// admits returns whether one more channel can be assigned to the node with the load
+func (q *ResourceQuota) admits(load *nodeLoad) bool {
+	if q == nil {
+		return true
+	}
+	if q.MaxChannels > 0 && load.channels >= q.MaxChannels {
+		return false
+	}
+	if q.MaxSegments > 0 && load.segments >= q.MaxSegments {
+		return false
+	}
+	return true
+}
+
+// SegmentCounter returns the number of growing segments of the channel
+type SegmentCounter func(channelName string) int
+
+func withSegmentCounter(counter SegmentCounter) ChannelManagerOpt {
+	return func(c *ChannelManager) { c.segmentCounter = counter }
+}
+
+// resourceQuotas keeps the resource quotas of DataNodes, it's protected by the lock of ChannelManager
+type resourceQuotas struct {
+	kv     kv.TxnKV
+	quotas map[int64]*ResourceQuota // node id => quota
+}
+
+func newResourceQuotas(kv kv.TxnKV) *resourceQuotas {
+	return &resourceQuotas{
+		kv:     kv,
+		quotas: make(map[int64]*ResourceQuota),
+	}
+}
+
+// get returns the quota of the node, nil if the node is not limited
+func (r *resourceQuotas) get(nodeID int64) *ResourceQuota {
+	if r == nil {
+		return nil
+	}
+	return r.quotas[nodeID]
+}
+
+// set persists and sets the quota of the node
+func (r *resourceQuotas) set(nodeID int64, quota *ResourceQuota) error {
+	v, err := json.Marshal(quota)
+	if err != nil {
+		return err
+	}
+	if err := r.kv.Save(path.Join(resourceQuotaPrefix, strconv.FormatInt(nodeID, 10)), string(v)); err != nil {
+		return err
+	}
+	r.quotas[nodeID] = quota
+	return nil
+}
+
+// reload restores the persisted quotas from kv
+func (r *resourceQuotas) reload() error {
+	keys, values, err := r.kv.LoadWithPrefix(resourceQuotaPrefix)
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		nodeID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid resource quota key %s: %w", key, err)
+		}
+		quota := &ResourceQuota{}
+		if err := json.Unmarshal([]byte(values[i]), quota); err != nil {
+			return fmt.Errorf("failed to unmarshal resource quota of node %d: %w", nodeID, err)
+		}
+		r.quotas[nodeID] = quota
+	}
+	return nil
+}
+
+// RegisterResourceQuota sets the resource quota of the DataNode.
+// Channels assigned before are kept even if the node exceeds the quota, while no more channels are assigned to it
+func (c *ChannelManager) RegisterResourceQuota(nodeID int64, quota *ResourceQuota) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.quotas.set(nodeID, quota); err != nil {
+		return err
+	}
+	c.updateQuotaHeadroom()
+	return nil
+}
+
+// GetResourceQuota returns the resource quota of the DataNode, nil if the node is not limited
+func (c *ChannelManager) GetResourceQuota(nodeID int64) *ResourceQuota {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.quotas.get(nodeID)
+}
+
+func (c *ChannelManager) loadOf(info *NodeChannelInfo) *nodeLoad {
+	load := &nodeLoad{nodeID: info.NodeID, channels: int64(len(info.Channels))}
+	if c.segmentCounter != nil {
+		for _, ch := range info.Channels {
+			load.segments += int64(c.segmentCounter(ch.Name))
+		}
+	}
+	return load
+}
+
+// applyResourceQuotas moves the channels assigned to nodes at quota in updates to the least loaded nodes with headroom.
+// Channels released from a node in updates are kept on it if it's the least loaded one,
+// other channels are kept in buffer if all nodes are at quota. Nodes in excludes are never assigned channels
+func (c *ChannelManager) applyResourceQuotas(updates ChannelOpSet, excludes ...int64) ChannelOpSet {
+	if c.quotas == nil || len(c.quotas.quotas) == 0 || len(updates) == 0 {
+		return updates
+	}
+
+	loads := make(map[int64]*nodeLoad)
+	for _, info := range c.store.GetNodesChannels() {
+		loads[info.NodeID] = c.loadOf(info)
+	}
+	excluded := make(map[int64]struct{}, len(excludes))
+	for _, id := range excludes {
+		excluded[id] = struct{}{}
+	}
+	// channel name => node releasing the channel in updates
+	released := make(map[string]int64)
+	for _, op := range updates {
+		if op.Type != Delete {
+			continue
+		}
+		for _, ch := range op.Channels {
+			released[ch.Name] = op.NodeID
+			if load, ok := loads[op.NodeID]; ok {
+				load.channels--
+				if c.segmentCounter != nil {
+					load.segments -= int64(c.segmentCounter(ch.Name))
+				}
+			}
+		}
+	}
+
+	adds := make(map[int64][]*channel)
+	kept := make(map[string]struct{})
+	for _, op := range updates {
+		if op.Type != Add {
+			continue
+		}
+		for _, ch := range op.Channels {
+			target := op.NodeID
+			if load, ok := loads[target]; ok && !c.quotas.get(target).admits(load) {
+				target = c.pickNodeWithHeadroom(loads, excluded)
+				log.Warn("DataNode is at resource quota, assign channel to another node",
+					zap.Int64("nodeID", op.NodeID), zap.String("channel", ch.Name), zap.Int64("target", target))
+			}
+			if load, ok := loads[target]; ok {
+				load.channels++
+			}
+			if from, ok := released[ch.Name]; ok && from == target {
+				kept[ch.Name] = struct{}{}
+				continue
+			}
+			adds[target] = append(adds[target], ch)
+		}
+	}
+
+	ret := ChannelOpSet{}
+	for _, op := range updates {
+		if op.Type == Add {
+			continue
+		}
+		channels := make([]*channel, 0, len(op.Channels))
+		for _, ch := range op.Channels {
+			if _, ok := kept[ch.Name]; !ok {
+				channels = append(channels, ch)
+			}
+		}
+		if len(channels) > 0 {
+			ret = append(ret, &ChannelOp{Type: op.Type, NodeID: op.NodeID, Channels: channels})
+		}
+	}
+	nodeIDs := make([]int64, 0, len(adds))
+	for id := range adds {
+		nodeIDs = append(nodeIDs, id)
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+	for _, id := range nodeIDs {
+		ret.Add(id, adds[id])
+	}
+	return ret
+}
+
+// pickNodeWithHeadroom returns the node with the fewest channels under quota, bufferID if all nodes are at quota
+func (c *ChannelManager) pickNodeWithHeadroom(loads map[int64]*nodeLoad, excluded map[int64]struct{}) int64 {
+	var picked *nodeLoad
+	for id, load := range loads {
+		if _, ok := excluded[id]; ok || !c.quotas.get(id).admits(load) {
+			continue
+		}
+		if picked == nil || load.channels < picked.channels ||
+			(load.channels == picked.channels && load.segments < picked.segments) ||
+			(load.channels == picked.channels && load.segments == picked.segments && id < picked.nodeID) {
+			picked = load
+		}
+	}
+	if picked == nil {
+		return bufferID
+	}
+	return picked.nodeID
+}
+
+// updateQuotaHeadroom sets the headroom gauges of the DataNodes with quotas
+func (c *ChannelManager) updateQuotaHeadroom() {
+	if c.quotas == nil {
+		return
+	}
+	for nodeID, quota := range c.quotas.quotas {
+		label := strconv.FormatInt(nodeID, 10)
+		info := c.store.GetNode(nodeID)
+		if info == nil {
+			metrics.DataCoordDataNodeQuotaHeadroom.DeleteLabelValues(label, "channels")
+			metrics.DataCoordDataNodeQuotaHeadroom.DeleteLabelValues(label, "segments")
+			continue
+		}
+		load := c.loadOf(info)
+		if quota.MaxChannels > 0 {
+			metrics.DataCoordDataNodeQuotaHeadroom.WithLabelValues(label, "channels").Set(float64(quota.MaxChannels - load.channels))
+		} else {
+			metrics.DataCoordDataNodeQuotaHeadroom.DeleteLabelValues(label, "channels")
+		}
+		if quota.MaxSegments > 0 {
+			metrics.DataCoordDataNodeQuotaHeadroom.WithLabelValues(label, "segments").Set(float64(quota.MaxSegments - load.segments))
+		} else {
+			metrics.DataCoordDataNodeQuotaHeadroom.DeleteLabelValues(label, "segments")
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"testing"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func countChannels(cm *ChannelManager, nodeID int64) int {
+	for _, info := range cm.GetChannels() {
+		if info.NodeID == nodeID {
+			return len(info.Channels)
+		}
+	}
+	return 0
+}
+
+func TestResourceQuota_admits(t *testing.T) {
+	var unlimited *ResourceQuota
+	assert.True(t, unlimited.admits(&nodeLoad{channels: 100, segments: 100}))
+
+	quota := &ResourceQuota{MaxSegments: 2, MaxChannels: 2}
+	assert.True(t, quota.admits(&nodeLoad{channels: 1, segments: 1}))
+	assert.False(t, quota.admits(&nodeLoad{channels: 2, segments: 1}))
+	assert.False(t, quota.admits(&nodeLoad{channels: 1, segments: 2}))
+
+	quota = &ResourceQuota{MaxSegments: 0, MaxChannels: -1}
+	assert.True(t, quota.admits(&nodeLoad{channels: 100, segments: 100}))
+}
+
+func TestChannelManager_ResourceQuota(t *testing.T) {
+	Params.Init()
+
+	t.Run("watch falls back to nodes with headroom", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		cm, err := NewChannelManager(kv, &dummyPosProvider{})
+		assert.Nil(t, err)
+		assert.Nil(t, cm.AddNode(1))
+		assert.Nil(t, cm.AddNode(2))
+		assert.Nil(t, cm.RegisterResourceQuota(1, &ResourceQuota{MaxChannels: 1}))
+
+		for i := 0; i < 4; i++ {
+			assert.Nil(t, cm.Watch(&channel{fmt.Sprintf("channel%d", i), 1}))
+		}
+		assert.Equal(t, 1, countChannels(cm, 1))
+		assert.Equal(t, 3, countChannels(cm, 2))
+		assert.EqualValues(t, 0, testutil.ToFloat64(metrics.DataCoordDataNodeQuotaHeadroom.WithLabelValues("1", "channels")))
+
+		// all nodes are at quota
+		assert.Nil(t, cm.RegisterResourceQuota(2, &ResourceQuota{MaxChannels: 3}))
+		assert.Nil(t, cm.Watch(&channel{"channel4", 1}))
+		assert.Equal(t, 1, len(cm.GetBuffer().Channels))
+		_, err = cm.FindWatcher("channel4")
+		assert.Equal(t, errChannelInBuffer, err)
+
+		// quotas are reloaded
+		cm2, err := NewChannelManager(kv, &dummyPosProvider{})
+		assert.Nil(t, err)
+		assert.Equal(t, &ResourceQuota{MaxChannels: 1}, cm2.GetResourceQuota(1))
+		assert.Equal(t, &ResourceQuota{MaxChannels: 3}, cm2.GetResourceQuota(2))
+		assert.Nil(t, cm2.GetResourceQuota(3))
+	})
+
+	t.Run("segments quota", func(t *testing.T) {
+		segments := map[string]int{"channel0": 2}
+		cm, err := NewChannelManager(memkv.NewMemoryKV(), &dummyPosProvider{}, withSegmentCounter(func(channelName string) int {
+			return segments[channelName]
+		}))
+		assert.Nil(t, err)
+		assert.Nil(t, cm.AddNode(1))
+		assert.Nil(t, cm.RegisterResourceQuota(1, &ResourceQuota{MaxSegments: 2}))
+		assert.Nil(t, cm.Watch(&channel{"channel0", 1}))
+		assert.True(t, cm.Match(1, "channel0"))
+		assert.EqualValues(t, 0, testutil.ToFloat64(metrics.DataCoordDataNodeQuotaHeadroom.WithLabelValues("1", "segments")))
+
+		assert.Nil(t, cm.AddNode(2))
+		for i := 1; i < 3; i++ {
+			assert.Nil(t, cm.Watch(&channel{fmt.Sprintf("channel%d", i), 1}))
+		}
+		assert.Equal(t, 1, countChannels(cm, 1))
+		assert.Equal(t, 2, countChannels(cm, 2))
+	})
+
+	t.Run("channels of new node at quota are kept", func(t *testing.T) {
+		cm, err := NewChannelManager(memkv.NewMemoryKV(), &dummyPosProvider{})
+		assert.Nil(t, err)
+		assert.Nil(t, cm.AddNode(1))
+		for i := 0; i < 4; i++ {
+			assert.Nil(t, cm.Watch(&channel{fmt.Sprintf("channel%d", i), 1}))
+		}
+		assert.Nil(t, cm.RegisterResourceQuota(2, &ResourceQuota{MaxChannels: 1}))
+		assert.Nil(t, cm.AddNode(2))
+		assert.Equal(t, 3, countChannels(cm, 1))
+		assert.Equal(t, 1, countChannels(cm, 2))
+		assert.Equal(t, 0, len(cm.GetBuffer().Channels))
+	})
+
+	t.Run("channels of deleted node", func(t *testing.T) {
+		cm, err := NewChannelManager(memkv.NewMemoryKV(), &dummyPosProvider{})
+		assert.Nil(t, err)
+		assert.Nil(t, cm.AddNode(1))
+		for i := 0; i < 4; i++ {
+			assert.Nil(t, cm.Watch(&channel{fmt.Sprintf("channel%d", i), 1}))
+		}
+		assert.Nil(t, cm.AddNode(2))
+		assert.Nil(t, cm.AddNode(3))
+		assert.Nil(t, cm.RegisterResourceQuota(2, &ResourceQuota{MaxChannels: int64(countChannels(cm, 2))}))
+		channels2 := countChannels(cm, 2)
+		channels3 := countChannels(cm, 3)
+
+		assert.Nil(t, cm.DeleteNode(1))
+		assert.Equal(t, channels2, countChannels(cm, 2))
+		assert.Equal(t, 4-channels2, countChannels(cm, 3))
+		assert.True(t, channels3 < countChannels(cm, 3))
+
+		// channels are kept in buffer if all nodes are at quota
+		assert.Nil(t, cm.RegisterResourceQuota(3, &ResourceQuota{MaxChannels: 1}))
+		assert.Nil(t, cm.DeleteNode(3))
+		assert.Equal(t, 4-channels2, len(cm.GetBuffer().Channels))
+	})
+
+	t.Run("migrate to node at quota", func(t *testing.T) {
+		cm, err := NewChannelManager(memkv.NewMemoryKV(), &dummyPosProvider{})
+		assert.Nil(t, err)
+		assert.Nil(t, cm.AddNode(1))
+		assert.Nil(t, cm.Watch(&channel{"channel0", 1}))
+		assert.Nil(t, cm.Watch(&channel{"channel1", 1}))
+		assert.Nil(t, cm.Watch(&channel{"channel2", 1}))
+		assert.Nil(t, cm.AddNode(2))
+		from, err := cm.FindWatcher("channel0")
+		assert.Nil(t, err)
+		to := 3 - from
+		assert.NotZero(t, countChannels(cm, to))
+		assert.Nil(t, cm.RegisterResourceQuota(to, &ResourceQuota{MaxChannels: int64(countChannels(cm, to))}))
+
+		_, err = cm.Migrate("channel0", from, to, nil)
+		assert.NotNil(t, err)
+		assert.True(t, cm.Match(from, "channel0"))
+	})
+}
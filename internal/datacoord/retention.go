@@ -0,0 +1,284 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"go.uber.org/zap"
+)
+
+// collectionRetentionPrefix is the kv prefix where data retention policies of collections are persisted
+const collectionRetentionPrefix = metaPrefix + "/collection-retention"
+
+// collection properties of the data retention policy set by SetCollectionProperty
+const (
+	retentionPeriodProperty = "retention.period"
+	retentionModeProperty   = "retention.mode"
+)
+
+// retention modes, which decide the flushed segments dropped once they are out of the retention period
+const (
+	// retentionModeDropSegment drops the segments out of the retention period one by one,
+	// the latest segment of a partition is kept if all the others are dropped
+	retentionModeDropSegment = "drop_segment"
+	// retentionModeDropPartition drops the segments of a partition only once all its flushed segments are out of
+	// the retention period, and never drops all segments of a partition
+	retentionModeDropPartition = "drop_partition"
+	// retentionModeAllowEmpty drops the segments out of the retention period one by one, even if the partition is left empty
+	retentionModeAllowEmpty = "allow_empty"
+)
+
+// maxRetentionDroppedPerTxn limits the segments dropped in an etcd txn, since etcd limits the operations of a txn
+const maxRetentionDroppedPerTxn = 64
+
+// dataRetentionPolicy is the data retention policy of a collection
+type dataRetentionPolicy struct {
+	Period int64  `json:"period"` // nanoseconds
+	Mode   string `json:"mode"`
+}
+
+// retentionManager keeps the data retention policies of collections, and drops the flushed segments
+// out of the retention period of their collection periodically
+type retentionManager struct {
+	mu       sync.Mutex
+	kv       kv.TxnKV
+	meta     *meta
+	policies map[UniqueID]*dataRetentionPolicy // collection id => policy
+
+	quit      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// newRetentionManager creates a retentionManager restoring the policies persisted
+func newRetentionManager(kv kv.TxnKV, meta *meta) (*retentionManager, error) {
+	m := &retentionManager{
+		kv:       kv,
+		meta:     meta,
+		policies: make(map[UniqueID]*dataRetentionPolicy),
+		quit:     make(chan struct{}),
+	}
+	if err := m.reload(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *retentionManager) reload() error {
+	keys, values, err := m.kv.LoadWithPrefix(collectionRetentionPrefix)
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		collectionID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid collection retention key %s: %w", key, err)
+		}
+		policy := &dataRetentionPolicy{}
+		if err := json.Unmarshal([]byte(values[i]), policy); err != nil {
+			return fmt.Errorf("failed to unmarshal retention policy of collection %d: %w", collectionID, err)
+		}
+		m.policies[collectionID] = policy
+	}
+	return nil
+}
+
+func collectionRetentionKey(collectionID UniqueID) string {
+	return path.Join(collectionRetentionPrefix, strconv.FormatInt(collectionID, 10))
+}
+
+// setProperties updates the retention policy of the collection with the properties and persists it,
+// a zero retention period removes the policy. nothing is changed if any of the properties is invalid
+func (m *retentionManager) setProperties(collectionID UniqueID, properties []*commonpb.KeyValuePair) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	policy := &dataRetentionPolicy{Mode: retentionModeDropSegment}
+	if current, ok := m.policies[collectionID]; ok {
+		*policy = *current
+	}
+	periodSet := false
+	for _, property := range properties {
+		switch property.GetKey() {
+		case retentionPeriodProperty:
+			period, err := time.ParseDuration(property.GetValue())
+			if err != nil {
+				return fmt.Errorf("invalid %s %s: %w", retentionPeriodProperty, property.GetValue(), err)
+			}
+			if period < 0 {
+				return fmt.Errorf("invalid %s %s: negative period", retentionPeriodProperty, property.GetValue())
+			}
+			policy.Period = int64(period)
+			periodSet = true
+		case retentionModeProperty:
+			switch property.GetValue() {
+			case retentionModeDropSegment, retentionModeDropPartition, retentionModeAllowEmpty:
+				policy.Mode = property.GetValue()
+			default:
+				return fmt.Errorf("invalid %s %s", retentionModeProperty, property.GetValue())
+			}
+		default:
+			return fmt.Errorf("unknown collection property %s", property.GetKey())
+		}
+	}
+
+	if policy.Period == 0 {
+		if !periodSet {
+			return fmt.Errorf("%s of collection %d is not set", retentionPeriodProperty, collectionID)
+		}
+		if err := m.kv.Remove(collectionRetentionKey(collectionID)); err != nil {
+			return err
+		}
+		delete(m.policies, collectionID)
+		return nil
+	}
+
+	v, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	if err := m.kv.Save(collectionRetentionKey(collectionID), string(v)); err != nil {
+		return err
+	}
+	m.policies[collectionID] = policy
+	return nil
+}
+
+// segmentEndTime returns the time of the latest data in the segment, false if unknown
+func segmentEndTime(segment *SegmentInfo) (time.Time, bool) {
+	ts := segment.GetDmlPosition().GetTimestamp()
+	if ts == 0 {
+		ts = segment.GetLastExpireTime()
+	}
+	if ts == 0 {
+		return time.Time{}, false
+	}
+	t, _ := tsoutil.ParseTS(ts)
+	return t, true
+}
+
+// scan drops the flushed segments out of the retention period of their collection, and returns the segments dropped
+func (m *retentionManager) scan(now time.Time) []UniqueID {
+	m.mu.Lock()
+	policies := make(map[UniqueID]dataRetentionPolicy, len(m.policies))
+	for collectionID, policy := range m.policies {
+		policies[collectionID] = *policy
+	}
+	m.mu.Unlock()
+
+	var dropped []UniqueID
+	for collectionID, policy := range policies {
+		deadline := now.Add(-time.Duration(policy.Period))
+		// counts and segments out of the retention period by partition id
+		healthy := make(map[UniqueID]int)
+		flushed := make(map[UniqueID]int)
+		expired := make(map[UniqueID][]*SegmentInfo)
+		endTimes := make(map[UniqueID]time.Time)
+		for _, segment := range m.meta.GetSegmentsOfCollection(collectionID) {
+			partitionID := segment.GetPartitionID()
+			healthy[partitionID]++
+			if segment.GetState() != commonpb.SegmentState_Flushed {
+				continue
+			}
+			flushed[partitionID]++
+			endTime, ok := segmentEndTime(segment)
+			if !ok || !endTime.Before(deadline) || segment.isCompacting {
+				continue
+			}
+			expired[partitionID] = append(expired[partitionID], segment)
+			endTimes[segment.GetID()] = endTime
+		}
+
+		for partitionID, segments := range expired {
+			if policy.Mode == retentionModeDropPartition && len(segments) < flushed[partitionID] {
+				continue
+			}
+			if policy.Mode != retentionModeAllowEmpty && len(segments) == healthy[partitionID] {
+				if policy.Mode == retentionModeDropPartition {
+					log.Warn("partition out of retention period is kept, since dropping it leaves the partition empty",
+						zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID))
+					continue
+				}
+				// keep the latest segment, so that the partition is never left empty
+				sort.Slice(segments, func(i, j int) bool {
+					return endTimes[segments[i].GetID()].Before(endTimes[segments[j].GetID()])
+				})
+				segments = segments[:len(segments)-1]
+			}
+
+			for len(segments) > 0 {
+				n := len(segments)
+				if n > maxRetentionDroppedPerTxn {
+					n = maxRetentionDroppedPerTxn
+				}
+				ids := make([]UniqueID, 0, n)
+				for _, segment := range segments[:n] {
+					ids = append(ids, segment.GetID())
+				}
+				segments = segments[n:]
+				if err := m.meta.SetSegmentsDropped(ids); err != nil {
+					log.Warn("failed to drop segments out of retention period", zap.Int64("collectionID", collectionID),
+						zap.Int64("partitionID", partitionID), zap.Int64s("segmentIDs", ids), zap.Error(err))
+					continue
+				}
+				log.Info("drop segments out of retention period", zap.Int64("collectionID", collectionID),
+					zap.Int64("partitionID", partitionID), zap.Int64s("segmentIDs", ids),
+					zap.Duration("period", time.Duration(policy.Period)), zap.String("mode", policy.Mode))
+				dropped = append(dropped, ids...)
+			}
+		}
+	}
+	return dropped
+}
+
+func (m *retentionManager) start(interval time.Duration) {
+	m.wg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer m.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.quit:
+				log.Info("retention manager exit")
+				return
+			case <-ticker.C:
+				m.scan(time.Now())
+			}
+		}
+	}()
+}
+
+func (m *retentionManager) close() {
+	m.closeOnce.Do(func() {
+		close(m.quit)
+	})
+	m.wg.Wait()
+}
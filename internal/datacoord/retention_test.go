@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func retentionProperties(kvs ...string) []*commonpb.KeyValuePair {
+	properties := make([]*commonpb.KeyValuePair, 0, len(kvs)/2)
+	for i := 0; i+1 < len(kvs); i += 2 {
+		properties = append(properties, &commonpb.KeyValuePair{Key: kvs[i], Value: kvs[i+1]})
+	}
+	return properties
+}
+
+func TestRetentionManager_setProperties(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m, err := newRetentionManager(kv, nil)
+	assert.Nil(t, err)
+
+	assert.Nil(t, m.setProperties(1, retentionProperties(retentionPeriodProperty, "720h")))
+	assert.Equal(t, &dataRetentionPolicy{Period: int64(720 * time.Hour), Mode: retentionModeDropSegment}, m.policies[1])
+	assert.Nil(t, m.setProperties(1, retentionProperties(retentionModeProperty, retentionModeAllowEmpty)))
+	assert.Equal(t, &dataRetentionPolicy{Period: int64(720 * time.Hour), Mode: retentionModeAllowEmpty}, m.policies[1])
+
+	// policies are restored from kv
+	m, err = newRetentionManager(kv, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, &dataRetentionPolicy{Period: int64(720 * time.Hour), Mode: retentionModeAllowEmpty}, m.policies[1])
+
+	// nothing is changed by invalid properties
+	assert.NotNil(t, m.setProperties(1, retentionProperties(retentionPeriodProperty, "1h", retentionModeProperty, "drop_all")))
+	assert.NotNil(t, m.setProperties(1, retentionProperties(retentionPeriodProperty, "-1h")))
+	assert.NotNil(t, m.setProperties(1, retentionProperties(retentionPeriodProperty, "month")))
+	assert.NotNil(t, m.setProperties(1, retentionProperties("unknown", "1")))
+	assert.Equal(t, &dataRetentionPolicy{Period: int64(720 * time.Hour), Mode: retentionModeAllowEmpty}, m.policies[1])
+
+	// mode without period is not a policy
+	assert.NotNil(t, m.setProperties(2, retentionProperties(retentionModeProperty, retentionModeDropPartition)))
+	assert.Nil(t, m.policies[2])
+
+	// zero period removes the policy
+	assert.Nil(t, m.setProperties(1, retentionProperties(retentionPeriodProperty, "0")))
+	assert.Empty(t, m.policies)
+	keys, _, err := kv.LoadWithPrefix(collectionRetentionPrefix)
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	assert.Nil(t, kv.Save(collectionRetentionKey(3), "bad"))
+	_, err = newRetentionManager(kv, nil)
+	assert.NotNil(t, err)
+}
+
+func TestRetentionManager_scan(t *testing.T) {
+	now := time.Now()
+	endTs := func(ago time.Duration) uint64 {
+		return tsoutil.ComposeTS(now.Add(-ago).UnixNano()/int64(time.Millisecond), 0)
+	}
+	newMeta := func(segments ...*datapb.SegmentInfo) *meta {
+		meta, err := newMemoryMeta(nil)
+		assert.Nil(t, err)
+		for _, segment := range segments {
+			assert.Nil(t, meta.AddSegment(NewSegmentInfo(segment)))
+		}
+		return meta
+	}
+	flushed := func(id, partitionID UniqueID, ago time.Duration) *datapb.SegmentInfo {
+		return &datapb.SegmentInfo{ID: id, CollectionID: 1, PartitionID: partitionID, State: commonpb.SegmentState_Flushed,
+			DmlPosition: &internalpb.MsgPosition{Timestamp: endTs(ago)}}
+	}
+	sorted := func(ids []UniqueID) []UniqueID {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		return ids
+	}
+
+	t.Run("drop segment", func(t *testing.T) {
+		meta := newMeta(
+			flushed(1, 10, 3*time.Hour),
+			flushed(2, 10, 2*time.Hour),
+			flushed(3, 10, time.Minute),
+			// partition 20 is all expired, its latest segment is kept
+			flushed(4, 20, 3*time.Hour),
+			flushed(5, 20, 2*time.Hour),
+			// other collections are not affected
+			&datapb.SegmentInfo{ID: 6, CollectionID: 2, State: commonpb.SegmentState_Flushed,
+				DmlPosition: &internalpb.MsgPosition{Timestamp: endTs(3 * time.Hour)}},
+		)
+		m, err := newRetentionManager(memkv.NewMemoryKV(), meta)
+		assert.Nil(t, err)
+		assert.Nil(t, m.setProperties(1, retentionProperties(retentionPeriodProperty, "1h")))
+
+		assert.Equal(t, []UniqueID{1, 2, 4}, sorted(m.scan(now)))
+		for id, state := range map[UniqueID]commonpb.SegmentState{
+			1: commonpb.SegmentState_Dropped,
+			2: commonpb.SegmentState_Dropped,
+			3: commonpb.SegmentState_Flushed,
+			4: commonpb.SegmentState_Dropped,
+			5: commonpb.SegmentState_Flushed,
+			6: commonpb.SegmentState_Flushed,
+		} {
+			assert.Equal(t, state, meta.segments.GetSegment(id).GetState(), "segment %d", id)
+		}
+		assert.NotZero(t, meta.segments.GetSegment(1).GetDroppedAt())
+		// segments dropped are left to garbage collection
+		assert.Empty(t, m.scan(now))
+	})
+
+	t.Run("drop partition", func(t *testing.T) {
+		meta := newMeta(
+			flushed(1, 10, 3*time.Hour),
+			flushed(2, 10, time.Minute),
+			flushed(3, 20, 3*time.Hour),
+			flushed(4, 20, 2*time.Hour),
+			&datapb.SegmentInfo{ID: 5, CollectionID: 1, PartitionID: 20, State: commonpb.SegmentState_Growing},
+			flushed(6, 30, 3*time.Hour),
+		)
+		m, err := newRetentionManager(memkv.NewMemoryKV(), meta)
+		assert.Nil(t, err)
+		assert.Nil(t, m.setProperties(1, retentionProperties(retentionPeriodProperty, "1h", retentionModeProperty, retentionModeDropPartition)))
+
+		// partition 10 is partially expired, and partition 30 would be left empty
+		assert.Equal(t, []UniqueID{3, 4}, sorted(m.scan(now)))
+		assert.Equal(t, commonpb.SegmentState_Flushed, meta.segments.GetSegment(1).GetState())
+		assert.Equal(t, commonpb.SegmentState_Flushed, meta.segments.GetSegment(6).GetState())
+	})
+
+	t.Run("allow empty", func(t *testing.T) {
+		meta := newMeta(
+			flushed(1, 10, 3*time.Hour),
+			flushed(2, 10, 2*time.Hour),
+			// segment without position is never dropped
+			&datapb.SegmentInfo{ID: 3, CollectionID: 1, PartitionID: 10, State: commonpb.SegmentState_Flushed},
+			flushed(4, 20, 3*time.Hour),
+		)
+		meta.SetSegmentCompacting(4, true)
+		m, err := newRetentionManager(memkv.NewMemoryKV(), meta)
+		assert.Nil(t, err)
+		assert.Nil(t, m.setProperties(1, retentionProperties(retentionPeriodProperty, "1h", retentionModeProperty, retentionModeAllowEmpty)))
+
+		assert.Equal(t, []UniqueID{1, 2}, sorted(m.scan(now)))
+		assert.Nil(t, meta.SetState(3, commonpb.SegmentState_Dropped))
+		meta.SetSegmentCompacting(4, false)
+		assert.Equal(t, []UniqueID{4}, m.scan(now))
+	})
+}
+
+func TestRetentionManager_start(t *testing.T) {
+	meta, err := newMemoryMeta(nil)
+	assert.Nil(t, err)
+	for _, id := range []UniqueID{1, 2} {
+		assert.Nil(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: id, CollectionID: 1, State: commonpb.SegmentState_Flushed,
+			DmlPosition: &internalpb.MsgPosition{Timestamp: tsoutil.ComposeTS(int64(id), 0)}})))
+	}
+
+	m, err := newRetentionManager(memkv.NewMemoryKV(), meta)
+	assert.Nil(t, err)
+	assert.Nil(t, m.setProperties(1, retentionProperties(retentionPeriodProperty, "1h")))
+	m.start(10 * time.Millisecond)
+	defer m.close()
+	assert.Eventually(t, func() bool {
+		return meta.GetSegment(1) == nil
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, commonpb.SegmentState_Flushed, meta.GetSegment(2).GetState())
+}
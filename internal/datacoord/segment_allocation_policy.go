@@ -30,6 +30,18 @@ import (
 type calUpperLimitPolicy func(schema *schemapb.CollectionSchema) (int, error)
 
 func calBySchemaPolicy(schema *schemapb.CollectionSchema) (int, error) {
+	return calBySchemaWithMaxSize(schema, Params.SegmentMaxSize)
+}
+
+// calBySchemaWithSizerPolicy returns a calUpperLimitPolicy following the segment max size adapted by sizer
+func calBySchemaWithSizerPolicy(sizer *AdaptiveSegmentSizer) calUpperLimitPolicy {
+	return func(schema *schemapb.CollectionSchema) (int, error) {
+		return calBySchemaWithMaxSize(schema, sizer.getMaxSize())
+	}
+}
+
+// calBySchemaWithMaxSize returns the number of records of schema a segment of maxSize MB is able to hold
+func calBySchemaWithMaxSize(schema *schemapb.CollectionSchema, maxSize float64) (int, error) {
 	if schema == nil {
 		return -1, errors.New("nil schema")
 	}
@@ -41,7 +53,7 @@ func calBySchemaPolicy(schema *schemapb.CollectionSchema) (int, error) {
 	if sizePerRecord == 0 {
 		return -1, errors.New("zero size record schema found")
 	}
-	threshold := Params.SegmentMaxSize * 1024 * 1024
+	threshold := maxSize * 1024 * 1024
 	return int(threshold / float64(sizePerRecord)), nil
 }
 
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// fingerprintHashNum is the number of hash functions used by the fingerprint bloom filter
+const fingerprintHashNum = 7
+
+// FingerprintValidator detects segments registered with the same binlog paths as another segment,
+// each registered binlog path is kept in a bloom filter, so that paths added by a flush and paths of a whole segment
+// rebuilt from meta are fingerprinted alike, and segments in meta are checked only if the filter reports all the
+// paths possibly registered
+type FingerprintValidator struct {
+	mu     sync.Mutex
+	meta   *meta
+	filter *bloom.BloomFilter
+}
+
+// NewFingerprintValidator creates a FingerprintValidator with a bloom filter of size bits,
+// the filter is rebuilt from the binlogs of segments in meta
+func NewFingerprintValidator(meta *meta, size uint) *FingerprintValidator {
+	v := &FingerprintValidator{
+		meta:   meta,
+		filter: bloom.New(size, fingerprintHashNum),
+	}
+	for _, segment := range meta.SelectSegments(isSegmentHealthy) {
+		v.Add(segment.GetBinlogs())
+	}
+	return v
+}
+
+// Check returns the IDs of healthy segments other than segmentID holding all the binlogs
+func (v *FingerprintValidator) Check(segmentID UniqueID, binlogs []*datapb.FieldBinlog) []UniqueID {
+	paths := binlogPaths(binlogs)
+	if len(paths) == 0 {
+		return nil
+	}
+	v.mu.Lock()
+	for _, path := range paths {
+		if !v.filter.TestString(path) {
+			v.mu.Unlock()
+			return nil
+		}
+	}
+	v.mu.Unlock()
+
+	// filter may report false positive, confirm with segments in meta
+	duplicates := v.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetID() != segmentID && isSegmentHealthy(segment) && containsAllPaths(segment.GetBinlogs(), paths)
+	})
+	ids := make([]UniqueID, 0, len(duplicates))
+	for _, segment := range duplicates {
+		ids = append(ids, segment.GetID())
+	}
+	return ids
+}
+
+// Add records the paths of binlogs registered
+func (v *FingerprintValidator) Add(binlogs []*datapb.FieldBinlog) {
+	paths := binlogPaths(binlogs)
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for _, path := range paths {
+		v.filter.AddString(path)
+	}
+}
+
+func binlogPaths(binlogs []*datapb.FieldBinlog) []string {
+	var paths []string
+	for _, fieldBinlog := range binlogs {
+		paths = append(paths, fieldBinlog.GetBinlogs()...)
+	}
+	return paths
+}
+
+// containsAllPaths returns true if all the paths are in binlogs
+func containsAllPaths(binlogs []*datapb.FieldBinlog, paths []string) bool {
+	set := make(map[string]struct{})
+	for _, path := range binlogPaths(binlogs) {
+		set[path] = struct{}{}
+	}
+	for _, path := range paths {
+		if _, ok := set[path]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFingerprintValidator(t *testing.T) {
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+	binlogs := []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1", "log2"}}}
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, State: commonpb.SegmentState_Flushed, Binlogs: binlogs},
+		{ID: 2, State: commonpb.SegmentState_Dropped, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log3"}}}},
+		{ID: 3, State: commonpb.SegmentState_Growing},
+	}
+	for _, segment := range segments {
+		assert.Nil(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+
+	// filter is rebuilt from meta
+	v := NewFingerprintValidator(meta, 1024)
+	assert.ElementsMatch(t, []UniqueID{1}, v.Check(3, binlogs))
+	// paths of a single flush are checked against the paths of whole segments rebuilt from meta
+	assert.ElementsMatch(t, []UniqueID{1}, v.Check(3, []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}}))
+	assert.Empty(t, v.Check(3, []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2", "log5"}}}))
+	// segment itself is not duplicate
+	assert.Empty(t, v.Check(1, binlogs))
+	// dropped segment is not duplicate
+	assert.Empty(t, v.Check(3, []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log3"}}}))
+	assert.Empty(t, v.Check(3, nil))
+
+	newBinlogs := []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log4"}}}
+	assert.Empty(t, v.Check(4, newBinlogs))
+	assert.Nil(t, meta.UpdateFlushSegmentsInfo(3, false, false, false, newBinlogs, nil, nil, nil, nil, nil, 0))
+	v.Add(newBinlogs)
+	assert.ElementsMatch(t, []UniqueID{3}, v.Check(4, newBinlogs))
+}
@@ -0,0 +1,213 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/minio/minio-go/v7"
+)
+
+// maxManifestSize limits the size of a manifest file to download
+const maxManifestSize = 64 << 20
+
+var errStorageNotInitialized = errors.New("object storage client is not initialized")
+
+// segmentManifest describes the external segments to import
+type segmentManifest struct {
+	CollectionID int64              `json:"collection_id"`
+	Segments     []*manifestSegment `json:"segments"`
+}
+
+// manifestSegment describes a single flushed segment in the manifest
+type manifestSegment struct {
+	PartitionID int64              `json:"partition_id"`
+	Channel     string             `json:"channel"`
+	NumOfRows   int64              `json:"num_of_rows"`
+	Binlogs     map[int64][]string `json:"binlogs"`   // field id => insert binlog paths
+	Statslogs   map[int64][]string `json:"statslogs"` // field id => stats binlog paths
+}
+
+// checkManifestURL checks the manifest url is of http or https and one of the hosts allowed
+func checkManifestURL(u *url.URL, allowedHosts []string) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("manifest url scheme %q not allowed", u.Scheme)
+	}
+	for _, host := range allowedHosts {
+		if host == u.Host || host == u.Hostname() {
+			return nil
+		}
+	}
+	return fmt.Errorf("manifest host %q not allowed", u.Host)
+}
+
+// newManifestClient returns the http client downloading manifests, redirects are followed only to the hosts allowed
+func newManifestClient(timeout time.Duration, allowedHosts []string) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return checkManifestURL(req.URL, allowedHosts)
+		},
+	}
+}
+
+// fetchManifest downloads and decodes the manifest file from url
+func fetchManifest(ctx context.Context, url string) (*segmentManifest, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkManifestURL(req.URL, Params.ImportManifestHosts); err != nil {
+		return nil, err
+	}
+	client := newManifestClient(time.Duration(Params.ImportManifestTimeoutSeconds)*time.Second, Params.ImportManifestHosts)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download manifest %s, status: %s", url, resp.Status)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &segmentManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest %s: %w", url, err)
+	}
+	return manifest, nil
+}
+
+// validateManifest checks the manifest is compatible with the collection schema
+// and all the binlogs exist in object storage
+func (s *Server) validateManifest(ctx context.Context, manifest *segmentManifest) error {
+	if len(manifest.Segments) == 0 {
+		return errors.New("no segment in manifest")
+	}
+	coll := s.GetCollection(ctx, manifest.CollectionID)
+	if coll == nil {
+		return fmt.Errorf("collection %d not found", manifest.CollectionID)
+	}
+	if s.storageCli == nil {
+		return errStorageNotInitialized
+	}
+
+	fields := make(map[int64]struct{})
+	for _, field := range coll.GetSchema().GetFields() {
+		fields[field.GetFieldID()] = struct{}{}
+	}
+	partitions := make(map[int64]struct{})
+	for _, partitionID := range coll.GetPartitions() {
+		partitions[partitionID] = struct{}{}
+	}
+
+	for i, seg := range manifest.Segments {
+		if _, ok := partitions[seg.PartitionID]; !ok {
+			return fmt.Errorf("segment %d: partition %d not found in collection %d", i, seg.PartitionID, manifest.CollectionID)
+		}
+		if seg.Channel == "" {
+			return fmt.Errorf("segment %d: empty channel", i)
+		}
+		if seg.NumOfRows <= 0 {
+			return fmt.Errorf("segment %d: invalid num of rows %d", i, seg.NumOfRows)
+		}
+		if len(seg.Binlogs) != len(fields) {
+			return fmt.Errorf("segment %d: binlogs of %d fields provided, schema has %d fields", i, len(seg.Binlogs), len(fields))
+		}
+		for _, fieldLogs := range []map[int64][]string{seg.Binlogs, seg.Statslogs} {
+			for fieldID, paths := range fieldLogs {
+				if _, ok := fields[fieldID]; !ok {
+					return fmt.Errorf("segment %d: field %d not found in schema", i, fieldID)
+				}
+				for _, p := range paths {
+					if err := s.checkObjectExists(ctx, p); err != nil {
+						return fmt.Errorf("segment %d: %w", i, err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) checkObjectExists(ctx context.Context, key string) error {
+	_, err := s.storageCli.StatObject(ctx, Params.MinioBucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return fmt.Errorf("binlog %s not found", key)
+		}
+		return err
+	}
+	return nil
+}
+
+// importManifestSegments registers the segments of a validated manifest as flushed segments, all or none of them
+// are registered
+func (s *Server) importManifestSegments(ctx context.Context, manifest *segmentManifest) ([]int64, error) {
+	segmentIDs := make([]int64, 0, len(manifest.Segments))
+	segments := make([]*SegmentInfo, 0, len(manifest.Segments))
+	for _, seg := range manifest.Segments {
+		id, err := s.allocator.allocID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		info := &datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  manifest.CollectionID,
+			PartitionID:   seg.PartitionID,
+			InsertChannel: seg.Channel,
+			NumOfRows:     seg.NumOfRows,
+			MaxRowNum:     seg.NumOfRows,
+			State:         commonpb.SegmentState_Flushed,
+			Binlogs:       manifestFieldBinlogs(seg.Binlogs),
+			Statslogs:     manifestFieldBinlogs(seg.Statslogs),
+			IsImported:    true,
+		}
+		segments = append(segments, NewSegmentInfo(info))
+		segmentIDs = append(segmentIDs, id)
+	}
+	if err := s.meta.AddSegments(segments); err != nil {
+		return nil, err
+	}
+	return segmentIDs, nil
+}
+
+func manifestFieldBinlogs(fieldLogs map[int64][]string) []*datapb.FieldBinlog {
+	ret := make([]*datapb.FieldBinlog, 0, len(fieldLogs))
+	for fieldID, paths := range fieldLogs {
+		ret = append(ret, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: paths,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveManifest(t *testing.T, manifest *segmentManifest) *httptest.Server {
+	data, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(data)
+	}))
+}
+
+func TestFetchManifest(t *testing.T) {
+	defer func(hosts []string) { Params.ImportManifestHosts = hosts }(Params.ImportManifestHosts)
+	manifest := &segmentManifest{CollectionID: 1, Segments: []*manifestSegment{{PartitionID: 10}}}
+	ts := serveManifest(t, manifest)
+	defer ts.Close()
+	redirect := httptest.NewServer(http.RedirectHandler(ts.URL, http.StatusFound))
+	defer redirect.Close()
+	tsURL, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	redirectURL, err := url.Parse(redirect.URL)
+	require.NoError(t, err)
+
+	Params.ImportManifestHosts = nil
+	_, err = fetchManifest(context.TODO(), ts.URL)
+	assert.Error(t, err)
+
+	Params.ImportManifestHosts = []string{"127.0.0.1"}
+	fetched, err := fetchManifest(context.TODO(), ts.URL)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, fetched.CollectionID)
+	_, err = fetchManifest(context.TODO(), "file:///etc/passwd")
+	assert.Error(t, err)
+
+	// redirected to the host not allowed
+	Params.ImportManifestHosts = []string{redirectURL.Host}
+	_, err = fetchManifest(context.TODO(), redirect.URL)
+	assert.Error(t, err)
+	Params.ImportManifestHosts = []string{redirectURL.Host, tsURL.Host}
+	_, err = fetchManifest(context.TODO(), redirect.URL)
+	assert.NoError(t, err)
+}
+
+// NOTE: start minio before test
+func TestImportSegmentManifest(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+	defer func(hosts []string) { Params.ImportManifestHosts = hosts }(Params.ImportManifestHosts)
+	Params.ImportManifestHosts = []string{"127.0.0.1"}
+
+	rootPath := `import` + funcutil.RandomString(8)
+	cli, files, err := initUtOSSEnv(Params.MinioBucketName, rootPath, 4)
+	require.NoError(t, err)
+	defer func() {
+		for _, file := range files {
+			_ = cli.RemoveObject(context.TODO(), Params.MinioBucketName, file, minio.RemoveObjectOptions{})
+		}
+	}()
+	svr.storageCli = cli
+
+	svr.meta.AddCollection(&datapb.CollectionInfo{
+		ID:         1,
+		Schema:     newTestSchema(),
+		Partitions: []int64{10},
+	})
+
+	validSegment := func() *manifestSegment {
+		return &manifestSegment{
+			PartitionID: 10,
+			Channel:     "ch1",
+			NumOfRows:   100,
+			Binlogs: map[int64][]string{
+				1: {files[0]},
+				2: {files[1]},
+			},
+			Statslogs: map[int64][]string{
+				1: {files[2]},
+			},
+		}
+	}
+
+	t.Run("import successfully", func(t *testing.T) {
+		ts := serveManifest(t, &segmentManifest{
+			CollectionID: 1,
+			Segments:     []*manifestSegment{validSegment(), validSegment()},
+		})
+		defer ts.Close()
+
+		resp, err := svr.ImportSegmentManifest(context.TODO(), &datapb.ImportManifestRequest{ManifestUrl: ts.URL})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 2, len(resp.GetSegmentIDs()))
+		for _, id := range resp.GetSegmentIDs() {
+			segment := svr.meta.GetSegment(id)
+			require.NotNil(t, segment)
+			assert.True(t, segment.GetIsImported())
+			assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+			assert.EqualValues(t, 100, segment.GetNumOfRows())
+			assert.Equal(t, 2, len(segment.GetBinlogs()))
+		}
+	})
+
+	t.Run("binlog not exist", func(t *testing.T) {
+		seg := validSegment()
+		seg.Binlogs[2] = []string{rootPath + "/not-exist"}
+		ts := serveManifest(t, &segmentManifest{CollectionID: 1, Segments: []*manifestSegment{seg}})
+		defer ts.Close()
+
+		resp, err := svr.ImportSegmentManifest(context.TODO(), &datapb.ImportManifestRequest{ManifestUrl: ts.URL})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("schema incompatible", func(t *testing.T) {
+		seg := validSegment()
+		seg.Binlogs[3] = []string{files[3]}
+		delete(seg.Binlogs, 2)
+		ts := serveManifest(t, &segmentManifest{CollectionID: 1, Segments: []*manifestSegment{seg}})
+		defer ts.Close()
+
+		resp, err := svr.ImportSegmentManifest(context.TODO(), &datapb.ImportManifestRequest{ManifestUrl: ts.URL})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("partition not exist", func(t *testing.T) {
+		seg := validSegment()
+		seg.PartitionID = 11
+		ts := serveManifest(t, &segmentManifest{CollectionID: 1, Segments: []*manifestSegment{seg}})
+		defer ts.Close()
+
+		resp, err := svr.ImportSegmentManifest(context.TODO(), &datapb.ImportManifestRequest{ManifestUrl: ts.URL})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("invalid manifest url", func(t *testing.T) {
+		ts := httptest.NewServer(http.NotFoundHandler())
+		defer ts.Close()
+
+		resp, err := svr.ImportSegmentManifest(context.TODO(), &datapb.ImportManifestRequest{ManifestUrl: ts.URL})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+}
+
+func TestImportSegmentManifest_ClosedServer(t *testing.T) {
+	svr := newTestServer(t, nil)
+	closeTestServer(t, svr)
+
+	resp, err := svr.ImportSegmentManifest(context.TODO(), &datapb.ImportManifestRequest{ManifestUrl: "http://localhost"})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+}
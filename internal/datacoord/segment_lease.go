@@ -0,0 +1,226 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"go.uber.org/zap"
+)
+
+// segmentLeasePrefix is the kv prefix where leases of growing segments are persisted
+const segmentLeasePrefix = metaPrefix + "/segment-lease"
+
+// segmentLease is the write lease of a growing segment held by the DataNode watching its channel
+type segmentLease struct {
+	ExpireAt int64 `json:"expireAt"` // unix time in nanoseconds
+	Revoked  bool  `json:"revoked"`  // the lease expired and the segment is sealed
+}
+
+// segmentLeaseManager seals growing segments whose lease is not renewed in time.
+// A lease is granted once a growing segment is found, and renewed by SaveBinlogPaths carrying a checkpoint of the segment.
+// Once sealed, no more rows are assigned to the segment, and it's flushed the same way as segments sealed by policies
+type segmentLeaseManager struct {
+	mu       sync.Mutex
+	kv       kv.TxnKV
+	meta     *meta
+	duration time.Duration
+	leases   map[UniqueID]*segmentLease // segment id => lease
+
+	quit      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// newSegmentLeaseManager creates a segmentLeaseManager restoring the leases persisted
+func newSegmentLeaseManager(kv kv.TxnKV, meta *meta, duration time.Duration) (*segmentLeaseManager, error) {
+	m := &segmentLeaseManager{
+		kv:       kv,
+		meta:     meta,
+		duration: duration,
+		leases:   make(map[UniqueID]*segmentLease),
+		quit:     make(chan struct{}),
+	}
+	if err := m.reload(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *segmentLeaseManager) reload() error {
+	keys, values, err := m.kv.LoadWithPrefix(segmentLeasePrefix)
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		segmentID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid segment lease key %s: %w", key, err)
+		}
+		lease := &segmentLease{}
+		if err := json.Unmarshal([]byte(values[i]), lease); err != nil {
+			return fmt.Errorf("failed to unmarshal lease of segment %d: %w", segmentID, err)
+		}
+		m.leases[segmentID] = lease
+	}
+	return nil
+}
+
+func segmentLeaseKey(segmentID UniqueID) string {
+	return path.Join(segmentLeasePrefix, strconv.FormatInt(segmentID, 10))
+}
+
+func (m *segmentLeaseManager) save(segmentID UniqueID, lease *segmentLease) error {
+	v, err := json.Marshal(lease)
+	if err != nil {
+		return err
+	}
+	if err := m.kv.Save(segmentLeaseKey(segmentID), string(v)); err != nil {
+		return err
+	}
+	m.leases[segmentID] = lease
+	return nil
+}
+
+func (m *segmentLeaseManager) remove(segmentID UniqueID) error {
+	if err := m.kv.Remove(segmentLeaseKey(segmentID)); err != nil {
+		return err
+	}
+	delete(m.leases, segmentID)
+	return nil
+}
+
+// renew extends the leases of the growing segments to now plus the lease duration, revoked leases are not renewed
+func (m *segmentLeaseManager) renew(segmentIDs []UniqueID, now time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range segmentIDs {
+		if lease, ok := m.leases[id]; ok && lease.Revoked {
+			continue
+		}
+		segment := m.meta.GetSegment(id)
+		if segment == nil || segment.GetState() != commonpb.SegmentState_Growing {
+			continue
+		}
+		if err := m.save(id, &segmentLease{ExpireAt: now.Add(m.duration).UnixNano()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isRevoked returns whether the segment is sealed since its lease expired
+func (m *segmentLeaseManager) isRevoked(segmentID UniqueID) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	lease, ok := m.leases[segmentID]
+	return ok && lease.Revoked
+}
+
+// expire grants leases to growing segments without one, seals the growing segments whose lease expires,
+// and removes the leases of segments no longer growing or sealed. It returns the segments sealed
+func (m *segmentLeaseManager) expire(now time.Time) []UniqueID {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var sealed []UniqueID
+	segments := m.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment)
+	})
+	alive := make(map[UniqueID]struct{}, len(segments))
+	for _, segment := range segments {
+		id := segment.GetID()
+		lease, ok := m.leases[id]
+		switch segment.GetState() {
+		case commonpb.SegmentState_Growing:
+			alive[id] = struct{}{}
+			if !ok {
+				if err := m.save(id, &segmentLease{ExpireAt: now.Add(m.duration).UnixNano()}); err != nil {
+					log.Warn("failed to grant segment lease", zap.Int64("segmentID", id), zap.Error(err))
+				}
+				continue
+			}
+			if lease.Revoked || now.UnixNano() <= lease.ExpireAt {
+				continue
+			}
+			if err := m.meta.SetState(id, commonpb.SegmentState_Sealed); err != nil {
+				log.Warn("failed to seal segment with lease expired", zap.Int64("segmentID", id), zap.Error(err))
+				continue
+			}
+			if err := m.save(id, &segmentLease{ExpireAt: lease.ExpireAt, Revoked: true}); err != nil {
+				log.Warn("failed to revoke segment lease", zap.Int64("segmentID", id), zap.Error(err))
+			}
+			log.Warn("segment lease expired, seal the segment", zap.Int64("segmentID", id),
+				zap.String("channel", segment.GetInsertChannel()), zap.Time("expireAt", time.Unix(0, lease.ExpireAt)))
+			sealed = append(sealed, id)
+		case commonpb.SegmentState_Sealed:
+			// revoked leases are kept until the segment is flushed, so that DataNode is told the lease expired
+			if ok && lease.Revoked {
+				alive[id] = struct{}{}
+			}
+		}
+	}
+
+	for id := range m.leases {
+		if _, ok := alive[id]; ok {
+			continue
+		}
+		if err := m.remove(id); err != nil {
+			log.Warn("failed to remove segment lease", zap.Int64("segmentID", id), zap.Error(err))
+		}
+	}
+	return sealed
+}
+
+func (m *segmentLeaseManager) start() {
+	m.wg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer m.wg.Done()
+		interval := m.duration / 4
+		if interval <= 0 {
+			interval = time.Second
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.quit:
+				log.Info("segment lease manager exit")
+				return
+			case <-ticker.C:
+				m.expire(time.Now())
+			}
+		}
+	}()
+}
+
+func (m *segmentLeaseManager) close() {
+	m.closeOnce.Do(func() {
+		close(m.quit)
+	})
+	m.wg.Wait()
+}
@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentLeaseManager(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	meta, err := newMemoryMeta(nil)
+	assert.Nil(t, err)
+	for id, state := range map[UniqueID]commonpb.SegmentState{
+		1: commonpb.SegmentState_Growing,
+		2: commonpb.SegmentState_Growing,
+		3: commonpb.SegmentState_Flushed,
+	} {
+		assert.Nil(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: id, InsertChannel: "ch1", State: state})))
+	}
+
+	duration := 10 * time.Second
+	m, err := newSegmentLeaseManager(kv, meta, duration)
+	assert.Nil(t, err)
+
+	now := time.Now()
+	// leases are granted to growing segments found
+	assert.Empty(t, m.expire(now))
+	assert.Equal(t, 2, len(m.leases))
+
+	// segment 1 is renewed, segment 2 expires
+	assert.Nil(t, m.renew([]UniqueID{1, 3}, now.Add(8*time.Second)))
+	assert.Equal(t, []UniqueID{2}, m.expire(now.Add(12*time.Second)))
+	assert.Equal(t, commonpb.SegmentState_Growing, meta.GetSegment(1).GetState())
+	assert.Equal(t, commonpb.SegmentState_Sealed, meta.GetSegment(2).GetState())
+	assert.False(t, m.isRevoked(1))
+	assert.True(t, m.isRevoked(2))
+	assert.False(t, m.isRevoked(3))
+
+	// revoked leases are not renewed, and are restored from kv
+	assert.Nil(t, m.renew([]UniqueID{2}, now.Add(12*time.Second)))
+	m, err = newSegmentLeaseManager(kv, meta, duration)
+	assert.Nil(t, err)
+	assert.True(t, m.isRevoked(2))
+	assert.Empty(t, m.expire(now.Add(13*time.Second)))
+	assert.True(t, m.isRevoked(2))
+
+	// leases are removed once segments are flushed
+	assert.Nil(t, meta.SetState(1, commonpb.SegmentState_Flushed))
+	assert.Nil(t, meta.SetState(2, commonpb.SegmentState_Flushed))
+	assert.Empty(t, m.expire(now.Add(14*time.Second)))
+	assert.Empty(t, m.leases)
+	keys, _, err := kv.LoadWithPrefix(segmentLeasePrefix)
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	assert.Nil(t, kv.Save(segmentLeaseKey(4), "bad"))
+	_, err = newSegmentLeaseManager(kv, meta, duration)
+	assert.NotNil(t, err)
+}
+
+func TestSegmentLeaseManager_start(t *testing.T) {
+	meta, err := newMemoryMeta(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, InsertChannel: "ch1", State: commonpb.SegmentState_Growing})))
+
+	m, err := newSegmentLeaseManager(memkv.NewMemoryKV(), meta, 40*time.Millisecond)
+	assert.Nil(t, err)
+	m.start()
+	defer m.close()
+	assert.Eventually(t, func() bool {
+		return m.isRevoked(1)
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, commonpb.SegmentState_Sealed, meta.GetSegment(1).GetState())
+}
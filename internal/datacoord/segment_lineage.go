@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+// segmentStateColors are the fill colors of segment nodes in the lineage graph
+var segmentStateColors = map[commonpb.SegmentState]string{
+	commonpb.SegmentState_Growing:  "palegreen",
+	commonpb.SegmentState_Sealed:   "lightyellow",
+	commonpb.SegmentState_Flushing: "orange",
+	commonpb.SegmentState_Flushed:  "lightblue",
+	commonpb.SegmentState_Dropped:  "lightgray",
+}
+
+// removedSegmentColor is the fill color of the compaction sources already removed from meta by garbage collection
+const removedSegmentColor = "white"
+
+// segmentLineageDOT renders the compaction lineage of the segments of the collection in meta as a Graphviz DOT graph.
+// Nodes are segments colored by state, labeled with their row count and estimated size, and edges point from the
+// compacted segments to the segment they are compacted into. Compaction sources removed from meta are drawn dashed
+func segmentLineageDOT(m *meta, collectionID UniqueID) string {
+	segments := m.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == collectionID
+	})
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "digraph collection_%d {\n", collectionID)
+	sb.WriteString("  node [shape=box, style=filled];\n")
+
+	known := make(map[UniqueID]struct{}, len(segments))
+	for _, segment := range segments {
+		known[segment.GetID()] = struct{}{}
+	}
+	for _, segment := range segments {
+		color, ok := segmentStateColors[segment.GetState()]
+		if !ok {
+			color = removedSegmentColor
+		}
+		rows, size := segment.GetNumOfRows(), estimateSegmentBytes(m, segment)
+		fmt.Fprintf(&sb, "  \"%d\" [label=\"%d\\n%s\\nrows: %d\\nsize: %d\", fillcolor=%s, rows=%d, size=%d];\n",
+			segment.GetID(), segment.GetID(), segment.GetState().String(), rows, size, color, rows, size)
+	}
+
+	removed := make(map[UniqueID]struct{})
+	for _, segment := range segments {
+		for _, from := range segment.GetCompactionFrom() {
+			if _, ok := known[from]; !ok {
+				removed[from] = struct{}{}
+			}
+		}
+	}
+	removedIDs := make([]UniqueID, 0, len(removed))
+	for id := range removed {
+		removedIDs = append(removedIDs, id)
+	}
+	sort.Slice(removedIDs, func(i, j int) bool { return removedIDs[i] < removedIDs[j] })
+	for _, id := range removedIDs {
+		fmt.Fprintf(&sb, "  \"%d\" [label=\"%d\\nremoved\", fillcolor=%s, style=\"filled,dashed\"];\n", id, id, removedSegmentColor)
+	}
+
+	for _, segment := range segments {
+		for _, from := range segment.GetCompactionFrom() {
+			fmt.Fprintf(&sb, "  \"%d\" -> \"%d\";\n", from, segment.GetID())
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentLineageDOT(t *testing.T) {
+	meta, err := newMemoryMeta(nil)
+	require.NoError(t, err)
+	segments := []*datapb.SegmentInfo{
+		{ID: 2, CollectionID: 1, State: commonpb.SegmentState_Dropped, NumOfRows: 10},
+		{ID: 3, CollectionID: 1, State: commonpb.SegmentState_Dropped, NumOfRows: 20},
+		{ID: 4, CollectionID: 1, State: commonpb.SegmentState_Flushed, NumOfRows: 30, CompactionFrom: []int64{1, 2, 3}},
+		{ID: 5, CollectionID: 1, State: commonpb.SegmentState_Growing, NumOfRows: 5,
+			Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogSize: 16}}},
+		{ID: 6, CollectionID: 2, State: commonpb.SegmentState_Flushed},
+	}
+	for _, segment := range segments {
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+
+	assert.Equal(t, `digraph collection_1 {
+  node [shape=box, style=filled];
+  "2" [label="2\nDropped\nrows: 10\nsize: 0", fillcolor=lightgray, rows=10, size=0];
+  "3" [label="3\nDropped\nrows: 20\nsize: 0", fillcolor=lightgray, rows=20, size=0];
+  "4" [label="4\nFlushed\nrows: 30\nsize: 0", fillcolor=lightblue, rows=30, size=0];
+  "5" [label="5\nGrowing\nrows: 5\nsize: 16", fillcolor=palegreen, rows=5, size=16];
+  "1" [label="1\nremoved", fillcolor=white, style="filled,dashed"];
+  "1" -> "4";
+  "2" -> "4";
+  "3" -> "4";
+}
+`, segmentLineageDOT(meta, 1))
+
+	assert.Equal(t, "digraph collection_3 {\n  node [shape=box, style=filled];\n}\n", segmentLineageDOT(meta, 3))
+}
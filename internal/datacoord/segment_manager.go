@@ -73,16 +73,25 @@ const segmentMaxLifetime = 24 * time.Hour
 type Manager interface {
 	// AllocSegment allocates rows and record the allocation.
 	AllocSegment(ctx context.Context, collectionID, partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error)
+	// AllocSegmentAhead allocates rows for a DataNode ahead of time, segments opened by it are discardable until
+	// any other allocation is made to them.
+	AllocSegmentAhead(ctx context.Context, collectionID, partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error)
+	// DiscardSegment drops the segment allocated ahead of time if it's never used, and returns whether it's dropped.
+	DiscardSegment(ctx context.Context, segmentID UniqueID) (bool, error)
 	// DropSegment drops the segment from manager.
 	DropSegment(ctx context.Context, segmentID UniqueID)
 	// SealAllSegments seals all segments of collection with collectionID and return sealed segments
 	SealAllSegments(ctx context.Context, collectionID UniqueID) ([]UniqueID, error)
+	// SealChannelSegments seals all segments of the channel and return sealed segments
+	SealChannelSegments(ctx context.Context, channel string) ([]UniqueID, error)
 	// GetFlushableSegments returns flushable segment ids
 	GetFlushableSegments(ctx context.Context, channel string, ts Timestamp) ([]UniqueID, error)
 	// ExpireAllocations notifies segment status to expire old allocations
 	ExpireAllocations(channel string, ts Timestamp) error
 	// DropSegmentsOfChannel drops all segments in a channel
 	DropSegmentsOfChannel(ctx context.Context, channel string)
+	// CancelFlush reopens the sealed segments of collection whose flush is not started and return them
+	CancelFlush(ctx context.Context, collectionID UniqueID, segmentIDs []UniqueID) ([]UniqueID, error)
 }
 
 // Allocation records the allocation info
@@ -107,6 +116,7 @@ type SegmentManager struct {
 	segmentSealPolicies []segmentSealPolicy
 	channelSealPolicies []channelSealPolicy
 	flushPolicy         flushPolicy
+	allocatedAhead      map[UniqueID]struct{} // segments opened by AllocSegmentAhead and not allocated since
 }
 
 type allocHelper struct {
@@ -200,6 +210,7 @@ func newSegmentManager(meta *meta, allocator allocator, opts ...allocOption) *Se
 		segmentSealPolicies: defaultSegmentSealPolicy(), // default only segment size policy
 		channelSealPolicies: []channelSealPolicy{},      // no default channel seal policy
 		flushPolicy:         defaultFlushPolicy(),
+		allocatedAhead:      make(map[UniqueID]struct{}),
 	}
 	for _, opt := range opts {
 		opt.apply(manager)
@@ -221,6 +232,18 @@ func (s *SegmentManager) loadSegmentsFromMeta() {
 // AllocSegment allocate segment per request collcation, partication, channel and rows
 func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID,
 	partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error) {
+	return s.allocSegment(ctx, collectionID, partitionID, channelName, requestRows, false)
+}
+
+// AllocSegmentAhead allocates rows for a DataNode ahead of time, the segments opened are tracked apart from the
+// others until any other allocation is made to them
+func (s *SegmentManager) AllocSegmentAhead(ctx context.Context, collectionID UniqueID,
+	partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error) {
+	return s.allocSegment(ctx, collectionID, partitionID, channelName, requestRows, true)
+}
+
+func (s *SegmentManager) allocSegment(ctx context.Context, collectionID UniqueID,
+	partitionID UniqueID, channelName string, requestRows int64, ahead bool) ([]*Allocation, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
 	defer sp.Finish()
 	s.mu.Lock()
@@ -248,6 +271,10 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	}
 	newSegmentAllocations, existedSegmentAllocations := s.allocPolicy(segments,
 		requestRows, int64(maxCountPerSegment))
+	if len(newSegmentAllocations) > 0 && int64(maxCountPerSegment) < Params.MinSegmentRowCount {
+		return nil, fmt.Errorf("%w: new segment of collection %d holds at most %d rows, less than %d",
+			errSegmentTooSmall, collectionID, maxCountPerSegment, Params.MinSegmentRowCount)
+	}
 
 	// create new segments and add allocations
 	expireTs, err := s.genExpireTs(ctx)
@@ -264,6 +291,9 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 		if err := s.meta.AddAllocation(segment.GetID(), allocation); err != nil {
 			return nil, err
 		}
+		if ahead {
+			s.allocatedAhead[segment.GetID()] = struct{}{}
+		}
 	}
 
 	for _, allocation := range existedSegmentAllocations {
@@ -271,6 +301,8 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 		if err := s.meta.AddAllocation(allocation.SegmentID, allocation); err != nil {
 			return nil, err
 		}
+		// rows may be written into the segment by whoever is given the allocation
+		delete(s.allocatedAhead, allocation.SegmentID)
 	}
 
 	allocations := append(newSegmentAllocations, existedSegmentAllocations...)
@@ -344,6 +376,7 @@ func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 			break
 		}
 	}
+	delete(s.allocatedAhead, segmentID)
 	segment := s.meta.GetSegment(segmentID)
 	if segment == nil {
 		log.Warn("Failed to get segment", zap.Int64("id", segmentID))
@@ -355,6 +388,44 @@ func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 	}
 }
 
+// DiscardSegment drops the segment allocated ahead of time from the manager and meta, only if no other allocation
+// is made to it and no row is written into it. Otherwise the segment is kept and its allocation expires as usual
+func (s *SegmentManager) DiscardSegment(ctx context.Context, segmentID UniqueID) (bool, error) {
+	sp, _ := trace.StartSpanFromContext(ctx)
+	defer sp.Finish()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.allocatedAhead[segmentID]; !ok {
+		return false, nil
+	}
+	segment := s.meta.GetSegment(segmentID)
+	if segment == nil {
+		delete(s.allocatedAhead, segmentID)
+		return false, nil
+	}
+	if segment.GetState() != commonpb.SegmentState_Growing || segment.GetNumOfRows() > 0 || segment.currRows > 0 ||
+		len(segment.GetBinlogs()) > 0 {
+		return false, nil
+	}
+
+	for i, id := range s.segments {
+		if id == segmentID {
+			s.segments = append(s.segments[:i], s.segments[i+1:]...)
+			break
+		}
+	}
+	delete(s.allocatedAhead, segmentID)
+	s.meta.SetAllocations(segmentID, nil)
+	for _, allocation := range segment.allocations {
+		putAllocation(allocation)
+	}
+	// the segment has no binlog to be collected
+	if err := s.meta.DropSegment(segmentID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // SealAllSegments seals all segmetns of collection with collectionID and return sealed segments
 func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID) ([]UniqueID, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
@@ -383,6 +454,32 @@ func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID Uniqu
 	return ret, nil
 }
 
+// SealChannelSegments seals all segments of the channel and return sealed segments
+func (s *SegmentManager) SealChannelSegments(ctx context.Context, channel string) ([]UniqueID, error) {
+	sp, _ := trace.StartSpanFromContext(ctx)
+	defer sp.Finish()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ret := make([]UniqueID, 0)
+	for _, id := range s.segments {
+		info := s.meta.GetSegment(id)
+		if info == nil {
+			log.Warn("Failed to get seg info from meta", zap.Int64("id", id))
+			continue
+		}
+		if info.InsertChannel != channel {
+			continue
+		}
+		if info.State != commonpb.SegmentState_Sealed {
+			if err := s.meta.SetState(id, commonpb.SegmentState_Sealed); err != nil {
+				return nil, err
+			}
+		}
+		ret = append(ret, id)
+	}
+	return ret, nil
+}
+
 // GetFlushableSegments get segment ids with Sealed State and flushable (meets flushPolicy)
 func (s *SegmentManager) GetFlushableSegments(ctx context.Context, channel string, t Timestamp) ([]UniqueID, error) {
 	s.mu.Lock()
@@ -400,6 +497,9 @@ func (s *SegmentManager) GetFlushableSegments(ctx context.Context, channel strin
 			continue
 		}
 		if s.flushPolicy(info, t) {
+			if err := s.meta.SetFlushStarted(id); err != nil {
+				return nil, err
+			}
 			ret = append(ret, id)
 		}
 	}
@@ -483,6 +583,7 @@ func (s *SegmentManager) DropSegmentsOfChannel(ctx context.Context, channel stri
 			validSegments = append(validSegments, sid)
 			continue
 		}
+		delete(s.allocatedAhead, sid)
 		s.meta.SetAllocations(sid, nil)
 		for _, allocation := range segment.allocations {
 			putAllocation(allocation)
@@ -491,3 +592,51 @@ func (s *SegmentManager) DropSegmentsOfChannel(ctx context.Context, channel stri
 
 	s.segments = validSegments
 }
+
+// segmentFlushStartedError is returned when cancelling the flush of a segment which is flushing or flushed
+type segmentFlushStartedError struct {
+	segmentID UniqueID
+	state     commonpb.SegmentState
+}
+
+func (e *segmentFlushStartedError) Error() string {
+	return fmt.Sprintf("segment %d has started flushing, state %s", e.segmentID, e.state.String())
+}
+
+// CancelFlush reopens the sealed segments of collection, unless any of them has been returned by
+// GetFlushableSegments or is flushing or flushed, segmentFlushStartedError is returned then and no segment is reopened.
+// Segments growing already are returned as they are, so that cancelling twice makes no difference
+func (s *SegmentManager) CancelFlush(ctx context.Context, collectionID UniqueID, segmentIDs []UniqueID) ([]UniqueID, error) {
+	sp, _ := trace.StartSpanFromContext(ctx)
+	defer sp.Finish()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sealed := make([]UniqueID, 0, len(segmentIDs))
+	for _, id := range segmentIDs {
+		info := s.meta.GetSegment(id)
+		if info == nil {
+			return nil, fmt.Errorf("segment %d not found", id)
+		}
+		if info.GetCollectionID() != collectionID {
+			return nil, fmt.Errorf("segment %d doesn't belong to collection %d", id, collectionID)
+		}
+		switch info.GetState() {
+		case commonpb.SegmentState_Growing:
+		case commonpb.SegmentState_Sealed:
+			if info.GetFlushStarted() {
+				return nil, &segmentFlushStartedError{segmentID: id, state: info.GetState()}
+			}
+			sealed = append(sealed, id)
+		default:
+			return nil, &segmentFlushStartedError{segmentID: id, state: info.GetState()}
+		}
+	}
+
+	for _, id := range sealed {
+		if err := s.meta.SetState(id, commonpb.SegmentState_Growing); err != nil {
+			return nil, err
+		}
+	}
+	return segmentIDs, nil
+}
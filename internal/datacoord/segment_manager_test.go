@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"errors"
 	"math"
 	"sync"
 	"testing"
@@ -119,6 +120,22 @@ func TestAllocSegment(t *testing.T) {
 		_, err := segmentManager.AllocSegment(ctx, collID, 100, "c1", 100)
 		assert.NotNil(t, err)
 	})
+
+	t.Run("new segment too small", func(t *testing.T) {
+		maxNumOfRows, err := segmentManager.estimateMaxNumOfRows(collID)
+		assert.Nil(t, err)
+		defer func(origin int64) { Params.MinSegmentRowCount = origin }(Params.MinSegmentRowCount)
+		Params.MinSegmentRowCount = int64(maxNumOfRows) + 1
+
+		// rows are allocated from the existing segment
+		allocations, err := segmentManager.AllocSegment(ctx, collID, 100, "c1", 100)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+
+		_, err = segmentManager.AllocSegment(ctx, collID, 100, "c2", 100)
+		assert.True(t, errors.Is(err, errSegmentTooSmall))
+		assert.Empty(t, meta.GetSegmentsByChannel("c2"))
+	})
 }
 
 func TestLoadSegmentsFromMeta(t *testing.T) {
@@ -579,3 +596,144 @@ func TestSegmentManager_DropSegmentsOfChannel(t *testing.T) {
 		})
 	}
 }
+
+func TestSegmentManager_CancelFlush(t *testing.T) {
+	Params.Init()
+	newManager := func(t *testing.T) (*SegmentManager, *meta, UniqueID, *Allocation) {
+		mockAllocator := newMockAllocator()
+		meta, err := newMemoryMeta(mockAllocator)
+		assert.Nil(t, err)
+		collID, err := mockAllocator.allocID(context.Background())
+		assert.Nil(t, err)
+		meta.AddCollection(&datapb.CollectionInfo{ID: collID, Schema: newTestSchema()})
+		segmentManager := newSegmentManager(meta, mockAllocator)
+		allocations, err := segmentManager.AllocSegment(context.TODO(), collID, 0, "c1", 2)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		_, err = segmentManager.SealAllSegments(context.TODO(), collID)
+		assert.Nil(t, err)
+		return segmentManager, meta, collID, allocations[0]
+	}
+
+	t.Run("cancel sealed segments", func(t *testing.T) {
+		segmentManager, meta, collID, allocation := newManager(t)
+		ids, err := segmentManager.CancelFlush(context.TODO(), collID, []UniqueID{allocation.SegmentID})
+		assert.Nil(t, err)
+		assert.EqualValues(t, []UniqueID{allocation.SegmentID}, ids)
+		assert.EqualValues(t, commonpb.SegmentState_Growing, meta.GetSegment(allocation.SegmentID).GetState())
+
+		// cancel again makes no difference
+		ids, err = segmentManager.CancelFlush(context.TODO(), collID, []UniqueID{allocation.SegmentID})
+		assert.Nil(t, err)
+		assert.EqualValues(t, []UniqueID{allocation.SegmentID}, ids)
+		assert.EqualValues(t, commonpb.SegmentState_Growing, meta.GetSegment(allocation.SegmentID).GetState())
+
+		// not flushable since not sealed
+		ids, err = segmentManager.GetFlushableSegments(context.TODO(), "c1", allocation.ExpireTime)
+		assert.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("cancel segments returned as flushable", func(t *testing.T) {
+		segmentManager, meta, collID, allocation := newManager(t)
+		ids, err := segmentManager.GetFlushableSegments(context.TODO(), "c1", allocation.ExpireTime)
+		assert.Nil(t, err)
+		assert.EqualValues(t, []UniqueID{allocation.SegmentID}, ids)
+
+		_, err = segmentManager.CancelFlush(context.TODO(), collID, []UniqueID{allocation.SegmentID})
+		var started *segmentFlushStartedError
+		assert.True(t, errors.As(err, &started))
+		assert.EqualValues(t, commonpb.SegmentState_Sealed, meta.GetSegment(allocation.SegmentID).GetState())
+
+		// persisted with the segment meta
+		reloaded, err := newMeta(meta.client)
+		assert.Nil(t, err)
+		assert.True(t, reloaded.GetSegment(allocation.SegmentID).GetFlushStarted())
+		_, err = newSegmentManager(reloaded, newMockAllocator()).CancelFlush(context.TODO(), collID, []UniqueID{allocation.SegmentID})
+		assert.True(t, errors.As(err, &started))
+	})
+
+	t.Run("cancel flushed segments", func(t *testing.T) {
+		segmentManager, meta, collID, allocation := newManager(t)
+		flushed := &datapb.SegmentInfo{ID: allocation.SegmentID + 1, CollectionID: collID, State: commonpb.SegmentState_Flushed}
+		err := meta.AddSegment(NewSegmentInfo(flushed))
+		assert.Nil(t, err)
+
+		// no segment is reopened if any of them is flushed
+		_, err = segmentManager.CancelFlush(context.TODO(), collID, []UniqueID{allocation.SegmentID, flushed.GetID()})
+		var started *segmentFlushStartedError
+		assert.True(t, errors.As(err, &started))
+		assert.EqualValues(t, flushed.GetID(), started.segmentID)
+		assert.EqualValues(t, commonpb.SegmentState_Flushed, started.state)
+		assert.EqualValues(t, commonpb.SegmentState_Sealed, meta.GetSegment(allocation.SegmentID).GetState())
+	})
+
+	t.Run("cancel invalid segments", func(t *testing.T) {
+		segmentManager, _, collID, allocation := newManager(t)
+		_, err := segmentManager.CancelFlush(context.TODO(), collID, []UniqueID{allocation.SegmentID + 100})
+		assert.NotNil(t, err)
+		_, err = segmentManager.CancelFlush(context.TODO(), collID+1, []UniqueID{allocation.SegmentID})
+		assert.NotNil(t, err)
+		var started *segmentFlushStartedError
+		assert.False(t, errors.As(err, &started))
+	})
+}
+
+func TestSegmentManager_DiscardSegment(t *testing.T) {
+	Params.Init()
+	newManager := func(t *testing.T) (*SegmentManager, *meta, UniqueID, UniqueID) {
+		mockAllocator := newMockAllocator()
+		meta, err := newMemoryMeta(mockAllocator)
+		assert.Nil(t, err)
+		collID, err := mockAllocator.allocID(context.Background())
+		assert.Nil(t, err)
+		meta.AddCollection(&datapb.CollectionInfo{ID: collID, Schema: newTestSchema()})
+		segmentManager := newSegmentManager(meta, mockAllocator)
+		allocations, err := segmentManager.AllocSegmentAhead(context.TODO(), collID, 0, "c1", 2)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		return segmentManager, meta, collID, allocations[0].SegmentID
+	}
+
+	t.Run("discard segment never used", func(t *testing.T) {
+		segmentManager, meta, _, segmentID := newManager(t)
+		dropped, err := segmentManager.DiscardSegment(context.TODO(), segmentID)
+		assert.Nil(t, err)
+		assert.True(t, dropped)
+		assert.Nil(t, meta.GetSegment(segmentID))
+		assert.NotContains(t, segmentManager.segments, segmentID)
+	})
+
+	t.Run("segment allocated to others", func(t *testing.T) {
+		segmentManager, meta, collID, segmentID := newManager(t)
+		allocations, err := segmentManager.AllocSegment(context.TODO(), collID, 0, "c1", 2)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		assert.EqualValues(t, segmentID, allocations[0].SegmentID)
+
+		dropped, err := segmentManager.DiscardSegment(context.TODO(), segmentID)
+		assert.Nil(t, err)
+		assert.False(t, dropped)
+		assert.NotNil(t, meta.GetSegment(segmentID))
+	})
+
+	t.Run("rows written", func(t *testing.T) {
+		segmentManager, meta, _, segmentID := newManager(t)
+		meta.SetCurrentRows(segmentID, 1)
+		dropped, err := segmentManager.DiscardSegment(context.TODO(), segmentID)
+		assert.Nil(t, err)
+		assert.False(t, dropped)
+		assert.NotNil(t, meta.GetSegment(segmentID))
+	})
+
+	t.Run("segment not allocated ahead of time", func(t *testing.T) {
+		segmentManager, meta, collID, _ := newManager(t)
+		allocations, err := segmentManager.AllocSegment(context.TODO(), collID, 1, "c1", 2)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		dropped, err := segmentManager.DiscardSegment(context.TODO(), allocations[0].SegmentID)
+		assert.Nil(t, err)
+		assert.False(t, dropped)
+		assert.NotNil(t, meta.GetSegment(allocations[0].SegmentID))
+	})
+}
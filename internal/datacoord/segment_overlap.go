@@ -0,0 +1,232 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/minio/minio-go/v7"
+)
+
+// overlapMergeThreshold is the overlap above which two segments are suggested to be merged
+const overlapMergeThreshold = 0.25
+
+// pkRange is the closed range of primary keys in a segment
+type pkRange struct {
+	min, max int64
+}
+
+// overlap returns the length of the intersection of the ranges divided by the length of their union
+func (r pkRange) overlap(other pkRange) float64 {
+	lo, hi := r.min, r.max
+	if other.min > lo {
+		lo = other.min
+	}
+	if other.max < hi {
+		hi = other.max
+	}
+	if lo > hi {
+		return 0
+	}
+	unionLo, unionHi := r.min, r.max
+	if other.min < unionLo {
+		unionLo = other.min
+	}
+	if other.max > unionHi {
+		unionHi = other.max
+	}
+	// converted to float64 first, since the lengths may overflow int64
+	return (float64(hi) - float64(lo) + 1) / (float64(unionHi) - float64(unionLo) + 1)
+}
+
+// pkStats is the part of storage.Int64Stats needed for the primary key range
+type pkStats struct {
+	Min int64 `json:"min"`
+	Max int64 `json:"max"`
+}
+
+// segmentPKRangeCache keeps the primary key ranges of flushed segments read from their stats logs,
+// which never change once the segments are flushed
+type segmentPKRangeCache struct {
+	mu     sync.RWMutex
+	ranges map[UniqueID]pkRange
+}
+
+func newSegmentPKRangeCache() *segmentPKRangeCache {
+	return &segmentPKRangeCache{
+		ranges: make(map[UniqueID]pkRange),
+	}
+}
+
+// get returns the cached primary key range of the segment, it's safe to call on a nil cache
+func (c *segmentPKRangeCache) get(segmentID UniqueID) (pkRange, bool) {
+	if c == nil {
+		return pkRange{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	r, ok := c.ranges[segmentID]
+	return r, ok
+}
+
+// load returns the primary key range of the segment, read from the stats logs of the primary key field with getObject
+// if not cached. false is returned if the segment has no stats logs of the field
+func (c *segmentPKRangeCache) load(ctx context.Context, segment *SegmentInfo, pkFieldID UniqueID,
+	getObject func(ctx context.Context, key string) ([]byte, error)) (pkRange, bool, error) {
+	if r, ok := c.get(segment.GetID()); ok {
+		return r, true, nil
+	}
+
+	var r pkRange
+	found := false
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		if fieldBinlog.GetFieldID() != pkFieldID {
+			continue
+		}
+		for _, path := range fieldBinlog.GetBinlogs() {
+			value, err := getObject(ctx, path)
+			if err != nil {
+				return pkRange{}, false, err
+			}
+			stats := &pkStats{}
+			if err := json.Unmarshal(value, stats); err != nil {
+				return pkRange{}, false, fmt.Errorf("failed to unmarshal stats log %s of segment %d: %w", path, segment.GetID(), err)
+			}
+			if !found || stats.Min < r.min {
+				r.min = stats.Min
+			}
+			if !found || stats.Max > r.max {
+				r.max = stats.Max
+			}
+			found = true
+		}
+	}
+	if !found {
+		return pkRange{}, false, nil
+	}
+
+	c.mu.Lock()
+	c.ranges[segment.GetID()] = r
+	c.mu.Unlock()
+	return r, true, nil
+}
+
+// prune removes the ranges of the segments not kept
+func (c *segmentPKRangeCache) prune(keep func(segmentID UniqueID) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for segmentID := range c.ranges {
+		if !keep(segmentID) {
+			delete(c.ranges, segmentID)
+		}
+	}
+}
+
+// meanOverlap returns the mean pairwise overlap of the segments, 0 if there are fewer than 2 segments
+func meanOverlap(segmentIDs []UniqueID, ranges map[UniqueID]pkRange) float64 {
+	sum, pairs := 0.0, 0
+	for i := range segmentIDs {
+		for j := i + 1; j < len(segmentIDs); j++ {
+			sum += ranges[segmentIDs[i]].overlap(ranges[segmentIDs[j]])
+			pairs++
+		}
+	}
+	if pairs == 0 {
+		return 0
+	}
+	return sum / float64(pairs)
+}
+
+// newOverlapReport builds the overlap report of the segments with the primary key ranges. Segments overlapping
+// each other beyond overlapMergeThreshold, directly or through other segments, are suggested to be merged together
+func newOverlapReport(collectionID, partitionID UniqueID, ranges map[UniqueID]pkRange, skipped []UniqueID) *datapb.OverlapReport {
+	segmentIDs := make([]UniqueID, 0, len(ranges))
+	for segmentID := range ranges {
+		segmentIDs = append(segmentIDs, segmentID)
+	}
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+
+	// groups are found with union-find over the pairs overlapping beyond the threshold
+	parents := make([]int, len(segmentIDs))
+	for i := range parents {
+		parents[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+
+	matrix := make([]*datapb.SegmentOverlapRow, 0, len(segmentIDs))
+	for i, segmentID := range segmentIDs {
+		row := &datapb.SegmentOverlapRow{SegmentID: segmentID, Overlaps: make([]float64, len(segmentIDs))}
+		for j, other := range segmentIDs {
+			row.Overlaps[j] = ranges[segmentID].overlap(ranges[other])
+			if i < j && row.Overlaps[j] >= overlapMergeThreshold {
+				parents[find(j)] = find(i)
+			}
+		}
+		matrix = append(matrix, row)
+	}
+
+	groups := make(map[int][]UniqueID)
+	for i, segmentID := range segmentIDs {
+		root := find(i)
+		groups[root] = append(groups[root], segmentID)
+	}
+	merges := make([]*datapb.SuggestedMerge, 0)
+	for _, group := range groups {
+		if len(group) < 2 {
+			continue
+		}
+		merges = append(merges, &datapb.SuggestedMerge{SegmentIDs: group, OverlapRatio: meanOverlap(group, ranges)})
+	}
+	sort.Slice(merges, func(i, j int) bool {
+		if merges[i].GetOverlapRatio() != merges[j].GetOverlapRatio() {
+			return merges[i].GetOverlapRatio() > merges[j].GetOverlapRatio()
+		}
+		return merges[i].GetSegmentIDs()[0] < merges[j].GetSegmentIDs()[0]
+	})
+
+	return &datapb.OverlapReport{
+		CollectionID:      collectionID,
+		PartitionID:       partitionID,
+		SegmentIDs:        segmentIDs,
+		OverlapMatrix:     matrix,
+		OverlapRatio:      meanOverlap(segmentIDs, ranges),
+		SuggestedMerges:   merges,
+		SkippedSegmentIDs: skipped,
+	}
+}
+
+// getObject reads the object of the key from the object storage
+func (s *Server) getObject(ctx context.Context, key string) ([]byte, error) {
+	object, err := s.storageCli.GetObject(ctx, Params.MinioBucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	return ioutil.ReadAll(object)
+}
@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPKRange_overlap(t *testing.T) {
+	assert.Equal(t, 1.0, pkRange{0, 99}.overlap(pkRange{0, 99}))
+	assert.Equal(t, 0.0, pkRange{0, 99}.overlap(pkRange{100, 199}))
+	assert.InDelta(t, 50.0/150.0, pkRange{0, 99}.overlap(pkRange{50, 149}), 1e-9)
+	assert.InDelta(t, 50.0/150.0, pkRange{50, 149}.overlap(pkRange{0, 99}), 1e-9)
+	// contained range
+	assert.InDelta(t, 0.1, pkRange{0, 99}.overlap(pkRange{10, 19}), 1e-9)
+	// single key ranges
+	assert.Equal(t, 1.0, pkRange{5, 5}.overlap(pkRange{5, 5}))
+	assert.Equal(t, 1.0, pkRange{math.MinInt64, math.MaxInt64}.overlap(pkRange{math.MinInt64, math.MaxInt64}))
+}
+
+func TestSegmentPKRangeCache_load(t *testing.T) {
+	objects := map[string]string{
+		"stats/1/100/1": `{"fieldID":100,"max":200,"min":100,"bf":null}`,
+		"stats/1/100/2": `{"fieldID":100,"max":500,"min":300,"bf":null}`,
+		"stats/1/101/1": `{"fieldID":101,"max":9999,"min":-9999}`,
+		"stats/2/100/1": `bad`,
+	}
+	reads := 0
+	getObject := func(ctx context.Context, key string) ([]byte, error) {
+		reads++
+		value, ok := objects[key]
+		if !ok {
+			return nil, fmt.Errorf("object %s not found", key)
+		}
+		return []byte(value), nil
+	}
+	segment := func(id UniqueID, statslogs ...*datapb.FieldBinlog) *SegmentInfo {
+		return NewSegmentInfo(&datapb.SegmentInfo{ID: id, Statslogs: statslogs})
+	}
+	c := newSegmentPKRangeCache()
+
+	seg1 := segment(1, &datapb.FieldBinlog{FieldID: 100, Binlogs: []string{"stats/1/100/1", "stats/1/100/2"}},
+		&datapb.FieldBinlog{FieldID: 101, Binlogs: []string{"stats/1/101/1"}})
+	r, ok, err := c.load(context.TODO(), seg1, 100, getObject)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, pkRange{100, 500}, r)
+	assert.Equal(t, 2, reads)
+	// cached range is not read again
+	r, ok, err = c.load(context.TODO(), seg1, 100, getObject)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, pkRange{100, 500}, r)
+	assert.Equal(t, 2, reads)
+
+	_, ok, err = c.load(context.TODO(), segment(2, &datapb.FieldBinlog{FieldID: 100, Binlogs: []string{"stats/2/100/1"}}), 100, getObject)
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+	_, ok, err = c.load(context.TODO(), segment(3, &datapb.FieldBinlog{FieldID: 100, Binlogs: []string{"stats/3/100/1"}}), 100, getObject)
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+	_, ok, err = c.load(context.TODO(), segment(4), 100, func(ctx context.Context, key string) ([]byte, error) {
+		return nil, errors.New("unexpected read")
+	})
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	c.prune(func(segmentID UniqueID) bool { return segmentID != 1 })
+	_, ok = c.get(1)
+	assert.False(t, ok)
+	_, ok = (*segmentPKRangeCache)(nil).get(1)
+	assert.False(t, ok)
+}
+
+func TestNewOverlapReport(t *testing.T) {
+	t.Run("disjoint segments", func(t *testing.T) {
+		report := newOverlapReport(1, 10, map[UniqueID]pkRange{2: {100, 199}, 1: {0, 99}}, []UniqueID{3})
+		assert.Equal(t, []UniqueID{1, 2}, report.GetSegmentIDs())
+		assert.Equal(t, 0.0, report.GetOverlapRatio())
+		assert.Empty(t, report.GetSuggestedMerges())
+		assert.Equal(t, []UniqueID{3}, report.GetSkippedSegmentIDs())
+		assert.Equal(t, []float64{1, 0}, report.GetOverlapMatrix()[0].GetOverlaps())
+		assert.Equal(t, []float64{0, 1}, report.GetOverlapMatrix()[1].GetOverlaps())
+	})
+
+	t.Run("fully overlapping segments", func(t *testing.T) {
+		report := newOverlapReport(1, 10, map[UniqueID]pkRange{1: {0, 99}, 2: {0, 99}, 3: {0, 99}}, nil)
+		assert.Equal(t, 1.0, report.GetOverlapRatio())
+		assert.Equal(t, 1, len(report.GetSuggestedMerges()))
+		assert.ElementsMatch(t, []UniqueID{1, 2, 3}, report.GetSuggestedMerges()[0].GetSegmentIDs())
+		assert.Equal(t, 1.0, report.GetSuggestedMerges()[0].GetOverlapRatio())
+	})
+
+	t.Run("overlapping groups", func(t *testing.T) {
+		report := newOverlapReport(1, 10, map[UniqueID]pkRange{
+			// 1 and 3 overlap through 2
+			1: {0, 99},
+			2: {50, 149},
+			3: {100, 199},
+			// 4 and 5 overlap by 0.5
+			4: {1000, 1099},
+			5: {1000, 1049},
+			// 6 overlaps 4 and 5 by less than the threshold
+			6: {1045, 1244},
+			7: {5000, 5099},
+		}, nil)
+		assert.Equal(t, 7, len(report.GetOverlapMatrix()))
+		assert.Greater(t, report.GetOverlapRatio(), 0.0)
+		assert.Less(t, report.GetOverlapRatio(), 1.0)
+		assert.Equal(t, report.GetOverlapMatrix()[0].GetOverlaps()[1], report.GetOverlapMatrix()[1].GetOverlaps()[0])
+
+		merges := report.GetSuggestedMerges()
+		assert.Equal(t, 2, len(merges))
+		assert.ElementsMatch(t, []UniqueID{4, 5}, merges[0].GetSegmentIDs())
+		assert.InDelta(t, 0.5, merges[0].GetOverlapRatio(), 1e-9)
+		assert.ElementsMatch(t, []UniqueID{1, 2, 3}, merges[1].GetSegmentIDs())
+		assert.InDelta(t, (50.0/150+50.0/150)/3, merges[1].GetOverlapRatio(), 1e-9)
+	})
+
+	t.Run("single segment", func(t *testing.T) {
+		report := newOverlapReport(1, 10, map[UniqueID]pkRange{1: {0, 99}}, nil)
+		assert.Equal(t, 0.0, report.GetOverlapRatio())
+		assert.Empty(t, report.GetSuggestedMerges())
+	})
+}
@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// newSegmentPathResponse collects the binlog paths of the segment, insert and stats logs are organized by field,
+// along with the object storage they are in
+func newSegmentPathResponse(segment *SegmentInfo) *datapb.GetSegmentPathResponse {
+	resp := &datapb.GetSegmentPathResponse{
+		InsertPaths: make([]*datapb.FieldBinlog, 0, len(segment.GetBinlogs())),
+		StatsPaths:  make([]*datapb.FieldBinlog, 0, len(segment.GetStatslogs())),
+		DeltaPaths:  make([]string, 0, len(segment.GetDeltalogs())),
+		Endpoint:    Params.MinioAddress,
+		BucketName:  Params.MinioBucketName,
+		UseSsl:      Params.MinioUseSSL,
+	}
+	// copied so that the response never shares slices with meta
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		resp.InsertPaths = append(resp.InsertPaths, &datapb.FieldBinlog{
+			FieldID: fieldBinlog.GetFieldID(),
+			Binlogs: append([]string{}, fieldBinlog.GetBinlogs()...),
+		})
+	}
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		resp.StatsPaths = append(resp.StatsPaths, &datapb.FieldBinlog{
+			FieldID: fieldBinlog.GetFieldID(),
+			Binlogs: append([]string{}, fieldBinlog.GetBinlogs()...),
+		})
+	}
+	for _, deltaLog := range segment.GetDeltalogs() {
+		resp.DeltaPaths = append(resp.DeltaPaths, deltaLog.GetDeltaLogPath())
+	}
+	return resp
+}
+
+// segmentPaths returns all paths in the response
+func segmentPaths(resp *datapb.GetSegmentPathResponse) []string {
+	paths := make([]string, 0, len(resp.GetDeltaPaths()))
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{resp.GetInsertPaths(), resp.GetStatsPaths()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			paths = append(paths, fieldBinlog.GetBinlogs()...)
+		}
+	}
+	return append(paths, resp.GetDeltaPaths()...)
+}
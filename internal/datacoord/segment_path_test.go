@@ -0,0 +1,52 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSegmentPathResponse(t *testing.T) {
+	segment := NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 1,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []string{"insert_log/1/100/1", "insert_log/1/100/2"}},
+			{FieldID: 101, Binlogs: []string{"insert_log/1/101/1"}},
+		},
+		Statslogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"stats_log/1/100/1"}}},
+		Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "delta_log/1/1"}},
+	})
+	resp := newSegmentPathResponse(segment)
+	assert.Equal(t, segment.GetBinlogs(), resp.GetInsertPaths())
+	assert.Equal(t, segment.GetStatslogs(), resp.GetStatsPaths())
+	assert.Equal(t, []string{"delta_log/1/1"}, resp.GetDeltaPaths())
+	assert.Equal(t, Params.MinioAddress, resp.GetEndpoint())
+	assert.Equal(t, Params.MinioBucketName, resp.GetBucketName())
+	assert.Empty(t, resp.GetSignedUrls())
+	assert.Equal(t, []string{"insert_log/1/100/1", "insert_log/1/100/2", "insert_log/1/101/1", "stats_log/1/100/1", "delta_log/1/1"},
+		segmentPaths(resp))
+
+	// paths in response are not shared with meta
+	resp.GetInsertPaths()[0].Binlogs[0] = "modified"
+	assert.Equal(t, "insert_log/1/100/1", segment.GetBinlogs()[0].GetBinlogs()[0])
+
+	resp = newSegmentPathResponse(NewSegmentInfo(&datapb.SegmentInfo{ID: 2}))
+	assert.Empty(t, segmentPaths(resp))
+}
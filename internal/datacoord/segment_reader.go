@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// readSegmentFields returns the schemas and the binlog paths of the fields of fieldIDs, all the fields of schema
+// if fieldIDs is empty. The i-th binlogs of the fields hold the same rows
+func readSegmentFields(segment *SegmentInfo, schema *schemapb.CollectionSchema, fieldIDs []int64) ([]*schemapb.FieldSchema, [][]string, error) {
+	var fields []*schemapb.FieldSchema
+	if len(fieldIDs) == 0 {
+		fields = schema.GetFields()
+	} else {
+		for _, fieldID := range fieldIDs {
+			var field *schemapb.FieldSchema
+			for _, f := range schema.GetFields() {
+				if f.GetFieldID() == fieldID {
+					field = f
+					break
+				}
+			}
+			if field == nil {
+				return nil, nil, fmt.Errorf("field %d not found in collection %d", fieldID, segment.GetCollectionID())
+			}
+			fields = append(fields, field)
+		}
+	}
+
+	binlogs := make([][]string, 0, len(fields))
+	for _, field := range fields {
+		var paths []string
+		for _, fieldBinlog := range segment.GetBinlogs() {
+			if fieldBinlog.GetFieldID() == field.GetFieldID() {
+				paths = fieldBinlog.GetBinlogs()
+				break
+			}
+		}
+		if len(binlogs) > 0 && len(paths) != len(binlogs[0]) {
+			return nil, nil, fmt.Errorf("field %d of segment %d has %d binlogs, %d expected", field.GetFieldID(),
+				segment.GetID(), len(paths), len(binlogs[0]))
+		}
+		binlogs = append(binlogs, paths)
+	}
+	return fields, binlogs, nil
+}
+
+// readSegmentRows reads the rows of the fields of the flushed segment binlog by binlog, and calls send with
+// batches of at most batchSize rows, until maxRows rows are sent if maxRows is positive
+func readSegmentRows(ctx context.Context, segment *SegmentInfo, schema *schemapb.CollectionSchema, fieldIDs []int64,
+	batchSize, maxRows int64, getObject func(ctx context.Context, key string) ([]byte, error),
+	send func(fieldsData []*schemapb.FieldData, numRows int64) error) error {
+	fields, binlogs, err := readSegmentFields(segment, schema, fieldIDs)
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 || len(binlogs[0]) == 0 {
+		return nil
+	}
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	codec := storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: segment.GetCollectionID(), Schema: schema})
+	defer codec.Close()
+	var sent int64
+	for i := range binlogs[0] {
+		blobs := make([]*storage.Blob, 0, len(fields))
+		for j := range fields {
+			value, err := getObject(ctx, binlogs[j][i])
+			if err != nil {
+				return err
+			}
+			blobs = append(blobs, &storage.Blob{Key: binlogs[j][i], Value: value})
+		}
+		_, _, data, err := codec.Deserialize(blobs)
+		if err != nil {
+			return err
+		}
+
+		numRows := -1
+		for _, field := range fields {
+			fieldData, ok := data.Data[field.GetFieldID()]
+			if !ok {
+				return fmt.Errorf("no data of field %d in binlog %s", field.GetFieldID(), binlogs[0][i])
+			}
+			if numRows >= 0 && fieldData.RowNum() != numRows {
+				return fmt.Errorf("fields of binlog %s of segment %d have different number of rows", binlogs[0][i], segment.GetID())
+			}
+			numRows = fieldData.RowNum()
+		}
+
+		for start := 0; start < numRows; start += int(batchSize) {
+			end := start + int(batchSize)
+			if end > numRows {
+				end = numRows
+			}
+			if maxRows > 0 && sent+int64(end-start) > maxRows {
+				end = start + int(maxRows-sent)
+			}
+			fieldsData := make([]*schemapb.FieldData, 0, len(fields))
+			for _, field := range fields {
+				fd, err := fieldDataRows(field, data.Data[field.GetFieldID()], start, end)
+				if err != nil {
+					return err
+				}
+				fieldsData = append(fieldsData, fd)
+			}
+			if err := send(fieldsData, int64(end-start)); err != nil {
+				return err
+			}
+			sent += int64(end - start)
+			if maxRows > 0 && sent >= maxRows {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// fieldDataRows converts the rows in [start, end) of the field data read from binlogs to the field data of responses
+func fieldDataRows(field *schemapb.FieldSchema, data storage.FieldData, start, end int) (*schemapb.FieldData, error) {
+	fd := &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+	}
+	scalars := func(sf *schemapb.ScalarField) {
+		fd.Field = &schemapb.FieldData_Scalars{Scalars: sf}
+	}
+	switch d := data.(type) {
+	case *storage.BoolFieldData:
+		scalars(&schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{
+			BoolData: &schemapb.BoolArray{Data: d.Data[start:end]},
+		}})
+	case *storage.Int8FieldData:
+		ints := make([]int32, 0, end-start)
+		for _, v := range d.Data[start:end] {
+			ints = append(ints, int32(v))
+		}
+		scalars(&schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: ints}}})
+	case *storage.Int16FieldData:
+		ints := make([]int32, 0, end-start)
+		for _, v := range d.Data[start:end] {
+			ints = append(ints, int32(v))
+		}
+		scalars(&schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: ints}}})
+	case *storage.Int32FieldData:
+		scalars(&schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{
+			IntData: &schemapb.IntArray{Data: d.Data[start:end]},
+		}})
+	case *storage.Int64FieldData:
+		scalars(&schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{
+			LongData: &schemapb.LongArray{Data: d.Data[start:end]},
+		}})
+	case *storage.FloatFieldData:
+		scalars(&schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{
+			FloatData: &schemapb.FloatArray{Data: d.Data[start:end]},
+		}})
+	case *storage.DoubleFieldData:
+		scalars(&schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{
+			DoubleData: &schemapb.DoubleArray{Data: d.Data[start:end]},
+		}})
+	case *storage.StringFieldData:
+		scalars(&schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{
+			StringData: &schemapb.StringArray{Data: d.Data[start:end]},
+		}})
+	case *storage.FloatVectorFieldData:
+		fd.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim: int64(d.Dim),
+			Data: &schemapb.VectorField_FloatVector{
+				FloatVector: &schemapb.FloatArray{Data: d.Data[start*d.Dim : end*d.Dim]},
+			},
+		}}
+	case *storage.BinaryVectorFieldData:
+		fd.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim: int64(d.Dim),
+			Data: &schemapb.VectorField_BinaryVector{
+				BinaryVector: d.Data[start*d.Dim/8 : end*d.Dim/8],
+			},
+		}}
+	default:
+		return nil, fmt.Errorf("reading field %d of type %T is not supported", field.GetFieldID(), data)
+	}
+	return fd, nil
+}
@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldDataRows(t *testing.T) {
+	field := &schemapb.FieldSchema{FieldID: 100, Name: "f"}
+	fd, err := fieldDataRows(field, &storage.Int8FieldData{Data: []int8{1, 2, 3}}, 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), fd.GetFieldId())
+	assert.Equal(t, "f", fd.GetFieldName())
+	assert.Equal(t, []int32{2, 3}, fd.GetScalars().GetIntData().GetData())
+
+	fd, err = fieldDataRows(field, &storage.FloatVectorFieldData{Data: []float32{1, 2, 3, 4, 5, 6}, Dim: 2}, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), fd.GetVectors().GetDim())
+	assert.Equal(t, []float32{3, 4}, fd.GetVectors().GetFloatVector().GetData())
+
+	fd, err = fieldDataRows(field, &storage.BinaryVectorFieldData{Data: []byte{1, 2, 3, 4}, Dim: 16}, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3, 4}, fd.GetVectors().GetBinaryVector())
+
+	_, err = fieldDataRows(field, &storage.DynamicFieldData{}, 0, 0)
+	assert.Error(t, err)
+}
+
+func TestReadSegmentRows(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+	codec := storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: 1, Schema: schema})
+	objects := make(map[string][]byte)
+	segment := NewSegmentInfo(&datapb.SegmentInfo{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Flushed,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 100}, {FieldID: 101}}})
+	// two binlogs of rows [1, 2, 3] and [4, 5]
+	for i, pks := range [][]int64{{1, 2, 3}, {4, 5}} {
+		var vectors []float32
+		for _, pk := range pks {
+			vectors = append(vectors, float32(pk), -float32(pk))
+		}
+		blobs, _, err := codec.Serialize(1, 1, &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+			1:   &storage.Int64FieldData{NumRows: []int64{int64(len(pks))}, Data: pks},
+			100: &storage.Int64FieldData{NumRows: []int64{int64(len(pks))}, Data: pks},
+			101: &storage.FloatVectorFieldData{NumRows: []int64{int64(len(pks))}, Data: vectors, Dim: 2},
+		}})
+		require.NoError(t, err)
+		for j, blob := range blobs {
+			path := fmt.Sprintf("insert_log/1/1/1/%s/%d", blob.Key, i+1)
+			objects[path] = blob.Value
+			segment.Binlogs[j].Binlogs = append(segment.Binlogs[j].Binlogs, path)
+		}
+	}
+	getObject := func(ctx context.Context, key string) ([]byte, error) {
+		value, ok := objects[key]
+		if !ok {
+			return nil, fmt.Errorf("object %s not found", key)
+		}
+		return value, nil
+	}
+	read := func(fieldIDs []int64, batchSize, maxRows int64) ([][]*schemapb.FieldData, error) {
+		var batches [][]*schemapb.FieldData
+		err := readSegmentRows(context.Background(), segment, schema, fieldIDs, batchSize, maxRows, getObject,
+			func(fieldsData []*schemapb.FieldData, numRows int64) error {
+				batches = append(batches, fieldsData)
+				return nil
+			})
+		return batches, err
+	}
+
+	batches, err := read(nil, 2, 0)
+	require.NoError(t, err)
+	require.Len(t, batches, 3)
+	assert.Equal(t, []int64{1, 2}, batches[0][0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []float32{1, -1, 2, -2}, batches[0][1].GetVectors().GetFloatVector().GetData())
+	assert.Equal(t, []int64{3}, batches[1][0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{4, 5}, batches[2][0].GetScalars().GetLongData().GetData())
+
+	batches, err = read([]int64{101}, 10, 4)
+	require.NoError(t, err)
+	require.Len(t, batches, 2)
+	require.Len(t, batches[1], 1)
+	assert.Equal(t, []float32{4, -4}, batches[1][0].GetVectors().GetFloatVector().GetData())
+
+	_, err = read([]int64{102}, 10, 0)
+	assert.Error(t, err)
+
+	delete(objects, segment.Binlogs[0].Binlogs[1])
+	_, err = read(nil, 10, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+type sampleCacheKey struct {
+	segmentID  UniqueID
+	sampleRate float64
+}
+
+type sampleCacheEntry struct {
+	resp     *datapb.SampleSegmentResponse
+	expireAt time.Time
+}
+
+// segmentSampleCache keeps the sample results of segments for a ttl, so that repeated inspections of a segment
+// don't read its binlogs again
+type segmentSampleCache struct {
+	mu      sync.Mutex
+	entries map[sampleCacheKey]*sampleCacheEntry
+}
+
+func newSegmentSampleCache() *segmentSampleCache {
+	return &segmentSampleCache{
+		entries: make(map[sampleCacheKey]*sampleCacheEntry),
+	}
+}
+
+// get returns the cached result of sampling the segment at the rate, nil if not cached or expired
+func (c *segmentSampleCache) get(segmentID UniqueID, sampleRate float64, now time.Time) *datapb.SampleSegmentResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[sampleCacheKey{segmentID, sampleRate}]
+	if !ok || !now.Before(entry.expireAt) {
+		return nil
+	}
+	return entry.resp
+}
+
+// put caches the result for ttl, expired entries are evicted meanwhile
+func (c *segmentSampleCache) put(segmentID UniqueID, sampleRate float64, resp *datapb.SampleSegmentResponse, now time.Time, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		if !now.Before(entry.expireAt) {
+			delete(c.entries, key)
+		}
+	}
+	if ttl <= 0 {
+		return
+	}
+	c.entries[sampleCacheKey{segmentID, sampleRate}] = &sampleCacheEntry{resp: resp, expireAt: now.Add(ttl)}
+}
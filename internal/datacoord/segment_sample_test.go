@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentSampleCache(t *testing.T) {
+	c := newSegmentSampleCache()
+	now := time.Now()
+	resp := &datapb.SampleSegmentResponse{SegmentID: 1}
+	assert.Nil(t, c.get(1, 0.5, now))
+
+	c.put(1, 0.5, resp, now, time.Minute)
+	assert.Same(t, resp, c.get(1, 0.5, now.Add(time.Second)))
+	// results of other rates are not shared
+	assert.Nil(t, c.get(1, 0.1, now))
+	assert.Nil(t, c.get(1, 0.5, now.Add(time.Minute)))
+
+	// expired entries are evicted
+	c.put(2, 0.5, &datapb.SampleSegmentResponse{SegmentID: 2}, now.Add(2*time.Minute), time.Minute)
+	assert.Equal(t, 1, len(c.entries))
+
+	// not cached without ttl
+	c.put(3, 0.5, &datapb.SampleSegmentResponse{SegmentID: 3}, now, 0)
+	assert.Nil(t, c.get(3, 0.5, now))
+}
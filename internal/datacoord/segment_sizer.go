@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"go.uber.org/zap"
+)
+
+// adaptiveSegmentMaxSizeKey is the etcd key of the segment max size adapted
+const adaptiveSegmentMaxSizeKey = metaPrefix + "/adaptive-segment-max-size"
+
+// AdaptiveSegmentSizer adjusts the segment max size by the efficiency of merge compaction.
+// The efficiency of a round is the ratio of output to input of the merge compactions completed in it,
+// rows are used to measure the ratio since input and output segments share the same schema.
+// The max size is reduced by a step if the ratio exceeds Params.CompactionEfficiencyThreshold for
+// Params.AdaptiveSegmentSizeRounds consecutive rounds, and increased by a step if the ratio is below the threshold
+type AdaptiveSegmentSizer struct {
+	mu         sync.RWMutex
+	kv         kv.TxnKV
+	maxSize    float64 // MB
+	inputRows  int64   // input rows of merge compactions in the current round
+	outputRows int64   // output rows of merge compactions in the current round
+	highRounds int     // consecutive rounds whose ratio exceeds the threshold
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewAdaptiveSegmentSizer creates an AdaptiveSegmentSizer starting from the max size persisted,
+// or Params.SegmentMaxSize if nothing is persisted
+func NewAdaptiveSegmentSizer(kv kv.TxnKV) (*AdaptiveSegmentSizer, error) {
+	s := &AdaptiveSegmentSizer{
+		kv:      kv,
+		maxSize: Params.SegmentMaxSize,
+		quit:    make(chan struct{}),
+	}
+	_, values, err := kv.LoadWithPrefix(adaptiveSegmentMaxSizeKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) > 0 {
+		maxSize, err := strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			return nil, err
+		}
+		s.maxSize = maxSize
+	}
+	s.maxSize = clampSegmentMaxSize(s.maxSize)
+	return s, nil
+}
+
+func clampSegmentMaxSize(size float64) float64 {
+	return math.Max(Params.MinSegmentMaxSize, math.Min(Params.MaxSegmentMaxSize, size))
+}
+
+// getMaxSize returns the segment max size in MB
+func (s *AdaptiveSegmentSizer) getMaxSize() float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.maxSize
+}
+
+// observe records a merge compaction completed
+func (s *AdaptiveSegmentSizer) observe(inputRows, outputRows int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inputRows += inputRows
+	s.outputRows += outputRows
+}
+
+// adjust ends the current round, and persists the max size if it is changed
+func (s *AdaptiveSegmentSizer) adjust() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inputRows <= 0 {
+		return nil
+	}
+	ratio := float64(s.outputRows) / float64(s.inputRows)
+	s.inputRows, s.outputRows = 0, 0
+
+	maxSize := s.maxSize
+	switch {
+	case ratio > Params.CompactionEfficiencyThreshold:
+		s.highRounds++
+		if s.highRounds < Params.AdaptiveSegmentSizeRounds {
+			return nil
+		}
+		s.highRounds = 0
+		maxSize = clampSegmentMaxSize(maxSize - Params.AdaptiveSegmentSizeStep)
+	case ratio < Params.CompactionEfficiencyThreshold:
+		s.highRounds = 0
+		maxSize = clampSegmentMaxSize(maxSize + Params.AdaptiveSegmentSizeStep)
+	}
+	if maxSize == s.maxSize {
+		return nil
+	}
+
+	if err := s.kv.Save(adaptiveSegmentMaxSizeKey, strconv.FormatFloat(maxSize, 'f', -1, 64)); err != nil {
+		return err
+	}
+	log.Info("adapt segment max size", zap.Float64("ratio", ratio),
+		zap.Float64("from", s.maxSize), zap.Float64("to", maxSize))
+	s.maxSize = maxSize
+	return nil
+}
+
+func (s *AdaptiveSegmentSizer) start() {
+	s.wg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.wg.Done()
+		interval := time.Duration(Params.AdaptiveSegmentSizeInterval) * time.Second
+		if interval <= 0 {
+			interval = time.Second
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.quit:
+				log.Info("adaptive segment sizer exit")
+				return
+			case <-ticker.C:
+				if err := s.adjust(); err != nil {
+					log.Warn("failed to adapt segment max size", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *AdaptiveSegmentSizer) close() {
+	close(s.quit)
+	s.wg.Wait()
+}
@@ -0,0 +1,178 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func setAdaptiveSegmentSizeParams(t *testing.T) {
+	maxSize, threshold, rounds, step := Params.SegmentMaxSize, Params.CompactionEfficiencyThreshold,
+		Params.AdaptiveSegmentSizeRounds, Params.AdaptiveSegmentSizeStep
+	interval, lower, upper := Params.AdaptiveSegmentSizeInterval, Params.MinSegmentMaxSize, Params.MaxSegmentMaxSize
+	t.Cleanup(func() {
+		Params.SegmentMaxSize, Params.CompactionEfficiencyThreshold = maxSize, threshold
+		Params.AdaptiveSegmentSizeRounds, Params.AdaptiveSegmentSizeStep = rounds, step
+		Params.AdaptiveSegmentSizeInterval, Params.MinSegmentMaxSize, Params.MaxSegmentMaxSize = interval, lower, upper
+	})
+	Params.SegmentMaxSize = 512
+	Params.CompactionEfficiencyThreshold = 0.9
+	Params.AdaptiveSegmentSizeRounds = 2
+	Params.AdaptiveSegmentSizeStep = 64
+	Params.AdaptiveSegmentSizeInterval = 600
+	Params.MinSegmentMaxSize = 400
+	Params.MaxSegmentMaxSize = 600
+}
+
+func TestNewAdaptiveSegmentSizer(t *testing.T) {
+	setAdaptiveSegmentSizeParams(t)
+
+	kv := memkv.NewMemoryKV()
+	s, err := NewAdaptiveSegmentSizer(kv)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(512), s.getMaxSize())
+
+	// persisted value survives restarts
+	assert.Nil(t, kv.Save(adaptiveSegmentMaxSizeKey, "448"))
+	s, err = NewAdaptiveSegmentSizer(kv)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(448), s.getMaxSize())
+
+	// bounds may be changed after restart
+	assert.Nil(t, kv.Save(adaptiveSegmentMaxSizeKey, "1024"))
+	s, err = NewAdaptiveSegmentSizer(kv)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(600), s.getMaxSize())
+
+	assert.Nil(t, kv.Save(adaptiveSegmentMaxSizeKey, "bad"))
+	_, err = NewAdaptiveSegmentSizer(kv)
+	assert.NotNil(t, err)
+}
+
+func TestAdaptiveSegmentSizer_adjust(t *testing.T) {
+	setAdaptiveSegmentSizeParams(t)
+
+	kv := memkv.NewMemoryKV()
+	s, err := NewAdaptiveSegmentSizer(kv)
+	assert.Nil(t, err)
+
+	// nothing observed
+	assert.Nil(t, s.adjust())
+	assert.Equal(t, float64(512), s.getMaxSize())
+
+	// ratio below the threshold increases max size
+	s.observe(100, 50)
+	assert.Nil(t, s.adjust())
+	assert.Equal(t, float64(576), s.getMaxSize())
+	value, err := kv.Load(adaptiveSegmentMaxSizeKey)
+	assert.Nil(t, err)
+	assert.Equal(t, "576", value)
+
+	// bounded by MaxSegmentMaxSize
+	s.observe(100, 50)
+	assert.Nil(t, s.adjust())
+	assert.Equal(t, float64(600), s.getMaxSize())
+
+	// max size is reduced after consecutive rounds exceeding the threshold
+	s.observe(100, 95)
+	assert.Nil(t, s.adjust())
+	assert.Equal(t, float64(600), s.getMaxSize())
+	s.observe(100, 95)
+	assert.Nil(t, s.adjust())
+	assert.Equal(t, float64(536), s.getMaxSize())
+
+	// a round below the threshold breaks consecutive rounds
+	s.observe(100, 95)
+	assert.Nil(t, s.adjust())
+	s.observe(100, 50)
+	assert.Nil(t, s.adjust())
+	assert.Equal(t, float64(600), s.getMaxSize())
+	s.observe(100, 95)
+	assert.Nil(t, s.adjust())
+	assert.Equal(t, float64(600), s.getMaxSize())
+
+	// restored from kv
+	s, err = NewAdaptiveSegmentSizer(kv)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(600), s.getMaxSize())
+
+	s.start()
+	s.close()
+}
+
+func TestAdaptiveSegmentSizer_observeMergeCompaction(t *testing.T) {
+	setAdaptiveSegmentSizeParams(t)
+
+	sizer, err := NewAdaptiveSegmentSizer(memkv.NewMemoryKV())
+	assert.Nil(t, err)
+	plan := &datapb.CompactionPlan{
+		PlanID: 1,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: 1, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}},
+			{SegmentID: 2, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}}},
+		},
+		Type: datapb.CompactionType_MergeCompaction,
+	}
+	c := &compactionPlanHandler{
+		plans: map[int64]*compactionTask{
+			1: {triggerInfo: &compactionSignal{id: 1}, state: executing, plan: plan},
+		},
+		meta: &meta{
+			client: memkv.NewMemoryKV(),
+			segments: &SegmentsInfo{
+				map[int64]*SegmentInfo{
+					1: {SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 60}},
+					2: {SegmentInfo: &datapb.SegmentInfo{ID: 2, NumOfRows: 40}},
+				},
+			},
+		},
+		flushCh:      make(chan UniqueID, 1),
+		segmentSizer: sizer,
+	}
+	err = c.completeCompaction(&datapb.CompactionResult{PlanID: 1, SegmentID: 3, NumOfRows: 90})
+	assert.Nil(t, err)
+	assert.EqualValues(t, 100, sizer.inputRows)
+	assert.EqualValues(t, 90, sizer.outputRows)
+}
+
+func TestCalBySchemaWithSizerPolicy(t *testing.T) {
+	setAdaptiveSegmentSizeParams(t)
+
+	sizer, err := NewAdaptiveSegmentSizer(memkv.NewMemoryKV())
+	assert.Nil(t, err)
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{DataType: schemapb.DataType_Int64}},
+	}
+	policy := calBySchemaWithSizerPolicy(sizer)
+	result, err := policy(schema)
+	assert.Nil(t, err)
+	assert.Equal(t, int(512*1024*1024/8), result)
+
+	sizer.observe(100, 10)
+	assert.Nil(t, sizer.adjust())
+	result, err = policy(schema)
+	assert.Nil(t, err)
+	assert.Equal(t, int(576*1024*1024/8), result)
+
+	_, err = policy(nil)
+	assert.NotNil(t, err)
+}
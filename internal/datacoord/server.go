@@ -39,6 +39,7 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -112,12 +113,32 @@ type Server struct {
 	rootCoordClient  types.RootCoord
 	garbageCollector *garbageCollector
 	gcOpt            GcOption
+	storageCli       *minio.Client // OSS client, only initialized when garbage collection is enabled
 
 	compactionTrigger trigger
 	compactionHandler compactionPlanContext
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
+	migratingChannels *channelLocker // channels being migrated by MigrateChannel
+
+	fingerprintValidator *FingerprintValidator // detects segments registered with duplicate binlog paths
+	assignLimiter        *assignRateLimiter    // limits AssignSegmentID requests per collection, nil if no limit
+	segmentSizer         *AdaptiveSegmentSizer // adapts segment max size to compaction efficiency, nil if not enabled
+	compactionHistory    *compactionHistory    // persists compactions completed and limits the records per collection
+	statsCollector       *TimeSeriesCollector  // estimates binlog growth rate of collections, nil if not enabled
+	prefixMigrationMu    sync.Mutex            // serializes MigrateEtcdPrefix requests
+	sampleCache          *segmentSampleCache   // caches SampledSegmentInspector results for Params.SampleCacheTTLSeconds
+	leaseManager         *segmentLeaseManager  // seals growing segments not renewed by DataNodes, nil if not enabled
+	usageCache           *storageUsageCache    // caches GetStorageUsage results for Params.StorageUsageCacheTTLSeconds
+	gcEvents             *gcEventLog           // records objects removed by garbage collection and storage audit
+	retentionManager     *retentionManager     // drops flushed segments out of the retention period of their collection
+	pkRanges             *segmentPKRangeCache  // primary key ranges of flushed segments read by ComputeSegmentOverlap
+	roiCache             *compactionROICache   // caches GetCompactionROI results for Params.CompactionROICacheTTLSeconds
+	freezer              *partitionFreezer     // partitions frozen by FreezePartition, segments of which are not allocated
+	nodeHealth           *dataNodeHealth       // vchannels of DataNodes failing to start, reported by ReportDataNodeHealth
+	dashboard            *dashboardCache       // dashboard payload computed every Params.DashboardRefreshIntervalSeconds
+
 	flushCh   chan UniqueID
 	msFactory msgstream.Factory
 
@@ -187,6 +208,14 @@ func CreateServer(ctx context.Context, factory msgstream.Factory, opts ...Option
 		dataNodeCreator:        defaultDataNodeCreatorFunc,
 		rootCoordClientCreator: defaultRootCoordCreatorFunc,
 		helper:                 defaultServerHelper(),
+		migratingChannels:      newChannelLocker(),
+		sampleCache:            newSegmentSampleCache(),
+		usageCache:             newStorageUsageCache(),
+		pkRanges:               newSegmentPKRangeCache(),
+		roiCache:               newCompactionROICache(),
+		freezer:                newPartitionFreezer(),
+		nodeHealth:             newDataNodeHealth(),
+		dashboard:              newDashboardCache(),
 
 		metricsCacheManager: metricsinfo.NewMetricsCacheManager(),
 	}
@@ -240,10 +269,20 @@ func (s *Server) Start() error {
 	if err != nil {
 		return err
 	}
+	// ReadSegment decodes binlogs of encrypted fields
+	if err = storage.InitColumnKeyManager(Params.ColumnEncryptionFieldKeys, Params.ColumnEncryptionKeys); err != nil {
+		log.Error("invalid column encryption keys", zap.Error(err))
+		return err
+	}
 	if err = s.initRootCoordClient(); err != nil {
 		return err
 	}
 
+	// a partial migration must be found before any meta is loaded from either prefix
+	if err = s.checkEtcdPrefixMigrations(); err != nil {
+		return err
+	}
+
 	if err = s.initMeta(); err != nil {
 		return err
 	}
@@ -253,12 +292,22 @@ func (s *Server) Start() error {
 	}
 
 	s.allocator = newRootCoordAllocator(s.rootCoordClient)
+	if err = s.initSegmentSizer(); err != nil {
+		return err
+	}
 	if Params.EnableCompaction {
 		s.createCompactionHandler()
 		s.createCompactionTrigger()
 	}
 
 	s.startSegmentManager()
+	if err = s.initSegmentLeaseManager(); err != nil {
+		return err
+	}
+	if s.retentionManager, err = newRetentionManager(s.kvClient, s.meta); err != nil {
+		return err
+	}
+	s.assignLimiter = newAssignRateLimiter()
 	if err = s.initServiceDiscovery(); err != nil {
 		return err
 	}
@@ -282,7 +331,7 @@ func (s *Server) initCluster() error {
 	}
 
 	var err error
-	s.channelManager, err = NewChannelManager(s.kvClient, s)
+	s.channelManager, err = NewChannelManager(s.kvClient, s, withSegmentCounter(s.meta.GetNumOfGrowingSegmentsByChannel))
 	if err != nil {
 		return err
 	}
@@ -291,17 +340,64 @@ func (s *Server) initCluster() error {
 	return nil
 }
 
+// initSegmentSizer creates the adaptive segment sizer, which works only if merge compaction is enabled
+func (s *Server) initSegmentSizer() error {
+	if !Params.EnableAdaptiveSegmentSize || !Params.EnableCompaction {
+		return nil
+	}
+	sizer, err := NewAdaptiveSegmentSizer(s.kvClient)
+	if err != nil {
+		return err
+	}
+	s.segmentSizer = sizer
+	s.segmentSizer.start()
+	return nil
+}
+
+// initSegmentLeaseManager creates the segment lease manager, which works only if Params.SegmentLeaseDuration is positive
+func (s *Server) initSegmentLeaseManager() error {
+	if Params.SegmentLeaseDuration <= 0 {
+		return nil
+	}
+	manager, err := newSegmentLeaseManager(s.kvClient, s.meta, time.Duration(Params.SegmentLeaseDuration)*time.Second)
+	if err != nil {
+		return err
+	}
+	s.leaseManager = manager
+	return nil
+}
+
+// getSegmentMaxSize returns the segment max size in MB
+func (s *Server) getSegmentMaxSize() float64 {
+	if s.segmentSizer != nil {
+		return s.segmentSizer.getMaxSize()
+	}
+	return Params.SegmentMaxSize
+}
+
 func (s *Server) createCompactionHandler() {
-	s.compactionHandler = newCompactionPlanHandler(s.sessionManager, s.channelManager, s.meta, s.allocator, s.flushCh)
+	handler := newCompactionPlanHandler(s.sessionManager, s.channelManager, s.meta, s.allocator, s.flushCh)
+	handler.segmentSizer = s.segmentSizer
+	handler.history = newCompactionHistory(s.kvClient, Params.MaxCompactionHistoryPerCollection)
+	handler.history.start()
+	s.compactionHistory = handler.history
+	s.compactionHandler = handler
+	if Params.EnableFairCompactionQueue {
+		s.compactionHandler = newFairQueueCompactionHandler(s.compactionHandler, s.meta)
+	}
 	s.compactionHandler.start()
 }
 
 func (s *Server) stopCompactionHandler() {
 	s.compactionHandler.stop()
+	s.compactionHistory.stop()
 }
 
 func (s *Server) createCompactionTrigger() {
-	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator)
+	trigger := newCompactionTrigger(s.meta, s.compactionHandler, s.allocator)
+	// ranges read by ComputeSegmentOverlap are taken into the merge compaction score
+	trigger.pkRanges = s.pkRanges
+	s.compactionTrigger = trigger
 	s.compactionTrigger.start()
 }
 
@@ -332,6 +428,8 @@ func (s *Server) initGarbageCollection() error {
 		}
 	}
 
+	s.storageCli = cli
+	s.gcEvents = newGCEventLog(Params.GCLogPath)
 	s.garbageCollector = newGarbageCollector(s.meta, GcOption{
 		cli:        cli,
 		enabled:    Params.EnableGarbageCollection,
@@ -341,6 +439,7 @@ func (s *Server) initGarbageCollection() error {
 		checkInterval:    defaultGcInterval,
 		missingTolerance: defaultMissingTolerance,
 		dropTolerance:    defaultMissingTolerance,
+		events:           s.gcEvents,
 	})
 	return nil
 }
@@ -370,7 +469,11 @@ func (s *Server) initServiceDiscovery() error {
 
 func (s *Server) startSegmentManager() {
 	if s.segmentManager == nil {
-		s.segmentManager = newSegmentManager(s.meta, s.allocator)
+		var opts []allocOption
+		if s.segmentSizer != nil {
+			opts = append(opts, withCalUpperLimitPolicy(calBySchemaWithSizerPolicy(s.segmentSizer)))
+		}
+		s.segmentManager = newSegmentManager(s.meta, s.allocator, opts...)
 	}
 }
 
@@ -386,19 +489,41 @@ func (s *Server) initMeta() error {
 		if err != nil {
 			return err
 		}
+		s.fingerprintValidator = NewFingerprintValidator(s.meta, Params.FingerprintBloomSize)
 		return nil
 	}
 	return retry.Do(s.ctx, connectEtcdFn, retry.Attempts(connEtcdMaxRetryTime))
 }
 
+// checkEtcdPrefixMigrations refuses to start if any etcd prefix migration recorded is partial
+func (s *Server) checkEtcdPrefixMigrations() error {
+	rootKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, "")
+	if err != nil {
+		return err
+	}
+	defer rootKV.Close()
+	return checkEtcdPrefixMigrations(rootKV)
+}
+
 func (s *Server) startServerLoop() {
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
-	s.serverLoopWg.Add(4)
+	s.serverLoopWg.Add(5)
 	s.startStatsChannel(s.serverLoopCtx)
 	s.startDataNodeTtLoop(s.serverLoopCtx)
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
 	s.garbageCollector.start()
+	if Params.StatsCollectionIntervalSeconds > 0 {
+		s.statsCollector = newTimeSeriesCollector(s.meta.ListCollectionIDs, s.GetCollectionStatistics)
+		s.statsCollector.start()
+	}
+	s.startDashboardLoop(s.serverLoopCtx)
+	if s.leaseManager != nil {
+		s.leaseManager.start()
+	}
+	if Params.RetentionScanIntervalSeconds > 0 {
+		s.retentionManager.start(time.Duration(Params.RetentionScanIntervalSeconds) * time.Second)
+	}
 	go s.session.LivenessCheck(s.serverLoopCtx, func() {
 		log.Error("Data Coord disconnected from etcd, process will exit", zap.Int64("Server Id", s.session.ServerID))
 		if err := s.Stop(); err != nil {
@@ -616,6 +741,7 @@ func (s *Server) handleSessionEvent(ctx context.Context, event *sessionutil.Sess
 			log.Warn("failed to deregisger node", zap.Int64("id", node.NodeID), zap.String("address", node.Address), zap.Error(err))
 			return err
 		}
+		s.nodeHealth.remove(node.NodeID)
 		s.metricsCacheManager.InvalidateSystemInfoMetrics()
 	default:
 		log.Warn("receive unknown service event type",
@@ -708,15 +834,29 @@ func (s *Server) Stop() error {
 		return nil
 	}
 	log.Debug("dataCoord server shutdown")
+	if err := s.channelManager.SaveHistory(); err != nil {
+		log.Warn("failed to save channel history", zap.Error(err))
+	}
 	s.cluster.Close()
 	s.garbageCollector.close()
+	if s.statsCollector != nil {
+		s.statsCollector.close()
+	}
+	if s.leaseManager != nil {
+		s.leaseManager.close()
+	}
+	s.retentionManager.close()
 	s.stopServerLoop()
+	s.assignLimiter.close()
 	s.session.Revoke(time.Second)
 
 	if Params.EnableCompaction {
 		s.stopCompactionTrigger()
 		s.stopCompactionHandler()
 	}
+	if s.segmentSizer != nil {
+		s.segmentSizer.close()
+	}
 	return nil
 }
 
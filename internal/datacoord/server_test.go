@@ -19,30 +19,46 @@ package datacoord
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
@@ -100,6 +116,69 @@ func TestAssignSegmentID(t *testing.T) {
 		assert.EqualValues(t, 1000, assign.Count)
 	})
 
+	t.Run("new segment too small", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		schema := newTestSchema()
+		svr.meta.AddCollection(&datapb.CollectionInfo{
+			ID:         collID,
+			Schema:     schema,
+			Partitions: []int64{},
+		})
+		defer func(origin int64) { Params.MinSegmentRowCount = origin }(Params.MinSegmentRowCount)
+		Params.MinSegmentRowCount = math.MaxInt64
+		req := &datapb.SegmentIDRequest{
+			Count:        1000,
+			ChannelName:  channel0,
+			CollectionID: collID,
+			PartitionID:  partID,
+		}
+
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			SegmentIDRequests: []*datapb.SegmentIDRequest{req},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 1, len(resp.SegIDAssignments))
+		assign := resp.SegIDAssignments[0]
+		assert.EqualValues(t, commonpb.ErrorCode_SegmentTooSmall, assign.GetStatus().GetErrorCode())
+		assert.EqualValues(t, collID, assign.CollectionID)
+		assert.EqualValues(t, channel0, assign.ChannelName)
+		assert.Empty(t, svr.meta.GetSegmentsByChannel(channel0))
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		schema := newTestSchema()
+		svr.meta.AddCollection(&datapb.CollectionInfo{
+			ID:         collID,
+			Schema:     schema,
+			Partitions: []int64{},
+		})
+		svr.assignLimiter = &assignRateLimiter{
+			rate:    0.001,
+			burst:   1,
+			buckets: make(map[UniqueID]*tokenBucket),
+		}
+		req := &datapb.SegmentIDRequest{
+			Count:        1000,
+			ChannelName:  channel0,
+			CollectionID: collID,
+			PartitionID:  partID,
+		}
+
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			SegmentIDRequests: []*datapb.SegmentIDRequest{req, req},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 2, len(resp.SegIDAssignments))
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.SegIDAssignments[0].GetStatus().GetErrorCode())
+		assert.EqualValues(t, commonpb.ErrorCode_Busy, resp.SegIDAssignments[1].GetStatus().GetErrorCode())
+		assert.EqualValues(t, collID, resp.SegIDAssignments[1].CollectionID)
+	})
+
 	t.Run("with closed server", func(t *testing.T) {
 		req := &datapb.SegmentIDRequest{
 			Count:        100,
@@ -383,7 +462,10 @@ func TestGetCollectionStatistics(t *testing.T) {
 		resp, err := svr.GetCollectionStatistics(svr.ctx, req)
 		assert.Nil(t, err)
 		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
-
+		assert.Equal(t, []*commonpb.KeyValuePair{
+			{Key: "row_count", Value: "0"},
+			{Key: binlogCountStatsKey, Value: "0"},
+		}, resp.GetStats())
 	})
 	t.Run("with closed server", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -567,6 +649,48 @@ func TestGetFlushedSegments(t *testing.T) {
 		}
 	})
 
+	t.Run("paginated", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		for _, id := range []int64{5, 1, 4, 2, 3} {
+			segInfo := &datapb.SegmentInfo{
+				ID:           id,
+				CollectionID: 1,
+				PartitionID:  1,
+				State:        commonpb.SegmentState_Flushed,
+			}
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segInfo)))
+		}
+
+		var segments []int64
+		token := ""
+		for {
+			resp, err := svr.GetFlushedSegments(context.Background(), &datapb.GetFlushedSegmentsRequest{
+				CollectionID: 1,
+				PartitionID:  -1,
+				Limit:        2,
+				PageToken:    token,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+			assert.LessOrEqual(t, len(resp.GetSegments()), 2)
+			segments = append(segments, resp.GetSegments()...)
+			token = resp.GetNextPageToken()
+			if token == "" {
+				break
+			}
+		}
+		assert.Equal(t, []int64{1, 2, 3, 4, 5}, segments)
+
+		resp, err := svr.GetFlushedSegments(context.Background(), &datapb.GetFlushedSegmentsRequest{
+			CollectionID: 1,
+			PartitionID:  -1,
+			Limit:        -1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
 	t.Run("with closed server", func(t *testing.T) {
 		t.Run("with closed server", func(t *testing.T) {
 			svr := newTestServer(t, nil)
@@ -579,6 +703,106 @@ func TestGetFlushedSegments(t *testing.T) {
 	})
 }
 
+func TestGetFlushedSegmentsV2(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+
+	addSegment := func(id, partID int64, state commonpb.SegmentState) {
+		segInfo := &datapb.SegmentInfo{
+			ID:           id,
+			CollectionID: 1,
+			PartitionID:  partID,
+			State:        state,
+			Deltalogs:    []*datapb.DeltaLogInfo{{DeltaLogPath: fmt.Sprintf("deltalog/%d", id)}},
+		}
+		assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segInfo)))
+	}
+	addSegment(1, 1, commonpb.SegmentState_Flushed)
+	addSegment(2, 1, commonpb.SegmentState_Flushing)
+	addSegment(3, 1, commonpb.SegmentState_Growing)
+	addSegment(4, 2, commonpb.SegmentState_Flushed)
+	addSegment(5, 2, commonpb.SegmentState_Flushing)
+	addSegment(6, 2, commonpb.SegmentState_Dropped)
+
+	segmentIDs := func(segments []*datapb.SegmentInfo) []int64 {
+		ids := make([]int64, 0, len(segments))
+		for _, segment := range segments {
+			ids = append(ids, segment.GetID())
+		}
+		return ids
+	}
+
+	t.Run("flushed by default", func(t *testing.T) {
+		resp, err := svr.GetFlushedSegmentsV2(context.Background(), &datapb.GetFlushedSegmentsV2Request{
+			CollectionID: 1,
+			PartitionID:  -1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []int64{1, 4}, segmentIDs(resp.GetSegments()))
+		assert.EqualValues(t, 0, resp.GetNextCursor())
+		for _, segment := range resp.GetSegments() {
+			assert.Empty(t, segment.GetDeltalogs())
+		}
+		// deltalogs in meta are kept
+		assert.NotEmpty(t, svr.meta.GetSegment(1).GetDeltalogs())
+	})
+
+	t.Run("flushed and flushing of partition", func(t *testing.T) {
+		resp, err := svr.GetFlushedSegmentsV2(context.Background(), &datapb.GetFlushedSegmentsV2Request{
+			CollectionID:     1,
+			PartitionID:      2,
+			States:           []commonpb.SegmentState{commonpb.SegmentState_Flushed, commonpb.SegmentState_Flushing},
+			IncludeDeltalogs: true,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []int64{4, 5}, segmentIDs(resp.GetSegments()))
+		for _, segment := range resp.GetSegments() {
+			assert.Equal(t, fmt.Sprintf("deltalog/%d", segment.GetID()), segment.GetDeltalogs()[0].GetDeltaLogPath())
+		}
+	})
+
+	t.Run("paging", func(t *testing.T) {
+		req := &datapb.GetFlushedSegmentsV2Request{
+			CollectionID: 1,
+			PartitionID:  -1,
+			States:       []commonpb.SegmentState{commonpb.SegmentState_Flushed, commonpb.SegmentState_Flushing},
+			Limit:        3,
+		}
+		resp, err := svr.GetFlushedSegmentsV2(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []int64{1, 2, 4}, segmentIDs(resp.GetSegments()))
+		assert.EqualValues(t, 4, resp.GetNextCursor())
+
+		req.Cursor = resp.GetNextCursor()
+		resp, err = svr.GetFlushedSegmentsV2(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []int64{5}, segmentIDs(resp.GetSegments()))
+		assert.EqualValues(t, 0, resp.GetNextCursor())
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		resp, err := svr.GetFlushedSegmentsV2(context.Background(), &datapb.GetFlushedSegmentsV2Request{
+			CollectionID: 1,
+			Limit:        -1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.GetFlushedSegmentsV2(context.Background(), &datapb.GetFlushedSegmentsV2Request{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, serverNotServingErrMsg, resp.GetStatus().GetReason())
+	})
+}
+
 func TestService_WatchServices(t *testing.T) {
 	factory := msgstream.NewPmsFactory()
 	svr, err := CreateServer(context.TODO(), factory)
@@ -743,15 +967,30 @@ func (s *spySegmentManager) AllocSegment(ctx context.Context, collectionID Uniqu
 	panic("not implemented") // TODO: Implement
 }
 
+// AllocSegmentAhead allocates rows for a DataNode ahead of time
+func (s *spySegmentManager) AllocSegmentAhead(ctx context.Context, collectionID UniqueID, partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 // DropSegment drops the segment from manager.
 func (s *spySegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 }
 
+// DiscardSegment drops the segment allocated ahead of time if it's never used
+func (s *spySegmentManager) DiscardSegment(ctx context.Context, segmentID UniqueID) (bool, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 // SealAllSegments seals all segments of collection with collectionID and return sealed segments
 func (s *spySegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID) ([]UniqueID, error) {
 	panic("not implemented") // TODO: Implement
 }
 
+// SealChannelSegments seals all segments of the channel and return sealed segments
+func (s *spySegmentManager) SealChannelSegments(ctx context.Context, channel string) ([]UniqueID, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 // GetFlushableSegments returns flushable segment ids
 func (s *spySegmentManager) GetFlushableSegments(ctx context.Context, channel string, ts Timestamp) ([]UniqueID, error) {
 	panic("not implemented") // TODO: Implement
@@ -767,6 +1006,11 @@ func (s *spySegmentManager) DropSegmentsOfChannel(ctx context.Context, channel s
 	s.spyCh <- struct{}{}
 }
 
+// CancelFlush reopens the sealed segments of collection whose flush is not started and return them
+func (s *spySegmentManager) CancelFlush(ctx context.Context, collectionID UniqueID, segmentIDs []UniqueID) ([]UniqueID, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func TestSaveBinlogPaths(t *testing.T) {
 	t.Run("Normal SaveRequest", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -847,6 +1091,187 @@ func TestSaveBinlogPaths(t *testing.T) {
 		assert.EqualValues(t, segmentInfo.NumOfRows, 10)
 	})
 
+	t.Run("retried request", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0})
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, InsertChannel: "ch1"}))
+		assert.Nil(t, err)
+		err = svr.channelManager.AddNode(0)
+		assert.Nil(t, err)
+		err = svr.channelManager.Watch(&channel{"ch1", 0})
+		assert.Nil(t, err)
+
+		req := &datapb.SaveBinlogPathsRequest{
+			SegmentID: 1,
+			Field2BinlogPaths: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []string{"/by-dev/test/0/1/2/1/Allo1", "/by-dev/test/0/1/2/1/Allo2"}},
+			},
+			Field2StatslogPaths: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []string{"/by-dev/test/0/1/2/1/Stats1"}},
+			},
+		}
+		for i := 0; i < 2; i++ {
+			resp, err := svr.SaveBinlogPaths(context.TODO(), req)
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		}
+
+		segment := svr.meta.GetSegment(1)
+		require.Equal(t, 1, len(segment.GetBinlogs()))
+		assert.Equal(t, []string{"/by-dev/test/0/1/2/1/Allo1", "/by-dev/test/0/1/2/1/Allo2"}, segment.GetBinlogs()[0].GetBinlogs())
+		require.Equal(t, 1, len(segment.GetStatslogs()))
+		assert.Equal(t, []string{"/by-dev/test/0/1/2/1/Stats1"}, segment.GetStatslogs()[0].GetBinlogs())
+	})
+
+	t.Run("segment lease expired", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0})
+		for _, id := range []UniqueID{0, 1} {
+			err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+				ID:            id,
+				InsertChannel: "ch1",
+				State:         commonpb.SegmentState_Growing,
+			}))
+			assert.Nil(t, err)
+		}
+		assert.Nil(t, svr.channelManager.AddNode(0))
+		assert.Nil(t, svr.channelManager.Watch(&channel{"ch1", 0}))
+
+		var err error
+		svr.leaseManager, err = newSegmentLeaseManager(memkv.NewMemoryKV(), svr.meta, time.Minute)
+		assert.Nil(t, err)
+		now := time.Now()
+		svr.leaseManager.expire(now)
+
+		// segment 0 is renewed by the checkpoint, segment 1 expires
+		resp, err := svr.SaveBinlogPaths(context.Background(), &datapb.SaveBinlogPathsRequest{
+			SegmentID:   0,
+			CheckPoints: []*datapb.CheckPoint{{SegmentID: 0, Position: &internalpb.MsgPosition{ChannelName: "ch1"}}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, []UniqueID{1}, svr.leaseManager.expire(now.Add(90*time.Second)))
+		assert.Equal(t, commonpb.SegmentState_Sealed, svr.meta.GetSegment(1).GetState())
+
+		resp, err = svr.SaveBinlogPaths(context.Background(), &datapb.SaveBinlogPathsRequest{
+			SegmentID: 1,
+			Field2BinlogPaths: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []string{"/by-dev/test/0/1/2/1/lease"}},
+			},
+			CheckPoints: []*datapb.CheckPoint{{SegmentID: 1, Position: &internalpb.MsgPosition{ChannelName: "ch1"}}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_SegmentLeaseExpired, resp.GetErrorCode())
+		// binlogs are saved though
+		assert.Equal(t, 1, len(svr.meta.GetSegment(1).GetBinlogs()))
+		assert.Equal(t, commonpb.SegmentState_Sealed, svr.meta.GetSegment(1).GetState())
+
+		// flushing the sealed segment succeeds
+		resp, err = svr.SaveBinlogPaths(context.Background(), &datapb.SaveBinlogPathsRequest{
+			SegmentID: 1,
+			Flushed:   true,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("duplicate binlogs", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0})
+		for _, id := range []UniqueID{0, 1} {
+			err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+				ID:            id,
+				CollectionID:  0,
+				InsertChannel: "ch1",
+				State:         commonpb.SegmentState_Growing,
+			}))
+			assert.Nil(t, err)
+		}
+
+		err := svr.channelManager.AddNode(0)
+		assert.Nil(t, err)
+		err = svr.channelManager.Watch(&channel{"ch1", 0})
+		assert.Nil(t, err)
+
+		save := func(segmentID UniqueID) *commonpb.Status {
+			resp, err := svr.SaveBinlogPaths(context.TODO(), &datapb.SaveBinlogPathsRequest{
+				SegmentID:    segmentID,
+				CollectionID: 0,
+				Field2BinlogPaths: []*datapb.FieldBinlog{
+					{FieldID: 1, Binlogs: []string{"/by-dev/test/0/1/2/1/Allo2", "/by-dev/test/0/1/2/1/Allo1"}},
+				},
+			})
+			assert.Nil(t, err)
+			return resp
+		}
+		assert.EqualValues(t, commonpb.ErrorCode_Success, save(1).GetErrorCode())
+
+		resp := save(0)
+		assert.EqualValues(t, commonpb.ErrorCode_DuplicateSegment, resp.GetErrorCode())
+		assert.Contains(t, resp.GetReason(), "[1]")
+		assert.Empty(t, svr.meta.GetSegment(0).GetBinlogs())
+
+		// retry of the same segment is not duplicate
+		assert.EqualValues(t, commonpb.ErrorCode_Success, save(1).GetErrorCode())
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0})
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            1,
+			CollectionID:  0,
+			InsertChannel: "ch1",
+			State:         commonpb.SegmentState_Growing,
+		}))
+		assert.Nil(t, err)
+
+		err = svr.channelManager.AddNode(0)
+		assert.Nil(t, err)
+		err = svr.channelManager.Watch(&channel{"ch1", 0})
+		assert.Nil(t, err)
+
+		save := func(expectedVersion int64) *commonpb.Status {
+			resp, err := svr.SaveBinlogPaths(context.TODO(), &datapb.SaveBinlogPathsRequest{
+				SegmentID:       1,
+				CollectionID:    0,
+				ExpectedVersion: expectedVersion,
+				Field2BinlogPaths: []*datapb.FieldBinlog{
+					{FieldID: 1, Binlogs: []string{fmt.Sprintf("/by-dev/test/0/1/1/1/%d", expectedVersion)}},
+				},
+			})
+			assert.Nil(t, err)
+			return resp
+		}
+		version := svr.meta.GetSegment(1).GetVersion()
+		assert.EqualValues(t, commonpb.ErrorCode_Success, save(version).GetErrorCode())
+
+		// the version is incremented by the save above
+		resp := save(version)
+		assert.EqualValues(t, commonpb.ErrorCode_VersionMismatch, resp.GetErrorCode())
+		assert.Contains(t, resp.GetReason(), fmt.Sprintf("current version %d", version+1))
+		assert.Equal(t, 1, len(svr.meta.GetSegment(1).GetBinlogs()[0].GetBinlogs()))
+
+		// version is not checked if not provided
+		assert.EqualValues(t, commonpb.ErrorCode_Success, save(0).GetErrorCode())
+	})
+
+	t.Run("segment not found", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		resp, err := svr.SaveBinlogPaths(context.TODO(), &datapb.SaveBinlogPathsRequest{SegmentID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_SegmentNotFound, resp.GetErrorCode())
+	})
+
 	t.Run("with closed server", func(t *testing.T) {
 		svr := newTestServer(t, nil)
 		closeTestServer(t, svr)
@@ -856,6 +1281,53 @@ func TestSaveBinlogPaths(t *testing.T) {
 		assert.Equal(t, serverNotServingErrMsg, resp.GetReason())
 	})
 
+	t.Run("restored segment", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0})
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            1,
+			InsertChannel: "ch1",
+			State:         commonpb.SegmentState_Flushed,
+			Binlogs:       []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"/by-dev/test/0/1/2/1/Allo1", "/by-dev/test/0/1/2/1/Corrupted"}}},
+		}))
+		assert.Nil(t, err)
+		err = svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 2, InsertChannel: "ch1", State: commonpb.SegmentState_Growing}))
+		assert.Nil(t, err)
+
+		// saved by a DataNode not watching the channel, binlogs are replaced
+		req := &datapb.SaveBinlogPathsRequest{
+			Base:      &commonpb.MsgBase{SourceID: 100},
+			SegmentID: 1,
+			Field2BinlogPaths: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []string{"/by-dev/test/0/1/2/1/Allo1"}},
+			},
+			IsRestored: true,
+		}
+		// only admins restore segments
+		defer func(token string) { Params.AdminToken = token }(Params.AdminToken)
+		Params.AdminToken = "secret"
+		resp, err := svr.SaveBinlogPaths(context.TODO(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, util.ErrNotAdmin.Error(), resp.GetReason())
+
+		ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(util.AdminTokenKey, "secret"))
+		resp, err = svr.SaveBinlogPaths(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		segment := svr.meta.GetSegment(1)
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+		require.Equal(t, 1, len(segment.GetBinlogs()))
+		assert.Equal(t, []string{"/by-dev/test/0/1/2/1/Allo1"}, segment.GetBinlogs()[0].GetBinlogs())
+
+		req.SegmentID = 2
+		resp, err = svr.SaveBinlogPaths(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, "segment 2 is Growing, only flushed segment can be restored", resp.GetReason())
+	})
+
 	t.Run("test save dropped segment and remove channel", func(t *testing.T) {
 		spyCh := make(chan struct{}, 1)
 		svr := newTestServer(t, nil, SetSegmentManager(&spySegmentManager{spyCh: spyCh}))
@@ -1532,6 +2004,29 @@ func TestManualCompaction(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
 	})
 
+	t.Run("test manual compaction in dry run", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+		svr.meta = newDryRunTestMeta(t)
+		handler := newCompactionPlanHandler(nil, nil, svr.meta, newMockAllocator(), nil)
+		trigger := newCompactionTrigger(svr.meta, handler, newMockAllocator())
+		trigger.mergeCompactionPolicy = (mergeCompactionFunc)(greedyMergeCompaction)
+		svr.compactionHandler = handler
+		svr.compactionTrigger = trigger
+
+		resp, err := svr.ManualCompaction(context.TODO(), &milvuspb.ManualCompactionRequest{
+			CollectionID: 1,
+			Timetravel:   200,
+			DryRun:       true,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 2, len(resp.GetMergeInfos()))
+		assert.EqualValues(t, 8*300+10, resp.GetSourceSize())
+		assert.EqualValues(t, 8*295, resp.GetEstimatedTargetSize())
+		assert.Empty(t, handler.getCompactionTasks())
+	})
+
 	t.Run("test manual compaction with closed server", func(t *testing.T) {
 		svr := &Server{}
 		svr.isServing = ServerStateStopped
@@ -1553,7 +2048,114 @@ func TestManualCompaction(t *testing.T) {
 	})
 }
 
-func TestGetCompactionStateWithPlans(t *testing.T) {
+func TestPauseCompaction(t *testing.T) {
+	Params.EnableCompaction = true
+	t.Run("test pause and resume compaction", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+		svr.meta = &meta{segments: NewSegmentsInfo()}
+		trigger := newCompactionTrigger(svr.meta, &spyCompactionHandler{}, newMockAllocator())
+		svr.compactionTrigger = trigger
+
+		status, err := svr.PauseCompaction(context.TODO(), &datapb.PauseCompactionRequest{Reason: "maintenance"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err := svr.ManualCompaction(context.TODO(), &milvuspb.ManualCompactionRequest{CollectionID: 1, Timetravel: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Contains(t, resp.GetStatus().GetReason(), "maintenance")
+
+		status, err = svr.ResumeCompaction(context.TODO(), &datapb.ResumeCompactionRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		paused, _ := trigger.isPaused()
+		assert.False(t, paused)
+
+		// resuming compaction not paused succeeds
+		status, err = svr.ResumeCompaction(context.TODO(), &datapb.ResumeCompactionRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("test pause compaction with compaction disabled", func(t *testing.T) {
+		Params.EnableCompaction = false
+		defer func() { Params.EnableCompaction = true }()
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+
+		status, err := svr.PauseCompaction(context.TODO(), &datapb.PauseCompactionRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		status, err = svr.ResumeCompaction(context.TODO(), &datapb.ResumeCompactionRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("test pause compaction with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		status, err := svr.PauseCompaction(context.TODO(), &datapb.PauseCompactionRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), status.GetReason())
+		status, err = svr.ResumeCompaction(context.TODO(), &datapb.ResumeCompactionRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), status.GetReason())
+	})
+}
+
+func TestGetCompactionScoreCard(t *testing.T) {
+	Params.EnableCompaction = true
+	t.Run("test get compaction score card successfully", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"getScoreCards": func(segment *SegmentInfo, tt *timetravel) []*datapb.CompactionScoreCard {
+					return []*datapb.CompactionScoreCard{
+						{PolicyName: datapb.CompactionType_InnerCompaction.String(), Eligible: true},
+					}
+				},
+			},
+		}
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           1,
+			CollectionID: 0,
+			State:        commonpb.SegmentState_Dropped,
+		}))
+		assert.Nil(t, err)
+
+		resp, err := svr.GetCompactionScoreCard(context.TODO(), &datapb.GetCompactionScoreCardRequest{SegmentID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(resp.GetScoreCards()))
+	})
+
+	t.Run("test get compaction score card with segment not found", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.GetCompactionScoreCard(context.TODO(), &datapb.GetCompactionScoreCardRequest{SegmentID: 100})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, "segment 100 not found", resp.GetStatus().GetReason())
+	})
+
+	t.Run("test get compaction score card with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		resp, err := svr.GetCompactionScoreCard(context.TODO(), &datapb.GetCompactionScoreCardRequest{SegmentID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestGetCompactionStateWithPlans(t *testing.T) {
 	t.Run("test get compaction state successfully", func(t *testing.T) {
 		svr := &Server{}
 		svr.isServing = ServerStateHealthy
@@ -1603,252 +2205,1977 @@ func TestGetCompactionStateWithPlans(t *testing.T) {
 	})
 }
 
-func TestOptions(t *testing.T) {
-	t.Run("SetRootCoordCreator", func(t *testing.T) {
-		svr := newTestServer(t, nil)
-		defer closeTestServer(t, svr)
-		var crt rootCoordCreatorFunc = func(ctx context.Context, metaRoot string, endpoints []string) (types.RootCoord, error) {
-			return nil, errors.New("dummy")
+func TestListCompactionPlans(t *testing.T) {
+	Params.EnableCompaction = true
+	newServer := func(t *testing.T) *Server {
+		meta, err := newMemoryMeta(newMockAllocator())
+		assert.Nil(t, err)
+		meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: newTestSchema()})
+		meta.AddCollection(&datapb.CollectionInfo{ID: 2, Schema: newTestSchema()})
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 1, NumOfRows: 100},
+			{ID: 2, CollectionID: 1, NumOfRows: 200},
+			{ID: 3, CollectionID: 2, NumOfRows: 300},
+			{ID: 4, CollectionID: 2, NumOfRows: 400},
+		} {
+			assert.Nil(t, meta.AddSegment(NewSegmentInfo(segment)))
 		}
-		opt := SetRootCoordCreator(crt)
-		assert.NotNil(t, opt)
-		svr.rootCoordClientCreator = nil
-		opt(svr)
-		// testify cannot compare function directly
-		// the behavior is actually undefined
-		assert.NotNil(t, crt)
-		assert.NotNil(t, svr.rootCoordClientCreator)
+
+		now := time.Now()
+		newPlan := func(planID int64, segmentIDs ...int64) *datapb.CompactionPlan {
+			plan := &datapb.CompactionPlan{PlanID: planID}
+			for _, segmentID := range segmentIDs {
+				plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{SegmentID: segmentID})
+			}
+			return plan
+		}
+		tasks := map[int64]*compactionTask{
+			1: {
+				triggerInfo: &compactionSignal{id: 10, collectionID: 1},
+				plan:        newPlan(1, 1, 2),
+				state:       completed,
+				result:      &datapb.CompactionResult{PlanID: 1, NumOfRows: 250},
+				createTime:  now.Add(-time.Hour),
+				endTime:     now.Add(-time.Hour + time.Minute),
+			},
+			// global signal has no collection
+			2: {
+				triggerInfo: &compactionSignal{id: 11, isGlobal: true},
+				plan:        newPlan(2, 3),
+				state:       timeout,
+				createTime:  now.Add(-time.Minute),
+				endTime:     now,
+			},
+			3: {
+				triggerInfo: &compactionSignal{id: 11, isGlobal: true},
+				plan:        newPlan(3, 4),
+				state:       executing,
+				createTime:  now,
+			},
+		}
+
+		svr := &Server{meta: meta}
+		svr.isServing = ServerStateHealthy
+		svr.compactionHandler = &mockCompactionHandler{
+			methods: map[string]interface{}{
+				"getCompactionTasks": func() []*compactionTask {
+					ret := make([]*compactionTask, 0, len(tasks))
+					for _, task := range tasks {
+						ret = append(ret, task)
+					}
+					return ret
+				},
+				"getCompaction": func(planID int64) *compactionTask {
+					return tasks[planID]
+				},
+			},
+		}
+		return svr
+	}
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(newTestSchema())
+	assert.Nil(t, err)
+
+	t.Run("list all plans", func(t *testing.T) {
+		svr := newServer(t)
+		resp, err := svr.ListCompactionPlans(context.TODO(), &datapb.ListCompactionPlansRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 0, resp.GetNextCursor())
+		plans := resp.GetPlans()
+		assert.Equal(t, 3, len(plans))
+		// latest created plan comes first
+		assert.EqualValues(t, []int64{3, 2, 1}, []int64{plans[0].GetPlanID(), plans[1].GetPlanID(), plans[2].GetPlanID()})
+
+		assert.EqualValues(t, 11, plans[1].GetSignalID())
+		assert.EqualValues(t, 2, plans[1].GetCollectionID())
+		assert.Equal(t, datapb.CompactionPlanState_PlanTimeout, plans[1].GetState())
+		assert.EqualValues(t, 60, plans[1].GetElapsedSeconds())
+		assert.EqualValues(t, 300*sizePerRecord, plans[1].GetEstimatedOutputSize())
+
+		assert.EqualValues(t, 1, plans[2].GetCollectionID())
+		assert.Equal(t, datapb.CompactionPlanState_PlanCompleted, plans[2].GetState())
+		assert.EqualValues(t, 2, plans[2].GetNumOfSegments())
+		assert.EqualValues(t, 60, plans[2].GetElapsedSeconds())
+		// rows of result are used for completed plan
+		assert.EqualValues(t, 250*sizePerRecord, plans[2].GetEstimatedOutputSize())
 	})
-	t.Run("SetCluster", func(t *testing.T) {
-		kv := memkv.NewMemoryKV()
-		sessionManager := NewSessionManager()
-		channelManager, err := NewChannelManager(kv, dummyPosProvider{})
+
+	t.Run("filter plans", func(t *testing.T) {
+		svr := newServer(t)
+		resp, err := svr.ListCompactionPlans(context.TODO(), &datapb.ListCompactionPlansRequest{CollectionID: 2})
 		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 2, len(resp.GetPlans()))
 
-		cluster := NewCluster(sessionManager, channelManager)
+		resp, err = svr.ListCompactionPlans(context.TODO(), &datapb.ListCompactionPlansRequest{
+			State: datapb.CompactionPlanState_PlanExecuting,
+		})
 		assert.Nil(t, err)
-		opt := SetCluster(cluster)
-		assert.NotNil(t, opt)
-		svr := newTestServer(t, nil, opt)
-		defer closeTestServer(t, svr)
+		assert.Equal(t, 1, len(resp.GetPlans()))
+		assert.EqualValues(t, 3, resp.GetPlans()[0].GetPlanID())
 
-		assert.Same(t, cluster, svr.cluster)
+		resp, err = svr.ListCompactionPlans(context.TODO(), &datapb.ListCompactionPlansRequest{
+			CreatedAfter: tsoutil.ComposeTS(time.Now().Add(-10*time.Minute).UnixNano()/int64(time.Millisecond), 0),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(resp.GetPlans()))
 	})
-	t.Run("SetDataNodeCreator", func(t *testing.T) {
-		var target int64
-		var val int64 = rand.Int63()
-		opt := SetDataNodeCreator(func(context.Context, string) (types.DataNode, error) {
-			target = val
-			return nil, nil
+
+	t.Run("page plans", func(t *testing.T) {
+		svr := newServer(t)
+		resp, err := svr.ListCompactionPlans(context.TODO(), &datapb.ListCompactionPlansRequest{Limit: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 2, len(resp.GetPlans()))
+		assert.EqualValues(t, 2, resp.GetNextCursor())
+
+		resp, err = svr.ListCompactionPlans(context.TODO(), &datapb.ListCompactionPlansRequest{
+			Limit:  2,
+			Cursor: resp.GetNextCursor(),
 		})
-		assert.NotNil(t, opt)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(resp.GetPlans()))
+		assert.EqualValues(t, 1, resp.GetPlans()[0].GetPlanID())
+		assert.EqualValues(t, 0, resp.GetNextCursor())
 
-		factory := msgstream.NewPmsFactory()
+		resp, err = svr.ListCompactionPlans(context.TODO(), &datapb.ListCompactionPlansRequest{Cursor: 100})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
 
-		svr, err := CreateServer(context.TODO(), factory, opt)
+		resp, err = svr.ListCompactionPlans(context.TODO(), &datapb.ListCompactionPlansRequest{Limit: -1})
 		assert.Nil(t, err)
-		dn, err := svr.dataNodeCreator(context.Background(), "")
-		assert.Nil(t, dn)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		resp, err := svr.ListCompactionPlans(context.Background(), &datapb.ListCompactionPlansRequest{})
 		assert.Nil(t, err)
-		assert.Equal(t, target, val)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
 	})
 }
 
-type mockPolicyFactory struct {
-	ChannelPolicyFactoryV1
+// mockMinio serves DELETE requests of object storage and records the objects removed
+type mockMinio struct {
+	mu      sync.Mutex
+	removed []string
+	fail    bool
 }
 
-// NewRegisterPolicy create a new register policy
-func (p *mockPolicyFactory) NewRegisterPolicy() RegisterPolicy {
-	return EmptyRegister
+func newMockMinio(t *testing.T) (*mockMinio, *minio.Client) {
+	m := &mockMinio{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if r.Method != http.MethodDelete || m.fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		m.removed = append(m.removed, strings.TrimPrefix(r.URL.Path, "/"+Params.MinioBucketName+"/"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(ts.Close)
+	cli, err := minio.New(strings.TrimPrefix(ts.URL, "http://"), &minio.Options{
+		Creds:  credentials.NewStaticV4("minioadmin", "minioadmin", ""),
+		Region: "us-east-1",
+	})
+	require.NoError(t, err)
+	return m, cli
 }
 
-// NewDeregisterPolicy create a new dereigster policy
-func (p *mockPolicyFactory) NewDeregisterPolicy() DeregisterPolicy {
-	return EmptyDeregisterPolicy
-}
+func TestReclaimFailedCompaction(t *testing.T) {
+	Params.EnableCompaction = true
+	result := &datapb.CompactionResult{
+		PlanID:              1,
+		SegmentID:           3,
+		InsertLogs:          []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"insert_log/1", "insert_log/2"}}},
+		Field2StatslogPaths: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"stats_log/1"}}},
+		Deltalogs:           []*datapb.DeltaLogInfo{{DeltaLogPath: "delta_log/1"}},
+	}
+	newServer := func(t *testing.T) (*Server, *mockMinio) {
+		m, cli := newMockMinio(t)
+		svr := &Server{storageCli: cli}
+		svr.isServing = ServerStateHealthy
+		svr.compactionHandler = &compactionPlanHandler{
+			plans: map[int64]*compactionTask{
+				1: {plan: &datapb.CompactionPlan{PlanID: 1}, state: failed, result: result},
+				2: {plan: &datapb.CompactionPlan{PlanID: 2}, state: completed, result: &datapb.CompactionResult{PlanID: 2}},
+			},
+		}
+		return svr, m
+	}
 
-func TestHandleSessionEvent(t *testing.T) {
-	kv := memkv.NewMemoryKV()
-	channelManager, err := NewChannelManager(kv, dummyPosProvider{}, withFactory(&mockPolicyFactory{}))
-	assert.Nil(t, err)
-	sessionManager := NewSessionManager()
-	cluster := NewCluster(sessionManager, channelManager)
-	assert.Nil(t, err)
+	t.Run("reclaim failed compaction", func(t *testing.T) {
+		svr, m := newServer(t)
+		resp, err := svr.ReclaimFailedCompaction(context.TODO(), &datapb.ReclaimFailedCompactionRequest{PlanID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.ElementsMatch(t, []string{"insert_log/1", "insert_log/2", "stats_log/1", "delta_log/1"}, m.removed)
+		assert.Equal(t, reclaimed, svr.compactionHandler.getCompaction(1).state)
 
-	err = cluster.Startup(nil)
-	assert.Nil(t, err)
-	defer cluster.Close()
+		// reclaiming again succeeds without removing files
+		resp, err = svr.ReclaimFailedCompaction(context.TODO(), &datapb.ReclaimFailedCompactionRequest{PlanID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, 4, len(m.removed))
+	})
 
-	svr := newTestServer(t, nil, SetCluster(cluster))
-	defer closeTestServer(t, svr)
-	t.Run("handle events", func(t *testing.T) {
-		// None event
-		evt := &sessionutil.SessionEvent{
-			EventType: sessionutil.SessionNoneEvent,
-			Session: &sessionutil.Session{
-				ServerID:   0,
-				ServerName: "",
-				Address:    "",
-				Exclusive:  false,
-			},
-		}
-		err = svr.handleSessionEvent(context.Background(), evt)
+	t.Run("storage fails", func(t *testing.T) {
+		svr, m := newServer(t)
+		m.fail = true
+		resp, err := svr.ReclaimFailedCompaction(context.TODO(), &datapb.ReclaimFailedCompactionRequest{PlanID: 1})
 		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		// plan can be reclaimed later
+		assert.Equal(t, failed, svr.compactionHandler.getCompaction(1).state)
+	})
 
-		evt = &sessionutil.SessionEvent{
-			EventType: sessionutil.SessionAddEvent,
-			Session: &sessionutil.Session{
-				ServerID:   101,
-				ServerName: "DN101",
-				Address:    "DN127.0.0.101",
-				Exclusive:  false,
-			},
-		}
-		err = svr.handleSessionEvent(context.Background(), evt)
+	t.Run("plan not failed", func(t *testing.T) {
+		svr, m := newServer(t)
+		resp, err := svr.ReclaimFailedCompaction(context.TODO(), &datapb.ReclaimFailedCompactionRequest{PlanID: 2})
 		assert.Nil(t, err)
-		dataNodes := svr.cluster.GetSessions()
-		assert.EqualValues(t, 1, len(dataNodes))
-		assert.EqualValues(t, "DN127.0.0.101", dataNodes[0].info.Address)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Empty(t, m.removed)
+	})
 
-		evt = &sessionutil.SessionEvent{
-			EventType: sessionutil.SessionDelEvent,
-			Session: &sessionutil.Session{
-				ServerID:   101,
-				ServerName: "DN101",
-				Address:    "DN127.0.0.101",
-				Exclusive:  false,
-			},
-		}
-		err = svr.handleSessionEvent(context.Background(), evt)
+	t.Run("plan not found", func(t *testing.T) {
+		svr, _ := newServer(t)
+		resp, err := svr.ReclaimFailedCompaction(context.TODO(), &datapb.ReclaimFailedCompactionRequest{PlanID: 3})
 		assert.Nil(t, err)
-		dataNodes = svr.cluster.GetSessions()
-		assert.EqualValues(t, 0, len(dataNodes))
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
 	})
 
-	t.Run("nil evt", func(t *testing.T) {
-		assert.NotPanics(t, func() {
-			err = svr.handleSessionEvent(context.Background(), nil)
-			assert.Nil(t, err)
-		})
+	t.Run("storage not initialized", func(t *testing.T) {
+		svr, _ := newServer(t)
+		svr.storageCli = nil
+		resp, err := svr.ReclaimFailedCompaction(context.TODO(), &datapb.ReclaimFailedCompactionRequest{PlanID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
 	})
-}
 
-type rootCoordSegFlushComplete struct {
-	mockRootCoordService
-	flag bool
+	t.Run("with closed server", func(t *testing.T) {
+		svr, _ := newServer(t)
+		svr.isServing = ServerStateStopped
+		resp, err := svr.ReclaimFailedCompaction(context.TODO(), &datapb.ReclaimFailedCompactionRequest{PlanID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetReason())
+	})
 }
 
-//SegmentFlushCompleted, override default behavior
-func (rc *rootCoordSegFlushComplete) SegmentFlushCompleted(ctx context.Context, req *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error) {
-	if rc.flag {
-		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+func TestCancelCompaction(t *testing.T) {
+	Params.EnableCompaction = true
+	newServer := func(t *testing.T) (*Server, chan interface{}) {
+		ch := make(chan interface{}, 1)
+		svr := newTestServer(t, ch)
+		assert.Nil(t, svr.cluster.Register(&NodeInfo{Address: "localhost:7777", NodeID: 0}))
+		// the mock DataNode reports the output segment sharing the plan id
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, State: commonpb.SegmentState_Flushed},
+			{ID: 2, State: commonpb.SegmentState_Flushed},
+			{ID: 10, State: commonpb.SegmentState_Flushed},
+			{ID: 12, State: commonpb.SegmentState_Flushed},
+		} {
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segment)))
+		}
+		svr.compactionHandler = &compactionPlanHandler{
+			meta: svr.meta,
+			plans: map[int64]*compactionTask{
+				10: {state: executing, plan: &datapb.CompactionPlan{PlanID: 10, Type: datapb.CompactionType_MergeCompaction,
+					SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 2}}}},
+				11: {state: completed, plan: &datapb.CompactionPlan{PlanID: 11}},
+				12: {state: executing, plan: &datapb.CompactionPlan{PlanID: 12, Type: datapb.CompactionType_InnerCompaction,
+					SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 12}}}},
+				13: {state: timeout, plan: &datapb.CompactionPlan{PlanID: 13}},
+			},
+			executingTaskNum: 2,
+		}
+		return svr, ch
 	}
-	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}, nil
-}
 
-func TestPostFlush(t *testing.T) {
-	t.Run("segment not found", func(t *testing.T) {
-		svr := newTestServer(t, nil)
+	t.Run("cancel merge compaction", func(t *testing.T) {
+		svr, ch := newServer(t)
 		defer closeTestServer(t, svr)
 
-		err := svr.postFlush(context.Background(), 1)
-		assert.EqualValues(t, errors.New("segment not found"), err)
+		resp, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{PlanID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, datapb.CompactionPlanState_PlanCancelled, resp.GetState())
+		req := <-ch
+		assert.EqualValues(t, 10, req.(*datapb.CancelCompactionRequest).GetPlanID())
+		assert.Equal(t, cancelled, svr.compactionHandler.getCompaction(10).state)
+		// output segment is dropped, input segments are kept
+		assert.Equal(t, commonpb.SegmentState_Dropped, svr.meta.GetSegment(10).GetState())
+		assert.Equal(t, commonpb.SegmentState_Flushed, svr.meta.GetSegment(1).GetState())
+		assert.Equal(t, commonpb.SegmentState_Flushed, svr.meta.GetSegment(2).GetState())
 	})
-	t.Run("failed to sync with Rootcoord", func(t *testing.T) {
+
+	t.Run("cancel inner compaction", func(t *testing.T) {
+		svr, ch := newServer(t)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{PlanID: 12})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, datapb.CompactionPlanState_PlanCancelled, resp.GetState())
+		<-ch
+		assert.Equal(t, commonpb.SegmentState_Flushed, svr.meta.GetSegment(12).GetState())
+	})
+
+	t.Run("plan already completed", func(t *testing.T) {
+		svr, ch := newServer(t)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{PlanID: 11})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, datapb.CompactionPlanState_PlanCompleted, resp.GetState())
+		assert.Equal(t, 0, len(ch))
+	})
+
+	t.Run("plan not executing", func(t *testing.T) {
+		svr, _ := newServer(t)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{PlanID: 13})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		resp, err = svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{PlanID: 14})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{PlanID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestPinSegments(t *testing.T) {
+	defer func(token string) { Params.AdminToken = token }(Params.AdminToken)
+	Params.AdminToken = "secret"
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(util.AdminTokenKey, "secret"))
+
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+
+	for _, id := range []int64{1, 2, 3} {
+		segInfo := &datapb.SegmentInfo{ID: id, CollectionID: 1, State: commonpb.SegmentState_Flushed}
+		assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segInfo)))
+	}
+
+	t.Run("pin and unpin segments", func(t *testing.T) {
+		resp, err := svr.PinSegments(ctx, &datapb.PinSegmentsRequest{CollectionID: 1, SegmentIDs: []int64{1, 2}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+
+		infoResp, err := svr.GetSegmentInfo(context.TODO(), &datapb.GetSegmentInfoRequest{SegmentIDs: []int64{1, 2, 3}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, infoResp.GetStatus().GetErrorCode())
+		pinned := make(map[int64]bool)
+		for _, info := range infoResp.GetInfos() {
+			pinned[info.GetID()] = info.GetPinned()
+		}
+		assert.Equal(t, map[int64]bool{1: true, 2: true, 3: false}, pinned)
+		assert.Equal(t, []int64{1, 2}, svr.getDataCoordMetrics().CompactionOverview.PinnedSegments)
+
+		resp, err = svr.UnpinSegments(ctx, &datapb.UnpinSegmentsRequest{CollectionID: 1, SegmentIDs: []int64{1}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.False(t, svr.meta.GetSegment(1).GetPinned())
+		assert.Equal(t, []int64{2}, svr.getDataCoordMetrics().CompactionOverview.PinnedSegments)
+	})
+
+	t.Run("not admin", func(t *testing.T) {
+		resp, err := svr.PinSegments(context.TODO(), &datapb.PinSegmentsRequest{CollectionID: 1, SegmentIDs: []int64{3}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, util.ErrNotAdmin.Error(), resp.GetReason())
+		assert.False(t, svr.meta.GetSegment(3).GetPinned())
+
+		resp, err = svr.UnpinSegments(context.TODO(), &datapb.UnpinSegmentsRequest{CollectionID: 1, SegmentIDs: []int64{2}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.True(t, svr.meta.GetSegment(2).GetPinned())
+	})
+
+	t.Run("segment not found", func(t *testing.T) {
+		resp, err := svr.PinSegments(ctx, &datapb.PinSegmentsRequest{CollectionID: 2, SegmentIDs: []int64{3}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.False(t, svr.meta.GetSegment(3).GetPinned())
+	})
+
+	t.Run("no segment specified", func(t *testing.T) {
+		resp, err := svr.UnpinSegments(ctx, &datapb.UnpinSegmentsRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.PinSegments(ctx, &datapb.PinSegmentsRequest{CollectionID: 1, SegmentIDs: []int64{1}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetReason())
+	})
+}
+
+func TestListManagedCollections(t *testing.T) {
+	t.Run("list managed collections", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		schema := newTestSchema()
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: schema})
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 3, Schema: schema})
+		segments := []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Growing, NumOfRows: 10,
+				StartPosition: &internalpb.MsgPosition{Timestamp: 200}},
+			{ID: 2, CollectionID: 1, State: commonpb.SegmentState_Sealed, NumOfRows: 20,
+				StartPosition: &internalpb.MsgPosition{Timestamp: 100}},
+			{ID: 3, CollectionID: 1, State: commonpb.SegmentState_Flushed, NumOfRows: 30,
+				StartPosition: &internalpb.MsgPosition{Timestamp: 50}},
+			{ID: 4, CollectionID: 1, State: commonpb.SegmentState_Dropped, NumOfRows: 40},
+			// collection only known from segments
+			{ID: 5, CollectionID: 2, State: commonpb.SegmentState_Flushed, NumOfRows: 50},
+		}
+		for _, segment := range segments {
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segment)))
+		}
+
+		resp, err := svr.ListManagedCollections(context.TODO(), &datapb.ListManagedCollectionsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 3, len(resp.GetCollections()))
+
+		sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+		assert.Nil(t, err)
+		coll1 := resp.GetCollections()[0]
+		assert.EqualValues(t, 1, coll1.GetCollectionID())
+		assert.Equal(t, []*datapb.SegmentStateCount{
+			{State: commonpb.SegmentState_Growing, Count: 1},
+			{State: commonpb.SegmentState_Sealed, Count: 1},
+			{State: commonpb.SegmentState_Flushed, Count: 1},
+			{State: commonpb.SegmentState_Dropped, Count: 1},
+		}, coll1.GetSegmentCounts())
+		assert.EqualValues(t, 60, coll1.GetNumOfRows())
+		assert.EqualValues(t, 60*sizePerRecord, coll1.GetEstimatedSize())
+		assert.EqualValues(t, 100, coll1.GetOldestUnflushedTimestamp())
+		assert.Equal(t, !Params.EnableCompaction, coll1.GetCompactionPaused())
+
+		coll2 := resp.GetCollections()[1]
+		assert.EqualValues(t, 2, coll2.GetCollectionID())
+		assert.EqualValues(t, 50, coll2.GetNumOfRows())
+		// schema is not cached
+		assert.EqualValues(t, 0, coll2.GetEstimatedSize())
+		assert.EqualValues(t, 0, coll2.GetOldestUnflushedTimestamp())
+
+		coll3 := resp.GetCollections()[2]
+		assert.EqualValues(t, 3, coll3.GetCollectionID())
+		assert.Empty(t, coll3.GetSegmentCounts())
+		assert.EqualValues(t, 0, coll3.GetNumOfRows())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.ListManagedCollections(context.TODO(), &datapb.ListManagedCollectionsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestMigrateEtcdPrefix(t *testing.T) {
+	t.Run("migrate etcd prefix", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		defer func(token string) { Params.AdminToken = token }(Params.AdminToken)
+		Params.AdminToken = "secret"
+		ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(util.AdminTokenKey, "secret"))
+
+		rootKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, "")
+		require.NoError(t, err)
+		defer rootKV.Close()
+		suffix := strconv.Itoa(rand.Int())
+		oldPrefix, newPrefix := "test-migrate-old-"+suffix, "test-migrate-new-"+suffix
+		defer rootKV.RemoveWithPrefix(newPrefix)
+		require.NoError(t, rootKV.MultiSave(map[string]string{oldPrefix + "/a": "1", oldPrefix + "/b": "2"}))
+
+		// not admin
+		resp, err := svr.MigrateEtcdPrefix(context.TODO(), &datapb.MigrateEtcdPrefixRequest{
+			OldPrefix: oldPrefix,
+			NewPrefix: newPrefix,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		// the meta root path of the server is in use
+		resp, err = svr.MigrateEtcdPrefix(ctx, &datapb.MigrateEtcdPrefixRequest{
+			OldPrefix: Params.MetaRootPath,
+			NewPrefix: newPrefix,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		resp, err = svr.MigrateEtcdPrefix(ctx, &datapb.MigrateEtcdPrefixRequest{
+			OldPrefix: oldPrefix,
+			NewPrefix: newPrefix,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 2, resp.GetMigratedKeys())
+		value, err := rootKV.Load(newPrefix + "/b")
+		assert.NoError(t, err)
+		assert.Equal(t, "2", value)
+		assert.NoError(t, svr.checkEtcdPrefixMigrations())
+
+		// new prefix is not empty
+		resp, err = svr.MigrateEtcdPrefix(ctx, &datapb.MigrateEtcdPrefixRequest{
+			OldPrefix: "test-migrate-empty-" + suffix,
+			NewPrefix: newPrefix,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.MigrateEtcdPrefix(context.TODO(), &datapb.MigrateEtcdPrefixRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestRegisterDataNodeQuota(t *testing.T) {
+	t.Run("register quota", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.RegisterDataNodeQuota(context.TODO(), &datapb.RegisterDataNodeQuotaRequest{
+			NodeID:      1,
+			MaxSegments: 10,
+			MaxChannels: 2,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, &ResourceQuota{MaxSegments: 10, MaxChannels: 2}, svr.channelManager.GetResourceQuota(1))
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.RegisterDataNodeQuota(context.TODO(), &datapb.RegisterDataNodeQuotaRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetReason())
+	})
+}
+
+func TestStorageAudit(t *testing.T) {
+	t.Run("storage not initialized", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.storageCli = nil
+
+		resp, err := svr.StorageAudit(context.TODO(), &datapb.StorageAuditRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, errStorageNotInitialized.Error(), resp.GetStatus().GetReason())
+	})
+
+	t.Run("remove without admin token", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		defer func(token string) { Params.AdminToken = token }(Params.AdminToken)
+		Params.AdminToken = "secret"
+
+		resp, err := svr.StorageAudit(context.TODO(), &datapb.StorageAuditRequest{Remove: true})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, util.ErrNotAdmin.Error(), resp.GetStatus().GetReason())
+
+		ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(util.AdminTokenKey, "secret"))
+		svr.storageCli = nil
+		resp, err = svr.StorageAudit(ctx, &datapb.StorageAuditRequest{Remove: true})
+		assert.Nil(t, err)
+		assert.Equal(t, errStorageNotInitialized.Error(), resp.GetStatus().GetReason())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.StorageAudit(context.TODO(), &datapb.StorageAuditRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestSampledSegmentInspector(t *testing.T) {
+	newServer := func(t *testing.T) *Server {
+		svr := newTestServer(t, nil)
+		assert.Nil(t, svr.cluster.Register(&NodeInfo{Address: "localhost:7777", NodeID: 0}))
+		assert.Nil(t, svr.channelManager.Watch(&channel{"ch-1", 0}))
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, InsertChannel: "ch-1", State: commonpb.SegmentState_Flushed},
+			{ID: 2, InsertChannel: "ch-2", State: commonpb.SegmentState_Flushed},
+		} {
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segment)))
+		}
+		return svr
+	}
+
+	t.Run("inspect segment", func(t *testing.T) {
+		svr := newServer(t)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.SampledSegmentInspector(context.TODO(), &datapb.SampleSegmentRequest{SegmentID: 1, SampleRate: 0.5})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 1, resp.GetSegmentID())
+		assert.EqualValues(t, 50, resp.GetSampledRows())
+
+		// the result is cached
+		cached, err := svr.SampledSegmentInspector(context.TODO(), &datapb.SampleSegmentRequest{SegmentID: 1, SampleRate: 0.5})
+		assert.Nil(t, err)
+		assert.Same(t, resp, cached)
+		other, err := svr.SampledSegmentInspector(context.TODO(), &datapb.SampleSegmentRequest{SegmentID: 1, SampleRate: 0.2})
+		assert.Nil(t, err)
+		assert.EqualValues(t, 20, other.GetSampledRows())
+	})
+
+	t.Run("invalid sample rate", func(t *testing.T) {
+		svr := newServer(t)
+		defer closeTestServer(t, svr)
+
+		for _, rate := range []float64{0, -0.5, 1.5} {
+			resp, err := svr.SampledSegmentInspector(context.TODO(), &datapb.SampleSegmentRequest{SegmentID: 1, SampleRate: rate})
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		}
+	})
+
+	t.Run("segment not found", func(t *testing.T) {
+		svr := newServer(t)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.SampledSegmentInspector(context.TODO(), &datapb.SampleSegmentRequest{SegmentID: 3, SampleRate: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_SegmentNotFound, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("channel not watched", func(t *testing.T) {
+		svr := newServer(t)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.SampledSegmentInspector(context.TODO(), &datapb.SampleSegmentRequest{SegmentID: 2, SampleRate: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.SampledSegmentInspector(context.TODO(), &datapb.SampleSegmentRequest{SegmentID: 1, SampleRate: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestGetStorageUsageRPC(t *testing.T) {
+	t.Run("get storage usage", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 1, NumOfRows: 1, State: commonpb.SegmentState_Flushed,
+				Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "a", DeltaLogSize: 10}}},
+			{ID: 2, CollectionID: 2, NumOfRows: 1, State: commonpb.SegmentState_Flushed,
+				Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "b", DeltaLogSize: 20}}},
+		} {
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segment)))
+		}
+
+		resp, err := svr.GetStorageUsage(context.TODO(), &datapb.GetStorageUsageRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 30, resp.GetTotalSize())
+		assert.Equal(t, 2, len(resp.GetCollections()))
+		assert.EqualValues(t, 2, resp.GetCollections()[0].GetCollectionID())
+
+		// the result is cached
+		cached, err := svr.GetStorageUsage(context.TODO(), &datapb.GetStorageUsageRequest{})
+		assert.Nil(t, err)
+		assert.Same(t, resp, cached)
+
+		resp, err = svr.GetStorageUsage(context.TODO(), &datapb.GetStorageUsageRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 10, resp.GetTotalSize())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.GetStorageUsage(context.TODO(), &datapb.GetStorageUsageRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestGetGCEventsRPC(t *testing.T) {
+	t.Run("get gc events", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.gcEvents.record("a", 1, gcReasonOrphaned)
+		svr.gcEvents.record("b", 2, gcReasonDropped)
+		svr.gcEvents.record("c", 3, gcReasonDropped)
+
+		resp, err := svr.GetGCEvents(context.TODO(), &datapb.GetGCEventsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 3, len(resp.GetEvents()))
+
+		resp, err = svr.GetGCEvents(context.TODO(), &datapb.GetGCEventsRequest{Reason: gcReasonDropped})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(resp.GetEvents()))
+		assert.Equal(t, "b", resp.GetEvents()[0].GetObjectPath())
+
+		defer func(origin int64) { Params.GCEventMaxReturn = origin }(Params.GCEventMaxReturn)
+		Params.GCEventMaxReturn = 1
+		resp, err = svr.GetGCEvents(context.TODO(), &datapb.GetGCEventsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(resp.GetEvents()))
+		assert.Equal(t, "c", resp.GetEvents()[0].GetObjectPath())
+	})
+
+	t.Run("invalid time range", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		resp, err := svr.GetGCEvents(context.TODO(), &datapb.GetGCEventsRequest{StartTime: 2, EndTime: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.GetGCEvents(context.TODO(), &datapb.GetGCEventsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestReportSegmentError(t *testing.T) {
+	t.Run("seal growing segment", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Growing},
+			{ID: 2, CollectionID: 1, State: commonpb.SegmentState_Flushed},
+		} {
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segment)))
+		}
+
+		resp, err := svr.ReportSegmentError(context.TODO(), &datapb.ReportSegmentErrorRequest{SegmentID: 1, Reason: "mocked"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, commonpb.SegmentState_Sealed, svr.meta.GetSegment(1).GetState())
+
+		resp, err = svr.ReportSegmentError(context.TODO(), &datapb.ReportSegmentErrorRequest{SegmentID: 2, Reason: "mocked"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, commonpb.SegmentState_Flushed, svr.meta.GetSegment(2).GetState())
+
+		resp, err = svr.ReportSegmentError(context.TODO(), &datapb.ReportSegmentErrorRequest{SegmentID: 3})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_SegmentNotFound, resp.GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.ReportSegmentError(context.TODO(), &datapb.ReportSegmentErrorRequest{SegmentID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetReason())
+	})
+}
+
+func TestGetChannelHistory(t *testing.T) {
+	t.Run("get channel history", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		err := svr.channelManager.AddNode(0)
+		assert.Nil(t, err)
+		err = svr.channelManager.Watch(&channel{"ch1", 0})
+		assert.Nil(t, err)
+
+		resp, err := svr.GetChannelHistory(context.TODO(), &datapb.GetChannelHistoryRequest{
+			ChannelName: "ch1",
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 1, len(resp.GetEvents()))
+		assert.EqualValues(t, datapb.ChannelEventType_ChannelWatched, resp.GetEvents()[0].GetAction())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.GetChannelHistory(context.TODO(), &datapb.GetChannelHistoryRequest{
+			ChannelName: "ch1",
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestWatchChannelsV2(t *testing.T) {
+	t.Run("watch from seek positions", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		err := svr.channelManager.AddNode(0)
+		assert.Nil(t, err)
+
+		pos := &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1, 2, 3}, Timestamp: 100}
+		resp, err := svr.WatchChannelsV2(context.TODO(), &datapb.WatchChannelsV2Request{
+			CollectionID:  0,
+			ChannelNames:  []string{"ch1", "ch2"},
+			SeekPositions: map[string]*internalpb.MsgPosition{"ch1": pos},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.True(t, svr.channelManager.Match(0, "ch1"))
+		assert.True(t, svr.channelManager.Match(0, "ch2"))
+
+		v, err := svr.kvClient.Load(buildChannelKey(0, "ch1"))
+		assert.Nil(t, err)
+		info := &datapb.ChannelWatchInfo{}
+		err = proto.Unmarshal([]byte(v), info)
+		assert.Nil(t, err)
+		assert.True(t, proto.Equal(pos, info.GetVchan().GetSeekPosition()))
+
+		// seek position of channel watched already is rejected
+		resp, err = svr.WatchChannelsV2(context.TODO(), &datapb.WatchChannelsV2Request{
+			CollectionID:  0,
+			ChannelNames:  []string{"ch2"},
+			SeekPositions: map[string]*internalpb.MsgPosition{"ch2": pos},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.WatchChannelsV2(context.TODO(), &datapb.WatchChannelsV2Request{
+			ChannelNames: []string{"ch1"},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestMigrateChannel(t *testing.T) {
+	t.Run("migrate to least loaded node", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		assert.Nil(t, svr.channelManager.AddNode(1))
+		assert.Nil(t, svr.channelManager.AddNode(2))
+		assert.Nil(t, svr.channelManager.AddNode(3))
+		place := func(channelName string, nodeID int64) {
+			assert.Nil(t, svr.channelManager.Watch(&channel{channelName, 0}))
+			from, err := svr.channelManager.FindWatcher(channelName)
+			assert.Nil(t, err)
+			if from != nodeID {
+				_, err = svr.channelManager.Migrate(channelName, from, nodeID, nil)
+				assert.Nil(t, err)
+			}
+		}
+		// node 3 watches no channel
+		place("ch1", 1)
+		place("ch2", 2)
+
+		flushedPos := &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1}, Timestamp: 100}
+		segments := []*datapb.SegmentInfo{
+			{ID: 1, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed,
+				DmlPosition: &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{0}, Timestamp: 50}},
+			{ID: 2, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed, DmlPosition: flushedPos},
+			{ID: 3, InsertChannel: "ch1", State: commonpb.SegmentState_Dropped,
+				DmlPosition: &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{2}, Timestamp: 200}},
+		}
+		for _, segment := range segments {
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segment)))
+		}
+
+		resp, err := svr.MigrateChannel(context.TODO(), &datapb.MigrateChannelRequest{ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 3, resp.GetNodeID())
+		assert.True(t, svr.channelManager.Match(3, "ch1"))
+		assert.False(t, svr.channelManager.Match(1, "ch1"))
+		assert.True(t, proto.Equal(flushedPos, resp.GetSeekPosition()))
+	})
+
+	t.Run("migrate to target node", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		assert.Nil(t, svr.channelManager.AddNode(1))
+		assert.Nil(t, svr.channelManager.Watch(&channel{"ch1", 0}))
+		assert.Nil(t, svr.channelManager.AddNode(2))
+		from, err := svr.channelManager.FindWatcher("ch1")
+		assert.Nil(t, err)
+
+		resp, err := svr.MigrateChannel(context.TODO(), &datapb.MigrateChannelRequest{ChannelName: "ch1", TargetNodeID: from})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		resp, err = svr.MigrateChannel(context.TODO(), &datapb.MigrateChannelRequest{ChannelName: "ch1", TargetNodeID: 100})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		resp, err = svr.MigrateChannel(context.TODO(), &datapb.MigrateChannelRequest{ChannelName: "ch2", TargetNodeID: from})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		resp, err = svr.MigrateChannel(context.TODO(), &datapb.MigrateChannelRequest{ChannelName: "ch1", TargetNodeID: 3 - from})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 3-from, resp.GetNodeID())
+		assert.True(t, svr.channelManager.Match(3-from, "ch1"))
+	})
+
+	t.Run("concurrent migration", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0, Schema: newTestSchema(), Partitions: []int64{}})
+		assert.Nil(t, svr.channelManager.AddNode(1))
+		assert.Nil(t, svr.channelManager.Watch(&channel{"ch1", 0}))
+		assert.Nil(t, svr.channelManager.AddNode(2))
+		from, err := svr.channelManager.FindWatcher("ch1")
+		assert.Nil(t, err)
+
+		allocations, err := svr.segmentManager.AllocSegment(context.TODO(), 0, 1, "ch1", 1)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		segID := allocations[0].SegmentID
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		var first *datapb.MigrateChannelResponse
+		go func() {
+			defer wg.Done()
+			first, _ = svr.MigrateChannel(context.TODO(), &datapb.MigrateChannelRequest{ChannelName: "ch1"})
+		}()
+
+		// the first migration is waiting for the sealed segment to flush
+		assert.Eventually(t, func() bool {
+			return svr.meta.GetSegment(segID).GetState() == commonpb.SegmentState_Sealed
+		}, 5*time.Second, 10*time.Millisecond)
+		second, err := svr.MigrateChannel(context.TODO(), &datapb.MigrateChannelRequest{ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, second.GetStatus().GetErrorCode())
+		assert.Equal(t, "channel ch1 is being migrated", second.GetStatus().GetReason())
+		assert.True(t, svr.channelManager.Match(from, "ch1"))
+
+		assert.Nil(t, svr.meta.SetState(segID, commonpb.SegmentState_Flushed))
+		wg.Wait()
+		assert.EqualValues(t, commonpb.ErrorCode_Success, first.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 3-from, first.GetNodeID())
+		assert.True(t, svr.channelManager.Match(3-from, "ch1"))
+	})
+
+	t.Run("drain timeout", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0, Schema: newTestSchema(), Partitions: []int64{}})
+		assert.Nil(t, svr.channelManager.AddNode(1))
+		assert.Nil(t, svr.channelManager.Watch(&channel{"ch1", 0}))
+		assert.Nil(t, svr.channelManager.AddNode(2))
+		from, err := svr.channelManager.FindWatcher("ch1")
+		assert.Nil(t, err)
+		_, err = svr.segmentManager.AllocSegment(context.TODO(), 0, 1, "ch1", 1)
+		assert.Nil(t, err)
+
+		originTimeout := migrateChannelTimeout
+		migrateChannelTimeout = 200 * time.Millisecond
+		defer func() { migrateChannelTimeout = originTimeout }()
+		resp, err := svr.MigrateChannel(context.TODO(), &datapb.MigrateChannelRequest{ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.True(t, svr.channelManager.Match(from, "ch1"))
+
+		// channel is unlocked after failure
+		assert.True(t, svr.migratingChannels.tryLock("ch1"))
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.MigrateChannel(context.TODO(), &datapb.MigrateChannelRequest{ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("SetRootCoordCreator", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		var crt rootCoordCreatorFunc = func(ctx context.Context, metaRoot string, endpoints []string) (types.RootCoord, error) {
+			return nil, errors.New("dummy")
+		}
+		opt := SetRootCoordCreator(crt)
+		assert.NotNil(t, opt)
+		svr.rootCoordClientCreator = nil
+		opt(svr)
+		// testify cannot compare function directly
+		// the behavior is actually undefined
+		assert.NotNil(t, crt)
+		assert.NotNil(t, svr.rootCoordClientCreator)
+	})
+	t.Run("SetCluster", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		sessionManager := NewSessionManager()
+		channelManager, err := NewChannelManager(kv, dummyPosProvider{})
+		assert.Nil(t, err)
+
+		cluster := NewCluster(sessionManager, channelManager)
+		assert.Nil(t, err)
+		opt := SetCluster(cluster)
+		assert.NotNil(t, opt)
+		svr := newTestServer(t, nil, opt)
+		defer closeTestServer(t, svr)
+
+		assert.Same(t, cluster, svr.cluster)
+	})
+	t.Run("SetDataNodeCreator", func(t *testing.T) {
+		var target int64
+		var val int64 = rand.Int63()
+		opt := SetDataNodeCreator(func(context.Context, string) (types.DataNode, error) {
+			target = val
+			return nil, nil
+		})
+		assert.NotNil(t, opt)
+
+		factory := msgstream.NewPmsFactory()
+
+		svr, err := CreateServer(context.TODO(), factory, opt)
+		assert.Nil(t, err)
+		dn, err := svr.dataNodeCreator(context.Background(), "")
+		assert.Nil(t, dn)
+		assert.Nil(t, err)
+		assert.Equal(t, target, val)
+	})
+}
+
+type mockPolicyFactory struct {
+	ChannelPolicyFactoryV1
+}
+
+// NewRegisterPolicy create a new register policy
+func (p *mockPolicyFactory) NewRegisterPolicy() RegisterPolicy {
+	return EmptyRegister
+}
+
+// NewDeregisterPolicy create a new dereigster policy
+func (p *mockPolicyFactory) NewDeregisterPolicy() DeregisterPolicy {
+	return EmptyDeregisterPolicy
+}
+
+func TestHandleSessionEvent(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	channelManager, err := NewChannelManager(kv, dummyPosProvider{}, withFactory(&mockPolicyFactory{}))
+	assert.Nil(t, err)
+	sessionManager := NewSessionManager()
+	cluster := NewCluster(sessionManager, channelManager)
+	assert.Nil(t, err)
+
+	err = cluster.Startup(nil)
+	assert.Nil(t, err)
+	defer cluster.Close()
+
+	svr := newTestServer(t, nil, SetCluster(cluster))
+	defer closeTestServer(t, svr)
+	t.Run("handle events", func(t *testing.T) {
+		// None event
+		evt := &sessionutil.SessionEvent{
+			EventType: sessionutil.SessionNoneEvent,
+			Session: &sessionutil.Session{
+				ServerID:   0,
+				ServerName: "",
+				Address:    "",
+				Exclusive:  false,
+			},
+		}
+		err = svr.handleSessionEvent(context.Background(), evt)
+		assert.Nil(t, err)
+
+		evt = &sessionutil.SessionEvent{
+			EventType: sessionutil.SessionAddEvent,
+			Session: &sessionutil.Session{
+				ServerID:   101,
+				ServerName: "DN101",
+				Address:    "DN127.0.0.101",
+				Exclusive:  false,
+			},
+		}
+		err = svr.handleSessionEvent(context.Background(), evt)
+		assert.Nil(t, err)
+		dataNodes := svr.cluster.GetSessions()
+		assert.EqualValues(t, 1, len(dataNodes))
+		assert.EqualValues(t, "DN127.0.0.101", dataNodes[0].info.Address)
+
+		evt = &sessionutil.SessionEvent{
+			EventType: sessionutil.SessionDelEvent,
+			Session: &sessionutil.Session{
+				ServerID:   101,
+				ServerName: "DN101",
+				Address:    "DN127.0.0.101",
+				Exclusive:  false,
+			},
+		}
+		err = svr.handleSessionEvent(context.Background(), evt)
+		assert.Nil(t, err)
+		dataNodes = svr.cluster.GetSessions()
+		assert.EqualValues(t, 0, len(dataNodes))
+	})
+
+	t.Run("nil evt", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			err = svr.handleSessionEvent(context.Background(), nil)
+			assert.Nil(t, err)
+		})
+	})
+}
+
+type rootCoordSegFlushComplete struct {
+	mockRootCoordService
+	flag bool
+}
+
+//SegmentFlushCompleted, override default behavior
+func (rc *rootCoordSegFlushComplete) SegmentFlushCompleted(ctx context.Context, req *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error) {
+	if rc.flag {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}, nil
+}
+
+func TestPostFlush(t *testing.T) {
+	t.Run("segment not found", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		err := svr.postFlush(context.Background(), 1)
+		assert.EqualValues(t, errors.New("segment not found"), err)
+	})
+	t.Run("failed to sync with Rootcoord", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.rootCoordClient = &rootCoordSegFlushComplete{flag: false}
+
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           1,
+			CollectionID: 1,
+			PartitionID:  1,
+			State:        commonpb.SegmentState_Flushing,
+		}))
+
+		assert.Nil(t, err)
+
+		err = svr.postFlush(context.Background(), 1)
+		assert.NotNil(t, err)
+	})
+	t.Run("success post flush", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.rootCoordClient = &rootCoordSegFlushComplete{flag: true}
+
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           1,
+			CollectionID: 1,
+			PartitionID:  1,
+			State:        commonpb.SegmentState_Flushing,
+		}))
+
+		assert.Nil(t, err)
+
+		err = svr.postFlush(context.Background(), 1)
+		assert.Nil(t, err)
+	})
+}
+
+func newTestServer(t *testing.T, receiveCh chan interface{}, opts ...Option) *Server {
+	Params.Init()
+	Params.TimeTickChannelName = Params.TimeTickChannelName + strconv.Itoa(rand.Int())
+	Params.StatisticsChannelName = Params.StatisticsChannelName + strconv.Itoa(rand.Int())
+	var err error
+	factory := msgstream.NewPmsFactory()
+	m := map[string]interface{}{
+		"pulsarAddress":  Params.PulsarAddress,
+		"receiveBufSize": 1024,
+		"pulsarBufSize":  1024,
+	}
+	err = factory.SetParams(m)
+	assert.Nil(t, err)
+
+	etcdCli, err := initEtcd(Params.EtcdEndpoints)
+	assert.Nil(t, err)
+	sessKey := path.Join(Params.MetaRootPath, sessionutil.DefaultServiceRoot)
+	_, err = etcdCli.Delete(context.Background(), sessKey, clientv3.WithPrefix())
+	assert.Nil(t, err)
+
+	svr, err := CreateServer(context.TODO(), factory, opts...)
+	assert.Nil(t, err)
+	svr.dataNodeCreator = func(ctx context.Context, addr string) (types.DataNode, error) {
+		return newMockDataNodeClient(0, receiveCh)
+	}
+	svr.rootCoordClientCreator = func(ctx context.Context, metaRootPath string, etcdEndpoints []string) (types.RootCoord, error) {
+		return newMockRootCoordService(), nil
+	}
+	assert.Nil(t, err)
+	err = svr.Register()
+	assert.Nil(t, err)
+	err = svr.Init()
+	assert.Nil(t, err)
+	err = svr.Start()
+	assert.Nil(t, err)
+	return svr
+}
+
+func closeTestServer(t *testing.T, svr *Server) {
+	err := svr.Stop()
+	assert.Nil(t, err)
+	err = svr.CleanMeta()
+	assert.Nil(t, err)
+}
+
+func initEtcd(etcdEndpoints []string) (*clientv3.Client, error) {
+	var etcdCli *clientv3.Client
+	connectEtcdFn := func() error {
+		etcd, err := clientv3.New(clientv3.Config{Endpoints: etcdEndpoints, DialTimeout: 5 * time.Second})
+		if err != nil {
+			return err
+		}
+		etcdCli = etcd
+		return nil
+	}
+	err := retry.Do(context.TODO(), connectEtcdFn, retry.Attempts(300))
+	if err != nil {
+		return nil, err
+	}
+	return etcdCli, nil
+}
+
+func TestSetCollectionProperty(t *testing.T) {
+	t.Run("set retention policy", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: newTestSchema()})
+
+		resp, err := svr.SetCollectionProperty(context.TODO(), &datapb.SetCollectionPropertyRequest{
+			CollectionID: 1,
+			Properties: []*commonpb.KeyValuePair{
+				{Key: retentionPeriodProperty, Value: "24h"},
+				{Key: retentionModeProperty, Value: retentionModeDropPartition},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, &dataRetentionPolicy{Period: int64(24 * time.Hour), Mode: retentionModeDropPartition},
+			svr.retentionManager.policies[1])
+		_, err = svr.kvClient.Load(collectionRetentionKey(1))
+		assert.Nil(t, err)
+
+		resp, err = svr.SetCollectionProperty(context.TODO(), &datapb.SetCollectionPropertyRequest{
+			CollectionID: 1,
+			Properties:   []*commonpb.KeyValuePair{{Key: retentionModeProperty, Value: "unknown"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, retentionModeDropPartition, svr.retentionManager.policies[1].Mode)
+
+		resp, err = svr.SetCollectionProperty(context.TODO(), &datapb.SetCollectionPropertyRequest{
+			CollectionID: 1,
+			Properties:   []*commonpb.KeyValuePair{{Key: retentionPeriodProperty, Value: "0"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Nil(t, svr.retentionManager.policies[1])
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.SetCollectionProperty(context.TODO(), &datapb.SetCollectionPropertyRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+
+		resp, err = svr.SetCollectionProperty(context.TODO(), &datapb.SetCollectionPropertyRequest{
+			CollectionID: 2,
+			Properties:   []*commonpb.KeyValuePair{{Key: retentionPeriodProperty, Value: "24h"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Nil(t, svr.retentionManager.policies[2])
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.SetCollectionProperty(context.TODO(), &datapb.SetCollectionPropertyRequest{
+			CollectionID: 1,
+			Properties:   []*commonpb.KeyValuePair{{Key: retentionPeriodProperty, Value: "24h"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetReason())
+	})
+}
+
+func TestComputeSegmentOverlap(t *testing.T) {
+	pkSchema := &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	newServer := func(t *testing.T) *Server {
+		svr := newTestServer(t, nil)
+		_, svr.storageCli = newMockMinio(t)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: pkSchema})
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 2, Schema: newTestSchema()})
+		return svr
+	}
+
+	t.Run("compute with known ranges", func(t *testing.T) {
+		svr := newServer(t)
+		defer closeTestServer(t, svr)
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 1, PartitionID: 10, State: commonpb.SegmentState_Flushed},
+			{ID: 2, CollectionID: 1, PartitionID: 10, State: commonpb.SegmentState_Flushed},
+			{ID: 3, CollectionID: 1, PartitionID: 10, State: commonpb.SegmentState_Flushed},
+			{ID: 4, CollectionID: 1, PartitionID: 10, State: commonpb.SegmentState_Growing},
+			{ID: 5, CollectionID: 1, PartitionID: 20, State: commonpb.SegmentState_Flushed},
+		} {
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segment)))
+		}
+		svr.pkRanges.ranges[1] = pkRange{0, 99}
+		svr.pkRanges.ranges[2] = pkRange{0, 99}
+		svr.pkRanges.ranges[9] = pkRange{0, 99}
+
+		resp, err := svr.ComputeSegmentOverlap(context.TODO(), &datapb.ComputeSegmentOverlapRequest{CollectionID: 1, PartitionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []UniqueID{1, 2}, resp.GetReport().GetSegmentIDs())
+		assert.Equal(t, 1.0, resp.GetReport().GetOverlapRatio())
+		assert.Equal(t, 1, len(resp.GetReport().GetSuggestedMerges()))
+		// segment 3 has no stats logs
+		assert.Equal(t, []UniqueID{3}, resp.GetReport().GetSkippedSegmentIDs())
+		// range of segment 9 not existing is pruned
+		_, ok := svr.pkRanges.get(9)
+		assert.False(t, ok)
+	})
+
+	t.Run("failed to read stats logs", func(t *testing.T) {
+		svr := newServer(t)
+		defer closeTestServer(t, svr)
+		assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, CollectionID: 1, PartitionID: 10,
+			State: commonpb.SegmentState_Flushed, Statslogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"stats_log/1"}}}})))
+
+		resp, err := svr.ComputeSegmentOverlap(context.TODO(), &datapb.ComputeSegmentOverlapRequest{CollectionID: 1, PartitionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("collection without int64 primary key", func(t *testing.T) {
+		svr := newServer(t)
+		defer closeTestServer(t, svr)
+		resp, err := svr.ComputeSegmentOverlap(context.TODO(), &datapb.ComputeSegmentOverlapRequest{CollectionID: 2, PartitionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, "collection 2 has no int64 primary key", resp.GetStatus().GetReason())
+	})
+
+	t.Run("storage not initialized", func(t *testing.T) {
+		svr := newServer(t)
+		defer closeTestServer(t, svr)
+		svr.storageCli = nil
+		resp, err := svr.ComputeSegmentOverlap(context.TODO(), &datapb.ComputeSegmentOverlapRequest{CollectionID: 1, PartitionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, errStorageNotInitialized.Error(), resp.GetStatus().GetReason())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.ComputeSegmentOverlap(context.TODO(), &datapb.ComputeSegmentOverlapRequest{CollectionID: 1, PartitionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestGetCompactionROI(t *testing.T) {
+	Params.EnableCompaction = true
+	t.Run("get compaction ROI", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		now := time.Now()
+		for planID, bytesOut := range map[int64]int64{1: 100, 2: 300} {
+			svr.compactionHistory.record(1, &compactionTask{
+				plan:       &datapb.CompactionPlan{PlanID: planID, Type: datapb.CompactionType_MergeCompaction},
+				state:      completed,
+				createTime: now.Add(-time.Second),
+				endTime:    now,
+				bytesIn:    400,
+				bytesOut:   bytesOut,
+			})
+		}
+
+		resp, err := svr.GetCompactionROI(context.TODO(), &datapb.GetCompactionROIRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 800, resp.GetTotalBytesIn())
+		assert.EqualValues(t, 400, resp.GetTotalBytesOut())
+		assert.Equal(t, 2.0, resp.GetRoiRatio())
+		assert.EqualValues(t, 2, resp.GetPlanCount())
+		assert.EqualValues(t, 1000, resp.GetAverageDuration())
+
+		// result is cached
+		svr.compactionHistory.record(1, &compactionTask{
+			plan:  &datapb.CompactionPlan{PlanID: 3, Type: datapb.CompactionType_MergeCompaction},
+			state: completed,
+		})
+		resp, err = svr.GetCompactionROI(context.TODO(), &datapb.GetCompactionROIRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.EqualValues(t, 2, resp.GetPlanCount())
+
+		resp, err = svr.GetCompactionROI(context.TODO(), &datapb.GetCompactionROIRequest{CollectionID: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 0, resp.GetPlanCount())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.GetCompactionROI(context.TODO(), &datapb.GetCompactionROIRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestFreezePartition(t *testing.T) {
+	assign := func(svr *Server) *datapb.SegmentIDAssignment {
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			SegmentIDRequests: []*datapb.SegmentIDRequest{{Count: 1000, ChannelName: "ch1", CollectionID: 1, PartitionID: 10}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(resp.GetSegIDAssignments()))
+		return resp.GetSegIDAssignments()[0]
+	}
+
+	t.Run("assignment rejected while frozen", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: newTestSchema(), Partitions: []int64{10, 11}})
+		allocated := assign(svr)
+		assert.Equal(t, commonpb.ErrorCode_Success, allocated.GetStatus().GetErrorCode())
+
+		resp, err := svr.FreezePartition(context.TODO(), &datapb.FreezePartitionRequest{CollectionID: 1, PartitionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, commonpb.ErrorCode_PartitionFrozen, assign(svr).GetStatus().GetErrorCode())
+		// segments allocated before the freeze are kept
+		assert.NotNil(t, svr.meta.GetSegment(allocated.GetSegID()))
+
+		stats, err := svr.GetCollectionStatistics(context.TODO(), &datapb.GetCollectionStatisticsRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Contains(t, stats.GetStats(), &commonpb.KeyValuePair{Key: frozenPartitionsStatsKey, Value: "10"})
+
+		resp, err = svr.UnfreezePartition(context.TODO(), &datapb.UnfreezePartitionRequest{CollectionID: 1, PartitionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, commonpb.ErrorCode_Success, assign(svr).GetStatus().GetErrorCode())
+		stats, err = svr.GetCollectionStatistics(context.TODO(), &datapb.GetCollectionStatisticsRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		for _, kv := range stats.GetStats() {
+			assert.NotEqual(t, frozenPartitionsStatsKey, kv.GetKey())
+		}
+	})
+
+	t.Run("auto unfreeze", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: newTestSchema(), Partitions: []int64{10}})
+
+		resp, err := svr.FreezePartition(context.TODO(), &datapb.FreezePartitionRequest{CollectionID: 1, PartitionID: 10, AutoUnfreezeSeconds: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, commonpb.ErrorCode_PartitionFrozen, assign(svr).GetStatus().GetErrorCode())
+		time.Sleep(time.Second)
+		assert.Equal(t, commonpb.ErrorCode_Success, assign(svr).GetStatus().GetErrorCode())
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		resp, err := svr.FreezePartition(context.TODO(), &datapb.FreezePartitionRequest{CollectionID: 1, PartitionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.FreezePartition(context.TODO(), &datapb.FreezePartitionRequest{CollectionID: 1, PartitionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetReason())
+		resp, err = svr.UnfreezePartition(context.TODO(), &datapb.UnfreezePartitionRequest{CollectionID: 1, PartitionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetReason())
+	})
+}
+
+func TestGetSegmentPath(t *testing.T) {
+	defer func(token string) { Params.AdminToken = token }(Params.AdminToken)
+	Params.AdminToken = "secret"
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(util.AdminTokenKey, "secret"))
+
+	t.Run("get segment path", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:        1,
+			State:     commonpb.SegmentState_Flushed,
+			Binlogs:   []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"insert_log/1/100/1"}}},
+			Statslogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"stats_log/1/100/1"}}},
+			Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "delta_log/1/1"}},
+		}))
+		assert.Nil(t, err)
+
+		resp, err := svr.GetSegmentPath(ctx, &datapb.GetSegmentPathRequest{SegmentID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []string{"insert_log/1/100/1"}, resp.GetInsertPaths()[0].GetBinlogs())
+		assert.Equal(t, []string{"stats_log/1/100/1"}, resp.GetStatsPaths()[0].GetBinlogs())
+		assert.Equal(t, []string{"delta_log/1/1"}, resp.GetDeltaPaths())
+		assert.Equal(t, Params.MinioBucketName, resp.GetBucketName())
+		assert.EqualValues(t, 0, resp.GetAccessTokenTtl())
+
+		resp, err = svr.GetSegmentPath(ctx, &datapb.GetSegmentPathRequest{SegmentID: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("not admin", func(t *testing.T) {
 		svr := newTestServer(t, nil)
 		defer closeTestServer(t, svr)
-		svr.rootCoordClient = &rootCoordSegFlushComplete{flag: false}
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Flushed}))
+		assert.Nil(t, err)
+
+		resp, err := svr.GetSegmentPath(context.TODO(), &datapb.GetSegmentPathRequest{SegmentID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, util.ErrNotAdmin.Error(), resp.GetStatus().GetReason())
+	})
+
+	t.Run("storage not initialized", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.storageCli = nil
+		defer func(origin bool) { Params.EnableBlobStorageAuth = origin }(Params.EnableBlobStorageAuth)
+		Params.EnableBlobStorageAuth = true
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Flushed}))
+		assert.Nil(t, err)
+
+		resp, err := svr.GetSegmentPath(ctx, &datapb.GetSegmentPathRequest{SegmentID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, errStorageNotInitialized.Error(), resp.GetStatus().GetReason())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.GetSegmentPath(ctx, &datapb.GetSegmentPathRequest{SegmentID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestGetSegmentsForCollection(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
 
+	addSegment := func(id, collID int64, state commonpb.SegmentState) {
 		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
-			ID:           1,
-			CollectionID: 1,
-			PartitionID:  1,
-			State:        commonpb.SegmentState_Flushing,
+			ID:           id,
+			CollectionID: collID,
+			State:        state,
+			Binlogs:      []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{fmt.Sprintf("insert_log/%d", id)}}},
 		}))
+		assert.Nil(t, err)
+	}
+	addSegment(1, 1, commonpb.SegmentState_Flushed)
+	addSegment(2, 1, commonpb.SegmentState_Growing)
+	addSegment(3, 1, commonpb.SegmentState_Dropped)
+	addSegment(4, 1, commonpb.SegmentState_Flushed)
+	addSegment(5, 1, commonpb.SegmentState_Sealed)
+	addSegment(6, 2, commonpb.SegmentState_Flushed)
+
+	grouped := func(resp *datapb.GetSegmentsForCollectionResponse) map[commonpb.SegmentState][]int64 {
+		ret := make(map[commonpb.SegmentState][]int64)
+		for _, bucket := range resp.GetSegmentsByState() {
+			for _, segment := range bucket.GetSegments() {
+				ret[bucket.GetState()] = append(ret[bucket.GetState()], segment.GetID())
+			}
+		}
+		return ret
+	}
 
+	t.Run("all states", func(t *testing.T) {
+		resp, err := svr.GetSegmentsForCollection(context.TODO(), &datapb.GetSegmentsForCollectionRequest{CollectionID: 1})
 		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, map[commonpb.SegmentState][]int64{
+			commonpb.SegmentState_Growing: {2},
+			commonpb.SegmentState_Sealed:  {5},
+			commonpb.SegmentState_Flushed: {1, 4},
+			commonpb.SegmentState_Dropped: {3},
+		}, grouped(resp))
+		for i := 1; i < len(resp.GetSegmentsByState()); i++ {
+			assert.Less(t, int32(resp.GetSegmentsByState()[i-1].GetState()), int32(resp.GetSegmentsByState()[i].GetState()))
+		}
+		assert.Equal(t, "insert_log/1", resp.GetSegmentsByState()[2].GetSegments()[0].GetBinlogs()[0].GetBinlogs()[0])
+		assert.EqualValues(t, 0, resp.GetNextCursor())
+	})
 
-		err = svr.postFlush(context.Background(), 1)
-		assert.NotNil(t, err)
+	t.Run("paging", func(t *testing.T) {
+		req := &datapb.GetSegmentsForCollectionRequest{CollectionID: 1, Limit: 3}
+		resp, err := svr.GetSegmentsForCollection(context.TODO(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, map[commonpb.SegmentState][]int64{
+			commonpb.SegmentState_Growing: {2},
+			commonpb.SegmentState_Flushed: {1},
+			commonpb.SegmentState_Dropped: {3},
+		}, grouped(resp))
+		assert.EqualValues(t, 3, resp.GetNextCursor())
+
+		req.Cursor = resp.GetNextCursor()
+		resp, err = svr.GetSegmentsForCollection(context.TODO(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, map[commonpb.SegmentState][]int64{
+			commonpb.SegmentState_Sealed:  {5},
+			commonpb.SegmentState_Flushed: {4},
+		}, grouped(resp))
+		assert.EqualValues(t, 0, resp.GetNextCursor())
 	})
-	t.Run("success post flush", func(t *testing.T) {
+
+	t.Run("invalid limit", func(t *testing.T) {
+		resp, err := svr.GetSegmentsForCollection(context.TODO(), &datapb.GetSegmentsForCollectionRequest{CollectionID: 1, Limit: -1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.GetSegmentsForCollection(context.TODO(), &datapb.GetSegmentsForCollectionRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestReportDataNodeHealth(t *testing.T) {
+	t.Run("report data node health", func(t *testing.T) {
 		svr := newTestServer(t, nil)
 		defer closeTestServer(t, svr)
-		svr.rootCoordClient = &rootCoordSegFlushComplete{flag: true}
 
+		resp, err := svr.ReportDataNodeHealth(context.TODO(), &datapb.ReportDataNodeHealthRequest{
+			NodeID:        1,
+			ChannelErrors: map[string]string{"ch1": "timeout"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, map[string]string{"ch1": "timeout"}, svr.nodeHealth.errors(1))
+
+		resp, err = svr.ReportDataNodeHealth(context.TODO(), &datapb.ReportDataNodeHealthRequest{
+			NodeID:          1,
+			HealthyChannels: []string{"ch1"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Nil(t, svr.nodeHealth.errors(1))
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.ReportDataNodeHealth(context.TODO(), &datapb.ReportDataNodeHealthRequest{NodeID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetReason())
+	})
+}
+
+func TestGetSegmentLineageDOT(t *testing.T) {
+	t.Run("get segment lineage dot", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
 		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
-			ID:           1,
-			CollectionID: 1,
-			PartitionID:  1,
-			State:        commonpb.SegmentState_Flushing,
+			ID:             2,
+			CollectionID:   1,
+			State:          commonpb.SegmentState_Flushed,
+			CompactionFrom: []int64{1},
 		}))
+		assert.Nil(t, err)
 
+		resp, err := svr.GetSegmentLineageDOT(context.TODO(), &datapb.GetSegmentLineageDOTRequest{CollectionID: 1})
 		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Contains(t, resp.GetDot(), "\"1\" -> \"2\";")
+	})
 
-		err = svr.postFlush(context.Background(), 1)
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.GetSegmentLineageDOT(context.TODO(), &datapb.GetSegmentLineageDOTRequest{CollectionID: 1})
 		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
 	})
 }
 
-func newTestServer(t *testing.T, receiveCh chan interface{}, opts ...Option) *Server {
-	Params.Init()
-	Params.TimeTickChannelName = Params.TimeTickChannelName + strconv.Itoa(rand.Int())
-	Params.StatisticsChannelName = Params.StatisticsChannelName + strconv.Itoa(rand.Int())
-	var err error
-	factory := msgstream.NewPmsFactory()
-	m := map[string]interface{}{
-		"pulsarAddress":  Params.PulsarAddress,
-		"receiveBufSize": 1024,
-		"pulsarBufSize":  1024,
-	}
-	err = factory.SetParams(m)
-	assert.Nil(t, err)
+func TestDiscardSegment(t *testing.T) {
+	t.Run("discard segment", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: newTestSchema(), Partitions: []int64{10}})
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			PeerRole:          typeutil.DataNodeRole,
+			SegmentIDRequests: []*datapb.SegmentIDRequest{{Count: 1000, ChannelName: "ch1", CollectionID: 1, PartitionID: 10}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(resp.GetSegIDAssignments()))
+		segmentID := resp.GetSegIDAssignments()[0].GetSegID()
 
-	etcdCli, err := initEtcd(Params.EtcdEndpoints)
-	assert.Nil(t, err)
-	sessKey := path.Join(Params.MetaRootPath, sessionutil.DefaultServiceRoot)
-	_, err = etcdCli.Delete(context.Background(), sessKey, clientv3.WithPrefix())
-	assert.Nil(t, err)
+		status, err := svr.DiscardSegment(context.TODO(), &datapb.DiscardSegmentRequest{SegmentID: segmentID, ChannelName: "ch2"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
 
-	svr, err := CreateServer(context.TODO(), factory, opts...)
-	assert.Nil(t, err)
-	svr.dataNodeCreator = func(ctx context.Context, addr string) (types.DataNode, error) {
-		return newMockDataNodeClient(0, receiveCh)
-	}
-	svr.rootCoordClientCreator = func(ctx context.Context, metaRootPath string, etcdEndpoints []string) (types.RootCoord, error) {
-		return newMockRootCoordService(), nil
-	}
-	assert.Nil(t, err)
-	err = svr.Register()
-	assert.Nil(t, err)
-	err = svr.Init()
-	assert.Nil(t, err)
-	err = svr.Start()
-	assert.Nil(t, err)
-	return svr
+		status, err = svr.DiscardSegment(context.TODO(), &datapb.DiscardSegmentRequest{SegmentID: segmentID, ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Nil(t, svr.meta.GetSegment(segmentID))
+
+		// discarded already
+		status, err = svr.DiscardSegment(context.TODO(), &datapb.DiscardSegmentRequest{SegmentID: segmentID, ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("segment allocated to others", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: newTestSchema(), Partitions: []int64{10}})
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			PeerRole:          typeutil.DataNodeRole,
+			SegmentIDRequests: []*datapb.SegmentIDRequest{{Count: 1000, ChannelName: "ch1", CollectionID: 1, PartitionID: 10}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(resp.GetSegIDAssignments()))
+		segmentID := resp.GetSegIDAssignments()[0].GetSegID()
+		// a proxy is given an allocation of the same segment, whose rows are not flushed yet
+		resp, err = svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			SegmentIDRequests: []*datapb.SegmentIDRequest{{Count: 10, ChannelName: "ch1", CollectionID: 1, PartitionID: 10}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(resp.GetSegIDAssignments()))
+		require.Equal(t, segmentID, resp.GetSegIDAssignments()[0].GetSegID())
+
+		status, err := svr.DiscardSegment(context.TODO(), &datapb.DiscardSegmentRequest{SegmentID: segmentID, ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		assert.NotNil(t, svr.meta.GetSegment(segmentID))
+	})
+
+	t.Run("rows written", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: newTestSchema(), Partitions: []int64{10}})
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			PeerRole:          typeutil.DataNodeRole,
+			SegmentIDRequests: []*datapb.SegmentIDRequest{{Count: 1000, ChannelName: "ch1", CollectionID: 1, PartitionID: 10}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(resp.GetSegIDAssignments()))
+		segmentID := resp.GetSegIDAssignments()[0].GetSegID()
+		svr.meta.SetCurrentRows(segmentID, 5)
+
+		status, err := svr.DiscardSegment(context.TODO(), &datapb.DiscardSegmentRequest{SegmentID: segmentID, ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		assert.NotNil(t, svr.meta.GetSegment(segmentID))
+	})
+
+	t.Run("segment in use", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            1,
+			CollectionID:  1,
+			InsertChannel: "ch1",
+			State:         commonpb.SegmentState_Growing,
+			NumOfRows:     10,
+		}))
+		assert.Nil(t, err)
+
+		status, err := svr.DiscardSegment(context.TODO(), &datapb.DiscardSegmentRequest{SegmentID: 1, ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		assert.NotNil(t, svr.meta.GetSegment(1))
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		status, err := svr.DiscardSegment(context.TODO(), &datapb.DiscardSegmentRequest{SegmentID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), status.GetReason())
+	})
 }
 
-func closeTestServer(t *testing.T, svr *Server) {
-	err := svr.Stop()
-	assert.Nil(t, err)
-	err = svr.CleanMeta()
-	assert.Nil(t, err)
+func TestGetChannelSegmentStats(t *testing.T) {
+	Params.EnableCompaction = true
+	t.Run("test get channel segment stats successfully", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"getChannelSegmentStats": func(channel string, tt *timetravel, now time.Time, topN int) *datapb.GetChannelSegmentStatsResponse {
+					assert.Equal(t, "ch1", channel)
+					assert.Equal(t, 5, topN)
+					return &datapb.GetChannelSegmentStatsResponse{TotalSegments: 3}
+				},
+			},
+		}
+
+		resp, err := svr.GetChannelSegmentStats(context.TODO(), &datapb.GetChannelSegmentStatsRequest{ChannelName: "ch1", TopN: 5})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 3, resp.GetTotalSegments())
+	})
+
+	t.Run("test get channel segment stats with compaction disabled", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		Params.EnableCompaction = false
+		defer func() { Params.EnableCompaction = true }()
+
+		resp, err := svr.GetChannelSegmentStats(context.TODO(), &datapb.GetChannelSegmentStatsRequest{ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, "compaction disabled", resp.GetStatus().GetReason())
+	})
+
+	t.Run("test get channel segment stats with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		resp, err := svr.GetChannelSegmentStats(context.TODO(), &datapb.GetChannelSegmentStatsRequest{ChannelName: "ch1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
 }
 
-func initEtcd(etcdEndpoints []string) (*clientv3.Client, error) {
-	var etcdCli *clientv3.Client
-	connectEtcdFn := func() error {
-		etcd, err := clientv3.New(clientv3.Config{Endpoints: etcdEndpoints, DialTimeout: 5 * time.Second})
-		if err != nil {
-			return err
+func TestServer_GetFlushProgress(t *testing.T) {
+	t.Run("test get flush progress successfully", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 0, NumOfRows: 100, State: commonpb.SegmentState_Sealed},
+			{ID: 2, CollectionID: 0, NumOfRows: 100, State: commonpb.SegmentState_Flushed},
+		} {
+			err := svr.meta.AddSegment(NewSegmentInfo(segment))
+			assert.Nil(t, err)
 		}
-		etcdCli = etcd
-		return nil
-	}
-	err := retry.Do(context.TODO(), connectEtcdFn, retry.Attempts(300))
-	if err != nil {
-		return nil, err
-	}
-	return etcdCli, nil
+
+		resp, err := svr.GetFlushProgress(context.TODO(), &datapb.GetFlushProgressRequest{CollectionID: 0, SegmentIDs: []int64{1, 2}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.InDelta(t, 100.0*2/3, resp.GetProgress(), 0.01)
+		assert.Equal(t, 2, len(resp.GetSegments()))
+
+		resp, err = svr.GetFlushProgress(context.TODO(), &datapb.GetFlushProgressRequest{CollectionID: 0, SegmentIDs: []int64{3}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("test get flush progress with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		resp, err := svr.GetFlushProgress(context.TODO(), &datapb.GetFlushProgressRequest{CollectionID: 0})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
+}
+
+func TestServer_CancelFlush(t *testing.T) {
+	t.Run("test cancel flush successfully", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 0, State: commonpb.SegmentState_Sealed},
+			{ID: 2, CollectionID: 0, State: commonpb.SegmentState_Flushed},
+		} {
+			err := svr.meta.AddSegment(NewSegmentInfo(segment))
+			assert.Nil(t, err)
+		}
+
+		resp, err := svr.CancelFlush(context.TODO(), &datapb.CancelFlushRequest{CollectionID: 0, SegmentIDs: []int64{1}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []int64{1}, resp.GetSegmentIDs())
+		assert.Equal(t, commonpb.SegmentState_Growing, svr.meta.GetSegment(1).GetState())
+
+		resp, err = svr.CancelFlush(context.TODO(), &datapb.CancelFlushRequest{CollectionID: 0, SegmentIDs: []int64{1, 2}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_SegmentFlushStarted, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("test cancel flush with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		resp, err := svr.CancelFlush(context.TODO(), &datapb.CancelFlushRequest{CollectionID: 0})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetStatus().GetReason())
+	})
 }
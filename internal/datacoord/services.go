@@ -18,19 +18,30 @@ package datacoord
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/util/trace"
 
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/minio/minio-go/v7"
 	"go.uber.org/zap"
 )
 
@@ -118,10 +129,58 @@ func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentI
 			continue
 		}
 
+		if s.freezer.isFrozen(r.CollectionID, r.PartitionID, time.Now()) {
+			log.Warn("assign segment request is rejected since the partition is frozen",
+				zap.Int64("collectionID", r.CollectionID), zap.Int64("partitionID", r.PartitionID))
+			assigns = append(assigns, &datapb.SegmentIDAssignment{
+				ChannelName:  r.ChannelName,
+				CollectionID: r.CollectionID,
+				PartitionID:  r.PartitionID,
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_PartitionFrozen,
+					Reason:    fmt.Sprintf("partition %d of collection %d is frozen", r.PartitionID, r.CollectionID),
+				},
+			})
+			continue
+		}
+
+		if !s.assignLimiter.allow(ctx, r.CollectionID) {
+			log.Warn("assign segment request is rate limited", zap.Int64("collectionID", r.CollectionID))
+			metrics.DataCoordAssignSegmentRateLimitedCounter.Inc()
+			assigns = append(assigns, &datapb.SegmentIDAssignment{
+				ChannelName:  r.ChannelName,
+				CollectionID: r.CollectionID,
+				PartitionID:  r.PartitionID,
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Busy,
+					Reason:    fmt.Sprintf("assign segment rate of collection %d exceeds the limit", r.CollectionID),
+				},
+			})
+			continue
+		}
+
 		s.cluster.Watch(r.ChannelName, r.CollectionID)
 
-		allocations, err := s.segmentManager.AllocSegment(ctx,
-			r.CollectionID, r.PartitionID, r.ChannelName, int64(r.Count))
+		alloc := s.segmentManager.AllocSegment
+		// DataNodes allocate segments ahead of time, which are discarded if not taken
+		if req.GetPeerRole() == typeutil.DataNodeRole {
+			alloc = s.segmentManager.AllocSegmentAhead
+		}
+		allocations, err := alloc(ctx, r.CollectionID, r.PartitionID, r.ChannelName, int64(r.Count))
+		if errors.Is(err, errSegmentTooSmall) {
+			log.Warn("reject to create small segment", zap.Any("request", r), zap.Error(err))
+			metrics.DataCoordSegmentTooSmallCounter.Inc()
+			assigns = append(assigns, &datapb.SegmentIDAssignment{
+				ChannelName:  r.ChannelName,
+				CollectionID: r.CollectionID,
+				PartitionID:  r.PartitionID,
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_SegmentTooSmall,
+					Reason:    err.Error(),
+				},
+			})
+			continue
+		}
 		if err != nil {
 			log.Warn("failed to alloc segment", zap.Any("request", r), zap.Error(err))
 			continue
@@ -216,7 +275,7 @@ func (s *Server) GetInsertBinlogPaths(ctx context.Context, req *datapb.GetInsert
 }
 
 // GetCollectionStatistics returns statistics for collection
-// for now only row count is returned
+// for now only row count and binlog file count are returned
 func (s *Server) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
 	resp := &datapb.GetCollectionStatisticsResponse{
 		Status: &commonpb.Status{
@@ -228,8 +287,17 @@ func (s *Server) GetCollectionStatistics(ctx context.Context, req *datapb.GetCol
 		return resp, nil
 	}
 	nums := s.meta.GetNumRowsOfCollection(req.CollectionID)
+	binlogs := s.meta.GetBinlogCountOfCollection(req.CollectionID)
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 	resp.Stats = append(resp.Stats, &commonpb.KeyValuePair{Key: "row_count", Value: strconv.FormatInt(nums, 10)})
+	resp.Stats = append(resp.Stats, &commonpb.KeyValuePair{Key: binlogCountStatsKey, Value: strconv.FormatInt(binlogs, 10)})
+	if frozen := s.freezer.frozenPartitions(req.CollectionID, time.Now()); len(frozen) > 0 {
+		ids := make([]string, 0, len(frozen))
+		for _, partitionID := range frozen {
+			ids = append(ids, strconv.FormatInt(partitionID, 10))
+		}
+		resp.Stats = append(resp.Stats, &commonpb.KeyValuePair{Key: frozenPartitionsStatsKey, Value: strings.Join(ids, ",")})
+	}
 	return resp, nil
 }
 
@@ -309,18 +377,46 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 	segment := s.meta.GetSegment(segmentID)
 
 	if segment == nil {
-		FailResponse(resp, fmt.Sprintf("failed to get segment %d", segmentID))
+		resp.ErrorCode = commonpb.ErrorCode_SegmentNotFound
+		resp.Reason = fmt.Sprintf("failed to get segment %d", segmentID)
 		log.Error("failed to get segment", zap.Int64("segmentID", segmentID))
 		return resp, nil
 	}
 
 	channel := segment.GetInsertChannel()
-	if !s.channelManager.Match(nodeID, channel) {
+	if req.GetIsRestored() {
+		// logs of a flushed segment restored by any DataNode on behalf of an admin, the segment stays flushed
+		if err := util.CheckAdmin(ctx, Params.AdminToken); err != nil {
+			FailResponse(resp, err.Error())
+			log.Warn("failed to save restored binlogs", zap.Int64("segmentID", segmentID), zap.Error(err))
+			return resp, nil
+		}
+		if segment.GetState() != commonpb.SegmentState_Flushed || req.GetFlushed() || req.GetDropped() {
+			FailResponse(resp, fmt.Sprintf("segment %d is %s, only flushed segment can be restored", segmentID, segment.GetState()))
+			log.Warn("failed to save restored binlogs", zap.Int64("segmentID", segmentID), zap.String("state", segment.GetState().String()))
+			return resp, nil
+		}
+	} else if !s.channelManager.Match(nodeID, channel) {
 		FailResponse(resp, fmt.Sprintf("channel %s is not watched on node %d", channel, nodeID))
 		log.Warn("node is not matched with channel", zap.String("channel", channel), zap.Int64("nodeID", nodeID))
 		return resp, nil
 	}
 
+	// checked again when updating meta, fail fast here before dropping the segment
+	if err := checkSegmentVersion(segment, req.GetExpectedVersion()); err != nil {
+		resp.ErrorCode = commonpb.ErrorCode_VersionMismatch
+		resp.Reason = err.Error()
+		log.Warn("segment version mismatch", zap.Error(err))
+		return resp, nil
+	}
+
+	if duplicates := s.fingerprintValidator.Check(segmentID, req.GetField2BinlogPaths()); len(duplicates) > 0 {
+		resp.ErrorCode = commonpb.ErrorCode_DuplicateSegment
+		resp.Reason = fmt.Sprintf("binlogs of segment %d are registered by segments %v", segmentID, duplicates)
+		log.Warn("segment binlogs are duplicate", zap.Int64("segmentID", segmentID), zap.Int64s("duplicates", duplicates))
+		return resp, nil
+	}
+
 	if req.GetDropped() {
 		s.segmentManager.DropSegment(ctx, segment.GetID())
 	}
@@ -330,19 +426,41 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 		req.GetSegmentID(),
 		req.GetFlushed(),
 		req.GetDropped(),
+		req.GetRebuilt() || req.GetIsRestored(),
 		req.GetField2BinlogPaths(),
 		req.GetField2StatslogPaths(),
+		req.GetField2SketchlogPaths(),
 		req.GetDeltalogs(),
 		req.GetCheckPoints(),
-		req.GetStartPositions())
+		req.GetStartPositions(),
+		req.GetExpectedVersion())
 	if err != nil {
 		log.Error("save binlog and checkpoints failed",
 			zap.Int64("segmentID", req.GetSegmentID()),
 			zap.Error(err))
+		var mismatch *segmentVersionMismatchError
+		if errors.As(err, &mismatch) {
+			resp.ErrorCode = commonpb.ErrorCode_VersionMismatch
+		}
 		resp.Reason = err.Error()
 		return resp, nil
 	}
 
+	s.fingerprintValidator.Add(req.GetField2BinlogPaths())
+
+	leaseExpired := false
+	if s.leaseManager != nil {
+		// binlogs are saved anyway, DataNode is told the segment is sealed unless it's flushed or dropped
+		leaseExpired = s.leaseManager.isRevoked(segmentID) && !req.GetFlushed() && !req.GetDropped()
+		renewed := []UniqueID{segmentID}
+		for _, cp := range req.GetCheckPoints() {
+			renewed = append(renewed, cp.GetSegmentID())
+		}
+		if err := s.leaseManager.renew(renewed, time.Now()); err != nil {
+			log.Warn("failed to renew segment lease", zap.Int64("segmentID", segmentID), zap.Error(err))
+		}
+	}
+
 	log.Debug("flush segment with meta", zap.Int64("id", req.SegmentID),
 		zap.Any("meta", req.GetField2BinlogPaths()))
 
@@ -373,6 +491,11 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 			}
 		}
 	}
+	if leaseExpired {
+		resp.ErrorCode = commonpb.ErrorCode_SegmentLeaseExpired
+		resp.Reason = fmt.Sprintf("lease of segment %d expired, the segment is sealed", segmentID)
+		return resp, nil
+	}
 	resp.ErrorCode = commonpb.ErrorCode_Success
 	return resp, nil
 }
@@ -536,6 +659,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 
 // GetFlushedSegments returns all segment matches provided criterion and in State Flushed
 // If requested partition id < 0, ignores the partition id filter
+// Segment ids are sorted, and returned in pages of the requested limit if it's not 0
 func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
 	resp := &datapb.GetFlushedSegmentsResponse{
 		Status: &commonpb.Status{
@@ -546,7 +670,9 @@ func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 	partitionID := req.GetPartitionID()
 	log.Debug("GetFlushedSegment",
 		zap.Int64("collectionID", collectionID),
-		zap.Int64("partitionID", partitionID))
+		zap.Int64("partitionID", partitionID),
+		zap.Int64("limit", req.GetLimit()),
+		zap.String("pageToken", req.GetPageToken()))
 	if s.isClosed() {
 		resp.Status.Reason = serverNotServingErrMsg
 		return resp, nil
@@ -566,7 +692,90 @@ func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 		// if this segment == nil, we assume this segment has been compacted and flushed
 		ret = append(ret, id)
 	}
-	resp.Segments = ret
+	page, nextPageToken, err := pageFlushedSegments(ret, collectionID, partitionID, req.GetLimit(), req.GetPageToken())
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	resp.Segments = page
+	resp.NextPageToken = nextPageToken
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// defaultGetFlushedSegmentsLimit is the page size of GetFlushedSegmentsV2 if limit is not specified
+const defaultGetFlushedSegmentsLimit = 100
+
+// GetFlushedSegmentsV2 returns segments of the collection in the requested states, [Flushed] by default.
+// If requested partition id < 0, ignores the partition id filter.
+// Segments are sorted by ID and returned in pages, the next page starts after the segment of the cursor
+func (s *Server) GetFlushedSegmentsV2(ctx context.Context, req *datapb.GetFlushedSegmentsV2Request) (*datapb.GetFlushedSegmentsV2Response, error) {
+	collectionID := req.GetCollectionID()
+	partitionID := req.GetPartitionID()
+	log.Debug("received GetFlushedSegmentsV2 request",
+		zap.Int64("collectionID", collectionID),
+		zap.Int64("partitionID", partitionID),
+		zap.Any("states", req.GetStates()),
+		zap.Int64("cursor", req.GetCursor()),
+		zap.Int64("limit", req.GetLimit()))
+	resp := &datapb.GetFlushedSegmentsV2Response{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+
+	limit := req.GetLimit()
+	if limit < 0 {
+		resp.Status.Reason = fmt.Sprintf("invalid limit %d", limit)
+		return resp, nil
+	}
+	if limit == 0 {
+		limit = defaultGetFlushedSegmentsLimit
+	}
+	states := make(map[commonpb.SegmentState]struct{})
+	for _, state := range req.GetStates() {
+		states[state] = struct{}{}
+	}
+	if len(states) == 0 {
+		states[commonpb.SegmentState_Flushed] = struct{}{}
+	}
+
+	var segmentIDs []UniqueID
+	if partitionID < 0 {
+		segmentIDs = s.meta.GetSegmentsIDOfCollection(collectionID)
+	} else {
+		segmentIDs = s.meta.GetSegmentsIDOfPartition(collectionID, partitionID)
+	}
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+	// segments may change between pages, so the next page starts from the position of cursor rather than its index
+	start := sort.Search(len(segmentIDs), func(i int) bool { return segmentIDs[i] > req.GetCursor() })
+
+	segments := make([]*datapb.SegmentInfo, 0)
+	for _, id := range segmentIDs[start:] {
+		segment := s.meta.GetSegment(id)
+		// segment may be compacted or dropped after listed
+		if segment == nil {
+			continue
+		}
+		if _, ok := states[segment.GetState()]; !ok {
+			continue
+		}
+		if int64(len(segments)) == limit {
+			resp.NextCursor = segments[len(segments)-1].GetID()
+			break
+		}
+		info := segment.Clone().SegmentInfo
+		if !req.GetIncludeDeltalogs() {
+			info.Deltalogs = nil
+		}
+		segments = append(segments, info)
+	}
+
+	resp.Segments = segments
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 	return resp, nil
 }
@@ -680,6 +889,211 @@ func (s *Server) CompleteCompaction(ctx context.Context, req *datapb.CompactionR
 	return resp, nil
 }
 
+// ReclaimFailedCompaction removes the output binlogs of a failed compaction plan from storage and marks the plan
+// as reclaimed, reclaiming a plan already reclaimed succeeds
+func (s *Server) ReclaimFailedCompaction(ctx context.Context, req *datapb.ReclaimFailedCompactionRequest) (*commonpb.Status, error) {
+	log.Debug("receive reclaim failed compaction request", zap.Int64("planID", req.GetPlanID()))
+
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to reclaim compaction", zap.Int64("planID", req.GetPlanID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	task := s.compactionHandler.getCompaction(req.GetPlanID())
+	if task == nil {
+		resp.Reason = fmt.Sprintf("plan %d is not found", req.GetPlanID())
+		return resp, nil
+	}
+	if task.state == reclaimed {
+		resp.ErrorCode = commonpb.ErrorCode_Success
+		return resp, nil
+	}
+	if task.state != failed {
+		resp.Reason = fmt.Sprintf("plan %d is not failed", req.GetPlanID())
+		return resp, nil
+	}
+	if s.storageCli == nil {
+		resp.Reason = errStorageNotInitialized.Error()
+		return resp, nil
+	}
+
+	// the result may be applied by a save reported failed, binlogs referenced by segments must be kept
+	paths, err := s.meta.unreferencedBinlogs(compactionResultPaths(task.result))
+	if err != nil {
+		log.Warn("failed to check binlogs of failed compaction", zap.Int64("planID", req.GetPlanID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	for _, path := range paths {
+		// removing an object not existing succeeds, so that a reclaim interrupted can be retried
+		if err := s.storageCli.RemoveObject(ctx, Params.MinioBucketName, path, minio.RemoveObjectOptions{}); err != nil {
+			log.Warn("failed to remove binlog of failed compaction", zap.Int64("planID", req.GetPlanID()),
+				zap.String("path", path), zap.Error(err))
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+	}
+	if err := s.compactionHandler.reclaimCompaction(req.GetPlanID()); err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Debug("success to reclaim failed compaction", zap.Int64("planID", req.GetPlanID()), zap.Int("numOfBinlogs", len(paths)))
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// compactionResultPaths returns paths of all the binlogs written by a compaction
+func compactionResultPaths(result *datapb.CompactionResult) []string {
+	var paths []string
+	for _, fieldBinlog := range result.GetInsertLogs() {
+		paths = append(paths, fieldBinlog.GetBinlogs()...)
+	}
+	for _, fieldBinlog := range result.GetField2StatslogPaths() {
+		paths = append(paths, fieldBinlog.GetBinlogs()...)
+	}
+	for _, deltalog := range result.GetDeltalogs() {
+		if deltalog.GetDeltaLogPath() != "" {
+			paths = append(paths, deltalog.GetDeltaLogPath())
+		}
+	}
+	return paths
+}
+
+// PinSegments marks segments pinned so that compaction never merges them with other segments
+func (s *Server) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*commonpb.Status, error) {
+	log.Debug("receive pin segments request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	return s.setSegmentsPinned(ctx, req.GetCollectionID(), req.GetSegmentIDs(), true), nil
+}
+
+// UnpinSegments clears the pinned flag of segments
+func (s *Server) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	log.Debug("receive unpin segments request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	return s.setSegmentsPinned(ctx, req.GetCollectionID(), req.GetSegmentIDs(), false), nil
+}
+
+// setSegmentsPinned sets the pinned flag of segments in the collection, only admins may pin or unpin segments
+func (s *Server) setSegmentsPinned(ctx context.Context, collectionID UniqueID, segmentIDs []UniqueID, pinned bool) *commonpb.Status {
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to set segments pinned", zap.Int64("collectionID", collectionID),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp
+	}
+	// pinned segments are kept from compaction of the collection
+	if err := util.CheckAdmin(ctx, Params.AdminToken); err != nil {
+		log.Warn("failed to set segments pinned", zap.Int64("collectionID", collectionID), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp
+	}
+
+	if len(segmentIDs) == 0 {
+		resp.Reason = "no segment specified"
+		return resp
+	}
+
+	if err := s.meta.SetSegmentsPinned(collectionID, segmentIDs, pinned); err != nil {
+		log.Warn("failed to set segments pinned", zap.Int64("collectionID", collectionID),
+			zap.Int64s("segmentIDs", segmentIDs), zap.Bool("pinned", pinned), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp
+	}
+
+	log.Debug("success to set segments pinned", zap.Int64("collectionID", collectionID),
+		zap.Int64s("segmentIDs", segmentIDs), zap.Bool("pinned", pinned))
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp
+}
+
+// ListManagedCollections lists the collections in DataCoord meta with a summary of their segments,
+// so that collections can be discovered without RootCoord
+func (s *Server) ListManagedCollections(ctx context.Context, req *datapb.ListManagedCollectionsRequest) (*datapb.ListManagedCollectionsResponse, error) {
+	log.Debug("received ListManagedCollections request")
+	resp := &datapb.ListManagedCollectionsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to list managed collections", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	collectionIDs := s.meta.ListCollectionIDs()
+	summaries := make(map[UniqueID]*datapb.ManagedCollectionSummary, len(collectionIDs))
+	stateCounts := make(map[UniqueID]map[commonpb.SegmentState]int64, len(collectionIDs))
+	resp.Collections = make([]*datapb.ManagedCollectionSummary, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		summary := &datapb.ManagedCollectionSummary{
+			CollectionID:     collectionID,
+			SegmentCounts:    make([]*datapb.SegmentStateCount, 0),
+			CompactionPaused: !Params.EnableCompaction,
+		}
+		summaries[collectionID] = summary
+		stateCounts[collectionID] = make(map[commonpb.SegmentState]int64)
+		resp.Collections = append(resp.Collections, summary)
+	}
+
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool { return true })
+	for _, segment := range segments {
+		summary, ok := summaries[segment.GetCollectionID()]
+		if !ok {
+			// segment added after collections are listed
+			continue
+		}
+		stateCounts[segment.GetCollectionID()][segment.GetState()]++
+		if isSegmentHealthy(segment) {
+			summary.NumOfRows += segment.GetNumOfRows()
+		}
+		if segment.GetState() == commonpb.SegmentState_Growing || segment.GetState() == commonpb.SegmentState_Sealed {
+			ts := segment.GetStartPosition().GetTimestamp()
+			if ts != 0 && (summary.OldestUnflushedTimestamp == 0 || ts < summary.OldestUnflushedTimestamp) {
+				summary.OldestUnflushedTimestamp = ts
+			}
+		}
+	}
+
+	for _, summary := range resp.Collections {
+		counts := stateCounts[summary.GetCollectionID()]
+		for state, count := range counts {
+			summary.SegmentCounts = append(summary.SegmentCounts, &datapb.SegmentStateCount{State: state, Count: count})
+		}
+		sort.Slice(summary.SegmentCounts, func(i, j int) bool {
+			return summary.SegmentCounts[i].GetState() < summary.SegmentCounts[j].GetState()
+		})
+		// size is estimated only if the schema is cached, RootCoord is never asked for it
+		if collection := s.meta.GetCollection(summary.GetCollectionID()); collection != nil {
+			sizePerRecord, err := typeutil.EstimateSizePerRecord(collection.GetSchema())
+			if err == nil {
+				summary.EstimatedSize = summary.GetNumOfRows() * int64(sizePerRecord)
+			}
+		}
+	}
+
+	log.Debug("success to list managed collections", zap.Int("numOfCollections", len(resp.Collections)))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 // ManualCompaction triggers a compaction for a collection
 func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
 	log.Debug("receive manual compaction", zap.Int64("collectionID", req.GetCollectionID()))
@@ -702,6 +1116,23 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 		return resp, nil
 	}
 
+	if paused, reason := s.compactionTrigger.isPaused(); paused {
+		log.Warn("failed to execute manual compaction", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.String("pauseReason", reason))
+		resp.Status.Reason = fmt.Sprintf("%s: %s", errCompactionPaused.Error(), reason)
+		return resp, nil
+	}
+
+	if req.GetDryRun() {
+		plans := s.compactionTrigger.dryRunForceCompaction(req.GetCollectionID(), &timetravel{req.GetTimetravel()})
+		resp.MergeInfos, resp.SourceSize, resp.EstimatedTargetSize = estimateCompactionPlans(s.meta, plans)
+		log.Debug("success to plan manual compaction in dry run", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Int("plans", len(plans)), zap.Int64("sourceSize", resp.GetSourceSize()),
+			zap.Int64("estimatedTargetSize", resp.GetEstimatedTargetSize()))
+		resp.Status.ErrorCode = commonpb.ErrorCode_Success
+		return resp, nil
+	}
+
 	id, err := s.compactionTrigger.forceTriggerCompaction(req.CollectionID, &timetravel{req.Timetravel})
 	if err != nil {
 		log.Error("failed to trigger manual compaction", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
@@ -819,6 +1250,18 @@ func getCompactionState(tasks []*compactionTask) (state commonpb.CompactionState
 
 func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	log.Debug("receive watch channels request", zap.Any("channels", req.GetChannelNames()))
+	return s.watchChannels(req.GetCollectionID(), req.GetChannelNames(), nil), nil
+}
+
+// WatchChannelsV2 watches channels like WatchChannels, and the DataNodes seek to the specified positions
+// of channels, so that a channel taken over in recovery is resumed from the last known checkpoint
+func (s *Server) WatchChannelsV2(ctx context.Context, req *datapb.WatchChannelsV2Request) (*datapb.WatchChannelsResponse, error) {
+	log.Debug("receive watch channels v2 request", zap.Any("channels", req.GetChannelNames()),
+		zap.Any("seekPositions", req.GetSeekPositions()))
+	return s.watchChannels(req.GetCollectionID(), req.GetChannelNames(), req.GetSeekPositions()), nil
+}
+
+func (s *Server) watchChannels(collectionID UniqueID, channelNames []string, seekPositions map[string]*internalpb.MsgPosition) *datapb.WatchChannelsResponse {
 	resp := &datapb.WatchChannelsResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -826,24 +1269,1259 @@ func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsReq
 	}
 
 	if s.isClosed() {
-		log.Warn("failed to  watch channels request", zap.Any("channels", req.GetChannelNames()),
+		log.Warn("failed to  watch channels request", zap.Any("channels", channelNames),
 			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
 		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
-		return resp, nil
+		return resp
 	}
-	for _, channelName := range req.GetChannelNames() {
+	for _, channelName := range channelNames {
 		ch := &channel{
 			Name:         channelName,
-			CollectionID: req.GetCollectionID(),
+			CollectionID: collectionID,
 		}
-		err := s.channelManager.Watch(ch)
+		err := s.channelManager.WatchFromPosition(ch, seekPositions[channelName])
 		if err != nil {
 			log.Warn("fail to watch channelName", zap.String("channelName", channelName), zap.Error(err))
 			resp.Status.Reason = err.Error()
-			return resp, nil
+			return resp
 		}
 	}
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 
+	return resp
+}
+
+// GetChannelHistory returns the timeline of watcher assignments for a vchannel
+func (s *Server) GetChannelHistory(ctx context.Context, req *datapb.GetChannelHistoryRequest) (*datapb.GetChannelHistoryResponse, error) {
+	log.Debug("receive get channel history request", zap.String("channel", req.GetChannelName()))
+	resp := &datapb.GetChannelHistoryResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get channel history", zap.String("channel", req.GetChannelName()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	resp.Events = s.channelManager.GetHistory(req.GetChannelName())
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ImportSegmentManifest downloads the manifest file, validates it and registers
+// the described segments as flushed segments without re-ingesting data
+func (s *Server) ImportSegmentManifest(ctx context.Context, req *datapb.ImportManifestRequest) (*datapb.ImportManifestResponse, error) {
+	log.Debug("receive import segment manifest request", zap.String("url", req.GetManifestUrl()))
+	resp := &datapb.ImportManifestResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to import segment manifest", zap.String("url", req.GetManifestUrl()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	manifest, err := fetchManifest(ctx, req.GetManifestUrl())
+	if err != nil {
+		log.Warn("failed to fetch segment manifest", zap.String("url", req.GetManifestUrl()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	if err := s.validateManifest(ctx, manifest); err != nil {
+		log.Warn("invalid segment manifest", zap.String("url", req.GetManifestUrl()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	segmentIDs, err := s.importManifestSegments(ctx, manifest)
+	resp.SegmentIDs = segmentIDs
+	if err != nil {
+		log.Warn("failed to import segments", zap.String("url", req.GetManifestUrl()),
+			zap.Int64s("imported", segmentIDs), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	log.Info("segments imported from manifest", zap.String("url", req.GetManifestUrl()),
+		zap.Int64("collectionID", manifest.CollectionID), zap.Int64s("segmentIDs", segmentIDs))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetCompactionScoreCard explains why a segment was or was not selected by each compaction policy
+func (s *Server) GetCompactionScoreCard(ctx context.Context, req *datapb.GetCompactionScoreCardRequest) (*datapb.GetCompactionScoreCardResponse, error) {
+	log.Debug("receive get compaction score card request", zap.Int64("segmentID", req.GetSegmentID()))
+	resp := &datapb.GetCompactionScoreCardResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get compaction score card", zap.Int64("segmentID", req.GetSegmentID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Status.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	// dropped segments are selected as well, their score cards explain why they are not compactable
+	segments := s.meta.SelectSegments(func(info *SegmentInfo) bool {
+		return info.GetID() == req.GetSegmentID()
+	})
+	if len(segments) == 0 {
+		resp.Status.Reason = fmt.Sprintf("segment %d not found", req.GetSegmentID())
+		return resp, nil
+	}
+	segment := segments[0]
+
+	tt, err := getTimetravelReverseTime(ctx, s.allocator)
+	if err != nil {
+		log.Warn("failed to get timetravel reverse time", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.ScoreCards = s.compactionTrigger.getScoreCards(segment, tt)
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// MigrateChannel moves a channel from the DataNode watching it to another one. Segments of the channel are sealed
+// and flushed by the old DataNode first, then the new DataNode resumes the channel from the checkpoint of the
+// last flushed segment
+func (s *Server) MigrateChannel(ctx context.Context, req *datapb.MigrateChannelRequest) (*datapb.MigrateChannelResponse, error) {
+	channelName := req.GetChannelName()
+	log.Debug("receive migrate channel request", zap.String("channel", channelName),
+		zap.Int64("targetNodeID", req.GetTargetNodeID()))
+	resp := &datapb.MigrateChannelResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to migrate channel", zap.String("channel", channelName),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !s.migratingChannels.tryLock(channelName) {
+		resp.Status.Reason = fmt.Sprintf("channel %s is being migrated", channelName)
+		return resp, nil
+	}
+	defer s.migratingChannels.unlock(channelName)
+
+	from, err := s.channelManager.FindWatcher(channelName)
+	if err != nil {
+		log.Warn("failed to find watcher of channel", zap.String("channel", channelName), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	to, err := s.chooseMigrationTarget(from, req.GetTargetNodeID())
+	if err != nil {
+		log.Warn("failed to choose migration target", zap.String("channel", channelName), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	if err := s.drainChannel(ctx, channelName); err != nil {
+		log.Warn("failed to drain channel", zap.String("channel", channelName), zap.Int64("nodeID", from), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	seekPosition, err := s.channelManager.Migrate(channelName, from, to, s.migrationSeekPosition(channelName))
+	if err != nil {
+		log.Warn("failed to migrate channel", zap.String("channel", channelName), zap.Int64("from", from),
+			zap.Int64("to", to), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	log.Info("channel migrated", zap.String("channel", channelName), zap.Int64("from", from),
+		zap.Int64("to", to), zap.Any("seekPosition", seekPosition))
+	resp.NodeID = to
+	resp.SeekPosition = seekPosition
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// defaultListCompactionPlansLimit is the page size of ListCompactionPlans if limit is not specified
+const defaultListCompactionPlansLimit = 100
+
+// ListCompactionPlans lists compaction plans of all collections filtered by collection, state and creation time.
+// Plans are sorted by creation time in descending order and returned in pages, the next page starts after the
+// plan of the cursor
+func (s *Server) ListCompactionPlans(ctx context.Context, req *datapb.ListCompactionPlansRequest) (*datapb.ListCompactionPlansResponse, error) {
+	log.Debug("received ListCompactionPlans request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Any("state", req.GetState()), zap.Uint64("createdAfter", req.GetCreatedAfter()),
+		zap.Int64("cursor", req.GetCursor()), zap.Int64("limit", req.GetLimit()))
+	resp := &datapb.ListCompactionPlansResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to list compaction plans", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Status.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	limit := req.GetLimit()
+	if limit < 0 {
+		resp.Status.Reason = fmt.Sprintf("invalid limit %d", limit)
+		return resp, nil
+	}
+	if limit == 0 {
+		limit = defaultListCompactionPlansLimit
+	}
+
+	now := time.Now()
+	plans := make([]*datapb.CompactionPlanInfo, 0)
+	for _, task := range s.compactionHandler.getCompactionTasks() {
+		info := s.getCompactionPlanInfo(task, now)
+		if req.GetCollectionID() != 0 && info.GetCollectionID() != req.GetCollectionID() {
+			continue
+		}
+		if req.GetState() != datapb.CompactionPlanState_AllPlanStates && info.GetState() != req.GetState() {
+			continue
+		}
+		if info.GetCreateTime() <= req.GetCreatedAfter() {
+			continue
+		}
+		plans = append(plans, info)
+	}
+	sort.Slice(plans, func(i, j int) bool {
+		return compactionPlanInfoBefore(plans[i].GetCreateTime(), plans[i].GetPlanID(), plans[j].GetCreateTime(), plans[j].GetPlanID())
+	})
+
+	start := 0
+	if req.GetCursor() != 0 {
+		cursor := s.compactionHandler.getCompaction(req.GetCursor())
+		if cursor == nil {
+			resp.Status.Reason = fmt.Sprintf("cursor plan %d not found", req.GetCursor())
+			return resp, nil
+		}
+		// plans may change between pages, so the next page starts from the position of cursor rather than its index
+		cursorTime := compactionTaskCreateTime(cursor)
+		start = sort.Search(len(plans), func(i int) bool {
+			return compactionPlanInfoBefore(cursorTime, req.GetCursor(), plans[i].GetCreateTime(), plans[i].GetPlanID())
+		})
+	}
+	end := start + int(limit)
+	if end < len(plans) {
+		resp.NextCursor = plans[end-1].GetPlanID()
+	} else {
+		end = len(plans)
+	}
+
+	resp.Plans = plans[start:end]
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// compactionPlanInfoBefore returns true if plan a comes before plan b in ListCompactionPlans,
+// the later created plan comes first, and the plan with larger ID comes first if they are created at the same time
+func compactionPlanInfoBefore(createTimeA Timestamp, planIDA int64, createTimeB Timestamp, planIDB int64) bool {
+	if createTimeA != createTimeB {
+		return createTimeA > createTimeB
+	}
+	return planIDA > planIDB
+}
+
+func compactionTaskCreateTime(task *compactionTask) Timestamp {
+	return tsoutil.ComposeTS(task.createTime.UnixNano()/int64(time.Millisecond), 0)
+}
+
+func (s *Server) getCompactionPlanInfo(task *compactionTask, now time.Time) *datapb.CompactionPlanInfo {
+	info := &datapb.CompactionPlanInfo{
+		PlanID:        task.plan.GetPlanID(),
+		SignalID:      task.triggerInfo.id,
+		CollectionID:  task.triggerInfo.collectionID,
+		CreateTime:    compactionTaskCreateTime(task),
+		NumOfSegments: int64(len(task.plan.GetSegmentBinlogs())),
+	}
+	switch task.state {
+	case executing:
+		info.State = datapb.CompactionPlanState_PlanExecuting
+	case completed:
+		info.State = datapb.CompactionPlanState_PlanCompleted
+	case timeout:
+		info.State = datapb.CompactionPlanState_PlanTimeout
+	case failed:
+		info.State = datapb.CompactionPlanState_PlanFailed
+	case reclaimed:
+		info.State = datapb.CompactionPlanState_PlanReclaimed
+	case cancelled:
+		info.State = datapb.CompactionPlanState_PlanCancelled
+	}
+	end := now
+	if !task.endTime.IsZero() {
+		end = task.endTime
+	}
+	info.ElapsedSeconds = int64(end.Sub(task.createTime).Seconds())
+
+	// rows of result are counted if the plan is completed, otherwise rows of input segments
+	var numOfRows int64
+	for _, segmentBinlogs := range task.plan.GetSegmentBinlogs() {
+		segment := s.meta.GetSegment(segmentBinlogs.GetSegmentID())
+		if segment == nil {
+			continue
+		}
+		// collection of global signal is unknown, it's taken from segments
+		info.CollectionID = segment.GetCollectionID()
+		numOfRows += segment.GetNumOfRows()
+	}
+	if task.result != nil {
+		numOfRows = task.result.GetNumOfRows()
+	}
+	if collection := s.meta.GetCollection(info.GetCollectionID()); collection != nil {
+		sizePerRecord, err := typeutil.EstimateSizePerRecord(collection.GetSchema())
+		if err == nil {
+			info.EstimatedOutputSize = numOfRows * int64(sizePerRecord)
+		}
+	}
+	return info
+}
+
+// MigrateEtcdPrefix moves all etcd keys under the old prefix to the new prefix, the components using the old prefix
+// must be stopped before and are expected to be restarted with the new prefix configured once it is done
+func (s *Server) MigrateEtcdPrefix(ctx context.Context, req *datapb.MigrateEtcdPrefixRequest) (*datapb.MigrateEtcdPrefixResponse, error) {
+	log.Info("received MigrateEtcdPrefix request", zap.String("oldPrefix", req.GetOldPrefix()),
+		zap.String("newPrefix", req.GetNewPrefix()), zap.Int64("sourceID", req.GetBase().GetSourceID()))
+	resp := &datapb.MigrateEtcdPrefixResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to migrate etcd prefix", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+	if err := util.CheckAdmin(ctx, Params.AdminToken); err != nil {
+		log.Warn("failed to migrate etcd prefix", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	s.prefixMigrationMu.Lock()
+	defer s.prefixMigrationMu.Unlock()
+	rootKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, "")
+	if err != nil {
+		log.Warn("failed to connect etcd", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	defer rootKV.Close()
+
+	// keys under a prefix in use keep being written, and leased session keys would lose their leases if moved
+	if err := checkEtcdPrefixInUse(rootKV, req.GetOldPrefix(), Params.MetaRootPath, Params.KvRootPath); err != nil {
+		log.Warn("failed to migrate etcd prefix", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	migrated, err := migrateEtcdPrefix(rootKV, &etcdPrefixMigration{
+		OldPrefix: req.GetOldPrefix(),
+		NewPrefix: req.GetNewPrefix(),
+		SourceID:  req.GetBase().GetSourceID(),
+		StartTime: time.Now().Unix(),
+	})
+	if err != nil {
+		log.Warn("failed to migrate etcd prefix", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.MigratedKeys = int64(migrated)
+	return resp, nil
+}
+
+// RegisterDataNodeQuota registers the resource quota of a DataNode, which limits the channels assigned to it
+func (s *Server) RegisterDataNodeQuota(ctx context.Context, req *datapb.RegisterDataNodeQuotaRequest) (*commonpb.Status, error) {
+	log.Info("received RegisterDataNodeQuota request", zap.Int64("nodeID", req.GetNodeID()),
+		zap.Int64("maxSegments", req.GetMaxSegments()), zap.Int64("maxChannels", req.GetMaxChannels()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to register DataNode quota", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	quota := &ResourceQuota{
+		MaxSegments: req.GetMaxSegments(),
+		MaxChannels: req.GetMaxChannels(),
+	}
+	if err := s.channelManager.RegisterResourceQuota(req.GetNodeID(), quota); err != nil {
+		log.Warn("failed to register DataNode quota", zap.Int64("nodeID", req.GetNodeID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// StorageAudit reports the binlog objects not referenced by segment meta, and removes them if it's requested explicitly,
+// which is only allowed to the admin
+func (s *Server) StorageAudit(ctx context.Context, req *datapb.StorageAuditRequest) (*datapb.StorageAuditResponse, error) {
+	log.Info("received StorageAudit request", zap.Bool("remove", req.GetRemove()))
+	resp := &datapb.StorageAuditResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to audit storage", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+	if req.GetRemove() {
+		if err := util.CheckAdmin(ctx, Params.AdminToken); err != nil {
+			log.Warn("failed to audit storage", zap.Error(err))
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+	}
+	if s.storageCli == nil {
+		resp.Status.Reason = errStorageNotInitialized.Error()
+		return resp, nil
+	}
+
+	report, err := newStorageAuditor(s.meta, s.storageCli, Params.MinioBucketName, Params.MinioRootPath, s.gcEvents).audit(ctx, !req.GetRemove())
+	if err != nil {
+		log.Warn("failed to audit storage", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	log.Info("success to audit storage", zap.Int64("scanned", report.GetScannedObjects()),
+		zap.Int64("orphaned", report.GetOrphanedObjects()), zap.Int64("orphanedSize", report.GetOrphanedSize()),
+		zap.Int64("removed", report.GetRemovedObjects()))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.Report = report
+	return resp, nil
+}
+
+// SampledSegmentInspector returns the statistics of fields over a random sample of rows in a segment.
+// The DataNode watching the channel of the segment reads the sample, the result is cached for Params.SampleCacheTTLSeconds
+func (s *Server) SampledSegmentInspector(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	log.Info("received SampledSegmentInspector request", zap.Int64("segmentID", req.GetSegmentID()),
+		zap.Float64("sampleRate", req.GetSampleRate()))
+	resp := &datapb.SampleSegmentResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+		SegmentID: req.GetSegmentID(),
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to inspect segment", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+	if req.GetSampleRate() <= 0 || req.GetSampleRate() > 1 {
+		resp.Status.Reason = fmt.Sprintf("invalid sample rate %v, it should be in (0, 1]", req.GetSampleRate())
+		return resp, nil
+	}
+	segment := s.meta.GetSegment(req.GetSegmentID())
+	if segment == nil {
+		resp.Status.ErrorCode = commonpb.ErrorCode_SegmentNotFound
+		resp.Status.Reason = fmt.Sprintf("segment %d is not found", req.GetSegmentID())
+		return resp, nil
+	}
+
+	if cached := s.sampleCache.get(segment.GetID(), req.GetSampleRate(), time.Now()); cached != nil {
+		return cached, nil
+	}
+	sampled, err := s.cluster.SampleSegment(ctx, &datapb.SampleSegmentRequest{
+		Base:         req.GetBase(),
+		SegmentID:    segment.GetID(),
+		SampleRate:   req.GetSampleRate(),
+		Channel:      segment.GetInsertChannel(),
+		FieldBinlogs: segment.GetBinlogs(),
+	})
+	if err != nil {
+		log.Warn("failed to inspect segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	s.sampleCache.put(segment.GetID(), req.GetSampleRate(), sampled, time.Now(), time.Duration(Params.SampleCacheTTLSeconds)*time.Second)
+	return sampled, nil
+}
+
+// GetStorageUsage returns the binlog storage usage of collections grouped by field and log type.
+// Usage is summed from the binlogs recorded in meta, the result is cached for Params.StorageUsageCacheTTLSeconds
+func (s *Server) GetStorageUsage(ctx context.Context, req *datapb.GetStorageUsageRequest) (*datapb.GetStorageUsageResponse, error) {
+	log.Info("received GetStorageUsage request", zap.Int64("collectionID", req.GetCollectionID()))
+	resp := &datapb.GetStorageUsageResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get storage usage", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if cached := s.usageCache.get(req.GetCollectionID(), time.Now()); cached != nil {
+		return cached, nil
+	}
+	usage := getStorageUsage(s.meta, req.GetCollectionID())
+	usage.Status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	if req.GetCollectionID() == 0 {
+		updateStorageUsageMetrics(usage, int(Params.StorageUsageTopN))
+	}
+	s.usageCache.put(req.GetCollectionID(), usage, time.Now(), time.Duration(Params.StorageUsageCacheTTLSeconds)*time.Second)
+	return usage, nil
+}
+
+// CancelCompaction cancels an executing compaction plan so that its result is rejected, and stops the plan on DataNode.
+// The output segment of the plan is dropped if DataNode acknowledges the cancellation. The final state of the plan
+// is returned, which is PlanCompleted if the plan is completed before it's cancelled
+func (s *Server) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	log.Info("received CancelCompaction request", zap.Int64("planID", req.GetPlanID()))
+	resp := &datapb.CancelCompactionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to cancel compaction", zap.Int64("planID", req.GetPlanID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Status.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	task, err := s.compactionHandler.cancelCompaction(req.GetPlanID())
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	switch task.state {
+	case completed:
+		resp.State = datapb.CompactionPlanState_PlanCompleted
+		resp.Status.ErrorCode = commonpb.ErrorCode_Success
+		return resp, nil
+	case cancelled:
+		resp.State = datapb.CompactionPlanState_PlanCancelled
+	default:
+		resp.Status.Reason = fmt.Sprintf("plan %d is not executing", req.GetPlanID())
+		return resp, nil
+	}
+
+	// the plan stays cancelled even if DataNode fails to stop it, since its result is rejected anyway
+	ack, err := s.sessionManager.CancelCompaction(ctx, task.dataNodeID, &datapb.CancelCompactionRequest{
+		Base:   req.GetBase(),
+		PlanID: req.GetPlanID(),
+	})
+	if err != nil {
+		log.Warn("failed to stop compaction on DataNode", zap.Int64("planID", req.GetPlanID()),
+			zap.Int64("nodeID", task.dataNodeID), zap.Error(err))
+	} else if ack.GetState() == datapb.CompactionPlanState_PlanCancelled {
+		if err := s.dropCompactionOutput(task.plan, ack.GetSegmentID()); err != nil {
+			log.Warn("failed to drop output segment of cancelled compaction", zap.Int64("planID", req.GetPlanID()),
+				zap.Int64("segmentID", ack.GetSegmentID()), zap.Error(err))
+		}
+	}
+
+	log.Info("compaction cancelled", zap.Int64("planID", req.GetPlanID()), zap.Int64("nodeID", task.dataNodeID))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// dropCompactionOutput marks the output segment of a cancelled merge compaction as dropped, so that its binlogs
+// are removed by GC. Input segments are never dropped, the output of inner compaction is its input
+func (s *Server) dropCompactionOutput(plan *datapb.CompactionPlan, segmentID UniqueID) error {
+	if segmentID == 0 || plan.GetType() != datapb.CompactionType_MergeCompaction {
+		return nil
+	}
+	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
+		if segmentBinlogs.GetSegmentID() == segmentID {
+			return nil
+		}
+	}
+	return s.meta.UpdateFlushSegmentsInfo(segmentID, false, true, false, nil, nil, nil, nil, nil, nil, 0)
+}
+
+// GetGCEvents returns the latest objects removed by garbage collection and storage audit, at most Params.GCEventMaxReturn
+func (s *Server) GetGCEvents(ctx context.Context, req *datapb.GetGCEventsRequest) (*datapb.GetGCEventsResponse, error) {
+	resp := &datapb.GetGCEventsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get gc events", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+	if req.GetStartTime() != 0 && req.GetEndTime() != 0 && req.GetStartTime() > req.GetEndTime() {
+		resp.Status.Reason = fmt.Sprintf("start time %d is after end time %d", req.GetStartTime(), req.GetEndTime())
+		return resp, nil
+	}
+
+	resp.Events = s.gcEvents.list(req.GetStartTime(), req.GetEndTime(), req.GetReason(), Params.GCEventMaxReturn)
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ReportSegmentError is called by DataNode once it stops saving binlog paths of a segment after consecutive failures.
+// The segment is sealed if growing, so that no more rows are assigned to it
+func (s *Server) ReportSegmentError(ctx context.Context, req *datapb.ReportSegmentErrorRequest) (*commonpb.Status, error) {
+	log.Warn("received ReportSegmentError request", zap.Int64("segmentID", req.GetSegmentID()),
+		zap.Int64("collectionID", req.GetCollectionID()), zap.String("channel", req.GetChannel()),
+		zap.Int64("nodeID", req.GetBase().GetSourceID()), zap.String("reason", req.GetReason()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to report segment error", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	segment := s.meta.GetSegment(req.GetSegmentID())
+	if segment == nil {
+		resp.ErrorCode = commonpb.ErrorCode_SegmentNotFound
+		resp.Reason = fmt.Sprintf("segment %d not found", req.GetSegmentID())
+		return resp, nil
+	}
+	if segment.GetState() == commonpb.SegmentState_Growing {
+		if err := s.meta.SetState(req.GetSegmentID(), commonpb.SegmentState_Sealed); err != nil {
+			log.Warn("failed to seal errored segment", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+		log.Warn("errored segment sealed", zap.Int64("segmentID", req.GetSegmentID()))
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// SetCollectionProperty sets properties of a collection managed by DataCoord, e.g. the data retention policy
+func (s *Server) SetCollectionProperty(ctx context.Context, req *datapb.SetCollectionPropertyRequest) (*commonpb.Status, error) {
+	log.Debug("receive set collection property request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Any("properties", req.GetProperties()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to set collection property", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+	if len(req.GetProperties()) == 0 {
+		resp.Reason = "no property specified"
+		return resp, nil
+	}
+	if s.GetCollection(ctx, req.GetCollectionID()) == nil {
+		resp.Reason = fmt.Sprintf("collection %d not found", req.GetCollectionID())
+		return resp, nil
+	}
+
+	if err := s.retentionManager.setProperties(req.GetCollectionID(), req.GetProperties()); err != nil {
+		log.Warn("failed to set collection property", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ComputeSegmentOverlap quantifies the primary key range overlap between flushed segments of a partition,
+// the ranges are read from the stats logs of the segments and taken into the merge compaction score
+func (s *Server) ComputeSegmentOverlap(ctx context.Context, req *datapb.ComputeSegmentOverlapRequest) (*datapb.ComputeSegmentOverlapResponse, error) {
+	log.Debug("receive compute segment overlap request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()))
+	resp := &datapb.ComputeSegmentOverlapResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to compute segment overlap", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Int64("partitionID", req.GetPartitionID()), zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+	if s.storageCli == nil {
+		resp.Status.Reason = errStorageNotInitialized.Error()
+		return resp, nil
+	}
+	coll := s.GetCollection(ctx, req.GetCollectionID())
+	if coll == nil {
+		resp.Status.Reason = fmt.Sprintf("collection %d not found", req.GetCollectionID())
+		return resp, nil
+	}
+	var pkField *schemapb.FieldSchema
+	for _, field := range coll.GetSchema().GetFields() {
+		if field.GetIsPrimaryKey() {
+			pkField = field
+			break
+		}
+	}
+	if pkField == nil || pkField.GetDataType() != schemapb.DataType_Int64 {
+		resp.Status.Reason = fmt.Sprintf("collection %d has no int64 primary key", req.GetCollectionID())
+		return resp, nil
+	}
+
+	segments := s.meta.SelectSegments(func(info *SegmentInfo) bool {
+		return info.GetCollectionID() == req.GetCollectionID() && info.GetPartitionID() == req.GetPartitionID() &&
+			info.GetState() == commonpb.SegmentState_Flushed
+	})
+	ranges := make(map[UniqueID]pkRange, len(segments))
+	var skipped []UniqueID
+	for _, segment := range segments {
+		r, ok, err := s.pkRanges.load(ctx, segment, pkField.GetFieldID(), s.getObject)
+		if err != nil {
+			log.Warn("failed to load primary key range of segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+		if !ok {
+			skipped = append(skipped, segment.GetID())
+			continue
+		}
+		ranges[segment.GetID()] = r
+	}
+	// ranges of the segments compacted or dropped are never used again
+	s.pkRanges.prune(func(segmentID UniqueID) bool {
+		return s.meta.GetSegment(segmentID) != nil
+	})
+
+	resp.Report = newOverlapReport(req.GetCollectionID(), req.GetPartitionID(), ranges, skipped)
+	log.Info("success to compute segment overlap", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()), zap.Int("segments", len(ranges)),
+		zap.Float64("overlapRatio", resp.Report.GetOverlapRatio()), zap.Int("suggestedMerges", len(resp.Report.GetSuggestedMerges())))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetCompactionROI returns the bytes read and written by the compaction plans completed, which are aggregated from
+// the compaction history. The result is cached for Params.CompactionROICacheTTLSeconds
+func (s *Server) GetCompactionROI(ctx context.Context, req *datapb.GetCompactionROIRequest) (*datapb.GetCompactionROIResponse, error) {
+	log.Debug("receive get compaction ROI request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("startTime", req.GetStartTime()), zap.Int64("endTime", req.GetEndTime()))
+	resp := &datapb.GetCompactionROIResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get compaction ROI", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+	if !Params.EnableCompaction || s.compactionHistory == nil {
+		resp.Status.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	if cached := s.roiCache.get(req.GetCollectionID(), req.GetStartTime(), req.GetEndTime(), time.Now()); cached != nil {
+		return cached, nil
+	}
+	records, err := s.compactionHistory.load(req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to load compaction history", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	resp = computeCompactionROI(records, req.GetStartTime(), req.GetEndTime())
+	resp.Status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	s.roiCache.put(req.GetCollectionID(), req.GetStartTime(), req.GetEndTime(), resp, time.Now(),
+		time.Duration(Params.CompactionROICacheTTLSeconds)*time.Second)
+	return resp, nil
+}
+
+// FreezePartition rejects new segment allocations of a partition until it's unfrozen or the freeze times out,
+// so that DDL operations on the partition are not raced by new writes
+func (s *Server) FreezePartition(ctx context.Context, req *datapb.FreezePartitionRequest) (*commonpb.Status, error) {
+	log.Debug("receive freeze partition request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()), zap.Int64("autoUnfreezeSeconds", req.GetAutoUnfreezeSeconds()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to freeze partition", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Int64("partitionID", req.GetPartitionID()), zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+	if s.GetCollection(ctx, req.GetCollectionID()) == nil {
+		resp.Reason = fmt.Sprintf("collection %d not found", req.GetCollectionID())
+		return resp, nil
+	}
+
+	s.freezer.freeze(req.GetCollectionID(), req.GetPartitionID(), time.Duration(req.GetAutoUnfreezeSeconds())*time.Second, time.Now())
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// UnfreezePartition resumes segment allocations of a frozen partition, unfreezing a partition not frozen succeeds
+func (s *Server) UnfreezePartition(ctx context.Context, req *datapb.UnfreezePartitionRequest) (*commonpb.Status, error) {
+	log.Debug("receive unfreeze partition request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to unfreeze partition", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Int64("partitionID", req.GetPartitionID()), zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !s.freezer.unfreeze(req.GetCollectionID(), req.GetPartitionID(), time.Now()) {
+		log.Debug("partition to unfreeze is not frozen", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Int64("partitionID", req.GetPartitionID()))
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetSegmentPath returns the object storage paths of all binlogs of a segment, with the endpoint and bucket
+// to access them. Urls to get the binlogs are signed if Params.EnableBlobStorageAuth is set, only admins are allowed
+func (s *Server) GetSegmentPath(ctx context.Context, req *datapb.GetSegmentPathRequest) (*datapb.GetSegmentPathResponse, error) {
+	log.Debug("receive get segment path request", zap.Int64("segmentID", req.GetSegmentID()))
+	resp := &datapb.GetSegmentPathResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get segment path", zap.Int64("segmentID", req.GetSegmentID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+	// signed urls grant access to binlogs without credentials
+	if err := util.CheckAdmin(ctx, Params.AdminToken); err != nil {
+		log.Warn("failed to get segment path", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	segment := s.meta.GetSegment(req.GetSegmentID())
+	if segment == nil {
+		resp.Status.Reason = fmt.Sprintf("segment %d not found", req.GetSegmentID())
+		return resp, nil
+	}
+
+	paths := newSegmentPathResponse(segment)
+	if Params.EnableBlobStorageAuth {
+		if s.storageCli == nil {
+			resp.Status.Reason = errStorageNotInitialized.Error()
+			return resp, nil
+		}
+		ttl := time.Duration(Params.BlobStorageAuthTTLSeconds) * time.Second
+		paths.SignedUrls = make(map[string]string)
+		for _, key := range segmentPaths(paths) {
+			signed, err := s.storageCli.PresignedGetObject(ctx, Params.MinioBucketName, key, ttl, url.Values{})
+			if err != nil {
+				log.Warn("failed to sign segment path", zap.Int64("segmentID", req.GetSegmentID()),
+					zap.String("path", key), zap.Error(err))
+				resp.Status.Reason = err.Error()
+				return resp, nil
+			}
+			paths.SignedUrls[key] = signed.String()
+		}
+		paths.AccessTokenTtl = Params.BlobStorageAuthTTLSeconds
+	}
+	paths.Status = resp.Status
+	paths.Status.ErrorCode = commonpb.ErrorCode_Success
+	return paths, nil
+}
+
+// GetSegmentsForCollection returns all segments of the collection grouped by state, including dropped ones.
+// Segments are sorted by ID and returned in pages, the next page starts after the segment of the cursor
+func (s *Server) GetSegmentsForCollection(ctx context.Context, req *datapb.GetSegmentsForCollectionRequest) (*datapb.GetSegmentsForCollectionResponse, error) {
+	log.Debug("receive get segments for collection request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("cursor", req.GetCursor()), zap.Int64("limit", req.GetLimit()))
+	resp := &datapb.GetSegmentsForCollectionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get segments for collection", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	limit := req.GetLimit()
+	if limit < 0 {
+		resp.Status.Reason = fmt.Sprintf("invalid limit %d", limit)
+		return resp, nil
+	}
+	if limit == 0 {
+		limit = defaultGetFlushedSegmentsLimit
+	}
+
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == req.GetCollectionID() && segment.GetID() > req.GetCursor()
+	})
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+	if int64(len(segments)) > limit {
+		segments = segments[:limit]
+		resp.NextCursor = segments[limit-1].GetID()
+	}
+
+	buckets := make(map[commonpb.SegmentState]*datapb.SegmentsByState)
+	for _, segment := range segments {
+		bucket, ok := buckets[segment.GetState()]
+		if !ok {
+			bucket = &datapb.SegmentsByState{State: segment.GetState()}
+			buckets[segment.GetState()] = bucket
+			resp.SegmentsByState = append(resp.SegmentsByState, bucket)
+		}
+		bucket.Segments = append(bucket.Segments, segment.Clone().SegmentInfo)
+	}
+	sort.Slice(resp.SegmentsByState, func(i, j int) bool {
+		return resp.SegmentsByState[i].GetState() < resp.SegmentsByState[j].GetState()
+	})
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ReportDataNodeHealth records the vchannels of a DataNode failing to start, which are shown in its metrics
+func (s *Server) ReportDataNodeHealth(ctx context.Context, req *datapb.ReportDataNodeHealthRequest) (*commonpb.Status, error) {
+	log.Debug("receive report data node health request", zap.Int64("nodeID", req.GetNodeID()),
+		zap.Any("channelErrors", req.GetChannelErrors()), zap.Strings("healthyChannels", req.GetHealthyChannels()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to report data node health", zap.Int64("nodeID", req.GetNodeID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	for channel, reason := range req.GetChannelErrors() {
+		log.Warn("vchannel of data node failed to start", zap.Int64("nodeID", req.GetNodeID()),
+			zap.String("channel", channel), zap.String("reason", reason))
+	}
+	s.nodeHealth.report(req.GetNodeID(), req.GetChannelErrors(), req.GetHealthyChannels())
+	s.metricsCacheManager.InvalidateSystemInfoMetrics()
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetSegmentLineageDOT returns the compaction lineage of the segments of the collection as a Graphviz DOT graph
+func (s *Server) GetSegmentLineageDOT(ctx context.Context, req *datapb.GetSegmentLineageDOTRequest) (*datapb.GetSegmentLineageDOTResponse, error) {
+	log.Debug("receive get segment lineage dot request", zap.Int64("collectionID", req.GetCollectionID()))
+	resp := &datapb.GetSegmentLineageDOTResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get segment lineage dot", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	resp.Dot = segmentLineageDOT(s.meta, req.GetCollectionID())
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ReadSegment streams the rows of a flushed segment read from its binlogs for debugging, in batches of
+// at most Params.ReadSegmentBatchSize rows. Only requests carrying the admin token are served
+func (s *Server) ReadSegment(req *datapb.ReadSegmentRequest, stream datapb.DataCoord_ReadSegmentServer) error {
+	log.Debug("receive read segment request", zap.Int64("segmentID", req.GetSegmentID()),
+		zap.Int64s("fieldIDs", req.GetFieldIDs()), zap.Int64("maxRows", req.GetMaxRows()))
+	failed := func(reason string) error {
+		return stream.Send(&datapb.ReadSegmentResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    reason,
+			},
+		})
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to read segment", zap.Int64("segmentID", req.GetSegmentID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		return failed(msgDataCoordIsUnhealthy(Params.NodeID))
+	}
+	ctx := stream.Context()
+	if err := util.CheckAdmin(ctx, Params.AdminToken); err != nil {
+		log.Warn("failed to read segment", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		return failed(err.Error())
+	}
+	if s.storageCli == nil {
+		return failed(errStorageNotInitialized.Error())
+	}
+	segment := s.meta.GetSegment(req.GetSegmentID())
+	if segment == nil {
+		return failed(fmt.Sprintf("segment %d not found", req.GetSegmentID()))
+	}
+	if segment.GetState() != commonpb.SegmentState_Flushed {
+		return failed(fmt.Sprintf("segment %d is %s, only flushed segments can be read", req.GetSegmentID(), segment.GetState()))
+	}
+	coll := s.GetCollection(ctx, segment.GetCollectionID())
+	if coll == nil {
+		return failed(fmt.Sprintf("collection %d not found", segment.GetCollectionID()))
+	}
+
+	var sent bool
+	err := readSegmentRows(ctx, segment, coll.GetSchema(), req.GetFieldIDs(), Params.ReadSegmentBatchSize, req.GetMaxRows(),
+		s.getObject, func(fieldsData []*schemapb.FieldData, numRows int64) error {
+			sent = true
+			return stream.Send(&datapb.ReadSegmentResponse{
+				Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				FieldsData: fieldsData,
+				NumRows:    numRows,
+			})
+		})
+	if err != nil {
+		log.Warn("failed to read segment", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		return failed(err.Error())
+	}
+	if !sent {
+		// an empty segment or no field selected
+		return stream.Send(&datapb.ReadSegmentResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		})
+	}
+	return nil
+}
+
+// DiscardSegment drops an empty growing segment allocated ahead of time by a DataNode and never used,
+// discarding a segment not found succeeds
+func (s *Server) DiscardSegment(ctx context.Context, req *datapb.DiscardSegmentRequest) (*commonpb.Status, error) {
+	log.Debug("receive discard segment request", zap.Int64("segmentID", req.GetSegmentID()),
+		zap.String("channel", req.GetChannelName()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to discard segment", zap.Int64("segmentID", req.GetSegmentID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+	segment := s.meta.GetSegment(req.GetSegmentID())
+	if segment == nil {
+		resp.ErrorCode = commonpb.ErrorCode_Success
+		return resp, nil
+	}
+	if segment.GetInsertChannel() != req.GetChannelName() {
+		resp.Reason = fmt.Sprintf("segment %d is not of channel %s", req.GetSegmentID(), req.GetChannelName())
+		return resp, nil
+	}
+	// rows may be buffered by the DataNode or in flight even if none is flushed, the segment is dropped only if
+	// nobody else is given an allocation of it, otherwise its allocation expires as usual
+	dropped, err := s.segmentManager.DiscardSegment(ctx, req.GetSegmentID())
+	if err != nil {
+		log.Warn("failed to discard segment", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	if !dropped {
+		resp.Reason = fmt.Sprintf("segment %d is in use, state %s, %d rows", req.GetSegmentID(), segment.GetState(), segment.GetNumOfRows())
+		return resp, nil
+	}
+	log.Info("success to discard segment", zap.Int64("segmentID", req.GetSegmentID()), zap.String("channel", req.GetChannelName()))
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetChannelSegmentStats returns the compaction related statistics of segments of a vchannel,
+// which are used to schedule compactions per channel
+func (s *Server) GetChannelSegmentStats(ctx context.Context, req *datapb.GetChannelSegmentStatsRequest) (*datapb.GetChannelSegmentStatsResponse, error) {
+	log.Debug("receive get channel segment stats request", zap.String("channel", req.GetChannelName()))
+	resp := &datapb.GetChannelSegmentStatsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get channel segment stats", zap.String("channel", req.GetChannelName()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Status.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	tt, err := getTimetravelReverseTime(ctx, s.allocator)
+	if err != nil {
+		log.Warn("failed to get timetravel reverse time", zap.String("channel", req.GetChannelName()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	stats := s.compactionTrigger.getChannelSegmentStats(req.GetChannelName(), tt, time.Now(), int(req.GetTopN()))
+	stats.Status = resp.Status
+	resp = stats
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetFlushProgress returns the flush progress of the segments of a collection, e.g. the segments returned by Flush
+func (s *Server) GetFlushProgress(ctx context.Context, req *datapb.GetFlushProgressRequest) (*datapb.FlushProgressResponse, error) {
+	log.Debug("receive get flush progress request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	resp := &datapb.FlushProgressResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get flush progress", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	progress, segments, err := getFlushProgress(s.meta, req.GetCollectionID(), req.GetSegmentIDs())
+	if err != nil {
+		log.Warn("failed to get flush progress", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	resp.Progress = progress
+	resp.Segments = segments
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// CancelFlush reopens the segments sealed by Flush unless they start flushing,
+// ErrorCode_SegmentFlushStarted is returned if any of them is flushing or flushed, and no segment is reopened
+func (s *Server) CancelFlush(ctx context.Context, req *datapb.CancelFlushRequest) (*datapb.CancelFlushResponse, error) {
+	log.Debug("receive cancel flush request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	resp := &datapb.CancelFlushResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to cancel flush", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	segmentIDs, err := s.segmentManager.CancelFlush(ctx, req.GetCollectionID(), req.GetSegmentIDs())
+	if err != nil {
+		log.Warn("failed to cancel flush", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		var started *segmentFlushStartedError
+		if errors.As(err, &started) {
+			resp.Status.ErrorCode = commonpb.ErrorCode_SegmentFlushStarted
+		}
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	log.Debug("flush cancelled", zap.Int64("collectionID", req.GetCollectionID()), zap.Int64s("segmentIDs", segmentIDs))
+	resp.SegmentIDs = segmentIDs
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// PauseCompaction stops dispatching new compaction plans until ResumeCompaction is called,
+// compaction plans executing are not aborted, and ManualCompaction fails while paused
+func (s *Server) PauseCompaction(ctx context.Context, req *datapb.PauseCompactionRequest) (*commonpb.Status, error) {
+	log.Debug("receive pause compaction request", zap.String("reason", req.GetReason()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to pause compaction", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	s.compactionTrigger.pause(req.GetReason())
+	log.Info("compaction paused", zap.String("reason", req.GetReason()))
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ResumeCompaction dispatches compaction plans again after PauseCompaction, resuming compaction not paused succeeds
+func (s *Server) ResumeCompaction(ctx context.Context, req *datapb.ResumeCompactionRequest) (*commonpb.Status, error) {
+	log.Debug("receive resume compaction request")
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to resume compaction", zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	_, reason := s.compactionTrigger.isPaused()
+	if s.compactionTrigger.resume() {
+		log.Info("compaction resumed", zap.String("pauseReason", reason))
+	} else {
+		log.Info("compaction is not paused, nothing to resume")
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
 	return resp, nil
 }
@@ -30,7 +30,9 @@ import (
 )
 
 const (
-	flushTimeout = 5 * time.Second
+	flushTimeout            = 5 * time.Second
+	sampleSegmentTimeout    = 60 * time.Second
+	cancelCompactionTimeout = 10 * time.Second
 )
 
 // SessionManager provides the grpc interfaces of cluster
@@ -147,6 +149,42 @@ func (c *SessionManager) execCompaction(nodeID int64, plan *datapb.CompactionPla
 	log.Debug("success to execute compaction", zap.Int64("node", nodeID), zap.Any("planID", plan.GetPlanID()))
 }
 
+// SampleSegment is a grpc interface. It samples the segment in nodeID synchronously
+func (c *SessionManager) SampleSegment(ctx context.Context, nodeID int64, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, sampleSegmentTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return nil, err
+	}
+
+	resp, err := cli.SampleSegment(ctx, req)
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to sample segment", zap.Int64("node", nodeID), zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CancelCompaction is a grpc interface. It stops the compaction plan executing in nodeID synchronously
+func (c *SessionManager) CancelCompaction(ctx context.Context, nodeID int64, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, cancelCompactionTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return nil, err
+	}
+
+	resp, err := cli.CancelCompaction(ctx, req)
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to cancel compaction", zap.Int64("node", nodeID), zap.Int64("planID", req.GetPlanID()), zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *SessionManager) getClient(ctx context.Context, nodeID int64) (types.DataNode, error) {
 	c.sessions.RLock()
 	session, ok := c.sessions.data[nodeID]
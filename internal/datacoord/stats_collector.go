@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"go.uber.org/zap"
+)
+
+// binlogCountStatsKey is the key of binlog file count in the response of GetCollectionStatistics
+const binlogCountStatsKey = "binlog_count"
+
+type collectionStatsFunc func(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)
+
+// binlogCountSample is the binlog file count of a collection sampled at ts
+type binlogCountSample struct {
+	ts    time.Time
+	count int64
+}
+
+// TimeSeriesCollector samples the collection statistics every Params.StatsCollectionIntervalSeconds,
+// and estimates the growth rate of binlog file count per collection over a sliding window of
+// Params.BinlogGrowthRateWindowSeconds. A WARN log is emitted if the rate exceeds Params.BinlogGrowthRateAlertThreshold
+type TimeSeriesCollector struct {
+	mu              sync.RWMutex
+	listCollections func() []UniqueID
+	getStats        collectionStatsFunc
+	series          map[UniqueID][]binlogCountSample
+	rates           map[UniqueID]float64 // files added per minute
+
+	quit      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func newTimeSeriesCollector(listCollections func() []UniqueID, getStats collectionStatsFunc) *TimeSeriesCollector {
+	return &TimeSeriesCollector{
+		listCollections: listCollections,
+		getStats:        getStats,
+		series:          make(map[UniqueID][]binlogCountSample),
+		rates:           make(map[UniqueID]float64),
+		quit:            make(chan struct{}),
+	}
+}
+
+// getGrowthRate returns the binlog files added per minute of the collection
+func (c *TimeSeriesCollector) getGrowthRate(collectionID UniqueID) (float64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	rate, ok := c.rates[collectionID]
+	return rate, ok
+}
+
+// collect samples the binlog file count of all collections at now
+func (c *TimeSeriesCollector) collect(ctx context.Context, now time.Time) {
+	collectionIDs := c.listCollections()
+	listed := make(map[UniqueID]struct{}, len(collectionIDs))
+	counts := make(map[UniqueID]int64, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		listed[collectionID] = struct{}{}
+		count, err := c.getBinlogCount(ctx, collectionID)
+		if err != nil {
+			log.Warn("failed to get binlog count of collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+			continue
+		}
+		counts[collectionID] = count
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the series of collections no longer managed are discarded
+	for collectionID := range c.series {
+		if _, ok := listed[collectionID]; !ok {
+			delete(c.series, collectionID)
+			delete(c.rates, collectionID)
+			metrics.DataCoordBinlogGrowthRate.DeleteLabelValues(strconv.FormatInt(collectionID, 10))
+		}
+	}
+	window := time.Duration(Params.BinlogGrowthRateWindowSeconds) * time.Second
+	for collectionID, count := range counts {
+		samples := append(c.series[collectionID], binlogCountSample{ts: now, count: count})
+		// drop the samples out of the window, the oldest one is kept as the base of the rate
+		for len(samples) > 2 && now.Sub(samples[1].ts) >= window {
+			samples = samples[1:]
+		}
+		c.series[collectionID] = samples
+
+		oldest := samples[0]
+		var rate float64
+		if elapsed := now.Sub(oldest.ts); elapsed > 0 {
+			rate = float64(count-oldest.count) / elapsed.Minutes()
+		}
+		c.rates[collectionID] = rate
+		metrics.DataCoordBinlogGrowthRate.WithLabelValues(strconv.FormatInt(collectionID, 10)).Set(rate)
+
+		if Params.BinlogGrowthRateAlertThreshold > 0 && rate > Params.BinlogGrowthRateAlertThreshold {
+			log.Warn("binlog file count of collection grows too fast",
+				zap.Int64("collectionID", collectionID),
+				zap.Int64("currentCount", count),
+				zap.Int64("historicalCount", oldest.count),
+				zap.Time("historicalTime", oldest.ts),
+				zap.Float64("filesPerMinute", rate),
+				zap.Float64("threshold", Params.BinlogGrowthRateAlertThreshold))
+		}
+	}
+}
+
+func (c *TimeSeriesCollector) getBinlogCount(ctx context.Context, collectionID UniqueID) (int64, error) {
+	resp, err := c.getStats(ctx, &datapb.GetCollectionStatisticsRequest{CollectionID: collectionID})
+	if err != nil {
+		return 0, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return 0, errors.New(resp.GetStatus().GetReason())
+	}
+	for _, kv := range resp.GetStats() {
+		if kv.GetKey() == binlogCountStatsKey {
+			return strconv.ParseInt(kv.GetValue(), 10, 64)
+		}
+	}
+	return 0, fmt.Errorf("%s not found in collection statistics", binlogCountStatsKey)
+}
+
+func (c *TimeSeriesCollector) start() {
+	c.wg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer c.wg.Done()
+		interval := time.Duration(Params.StatsCollectionIntervalSeconds) * time.Second
+		if interval <= 0 {
+			interval = time.Second
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.quit:
+				log.Info("time series collector exit")
+				return
+			case now := <-ticker.C:
+				c.collect(context.Background(), now)
+			}
+		}
+	}()
+}
+
+func (c *TimeSeriesCollector) close() {
+	c.closeOnce.Do(func() {
+		close(c.quit)
+	})
+	c.wg.Wait()
+}
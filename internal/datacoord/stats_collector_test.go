@@ -0,0 +1,139 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockBinlogCounts struct {
+	counts map[UniqueID]int64
+	err    error
+}
+
+func (m *mockBinlogCounts) list() []UniqueID {
+	ret := make([]UniqueID, 0, len(m.counts))
+	for id := range m.counts {
+		ret = append(ret, id)
+	}
+	return ret
+}
+
+func (m *mockBinlogCounts) getStats(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &datapb.GetCollectionStatisticsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Stats: []*commonpb.KeyValuePair{
+			{Key: "row_count", Value: "0"},
+			{Key: binlogCountStatsKey, Value: strconv.FormatInt(m.counts[req.GetCollectionID()], 10)},
+		},
+	}, nil
+}
+
+func TestTimeSeriesCollector_collect(t *testing.T) {
+	Params.Init()
+	defer func(window int64, threshold float64) {
+		Params.BinlogGrowthRateWindowSeconds = window
+		Params.BinlogGrowthRateAlertThreshold = threshold
+	}(Params.BinlogGrowthRateWindowSeconds, Params.BinlogGrowthRateAlertThreshold)
+	Params.BinlogGrowthRateWindowSeconds = 120
+	Params.BinlogGrowthRateAlertThreshold = 50
+
+	mock := &mockBinlogCounts{counts: map[UniqueID]int64{1: 0, 2: 10}}
+	c := newTimeSeriesCollector(mock.list, mock.getStats)
+	ctx := context.Background()
+	start := time.Now()
+
+	// a single sample has no growth
+	c.collect(ctx, start)
+	rate, ok := c.getGrowthRate(1)
+	assert.True(t, ok)
+	assert.Equal(t, float64(0), rate)
+
+	mock.counts[1] = 60
+	c.collect(ctx, start.Add(time.Minute))
+	rate, _ = c.getGrowthRate(1)
+	assert.Equal(t, float64(60), rate)
+	rate, _ = c.getGrowthRate(2)
+	assert.Equal(t, float64(0), rate)
+
+	mock.counts[1] = 120
+	mock.counts[2] = 40
+	c.collect(ctx, start.Add(2*time.Minute))
+	rate, _ = c.getGrowthRate(1)
+	assert.Equal(t, float64(60), rate)
+	rate, _ = c.getGrowthRate(2)
+	assert.Equal(t, float64(15), rate)
+
+	// the first sample slides out of the window
+	mock.counts[1] = 130
+	c.collect(ctx, start.Add(3*time.Minute))
+	rate, _ = c.getGrowthRate(1)
+	assert.Equal(t, float64(35), rate)
+	assert.Equal(t, 3, len(c.series[1]))
+
+	// series are kept on failures
+	mock.err = errors.New("mocked error")
+	c.collect(ctx, start.Add(4*time.Minute))
+	rate, _ = c.getGrowthRate(1)
+	assert.Equal(t, float64(35), rate)
+	mock.err = nil
+
+	// series of collections not listed are discarded
+	delete(mock.counts, 2)
+	c.collect(ctx, start.Add(5*time.Minute))
+	_, ok = c.getGrowthRate(2)
+	assert.False(t, ok)
+	_, ok = c.getGrowthRate(1)
+	assert.True(t, ok)
+}
+
+func TestTimeSeriesCollector_getBinlogCount(t *testing.T) {
+	c := newTimeSeriesCollector(nil, func(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
+		return &datapb.GetCollectionStatisticsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: serverNotServingErrMsg},
+		}, nil
+	})
+	_, err := c.getBinlogCount(context.Background(), 1)
+	assert.EqualError(t, err, serverNotServingErrMsg)
+
+	c.getStats = func(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
+		return &datapb.GetCollectionStatisticsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		}, nil
+	}
+	_, err = c.getBinlogCount(context.Background(), 1)
+	assert.Error(t, err)
+}
+
+func TestTimeSeriesCollector_startClose(t *testing.T) {
+	mock := &mockBinlogCounts{counts: map[UniqueID]int64{}}
+	c := newTimeSeriesCollector(mock.list, mock.getStats)
+	c.start()
+	c.close()
+	c.close()
+}
@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/minio/minio-go/v7"
+	"go.uber.org/zap"
+)
+
+// storageAuditSubPaths are the paths of binlogs under the root path of object storage
+var storageAuditSubPaths = []string{"insert_log", "stats_log", "delta_log"}
+
+// maxStorageAuditOrphansReported limits the orphans listed in a report, all of them are counted though
+const maxStorageAuditOrphansReported = 10000
+
+// storageAuditor cross-references the binlog objects in object storage against the binlog paths in meta
+type storageAuditor struct {
+	meta         *meta
+	listObjects  func(ctx context.Context, prefix string) <-chan minio.ObjectInfo
+	removeObject func(ctx context.Context, key string) error
+	rootPath     string
+	tempPrefix   string        // prefix of the temporary binlogs of flushes, empty means not listed
+	listRate     float64       // objects listed per second, non-positive value means unlimited
+	tolerance    time.Duration // orphans modified within it are not removed, they may be binlogs of a flush in progress
+	events       *gcEventLog   // records the removed orphans, nil means not recorded
+}
+
+func newStorageAuditor(meta *meta, cli *minio.Client, bucketName, rootPath string, events *gcEventLog) *storageAuditor {
+	return &storageAuditor{
+		meta: meta,
+		listObjects: func(ctx context.Context, prefix string) <-chan minio.ObjectInfo {
+			return cli.ListObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+		},
+		removeObject: func(ctx context.Context, key string) error {
+			return cli.RemoveObject(ctx, bucketName, key, minio.RemoveObjectOptions{})
+		},
+		rootPath:   rootPath,
+		tempPrefix: Params.BinlogTempPathPrefix,
+		listRate:   float64(Params.StorageAuditListRatePerSec),
+		tolerance:  defaultMissingTolerance,
+		events:     events,
+	}
+}
+
+// audit lists the binlog objects and reports the ones referenced by no segment in meta.
+// Orphans older than the tolerance are removed unless dryRun is set
+func (a *storageAuditor) audit(ctx context.Context, dryRun bool) (*datapb.StorageAuditReport, error) {
+	// binlogs of dropped segments are left to garbage collection
+	valid, dropped, _ := a.meta.ListSegmentFiles()
+	referenced := make(map[string]struct{}, len(valid)+len(dropped))
+	for _, k := range valid {
+		referenced[k] = struct{}{}
+	}
+	for _, k := range dropped {
+		referenced[k] = struct{}{}
+	}
+
+	// the trailing slash prevents matching the paths sharing the prefix
+	prefixes := make([]string, 0, len(storageAuditSubPaths)+1)
+	for _, sub := range storageAuditSubPaths {
+		prefixes = append(prefixes, path.Join(a.rootPath, sub)+"/")
+	}
+	// temporary binlogs are never referenced, the ones older than the tolerance are left by failed flushes
+	if a.tempPrefix != "" {
+		prefixes = append(prefixes, path.Clean(a.tempPrefix)+"/")
+	}
+
+	report := &datapb.StorageAuditReport{}
+	start := time.Now()
+	for _, prefix := range prefixes {
+		for info := range a.listObjects(ctx, prefix) {
+			if info.Err != nil {
+				return nil, info.Err
+			}
+			report.ScannedObjects++
+			if err := a.pace(ctx, start, report.ScannedObjects); err != nil {
+				return nil, err
+			}
+			if _, ok := referenced[info.Key]; ok {
+				continue
+			}
+			report.OrphanedObjects++
+			report.OrphanedSize += info.Size
+			if len(report.Orphans) < maxStorageAuditOrphansReported {
+				report.Orphans = append(report.Orphans, &datapb.StorageObject{Key: info.Key, Size: info.Size})
+			}
+			if dryRun || time.Since(info.LastModified) <= a.tolerance {
+				continue
+			}
+			if err := a.removeObject(ctx, info.Key); err != nil {
+				log.Warn("failed to remove orphaned binlog", zap.String("key", info.Key), zap.Error(err))
+				continue
+			}
+			report.RemovedObjects++
+			report.RemovedSize += info.Size
+			a.events.record(info.Key, info.Size, gcReasonOrphaned)
+		}
+	}
+	return report, nil
+}
+
+// pace blocks until listing the objects doesn't exceed the list rate since start
+func (a *storageAuditor) pace(ctx context.Context, start time.Time, listed int64) error {
+	if a.listRate <= 0 {
+		return nil
+	}
+	wait := time.Until(start.Add(time.Duration(float64(listed) / a.listRate * float64(time.Second))))
+	if wait <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
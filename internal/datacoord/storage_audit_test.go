@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStorageAuditor(t *testing.T, objects []minio.ObjectInfo) (*storageAuditor, *[]string) {
+	meta, err := newMemoryMeta(newMockAllocator())
+	require.NoError(t, err)
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:        1,
+		State:     commonpb.SegmentState_Flushed,
+		Binlogs:   []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"files/insert_log/1/1/1/1/1"}}},
+		Statslogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"files/stats_log/1/1/1/1/1"}}},
+	})))
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:        2,
+		State:     commonpb.SegmentState_Dropped,
+		Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "files/delta_log/1/1/2/1"}},
+	})))
+
+	removed := []string{}
+	return &storageAuditor{
+		meta: meta,
+		listObjects: func(ctx context.Context, prefix string) <-chan minio.ObjectInfo {
+			ch := make(chan minio.ObjectInfo, len(objects))
+			for _, obj := range objects {
+				if strings.HasPrefix(obj.Key, prefix) {
+					ch <- obj
+				}
+			}
+			close(ch)
+			return ch
+		},
+		removeObject: func(ctx context.Context, key string) error {
+			removed = append(removed, key)
+			return nil
+		},
+		rootPath:  "files",
+		tolerance: time.Hour,
+	}, &removed
+}
+
+func TestStorageAuditor_audit(t *testing.T) {
+	old := time.Now().Add(-2 * time.Hour)
+	objects := []minio.ObjectInfo{
+		{Key: "files/insert_log/1/1/1/1/1", Size: 10, LastModified: old},
+		{Key: "files/insert_log/1/1/3/1/1", Size: 20, LastModified: old},
+		{Key: "files/insert_log/1/1/4/1/1", Size: 30, LastModified: time.Now()},
+		{Key: "files/stats_log/1/1/1/1/1", Size: 10, LastModified: old},
+		{Key: "files/delta_log/1/1/2/1", Size: 10, LastModified: old},
+		{Key: "files/delta_log/1/1/2/1.idx", Size: 10, LastModified: old},
+		{Key: "files/delta_log/1/1/3/1", Size: 40, LastModified: old},
+		// not a binlog
+		{Key: "files/insert_log_backup/1", Size: 50, LastModified: old},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		auditor, removed := newTestStorageAuditor(t, objects)
+		report, err := auditor.audit(context.TODO(), true)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 7, report.GetScannedObjects())
+		assert.EqualValues(t, 3, report.GetOrphanedObjects())
+		assert.EqualValues(t, 90, report.GetOrphanedSize())
+		assert.ElementsMatch(t, []*datapb.StorageObject{
+			{Key: "files/insert_log/1/1/3/1/1", Size: 20},
+			{Key: "files/insert_log/1/1/4/1/1", Size: 30},
+			{Key: "files/delta_log/1/1/3/1", Size: 40},
+		}, report.GetOrphans())
+		assert.EqualValues(t, 0, report.GetRemovedObjects())
+		assert.Empty(t, *removed)
+	})
+
+	t.Run("remove orphans", func(t *testing.T) {
+		auditor, removed := newTestStorageAuditor(t, objects)
+		report, err := auditor.audit(context.TODO(), false)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 3, report.GetOrphanedObjects())
+		// the orphan modified within the tolerance is kept
+		assert.EqualValues(t, 2, report.GetRemovedObjects())
+		assert.EqualValues(t, 60, report.GetRemovedSize())
+		assert.ElementsMatch(t, []string{"files/insert_log/1/1/3/1/1", "files/delta_log/1/1/3/1"}, *removed)
+	})
+
+	t.Run("temporary binlogs", func(t *testing.T) {
+		auditor, removed := newTestStorageAuditor(t, append(objects,
+			minio.ObjectInfo{Key: "tmp/files/insert_log/1/1/1/1/1", Size: 10, LastModified: old},
+			minio.ObjectInfo{Key: "tmp/files/stats_log/1/1/5/1/1", Size: 10, LastModified: time.Now()},
+		))
+		auditor.tempPrefix = "tmp"
+		report, err := auditor.audit(context.TODO(), false)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 9, report.GetScannedObjects())
+		// temporary binlogs are orphans even if their final paths are referenced
+		assert.EqualValues(t, 5, report.GetOrphanedObjects())
+		assert.EqualValues(t, 3, report.GetRemovedObjects())
+		assert.ElementsMatch(t, []string{"files/insert_log/1/1/3/1/1", "files/delta_log/1/1/3/1", "tmp/files/insert_log/1/1/1/1/1"}, *removed)
+	})
+
+	t.Run("list fails", func(t *testing.T) {
+		auditor, _ := newTestStorageAuditor(t, nil)
+		auditor.listObjects = func(ctx context.Context, prefix string) <-chan minio.ObjectInfo {
+			ch := make(chan minio.ObjectInfo, 1)
+			ch <- minio.ObjectInfo{Err: errors.New("mock error")}
+			close(ch)
+			return ch
+		}
+		_, err := auditor.audit(context.TODO(), true)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("listing is rate limited", func(t *testing.T) {
+		auditor, _ := newTestStorageAuditor(t, objects)
+		auditor.listRate = 50
+		start := time.Now()
+		_, err := auditor.audit(context.TODO(), true)
+		assert.Nil(t, err)
+		assert.GreaterOrEqual(t, int64(time.Since(start)), int64(7*time.Second/50))
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		_, err = auditor.audit(ctx, true)
+		assert.Equal(t, context.Canceled, err)
+	})
+}
@@ -0,0 +1,220 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	insertLogType = "insert"
+	statsLogType  = "stats"
+	deltaLogType  = "delta"
+)
+
+// storageUsageLogTypes is the order of log types in a field usage
+var storageUsageLogTypes = []string{insertLogType, statsLogType, deltaLogType}
+
+// collectionStorageUsage accumulates the usage of a collection, field id => log type => usage
+type collectionStorageUsage map[UniqueID]map[string]*datapb.LogStorageUsage
+
+func (c collectionStorageUsage) add(fieldID UniqueID, logType string, numLogs, size int64) {
+	if numLogs == 0 {
+		return
+	}
+	logs, ok := c[fieldID]
+	if !ok {
+		logs = make(map[string]*datapb.LogStorageUsage)
+		c[fieldID] = logs
+	}
+	usage, ok := logs[logType]
+	if !ok {
+		usage = &datapb.LogStorageUsage{LogType: logType}
+		logs[logType] = usage
+	}
+	usage.NumLogs += numLogs
+	usage.Size += size
+}
+
+// fieldSizesPerRow returns the estimated bytes of a row of each field, and the primary key field of the schema
+func fieldSizesPerRow(schema *schemapb.CollectionSchema) (map[UniqueID]int64, UniqueID) {
+	sizes := map[UniqueID]int64{
+		common.RowIDField:     8,
+		common.TimeStampField: 8,
+	}
+	var pk UniqueID
+	for _, field := range schema.GetFields() {
+		if field.GetIsPrimaryKey() {
+			pk = field.GetFieldID()
+		}
+		size, err := typeutil.EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+		if err != nil {
+			continue
+		}
+		sizes[field.GetFieldID()] = int64(size)
+	}
+	return sizes, pk
+}
+
+// estimateSegmentBytes estimates the size of the insert and delta logs of the segment from meta, sizes of insert logs
+// are estimated by rows and field schema since they're not recorded in meta. 0 is returned for nil segment
+func estimateSegmentBytes(m *meta, segment *SegmentInfo) int64 {
+	if segment == nil {
+		return 0
+	}
+	sizes, _ := fieldSizesPerRow(m.GetCollection(segment.GetCollectionID()).GetSchema())
+	var size int64
+	for _, binlog := range segment.GetBinlogs() {
+		size += segment.GetNumOfRows() * sizes[binlog.GetFieldID()]
+	}
+	for _, deltalog := range segment.GetDeltalogs() {
+		size += deltalog.GetDeltaLogSize()
+	}
+	return size
+}
+
+// getStorageUsage sums the binlogs of healthy segments recorded in meta grouped by collection, field and log type,
+// no object is read from storage. collectionID 0 means all collections
+func getStorageUsage(m *meta, collectionID UniqueID) *datapb.GetStorageUsageResponse {
+	segments := m.SelectSegments(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) && (collectionID == 0 || segment.GetCollectionID() == collectionID)
+	})
+
+	usages := make(map[UniqueID]collectionStorageUsage)
+	type schemaInfo struct {
+		sizes map[UniqueID]int64
+		pk    UniqueID
+	}
+	schemas := make(map[UniqueID]*schemaInfo)
+	for _, segment := range segments {
+		collID := segment.GetCollectionID()
+		info, ok := schemas[collID]
+		if !ok {
+			sizes, pk := fieldSizesPerRow(m.GetCollection(collID).GetSchema())
+			info = &schemaInfo{sizes: sizes, pk: pk}
+			schemas[collID] = info
+		}
+		usage, ok := usages[collID]
+		if !ok {
+			usage = make(collectionStorageUsage)
+			usages[collID] = usage
+		}
+
+		for _, binlog := range segment.GetBinlogs() {
+			numLogs := int64(len(binlog.GetBinlogs()))
+			usage.add(binlog.GetFieldID(), insertLogType, numLogs, segment.GetNumOfRows()*info.sizes[binlog.GetFieldID()])
+		}
+		for _, statslog := range segment.GetStatslogs() {
+			usage.add(statslog.GetFieldID(), statsLogType, int64(len(statslog.GetBinlogs())), 0)
+		}
+		for _, deltalog := range segment.GetDeltalogs() {
+			usage.add(info.pk, deltaLogType, 1, deltalog.GetDeltaLogSize())
+		}
+	}
+
+	resp := &datapb.GetStorageUsageResponse{}
+	for collID, usage := range usages {
+		coll := &datapb.CollectionStorageUsage{CollectionID: collID}
+		for fieldID, logs := range usage {
+			field := &datapb.FieldStorageUsage{FieldID: fieldID}
+			for _, logType := range storageUsageLogTypes {
+				if l, ok := logs[logType]; ok {
+					field.Logs = append(field.Logs, l)
+					field.Size += l.GetSize()
+				}
+			}
+			coll.Fields = append(coll.Fields, field)
+			coll.Size += field.GetSize()
+		}
+		sort.Slice(coll.Fields, func(i, j int) bool { return coll.Fields[i].GetFieldID() < coll.Fields[j].GetFieldID() })
+		resp.Collections = append(resp.Collections, coll)
+		resp.TotalSize += coll.GetSize()
+	}
+	sort.Slice(resp.Collections, func(i, j int) bool {
+		ci, cj := resp.Collections[i], resp.Collections[j]
+		if ci.GetSize() != cj.GetSize() {
+			return ci.GetSize() > cj.GetSize()
+		}
+		return ci.GetCollectionID() < cj.GetCollectionID()
+	})
+	return resp
+}
+
+// updateStorageUsageMetrics exposes the usage of the top n collections, the usage must cover all collections
+func updateStorageUsageMetrics(resp *datapb.GetStorageUsageResponse, n int) {
+	metrics.DataCoordCollectionStorageUsage.Reset()
+	for i, coll := range resp.GetCollections() {
+		if i >= n {
+			break
+		}
+		metrics.DataCoordCollectionStorageUsage.WithLabelValues(strconv.FormatInt(coll.GetCollectionID(), 10)).Set(float64(coll.GetSize()))
+	}
+}
+
+type storageUsageCacheEntry struct {
+	resp     *datapb.GetStorageUsageResponse
+	expireAt time.Time
+}
+
+// storageUsageCache keeps the storage usage for a ttl, so that frequent queries don't scan all segments
+type storageUsageCache struct {
+	mu      sync.Mutex
+	entries map[UniqueID]*storageUsageCacheEntry // collection id filter => usage
+}
+
+func newStorageUsageCache() *storageUsageCache {
+	return &storageUsageCache{
+		entries: make(map[UniqueID]*storageUsageCacheEntry),
+	}
+}
+
+// get returns the cached usage of the collection filter, nil if not cached or expired
+func (c *storageUsageCache) get(collectionID UniqueID, now time.Time) *datapb.GetStorageUsageResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[collectionID]
+	if !ok || !now.Before(entry.expireAt) {
+		return nil
+	}
+	return entry.resp
+}
+
+// put caches the usage for ttl, expired entries are evicted meanwhile
+func (c *storageUsageCache) put(collectionID UniqueID, resp *datapb.GetStorageUsageResponse, now time.Time, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		if !now.Before(entry.expireAt) {
+			delete(c.entries, key)
+		}
+	}
+	if ttl <= 0 {
+		return
+	}
+	c.entries[collectionID] = &storageUsageCacheEntry{resp: resp, expireAt: now.Add(ttl)}
+}
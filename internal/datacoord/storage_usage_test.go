@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStorageUsageMeta(t *testing.T) *meta {
+	meta, err := newMemoryMeta(nil)
+	assert.Nil(t, err)
+	meta.AddCollection(&datapb.CollectionInfo{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}}},
+			},
+		},
+	})
+	segments := []*datapb.SegmentInfo{
+		{
+			ID: 1, CollectionID: 1, NumOfRows: 10, State: commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 0, Binlogs: []string{"a"}},
+				{FieldID: 100, Binlogs: []string{"b"}},
+				{FieldID: 101, Binlogs: []string{"c", "d"}},
+			},
+			Statslogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"e"}}},
+			Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "f", DeltaLogSize: 30}, {DeltaLogPath: "g", DeltaLogSize: 20}},
+		},
+		{
+			ID: 2, CollectionID: 1, NumOfRows: 5, State: commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []string{"h"}}},
+		},
+		// dropped segments are not counted
+		{
+			ID: 3, CollectionID: 1, NumOfRows: 100, State: commonpb.SegmentState_Dropped,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []string{"i"}}},
+		},
+		// collection without schema
+		{
+			ID: 4, CollectionID: 2, NumOfRows: 1, State: commonpb.SegmentState_Flushed,
+			Binlogs:   []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"j"}}},
+			Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "k", DeltaLogSize: 7}},
+		},
+	}
+	for _, segment := range segments {
+		assert.Nil(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+	return meta
+}
+
+func TestGetStorageUsage(t *testing.T) {
+	meta := newStorageUsageMeta(t)
+
+	resp := getStorageUsage(meta, 0)
+	assert.EqualValues(t, 80+80+240+50+8+7, resp.GetTotalSize())
+	assert.Equal(t, []*datapb.CollectionStorageUsage{
+		{
+			CollectionID: 1,
+			Size:         80 + 80 + 240 + 50,
+			Fields: []*datapb.FieldStorageUsage{
+				{FieldID: 0, Size: 80, Logs: []*datapb.LogStorageUsage{{LogType: "insert", NumLogs: 1, Size: 80}}},
+				{FieldID: 100, Size: 80 + 50, Logs: []*datapb.LogStorageUsage{
+					{LogType: "insert", NumLogs: 1, Size: 80},
+					{LogType: "stats", NumLogs: 1},
+					{LogType: "delta", NumLogs: 2, Size: 50},
+				}},
+				{FieldID: 101, Size: 240, Logs: []*datapb.LogStorageUsage{{LogType: "insert", NumLogs: 3, Size: 160 + 80}}},
+			},
+		},
+		{
+			CollectionID: 2,
+			Size:         15,
+			Fields: []*datapb.FieldStorageUsage{
+				{FieldID: 0, Size: 7, Logs: []*datapb.LogStorageUsage{{LogType: "delta", NumLogs: 1, Size: 7}}},
+				{FieldID: 1, Size: 8, Logs: []*datapb.LogStorageUsage{{LogType: "insert", NumLogs: 1, Size: 8}}},
+			},
+		},
+	}, resp.GetCollections())
+
+	resp = getStorageUsage(meta, 2)
+	assert.EqualValues(t, 15, resp.GetTotalSize())
+	assert.Equal(t, 1, len(resp.GetCollections()))
+
+	updateStorageUsageMetrics(getStorageUsage(meta, 0), 1)
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.DataCoordCollectionStorageUsage))
+	assert.EqualValues(t, 450, testutil.ToFloat64(metrics.DataCoordCollectionStorageUsage.WithLabelValues("1")))
+}
+
+func TestStorageUsageCache(t *testing.T) {
+	c := newStorageUsageCache()
+	now := time.Now()
+	resp := &datapb.GetStorageUsageResponse{TotalSize: 1}
+	assert.Nil(t, c.get(0, now))
+
+	c.put(0, resp, now, time.Minute)
+	assert.Same(t, resp, c.get(0, now.Add(time.Second)))
+	assert.Nil(t, c.get(1, now))
+	assert.Nil(t, c.get(0, now.Add(time.Minute)))
+
+	// expired entries are evicted
+	c.put(1, &datapb.GetStorageUsageResponse{}, now.Add(2*time.Minute), time.Minute)
+	assert.Equal(t, 1, len(c.entries))
+
+	// not cached without ttl
+	c.put(2, &datapb.GetStorageUsageResponse{}, now, 0)
+	assert.Nil(t, c.get(2, now))
+}
+
+func TestEstimateSegmentBytes(t *testing.T) {
+	meta := newStorageUsageMeta(t)
+	assert.EqualValues(t, 80+80+160+50, estimateSegmentBytes(meta, meta.GetSegment(1)))
+	assert.EqualValues(t, 80, estimateSegmentBytes(meta, meta.GetSegment(2)))
+	assert.EqualValues(t, 15, estimateSegmentBytes(meta, meta.GetSegment(4)))
+	assert.EqualValues(t, 0, estimateSegmentBytes(meta, nil))
+}
@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"fmt"
+	"hash/crc32"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// binlogChecksums returns the CRC32C of the values of kvs
+func binlogChecksums(kvs map[string]string) map[string]uint32 {
+	checksums := make(map[string]uint32, len(kvs))
+	for key, value := range kvs {
+		checksums[key] = crc32.Checksum([]byte(value), crc32cTable)
+	}
+	return checksums
+}
+
+// validateSavedBinlogs reads the binlogs saved back and compares their CRC32C with the checksums computed
+// before they are saved. The binlogs are removed if any of them mismatches, so that the flush task is retried
+// and no corrupt binlog is reported to DataCoord
+func validateSavedBinlogs(blobKV kv.BaseKV, checksums map[string]uint32) error {
+	keys := make([]string, 0, len(checksums))
+	for key := range checksums {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values, err := blobKV.MultiLoad(keys)
+	if err != nil {
+		return fmt.Errorf("failed to read binlogs saved to validate checksum: %w", err)
+	}
+	for i, key := range keys {
+		if crc32.Checksum([]byte(values[i]), crc32cTable) == checksums[key] {
+			continue
+		}
+		if err := blobKV.MultiRemove(keys); err != nil {
+			log.Warn("failed to remove binlogs with checksum mismatch", zap.Strings("keys", keys), zap.Error(err))
+		}
+		return fmt.Errorf("binlog %s checksum mismatch after saved", key)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bitFlipKV flips the lowest bit of the first byte of the value saved at key, mocking a silent corruption
+type bitFlipKV struct {
+	*InMemoryKV
+	key string
+}
+
+func (kv *bitFlipKV) MultiSaveWithContext(ctx context.Context, kvs map[string]string) error {
+	saved := make(map[string]string, len(kvs))
+	for k, v := range kvs {
+		if k == kv.key && len(v) > 0 {
+			b := []byte(v)
+			b[0] ^= 1
+			v = string(b)
+		}
+		saved[k] = v
+	}
+	return kv.InMemoryKV.MultiSaveWithContext(ctx, saved)
+}
+
+func TestValidateSavedBinlogs(t *testing.T) {
+	kvs := map[string]string{"a": "binlog a", "b": "binlog b"}
+	checksums := binlogChecksums(kvs)
+
+	memKV := NewInMemoryKV(0)
+	require.NoError(t, memKV.MultiSave(kvs))
+	assert.NoError(t, validateSavedBinlogs(memKV, checksums))
+
+	require.NoError(t, memKV.Save("b", "binlog c"))
+	assert.Error(t, validateSavedBinlogs(memKV, checksums))
+	// binlogs mismatched are removed
+	keys, _, err := memKV.LoadWithPrefix("")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// binlogs missing
+	assert.Error(t, validateSavedBinlogs(memKV, checksums))
+}
+
+func TestFlushBufferInsertTask_ChecksumValidation(t *testing.T) {
+	enabled := Params.EnableBinlogChecksumValidation
+	defer func() { Params.EnableBinlogChecksumValidation = enabled }()
+
+	newTask := func(blobKV *bitFlipKV) *flushBufferInsertTask {
+		return &flushBufferInsertTask{
+			ctx:         context.Background(),
+			BaseKV:      blobKV,
+			data:        genFieldKvs(10),
+			concurrency: 4,
+		}
+	}
+
+	t.Run("bit flipped", func(t *testing.T) {
+		Params.EnableBinlogChecksumValidation = true
+		memKV := NewInMemoryKV(0)
+		assert.Error(t, newTask(&bitFlipKV{InMemoryKV: memKV, key: "insert_log/105"}).flushInsertData())
+		_, err := memKV.Load("insert_log/105")
+		assert.Error(t, err)
+	})
+
+	t.Run("two-phase bit flipped", func(t *testing.T) {
+		Params.EnableBinlogChecksumValidation = true
+		memKV := NewInMemoryKV(0)
+		// kvs unable to copy have the values saved again to their keys in the second phase
+		task := newTask(&bitFlipKV{InMemoryKV: memKV, key: "insert_log/105"})
+		task.tempPrefix = "tmp"
+		assert.Error(t, task.flushInsertData())
+	})
+
+	t.Run("not corrupted", func(t *testing.T) {
+		Params.EnableBinlogChecksumValidation = true
+		assert.NoError(t, newTask(&bitFlipKV{InMemoryKV: NewInMemoryKV(0)}).flushInsertData())
+	})
+
+	t.Run("validation disabled", func(t *testing.T) {
+		Params.EnableBinlogChecksumValidation = false
+		memKV := NewInMemoryKV(0)
+		assert.NoError(t, newTask(&bitFlipKV{InMemoryKV: memKV, key: "insert_log/105"}).flushInsertData())
+		saved, err := memKV.Load("insert_log/105")
+		assert.NoError(t, err)
+		assert.NotEqual(t, "binlog", saved)
+	})
+
+	t.Run("flush task failed", func(t *testing.T) {
+		Params.EnableBinlogChecksumValidation = true
+		runner := newFlushTaskRunner(1, nil)
+		notified := make(chan *segmentFlushPack, 1)
+		signal := make(chan struct{})
+		close(signal)
+		runner.init(func(pack *segmentFlushPack) {
+			notified <- pack
+		}, func(*segmentFlushPack, postInjectionFunc) {}, signal)
+
+		runner.runFlushInsert(newTask(&bitFlipKV{InMemoryKV: NewInMemoryKV(0), key: "insert_log/105"}),
+			nil, nil, nil, true, false, nil, retry.Attempts(2), retry.Sleep(time.Millisecond))
+		runner.runFlushDel(&emptyFlushTask{}, nil)
+
+		select {
+		case pack := <-notified:
+			// the notify func reports the error instead of saving binlog paths
+			assert.Error(t, pack.err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("flush task not done")
+		}
+	})
+}
@@ -161,6 +161,9 @@ func (b *binlogIO) upload(
 				if err != errStart {
 					log.Info("retry save binlogs")
 				}
+				if err = waitBlobIO(ctx, kvs, blobIOTypeCompaction); err != nil {
+					continue
+				}
 				err = b.MultiSave(kvs)
 			}
 		}
@@ -195,7 +198,10 @@ func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueI
 
 // return kvs, insert-paths, stats-paths
 func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta *etcdpb.CollectionMeta) (map[string]string, []*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
-	inCodec := storage.NewInsertCodec(meta)
+	inCodec, err := storage.NewBinlogInsertCodec(meta, Params.BinlogFormat)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	inlogs, statslogs, err := inCodec.Serialize(partID, segID, data)
 	if err != nil {
 		return nil, nil, nil, err
@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// BufferDataPool recycles BufferData objects to reduce allocations and GC pressure on the insert path
+type BufferDataPool struct {
+	pool sync.Pool
+}
+
+// bufferDataPool is the BufferDataPool shared by all insertBufferNodes
+var bufferDataPool = NewBufferDataPool()
+
+// NewBufferDataPool creates an empty BufferDataPool
+func NewBufferDataPool() *BufferDataPool {
+	return &BufferDataPool{
+		pool: sync.Pool{
+			New: func() interface{} {
+				return &BufferData{buffer: &InsertData{Data: make(map[UniqueID]storage.FieldData)}}
+			},
+		},
+	}
+}
+
+// Prealloc puts n BufferData objects into the pool.
+// Note that objects in pool may still be collected by GC when idle.
+func (p *BufferDataPool) Prealloc(n int) {
+	for i := 0; i < n; i++ {
+		p.pool.Put(p.pool.New())
+	}
+}
+
+// Acquire gets an empty BufferData from the pool, with limit calculated the same way as newBufferData
+func (p *BufferDataPool) Acquire(dimension int64) (*BufferData, error) {
+	if dimension == 0 {
+		return nil, errors.New("Invalid dimension")
+	}
+	bd := p.pool.Get().(*BufferData)
+	bd.size = 0
+	bd.memorySize = 0
+	bd.limit = flushPolicy.bufferSize() / (dimension * 4)
+	return bd, nil
+}
+
+// Release clears the BufferData and puts it back to the pool, bd shall not be used after released
+func (p *BufferDataPool) Release(bd *BufferData) {
+	if bd == nil || bd.buffer == nil {
+		return
+	}
+	for k := range bd.buffer.Data {
+		delete(bd.buffer.Data, k)
+	}
+	bd.buffer.Infos = nil
+	bd.size = 0
+	bd.limit = 0
+	bd.memorySize = 0
+	p.pool.Put(bd)
+}
@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferDataPool(t *testing.T) {
+	Params.Init()
+	pool := NewBufferDataPool()
+	pool.Prealloc(2)
+
+	_, err := pool.Acquire(0)
+	assert.Error(t, err)
+
+	bd, err := pool.Acquire(8)
+	assert.NoError(t, err)
+	expected, err := newBufferData(8)
+	assert.NoError(t, err)
+	assert.Equal(t, expected.limit, bd.limit)
+	assert.EqualValues(t, 0, bd.size)
+	assert.Empty(t, bd.buffer.Data)
+
+	bd.buffer.Data[1] = &storage.Int64FieldData{NumRows: []int64{1}, Data: []int64{1}}
+	bd.updateSize(1)
+	pool.Release(bd)
+	assert.Empty(t, bd.buffer.Data)
+	assert.EqualValues(t, 0, bd.size)
+
+	// released buffer is empty when acquired again
+	bd, err = pool.Acquire(8)
+	assert.NoError(t, err)
+	assert.Empty(t, bd.buffer.Data)
+	assert.EqualValues(t, 0, bd.size)
+
+	assert.NotPanics(t, func() {
+		pool.Release(nil)
+	})
+}
+
+const (
+	benchRowsPerSecond = 100000
+	benchRowsPerMsg    = 100
+	benchDim           = 8
+)
+
+// benchmarkInsertBuffer simulates buffering one second of ingest at 100k rows/s,
+// each insert cycle gets a BufferData from getFn and recycles it with putFn after flushed
+func benchmarkInsertBuffer(b *testing.B, getFn func() *BufferData, putFn func(*BufferData)) {
+	Params.Init()
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for rows := 0; rows < benchRowsPerSecond; rows += benchRowsPerMsg {
+			bd := getFn()
+			bd.buffer.Data[0] = &storage.Int64FieldData{
+				NumRows: []int64{benchRowsPerMsg},
+				Data:    make([]int64, benchRowsPerMsg),
+			}
+			bd.buffer.Data[1] = &storage.FloatVectorFieldData{
+				NumRows: []int64{benchRowsPerMsg},
+				Data:    make([]float32, benchRowsPerMsg*benchDim),
+				Dim:     benchDim,
+			}
+			bd.updateSize(benchRowsPerMsg)
+			putFn(bd)
+		}
+	}
+	b.StopTimer()
+	runtime.ReadMemStats(&after)
+	b.ReportMetric(float64(after.PauseTotalNs-before.PauseTotalNs)/float64(b.N), "gc-pause-ns/op")
+	b.ReportMetric(float64(after.NumGC-before.NumGC)/float64(b.N), "gc/op")
+}
+
+func BenchmarkInsertBuffer_WithoutPool(b *testing.B) {
+	benchmarkInsertBuffer(b, func() *BufferData {
+		bd, _ := newBufferData(benchDim)
+		return bd
+	}, func(*BufferData) {})
+}
+
+func BenchmarkInsertBuffer_WithPool(b *testing.B) {
+	Params.Init()
+	pool := NewBufferDataPool()
+	pool.Prealloc(Params.BufferDataPoolPreallocSize)
+	benchmarkInsertBuffer(b, func() *BufferData {
+		bd, _ := pool.Acquire(benchDim)
+		return bd
+	}, pool.Release)
+}
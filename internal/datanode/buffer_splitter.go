@@ -0,0 +1,289 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// segmentAllocatorFunc assigns rows of the partition to segments by DataCoord,
+// the rows may be split into multiple segments
+type segmentAllocatorFunc func(collID, partID UniqueID, rows int64) ([]*datapb.SegmentIDAssignment, error)
+
+// maxRowsPerSegment returns the number of rows of schema a segment of Params.SegmentMaxSize MB is able to hold
+func maxRowsPerSegment(schema *schemapb.CollectionSchema) (int64, error) {
+	if schema == nil {
+		return 0, errors.New("nil schema")
+	}
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+	if err != nil {
+		return 0, err
+	}
+	if sizePerRecord <= 0 {
+		return 0, fmt.Errorf("invalid size per record %d", sizePerRecord)
+	}
+	return int64(Params.SegmentMaxSize * 1024 * 1024 / float64(sizePerRecord)), nil
+}
+
+// subBufferAssignment is a segment assigned to rows [start, end) of a buffer split
+type subBufferAssignment struct {
+	assignment *datapb.SegmentIDAssignment
+	start, end int64
+}
+
+// splitBufferData keeps the first maxRows rows of data in the segment, and flushes the rest into segments
+// allocated by DataCoord, each of them receives at most maxRows rows.
+// All the segments are assigned before any rows are flushed. If flushing a part fails, the rows already flushed
+// are removed from data, so that a retry of the flush doesn't write them again
+func (m *rendezvousFlushManager) splitBufferData(data *BufferData, segmentID, collID, partID UniqueID,
+	meta *etcdpb.CollectionMeta, maxRows int64, flushed, dropped bool, pos *internalpb.MsgPosition) error {
+	// fails before any segment is allocated if the buffer can't be split
+	kept, err := sliceBufferData(data, 0, maxRows)
+	if err != nil {
+		return err
+	}
+
+	subs, err := m.assignSubBuffers(data.size, maxRows, collID, partID)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		moved, err := m.flushSubBuffer(data, segmentID, sub.assignment, sub.start, sub.end, collID, partID, meta, flushed, dropped, pos)
+		if err != nil {
+			rest := sub.start
+			if moved {
+				rest = sub.end
+			}
+			if trimErr := trimBufferData(data, maxRows, rest); trimErr != nil {
+				log.Warn("failed to remove the rows flushed from the insert buffer", zap.Int64("segmentID", segmentID),
+					zap.Error(trimErr))
+			}
+			return err
+		}
+	}
+
+	data.buffer.Data = kept.buffer.Data
+	data.buffer.Infos = nil
+	data.size, data.memorySize = kept.size, kept.memorySize
+	return nil
+}
+
+// assignSubBuffers assigns rows [maxRows, total) of a buffer to segments by DataCoord, each of them receives at most maxRows rows
+func (m *rendezvousFlushManager) assignSubBuffers(total, maxRows int64, collID, partID UniqueID) ([]*subBufferAssignment, error) {
+	var subs []*subBufferAssignment
+	for start := maxRows; start < total; {
+		rows := total - start
+		if rows > maxRows {
+			rows = maxRows
+		}
+		assignments, err := m.segmentAllocator(collID, partID, rows)
+		if err != nil {
+			return nil, err
+		}
+		from := start
+		for _, assignment := range assignments {
+			if assignment.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+				return nil, fmt.Errorf("failed to assign segment for %d rows, reason = %s", rows, assignment.GetStatus().GetReason())
+			}
+			end := start + int64(assignment.GetCount())
+			if end > total {
+				end = total
+			}
+			if end <= start {
+				continue
+			}
+			subs = append(subs, &subBufferAssignment{assignment: assignment, start: start, end: end})
+			start = end
+		}
+		if start == from {
+			return nil, fmt.Errorf("no segment assigned for %d rows", rows)
+		}
+	}
+	return subs, nil
+}
+
+// flushSubBuffer flushes rows [start, end) of data into the segment assigned, the rows are moved from segmentID.
+// It returns whether the rows are moved, statistics of both segments are updated only after the rows are flushed
+func (m *rendezvousFlushManager) flushSubBuffer(data *BufferData, segmentID UniqueID, assignment *datapb.SegmentIDAssignment,
+	start, end int64, collID, partID UniqueID, meta *etcdpb.CollectionMeta, flushed, dropped bool, pos *internalpb.MsgPosition) (bool, error) {
+	sub, err := sliceBufferData(data, start, end)
+	if err != nil {
+		return false, err
+	}
+	subID := assignment.GetSegID()
+	if !m.hasSegment(subID, true) {
+		if err := m.addNewSegment(subID, collID, partID, assignment.GetChannelName(), pos, pos); err != nil {
+			return false, err
+		}
+	}
+	// sub is released once flushed
+	var pks []int64
+	for _, field := range meta.GetSchema().GetFields() {
+		if !field.GetIsPrimaryKey() {
+			continue
+		}
+		if pkData, ok := sub.buffer.Data[field.GetFieldID()].(*storage.Int64FieldData); ok {
+			pks = pkData.Data
+		}
+	}
+	if _, err = m.flushBufferData(sub, subID, flushed, dropped, pos); err != nil {
+		return false, err
+	}
+
+	if pks != nil {
+		m.updateSegmentPKRange(subID, pks)
+	}
+	m.updateStatistics(subID, end-start)
+	m.updateStatistics(segmentID, start-end)
+
+	log.Info("split insert buffer into another segment",
+		zap.Int64("segmentID", segmentID),
+		zap.Int64("target", subID),
+		zap.Int64("rows", end-start))
+	// the delete node only flushes delete data of segmentID, the sub segment has nothing to delete
+	_, err = flushDelDataBlocking(m, nil, subID, pos)
+	return true, err
+}
+
+// trimBufferData removes rows [maxRows, rest) of data, which are flushed into other segments.
+// The rows kept are copied, sub buffers flushed still share the rows of data
+func trimBufferData(data *BufferData, maxRows, rest int64) error {
+	if rest <= maxRows {
+		return nil
+	}
+	kept, err := sliceBufferData(data, 0, maxRows)
+	if err != nil {
+		return err
+	}
+	remain, err := sliceBufferData(data, rest, data.size)
+	if err != nil {
+		return err
+	}
+	if err := mergeInsertData(kept.buffer, remain.buffer); err != nil {
+		return err
+	}
+	data.buffer.Data = kept.buffer.Data
+	data.buffer.Infos = nil
+	data.size, data.memorySize = kept.size+remain.size, kept.memorySize+remain.memorySize
+	return nil
+}
+
+// sliceBufferData returns a buffer of rows [start, end) of data, the rows are shared instead of copied,
+// and appending to the buffer returned copies them rather than overwriting rows of data.
+// Memory size of the buffer returned is estimated proportionally
+func sliceBufferData(data *BufferData, start, end int64) (*BufferData, error) {
+	sub := &BufferData{
+		buffer: &InsertData{Data: make(map[UniqueID]storage.FieldData, len(data.buffer.Data))},
+		size:   end - start,
+		limit:  data.limit,
+	}
+	for fieldID, fieldData := range data.buffer.Data {
+		sliced, err := sliceFieldData(fieldData, int(start), int(end))
+		if err != nil {
+			return nil, fmt.Errorf("failed to split field %d: %w", fieldID, err)
+		}
+		sub.buffer.Data[fieldID] = sliced
+	}
+	if data.size > 0 {
+		sub.memorySize = data.memorySize * (end - start) / data.size
+	}
+	return sub, nil
+}
+
+// sliceFieldData returns rows [start, end) of the field data
+func sliceFieldData(fieldData storage.FieldData, start, end int) (storage.FieldData, error) {
+	numRows := []int64{int64(end - start)}
+	switch d := fieldData.(type) {
+	case *storage.BoolFieldData:
+		return &storage.BoolFieldData{NumRows: numRows, Data: d.Data[start:end:end]}, nil
+	case *storage.Int8FieldData:
+		return &storage.Int8FieldData{NumRows: numRows, Data: d.Data[start:end:end]}, nil
+	case *storage.Int16FieldData:
+		return &storage.Int16FieldData{NumRows: numRows, Data: d.Data[start:end:end]}, nil
+	case *storage.Int32FieldData:
+		return &storage.Int32FieldData{NumRows: numRows, Data: d.Data[start:end:end]}, nil
+	case *storage.Int64FieldData:
+		return &storage.Int64FieldData{NumRows: numRows, Data: d.Data[start:end:end]}, nil
+	case *storage.FloatFieldData:
+		return &storage.FloatFieldData{NumRows: numRows, Data: d.Data[start:end:end]}, nil
+	case *storage.DoubleFieldData:
+		return &storage.DoubleFieldData{NumRows: numRows, Data: d.Data[start:end:end]}, nil
+	case *storage.StringFieldData:
+		return &storage.StringFieldData{NumRows: numRows, Data: d.Data[start:end:end]}, nil
+	case *storage.BinaryVectorFieldData:
+		return &storage.BinaryVectorFieldData{NumRows: numRows, Data: d.Data[start*d.Dim/8 : end*d.Dim/8 : end*d.Dim/8], Dim: d.Dim}, nil
+	case *storage.FloatVectorFieldData:
+		return &storage.FloatVectorFieldData{NumRows: numRows, Data: d.Data[start*d.Dim : end*d.Dim : end*d.Dim], Dim: d.Dim}, nil
+	case *storage.DynamicFieldData:
+		return &storage.DynamicFieldData{NumRows: numRows, Data: d.Data[start:end:end]}, nil
+	default:
+		return nil, fmt.Errorf("unsupported field data type %T", fieldData)
+	}
+}
+
+// allocSegments assigns rows of the partition to segments of the vchannel by DataCoord
+func (dsService *dataSyncService) allocSegments(collID, partID UniqueID, rows int64) ([]*datapb.SegmentIDAssignment, error) {
+	resp, err := dsService.dataCoord.AssignSegmentID(dsService.ctx, &datapb.AssignSegmentIDRequest{
+		NodeID:   Params.NodeID,
+		PeerRole: typeutil.DataNodeRole,
+		SegmentIDRequests: []*datapb.SegmentIDRequest{
+			{
+				Count:        uint32(rows),
+				ChannelName:  dsService.vchannelName,
+				CollectionID: collID,
+				PartitionID:  partID,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp.GetSegIDAssignments(), nil
+}
+
+// discardSegment returns the segment of the vchannel allocated ahead of time and never used to DataCoord
+func (dsService *dataSyncService) discardSegment(segmentID UniqueID) error {
+	status, err := dsService.dataCoord.DiscardSegment(dsService.ctx, &datapb.DiscardSegmentRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.NodeID,
+		},
+		SegmentID:   segmentID,
+		ChannelName: dsService.vchannelName,
+	})
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(status.GetReason())
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFieldData struct {
+	storage.FieldData
+}
+
+func TestSliceBufferData(t *testing.T) {
+	data := &BufferData{buffer: genInsertData(), size: 2, memorySize: 100}
+	sub, err := sliceBufferData(data, 1, 2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sub.size)
+	assert.EqualValues(t, 50, sub.memorySize)
+	assert.Equal(t, len(data.buffer.Data), len(sub.buffer.Data))
+	for fieldID, fieldData := range sub.buffer.Data {
+		assert.Equal(t, 1, fieldData.RowNum(), "field %d", fieldID)
+		assert.Equal(t, data.buffer.Data[fieldID].GetRow(1), fieldData.GetRow(0), "field %d", fieldID)
+	}
+	// source buffer is kept as is
+	assert.EqualValues(t, 2, data.size)
+	assert.Equal(t, 2, data.buffer.Data[100].RowNum())
+
+	_, err = sliceFieldData(&fakeFieldData{}, 0, 1)
+	assert.Error(t, err)
+}
+
+func TestRendezvousFlushManager_SplitBufferData(t *testing.T) {
+	defer func(origin float64) { Params.SegmentMaxSize = origin }(Params.SegmentMaxSize)
+
+	collMeta := (&MetaFactory{}).GetCollectionMeta(1, "coll1")
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(collMeta.Schema)
+	require.NoError(t, err)
+	// a segment holds only one row
+	Params.SegmentMaxSize = float64(sizePerRecord) * 1.5 / 1024 / 1024
+
+	newReplica := func() *SegmentReplica {
+		replica := &SegmentReplica{
+			collectionID:    1,
+			newSegments:     make(map[UniqueID]*Segment),
+			normalSegments:  make(map[UniqueID]*Segment),
+			flushedSegments: make(map[UniqueID]*Segment),
+			metaService:     newMetaService(&RootCoordFactory{collectionID: 1}, 1),
+		}
+		pos := &internalpb.MsgPosition{ChannelName: "ch1"}
+		require.NoError(t, replica.addNewSegment(100, 1, 10, "ch1", pos, pos))
+		replica.updateStatistics(100, 2)
+		return replica
+	}
+	pos := &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1}}
+
+	t.Run("split", func(t *testing.T) {
+		replica := newReplica()
+		var mut sync.Mutex
+		packs := make(map[UniqueID]*segmentFlushPack)
+		kv := memkv.NewMemoryKV()
+		m := NewRendezvousFlushManager(NewAllocatorFactory(), kv, replica, func(pack *segmentFlushPack) {
+			mut.Lock()
+			defer mut.Unlock()
+			packs[pack.segmentID] = pack
+		})
+		var requested []int64
+		m.segmentAllocator = func(collID, partID UniqueID, rows int64) ([]*datapb.SegmentIDAssignment, error) {
+			assert.EqualValues(t, 1, collID)
+			assert.EqualValues(t, 10, partID)
+			requested = append(requested, rows)
+			return []*datapb.SegmentIDAssignment{{
+				SegID:       200,
+				ChannelName: "ch1",
+				Count:       uint32(rows),
+				Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			}}, nil
+		}
+
+		data := &BufferData{buffer: genInsertData(), size: 2}
+		_, err := m.flushBufferData(data, 100, false, false, pos)
+		require.NoError(t, err)
+		// the delete half of segment 200 is queued by the split
+		_, err = m.flushDelData(nil, 100, pos)
+		require.NoError(t, err)
+		require.NoError(t, m.waitForFlushTasks(m.ctx))
+
+		assert.Equal(t, []int64{1}, requested)
+		assert.True(t, replica.hasSegment(200, false))
+		for _, segID := range []UniqueID{100, 200} {
+			updates, err := replica.getSegmentStatisticsUpdates(segID)
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, updates.GetNumRows())
+		}
+		// the second row is moved to segment 200
+		assert.EqualValues(t, 2, replica.newSegments[200].minPK)
+		assert.EqualValues(t, 2, replica.newSegments[200].maxPK)
+
+		mut.Lock()
+		defer mut.Unlock()
+		require.Equal(t, 2, len(packs))
+		expectedPKs := map[UniqueID]int64{100: 1, 200: 2}
+		for segID, pack := range packs {
+			require.NoError(t, pack.err)
+			blobs := make([]*Blob, 0, len(pack.insertLogs))
+			for _, p := range pack.insertLogs {
+				v, err := kv.Load(p)
+				require.NoError(t, err)
+				blobs = append(blobs, &Blob{Key: p, Value: []byte(v)})
+			}
+			_, _, insertData, err := storage.NewInsertCodec(collMeta).Deserialize(blobs)
+			require.NoError(t, err)
+			assert.Equal(t, 1, insertData.Data[106].RowNum())
+			assert.Equal(t, expectedPKs[segID], insertData.Data[106].GetRow(0))
+		}
+	})
+
+	t.Run("allocation failure", func(t *testing.T) {
+		m := NewRendezvousFlushManager(NewAllocatorFactory(), memkv.NewMemoryKV(), newReplica(), func(pack *segmentFlushPack) {})
+		m.segmentAllocator = func(collID, partID UniqueID, rows int64) ([]*datapb.SegmentIDAssignment, error) {
+			return nil, errors.New("mocked error")
+		}
+		_, err := m.flushBufferData(&BufferData{buffer: genInsertData(), size: 2}, 100, false, false, pos)
+		assert.Error(t, err)
+
+		m.segmentAllocator = func(collID, partID UniqueID, rows int64) ([]*datapb.SegmentIDAssignment, error) {
+			return []*datapb.SegmentIDAssignment{{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_SegmentTooSmall}}}, nil
+		}
+		_, err = m.flushBufferData(&BufferData{buffer: genInsertData(), size: 2}, 100, false, false, pos)
+		assert.Error(t, err)
+
+		m.segmentAllocator = func(collID, partID UniqueID, rows int64) ([]*datapb.SegmentIDAssignment, error) {
+			return nil, nil
+		}
+		_, err = m.flushBufferData(&BufferData{buffer: genInsertData(), size: 2}, 100, false, false, pos)
+		assert.Error(t, err)
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		replica := newReplica()
+		replica.updateStatistics(100, 2)
+		// segment 202 can't be flushed into
+		replica.normalSegments[202] = &Segment{collectionID: 2, segmentID: 202}
+		var mut sync.Mutex
+		packs := make(map[UniqueID]*segmentFlushPack)
+		m := NewRendezvousFlushManager(NewAllocatorFactory(), memkv.NewMemoryKV(), replica, func(pack *segmentFlushPack) {
+			mut.Lock()
+			defer mut.Unlock()
+			packs[pack.segmentID] = pack
+		})
+		nextID := UniqueID(201)
+		var requested []int64
+		m.segmentAllocator = func(collID, partID UniqueID, rows int64) ([]*datapb.SegmentIDAssignment, error) {
+			requested = append(requested, rows)
+			segID := nextID
+			nextID++
+			return []*datapb.SegmentIDAssignment{{
+				SegID:       segID,
+				ChannelName: "ch1",
+				Count:       uint32(rows),
+				Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			}}, nil
+		}
+
+		buffer := genInsertDataWithPKs([2]int64{1, 2})
+		require.NoError(t, mergeInsertData(buffer, genInsertDataWithPKs([2]int64{3, 4})))
+		data := &BufferData{buffer: buffer, size: 4}
+		_, err := m.flushBufferData(data, 100, false, false, pos)
+		assert.Error(t, err)
+		// all the segments are assigned before flushing
+		assert.Equal(t, []int64{1, 1, 1}, requested)
+		// the row flushed into segment 201 is removed from the buffer, and moved in statistics
+		assert.EqualValues(t, 3, data.size)
+		assert.Equal(t, []int64{1, 3, 4}, data.buffer.Data[106].(*storage.Int64FieldData).Data)
+		for segID, numRows := range map[UniqueID]int64{100: 3, 201: 1} {
+			updates, err := replica.getSegmentStatisticsUpdates(segID)
+			require.NoError(t, err)
+			assert.EqualValues(t, numRows, updates.GetNumRows(), "segment %d", segID)
+		}
+
+		// the retry flushes the rest without duplicating the row flushed
+		_, err = m.flushBufferData(data, 100, false, false, pos)
+		require.NoError(t, err)
+		_, err = m.flushDelData(nil, 100, pos)
+		require.NoError(t, err)
+		require.NoError(t, m.waitForFlushTasks(m.ctx))
+		for segID, numRows := range map[UniqueID]int64{100: 1, 201: 1, 204: 1, 205: 1} {
+			updates, err := replica.getSegmentStatisticsUpdates(segID)
+			require.NoError(t, err)
+			assert.EqualValues(t, numRows, updates.GetNumRows(), "segment %d", segID)
+		}
+		assert.EqualValues(t, 3, replica.newSegments[204].minPK)
+		assert.EqualValues(t, 4, replica.newSegments[205].minPK)
+		mut.Lock()
+		defer mut.Unlock()
+		for _, segID := range []UniqueID{100, 201, 204, 205} {
+			require.NotNil(t, packs[segID], "segment %d", segID)
+			assert.NoError(t, packs[segID].err)
+		}
+	})
+
+	t.Run("no split without allocator", func(t *testing.T) {
+		replica := newReplica()
+		m := NewRendezvousFlushManager(NewAllocatorFactory(), memkv.NewMemoryKV(), replica, func(pack *segmentFlushPack) {})
+		_, err := m.flushBufferData(&BufferData{buffer: genInsertData(), size: 2}, 100, false, false, pos)
+		assert.NoError(t, err)
+		updates, err := replica.getSegmentStatisticsUpdates(100)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, updates.GetNumRows())
+	})
+}
@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"go.uber.org/zap"
+)
+
+// errCircuitOpen is the error of the call failed by which the circuit opens
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+type circuitState int32
+
+const (
+	// calls are allowed and failures are counted
+	circuitClosed circuitState = iota
+	// calls are rejected until the cooldown passes
+	circuitOpen
+	// a trial call is allowed after the cooldown, the circuit closes if it succeeds and opens again otherwise
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitClosed:
+		return "closed"
+	case circuitOpen:
+		return "open"
+	default:
+		return "half-open"
+	}
+}
+
+// CircuitBreaker stops calls after consecutive failures reaching the threshold, and tries again after the cooldown.
+// Calls guarded by a breaker are expected to be serialized, e.g. SaveBinlogPaths of a segment
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	threshold int64
+	cooldown  time.Duration
+	window    time.Duration // failures are counted afresh if the first of them is older than it, 0 means no window
+	state     circuitState
+	failures  int64 // consecutive failures
+	firstFail time.Time
+	openedAt  time.Time
+	now       func() time.Time
+}
+
+// NewCircuitBreaker creates a closed CircuitBreaker
+func NewCircuitBreaker(threshold int64, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// NewWindowedCircuitBreaker creates a closed CircuitBreaker opened by consecutive failures reaching the threshold
+// within the window
+func NewWindowedCircuitBreaker(threshold int64, window, cooldown time.Duration) *CircuitBreaker {
+	b := NewCircuitBreaker(threshold, cooldown)
+	b.window = window
+	return b
+}
+
+// Allow returns whether a call is allowed, an open circuit turns half-open once the cooldown passes
+func (b *CircuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == circuitOpen {
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = circuitHalfOpen
+	}
+	return true
+}
+
+// Success resets the failures and closes the circuit
+func (b *CircuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.state = circuitClosed
+}
+
+// Failure counts a failed call, returns true if the circuit is opened by it.
+// A failed trial call of a half-open circuit opens it again
+func (b *CircuitBreaker) Failure() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	if b.failures == 0 || (b.window > 0 && now.Sub(b.firstFail) > b.window) {
+		b.failures = 0
+		b.firstFail = now
+	}
+	b.failures++
+	if b.state == circuitHalfOpen || (b.state == circuitClosed && b.failures >= b.threshold) {
+		b.state = circuitOpen
+		b.openedAt = now
+		return true
+	}
+	return false
+}
+
+// Wait blocks while the circuit is open and the cooldown is not passed, or until ctx is done
+func (b *CircuitBreaker) Wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		var wait time.Duration
+		if b.state == circuitOpen {
+			wait = b.cooldown - b.now().Sub(b.openedAt)
+		}
+		b.mu.Unlock()
+		if wait <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// State returns the current state of the circuit
+func (b *CircuitBreaker) State() circuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// segmentCircuitBreakers holds a CircuitBreaker of flush for each segment, a segment with open circuit is errored
+type segmentCircuitBreakers struct {
+	mu        sync.Mutex
+	threshold int64
+	cooldown  time.Duration
+	breakers  map[UniqueID]*CircuitBreaker
+}
+
+// newSegmentCircuitBreakers returns nil if threshold is not positive, which means no circuit breaker
+func newSegmentCircuitBreakers(threshold int64, cooldown time.Duration) *segmentCircuitBreakers {
+	if threshold <= 0 {
+		return nil
+	}
+	return &segmentCircuitBreakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		breakers:  make(map[UniqueID]*CircuitBreaker),
+	}
+}
+
+// get returns the breaker of the segment, nil if there's no circuit breaker
+func (s *segmentCircuitBreakers) get(segmentID UniqueID) *CircuitBreaker {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.breakers[segmentID]
+	if !ok {
+		b = NewCircuitBreaker(s.threshold, s.cooldown)
+		s.breakers[segmentID] = b
+	}
+	return b
+}
+
+// remove removes the breaker of the segment once it's flushed or dropped
+func (s *segmentCircuitBreakers) remove(segmentID UniqueID) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.breakers, segmentID)
+}
+
+// reportSegmentError notifies DataCoord that binlog paths of the segment are not saved since its circuit is open
+func (dsService *dataSyncService) reportSegmentError(segmentID UniqueID, reason error) {
+	status, err := dsService.dataCoord.ReportSegmentError(context.Background(), &datapb.ReportSegmentErrorRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.NodeID,
+		},
+		SegmentID:    segmentID,
+		CollectionID: dsService.collectionID,
+		Channel:      dsService.vchannelName,
+		Reason:       reason.Error(),
+	})
+	if err == nil && status.GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(status.GetReason())
+	}
+	if err != nil {
+		log.Warn("failed to report segment error", zap.Int64("segmentID", segmentID), zap.Error(err))
+	}
+}
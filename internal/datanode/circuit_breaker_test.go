@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := NewCircuitBreaker(3, time.Minute)
+	b.now = func() time.Time { return now }
+
+	assert.True(t, b.Allow())
+	assert.False(t, b.Failure())
+	assert.False(t, b.Failure())
+	// a success resets the consecutive failures
+	b.Success()
+	assert.False(t, b.Failure())
+	assert.False(t, b.Failure())
+	assert.Equal(t, circuitClosed, b.State())
+	assert.True(t, b.Failure())
+	assert.Equal(t, circuitOpen, b.State())
+	assert.Equal(t, "open", b.State().String())
+	assert.False(t, b.Allow())
+
+	// half-open after the cooldown, a failed trial opens the circuit again
+	now = now.Add(time.Minute)
+	assert.True(t, b.Allow())
+	assert.Equal(t, circuitHalfOpen, b.State())
+	assert.Equal(t, "half-open", b.State().String())
+	assert.True(t, b.Failure())
+	assert.Equal(t, circuitOpen, b.State())
+	assert.False(t, b.Allow())
+
+	// a succeeded trial closes the circuit
+	now = now.Add(time.Minute)
+	assert.True(t, b.Allow())
+	b.Success()
+	assert.Equal(t, circuitClosed, b.State())
+	assert.Equal(t, "closed", b.State().String())
+	assert.False(t, b.Failure())
+}
+
+func TestCircuitBreaker_Window(t *testing.T) {
+	now := time.Now()
+	b := NewWindowedCircuitBreaker(3, time.Minute, time.Minute)
+	b.now = func() time.Time { return now }
+
+	assert.False(t, b.Failure())
+	assert.False(t, b.Failure())
+	// failures are counted afresh once the first of them is out of the window
+	now = now.Add(2 * time.Minute)
+	assert.False(t, b.Failure())
+	assert.False(t, b.Failure())
+	assert.Equal(t, circuitClosed, b.State())
+	assert.True(t, b.Failure())
+	assert.Equal(t, circuitOpen, b.State())
+}
+
+func TestCircuitBreaker_Wait(t *testing.T) {
+	b := NewCircuitBreaker(1, 20*time.Millisecond)
+	assert.NoError(t, b.Wait(context.Background()))
+
+	b.Failure()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, b.Wait(ctx))
+
+	start := time.Now()
+	assert.NoError(t, b.Wait(context.Background()))
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(10*time.Millisecond))
+	assert.True(t, b.Allow())
+	assert.Equal(t, circuitHalfOpen, b.State())
+}
+
+func TestSegmentCircuitBreakers(t *testing.T) {
+	disabled := newSegmentCircuitBreakers(0, time.Minute)
+	assert.Nil(t, disabled)
+	assert.Nil(t, disabled.get(1))
+	assert.NotPanics(t, func() { disabled.remove(1) })
+
+	breakers := newSegmentCircuitBreakers(1, time.Minute)
+	b := breakers.get(1)
+	assert.Same(t, b, breakers.get(1))
+	assert.NotSame(t, b, breakers.get(2))
+	assert.True(t, b.Failure())
+	assert.Equal(t, circuitClosed, breakers.get(2).State())
+
+	breakers.remove(1)
+	assert.Equal(t, circuitClosed, breakers.get(1).State())
+}
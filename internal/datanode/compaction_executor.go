@@ -90,12 +90,15 @@ func (c *compactionExecutor) executeTask(task compactor) {
 	log.Info("end to execute compaction", zap.Int64("planID", task.getPlanID()))
 }
 
-func (c *compactionExecutor) stopTask(planID UniqueID) {
+// stopTask stops the executing task of the plan, returns nil if the plan is not executing
+func (c *compactionExecutor) stopTask(planID UniqueID) compactor {
 	task, loaded := c.executing.LoadAndDelete(planID)
-	if loaded {
-		log.Warn("compaction executor stop task", zap.Int64("planID", planID))
-		task.(compactor).stop()
+	if !loaded {
+		return nil
 	}
+	log.Warn("compaction executor stop task", zap.Int64("planID", planID))
+	task.(compactor).stop()
+	return task.(compactor)
 }
 
 func (c *compactionExecutor) stopExecutingtaskByCollectionID(collID UniqueID) {
@@ -19,6 +19,8 @@ package datanode
 import (
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCompactionExecutor(t *testing.T) {
@@ -32,7 +34,8 @@ func TestCompactionExecutor(t *testing.T) {
 		ex := newCompactionExecutor()
 		mc := newMockCompactor(true)
 		ex.executing.Store(UniqueID(1), mc)
-		ex.stopTask(UniqueID(1))
+		assert.Equal(t, mc, ex.stopTask(UniqueID(1)))
+		assert.Nil(t, ex.stopTask(UniqueID(1)))
 	})
 
 	t.Run("Test start", func(t *testing.T) {
@@ -98,3 +101,7 @@ func (mc *mockCompactor) stop() {
 func (mc *mockCompactor) getCollection() UniqueID {
 	return 1
 }
+
+func (mc *mockCompactor) getOutputSegment() UniqueID {
+	return 0
+}
@@ -23,6 +23,7 @@ import (
 	"math"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -52,6 +53,8 @@ type compactor interface {
 	stop()
 	getPlanID() UniqueID
 	getCollection() UniqueID
+	// getOutputSegment returns the segment the compaction writes into, 0 if it's not allocated yet
+	getOutputSegment() UniqueID
 }
 
 // make sure compactionTask implements compactor interface
@@ -70,6 +73,8 @@ type compactionTask struct {
 
 	ctx    context.Context
 	cancel context.CancelFunc
+
+	outputSegID int64 // set once the target segment is known, accessed atomically
 }
 
 // check if compactionTask implements compactor
@@ -104,6 +109,10 @@ func (t *compactionTask) stop() {
 	t.cancel()
 }
 
+func (t *compactionTask) getOutputSegment() UniqueID {
+	return atomic.LoadInt64(&t.outputSegID)
+}
+
 func (t *compactionTask) getPlanID() UniqueID {
 	return t.plan.GetPlanID()
 }
@@ -282,6 +291,7 @@ func (t *compactionTask) compact() error {
 	case t.plan.GetType() == datapb.CompactionType_InnerCompaction:
 		targetSegID = t.plan.GetSegmentBinlogs()[0].GetSegmentID()
 	}
+	atomic.StoreInt64(&t.outputSegID, targetSegID)
 
 	log.Debug("compaction start", zap.Int64("planID", t.plan.GetPlanID()), zap.Any("timeout in seconds", t.plan.GetTimeoutInSeconds()))
 	segIDs := make([]UniqueID, 0, len(t.plan.GetSegmentBinlogs()))
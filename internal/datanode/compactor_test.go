@@ -544,12 +544,16 @@ type mockFlushManager struct {
 
 var _ flushManager = (*mockFlushManager)(nil)
 
-func (mfm *mockFlushManager) flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) error {
-	return nil
+func (mfm *mockFlushManager) flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) (*WriteBarrier, error) {
+	barrier := newWriteBarrier()
+	barrier.close()
+	return barrier, nil
 }
 
-func (mfm *mockFlushManager) flushDelData(data *DelDataBuf, segmentID UniqueID, pos *internalpb.MsgPosition) error {
-	return nil
+func (mfm *mockFlushManager) flushDelData(data *DelDataBuf, segmentID UniqueID, pos *internalpb.MsgPosition) (*WriteBarrier, error) {
+	barrier := newWriteBarrier()
+	barrier.close()
+	return barrier, nil
 }
 
 func (mfm *mockFlushManager) injectFlush(injection taskInjection, segments ...UniqueID) {
@@ -560,4 +564,12 @@ func (mfm *mockFlushManager) injectFlush(injection taskInjection, segments ...Un
 	}()
 }
 
+func (mfm *mockFlushManager) waitForFlushTasks(ctx context.Context) error {
+	return nil
+}
+
+func (mfm *mockFlushManager) GetPendingFlushCount() int {
+	return 0
+}
+
 func (mfm *mockFlushManager) close() {}
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// compressionRatioWindow is the number of the latest flushes the compression ratio of a field is averaged over
+const compressionRatioWindow = 10
+
+type fieldCompressionKey struct {
+	collectionID UniqueID
+	fieldID      UniqueID
+}
+
+// fieldCompressionRatios keeps the compression ratios of the latest flushes of each field,
+// it's shared by the flush managers of all vchannels, since the vchannels of a collection flush the same fields
+type fieldCompressionRatios struct {
+	mu     sync.Mutex
+	ratios map[fieldCompressionKey][]float64
+}
+
+var compressionRatios = newFieldCompressionRatios()
+
+func newFieldCompressionRatios() *fieldCompressionRatios {
+	return &fieldCompressionRatios{
+		ratios: make(map[fieldCompressionKey][]float64),
+	}
+}
+
+// observe records the compression ratio of a flush of the field, and returns the average ratio of the latest flushes
+func (r *fieldCompressionRatios) observe(collectionID, fieldID UniqueID, ratio float64) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := fieldCompressionKey{collectionID, fieldID}
+	ratios := append(r.ratios[key], ratio)
+	if len(ratios) > compressionRatioWindow {
+		ratios = ratios[len(ratios)-compressionRatioWindow:]
+	}
+	r.ratios[key] = ratios
+
+	sum := 0.0
+	for _, v := range ratios {
+		sum += v
+	}
+	return sum / float64(len(ratios))
+}
+
+// fieldDataSize returns the size of the field data in memory
+func fieldDataSize(data storage.FieldData) int {
+	// GetMemorySize doesn't count strings, which are not fixed-size
+	if stringData, ok := data.(*storage.StringFieldData); ok {
+		size := 0
+		for _, str := range stringData.Data {
+			size += len(str)
+		}
+		return size
+	}
+	return data.GetMemorySize()
+}
+
+// observeCompressionRatios updates the compression ratio of each field with the size of its data in memory
+// and the size of its insert binlog serialized
+func observeCompressionRatios(collectionID UniqueID, data *InsertData, binlogs []*Blob) {
+	for _, blob := range binlogs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		if err != nil {
+			continue
+		}
+		fieldData, ok := data.Data[fieldID]
+		if !ok || len(blob.GetValue()) == 0 {
+			continue
+		}
+		uncompressed := fieldDataSize(fieldData)
+		if uncompressed <= 0 {
+			continue
+		}
+		ratio := compressionRatios.observe(collectionID, fieldID, float64(uncompressed)/float64(len(blob.GetValue())))
+		metrics.DataNodeFieldCompressionRatio.WithLabelValues(strconv.FormatInt(collectionID, 10),
+			strconv.FormatInt(fieldID, 10)).Set(ratio)
+	}
+}
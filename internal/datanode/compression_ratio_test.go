@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldCompressionRatios_observe(t *testing.T) {
+	r := newFieldCompressionRatios()
+	assert.Equal(t, 2.0, r.observe(1, 100, 2))
+	assert.Equal(t, 3.0, r.observe(1, 100, 4))
+	// fields are averaged separately
+	assert.Equal(t, 10.0, r.observe(1, 101, 10))
+	assert.Equal(t, 10.0, r.observe(2, 100, 10))
+
+	// only the latest flushes are averaged
+	for i := 0; i < compressionRatioWindow; i++ {
+		r.observe(1, 100, 1)
+	}
+	assert.Equal(t, 1.0, r.observe(1, 100, 1))
+	assert.Equal(t, compressionRatioWindow, len(r.ratios[fieldCompressionKey{1, 100}]))
+	assert.InDelta(t, 1.9, r.observe(1, 100, 10), 1e-9)
+}
+
+func TestObserveCompressionRatios(t *testing.T) {
+	data := &InsertData{Data: map[UniqueID]storage.FieldData{
+		100: &storage.Int64FieldData{NumRows: []int64{4}, Data: []int64{1, 2, 3, 4}},
+		101: &storage.Int64FieldData{},
+		103: &storage.StringFieldData{NumRows: []int64{2}, Data: []string{"abcd", "efgh"}},
+	}}
+	observeCompressionRatios(3001, data, []*Blob{
+		{Key: "100", Value: make([]byte, 8)},
+		// fields without data or binlog are skipped
+		{Key: "101", Value: make([]byte, 8)},
+		{Key: "102", Value: make([]byte, 8)},
+		{Key: "invalid", Value: make([]byte, 8)},
+		{Key: "103", Value: make([]byte, 4)},
+	})
+	// 8 bytes of row nums and 32 bytes of data
+	assert.Equal(t, 5.0, testutil.ToFloat64(metrics.DataNodeFieldCompressionRatio.WithLabelValues("3001", "100")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.DataNodeFieldCompressionRatio.WithLabelValues("3001", "103")))
+
+	observeCompressionRatios(3001, data, []*Blob{{Key: "100", Value: make([]byte, 16)}})
+	assert.Equal(t, 3.75, testutil.ToFloat64(metrics.DataNodeFieldCompressionRatio.WithLabelValues("3001", "100")))
+	_, ok := compressionRatios.ratios[fieldCompressionKey{3001, 101}]
+	assert.False(t, ok)
+}
@@ -36,19 +36,24 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -90,6 +95,7 @@ var _ types.DataNode = (*DataNode)(nil)
 //  has ability to scale flowgraph.
 //  `vchan2FlushCh` holds flush-signal channels for every flowgraph.
 //  `clearSignal` is a signal channel for releasing the flowgraph resources.
+//  `shutdownSignal` is a signal channel for releasing a vchannel after unrecoverable failure.
 //  `segmentCache` stores all flushing and flushed segments.
 type DataNode struct {
 	ctx    context.Context
@@ -102,10 +108,14 @@ type DataNode struct {
 	chanMut           sync.RWMutex
 	vchan2SyncService map[string]*dataSyncService // vchannel name
 	vchan2FlushChs    map[string]chan flushMsg    // vchannel name to flush channels
+	startingChannels  map[string]*startingChannel // vchannels of dataSyncServices being created
 
-	clearSignal        chan UniqueID // collection ID
+	clearSignal        chan UniqueID        // collection ID
+	shutdownSignal     chan *shutdownSignal // vchannel failure
 	segmentCache       *Cache
 	compactionExecutor *compactionExecutor
+	saveBinlogLimiter  *tokenBucket    // limits SaveBinlogPaths calls of all flowgraphs
+	dataCoordBreaker   *CircuitBreaker // stops SaveBinlogPaths calls of all flowgraphs while DataCoord is unreachable, nil if disabled
 
 	rootCoord types.RootCoord
 	dataCoord types.DataCoord
@@ -136,7 +146,9 @@ func NewDataNode(ctx context.Context, factory msgstream.Factory) *DataNode {
 
 		vchan2SyncService: make(map[string]*dataSyncService),
 		vchan2FlushChs:    make(map[string]chan flushMsg),
+		startingChannels:  make(map[string]*startingChannel),
 		clearSignal:       make(chan UniqueID, 100),
+		shutdownSignal:    make(chan *shutdownSignal, 100),
 	}
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 	return node
@@ -196,13 +208,41 @@ func (node *DataNode) Register() error {
 	return nil
 }
 
-// Init function does nothing now.
+// Init initializes the SaveBinlogPaths and blob storage bandwidth rate limiters and the DataCoord circuit breaker,
+// preallocates insert buffers, enables the dynamic flush policy if configured, sets the id base of dynamic fields
+// and opens the flow graph checkpoint store if configured.
 func (node *DataNode) Init() error {
 	log.Debug("DataNode Init",
 		zap.String("SegmentStatisticsChannelName", Params.SegmentStatisticsChannelName),
 		zap.String("TimeTickChannelName", Params.TimeTickChannelName),
 	)
 
+	node.saveBinlogLimiter = newTokenBucket(Params.MaxSaveBinlogRatePerSec, Params.SaveBinlogBurstSize)
+	if Params.DataCoordCircuitBreakerThreshold > 0 {
+		node.dataCoordBreaker = NewWindowedCircuitBreaker(Params.DataCoordCircuitBreakerThreshold,
+			time.Duration(Params.DataCoordCircuitBreakerWindowSeconds)*time.Second,
+			time.Duration(Params.DataCoordCircuitBreakerCooldownSeconds)*time.Second)
+	}
+	// burst of one second bandwidth
+	blobIOLimiter = newTokenBucket(Params.MaxBlobStorageBandwidthBytesPerSec, int(Params.MaxBlobStorageBandwidthBytesPerSec))
+	bufferDataPool.Prealloc(Params.BufferDataPoolPreallocSize)
+	if Params.TargetFlushLatencyMs > 0 {
+		flushPolicy = NewDynamicFlushPolicy(time.Duration(Params.TargetFlushLatencyMs)*time.Millisecond,
+			Params.MinFlushSize, Params.MaxFlushSize, Params.FlushInsertBufferSize)
+	}
+	storage.DynamicFieldIDBase = Params.DynamicFieldIDBase
+	if err := storage.InitColumnKeyManager(Params.ColumnEncryptionFieldKeys, Params.ColumnEncryptionKeys); err != nil {
+		log.Error("invalid column encryption keys", zap.Error(err))
+		return err
+	}
+	if Params.FlowGraphCheckpointIntervalSeconds > 0 {
+		checkpointKV, err := rocksdbkv.NewRocksdbKV(Params.FlowGraphCheckpointPath)
+		if err != nil {
+			log.Error("failed to open flow graph checkpoint store", zap.String("path", Params.FlowGraphCheckpointPath), zap.Error(err))
+			return err
+		}
+		flowGraphCheckpointKV = checkpointKV
+	}
 	return nil
 }
 
@@ -239,9 +279,19 @@ func (node *DataNode) StartWatchChannels(ctx context.Context) {
 				}
 				return
 			}
+			// vchannels assigned by the same event start concurrently
+			var keys, values []string
 			for _, evt := range event.Events {
+				if evt.Type == clientv3.EventTypePut {
+					keys = append(keys, string(evt.Kv.Key))
+					values = append(values, string(evt.Kv.Value))
+					continue
+				}
 				go node.handleChannelEvt(evt)
 			}
+			if len(keys) > 0 {
+				go node.handleWatchInfos(keys, values)
+			}
 		}
 	}
 }
@@ -255,9 +305,7 @@ func (node *DataNode) checkWatchedList() error {
 	if err != nil {
 		return err
 	}
-	for i, val := range values {
-		node.handleWatchInfo(keys[i], []byte(val))
-	}
+	node.handleWatchInfos(keys, values)
 	return nil
 }
 
@@ -274,64 +322,144 @@ func (node *DataNode) handleChannelEvt(evt *clientv3.Event) {
 }
 
 func (node *DataNode) handleWatchInfo(key string, data []byte) {
+	if err := node.watchChannel(key, data); err != nil {
+		log.Warn("fail to handle ChannelWatchInfo", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// handleWatchInfos handles the ChannelWatchInfos concurrently, so that a vchannel slow or failing to start doesn't
+// block the others. Each vchannel is waited for at most Params.ChannelRecoveryTimeout, vchannels failed or not
+// started in time are reported to DataCoord, the latter keep starting in background
+func (node *DataNode) handleWatchInfos(keys, values []string) {
+	timeout := time.Duration(Params.ChannelRecoveryTimeout) * time.Second
+
+	var mu sync.Mutex
+	channelErrors := make(map[string]string)
+	healthyChannels := make([]string, 0, len(keys))
+	var wg sync.WaitGroup
+	for i := range keys {
+		wg.Add(1)
+		go func(key string, data []byte) {
+			defer wg.Done()
+			done := make(chan error, 1)
+			go func() {
+				done <- node.watchChannel(key, data)
+			}()
+
+			var err error
+			if timeout > 0 {
+				timer := time.NewTimer(timeout)
+				defer timer.Stop()
+				select {
+				case err = <-done:
+				case <-timer.C:
+					err = fmt.Errorf("vchannel not started in %v", timeout)
+				}
+			} else {
+				err = <-done
+			}
+
+			// REF MEP#7 watch path should be [prefix]/channel/{node_id}/{channel_name}
+			channel := path.Base(key)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				log.Warn("fail to handle ChannelWatchInfo", zap.String("key", key), zap.Error(err))
+				channelErrors[channel] = err.Error()
+				return
+			}
+			healthyChannels = append(healthyChannels, channel)
+		}(keys[i], []byte(values[i]))
+	}
+	wg.Wait()
+
+	node.reportDataNodeHealth(channelErrors, healthyChannels)
+}
+
+// reportDataNodeHealth reports the vchannels failed to start and the vchannels started to DataCoord
+func (node *DataNode) reportDataNodeHealth(channelErrors map[string]string, healthyChannels []string) {
+	status, err := node.dataCoord.ReportDataNodeHealth(node.ctx, &datapb.ReportDataNodeHealthRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: node.NodeID,
+		},
+		NodeID:          node.NodeID,
+		ChannelErrors:   channelErrors,
+		HealthyChannels: healthyChannels,
+	})
+	if err == nil && status.GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(status.GetReason())
+	}
+	if err != nil {
+		log.Warn("fail to report data node health", zap.Error(err))
+	}
+}
+
+// watchChannel starts the dataSyncService of the ChannelWatchInfo and marks the watch complete
+func (node *DataNode) watchChannel(key string, data []byte) error {
 	watchInfo := datapb.ChannelWatchInfo{}
 	err := proto.Unmarshal(data, &watchInfo)
 	if err != nil {
-		log.Warn("fail to parse ChannelWatchInfo", zap.String("key", key), zap.Error(err))
-		return
+		return fmt.Errorf("fail to parse ChannelWatchInfo: %w", err)
 	}
 	if watchInfo.State == datapb.ChannelWatchState_Complete {
-		return
+		return nil
 	}
 	if watchInfo.Vchan == nil {
-		log.Warn("found ChannelWatchInfo with nil VChannelInfo", zap.String("key", key))
-		return
+		return errors.New("found ChannelWatchInfo with nil VChannelInfo")
 	}
 	err = node.NewDataSyncService(watchInfo.Vchan)
 	if err != nil {
-		log.Warn("fail to create DataSyncService", zap.String("key", key), zap.Error(err))
-		return
+		return fmt.Errorf("fail to create DataSyncService: %w", err)
 	}
 	watchInfo.State = datapb.ChannelWatchState_Complete
 	v, err := proto.Marshal(&watchInfo)
 	if err != nil {
-		log.Warn("fail to Marshal watchInfo", zap.String("key", key), zap.Error(err))
-		return
+		return fmt.Errorf("fail to Marshal watchInfo: %w", err)
 	}
 	k := path.Join(Params.ChannelWatchSubPath, fmt.Sprintf("%d", node.NodeID), watchInfo.GetVchan().GetChannelName())
 	err = node.watchKv.Save(k, string(v))
 	if err != nil {
-		log.Warn("fail to change WatchState to complete", zap.String("key", key), zap.Error(err))
 		node.ReleaseDataSyncService(key)
+		return fmt.Errorf("fail to change WatchState to complete: %w", err)
 	}
+	return nil
 }
 
 // NewDataSyncService adds a new dataSyncService for new dmlVchannel and starts dataSyncService.
+// dataSyncServices of different vchannels are created concurrently
 func (node *DataNode) NewDataSyncService(vchan *datapb.VchannelInfo) error {
 	node.chanMut.Lock()
-	defer node.chanMut.Unlock()
-	if _, ok := node.vchan2SyncService[vchan.GetChannelName()]; ok {
+	_, ok := node.vchan2SyncService[vchan.GetChannelName()]
+	if ok {
+		node.chanMut.Unlock()
 		return nil
 	}
-
-	replica, err := newReplica(node.ctx, node.rootCoord, vchan.CollectionID)
-	if err != nil {
-		return err
+	if starting, ok := node.startingChannels[vchan.GetChannelName()]; ok {
+		// the vchannel is watched again after released, the dataSyncService being created is kept
+		starting.released = false
+		node.chanMut.Unlock()
+		return nil
 	}
+	starting := &startingChannel{collectionID: vchan.GetCollectionID()}
+	node.startingChannels[vchan.GetChannelName()] = starting
+	node.chanMut.Unlock()
 
-	var alloc allocatorInterface = newAllocator(node.rootCoord)
-
-	log.Debug("Received Vchannel Info",
-		zap.Int("Unflushed Segment Number", len(vchan.GetUnflushedSegments())),
-		zap.Int("Flushed Segment Number", len(vchan.GetFlushedSegments())),
-	)
-
-	flushCh := make(chan flushMsg, 100)
+	dataSyncService, flushCh, err := node.createDataSyncService(vchan)
 
-	dataSyncService, err := newDataSyncService(node.ctx, flushCh, replica, alloc, node.msFactory, vchan, node.clearSignal, node.dataCoord, node.segmentCache, node.blobKv)
+	node.chanMut.Lock()
+	defer node.chanMut.Unlock()
+	delete(node.startingChannels, vchan.GetChannelName())
 	if err != nil {
 		return err
 	}
+	if starting.released {
+		log.Info("vchannel released while its dataSyncService is being created",
+			zap.Int64("Collection ID", vchan.GetCollectionID()),
+			zap.String("Vchannel name", vchan.GetChannelName()),
+		)
+		dataSyncService.close()
+		return nil
+	}
 
 	node.vchan2SyncService[vchan.GetChannelName()] = dataSyncService
 	node.vchan2FlushChs[vchan.GetChannelName()] = flushCh
@@ -345,6 +473,40 @@ func (node *DataNode) NewDataSyncService(vchan *datapb.VchannelInfo) error {
 	return nil
 }
 
+// startingChannel is a vchannel whose dataSyncService is being created outside chanMut
+type startingChannel struct {
+	collectionID UniqueID
+	// released while being created, the dataSyncService is closed instead of started once created
+	released bool
+}
+
+// createDataSyncService creates the dataSyncService of the vchannel and its flush channel, the vchannel is recovered
+// from the segment checkpoints meanwhile
+func (node *DataNode) createDataSyncService(vchan *datapb.VchannelInfo) (*dataSyncService, chan flushMsg, error) {
+	replica, err := newReplica(node.ctx, node.rootCoord, vchan.CollectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alloc allocatorInterface = newAllocator(node.rootCoord)
+
+	log.Debug("Received Vchannel Info",
+		zap.Int("Unflushed Segment Number", len(vchan.GetUnflushedSegments())),
+		zap.Int("Flushed Segment Number", len(vchan.GetFlushedSegments())),
+	)
+
+	flushCh := make(chan flushMsg, 100)
+
+	dataSyncService, err := newDataSyncService(node.ctx, flushCh, replica, alloc, node.msFactory, vchan, node.clearSignal, node.shutdownSignal, node.dataCoord, node.segmentCache, node.blobKv)
+	if err != nil {
+		return nil, nil, err
+	}
+	dataSyncService.saveBinlogLimiter = node.saveBinlogLimiter
+	dataSyncService.dataCoordBreaker = node.dataCoordBreaker
+	dataSyncService.rootCoord = node.rootCoord
+	return dataSyncService, flushCh, nil
+}
+
 // BackGroundGC runs in background to release datanode resources
 func (node *DataNode) BackGroundGC(collIDCh <-chan UniqueID) {
 	log.Info("DataNode Background GC Start")
@@ -356,6 +518,16 @@ func (node *DataNode) BackGroundGC(collIDCh <-chan UniqueID) {
 			for _, vchanName := range node.getChannelNamesbyCollectionID(collID) {
 				node.ReleaseDataSyncService(vchanName)
 			}
+		case signal := <-node.shutdownSignal:
+			log.Warn("release vchannel after unrecoverable failure",
+				zap.Int64("collectionID", signal.collectionID),
+				zap.String("vChannelName", signal.channelName),
+				zap.String("source", signal.source),
+				zap.Any("reason", signal.reason))
+			node.ReleaseDataSyncService(signal.channelName)
+			if signal.restart {
+				node.restartDataSyncService(signal.collectionID, signal.channelName)
+			}
 		case <-node.ctx.Done():
 			log.Info("DataNode ctx done")
 			return
@@ -363,6 +535,41 @@ func (node *DataNode) BackGroundGC(collIDCh <-chan UniqueID) {
 	}
 }
 
+// restartDataSyncService recovers the vchannel released after a flush failure, with the positions fetched from
+// DataCoord again. The vchannel is reported as failed to DataCoord if it's not recovered
+func (node *DataNode) restartDataSyncService(collectionID UniqueID, vchanName string) {
+	err := node.recoverDataSyncService(collectionID, vchanName)
+	if err != nil {
+		log.Warn("failed to restart vchannel", zap.Int64("collectionID", collectionID),
+			zap.String("vChannelName", vchanName), zap.Error(err))
+		node.reportDataNodeHealth(map[string]string{vchanName: err.Error()}, nil)
+		return
+	}
+	log.Info("vchannel restarted", zap.Int64("collectionID", collectionID), zap.String("vChannelName", vchanName))
+	node.reportDataNodeHealth(nil, []string{vchanName})
+}
+
+func (node *DataNode) recoverDataSyncService(collectionID UniqueID, vchanName string) error {
+	resp, err := node.dataCoord.GetRecoveryInfo(node.ctx, &datapb.GetRecoveryInfoRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: node.NodeID,
+		},
+		CollectionID: collectionID,
+	})
+	if err == nil && resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(resp.GetStatus().GetReason())
+	}
+	if err != nil {
+		return err
+	}
+	for _, vchan := range resp.GetChannels() {
+		if vchan.GetChannelName() == vchanName {
+			return node.NewDataSyncService(vchan)
+		}
+	}
+	return fmt.Errorf("vchannel %s not found in collection %d", vchanName, collectionID)
+}
+
 // ReleaseDataSyncService release flowgraph resources for a vchanName
 func (node *DataNode) ReleaseDataSyncService(vchanName string) {
 	log.Info("Release flowgraph resources begin", zap.String("Vchannel", vchanName))
@@ -372,6 +579,9 @@ func (node *DataNode) ReleaseDataSyncService(vchanName string) {
 	if dss, ok := node.vchan2SyncService[vchanName]; ok {
 		dss.close()
 	}
+	if starting, ok := node.startingChannels[vchanName]; ok {
+		starting.released = true
+	}
 
 	delete(node.vchan2SyncService, vchanName)
 	delete(node.vchan2FlushChs, vchanName)
@@ -438,6 +648,12 @@ func (node *DataNode) Start() error {
 
 	go node.compactionExecutor.start(node.ctx)
 
+	if Params.MemPressureHighWatermark > 0 {
+		memoryPressureMonitor = NewMemoryPressureMonitor(time.Duration(Params.MemPressureCheckIntervalMs)*time.Millisecond,
+			uint64(Params.MemPressureHighWatermark))
+		memoryPressureMonitor.Start(node.ctx)
+	}
+
 	Params.CreatedTime = time.Now()
 	Params.UpdatedTime = time.Now()
 
@@ -506,6 +722,11 @@ func (node *DataNode) getChannelNamesbyCollectionID(collID UniqueID) []string {
 			channels = append(channels, name)
 		}
 	}
+	for name, starting := range node.startingChannels {
+		if starting.collectionID == collID {
+			channels = append(channels, name)
+		}
+	}
 	return channels
 }
 
@@ -628,6 +849,10 @@ func (node *DataNode) Stop() error {
 		}
 	}
 
+	if flowGraphCheckpointKV != nil {
+		flowGraphCheckpointKV.Close()
+	}
+
 	if node.closer != nil {
 		err := node.closer.Close()
 		if err != nil {
@@ -764,3 +989,204 @@ func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan
 		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
+
+// FlushAll seals and flushes all the active segments of vchannels in DataNode. It returns after the binlog paths
+//  of the segments are saved by DataCoord, and fails if it takes longer than Params.FlushAllTimeoutSeconds
+func (node *DataNode) FlushAll(ctx context.Context, req *datapb.FlushAllRequest) (*datapb.FlushAllResponse, error) {
+	resp := &datapb.FlushAllResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.isHealthy() {
+		resp.Status.Reason = "DataNode not in HEALTHY state"
+		return resp, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(Params.FlushAllTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	node.chanMut.RLock()
+	services := make([]*dataSyncService, 0, len(node.vchan2SyncService))
+	for _, ds := range node.vchan2SyncService {
+		services = append(services, ds)
+	}
+	node.chanMut.RUnlock()
+
+	log.Debug("Receive FlushAll req", zap.Int("vchannels", len(services)))
+	flushed := make([][]UniqueID, len(services))
+	group, gCtx := errgroup.WithContext(ctx)
+	for i, ds := range services {
+		i, ds := i, ds
+		group.Go(func() error {
+			segmentIDs, err := ds.flushAll(gCtx, req.GetBase())
+			if err != nil {
+				return fmt.Errorf("failed to flush vchannel %s: %w", ds.vchannelName, err)
+			}
+			flushed[i] = segmentIDs
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		log.Warn("FlushAll failed", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	for _, segmentIDs := range flushed {
+		resp.SegmentIDs = append(resp.SegmentIDs, segmentIDs...)
+	}
+	log.Info("FlushAll done", zap.Int64s("segmentIDs", resp.SegmentIDs))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// SampleSegment reads a random sample of rows in the binlogs of the segment, and returns the statistics of fields.
+// The segment must belong to a vchannel in DataNode
+func (node *DataNode) SampleSegment(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	resp := &datapb.SampleSegmentResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+		SegmentID: req.GetSegmentID(),
+	}
+	if !node.isHealthy() {
+		resp.Status.Reason = "DataNode not in HEALTHY state"
+		return resp, nil
+	}
+
+	node.chanMut.RLock()
+	ds, ok := node.vchan2SyncService[req.GetChannel()]
+	node.chanMut.RUnlock()
+	if !ok {
+		log.Warn("failed to sample segment, channel not in this DataNode", zap.Int64("segmentID", req.GetSegmentID()),
+			zap.String("channel", req.GetChannel()))
+		resp.Status.Reason = fmt.Sprintf("channel %s is not in this DataNode", req.GetChannel())
+		return resp, nil
+	}
+
+	sampled, err := sampleSegment(ctx, &binlogIO{node.blobKv, ds.idAllocator}, req, rand.New(rand.NewSource(time.Now().UnixNano())))
+	if err != nil {
+		log.Warn("failed to sample segment", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	log.Debug("sample segment done", zap.Int64("segmentID", req.GetSegmentID()), zap.Int64("numRows", sampled.GetNumRows()),
+		zap.Int64("sampledRows", sampled.GetSampledRows()))
+	sampled.Status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	return sampled, nil
+}
+
+// CancelCompaction stops the executing compaction plan, its result is not reported to DataCoord any more.
+// State replied is PlanCancelled with the output segment if the plan is stopped, the zero value if it's not executing
+func (node *DataNode) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	resp := &datapb.CancelCompactionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.isHealthy() {
+		resp.Status.Reason = "DataNode not in HEALTHY state"
+		return resp, nil
+	}
+
+	task := node.compactionExecutor.stopTask(req.GetPlanID())
+	if task == nil {
+		log.Info("compaction to cancel is not executing", zap.Int64("planID", req.GetPlanID()))
+		resp.Status.ErrorCode = commonpb.ErrorCode_Success
+		return resp, nil
+	}
+
+	log.Info("compaction cancelled", zap.Int64("planID", req.GetPlanID()), zap.Int64("outputSegmentID", task.getOutputSegment()))
+	resp.State = datapb.CompactionPlanState_PlanCancelled
+	resp.SegmentID = task.getOutputSegment()
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// RestoreSegmentFromSnapshot restores the logs of a flushed segment, e.g. corrupted by a bug, to their latest object
+// versions written no later than the timestamp, and registers them to DataCoord again as restored.
+// Blob storage must keep object versions, e.g. MinIO with versioning enabled on the bucket. DataCoord accepts
+// the restored logs only if the admin token of the request is authorized
+func (node *DataNode) RestoreSegmentFromSnapshot(ctx context.Context, req *datapb.RestoreSegmentRequest) (*commonpb.Status, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if !node.isHealthy() {
+		status.Reason = "DataNode not in HEALTHY state"
+		return status, nil
+	}
+	vkv, ok := node.blobKv.(versionedKV)
+	if !ok {
+		status.Reason = "blob storage doesn't keep object versions"
+		return status, nil
+	}
+
+	infoResp, err := node.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_SegmentInfo,
+			SourceID: Params.NodeID,
+		},
+		SegmentIDs: []int64{req.GetSegmentID()},
+	})
+	if err == nil && infoResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(infoResp.GetStatus().GetReason())
+	}
+	if err != nil {
+		log.Warn("failed to get segment to restore", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		status.Reason = err.Error()
+		return status, nil
+	}
+	if len(infoResp.GetInfos()) == 0 {
+		status.Reason = fmt.Sprintf("segment %d not found", req.GetSegmentID())
+		return status, nil
+	}
+	segment := infoResp.GetInfos()[0]
+	if segment.GetState() != commonpb.SegmentState_Flushed {
+		status.Reason = fmt.Sprintf("segment %d is %s, only flushed segment can be restored", req.GetSegmentID(), segment.GetState())
+		return status, nil
+	}
+
+	ts, _ := tsoutil.ParseTS(req.GetTimestamp())
+	restored, err := restoreSegmentBinlogs(vkv, segment, ts)
+	if err != nil {
+		log.Warn("failed to restore segment from snapshot", zap.Int64("segmentID", req.GetSegmentID()),
+			zap.Time("snapshot", ts), zap.Error(err))
+		status.Reason = err.Error()
+		return status, nil
+	}
+
+	saveStatus, err := node.dataCoord.SaveBinlogPaths(util.ForwardAdminToken(ctx), &datapb.SaveBinlogPathsRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.NodeID,
+		},
+		SegmentID:            segment.GetID(),
+		CollectionID:         segment.GetCollectionID(),
+		Field2BinlogPaths:    segment.GetBinlogs(),
+		Field2StatslogPaths:  segment.GetStatslogs(),
+		Field2SketchlogPaths: segment.GetSketchlogs(),
+		Deltalogs:            segment.GetDeltalogs(),
+		IsRestored:           true,
+	})
+	if err == nil && saveStatus.GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(saveStatus.GetReason())
+	}
+	if err != nil {
+		log.Warn("failed to save binlog paths of restored segment", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		// the segment is not marked as restored, the logs are left as they were
+		if rollbackErr := rollbackRestoredObjects(vkv, restored); rollbackErr != nil {
+			log.Warn("failed to roll back restored objects", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(rollbackErr))
+		}
+		status.Reason = err.Error()
+		return status, nil
+	}
+
+	for _, object := range restored {
+		log.Info("object restored", zap.Int64("segmentID", req.GetSegmentID()), zap.String("path", object.path),
+			zap.String("versionID", object.version.VersionID), zap.Time("lastModified", object.version.LastModified))
+	}
+	log.Info("segment restored from snapshot", zap.Int64("segmentID", req.GetSegmentID()), zap.Time("snapshot", ts),
+		zap.Int("restoredObjects", len(restored)))
+	status.ErrorCode = commonpb.ErrorCode_Success
+	return status, nil
+}
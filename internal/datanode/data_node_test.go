@@ -33,7 +33,9 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -41,6 +43,7 @@ import (
 	"go.uber.org/zap"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -296,6 +299,99 @@ func TestDataNode(t *testing.T) {
 
 	})
 
+	t.Run("Test FlushAll", func(t *testing.T) {
+		node := &DataNode{vchan2SyncService: make(map[string]*dataSyncService)}
+		node.State.Store(internalpb.StateCode_Abnormal)
+		resp, err := node.FlushAll(context.TODO(), &datapb.FlushAllRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		// nothing to flush
+		node.State.Store(internalpb.StateCode_Healthy)
+		resp, err = node.FlushAll(context.TODO(), &datapb.FlushAllRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Empty(t, resp.GetSegmentIDs())
+	})
+
+	t.Run("Test SampleSegment", func(t *testing.T) {
+		node := &DataNode{vchan2SyncService: make(map[string]*dataSyncService)}
+		node.State.Store(internalpb.StateCode_Abnormal)
+		resp, err := node.SampleSegment(context.TODO(), &datapb.SampleSegmentRequest{SegmentID: 1, Channel: "ch-1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		// channel not in the DataNode
+		node.State.Store(internalpb.StateCode_Healthy)
+		resp, err = node.SampleSegment(context.TODO(), &datapb.SampleSegmentRequest{SegmentID: 1, Channel: "ch-1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("Test CancelCompaction", func(t *testing.T) {
+		node := &DataNode{compactionExecutor: newCompactionExecutor()}
+		node.State.Store(internalpb.StateCode_Abnormal)
+		resp, err := node.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{PlanID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		// plan not executing
+		node.State.Store(internalpb.StateCode_Healthy)
+		resp, err = node.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{PlanID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, datapb.CompactionPlanState_AllPlanStates, resp.GetState())
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		node.compactionExecutor.executing.Store(UniqueID(1), &compactionTask{ctx: ctx, cancel: cancel, outputSegID: 100})
+		resp, err = node.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{PlanID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, datapb.CompactionPlanState_PlanCancelled, resp.GetState())
+		assert.EqualValues(t, 100, resp.GetSegmentID())
+		assert.Error(t, ctx.Err())
+	})
+
+	t.Run("Test RestoreSegmentFromSnapshot", func(t *testing.T) {
+		dataCoord := &DataCoordFactory{SegmentInfos: []*datapb.SegmentInfo{
+			{ID: 1, State: commonpb.SegmentState_Flushed, Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"insert/1"}}}},
+			{ID: 2, State: commonpb.SegmentState_Growing},
+		}}
+		node := &DataNode{dataCoord: dataCoord, blobKv: memkv.NewMemoryKV()}
+		node.State.Store(internalpb.StateCode_Healthy)
+		restore := func(segmentID UniqueID, snapshot time.Time) *commonpb.Status {
+			ts := tsoutil.ComposeTS(snapshot.UnixNano()/int64(time.Millisecond), 0)
+			status, err := node.RestoreSegmentFromSnapshot(context.TODO(), &datapb.RestoreSegmentRequest{SegmentID: segmentID, Timestamp: ts})
+			assert.NoError(t, err)
+			return status
+		}
+
+		assert.Equal(t, "blob storage doesn't keep object versions", restore(1, time.Now()).GetReason())
+
+		blobKV := newMemVersionedKV()
+		written := time.Now().Add(-time.Hour)
+		blobKV.put("insert/1", []byte("insert"), written)
+		blobKV.put("insert/1", []byte("corrupted"), time.Now())
+		node.blobKv = blobKV
+		assert.Equal(t, "segment 3 not found", restore(3, written).GetReason())
+		assert.Equal(t, "segment 2 is Growing, only flushed segment can be restored", restore(2, written).GetReason())
+
+		status := restore(1, written.Add(time.Second))
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Equal(t, []string{"insert/1@0"}, blobKV.restored)
+		require.Equal(t, 1, len(dataCoord.SaveBinlogPathRequests))
+		assert.True(t, dataCoord.SaveBinlogPathRequests[0].GetIsRestored())
+		assert.Equal(t, []string{"insert/1"}, dataCoord.SaveBinlogPathRequests[0].GetField2BinlogPaths()[0].GetBinlogs())
+
+		// restored objects are rolled back if they fail to be saved
+		dataCoord.SaveBinlogPathNotSuccess = true
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, restore(1, written.Add(time.Second)).GetErrorCode())
+		assert.Equal(t, []string{"insert/1@0", "insert/1@0", "insert/1@1"}, blobKV.restored)
+
+		node.State.Store(internalpb.StateCode_Abnormal)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, restore(1, written.Add(time.Second)).GetErrorCode())
+	})
+
 	t.Run("Test GetTimeTickChannel", func(t *testing.T) {
 		_, err := node.GetTimeTickChannel(node.ctx)
 		assert.NoError(t, err)
@@ -417,6 +513,40 @@ func TestDataNode(t *testing.T) {
 		assert.Nil(t, s)
 	})
 
+	t.Run("Test restart vchannel after flush failure", func(t *testing.T) {
+		dmChannelName := "fake-by-dev-rootcoord-dml-channel-test-restart"
+		vchan := &datapb.VchannelInfo{
+			CollectionID:      1,
+			ChannelName:       dmChannelName,
+			UnflushedSegments: []*datapb.SegmentInfo{},
+		}
+		ds := node.dataCoord.(*DataCoordFactory)
+		ds.RecoveryChannels = []*datapb.VchannelInfo{vchan}
+		defer func() { ds.RecoveryChannels = nil }()
+
+		err := node.NewDataSyncService(vchan)
+		require.NoError(t, err)
+		node.chanMut.RLock()
+		failed := node.vchan2SyncService[dmChannelName]
+		node.chanMut.RUnlock()
+		require.NotNil(t, failed)
+
+		// SaveBinlogPaths fails permanently, the vchannel is recovered from DataCoord instead of panicking
+		ds.SaveBinlogPathStatus = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+		defer func() { ds.SaveBinlogPathStatus = nil }()
+		assert.NotPanics(t, func() {
+			flushNotifyFunc(failed, retry.Attempts(1))(&segmentFlushPack{segmentID: 1})
+		})
+		assert.Eventually(t, func() bool {
+			node.chanMut.RLock()
+			defer node.chanMut.RUnlock()
+			restarted, ok := node.vchan2SyncService[dmChannelName]
+			return ok && restarted != failed
+		}, 5*time.Second, 10*time.Millisecond)
+
+		node.ReleaseDataSyncService(dmChannelName)
+	})
+
 	t.Run("Test GetChannelName", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		node := newIDLEDataNodeMock(ctx)
@@ -545,6 +675,40 @@ func TestWatchChannel(t *testing.T) {
 		assert.False(t, has)
 	})
 
+	t.Run("handle watch infos concurrently", func(t *testing.T) {
+		ch := fmt.Sprintf("datanode-etcd-test-by-dev-rootcoord-dml-channel_%d", rand.Int31())
+		info := &datapb.ChannelWatchInfo{
+			State: datapb.ChannelWatchState_Uncomplete,
+			Vchan: &datapb.VchannelInfo{
+				CollectionID:      1,
+				ChannelName:       ch,
+				UnflushedSegments: []*datapb.SegmentInfo{},
+			},
+		}
+		val, err := proto.Marshal(info)
+		require.NoError(t, err)
+		invalidCh := "datanode-etcd-test-by-dev-rootcoord-dml-channel-invalid"
+		keys := []string{
+			fmt.Sprintf("%s/%d/%s", Params.ChannelWatchSubPath, node.NodeID, ch),
+			fmt.Sprintf("%s/%d/%s", Params.ChannelWatchSubPath, node.NodeID, invalidCh),
+		}
+		node.handleWatchInfos(keys, []string{string(val), string([]byte{23})})
+
+		node.chanMut.RLock()
+		_, has := node.vchan2SyncService[ch]
+		node.chanMut.RUnlock()
+		assert.True(t, has)
+
+		ds := node.dataCoord.(*DataCoordFactory)
+		require.NotEmpty(t, ds.HealthReports)
+		report := ds.HealthReports[len(ds.HealthReports)-1]
+		assert.Equal(t, node.NodeID, report.GetNodeID())
+		assert.Equal(t, []string{ch}, report.GetHealthyChannels())
+		assert.Contains(t, report.GetChannelErrors(), invalidCh)
+
+		node.ReleaseDataSyncService(ch)
+	})
+
 	t.Run("handle watch info failed", func(t *testing.T) {
 		node.handleWatchInfo("test1", []byte{23})
 
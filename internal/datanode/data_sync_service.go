@@ -19,14 +19,22 @@ package datanode
 import (
 	"context"
 	"errors"
+	"sync"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/mqclient"
 
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -42,10 +50,50 @@ type dataSyncService struct {
 	collectionID UniqueID        // collection id of vchan for which this data sync service serves
 	dataCoord    types.DataCoord // DataCoord instance to interact with
 	clearSignal  chan<- UniqueID // signal channel to notify flowgraph close for collection/partition drop msg consumed
+	vchannelName string          // name of the vchannel this data sync service serves
+
+	shutdownCh   chan<- *shutdownSignal // signal channel to notify the vchannel shall be released after unrecoverable failure
+	shutdownOnce sync.Once
+	flushErrCh   chan error // the first flush pack failed permanently, the vchannel is restarted once received
+	// set once a flush pack failed permanently, no more binlog paths are saved until the vchannel is recovered
+	flushFailed atomic.Bool
 
 	flushingSegCache *Cache       // a guarding cache stores currently flushing segment ids
 	flushManager     flushManager // flush manager handles flush process
 	blobKV           kv.BaseKV
+
+	saveBinlogLimiter *tokenBucket // rate limiter of SaveBinlogPaths shared by the DataNode, no limit if nil
+
+	// stops SaveBinlogPaths and pauses ingestion while DataCoord is unreachable, shared by the DataNode, nil if disabled
+	dataCoordBreaker *CircuitBreaker
+
+	ackPublisher *durabilityAckPublisher // publishes flushed positions after binlogs saved, nil if durability ack disabled
+
+	readOnly bool // shadow-reads the vchannel with a shared subscription, binlog paths are never saved
+
+	rootCoord types.RootCoord // polls the collection schema to reload it once changed, never reloaded if nil
+
+	flushBreakers *segmentCircuitBreakers // stops SaveBinlogPaths of segments failing consecutively, nil if disabled
+
+	rebuiltSegments sync.Map // id to start position of segments replayed after incomplete binlogs found, whose next flush replaces the binlogs
+
+	checkpoint *FlowGraphCheckpoint // the position the vchannel recovers from, nil if disabled
+
+	preCreator *segmentPreCreator // allocates segments ahead of time for buffers to split, nil if disabled
+
+	recoveryLimiter *RecoveryRateLimiter // caps replay throughput until caught up with the stream head, nil if unlimited
+
+	vchanInfo      *datapb.VchannelInfo // the vchannel info the service is created with, which Resume restarts from
+	ibNode         *insertBufferNode
+	delNode        *deleteNode        // the last node of the flowgraph
+	nodesMut       sync.RWMutex       // guards the nodes replaced by Resume against GetStatus
+	stopCheckpoint context.CancelFunc // stops persisting the flow graph checkpoint periodically
+	running        atomic.Bool        // whether the flowgraph is started and neither paused nor closed
+
+	pauseMut  sync.Mutex // guards Pause and Resume against each other and close
+	paused    bool
+	resumePos *internalpb.MsgPosition // the position Resume restarts the flowgraph from, nil if no message is processed
+	pausedTs  Timestamp               // end timestamp of the messages processed before paused
 }
 
 func newDataSyncService(ctx context.Context,
@@ -55,6 +103,7 @@ func newDataSyncService(ctx context.Context,
 	factory msgstream.Factory,
 	vchan *datapb.VchannelInfo,
 	clearSignal chan<- UniqueID,
+	shutdownCh chan<- *shutdownSignal,
 	dataCoord types.DataCoord,
 	flushingSegCache *Cache,
 	blobKV kv.BaseKV,
@@ -78,8 +127,16 @@ func newDataSyncService(ctx context.Context,
 		collectionID:     vchan.GetCollectionID(),
 		dataCoord:        dataCoord,
 		clearSignal:      clearSignal,
+		vchannelName:     vchan.GetChannelName(),
+		vchanInfo:        vchan,
+		shutdownCh:       shutdownCh,
+		flushErrCh:       make(chan error, 1),
 		flushingSegCache: flushingSegCache,
 		blobKV:           blobKV,
+		flushBreakers: newSegmentCircuitBreakers(Params.FlushCircuitBreakerThreshold,
+			time.Duration(Params.FlushCircuitBreakerCooldownSeconds)*time.Second),
+		recoveryLimiter: newRecoveryRateLimiter(Params.RecoveryMaxMessagesPerSecond,
+			time.Duration(Params.RecoveryCatchupGapMs)*time.Millisecond, vchan.GetChannelName()),
 	}
 
 	if err := service.initNodes(vchan); err != nil {
@@ -99,6 +156,19 @@ type nodeConfig struct {
 	vChannelName string
 	replica      Replica // Segment replica
 	allocator    allocatorInterface
+	blobKV       kv.BaseKV // blob storage of pending delta logs, no transactional delete if nil
+	checkpoint   *FlowGraphCheckpoint
+	preCreator   *segmentPreCreator // nil if segments are never allocated ahead of time
+
+	segmentAllocator segmentAllocatorFunc // allocates segments for rows inserted into sealed segments, nil if read-only
+
+	recoveryLimiter *RecoveryRateLimiter // nil if replay is unlimited
+
+	ingestionGate func(ctx context.Context) error // blocks ingestion while it's paused, nil if never paused
+
+	readOnly bool // nothing is reported to DataCoord if the vchannel is shadow-read
+
+	reportError func(error) // restarts the vchannel on an error the flowgraph can't recover from
 
 	// defaults
 	parallelConfig
@@ -113,19 +183,394 @@ func (dsService *dataSyncService) start() {
 	if dsService.fg != nil {
 		log.Debug("Data Sync Service starting flowgraph")
 		dsService.fg.Start()
+		dsService.running.Store(true)
 	} else {
 		log.Debug("Data Sync Service flowgraph nil")
 	}
+
+	if dsService.rootCoord != nil && Params.SchemaWatchIntervalSeconds > 0 {
+		go dsService.watchCollectionSchemaChange(newMetaService(dsService.rootCoord, dsService.collectionID),
+			time.Duration(Params.SchemaWatchIntervalSeconds)*time.Second)
+	}
+	dsService.startCheckpoint()
+	go dsService.superviseFlush()
 }
 
+// startCheckpoint persists the flow graph checkpoint every Params.FlowGraphCheckpointIntervalSeconds
+// until the flowgraph is paused or closed
+func (dsService *dataSyncService) startCheckpoint() {
+	ctx, cancel := context.WithCancel(dsService.ctx)
+	dsService.stopCheckpoint = cancel
+	dsService.checkpoint.start(ctx, time.Duration(Params.FlowGraphCheckpointIntervalSeconds)*time.Second)
+}
+
+// shutdownSignal describes an unrecoverable failure of a single vchannel,
+// DataNode releases the dataSyncService of the vchannel and keeps serving the others.
+type shutdownSignal struct {
+	collectionID UniqueID
+	channelName  string
+	source       string      // where the failure happened
+	reason       interface{} // the recovered panic value, or the flush error
+	restart      bool        // recover the vchannel from DataCoord again after released
+}
+
+// panicHandlerFunc handles the value recovered from a panicking goroutine
+type panicHandlerFunc func(source string, r interface{})
+
+// recoverPanic recovers current goroutine from panic and passes the recovered value to the handler.
+// Panic keeps propagating if handler is nil. It must be called directly by defer.
+func recoverPanic(source string, handler panicHandlerFunc) {
+	if handler == nil {
+		return
+	}
+	if r := recover(); r != nil {
+		handler(source, r)
+	}
+}
+
+// handlePanic logs the panic of a goroutine serving this vchannel and notifies the DataNode
+// to release the vchannel, only the first failure is reported.
+func (dsService *dataSyncService) handlePanic(source string, r interface{}) {
+	log.Error("goroutine of data sync service panicked",
+		zap.Int64("collectionID", dsService.collectionID),
+		zap.String("vChannelName", dsService.vchannelName),
+		zap.String("source", source),
+		zap.Any("panic", r),
+		zap.Stack("stack"))
+
+	dsService.notifyShutdown(source, r, false)
+}
+
+// notifyShutdown notifies the DataNode to release the vchannel, and to recover it again if restart,
+// only the first failure is reported
+func (dsService *dataSyncService) notifyShutdown(source string, reason interface{}, restart bool) {
+	dsService.shutdownOnce.Do(func() {
+		if dsService.shutdownCh == nil {
+			return
+		}
+		signal := &shutdownSignal{
+			collectionID: dsService.collectionID,
+			channelName:  dsService.vchannelName,
+			source:       source,
+			reason:       reason,
+			restart:      restart,
+		}
+		select {
+		case dsService.shutdownCh <- signal:
+		case <-dsService.ctx.Done():
+		}
+	})
+}
+
+// errFlushFailed refuses SaveBinlogPaths of a vchannel after a flush pack of it failed permanently
+var errFlushFailed = errors.New("a flush of the vchannel failed permanently")
+
+// reportFlushError marks the service failed and passes a flush pack failed permanently to superviseFlush,
+// only the first error is kept
+func (dsService *dataSyncService) reportFlushError(err error) {
+	dsService.flushFailed.Store(true)
+	select {
+	case dsService.flushErrCh <- err:
+	default:
+	}
+}
+
+// superviseFlush waits for a flush pack failed permanently, and notifies the DataNode to restart the vchannel,
+// which closes this service after the flush tasks queued are drained, and recovers the vchannel from DataCoord.
+// No binlog paths are saved once a pack failed, even of the tasks drained, so the positions saved never pass
+// the binlogs not saved, whose rows are written again by the service recovered from the positions saved
+func (dsService *dataSyncService) superviseFlush() {
+	select {
+	case err := <-dsService.flushErrCh:
+		log.Warn("flush failed permanently, restart the vchannel",
+			zap.Int64("collectionID", dsService.collectionID),
+			zap.String("vChannelName", dsService.vchannelName),
+			zap.Error(err))
+		dsService.notifyShutdown("flush", err, true)
+	case <-dsService.ctx.Done():
+	}
+}
+
+// flushAllCheckInterval is the interval to check whether the segments are flushed in flushAll
+var flushAllCheckInterval = 100 * time.Millisecond
+
+// flushAll sends flush messages of all the active segments into the flowgraph, then waits until the segments
+// are flushed and all the flush tasks are done. Segments already in flushing are not sent again
+func (dsService *dataSyncService) flushAll(ctx context.Context, base *commonpb.MsgBase) ([]UniqueID, error) {
+	segmentIDs := make([]UniqueID, 0)
+	sent := make([]UniqueID, 0)
+	for _, segment := range dsService.replica.filterSegments(dsService.vchannelName, common.InvalidPartitionID) {
+		// only new and normal segments are active
+		if !dsService.replica.hasSegment(segment.segmentID, false) {
+			continue
+		}
+		segmentIDs = append(segmentIDs, segment.segmentID)
+		if dsService.flushingSegCache.checkIfCached(segment.segmentID) {
+			continue
+		}
+		dsService.flushingSegCache.Cache(segment.segmentID)
+
+		select {
+		case dsService.flushCh <- flushMsg{
+			msgID:        base.GetMsgID(),
+			timestamp:    base.GetTimestamp(),
+			segmentID:    segment.segmentID,
+			collectionID: dsService.collectionID,
+			flushed:      true,
+		}:
+			sent = append(sent, segment.segmentID)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	log.Debug("flush all segments of vchannel", zap.String("vchannel", dsService.vchannelName),
+		zap.Int64s("segmentIDs", segmentIDs), zap.Int64s("sent", sent))
+
+	ticker := time.NewTicker(flushAllCheckInterval)
+	defer ticker.Stop()
+	for !dsService.segmentsFlushed(sent) {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	// segments are moved to flushed after SaveBinlogPaths succeeds, waits for the other flush tasks of the vchannel
+	if err := dsService.flushManager.waitForFlushTasks(ctx); err != nil {
+		return nil, err
+	}
+	return segmentIDs, nil
+}
+
+// segmentsFlushed returns true if none of the segments is new or normal in replica
+func (dsService *dataSyncService) segmentsFlushed(segmentIDs []UniqueID) bool {
+	for _, id := range segmentIDs {
+		if dsService.replica.hasSegment(id, false) {
+			return false
+		}
+	}
+	return true
+}
+
+// waitDataCoord blocks while the DataCoord circuit breaker is open, so that neither SaveBinlogPaths is called
+// nor messages are ingested while DataCoord is unreachable
+func (dsService *dataSyncService) waitDataCoord(ctx context.Context) error {
+	if dsService.dataCoordBreaker == nil {
+		return nil
+	}
+	return dsService.dataCoordBreaker.Wait(ctx)
+}
+
+// flushDrainTimeout is the longest time close waits for the flush tasks queued
+var flushDrainTimeout = 10 * time.Second
+
 func (dsService *dataSyncService) close() {
+	dsService.pauseMut.Lock()
+	defer dsService.pauseMut.Unlock()
+	dsService.running.Store(false)
+	if dsService.paused {
+		// the flowgraph and the flush manager are closed by Pause
+		dsService.cancelFn()
+		return
+	}
+
 	if dsService.fg != nil {
 		log.Debug("Data Sync Service closing flowgraph")
 		dsService.fg.Close()
 	}
 
+	// no more flush task is enqueued once the flowgraph is closed, the tasks queued are done before
+	// the ctx they upload binlogs with is cancelled
+	ctx, cancel := context.WithTimeout(dsService.ctx, flushDrainTimeout)
+	if err := dsService.flushManager.waitForFlushTasks(ctx); err != nil {
+		log.Warn("flush tasks not drained before closing", zap.String("vChannelName", dsService.vchannelName),
+			zap.Error(err))
+	}
+	cancel()
+
+	// discards segments allocated ahead of time before the RPCs are cancelled
+	if dsService.preCreator != nil {
+		dsService.preCreator.close()
+	}
 	dsService.cancelFn()
 	dsService.flushManager.close()
+	if dsService.ackPublisher != nil {
+		dsService.ackPublisher.close()
+	}
+	if err := dsService.checkpoint.persist(); err != nil {
+		log.Warn("failed to persist flow graph checkpoint", zap.String("vchannel", dsService.vchannelName), zap.Error(err))
+	}
+}
+
+// Pause flushes all the segments of the vchannel and closes the flowgraph, the replica is kept so that Resume
+// restarts the flowgraph from where it's paused without recovering the vchannel from DataCoord.
+// Pausing a paused service does nothing
+func (dsService *dataSyncService) Pause(ctx context.Context) error {
+	dsService.pauseMut.Lock()
+	defer dsService.pauseMut.Unlock()
+	if dsService.paused {
+		return nil
+	}
+	if err := dsService.ctx.Err(); err != nil {
+		return err
+	}
+
+	// a shadow reader never flushes, what it buffered is replayed after resumed
+	if !dsService.readOnly {
+		if _, err := dsService.flushAll(ctx, &commonpb.MsgBase{MsgType: commonpb.MsgType_Flush}); err != nil {
+			return err
+		}
+	}
+
+	if dsService.fg != nil {
+		dsService.fg.Close()
+	}
+	dsService.running.Store(false)
+	drainCtx, cancel := context.WithTimeout(ctx, flushDrainTimeout)
+	if err := dsService.flushManager.waitForFlushTasks(drainCtx); err != nil {
+		log.Warn("flush tasks not drained before pausing", zap.String("vChannelName", dsService.vchannelName),
+			zap.Error(err))
+	}
+	cancel()
+	if dsService.preCreator != nil {
+		dsService.preCreator.close()
+	}
+	dsService.flushManager.close()
+	if dsService.ackPublisher != nil {
+		dsService.ackPublisher.close()
+	}
+	if dsService.stopCheckpoint != nil {
+		dsService.stopCheckpoint()
+	}
+	if err := dsService.checkpoint.persist(); err != nil {
+		log.Warn("failed to persist flow graph checkpoint", zap.String("vchannel", dsService.vchannelName), zap.Error(err))
+	}
+
+	dsService.pausedTs = dsService.delNode.latestPosition().GetTimestamp()
+	dsService.resumePos = dsService.pausedPosition()
+	dsService.paused = true
+	log.Info("data sync service paused", zap.String("vChannelName", dsService.vchannelName),
+		zap.Uint64("resume position", dsService.resumePos.GetTimestamp()))
+	return nil
+}
+
+// Resume restarts the flowgraph closed by Pause from the position it's paused at.
+// Resuming a service not paused does nothing
+func (dsService *dataSyncService) Resume(ctx context.Context) error {
+	dsService.pauseMut.Lock()
+	defer dsService.pauseMut.Unlock()
+	if !dsService.paused {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := dsService.ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := dsService.initNodes(dsService.resumeVchanInfo()); err != nil {
+		return err
+	}
+	dsService.fg.Start()
+	dsService.running.Store(true)
+	dsService.startCheckpoint()
+	dsService.paused = false
+	log.Info("data sync service resumed", zap.String("vChannelName", dsService.vchannelName),
+		zap.Uint64("resume position", dsService.resumePos.GetTimestamp()))
+	return nil
+}
+
+// DataSyncStatus is a snapshot of the flowgraph of a vchannel
+type DataSyncStatus struct {
+	FlowGraphRunning        bool
+	TailMsgTimestamp        uint64                  // end timestamp of the last message pack processed
+	InsertBufferPendingRows int64                   // rows buffered but not flushed yet, spilled ones included
+	PendingFlushCount       int                     // flush tasks running or waiting in the flush queues
+	LastCheckpointPosition  *internalpb.MsgPosition // nil if the flow graph checkpoint is disabled or nothing is processed
+}
+
+// GetStatus returns the status of the flowgraph, the nodes are read atomically without blocking the flowgraph,
+// nor waiting for it to be paused or resumed
+func (dsService *dataSyncService) GetStatus() DataSyncStatus {
+	dsService.nodesMut.RLock()
+	ibNode, delNode := dsService.ibNode, dsService.delNode
+	dsService.nodesMut.RUnlock()
+
+	status := DataSyncStatus{
+		FlowGraphRunning: dsService.running.Load() && dsService.ctx.Err() == nil,
+	}
+	if delNode != nil {
+		status.TailMsgTimestamp = delNode.latestPosition().GetTimestamp()
+	}
+	if ibNode != nil {
+		status.InsertBufferPendingRows = ibNode.pendingRows.Load()
+		status.PendingFlushCount = ibNode.flushManager.GetPendingFlushCount()
+		status.LastCheckpointPosition = ibNode.checkpoint.position()
+	}
+	return status
+}
+
+// pausedPosition returns the position to restart the flowgraph closed by Pause from. Data buffered after flushAll
+// is dropped with the flowgraph, so new segments holding them are removed from the replica to be replayed from
+// their start positions, and segments flushed partially are rolled back to their checkpoints
+func (dsService *dataSyncService) pausedPosition() *internalpb.MsgPosition {
+	pos := dsService.delNode.latestPosition()
+	earlier := func(p *internalpb.MsgPosition) {
+		if p != nil && (pos == nil || p.GetTimestamp() < pos.GetTimestamp()) {
+			pos = p
+		}
+	}
+	earlier(dsService.delNode.earliestBufferedPosition())
+	for _, segment := range dsService.replica.filterSegments(dsService.vchannelName, common.InvalidPartitionID) {
+		if !dsService.replica.hasSegment(segment.segmentID, false) {
+			continue
+		}
+		if segment.isNew.Load().(bool) {
+			earlier(segment.startPos)
+			dsService.replica.removeSegment(segment.segmentID)
+			continue
+		}
+		cp := segment.checkPoint
+		earlier(&cp.pos)
+		dsService.replica.updateStatistics(segment.segmentID, cp.numRows-segment.numRows)
+	}
+	if pos == nil {
+		return nil
+	}
+	return proto.Clone(pos).(*internalpb.MsgPosition)
+}
+
+// resumeVchanInfo returns the vchannel info to restart the flowgraph closed by Pause with. Segments of the replica
+// are passed as flushed or unflushed ones at their checkpoints, so that messages replayed into them are filtered
+func (dsService *dataSyncService) resumeVchanInfo() *datapb.VchannelInfo {
+	vchanInfo := proto.Clone(dsService.vchanInfo).(*datapb.VchannelInfo)
+	// no message is processed, restarts as the service started
+	if dsService.resumePos == nil {
+		return vchanInfo
+	}
+
+	vchanInfo.SeekPosition = proto.Clone(dsService.resumePos).(*internalpb.MsgPosition)
+	vchanInfo.UnflushedSegments = nil
+	vchanInfo.FlushedSegments = nil
+	for _, segment := range dsService.replica.filterSegments(dsService.vchannelName, common.InvalidPartitionID) {
+		info := &datapb.SegmentInfo{
+			ID:            segment.segmentID,
+			CollectionID:  segment.collectionID,
+			PartitionID:   segment.partitionID,
+			InsertChannel: segment.channelName,
+			NumOfRows:     segment.numRows,
+		}
+		if dsService.replica.hasSegment(segment.segmentID, false) {
+			cp := segment.checkPoint
+			info.DmlPosition = &cp.pos
+			vchanInfo.UnflushedSegments = append(vchanInfo.UnflushedSegments, info)
+		} else {
+			vchanInfo.FlushedSegments = append(vchanInfo.FlushedSegments, info)
+		}
+	}
+	return vchanInfo
 }
 
 // initNodes inits a TimetickedFlowGraph
@@ -136,6 +581,8 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		"PulsarAddress":  Params.PulsarAddress,
 		"ReceiveBufSize": 1024,
 		"PulsarBufSize":  1024,
+
+		"SubscriptionType": Params.PulsarSubscriptionType,
 	}
 
 	err := dsService.msFactory.SetParams(m)
@@ -143,8 +590,44 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		return err
 	}
 
+	// another DataNode may own the vchannel, a shadow reader must not take over its segments
+	if Params.PulsarSubscriptionType == mqclient.Shared || Params.PulsarSubscriptionType == mqclient.Failover {
+		log.Info("data sync service runs read-only", zap.String("vchannel", dsService.vchannelName),
+			zap.Any("subscriptionType", Params.PulsarSubscriptionType))
+		dsService.readOnly = true
+	}
+
+	if Params.EnableDurabilityAck {
+		dsService.ackPublisher, err = newDurabilityAckPublisher(dsService.ctx, dsService.msFactory, dsService.vchannelName, dsService.collectionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// a shadow reader never saves binlogs, its position is never safe to recover from
+	if !dsService.readOnly {
+		dsService.checkpoint = newFlowGraphCheckpoint(dsService.vchannelName, flowGraphCheckpointKV, dsService.replica)
+	}
+
 	// initialize flush manager for DataSync Service
-	dsService.flushManager = NewRendezvousFlushManager(dsService.idAllocator, dsService.blobKV, dsService.replica, flushNotifyFunc(dsService))
+	fm := NewRendezvousFlushManager(dsService.idAllocator, dsService.blobKV, dsService.replica, flushNotifyFunc(dsService))
+	fm.panicHandler = dsService.handlePanic
+	fm.ctx = dsService.ctx
+	fm.readOnly = dsService.readOnly
+	if !dsService.readOnly {
+		fm.segmentAllocator = dsService.allocSegments
+		if Params.SegmentPreCreateThreshold > 0 {
+			dsService.preCreator = newSegmentPreCreator(dsService.replica, dsService.allocSegments, dsService.discardSegment)
+			fm.segmentAllocator = dsService.preCreator.allocate
+		}
+	}
+	dsService.flushManager = fm
+
+	// segments with incomplete binlogs left by a crash in the middle of a flush are replayed from their start positions
+	vchanInfo, err = dsService.rewindPartialSegments(vchanInfo)
+	if err != nil {
+		return err
+	}
 
 	// recover segment checkpoints
 	for _, us := range vchanInfo.GetUnflushedSegments() {
@@ -158,6 +641,10 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 			)
 			continue
 		}
+		// segments are kept in the replica while the flowgraph is paused
+		if dsService.replica.hasSegment(us.GetID(), true) {
+			continue
+		}
 
 		log.Info("Recover Segment NumOfRows form checkpoints",
 			zap.String("InsertChannel", us.GetInsertChannel()),
@@ -181,6 +668,9 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 			)
 			continue
 		}
+		if dsService.replica.hasSegment(fs.GetID(), true) {
+			continue
+		}
 
 		log.Info("Recover Segment NumOfRows form checkpoints",
 			zap.String("InsertChannel", fs.GetInsertChannel()),
@@ -199,19 +689,50 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		vChannelName: vchanInfo.GetChannelName(),
 		replica:      dsService.replica,
 		allocator:    dsService.idAllocator,
+		blobKV:       dsService.blobKV,
+		checkpoint:   dsService.checkpoint,
+		preCreator:   dsService.preCreator,
+
+		segmentAllocator: fm.segmentAllocator,
+
+		recoveryLimiter: dsService.recoveryLimiter,
+		ingestionGate:   dsService.waitDataCoord,
+
+		readOnly:    dsService.readOnly,
+		reportError: dsService.reportFlushError,
 
 		parallelConfig: newParallelConfig(),
 	}
 
+	seekPos := vchanInfo.GetSeekPosition()
+	if dsService.checkpoint != nil {
+		if pos := loadFlowGraphCheckpoint(flowGraphCheckpointKV, vchanInfo); pos != nil {
+			if segmentID, ok := dsService.rebuiltBefore(pos); ok {
+				log.Info("flow graph checkpoint ignored for segment replayed from its start position",
+					zap.String("vchannel", dsService.vchannelName), zap.Int64("segmentID", segmentID),
+					zap.Uint64("checkpoint", pos.GetTimestamp()))
+			} else {
+				log.Info("data sync service recovers from flow graph checkpoint", zap.String("vchannel", dsService.vchannelName),
+					zap.Uint64("checkpoint", pos.GetTimestamp()), zap.Uint64("seek position", seekPos.GetTimestamp()))
+				seekPos = pos
+			}
+		}
+	}
+	dsService.confirmRebuiltSegments(seekPos)
+
 	var dmStreamNode Node
-	dmStreamNode, err = newDmInputNode(dsService.ctx, vchanInfo.GetSeekPosition(), c)
+	dmStreamNode, err = newDmInputNode(dsService.ctx, seekPos, c)
 	if err != nil {
 		return err
 	}
 
-	var ddNode Node = newDDNode(dsService.ctx, dsService.collectionID, vchanInfo, dsService.msFactory)
-	var insertBufferNode Node
-	insertBufferNode, err = newInsertBufferNode(
+	dd := newDDNode(dsService.ctx, dsService.collectionID, vchanInfo, dsService.msFactory)
+	if dd != nil {
+		// insert messages processed before paused are replayed from the resume position
+		dd.replayedTs = dsService.pausedTs
+	}
+	var ddNode Node = dd
+	ibNode, err := newInsertBufferNode(
 		dsService.ctx,
 		dsService.flushCh,
 		dsService.flushManager,
@@ -221,12 +742,23 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 	if err != nil {
 		return err
 	}
+	var insertBufferNode Node = ibNode
 
-	var deleteNode Node
-	deleteNode, err = newDeleteNode(dsService.ctx, dsService.flushManager, dsService.clearSignal, c)
+	delNode, err := newDeleteNode(dsService.ctx, dsService.flushManager, dsService.clearSignal, c)
 	if err != nil {
 		return err
 	}
+	var deleteNode Node = delNode
+
+	dsService.nodesMut.Lock()
+	dsService.ibNode, dsService.delNode = ibNode, delNode
+	dsService.nodesMut.Unlock()
+
+	// panics inside nodes are isolated within this vchannel
+	dmStreamNode = newRecoverableNode(dmStreamNode, dsService.handlePanic)
+	ddNode = newRecoverableNode(ddNode, dsService.handlePanic)
+	insertBufferNode = newRecoverableNode(insertBufferNode, dsService.handlePanic)
+	deleteNode = newRecoverableNode(deleteNode, dsService.handlePanic)
 
 	dsService.fg.AddNode(dmStreamNode)
 	dsService.fg.AddNode(ddNode)
@@ -20,11 +20,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"errors"
 	"math"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/common"
@@ -34,6 +39,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/mqclient"
 )
 
 func getVchanInfo(info *testInfo) *datapb.VchannelInfo {
@@ -145,6 +152,7 @@ func TestDataSyncService_newDataSyncService(te *testing.T) {
 				test.inMsgFactory,
 				getVchanInfo(test),
 				make(chan UniqueID),
+				make(chan *shutdownSignal),
 				df,
 				newCache(),
 				memkv.NewMemoryKV(),
@@ -166,6 +174,40 @@ func TestDataSyncService_newDataSyncService(te *testing.T) {
 
 }
 
+func TestDataSyncService_ReadOnly(t *testing.T) {
+	defer func(origin mqclient.SubscriptionType) { Params.PulsarSubscriptionType = origin }(Params.PulsarSubscriptionType)
+
+	cases := []struct {
+		subscriptionType mqclient.SubscriptionType
+		readOnly         bool
+	}{
+		{mqclient.Exclusive, false},
+		{mqclient.Shared, true},
+		{mqclient.Failover, true},
+		{mqclient.KeyShared, false},
+	}
+	for _, c := range cases {
+		Params.PulsarSubscriptionType = c.subscriptionType
+		replica, err := newReplica(context.Background(), &RootCoordFactory{}, 1)
+		require.NoError(t, err)
+
+		ds, err := newDataSyncService(context.Background(),
+			make(chan flushMsg),
+			replica,
+			NewAllocatorFactory(),
+			&mockMsgStreamFactory{true, true},
+			getVchanInfo(&testInfo{collID: 1, chanName: "by-dev-rootcoord-dml-test_v1"}),
+			make(chan UniqueID),
+			make(chan *shutdownSignal),
+			&DataCoordFactory{},
+			newCache(),
+			memkv.NewMemoryKV(),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, c.readOnly, ds.readOnly)
+	}
+}
+
 // NOTE: start pulsar before test
 func TestDataSyncService_Start(t *testing.T) {
 	t.Skip()
@@ -224,7 +266,7 @@ func TestDataSyncService_Start(t *testing.T) {
 	}
 
 	signalCh := make(chan UniqueID, 100)
-	sync, err := newDataSyncService(ctx, flushChan, replica, allocFactory, msFactory, vchan, signalCh, &DataCoordFactory{}, newCache(), memkv.NewMemoryKV())
+	sync, err := newDataSyncService(ctx, flushChan, replica, allocFactory, msFactory, vchan, signalCh, make(chan *shutdownSignal, 1), &DataCoordFactory{}, newCache(), memkv.NewMemoryKV())
 
 	assert.Nil(t, err)
 	// sync.replica.addCollection(collMeta.ID, collMeta.Schema)
@@ -361,3 +403,508 @@ func TestBytesReader(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, int8(100), dataInt8)
 }
+
+// mockTickNode is an input node counts its Operate calls, panics on every call if panicking is set
+type mockTickNode struct {
+	BaseNode
+	name      string
+	panicking bool
+	count     atomic.Int64
+}
+
+func (n *mockTickNode) Name() string      { return n.name }
+func (n *mockTickNode) IsInputNode() bool { return true }
+func (n *mockTickNode) Start()            {}
+func (n *mockTickNode) Close()            {}
+
+func (n *mockTickNode) Operate(in []Msg) []Msg {
+	time.Sleep(time.Millisecond)
+	if n.panicking {
+		panic("mock panic in flowgraph")
+	}
+	n.count.Inc()
+	return []Msg{}
+}
+
+func TestDataSyncService_PanicIsolation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	shutdownCh := make(chan *shutdownSignal, 2)
+	newService := func(vchannel string, panicking bool) (*dataSyncService, *mockTickNode) {
+		ds := &dataSyncService{
+			ctx:          ctx,
+			collectionID: 1,
+			vchannelName: vchannel,
+			shutdownCh:   shutdownCh,
+			fg:           flowgraph.NewTimeTickedFlowGraph(ctx),
+		}
+		node := &mockTickNode{name: vchannel, panicking: panicking}
+		ds.fg.AddNode(newRecoverableNode(node, ds.handlePanic))
+		err := ds.fg.SetEdges(node.Name(), []string{}, []string{})
+		assert.NoError(t, err)
+		return ds, node
+	}
+
+	panicDs, _ := newService("by-dev-rootcoord-dml-panic", true)
+	healthyDs, healthyNode := newService("by-dev-rootcoord-dml-healthy", false)
+	defer healthyDs.fg.Close()
+
+	panicDs.start()
+	healthyDs.start()
+
+	select {
+	case signal := <-shutdownCh:
+		assert.Equal(t, "by-dev-rootcoord-dml-panic", signal.channelName)
+		assert.EqualValues(t, 1, signal.collectionID)
+		assert.Equal(t, "mock panic in flowgraph", signal.reason)
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "shutdown signal not received")
+	}
+	panicDs.fg.Close()
+
+	// the other vchannel keeps processing
+	processed := healthyNode.count.Load()
+	assert.Eventually(t, func() bool {
+		return healthyNode.count.Load() > processed
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// only the first failure of a vchannel is reported
+	assert.Equal(t, 0, len(shutdownCh))
+}
+
+func TestDataSyncService_SuperviseFlush(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	shutdownCh := make(chan *shutdownSignal, 2)
+	ds := &dataSyncService{
+		ctx:          ctx,
+		collectionID: 1,
+		vchannelName: "by-dev-rootcoord-dml-flush-failure",
+		flushManager: &mockFlushManager{},
+		shutdownCh:   shutdownCh,
+		flushErrCh:   make(chan error, 1),
+	}
+	go ds.superviseFlush()
+
+	ds.reportFlushError(errors.New("mocked flush error"))
+	select {
+	case signal := <-shutdownCh:
+		assert.Equal(t, "by-dev-rootcoord-dml-flush-failure", signal.channelName)
+		assert.EqualValues(t, 1, signal.collectionID)
+		assert.Equal(t, "flush", signal.source)
+		assert.True(t, signal.restart)
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "shutdown signal not received")
+	}
+
+	// only the first failure of a vchannel is reported
+	assert.NotPanics(t, func() {
+		ds.reportFlushError(errors.New("mocked flush error"))
+		ds.reportFlushError(errors.New("mocked flush error"))
+	})
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 0, len(shutdownCh))
+}
+
+func TestDataSyncService_CloseDrainsFlushTasks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var notified atomic.Int64
+	fm := NewRendezvousFlushManager(&allocator{}, NewInMemoryKV(0), newMockReplica(), func(*segmentFlushPack) {
+		notified.Inc()
+	})
+	fm.ctx = ctx
+	ds := &dataSyncService{
+		ctx:          ctx,
+		cancelFn:     cancel,
+		flushManager: fm,
+	}
+
+	// uploads of the insert data take a while, deletes are enqueued after them
+	task := &blockingFlushTask{unblock: make(chan struct{})}
+	for i := 0; i < 10; i++ {
+		pos := &internalpb.MsgPosition{MsgID: []byte{byte(i)}}
+		fm.getFlushQueue(UniqueID(i%3)).enqueueInsertFlush(task, map[UniqueID]string{}, map[UniqueID]string{},
+			map[UniqueID]string{}, false, false, pos)
+		_, err := fm.flushDelData(nil, UniqueID(i%3), pos)
+		require.NoError(t, err)
+	}
+	time.AfterFunc(50*time.Millisecond, func() { close(task.unblock) })
+
+	ds.close()
+	assert.EqualValues(t, 10, notified.Load())
+	assert.Error(t, ctx.Err())
+}
+
+func TestDataNode_RestartDataSyncService(t *testing.T) {
+	dataCoord := &DataCoordFactory{}
+	node := &DataNode{
+		ctx:               context.Background(),
+		NodeID:            1,
+		dataCoord:         dataCoord,
+		vchan2SyncService: make(map[string]*dataSyncService),
+		vchan2FlushChs:    make(map[string]chan flushMsg),
+		startingChannels:  make(map[string]*startingChannel),
+	}
+
+	// the vchannel is reported as failed if it's not recovered
+	node.restartDataSyncService(1, "by-dev-rootcoord-dml-not-found")
+	require.Equal(t, 1, len(dataCoord.HealthReports))
+	assert.Contains(t, dataCoord.HealthReports[0].GetChannelErrors(), "by-dev-rootcoord-dml-not-found")
+	assert.Empty(t, node.vchan2SyncService)
+}
+
+func TestDataNode_ReleaseStartingDataSyncService(t *testing.T) {
+	vchannel := "by-dev-rootcoord-dml-starting"
+	starting := &startingChannel{collectionID: 1}
+	node := &DataNode{
+		ctx:               context.Background(),
+		vchan2SyncService: make(map[string]*dataSyncService),
+		vchan2FlushChs:    make(map[string]chan flushMsg),
+		startingChannels:  map[string]*startingChannel{vchannel: starting},
+	}
+
+	// the collection dropped is released with the vchannels being started
+	assert.ElementsMatch(t, []string{vchannel}, node.getChannelNamesbyCollectionID(1))
+	assert.Empty(t, node.getChannelNamesbyCollectionID(2))
+
+	node.ReleaseDataSyncService(vchannel)
+	assert.True(t, starting.released)
+
+	// watched again before the dataSyncService is created
+	err := node.NewDataSyncService(&datapb.VchannelInfo{CollectionID: 1, ChannelName: vchannel})
+	assert.NoError(t, err)
+	assert.False(t, starting.released)
+	assert.Empty(t, node.vchan2SyncService)
+}
+
+func TestDataSyncService_FlushAll(t *testing.T) {
+	newService := func(t *testing.T) *dataSyncService {
+		replica, err := newReplica(context.TODO(), &RootCoordFactory{}, 1)
+		assert.NoError(t, err)
+		vchannel := "by-dev-rootcoord-dml-flush-all"
+		assert.NoError(t, replica.addNewSegment(1, 1, 1, vchannel, &internalpb.MsgPosition{}, &internalpb.MsgPosition{}))
+		assert.NoError(t, replica.addNewSegment(2, 1, 1, vchannel, &internalpb.MsgPosition{}, &internalpb.MsgPosition{}))
+		assert.NoError(t, replica.addNewSegment(3, 1, 1, vchannel, &internalpb.MsgPosition{}, &internalpb.MsgPosition{}))
+		replica.segmentFlushed(3)
+		return &dataSyncService{
+			ctx:              context.TODO(),
+			collectionID:     1,
+			vchannelName:     vchannel,
+			flushCh:          make(chan flushMsg, 100),
+			replica:          replica,
+			flushingSegCache: newCache(),
+			flushManager:     &mockFlushManager{},
+		}
+	}
+
+	t.Run("segments flushed", func(t *testing.T) {
+		ds := newService(t)
+		// segment 2 is flushing already
+		ds.flushingSegCache.Cache(2)
+
+		received := make(chan flushMsg, 10)
+		go func() {
+			for msg := range ds.flushCh {
+				received <- msg
+				ds.replica.segmentFlushed(msg.segmentID)
+			}
+		}()
+		defer close(ds.flushCh)
+
+		segmentIDs, err := ds.flushAll(context.TODO(), &commonpb.MsgBase{MsgID: 10})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []UniqueID{1, 2}, segmentIDs)
+		assert.Equal(t, 1, len(received))
+		msg := <-received
+		assert.EqualValues(t, 1, msg.segmentID)
+		assert.EqualValues(t, 10, msg.msgID)
+		assert.True(t, msg.flushed)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ds := newService(t)
+		ctx, cancel := context.WithTimeout(context.TODO(), 200*time.Millisecond)
+		defer cancel()
+		_, err := ds.flushAll(ctx, &commonpb.MsgBase{})
+		assert.Error(t, err)
+		// flush messages are sent but segments are never flushed
+		assert.Equal(t, 2, len(ds.flushCh))
+	})
+}
+
+// logMsgStreamFactory creates msgstreams consuming the message packs produced into a log in memory. The position of a
+// pack is its index in the log, seeking to a position consumes from the pack at it like a real msgstream
+type logMsgStreamFactory struct {
+	mockMsgStreamFactory
+	mu    sync.Mutex
+	cond  *sync.Cond
+	packs []*msgstream.MsgPack
+}
+
+func newLogMsgStreamFactory() *logMsgStreamFactory {
+	f := &logMsgStreamFactory{mockMsgStreamFactory: mockMsgStreamFactory{true, true}}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+func (f *logMsgStreamFactory) NewTtMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	return &logMsgStream{factory: f}, nil
+}
+
+// produce appends a message pack of the messages ending at ts
+func (f *logMsgStreamFactory) produce(ts Timestamp, msgs ...msgstream.TsMsg) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	pos := &internalpb.MsgPosition{MsgID: []byte(strconv.Itoa(len(f.packs))), Timestamp: ts}
+	f.packs = append(f.packs, &msgstream.MsgPack{
+		BeginTs:        ts,
+		EndTs:          ts,
+		Msgs:           msgs,
+		StartPositions: []*internalpb.MsgPosition{pos},
+		EndPositions:   []*internalpb.MsgPosition{pos},
+	})
+	f.cond.Broadcast()
+}
+
+type logMsgStream struct {
+	mockTtMsgStream
+	factory *logMsgStreamFactory
+	next    int
+	closed  bool
+}
+
+func (s *logMsgStream) Seek(positions []*internalpb.MsgPosition) error {
+	s.factory.mu.Lock()
+	defer s.factory.mu.Unlock()
+	if next, err := strconv.Atoi(string(positions[0].GetMsgID())); err == nil {
+		s.next = next
+	}
+	return nil
+}
+
+func (s *logMsgStream) Consume() *msgstream.MsgPack {
+	s.factory.mu.Lock()
+	defer s.factory.mu.Unlock()
+	for !s.closed && s.next >= len(s.factory.packs) {
+		s.factory.cond.Wait()
+	}
+	if s.closed {
+		return nil
+	}
+	s.next++
+	return s.factory.packs[s.next-1]
+}
+
+func (s *logMsgStream) Close() {
+	s.factory.mu.Lock()
+	defer s.factory.mu.Unlock()
+	s.closed = true
+	s.factory.cond.Broadcast()
+}
+
+// newMemReplica returns a replica of the collection loading stats logs from memory instead of MinIO
+func newMemReplica(collID UniqueID) *SegmentReplica {
+	return &SegmentReplica{
+		collectionID:    collID,
+		newSegments:     make(map[UniqueID]*Segment),
+		normalSegments:  make(map[UniqueID]*Segment),
+		flushedSegments: make(map[UniqueID]*Segment),
+		metaService:     newMetaService(&RootCoordFactory{collectionID: collID}, collID),
+		minIOKV:         memkv.NewMemoryKV(),
+	}
+}
+
+func TestDataSyncService_PauseResume(t *testing.T) {
+	const vchannel = "by-dev-rootcoord-dml-pause-resume_0v0"
+	factory := newLogMsgStreamFactory()
+	replica := newMemReplica(0)
+	vchan := &datapb.VchannelInfo{ChannelName: vchannel, SeekPosition: &internalpb.MsgPosition{}}
+	ds, err := newDataSyncService(context.Background(), make(chan flushMsg, 100), replica, NewAllocatorFactory(), factory,
+		vchan, make(chan UniqueID, 1), make(chan *shutdownSignal, 1), &DataCoordFactory{}, newCache(), memkv.NewMemoryKV())
+	require.NoError(t, err)
+	ds.start()
+	defer ds.close()
+
+	df := NewDataFactory()
+	// time ticks are produced concurrently while pausing
+	var mu sync.Mutex
+	ts := Timestamp(1000)
+	insert := func(segmentID UniqueID, rows int) {
+		mu.Lock()
+		defer mu.Unlock()
+		msgs := make([]msgstream.TsMsg, 0, rows)
+		for i := 0; i < rows; i++ {
+			ts++
+			msg := df.GenMsgStreamInsertMsg(int(ts), vchannel)
+			msg.SegmentID = segmentID
+			msg.BeginTimestamp, msg.EndTimestamp = ts, ts
+			msg.Timestamps = []Timestamp{ts}
+			msgs = append(msgs, msg)
+		}
+		factory.produce(ts, msgs...)
+	}
+	tick := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		ts++
+		factory.produce(ts)
+	}
+	countRows := func() int64 {
+		var rows int64
+		for _, segmentID := range replica.listAllSegmentIDs() {
+			stats, err := replica.getSegmentStatisticsUpdates(segmentID)
+			require.NoError(t, err)
+			rows += stats.GetNumRows()
+		}
+		return rows
+	}
+
+	insert(1, 2)
+	insert(1, 3)
+	tick()
+	require.Eventually(t, func() bool { return countRows() == 5 }, 5*time.Second, 10*time.Millisecond)
+
+	// time ticks keep coming, the flush messages of flushAll are processed with them
+	ticking := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticking:
+				return
+			case <-time.After(20 * time.Millisecond):
+				tick()
+			}
+		}
+	}()
+	errCh := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() { errCh <- ds.Pause(context.Background()) }()
+	}
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, <-errCh)
+	}
+	close(ticking)
+	assert.True(t, ds.paused)
+	assert.False(t, replica.hasSegment(1, false))
+	assert.EqualValues(t, 5, countRows())
+
+	// messages produced while paused are consumed after resumed, and those processed are not counted again
+	insert(2, 4)
+	for i := 0; i < 2; i++ {
+		go func() { errCh <- ds.Resume(context.Background()) }()
+	}
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, <-errCh)
+	}
+	assert.False(t, ds.paused)
+	tick()
+	require.Eventually(t, func() bool { return countRows() == 9 }, 5*time.Second, 10*time.Millisecond)
+	insert(2, 1)
+	tick()
+	require.Eventually(t, func() bool { return countRows() == 10 }, 5*time.Second, 10*time.Millisecond)
+	stats, err := replica.getSegmentStatisticsUpdates(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, stats.GetNumRows())
+}
+
+func TestDataSyncService_PausedPosition(t *testing.T) {
+	const vchannel = "by-dev-rootcoord-dml-paused-position_0v0"
+	replica := newMemReplica(1)
+	ds := &dataSyncService{
+		replica:      replica,
+		vchannelName: vchannel,
+		vchanInfo:    &datapb.VchannelInfo{CollectionID: 1, ChannelName: vchannel},
+		delNode:      &deleteNode{},
+	}
+	// nothing is processed
+	assert.Nil(t, ds.pausedPosition())
+
+	ds.delNode.lastPosition.Store(&internalpb.MsgPosition{Timestamp: 100})
+	// segment 1 is flushed, segment 2 is buffered after flushAll, segment 3 is flushed partially after flushAll
+	require.NoError(t, replica.addFlushedSegment(1, 1, 1, vchannel, 10, nil))
+	require.NoError(t, replica.addNewSegment(2, 1, 1, vchannel, &internalpb.MsgPosition{Timestamp: 90}, &internalpb.MsgPosition{Timestamp: 100}))
+	replica.updateStatistics(2, 5)
+	require.NoError(t, replica.addNormalSegment(3, 1, 1, vchannel, 10, nil, &segmentCheckPoint{10, internalpb.MsgPosition{Timestamp: 80}}))
+	replica.updateStatistics(3, 5)
+
+	ds.resumePos = ds.pausedPosition()
+	assert.EqualValues(t, 80, ds.resumePos.GetTimestamp())
+	assert.False(t, replica.hasSegment(2, true))
+	stats, err := replica.getSegmentStatisticsUpdates(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, stats.GetNumRows())
+
+	vchanInfo := ds.resumeVchanInfo()
+	assert.EqualValues(t, 80, vchanInfo.GetSeekPosition().GetTimestamp())
+	require.Equal(t, 1, len(vchanInfo.GetFlushedSegments()))
+	assert.EqualValues(t, 1, vchanInfo.GetFlushedSegments()[0].GetID())
+	require.Equal(t, 1, len(vchanInfo.GetUnflushedSegments()))
+	assert.EqualValues(t, 3, vchanInfo.GetUnflushedSegments()[0].GetID())
+	assert.EqualValues(t, 80, vchanInfo.GetUnflushedSegments()[0].GetDmlPosition().GetTimestamp())
+
+	// deletes buffered after flushAll are replayed too
+	buf := newDelDataBuf()
+	buf.startPos = &internalpb.MsgPosition{Timestamp: 70}
+	ds.delNode.delBuf.Store(UniqueID(1), buf)
+	assert.EqualValues(t, 70, ds.pausedPosition().GetTimestamp())
+}
+
+func TestDataSyncService_GetStatus(t *testing.T) {
+	const vchannel = "by-dev-rootcoord-dml-get-status_0v0"
+	// the flow graph checkpoint is enabled for the position of it reported
+	interval := Params.FlowGraphCheckpointIntervalSeconds
+	Params.FlowGraphCheckpointIntervalSeconds = 1
+	flowGraphCheckpointKV = memkv.NewMemoryKV()
+	defer func() {
+		Params.FlowGraphCheckpointIntervalSeconds = interval
+		flowGraphCheckpointKV = nil
+	}()
+	factory := newLogMsgStreamFactory()
+	replica := newMemReplica(0)
+	vchan := &datapb.VchannelInfo{ChannelName: vchannel, SeekPosition: &internalpb.MsgPosition{}}
+	ds, err := newDataSyncService(context.Background(), make(chan flushMsg, 100), replica, NewAllocatorFactory(), factory,
+		vchan, make(chan UniqueID, 1), make(chan *shutdownSignal, 1), &DataCoordFactory{}, newCache(), memkv.NewMemoryKV())
+	require.NoError(t, err)
+	assert.False(t, ds.GetStatus().FlowGraphRunning)
+	ds.start()
+	assert.True(t, ds.GetStatus().FlowGraphRunning)
+
+	df := NewDataFactory()
+	msgs := make([]msgstream.TsMsg, 0, 3)
+	for ts := Timestamp(1001); ts <= 1003; ts++ {
+		msg := df.GenMsgStreamInsertMsg(int(ts), vchannel)
+		msg.SegmentID = 1
+		msg.BeginTimestamp, msg.EndTimestamp = ts, ts
+		msg.Timestamps = []Timestamp{ts}
+		msgs = append(msgs, msg)
+	}
+	factory.produce(1003, msgs...)
+	factory.produce(1004)
+	require.Eventually(t, func() bool { return ds.GetStatus().TailMsgTimestamp == 1004 }, 5*time.Second, 10*time.Millisecond)
+	status := ds.GetStatus()
+	assert.EqualValues(t, 3, status.InsertBufferPendingRows)
+	assert.Equal(t, 0, status.PendingFlushCount)
+	// the checkpoint is held back by the rows buffered and not saved yet
+	assert.EqualValues(t, 1003, status.LastCheckpointPosition.GetTimestamp())
+
+	// the rows buffered are no longer pending once flushed
+	go func() {
+		for ts := Timestamp(1005); ts < 1100; ts++ {
+			factory.produce(ts)
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	_, err = ds.flushAll(context.Background(), &commonpb.MsgBase{MsgType: commonpb.MsgType_Flush})
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, ds.GetStatus().InsertBufferPendingRows)
+
+	require.NoError(t, ds.Pause(context.Background()))
+	assert.False(t, ds.GetStatus().FlowGraphRunning)
+	require.NoError(t, ds.Resume(context.Background()))
+	assert.True(t, ds.GetStatus().FlowGraphRunning)
+
+	ds.close()
+	assert.False(t, ds.GetStatus().FlowGraphRunning)
+}
@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import "sort"
+
+// DeleteDeduplicator keeps the latest delete timestamp of each primary key,
+// deletes of a key at earlier timestamps are covered by the latest one
+type DeleteDeduplicator struct {
+	pkToTs map[int64]Timestamp
+}
+
+func newDeleteDeduplicator() *DeleteDeduplicator {
+	return &DeleteDeduplicator{
+		pkToTs: make(map[int64]Timestamp),
+	}
+}
+
+// Add records the delete of pk at ts, returns true if pk is not recorded before
+func (d *DeleteDeduplicator) Add(pk int64, ts Timestamp) bool {
+	prev, ok := d.pkToTs[pk]
+	if !ok || ts > prev {
+		d.pkToTs[pk] = ts
+	}
+	return !ok
+}
+
+// Len returns the number of distinct primary keys recorded
+func (d *DeleteDeduplicator) Len() int {
+	return len(d.pkToTs)
+}
+
+// DeleteData returns the delete data holding one entry of each primary key with its latest timestamp,
+// sorted by primary key
+func (d *DeleteDeduplicator) DeleteData() *DeleteData {
+	pks := make([]int64, 0, len(d.pkToTs))
+	for pk := range d.pkToTs {
+		pks = append(pks, pk)
+	}
+	sort.Slice(pks, func(i, j int) bool { return pks[i] < pks[j] })
+
+	data := &DeleteData{
+		Pks: pks,
+		Tss: make([]Timestamp, 0, len(pks)),
+	}
+	for _, pk := range pks {
+		data.Tss = append(data.Tss, d.pkToTs[pk])
+	}
+	data.RowCount = int64(len(pks))
+	return data
+}
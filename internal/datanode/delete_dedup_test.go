@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteDeduplicator(t *testing.T) {
+	d := newDeleteDeduplicator()
+	assert.Equal(t, 0, d.Len())
+	assert.Equal(t, int64(0), d.DeleteData().RowCount)
+
+	assert.True(t, d.Add(2, 100))
+	assert.True(t, d.Add(1, 200))
+	assert.False(t, d.Add(2, 300))
+	// an earlier delete is covered by the latest one
+	assert.False(t, d.Add(1, 50))
+	assert.Equal(t, 2, d.Len())
+
+	data := d.DeleteData()
+	assert.Equal(t, []int64{1, 2}, data.Pks)
+	assert.Equal(t, []Timestamp{200, 300}, data.Tss)
+	assert.Equal(t, int64(2), data.RowCount)
+}
+
+func TestDelDataBuf_bufferDelete(t *testing.T) {
+	t.Run("with deduplication", func(t *testing.T) {
+		buf := newDelDataBuf()
+		buf.dedup = newDeleteDeduplicator()
+		assert.Equal(t, int64(1), buf.bufferDelete(1, 100))
+		assert.Equal(t, int64(0), buf.bufferDelete(1, 200))
+		assert.Equal(t, int64(1), buf.bufferDelete(2, 100))
+
+		buf.applyDeduplication()
+		assert.Equal(t, []int64{1, 2}, buf.delData.Pks)
+		assert.Equal(t, []Timestamp{200, 100}, buf.delData.Tss)
+	})
+
+	t.Run("without deduplication", func(t *testing.T) {
+		buf := newDelDataBuf()
+		assert.Equal(t, int64(1), buf.bufferDelete(1, 100))
+		assert.Equal(t, int64(1), buf.bufferDelete(1, 200))
+
+		buf.applyDeduplication()
+		assert.Equal(t, []int64{1, 1}, buf.delData.Pks)
+		assert.Equal(t, []Timestamp{100, 200}, buf.delData.Tss)
+	})
+}
+
+// bufferAndSerializeDeletes buffers deletes of pks repeated in turn, and serializes the buffer into a delta log
+func bufferAndSerializeDeletes(dedup bool, numOfDeletes, numOfPks int) (*Blob, error) {
+	buf := newDelDataBuf()
+	if dedup {
+		buf.dedup = newDeleteDeduplicator()
+	}
+	for i := 0; i < numOfDeletes; i++ {
+		buf.updateSize(buf.bufferDelete(int64(i%numOfPks), Timestamp(i+1)))
+	}
+	buf.applyDeduplication()
+	return storage.NewDeleteCodec().Serialize(1, 1, 1, buf.delData)
+}
+
+func TestDelDataBuf_deduplicationDeltaLogSize(t *testing.T) {
+	deduped, err := bufferAndSerializeDeletes(true, 10000, 1000)
+	require.NoError(t, err)
+	full, err := bufferAndSerializeDeletes(false, 10000, 1000)
+	require.NoError(t, err)
+	assert.Less(t, len(deduped.Value), len(full.Value))
+
+	_, _, data, err := storage.NewDeleteCodec().Deserialize([]*Blob{deduped})
+	require.NoError(t, err)
+	assert.Equal(t, 1000, len(data.Pks))
+	for i, pk := range data.Pks {
+		// the latest delete of pk is the last round
+		assert.Equal(t, Timestamp(9000+pk+1), data.Tss[i])
+	}
+}
+
+func BenchmarkDelDataBuf_deduplication(b *testing.B) {
+	for _, dedup := range []bool{false, true} {
+		b.Run(fmt.Sprintf("dedup-%t", dedup), func(b *testing.B) {
+			var size int
+			for i := 0; i < b.N; i++ {
+				blob, err := bufferAndSerializeDeletes(dedup, 10000, 1000)
+				if err != nil {
+					b.Fatal(err)
+				}
+				size = len(blob.Value)
+			}
+			b.ReportMetric(float64(size), "deltalog-bytes")
+		})
+	}
+}
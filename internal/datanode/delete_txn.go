@@ -0,0 +1,302 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"go.uber.org/zap"
+)
+
+type deleteIntentState string
+
+const (
+	deleteIntentPending   deleteIntentState = "pending"
+	deleteIntentCommitted deleteIntentState = "committed"
+	deleteIntentAborted   deleteIntentState = "aborted"
+)
+
+// deleteIntent records the pending delta logs written by phase 1 of a delete transaction,
+// and the delta log paths they are finalized to by phase 2
+type deleteIntent struct {
+	TxnID        UniqueID            `json:"txnID"`
+	CollectionID UniqueID            `json:"collectionID"`
+	State        deleteIntentState   `json:"state"`
+	CreatedAt    int64               `json:"createdAt"`   // unix milliseconds
+	PendingLogs  map[UniqueID]string `json:"pendingLogs"` // segment id -> pending delta log path
+	FinalLogs    map[UniqueID]string `json:"finalLogs"`   // segment id -> delta log path
+}
+
+// deleteTxnCoordinator applies a delete to multiple segments atomically in 2 phases.
+// Phase 1 writes the intent and a pending delta log of each target segment into blob storage,
+// phase 2 commits the intent, and then converts the pending delta logs into delta logs of the segments, which are
+// saved into meta by the next flush of each segment. Intents not committed within Params.DeleteTransactionTimeoutMs
+// are aborted by the recovery GC, and their pending delta logs are removed. Delta logs finalized are never removed
+// by the recovery GC, the ones not saved into meta are left to the storage audit
+type deleteTxnCoordinator struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	kv           kv.BaseKV
+	allocator    allocatorInterface
+	collectionID UniqueID
+	timeout      time.Duration
+
+	// retryOpts are options to retry failed transactions, default options are used if empty
+	retryOpts []retry.Option
+}
+
+func newDeleteTxnCoordinator(ctx context.Context, blobKV kv.BaseKV, allocator allocatorInterface, collectionID UniqueID) *deleteTxnCoordinator {
+	ctx, cancel := context.WithCancel(ctx)
+	return &deleteTxnCoordinator{
+		ctx:          ctx,
+		cancel:       cancel,
+		kv:           blobKV,
+		allocator:    allocator,
+		collectionID: collectionID,
+		timeout:      time.Duration(Params.DeleteTransactionTimeoutMs) * time.Millisecond,
+	}
+}
+
+func (c *deleteTxnCoordinator) intentPrefix() string {
+	return path.Join(Params.PendingDeltaLogRootPath, "intent", strconv.FormatInt(c.collectionID, 10)) + "/"
+}
+
+func (c *deleteTxnCoordinator) intentKey(txnID UniqueID) string {
+	return c.intentPrefix() + strconv.FormatInt(txnID, 10)
+}
+
+func (c *deleteTxnCoordinator) saveIntent(intent *deleteIntent) error {
+	value, err := json.Marshal(intent)
+	if err != nil {
+		return err
+	}
+	return c.kv.Save(c.intentKey(intent.TxnID), string(value))
+}
+
+// prepare is phase 1, the intent is saved before the pending delta logs so that the recovery GC always knows
+// the pending delta logs to remove
+func (c *deleteTxnCoordinator) prepare(partitionID UniqueID, data map[UniqueID]*DeleteData) (*deleteIntent, error) {
+	txnID, err := c.allocator.allocID()
+	if err != nil {
+		return nil, err
+	}
+	intent := &deleteIntent{
+		TxnID:        txnID,
+		CollectionID: c.collectionID,
+		State:        deleteIntentPending,
+		CreatedAt:    time.Now().UnixNano() / int64(time.Millisecond),
+		PendingLogs:  make(map[UniqueID]string),
+		FinalLogs:    make(map[UniqueID]string),
+	}
+
+	kvs := make(map[string]string)
+	codec := storage.NewDeleteCodec()
+	for segmentID, delData := range data {
+		blob, err := codec.Serialize(c.collectionID, partitionID, segmentID, delData)
+		if err != nil {
+			return nil, err
+		}
+		key := path.Join(Params.PendingDeltaLogRootPath, strconv.FormatInt(c.collectionID, 10),
+			strconv.FormatInt(partitionID, 10), strconv.FormatInt(segmentID, 10), strconv.FormatInt(txnID, 10))
+		kvs[key] = bytes.NewBuffer(blob.GetValue()).String()
+		intent.PendingLogs[segmentID] = key
+
+		finalKey, err := c.allocator.genKey(true, c.collectionID, partitionID, segmentID)
+		if err != nil {
+			return nil, err
+		}
+		intent.FinalLogs[segmentID] = path.Join(Params.DeleteBinlogRootPath, finalKey)
+	}
+
+	if err := c.saveIntent(intent); err != nil {
+		return nil, err
+	}
+	if err := c.kv.MultiSave(kvs); err != nil {
+		c.abort(intent)
+		return nil, err
+	}
+	return intent, nil
+}
+
+// commit is phase 2, the intent is aborted instead if it has expired since the recovery GC may have removed it.
+// Saving the intent committed is the commit point, after which the pending delta logs are copied to their delta log
+// paths along with the index of each. The delta logs finalized of the segments in data are returned
+func (c *deleteTxnCoordinator) commit(intent *deleteIntent, data map[UniqueID]*DeleteData) (map[UniqueID]*DelDataBuf, error) {
+	if c.expired(intent, time.Now()) {
+		c.abort(intent)
+		return nil, fmt.Errorf("delete transaction %d expired before commit", intent.TxnID)
+	}
+	intent.State = deleteIntentCommitted
+	if err := c.saveIntent(intent); err != nil {
+		intent.State = deleteIntentPending
+		c.abort(intent)
+		return nil, err
+	}
+
+	// a committed intent failing to finalize is not aborted, the pending delta logs are removed by the recovery GC,
+	// and the delete is applied again once the vchannel recovers from the positions saved before it
+	segmentIDs := make([]UniqueID, 0, len(intent.PendingLogs))
+	pendingKeys := make([]string, 0, len(intent.PendingLogs))
+	for segmentID, key := range intent.PendingLogs {
+		segmentIDs = append(segmentIDs, segmentID)
+		pendingKeys = append(pendingKeys, key)
+	}
+	values, err := c.kv.MultiLoad(pendingKeys)
+	if err != nil {
+		return nil, err
+	}
+	kvs := make(map[string]string, 2*len(values))
+	finalized := make(map[UniqueID]*DelDataBuf, len(values))
+	for i, segmentID := range segmentIDs {
+		finalKey := intent.FinalLogs[segmentID]
+		kvs[finalKey] = values[i]
+		kvs[finalKey+storage.DeltaLogIndexSuffix] = string(buildDeltaLogIndex(data[segmentID]).Marshal())
+		buf := newDelDataBufFromData(data[segmentID])
+		buf.filePath = finalKey
+		buf.fileSize = int64(len(values[i]))
+		finalized[segmentID] = buf
+	}
+	if err := c.kv.MultiSave(kvs); err != nil {
+		return nil, err
+	}
+
+	// removal failure is left to the recovery GC
+	if err := c.remove(intent); err != nil {
+		log.Warn("failed to remove committed delete intent", zap.Int64("txnID", intent.TxnID), zap.Error(err))
+	}
+	return finalized, nil
+}
+
+// apply prepares and commits a transaction of data with retry, each attempt is a new transaction
+func (c *deleteTxnCoordinator) apply(partitionID UniqueID, data map[UniqueID]*DeleteData) (map[UniqueID]*DelDataBuf, error) {
+	var finalized map[UniqueID]*DelDataBuf
+	err := retry.Do(c.ctx, func() error {
+		intent, err := c.prepare(partitionID, data)
+		if err != nil {
+			return err
+		}
+		finalized, err = c.commit(intent, data)
+		if err != nil {
+			return err
+		}
+		log.Debug("delete transaction committed", zap.Int64("txnID", intent.TxnID), zap.Int("numOfSegments", len(data)))
+		return nil
+	}, c.retryOpts...)
+	return finalized, err
+}
+
+// abort marks the intent aborted and removes its pending delta logs
+func (c *deleteTxnCoordinator) abort(intent *deleteIntent) {
+	intent.State = deleteIntentAborted
+	if err := c.saveIntent(intent); err != nil {
+		log.Warn("failed to mark delete intent aborted", zap.Int64("txnID", intent.TxnID), zap.Error(err))
+		return
+	}
+	if err := c.remove(intent); err != nil {
+		log.Warn("failed to remove aborted delete intent", zap.Int64("txnID", intent.TxnID), zap.Error(err))
+	}
+}
+
+// remove removes the pending delta logs before the intent, so that they are never left without an intent.
+// The delta logs finalized are kept, since they may be saved into meta already
+func (c *deleteTxnCoordinator) remove(intent *deleteIntent) error {
+	keys := make([]string, 0, len(intent.PendingLogs))
+	for _, key := range intent.PendingLogs {
+		keys = append(keys, key)
+	}
+	if err := c.kv.MultiRemove(keys); err != nil {
+		return err
+	}
+	return c.kv.Remove(c.intentKey(intent.TxnID))
+}
+
+func (c *deleteTxnCoordinator) expired(intent *deleteIntent, now time.Time) bool {
+	return now.Sub(time.Unix(0, intent.CreatedAt*int64(time.Millisecond))) > c.timeout
+}
+
+// gc aborts the pending intents of the collection which have expired, and cleans up the pending delta logs of
+// the finished ones left
+func (c *deleteTxnCoordinator) gc(now time.Time) error {
+	keys, values, err := c.kv.LoadWithPrefix(c.intentPrefix())
+	if err != nil {
+		return err
+	}
+	for i, value := range values {
+		intent := &deleteIntent{}
+		if err := json.Unmarshal([]byte(value), intent); err != nil {
+			log.Warn("failed to unmarshal delete intent", zap.String("key", keys[i]), zap.Error(err))
+			continue
+		}
+		if !c.expired(intent, now) {
+			continue
+		}
+		switch intent.State {
+		case deleteIntentPending:
+			log.Info("abort expired delete transaction", zap.Int64("txnID", intent.TxnID),
+				zap.Int64("collectionID", intent.CollectionID), zap.Int("numOfSegments", len(intent.PendingLogs)))
+			c.abort(intent)
+		default:
+			if err := c.remove(intent); err != nil {
+				log.Warn("failed to remove delete intent", zap.Int64("txnID", intent.TxnID), zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// start runs the recovery GC at once and then every timeout
+func (c *deleteTxnCoordinator) start() {
+	c.wg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer c.wg.Done()
+		interval := c.timeout
+		if interval <= 0 {
+			interval = time.Second
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if err := c.gc(time.Now()); err != nil {
+				log.Warn("failed to gc delete intents", zap.Int64("collectionID", c.collectionID), zap.Error(err))
+			}
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (c *deleteTxnCoordinator) close() {
+	c.cancel()
+	c.wg.Wait()
+}
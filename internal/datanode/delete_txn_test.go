@@ -0,0 +1,190 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingMultiSaveKV fails MultiSave while failed is set, Save is not affected so that intents are saved
+type failingMultiSaveKV struct {
+	kv.BaseKV
+	failed bool
+}
+
+func (f *failingMultiSaveKV) MultiSave(kvs map[string]string) error {
+	if f.failed {
+		return errors.New("mocked error")
+	}
+	return f.BaseKV.MultiSave(kvs)
+}
+
+func genDeleteTxnData() map[UniqueID]*DeleteData {
+	return map[UniqueID]*DeleteData{
+		1: {Pks: []int64{1, 2}, Tss: []uint64{100, 100}, RowCount: 2},
+		2: {Pks: []int64{3}, Tss: []uint64{100}, RowCount: 1},
+	}
+}
+
+func TestDeleteTxnCoordinator(t *testing.T) {
+	Params.Init()
+	const collectionID = 10
+
+	t.Run("prepare and commit", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		c := newDeleteTxnCoordinator(context.Background(), kv, NewAllocatorFactory(), collectionID)
+		intent, err := c.prepare(100, genDeleteTxnData())
+		require.NoError(t, err)
+		assert.Equal(t, deleteIntentPending, intent.State)
+		assert.Equal(t, 2, len(intent.PendingLogs))
+
+		// pending delta logs are readable delta logs
+		value, err := kv.Load(intent.PendingLogs[1])
+		require.NoError(t, err)
+		_, segmentID, data, err := storage.NewDeleteCodec().Deserialize([]*Blob{{Value: []byte(value)}})
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, segmentID)
+		assert.Equal(t, []int64{1, 2}, data.Pks)
+		_, err = kv.Load(c.intentKey(intent.TxnID))
+		assert.NoError(t, err)
+
+		finalized, err := c.commit(intent, genDeleteTxnData())
+		require.NoError(t, err)
+		assert.Equal(t, deleteIntentCommitted, intent.State)
+		keys, _, err := kv.LoadWithPrefix(Params.PendingDeltaLogRootPath)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+
+		// pending delta logs are converted into delta logs of the segments
+		require.Equal(t, 2, len(finalized))
+		for segmentID, buf := range finalized {
+			assert.Equal(t, intent.FinalLogs[segmentID], buf.filePath)
+			value, err := kv.Load(buf.filePath)
+			require.NoError(t, err)
+			assert.EqualValues(t, len(value), buf.fileSize)
+			_, _, data, err := storage.NewDeleteCodec().Deserialize([]*Blob{{Value: []byte(value)}})
+			require.NoError(t, err)
+			assert.Equal(t, genDeleteTxnData()[segmentID].Pks, data.Pks)
+			_, err = kv.Load(buf.filePath + storage.DeltaLogIndexSuffix)
+			assert.NoError(t, err)
+		}
+		assert.EqualValues(t, 2, finalized[1].size)
+		assert.EqualValues(t, 100, finalized[1].tsFrom)
+		assert.EqualValues(t, 100, finalized[1].tsTo)
+	})
+
+	t.Run("finalize failed", func(t *testing.T) {
+		kv := &failingMultiSaveKV{BaseKV: memkv.NewMemoryKV()}
+		c := newDeleteTxnCoordinator(context.Background(), kv, NewAllocatorFactory(), collectionID)
+		c.timeout = time.Minute
+		intent, err := c.prepare(100, genDeleteTxnData())
+		require.NoError(t, err)
+		kv.failed = true
+		_, err = c.commit(intent, genDeleteTxnData())
+		assert.Error(t, err)
+
+		// the committed intent is not aborted, its pending delta logs are removed by the recovery gc
+		value, err := kv.Load(c.intentKey(intent.TxnID))
+		require.NoError(t, err)
+		assert.Contains(t, value, string(deleteIntentCommitted))
+		assert.NoError(t, c.gc(time.Now().Add(time.Hour)))
+		keys, _, err := kv.LoadWithPrefix(Params.PendingDeltaLogRootPath)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("apply with retry", func(t *testing.T) {
+		kv := &failingMultiSaveKV{BaseKV: memkv.NewMemoryKV(), failed: true}
+		c := newDeleteTxnCoordinator(context.Background(), kv, NewAllocatorFactory(), collectionID)
+		c.retryOpts = []retry.Option{retry.Attempts(2), retry.Sleep(time.Millisecond)}
+		_, err := c.apply(100, genDeleteTxnData())
+		assert.Error(t, err)
+		keys, _, err := kv.LoadWithPrefix(Params.PendingDeltaLogRootPath)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+
+		kv.failed = false
+		finalized, err := c.apply(100, genDeleteTxnData())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(finalized))
+	})
+
+	t.Run("prepare failed", func(t *testing.T) {
+		kv := &latencyKV{BaseKV: memkv.NewMemoryKV(), err: errors.New("mocked error")}
+		c := newDeleteTxnCoordinator(context.Background(), kv, NewAllocatorFactory(), collectionID)
+		_, err := c.prepare(100, genDeleteTxnData())
+		assert.Error(t, err)
+		keys, _, err := kv.LoadWithPrefix(Params.PendingDeltaLogRootPath)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("expired before commit", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		c := newDeleteTxnCoordinator(context.Background(), kv, NewAllocatorFactory(), collectionID)
+		c.timeout = 0
+		intent, err := c.prepare(100, genDeleteTxnData())
+		require.NoError(t, err)
+		time.Sleep(time.Millisecond)
+		_, err = c.commit(intent, genDeleteTxnData())
+		assert.Error(t, err)
+		assert.Equal(t, deleteIntentAborted, intent.State)
+		keys, _, err := kv.LoadWithPrefix(Params.PendingDeltaLogRootPath)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("recovery gc", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		c := newDeleteTxnCoordinator(context.Background(), kv, NewAllocatorFactory(), collectionID)
+		c.timeout = time.Minute
+		intent, err := c.prepare(100, genDeleteTxnData())
+		require.NoError(t, err)
+
+		// intents not expired are kept
+		assert.NoError(t, c.gc(time.Now()))
+		_, err = kv.Load(c.intentKey(intent.TxnID))
+		assert.NoError(t, err)
+
+		// intents of other collections are not touched
+		other := newDeleteTxnCoordinator(context.Background(), kv, NewAllocatorFactory(), collectionID+1)
+		assert.NoError(t, other.gc(time.Now().Add(time.Hour)))
+		_, err = kv.Load(c.intentKey(intent.TxnID))
+		assert.NoError(t, err)
+
+		assert.NoError(t, c.gc(time.Now().Add(time.Hour)))
+		keys, _, err := kv.LoadWithPrefix(Params.PendingDeltaLogRootPath)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("start and close", func(t *testing.T) {
+		c := newDeleteTxnCoordinator(context.Background(), memkv.NewMemoryKV(), NewAllocatorFactory(), collectionID)
+		c.start()
+		c.close()
+	})
+}
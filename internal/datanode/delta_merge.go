@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+// pendingDelta is the delete data of a flush task not serialized yet, it's merged with the delete data
+// of the flush tasks after it in the queue
+type pendingDelta struct {
+	runner    *flushTaskRunner
+	data      *DelDataBuf
+	trigger   chan struct{} // closed once the deltas pending from it reach the merge threshold
+	triggered bool
+}
+
+// deltaMerger holds the delete data of the flush tasks of a segment, so that the delete data of rapid flushes
+// is written into one delta log instead of many small ones. The delete data pending is merged into the delta log of
+// the earliest flush task of them, which is saved no later than any of the others, so no delete is saved later than
+// the position of its flush task. The merge happens once the deltas pending reach the threshold, or the earliest
+// flush task becomes the head of the queue, which never waits for the flush tasks after it
+type deltaMerger struct {
+	mu        sync.Mutex
+	threshold int
+	pending   []*pendingDelta
+}
+
+// newDeltaMerger creates a deltaMerger, returns nil if threshold is less than 2, which means no merge
+func newDeltaMerger(threshold int) *deltaMerger {
+	if threshold < 2 {
+		return nil
+	}
+	return &deltaMerger{threshold: threshold}
+}
+
+// add holds the delete data of the flush task run by runner
+func (d *deltaMerger) add(runner *flushTaskRunner, data *DelDataBuf) *pendingDelta {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	p := &pendingDelta{
+		runner:  runner,
+		data:    data,
+		trigger: make(chan struct{}),
+	}
+	d.pending = append(d.pending, p)
+	if head := d.pending[0]; len(d.pending) >= d.threshold && !head.triggered {
+		head.triggered = true
+		close(head.trigger)
+	}
+	return p
+}
+
+// take returns the deltas pending from p on if p is the earliest of them, otherwise p is merged by
+// a flush task before it and nil is returned
+func (d *deltaMerger) take(p *pendingDelta) []*pendingDelta {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.pending) == 0 || d.pending[0] != p {
+		return nil
+	}
+	taken := d.pending
+	d.pending = nil
+	return taken
+}
+
+// mergeDelDataBufs merges the delete data of bufs in order into one DelDataBuf
+func mergeDelDataBufs(bufs []*DelDataBuf) *DelDataBuf {
+	if len(bufs) == 1 {
+		return bufs[0]
+	}
+	merged := newDelDataBuf()
+	for _, buf := range bufs {
+		merged.delData.Pks = append(merged.delData.Pks, buf.delData.Pks...)
+		merged.delData.Tss = append(merged.delData.Tss, buf.delData.Tss...)
+		merged.delData.RowCount += buf.delData.RowCount
+		merged.updateSize(buf.size)
+		merged.updateTimeRange(TimeRange{timestampMin: buf.tsFrom, timestampMax: buf.tsTo})
+	}
+	return merged
+}
+
+// mergingDeleteTask is the flush delete task of delete data held by the deltaMerger, it waits until the deltas
+// pending are merged, and uploads the merged delta logs if the delete data is merged into the task
+type mergingDeleteTask struct {
+	m         *rendezvousFlushManager
+	merger    *deltaMerger
+	delta     *pendingDelta
+	collID    UniqueID
+	partID    UniqueID
+	segmentID UniqueID
+
+	taken  bool        // whether the deltas pending are taken
+	merged *DelDataBuf // delete data merged into the task, nil if merged into the task before it
+	upload *flushBufferDeleteTask
+}
+
+// flushDeleteData implements flushDeleteTask, the delete data merged is kept so that a retry serializes it again
+func (t *mergingDeleteTask) flushDeleteData() error {
+	if !t.taken {
+		select {
+		case <-t.delta.runner.startSignal:
+		case <-t.delta.trigger:
+		case <-t.m.ctx.Done():
+			return t.m.ctx.Err()
+		}
+		deltas := t.merger.take(t.delta)
+		t.taken = true
+		if len(deltas) > 0 {
+			bufs := make([]*DelDataBuf, 0, len(deltas))
+			for _, delta := range deltas {
+				bufs = append(bufs, delta.data)
+			}
+			t.merged = mergeDelDataBufs(bufs)
+			log.Debug("merge delete data of flush tasks", zap.Int64("segmentID", t.segmentID),
+				zap.Int("tasks", len(deltas)), zap.Int("rows", len(t.merged.delData.Pks)))
+		}
+	}
+	if t.merged == nil {
+		return nil
+	}
+	if t.upload == nil {
+		kvs, deltaLogs, err := t.m.serializeDelData(t.collID, t.partID, t.segmentID, t.merged)
+		if err != nil {
+			return err
+		}
+		// the flush pack reads the delta logs after the task is done, along with the delta logs finalized already
+		t.delta.runner.deltaLogs = append(t.delta.runner.deltaLogs, deltaLogs...)
+		t.upload = &flushBufferDeleteTask{
+			ctx:    t.m.ctx,
+			BaseKV: t.m.BaseKV,
+			data:   kvs,
+		}
+	}
+	return t.upload.flushDeleteData()
+}
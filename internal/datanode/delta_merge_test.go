@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeltaMerger(t *testing.T) {
+	assert.Nil(t, newDeltaMerger(0))
+	assert.Nil(t, newDeltaMerger(1))
+
+	d := newDeltaMerger(2)
+	first := d.add(newFlushTaskRunner(1, nil), newDelDataBuf())
+	select {
+	case <-first.trigger:
+		t.Fatal("triggered below the threshold")
+	default:
+	}
+	second := d.add(newFlushTaskRunner(1, nil), newDelDataBuf())
+	<-first.trigger
+	d.add(newFlushTaskRunner(1, nil), newDelDataBuf())
+
+	// deltas are taken by the earliest only
+	assert.Nil(t, d.take(second))
+	assert.Equal(t, 3, len(d.take(first)))
+	assert.Nil(t, d.take(second))
+}
+
+func TestMergeDelDataBufs(t *testing.T) {
+	bufs := make([]*DelDataBuf, 0, 3)
+	for i := 0; i < 3; i++ {
+		bufs = append(bufs, newDelDataBufFromData(&storage.DeleteData{
+			Pks:      []int64{int64(i), int64(i + 1)},
+			Tss:      []Timestamp{Timestamp(10*i + 1), Timestamp(10*i + 2)},
+			RowCount: 2,
+		}))
+	}
+	merged := mergeDelDataBufs(bufs)
+	assert.Equal(t, []int64{0, 1, 1, 2, 2, 3}, merged.delData.Pks)
+	assert.Equal(t, []Timestamp{1, 2, 11, 12, 21, 22}, merged.delData.Tss)
+	assert.EqualValues(t, 6, merged.delData.RowCount)
+	assert.EqualValues(t, 6, merged.size)
+	assert.EqualValues(t, 1, merged.tsFrom)
+	assert.EqualValues(t, 22, merged.tsTo)
+
+	assert.Same(t, bufs[0], mergeDelDataBufs(bufs[:1]))
+}
+
+// applyDeletes returns the latest delete timestamp of each primary key after the delete data applied in order
+func applyDeletes(data ...*storage.DeleteData) map[int64]Timestamp {
+	deleted := make(map[int64]Timestamp)
+	for _, d := range data {
+		for i, pk := range d.Pks {
+			if d.Tss[i] > deleted[pk] {
+				deleted[pk] = d.Tss[i]
+			}
+		}
+	}
+	return deleted
+}
+
+func TestRendezvousFlushManager_MergeDeltaLog(t *testing.T) {
+	defer func(origin int) { Params.DeltaLogMergeThreshold = origin }(Params.DeltaLogMergeThreshold)
+	Params.DeltaLogMergeThreshold = 3
+
+	genDeleteData := func(n int) []*storage.DeleteData {
+		data := make([]*storage.DeleteData, 0, n)
+		for i := 0; i < n; i++ {
+			data = append(data, &storage.DeleteData{
+				Pks:      []int64{int64(i), int64(i + 1), int64(i + 2)},
+				Tss:      []Timestamp{Timestamp(10*i + 1), Timestamp(10*i + 2), Timestamp(10*i + 3)},
+				RowCount: 3,
+			})
+		}
+		return data
+	}
+
+	run := func(t *testing.T, data []*storage.DeleteData) []*segmentFlushPack {
+		kv := NewInMemoryKV(0)
+		packCh := make(chan *segmentFlushPack, len(data)+1)
+		m := NewRendezvousFlushManager(NewAllocatorFactory(), kv, newMockReplica(), func(pack *segmentFlushPack) {
+			packCh <- pack
+		})
+		defer m.close()
+
+		// the head of the queue waits for its insert data, so that the flush tasks after it are pending
+		head := &internalpb.MsgPosition{MsgID: []byte{0}}
+		_, err := m.flushDelData(nil, 1, head)
+		require.NoError(t, err)
+		for i, d := range data {
+			_, err := m.flushDelData(newDelDataBufFromData(d), 1, &internalpb.MsgPosition{MsgID: []byte{byte(i + 1)}})
+			require.NoError(t, err)
+		}
+		_, err = m.flushBufferData(nil, 1, false, false, head)
+		require.NoError(t, err)
+		for i := range data {
+			_, err := m.flushBufferData(nil, 1, false, false, &internalpb.MsgPosition{MsgID: []byte{byte(i + 1)}})
+			require.NoError(t, err)
+		}
+
+		packs := make([]*segmentFlushPack, 0, len(data)+1)
+		for i := 0; i < len(data)+1; i++ {
+			select {
+			case pack := <-packCh:
+				require.NoError(t, pack.err)
+				packs = append(packs, pack)
+			case <-time.After(5 * time.Second):
+				t.Fatal("flush task not done")
+			}
+		}
+
+		// the delta logs merged produce the same deletes as the delete data applied one by one
+		var merged []*storage.DeleteData
+		for _, pack := range packs {
+			for _, deltaLog := range pack.deltaLogs {
+				value, err := kv.Load(deltaLog.filePath)
+				require.NoError(t, err)
+				_, _, d, err := storage.NewDeleteCodec().Deserialize([]*storage.Blob{{Key: deltaLog.filePath, Value: []byte(value)}})
+				require.NoError(t, err)
+				merged = append(merged, d)
+			}
+		}
+		assert.Equal(t, applyDeletes(data...), applyDeletes(merged...))
+		return packs
+	}
+
+	t.Run("threshold reached", func(t *testing.T) {
+		packs := run(t, genDeleteData(3))
+		assert.Empty(t, packs[0].deltaLogs)
+		// merged into the delta log of the earliest flush task
+		require.Equal(t, 1, len(packs[1].deltaLogs))
+		assert.EqualValues(t, 9, packs[1].deltaLogs[0].size)
+		assert.Empty(t, packs[2].deltaLogs)
+		assert.Empty(t, packs[3].deltaLogs)
+	})
+
+	t.Run("head of queue", func(t *testing.T) {
+		// merged once the earliest becomes the head of the queue, without waiting for the threshold
+		packs := run(t, genDeleteData(2))
+		require.Equal(t, 1, len(packs[1].deltaLogs))
+		assert.EqualValues(t, 6, packs[1].deltaLogs[0].size)
+		assert.Empty(t, packs[2].deltaLogs)
+	})
+}
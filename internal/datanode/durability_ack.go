@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"go.uber.org/zap"
+)
+
+// durabilityAckPublisher publishes the flushed position of a segment to the durability ack channel
+// once its binlogs are saved, so that producers know messages up to the position are persisted
+type durabilityAckPublisher struct {
+	stream       msgstream.MsgStream
+	vchannelName string
+	collectionID UniqueID
+}
+
+func newDurabilityAckPublisher(ctx context.Context, factory msgstream.Factory, vchannelName string, collectionID UniqueID) (*durabilityAckPublisher, error) {
+	stream, err := factory.NewMsgStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream.AsProducer([]string{Params.DurabilityAckChannelName})
+	log.Debug("datanode AsProducer", zap.String("DurabilityAckChannelName", Params.DurabilityAckChannelName))
+	stream.Start()
+
+	return &durabilityAckPublisher{
+		stream:       stream,
+		vchannelName: vchannelName,
+		collectionID: collectionID,
+	}, nil
+}
+
+// publish produces the ack of the segment flushed up to pos
+func (p *durabilityAckPublisher) publish(segmentID UniqueID, pos *internalpb.MsgPosition) error {
+	msgPack := msgstream.MsgPack{}
+	ackMsg := &msgstream.DurabilityAckMsg{
+		BaseMsg: msgstream.BaseMsg{
+			BeginTimestamp: pos.GetTimestamp(),
+			EndTimestamp:   pos.GetTimestamp(),
+			HashValues:     []uint32{0},
+		},
+		DurabilityAckMsg: datapb.DurabilityAckMsg{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DurabilityAck,
+				MsgID:     0,
+				Timestamp: pos.GetTimestamp(),
+				SourceID:  Params.NodeID,
+			},
+			ChannelName:     p.vchannelName,
+			CollectionID:    p.collectionID,
+			SegmentID:       segmentID,
+			FlushedPosition: pos,
+		},
+	}
+	msgPack.Msgs = append(msgPack.Msgs, ackMsg)
+	if err := p.stream.Produce(&msgPack); err != nil {
+		return err
+	}
+
+	physical, _ := tsoutil.ParseTS(pos.GetTimestamp())
+	metrics.DataNodeDurabilityAckLatency.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).
+		Observe(float64(time.Since(physical).Milliseconds()))
+	return nil
+}
+
+func (p *durabilityAckPublisher) close() {
+	p.stream.Close()
+}
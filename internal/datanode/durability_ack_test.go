@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ackRecordMsgStream records the produced acks with the time they are received
+type ackRecordMsgStream struct {
+	mockTtMsgStream
+	mu       sync.Mutex
+	acks     []*msgstream.DurabilityAckMsg
+	received []time.Time
+	err      error
+}
+
+func (s *ackRecordMsgStream) Produce(pack *msgstream.MsgPack) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, msg := range pack.Msgs {
+		s.acks = append(s.acks, msg.(*msgstream.DurabilityAckMsg))
+		s.received = append(s.received, time.Now())
+	}
+	return nil
+}
+
+type ackRecordMsgStreamFactory struct {
+	mockMsgStreamFactory
+	stream *ackRecordMsgStream
+}
+
+func (f *ackRecordMsgStreamFactory) NewMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	return f.stream, nil
+}
+
+func TestNewDurabilityAckPublisher(t *testing.T) {
+	_, err := newDurabilityAckPublisher(context.Background(), &mockMsgStreamFactory{}, "ch1", 1)
+	assert.Error(t, err)
+
+	p, err := newDurabilityAckPublisher(context.Background(), &mockMsgStreamFactory{NewMsgStreamNoError: true}, "ch1", 1)
+	assert.NoError(t, err)
+	p.close()
+}
+
+func TestDurabilityAckPublisher_publish(t *testing.T) {
+	stream := &ackRecordMsgStream{}
+	p, err := newDurabilityAckPublisher(context.Background(), &ackRecordMsgStreamFactory{stream: stream}, "ch1", 1)
+	require.NoError(t, err)
+	defer p.close()
+
+	pos := &internalpb.MsgPosition{
+		ChannelName: "ch1",
+		MsgID:       []byte{1, 2, 3},
+		Timestamp:   tsoutil.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0),
+	}
+	assert.NoError(t, p.publish(100, pos))
+	require.Equal(t, 1, len(stream.acks))
+	ack := stream.acks[0]
+	assert.Equal(t, commonpb.MsgType_DurabilityAck, ack.Type())
+	assert.Equal(t, "ch1", ack.GetChannelName())
+	assert.EqualValues(t, 1, ack.GetCollectionID())
+	assert.EqualValues(t, 100, ack.GetSegmentID())
+	assert.Equal(t, pos, ack.GetFlushedPosition())
+	assert.Equal(t, pos.GetTimestamp(), ack.EndTs())
+
+	stream.err = errors.New("mocked produce error")
+	assert.Error(t, p.publish(100, pos))
+}
+
+func TestFlushNotifyFunc_DurabilityAck(t *testing.T) {
+	ctx := context.Background()
+	replica, err := newReplica(ctx, &RootCoordFactory{}, 1)
+	require.NoError(t, err)
+
+	stream := &ackRecordMsgStream{}
+	p, err := newDurabilityAckPublisher(ctx, &ackRecordMsgStreamFactory{stream: stream}, "ch1", 1)
+	require.NoError(t, err)
+	defer p.close()
+
+	dataCoord := &DataCoordFactory{}
+	dsService := &dataSyncService{
+		collectionID:     1,
+		replica:          replica,
+		dataCoord:        dataCoord,
+		flushingSegCache: newCache(),
+		ackPublisher:     p,
+		flushErrCh:       make(chan error, 1),
+	}
+	notifyFunc := flushNotifyFunc(dsService, retry.Attempts(1))
+
+	// no ack without position
+	notifyFunc(&segmentFlushPack{segmentID: 100})
+	assert.Empty(t, stream.acks)
+
+	pos := &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 1000}
+	notifyFunc(&segmentFlushPack{segmentID: 100, pos: pos})
+	require.Equal(t, 1, len(stream.acks))
+	assert.EqualValues(t, 100, stream.acks[0].GetSegmentID())
+	assert.Equal(t, pos, stream.acks[0].GetFlushedPosition())
+
+	// failure to publish does not fail the flush
+	stream.err = errors.New("mocked produce error")
+	assert.NotPanics(t, func() {
+		notifyFunc(&segmentFlushPack{segmentID: 100, pos: pos})
+	})
+
+	// no ack if SaveBinlogPaths fails
+	stream.err = nil
+	dataCoord.SaveBinlogPathNotSuccess = true
+	assert.NotPanics(t, func() {
+		notifyFunc(&segmentFlushPack{segmentID: 100, pos: pos})
+	})
+	assert.Equal(t, 1, len(dsService.flushErrCh))
+	assert.Equal(t, 1, len(stream.acks))
+}
+
+func TestDurabilityAckLatency(t *testing.T) {
+	ctx := context.Background()
+	replica, err := newReplica(ctx, &RootCoordFactory{}, 1)
+	require.NoError(t, err)
+
+	stream := &ackRecordMsgStream{}
+	p, err := newDurabilityAckPublisher(ctx, &ackRecordMsgStreamFactory{stream: stream}, "ch1", 1)
+	require.NoError(t, err)
+	defer p.close()
+
+	dsService := &dataSyncService{
+		collectionID:     1,
+		replica:          replica,
+		dataCoord:        &DataCoordFactory{},
+		flushingSegCache: newCache(),
+		ackPublisher:     p,
+	}
+	notifyFunc := flushNotifyFunc(dsService, retry.Attempts(1))
+
+	// positions are produced at a fixed pace and flushed in batches, as the flowgraph does
+	const (
+		segmentNum = 200
+		batchSize  = 20
+		interval   = time.Millisecond
+	)
+	positions := make([]*internalpb.MsgPosition, 0, segmentNum)
+	for i := 0; i < segmentNum; i++ {
+		positions = append(positions, &internalpb.MsgPosition{
+			ChannelName: "ch1",
+			Timestamp:   tsoutil.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0),
+		})
+		time.Sleep(interval)
+		if (i+1)%batchSize == 0 {
+			for j := i + 1 - batchSize; j <= i; j++ {
+				notifyFunc(&segmentFlushPack{segmentID: UniqueID(j), pos: positions[j]})
+			}
+		}
+	}
+	require.Equal(t, segmentNum, len(stream.acks))
+
+	latencies := make([]time.Duration, 0, segmentNum)
+	for i, ack := range stream.acks {
+		assert.EqualValues(t, i, ack.GetSegmentID())
+		physical, _ := tsoutil.ParseTS(ack.GetFlushedPosition().GetTimestamp())
+		latencies = append(latencies, stream.received[i].Sub(physical))
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	percentile := func(p float64) time.Duration {
+		return latencies[int(p*float64(len(latencies)-1))]
+	}
+	p50, p90, p99 := percentile(0.5), percentile(0.9), percentile(0.99)
+	t.Logf("durability ack latency of %d segments: min %v, p50 %v, p90 %v, p99 %v, max %v",
+		segmentNum, latencies[0], p50, p90, p99, latencies[len(latencies)-1])
+
+	// timestamps are in milliseconds, so latency may be rounded down by one millisecond
+	assert.True(t, latencies[0] >= -time.Millisecond)
+	assert.True(t, p50 <= p90 && p90 <= p99)
+	// an ack waits at most for the rest of its batch to be produced
+	assert.True(t, p50 >= interval*batchSize/4, p50)
+}
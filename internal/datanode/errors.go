@@ -19,6 +19,8 @@ package datanode
 import (
 	"errors"
 	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 )
 
 func msgDataNodeIsUnhealthy(nodeID UniqueID) string {
@@ -28,3 +30,25 @@ func msgDataNodeIsUnhealthy(nodeID UniqueID) string {
 func errDataNodeIsUnhealthy(nodeID UniqueID) error {
 	return errors.New(msgDataNodeIsUnhealthy(nodeID))
 }
+
+// IsRetryable returns whether a coordinator call failing with err or resp may succeed on retry.
+// Errors of the call itself, e.g. network timeout, are transient, so are unexpected errors of the coordinator,
+// e.g. it's not serving yet. Error codes about the request itself are permanent
+func IsRetryable(err error, resp *commonpb.Status) bool {
+	if err != nil || resp == nil {
+		return true
+	}
+	switch resp.GetErrorCode() {
+	case commonpb.ErrorCode_Success,
+		commonpb.ErrorCode_SegmentNotFound,
+		commonpb.ErrorCode_VersionMismatch,
+		commonpb.ErrorCode_DuplicateSegment,
+		commonpb.ErrorCode_SegmentLeaseExpired,
+		commonpb.ErrorCode_CollectionNotExists,
+		commonpb.ErrorCode_IllegalArgument,
+		commonpb.ErrorCode_PermissionDenied:
+		return false
+	default:
+		return true
+	}
+}
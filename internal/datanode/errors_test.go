@@ -17,9 +17,12 @@
 package datanode
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"go.uber.org/zap"
@@ -38,3 +41,16 @@ func TestErrDataNodeIsUnhealthy(t *testing.T) {
 		log.Info("TestErrDataNodeIsUnhealthy", zap.Error(errDataNodeIsUnhealthy(nodeID)))
 	}
 }
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(errors.New("mocked timeout"), nil))
+	assert.True(t, IsRetryable(nil, nil))
+	assert.True(t, IsRetryable(nil, &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}))
+	assert.True(t, IsRetryable(nil, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Busy}))
+
+	assert.False(t, IsRetryable(nil, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}))
+	assert.False(t, IsRetryable(nil, &commonpb.Status{ErrorCode: commonpb.ErrorCode_SegmentNotFound}))
+	assert.False(t, IsRetryable(nil, &commonpb.Status{ErrorCode: commonpb.ErrorCode_VersionMismatch}))
+	assert.False(t, IsRetryable(nil, &commonpb.Status{ErrorCode: commonpb.ErrorCode_DuplicateSegment}))
+	assert.False(t, IsRetryable(nil, &commonpb.Status{ErrorCode: commonpb.ErrorCode_SegmentLeaseExpired}))
+}
@@ -0,0 +1,234 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"go.uber.org/zap"
+)
+
+// flowGraphCheckpointKV is the local store the flow graph checkpoints of the DataNode are persisted to,
+// flow graph checkpoints are disabled if nil
+var flowGraphCheckpointKV kv.BaseKV
+
+// flowGraphCheckpointValue is the persisted checkpoint of a vchannel
+type flowGraphCheckpointValue struct {
+	Position   []byte     `json:"position"`   // marshaled internalpb.MsgPosition
+	SegmentIDs []UniqueID `json:"segmentIDs"` // segments of the vchannel known when the checkpoint was taken
+}
+
+// FlowGraphCheckpoint records the minimum position acknowledged by all nodes of the flow graph of a vchannel.
+// The position never passes the data buffered in the DataNode but not saved by DataCoord yet, so that the
+// vchannel recovers from the checkpoint without losing data, which usually replays far less messages than
+// recovering from the DML positions of its segments.
+type FlowGraphCheckpoint struct {
+	mu      sync.Mutex
+	channel string
+	store   kv.BaseKV
+	replica Replica
+
+	nodes     map[string]*internalpb.MsgPosition   // node name => end position of the latest message pack processed
+	pending   map[UniqueID]*internalpb.MsgPosition // segment id => position from which data isn't saved by DataCoord
+	lastDirty map[UniqueID]Timestamp               // segment id => end timestamp of the latest data buffered
+}
+
+// newFlowGraphCheckpoint creates the checkpoint of the vchannel, returns nil if store is nil
+func newFlowGraphCheckpoint(channel string, store kv.BaseKV, replica Replica) *FlowGraphCheckpoint {
+	if store == nil {
+		return nil
+	}
+	return &FlowGraphCheckpoint{
+		channel:   channel,
+		store:     store,
+		replica:   replica,
+		nodes:     make(map[string]*internalpb.MsgPosition),
+		pending:   make(map[UniqueID]*internalpb.MsgPosition),
+		lastDirty: make(map[UniqueID]Timestamp),
+	}
+}
+
+func (c *FlowGraphCheckpoint) clonePosition(pos *internalpb.MsgPosition) *internalpb.MsgPosition {
+	cloned := proto.Clone(pos).(*internalpb.MsgPosition)
+	cloned.ChannelName = c.channel
+	return cloned
+}
+
+// markDirty records data of the segment between startPos and endPos is buffered
+func (c *FlowGraphCheckpoint) markDirty(segID UniqueID, startPos, endPos *internalpb.MsgPosition) {
+	if c == nil || startPos == nil || endPos == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.pending[segID]; !ok {
+		c.pending[segID] = c.clonePosition(startPos)
+	}
+	if endPos.GetTimestamp() > c.lastDirty[segID] {
+		c.lastDirty[segID] = endPos.GetTimestamp()
+	}
+}
+
+// ack records the node has processed the message pack ending at pos
+func (c *FlowGraphCheckpoint) ack(nodeName string, pos *internalpb.MsgPosition) {
+	if c == nil || pos == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nodes[nodeName] = c.clonePosition(pos)
+}
+
+// segmentSaved records the data of the segment up to pos is saved by DataCoord
+func (c *FlowGraphCheckpoint) segmentSaved(segID UniqueID, pos *internalpb.MsgPosition) {
+	if c == nil || pos == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.pending[segID]; !ok {
+		return
+	}
+	// data buffered after the flush was taken is still unsaved
+	if c.lastDirty[segID] <= pos.GetTimestamp() {
+		delete(c.pending, segID)
+		delete(c.lastDirty, segID)
+		return
+	}
+	c.pending[segID] = c.clonePosition(pos)
+}
+
+// position returns the checkpoint, nil if no node has processed any message pack
+func (c *FlowGraphCheckpoint) position() *internalpb.MsgPosition {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var min *internalpb.MsgPosition
+	for _, pos := range c.nodes {
+		if min == nil || pos.GetTimestamp() < min.GetTimestamp() {
+			min = pos
+		}
+	}
+	if min == nil {
+		return nil
+	}
+	for segID, pos := range c.pending {
+		// data of segments released from the replica is never saved
+		if !c.replica.hasSegment(segID, true) {
+			delete(c.pending, segID)
+			delete(c.lastDirty, segID)
+			continue
+		}
+		if pos.GetTimestamp() < min.GetTimestamp() {
+			min = pos
+		}
+	}
+	return proto.Clone(min).(*internalpb.MsgPosition)
+}
+
+// persist saves the checkpoint into the store
+func (c *FlowGraphCheckpoint) persist() error {
+	pos := c.position()
+	if pos == nil {
+		return nil
+	}
+	posBytes, err := proto.Marshal(pos)
+	if err != nil {
+		return err
+	}
+	value, err := json.Marshal(&flowGraphCheckpointValue{
+		Position:   posBytes,
+		SegmentIDs: c.replica.listAllSegmentIDs(),
+	})
+	if err != nil {
+		return err
+	}
+	return c.store.Save(c.channel, string(value))
+}
+
+// start persists the checkpoint every interval until ctx is done
+func (c *FlowGraphCheckpoint) start(ctx context.Context, interval time.Duration) {
+	if c == nil {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.persist(); err != nil {
+					log.Warn("failed to persist flow graph checkpoint", zap.String("vchannel", c.channel), zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// loadFlowGraphCheckpoint returns the persisted checkpoint of the vchannel if it's ahead of the seek position.
+// It's not used if the vchannel has unflushed segments unknown when the checkpoint was taken, since another
+// DataNode may have owned the vchannel meanwhile
+func loadFlowGraphCheckpoint(store kv.BaseKV, vchanInfo *datapb.VchannelInfo) *internalpb.MsgPosition {
+	seekPos := vchanInfo.GetSeekPosition()
+	if store == nil || seekPos == nil {
+		return nil
+	}
+	value, err := store.Load(vchanInfo.GetChannelName())
+	if err != nil || value == "" {
+		return nil
+	}
+	cp := &flowGraphCheckpointValue{}
+	if err := json.Unmarshal([]byte(value), cp); err != nil {
+		log.Warn("invalid flow graph checkpoint", zap.String("vchannel", vchanInfo.GetChannelName()), zap.Error(err))
+		return nil
+	}
+	pos := &internalpb.MsgPosition{}
+	if err := proto.Unmarshal(cp.Position, pos); err != nil {
+		log.Warn("invalid flow graph checkpoint", zap.String("vchannel", vchanInfo.GetChannelName()), zap.Error(err))
+		return nil
+	}
+	if pos.GetChannelName() != vchanInfo.GetChannelName() || pos.GetTimestamp() <= seekPos.GetTimestamp() {
+		return nil
+	}
+	known := make(map[UniqueID]struct{}, len(cp.SegmentIDs))
+	for _, segID := range cp.SegmentIDs {
+		known[segID] = struct{}{}
+	}
+	for _, us := range vchanInfo.GetUnflushedSegments() {
+		if _, ok := known[us.GetID()]; !ok {
+			log.Info("flow graph checkpoint ignored for unknown segment", zap.String("vchannel", vchanInfo.GetChannelName()),
+				zap.Int64("segmentID", us.GetID()))
+			return nil
+		}
+	}
+	return pos
+}
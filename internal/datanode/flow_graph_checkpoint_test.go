@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlowGraphCheckpoint(t *testing.T) {
+	const channel = "by-dev-rootcoord-dml_0_1v0"
+	pos := func(ts Timestamp) *internalpb.MsgPosition {
+		return &internalpb.MsgPosition{ChannelName: "by-dev-rootcoord-dml_0", MsgID: []byte{byte(ts)}, Timestamp: ts}
+	}
+	newCheckpoint := func() (*FlowGraphCheckpoint, *mockReplica) {
+		replica := newMockReplica()
+		replica.normalSegments[1] = &Segment{segmentID: 1}
+		replica.normalSegments[2] = &Segment{segmentID: 2}
+		return newFlowGraphCheckpoint(channel, memkv.NewMemoryKV(), replica), replica
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		c := newFlowGraphCheckpoint(channel, nil, newMockReplica())
+		assert.Nil(t, c)
+		c.markDirty(1, pos(1), pos(2))
+		c.ack("ibNode", pos(2))
+		c.segmentSaved(1, pos(2))
+		assert.Nil(t, c.position())
+		assert.NoError(t, c.persist())
+	})
+
+	t.Run("minimum node position", func(t *testing.T) {
+		c, _ := newCheckpoint()
+		assert.Nil(t, c.position())
+
+		c.ack("ibNode", pos(20))
+		c.ack("deleteNode", pos(10))
+		p := c.position()
+		assert.EqualValues(t, 10, p.GetTimestamp())
+		assert.Equal(t, channel, p.GetChannelName())
+
+		c.ack("deleteNode", pos(30))
+		assert.EqualValues(t, 20, c.position().GetTimestamp())
+	})
+
+	t.Run("unsaved data holds the checkpoint", func(t *testing.T) {
+		c, _ := newCheckpoint()
+		c.markDirty(1, pos(10), pos(20))
+		c.markDirty(1, pos(20), pos(30))
+		c.markDirty(2, pos(20), pos(30))
+		c.ack("ibNode", pos(50))
+		assert.EqualValues(t, 10, c.position().GetTimestamp())
+
+		// data buffered after the flush is still unsaved
+		c.markDirty(1, pos(40), pos(50))
+		c.segmentSaved(1, pos(30))
+		assert.EqualValues(t, 20, c.position().GetTimestamp())
+
+		c.segmentSaved(2, pos(30))
+		assert.EqualValues(t, 30, c.position().GetTimestamp())
+
+		c.segmentSaved(1, pos(50))
+		assert.EqualValues(t, 50, c.position().GetTimestamp())
+	})
+
+	t.Run("released segment", func(t *testing.T) {
+		c, replica := newCheckpoint()
+		c.markDirty(1, pos(10), pos(20))
+		c.ack("ibNode", pos(30))
+		assert.EqualValues(t, 10, c.position().GetTimestamp())
+
+		delete(replica.normalSegments, 1)
+		assert.EqualValues(t, 30, c.position().GetTimestamp())
+	})
+
+	t.Run("persist and load", func(t *testing.T) {
+		c, _ := newCheckpoint()
+		vchan := &datapb.VchannelInfo{
+			ChannelName:       channel,
+			SeekPosition:      pos(10),
+			UnflushedSegments: []*datapb.SegmentInfo{{ID: 1}, {ID: 2}},
+		}
+		assert.Nil(t, loadFlowGraphCheckpoint(c.store, vchan))
+		assert.Nil(t, loadFlowGraphCheckpoint(nil, vchan))
+
+		c.ack("ibNode", pos(30))
+		require.NoError(t, c.persist())
+		p := loadFlowGraphCheckpoint(c.store, vchan)
+		require.NotNil(t, p)
+		assert.EqualValues(t, 30, p.GetTimestamp())
+		assert.Equal(t, []byte{30}, p.GetMsgID())
+
+		// behind the seek position
+		vchan.SeekPosition = pos(40)
+		assert.Nil(t, loadFlowGraphCheckpoint(c.store, vchan))
+		vchan.SeekPosition = pos(10)
+
+		// unflushed segment created after the checkpoint
+		vchan.UnflushedSegments = append(vchan.UnflushedSegments, &datapb.SegmentInfo{ID: 3})
+		assert.Nil(t, loadFlowGraphCheckpoint(c.store, vchan))
+
+		require.NoError(t, c.store.Save(channel, "invalid"))
+		vchan.UnflushedSegments = vchan.UnflushedSegments[:2]
+		assert.Nil(t, loadFlowGraphCheckpoint(c.store, vchan))
+	})
+}
@@ -44,7 +44,8 @@ var _ flowgraph.Node = (*ddNode)(nil)
 //
 // ddNode filters insert messages according to the `flushedSegment` and `FilterThreshold`.
 //  If the timestamp of the insert message is earlier than `FilterThreshold`, ddNode will
-//  filter out the insert message for those who belong to `flushedSegment`
+//  filter out the insert message for those who belong to `flushedSegment`. So are the insert messages
+//  replayed after the flow graph is resumed, which are not later than `replayedTs`
 //
 // When receiving a `DropCollection` message, ddNode will send a signal to DataNode `BackgroundGC`
 //  goroutinue, telling DataNode to release the resources of this perticular flow graph.
@@ -62,6 +63,8 @@ type ddNode struct {
 
 	deltaMsgStream msgstream.MsgStream
 	dropMode       atomic.Value
+
+	replayedTs Timestamp // end timestamp of the messages processed before the flow graph is paused, 0 if never paused
 }
 
 // Name returns node name, implementing flowgraph.Node
@@ -127,7 +130,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 				//	zap.Int64("Expected collID", ddn.collectionID))
 				continue
 			}
-			if msg.EndTs() < FilterThreshold {
+			if msg.EndTs() < FilterThreshold || msg.EndTs() <= ddn.replayedTs {
 				log.Info("Filtering Insert Messages",
 					zap.Uint64("Message endts", msg.EndTs()),
 					zap.Uint64("FilterThreshold", FilterThreshold),
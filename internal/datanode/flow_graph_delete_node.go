@@ -20,12 +20,14 @@ import (
 	"context"
 	"math"
 	"sync"
+	"sync/atomic"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
@@ -45,7 +47,18 @@ type deleteNode struct {
 	idAllocator  allocatorInterface
 	flushManager flushManager
 
+	// applies deletes targeting multiple segments atomically, nil if blob storage is not configured
+	txnCoordinator *deleteTxnCoordinator
+
 	clearSignal chan<- UniqueID
+	checkpoint  *FlowGraphCheckpoint
+
+	// reportError restarts the vchannel if a delete fails to be applied, nil if never reported
+	reportError func(error)
+	// whether a delete failed to be applied, the checkpoint is never acked since then
+	failed bool
+
+	lastPosition atomic.Value // *internalpb.MsgPosition, end position of the latest message pack processed
 }
 
 // DelDataBuf buffers insert data, monitoring buffer size and limit
@@ -57,12 +70,40 @@ type DelDataBuf struct {
 	tsTo     Timestamp
 	fileSize int64
 	filePath string
+
+	startPos *internalpb.MsgPosition // start position of the first message pack buffered, nil if unknown
+
+	// keeps the latest delete of each primary key instead of delData if not nil, see Params.EnableDeleteDeduplication
+	dedup *DeleteDeduplicator
+
+	// delta logs finalized by delete transactions, which are saved already and saved into meta along with the buffer
+	committedLogs []*DelDataBuf
 }
 
 func (ddb *DelDataBuf) updateSize(size int64) {
 	ddb.size += size
 }
 
+// bufferDelete buffers the delete of pk at ts, returns the number of rows added into the buffer
+func (ddb *DelDataBuf) bufferDelete(pk int64, ts Timestamp) int64 {
+	if ddb.dedup != nil {
+		if ddb.dedup.Add(pk, ts) {
+			return 1
+		}
+		return 0
+	}
+	ddb.delData.Pks = append(ddb.delData.Pks, pk)
+	ddb.delData.Tss = append(ddb.delData.Tss, ts)
+	return 1
+}
+
+// applyDeduplication replaces delData with one delete of each primary key before flush
+func (ddb *DelDataBuf) applyDeduplication() {
+	if ddb.dedup != nil {
+		ddb.delData = ddb.dedup.DeleteData()
+	}
+}
+
 func (ddb *DelDataBuf) updateTimeRange(tr TimeRange) {
 	if tr.timestampMin < ddb.tsFrom {
 		ddb.tsFrom = tr.timestampMin
@@ -81,12 +122,33 @@ func newDelDataBuf() *DelDataBuf {
 	}
 }
 
+// newDelDataBufFromData creates a DelDataBuf holding the delete data
+func newDelDataBufFromData(data *DeleteData) *DelDataBuf {
+	buf := newDelDataBuf()
+	buf.delData = data
+	buf.updateSize(data.RowCount)
+	for _, ts := range data.Tss {
+		buf.updateTimeRange(TimeRange{timestampMin: ts, timestampMax: ts})
+	}
+	return buf
+}
+
 func (dn *deleteNode) Name() string {
 	return "deleteNode"
 }
 
+// Start starts the recovery GC of delete transactions
+func (dn *deleteNode) Start() {
+	if dn.txnCoordinator != nil {
+		dn.txnCoordinator.start()
+	}
+}
+
 func (dn *deleteNode) Close() {
 	log.Info("Flowgraph Delete Node closing")
+	if dn.txnCoordinator != nil {
+		dn.txnCoordinator.close()
+	}
 }
 
 func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) error {
@@ -108,6 +170,20 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) er
 		}
 	}
 
+	// a delete targeting multiple segments is applied to none of them unless its transaction commits,
+	// the delta logs finalized by the transaction are added to the buffers instead of the rows
+	var finalized map[UniqueID]*DelDataBuf
+	if len(segIDToPkMap) > 1 && dn.txnCoordinator != nil {
+		data := make(map[UniqueID]*DeleteData, len(segIDToPkMap))
+		for segID, pks := range segIDToPkMap {
+			data[segID] = &DeleteData{Pks: pks, Tss: segIDToTsMap[segID], RowCount: int64(len(pks))}
+		}
+		var err error
+		if finalized, err = dn.txnCoordinator.apply(msg.PartitionID, data); err != nil {
+			return err
+		}
+	}
+
 	for segID, pks := range segIDToPkMap {
 		rows := len(pks)
 		tss, ok := segIDToTsMap[segID]
@@ -121,17 +197,26 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) er
 			delDataBuf = value.(*DelDataBuf)
 		} else {
 			delDataBuf = newDelDataBuf()
+			if Params.EnableDeleteDeduplication {
+				delDataBuf.dedup = newDeleteDeduplicator()
+			}
+		}
+
+		if committed, ok := finalized[segID]; ok {
+			delDataBuf.committedLogs = append(delDataBuf.committedLogs, committed)
+			delDataBuf.updateTimeRange(tr)
+			dn.delBuf.Store(segID, delDataBuf)
+			continue
 		}
-		delData := delDataBuf.delData
 
+		var added int64
 		for i := 0; i < rows; i++ {
-			delData.Pks = append(delData.Pks, pks[i])
-			delData.Tss = append(delData.Tss, tss[i])
+			added += delDataBuf.bufferDelete(pks[i], tss[i])
 			log.Debug("delete", zap.Int64("primary key", pks[i]), zap.Uint64("ts", tss[i]))
 		}
 
 		// store
-		delDataBuf.updateSize(int64(rows))
+		delDataBuf.updateSize(added)
 		delDataBuf.updateTimeRange(tr)
 		dn.delBuf.Store(segID, delDataBuf)
 	}
@@ -177,37 +262,36 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 		msg.SetTraceCtx(ctx)
 	}
 
+	// deletes buffered before the segments are synced for a schema change end at the sync position
+	for _, segmentToSync := range fgMsg.segmentsToSync {
+		dn.flushDelBuf(segmentToSync, fgMsg.syncPosition)
+	}
+
+	// the checkpoint never passes a delete failed to be applied, which is applied again once the vchannel recovers
 	for i, msg := range fgMsg.deleteMessages {
 		traceID, _, _ := trace.InfoFromSpan(spans[i])
 		log.Info("Buffer delete request in DataNode", zap.String("traceID", traceID))
 
 		if err := dn.bufferDeleteMsg(msg, fgMsg.timeRange); err != nil {
-			log.Error("buffer delete msg failed", zap.Error(err))
+			log.Error("buffer delete msg failed, restart the vchannel", zap.Error(err))
+			dn.failed = true
+			if dn.reportError != nil {
+				dn.reportError(err)
+			}
 		}
 	}
 
 	// show all data in dn.delBuf
 	if len(fgMsg.deleteMessages) != 0 {
 		dn.showDelBuf()
+		dn.markDirtySegments(fgMsg)
 	}
 
 	// handle flush
 	if len(fgMsg.segmentsToFlush) > 0 {
 		log.Debug("DeleteNode receives flush message", zap.Int64s("segIDs", fgMsg.segmentsToFlush))
 		for _, segmentToFlush := range fgMsg.segmentsToFlush {
-			buf, ok := dn.delBuf.Load(segmentToFlush)
-			if !ok {
-				// send signal
-				dn.flushManager.flushDelData(nil, segmentToFlush, fgMsg.endPositions[0])
-			} else {
-				err := dn.flushManager.flushDelData(buf.(*DelDataBuf), segmentToFlush, fgMsg.endPositions[0])
-				if err != nil {
-					log.Warn("Failed to flush delete data", zap.Error(err))
-				} else {
-					// clean up
-					dn.delBuf.Delete(segmentToFlush)
-				}
-			}
+			dn.flushDelBuf(segmentToFlush, fgMsg.endPositions[0])
 		}
 	}
 
@@ -219,9 +303,74 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 	for _, sp := range spans {
 		sp.Finish()
 	}
+	if len(fgMsg.endPositions) > 0 {
+		dn.lastPosition.Store(fgMsg.endPositions[0])
+		if !dn.failed {
+			dn.checkpoint.ack(dn.Name(), fgMsg.endPositions[0])
+		}
+	}
 	return nil
 }
 
+// flushDelBuf flushes the delete buffer of segmentID at pos, which pairs with the insert buffer flushed at pos
+func (dn *deleteNode) flushDelBuf(segmentID UniqueID, pos *internalpb.MsgPosition) {
+	buf, ok := dn.delBuf.Load(segmentID)
+	if !ok {
+		// send signal
+		if _, err := flushDelDataBlocking(dn.flushManager, nil, segmentID, pos); err != nil {
+			log.Warn("Failed to flush delete data", zap.Error(err))
+		}
+		return
+	}
+	delDataBuf := buf.(*DelDataBuf)
+	delDataBuf.applyDeduplication()
+	_, err := flushDelDataBlocking(dn.flushManager, delDataBuf, segmentID, pos)
+	if err != nil {
+		log.Warn("Failed to flush delete data", zap.Error(err))
+	} else {
+		// clean up
+		dn.delBuf.Delete(segmentID)
+	}
+}
+
+// markDirtySegments records the start position of the buffers updated by the message pack, and marks the segments
+// dirty in the flow graph checkpoint. Time ranges of message packs are ascending so the buffers updated by the pack
+// end at its max timestamp
+func (dn *deleteNode) markDirtySegments(fgMsg *flowGraphMsg) {
+	if len(fgMsg.startPositions) == 0 || len(fgMsg.endPositions) == 0 {
+		return
+	}
+	dn.delBuf.Range(func(k, v interface{}) bool {
+		buf := v.(*DelDataBuf)
+		if buf.tsTo == fgMsg.timeRange.timestampMax {
+			if buf.startPos == nil {
+				buf.startPos = fgMsg.startPositions[0]
+			}
+			dn.checkpoint.markDirty(k.(UniqueID), fgMsg.startPositions[0], fgMsg.endPositions[0])
+		}
+		return true
+	})
+}
+
+// latestPosition returns the end position of the latest message pack processed, nil if none is processed
+func (dn *deleteNode) latestPosition() *internalpb.MsgPosition {
+	pos, _ := dn.lastPosition.Load().(*internalpb.MsgPosition)
+	return pos
+}
+
+// earliestBufferedPosition returns the earliest start position of the deletes buffered, nil if none is buffered
+func (dn *deleteNode) earliestBufferedPosition() *internalpb.MsgPosition {
+	var earliest *internalpb.MsgPosition
+	dn.delBuf.Range(func(_, v interface{}) bool {
+		pos := v.(*DelDataBuf).startPos
+		if pos != nil && (earliest == nil || pos.GetTimestamp() < earliest.GetTimestamp()) {
+			earliest = pos
+		}
+		return true
+	})
+	return earliest
+}
+
 // filterSegmentByPK returns the bloom filter check result.
 // If the key may exists in the segment, returns it in map.
 // If the key not exists in the segment, the segment is filter out.
@@ -246,7 +395,7 @@ func newDeleteNode(ctx context.Context, fm flushManager, sig chan<- UniqueID, co
 	baseNode.SetMaxQueueLength(config.maxQueueLength)
 	baseNode.SetMaxParallelism(config.maxParallelism)
 
-	return &deleteNode{
+	dn := &deleteNode{
 		BaseNode: baseNode,
 		delBuf:   sync.Map{},
 
@@ -255,5 +404,11 @@ func newDeleteNode(ctx context.Context, fm flushManager, sig chan<- UniqueID, co
 		channelName:  config.vChannelName,
 		flushManager: fm,
 		clearSignal:  sig,
-	}, nil
+		checkpoint:   config.checkpoint,
+		reportError:  config.reportError,
+	}
+	if config.blobKV != nil {
+		dn.txnCoordinator = newDeleteTxnCoordinator(ctx, config.blobKV, config.allocator, config.collectionID)
+	}
+	return dn, nil
 }
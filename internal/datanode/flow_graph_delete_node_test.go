@@ -28,7 +28,9 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockReplica struct {
@@ -95,6 +97,16 @@ func (replica *mockReplica) hasSegment(segID UniqueID, countFlushed bool) bool {
 	return has
 }
 
+func (replica *mockReplica) listAllSegmentIDs() []UniqueID {
+	var segIDs []UniqueID
+	for _, segs := range []map[UniqueID]*Segment{replica.newSegments, replica.normalSegments, replica.flushedSegments} {
+		for segID := range segs {
+			segIDs = append(segIDs, segID)
+		}
+	}
+	return segIDs
+}
+
 func TestFlowGraphDeleteNode_newDeleteNode(te *testing.T) {
 	tests := []struct {
 		ctx    context.Context
@@ -229,6 +241,53 @@ func TestFlowGraphDeleteNode_Operate(t *testing.T) {
 		}
 	})
 
+	t.Run("Test transactional delete", func(te *testing.T) {
+		c := &nodeConfig{
+			replica:      replica,
+			allocator:    NewAllocatorFactory(),
+			vChannelName: chanName,
+			blobKV:       memkv.NewMemoryKV(),
+		}
+		dn, err := newDeleteNode(context.Background(), fm, make(chan UniqueID, 1), c)
+		assert.Nil(te, err)
+		assert.NotNil(te, dn.txnCoordinator)
+
+		msg := genFlowGraphDeleteMsg(pks, chanName)
+		assert.Nil(te, dn.bufferDeleteMsg(msg.deleteMessages[0], msg.timeRange))
+		for _, segID := range segIDs {
+			v, ok := dn.delBuf.Load(segID)
+			require.True(te, ok)
+			// the delta logs finalized are buffered instead of the rows
+			buf := v.(*DelDataBuf)
+			assert.Empty(te, buf.delData.Pks)
+			require.Equal(te, 1, len(buf.committedLogs))
+			_, err := c.blobKV.Load(buf.committedLogs[0].filePath)
+			assert.Nil(te, err)
+		}
+		keys, _, err := c.blobKV.LoadWithPrefix(Params.PendingDeltaLogRootPath)
+		assert.Nil(te, err)
+		assert.Empty(te, keys)
+
+		// delete is applied to no segment if pending delta logs fail to be written, and the vchannel is restarted
+		var reported error
+		c.blobKV = &latencyKV{BaseKV: memkv.NewMemoryKV(), err: errors.New("mocked error")}
+		c.reportError = func(err error) { reported = err }
+		c.checkpoint = newFlowGraphCheckpoint(chanName, memkv.NewMemoryKV(), replica)
+		dn, err = newDeleteNode(context.Background(), fm, make(chan UniqueID, 1), c)
+		assert.Nil(te, err)
+		dn.txnCoordinator.retryOpts = []retry.Option{retry.Attempts(1)}
+		assert.NotNil(te, dn.bufferDeleteMsg(msg.deleteMessages[0], msg.timeRange))
+		for _, segID := range segIDs {
+			_, ok := dn.delBuf.Load(segID)
+			assert.False(te, ok)
+		}
+
+		dn.Operate([]flowgraph.Msg{&msg})
+		assert.Error(te, reported)
+		assert.True(te, dn.failed)
+		assert.Nil(te, c.checkpoint.nodes[dn.Name()])
+	})
+
 	t.Run("Test deleteNode Operate valid Msg with failure", func(te *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
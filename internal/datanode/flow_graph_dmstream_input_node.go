@@ -19,19 +19,61 @@ package datanode
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 )
 
 // DmInputNode receives messages from message streams, packs messages between two timeticks, and passes all
 //  messages between two timeticks to the following flowgraph node. In DataNode, the following flow graph node is
 //  flowgraph ddNode.
-func newDmInputNode(ctx context.Context, seekPos *internalpb.MsgPosition, dmNodeConfig *nodeConfig) (*flowgraph.InputNode, error) {
+func newDmInputNode(ctx context.Context, seekPos *internalpb.MsgPosition, dmNodeConfig *nodeConfig) (*dmInputNode, error) {
+	// MsgStream needs a physical channel name, but the channel name in seek position from DataCoord
+	//  is virtual channel name, so we need to convert vchannel name into pchannel neme here.
+	pchannelName := rootcoord.ToPhysicalChannel(dmNodeConfig.vChannelName)
+	if seekPos != nil {
+		seekPos.ChannelName = pchannelName
+	}
+	newStream := func(position *internalpb.MsgPosition) (msgstream.MsgStream, error) {
+		return newDmStream(ctx, pchannelName, position, dmNodeConfig)
+	}
+	insertStream, err := newStream(seekPos)
+	if err != nil {
+		return nil, err
+	}
+
+	node := flowgraph.NewInputNode(insertStream, "dmInputNode", dmNodeConfig.maxQueueLength, dmNodeConfig.maxParallelism)
+	dn := &dmInputNode{InputNode: node, newStream: newStream, closeCh: make(chan struct{})}
+	dn.recoveryLimiter = dmNodeConfig.recoveryLimiter
+	dn.ingestionGate = dmNodeConfig.ingestionGate
+	if dn.recoveryLimiter != nil || dn.ingestionGate != nil {
+		dn.limiterCtx, dn.limiterCancel = context.WithCancel(ctx)
+	}
+	if Params.ReorderBufferSize > 0 {
+		dn.reorder = newReorderBuffer(Params.ReorderBufferSize, time.Duration(Params.ReorderMaxDelayMs)*time.Millisecond)
+		dn.consumeCh = make(chan *flowgraph.MsgStreamMsg, Params.ReorderBufferSize)
+	}
+	if Params.PulsarHeartbeatTimeoutMs > 0 {
+		dn.watcher = newTopicWatcher(time.Duration(Params.PulsarHeartbeatTimeoutMs)*time.Millisecond, seekPos, dn.unblock)
+	}
+	return dn, nil
+}
+
+// newDmStream creates a msgstream consuming the physical channel from the seek position,
+// or from the position of the subscription if seekPos is nil
+func newDmStream(ctx context.Context, pchannelName string, seekPos *internalpb.MsgPosition, dmNodeConfig *nodeConfig) (msgstream.MsgStream, error) {
 	// subName should be unique, since pchannelName is shared among several collections
 	//	consumeSubName := Params.MsgChannelSubName + "-" + strconv.FormatInt(collID, 10)
 	consumeSubName := fmt.Sprintf("%s-%d", Params.MsgChannelSubName, dmNodeConfig.collectionID)
@@ -40,23 +82,317 @@ func newDmInputNode(ctx context.Context, seekPos *internalpb.MsgPosition, dmNode
 		return nil, err
 	}
 
-	// MsgStream needs a physical channel name, but the channel name in seek position from DataCoord
-	//  is virtual channel name, so we need to convert vchannel name into pchannel neme here.
-	pchannelName := rootcoord.ToPhysicalChannel(dmNodeConfig.vChannelName)
 	insertStream.AsConsumer([]string{pchannelName}, consumeSubName)
 	log.Debug("datanode AsConsumer", zap.String("physical channel", pchannelName), zap.String("subName", consumeSubName))
 
 	if seekPos != nil {
-		seekPos.ChannelName = pchannelName
 		start := time.Now()
 		log.Debug("datanode begin to seek: " + seekPos.GetChannelName())
 		err = insertStream.Seek([]*internalpb.MsgPosition{seekPos})
 		if err != nil {
+			insertStream.Close()
 			return nil, err
 		}
 		log.Debug("datanode Seek successfully: "+seekPos.GetChannelName(), zap.Int64("elapse ", time.Since(start).Milliseconds()))
 	}
+	return insertStream, nil
+}
 
-	node := flowgraph.NewInputNode(insertStream, "dmInputNode", dmNodeConfig.maxQueueLength, dmNodeConfig.maxParallelism)
-	return node, nil
+// dmInputNode is a flowgraph.InputNode which traces the ingestion of insert messages,
+// reorders message packs delivered out of order if the reorder buffer is enabled,
+// recreates the msgstream once no message pack is consumed within Params.PulsarHeartbeatTimeoutMs,
+// limits the messages replayed per second until caught up with the stream head, and pauses while the ingestion gate blocks
+type dmInputNode struct {
+	*flowgraph.InputNode
+
+	reorder   *ReorderBuffer               // nil if not enabled
+	consumeCh chan *flowgraph.MsgStreamMsg // message packs consumed in stream order, closed once the stream is closed
+
+	watcher   *TopicWatcher // nil if not enabled
+	newStream func(seekPos *internalpb.MsgPosition) (msgstream.MsgStream, error)
+	streamMu  sync.Mutex // guards replacing the msgstream against closing it
+
+	recoveryLimiter *RecoveryRateLimiter            // nil if unlimited or caught up
+	ingestionGate   func(ctx context.Context) error // blocks while ingestion is paused, nil if never paused
+	limiterCtx      context.Context                 // canceled once the node is closed, so that replay waiting returns
+	limiterCancel   context.CancelFunc
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+// Start starts the msgstream, and the loop consuming message packs into the reorder buffer if enabled
+func (dn *dmInputNode) Start() {
+	dn.InputNode.Start()
+	if dn.watcher != nil {
+		dn.watcher.start()
+	}
+	if dn.reorder != nil {
+		go dn.consumeLoop()
+	}
+}
+
+// Close closes the msgstream and stops the loop consuming message packs
+func (dn *dmInputNode) Close() {
+	dn.closeOnce.Do(func() {
+		close(dn.closeCh)
+	})
+	if dn.limiterCancel != nil {
+		dn.limiterCancel()
+	}
+	if dn.watcher != nil {
+		dn.watcher.close()
+	}
+	dn.streamMu.Lock()
+	defer dn.streamMu.Unlock()
+	dn.InputNode.Close()
+}
+
+// unblock closes the msgstream stuck, so that the consumer returns and recreates the msgstream
+func (dn *dmInputNode) unblock() {
+	dn.streamMu.Lock()
+	defer dn.streamMu.Unlock()
+	dn.InStream().Close()
+}
+
+// consume consumes a message pack from the msgstream, the msgstream is recreated at the last position consumed
+// if the watcher finds it stuck. nil is returned once the node is closed
+func (dn *dmInputNode) consume() []Msg {
+	for {
+		out := dn.InputNode.Operate(nil)
+		if dn.watcher == nil {
+			return out
+		}
+		if len(out) > 0 {
+			dn.watcher.received(out[0].(*MsgStreamMsg), time.Now())
+			return out
+		}
+		gap, position, ok := dn.watcher.recover(time.Now())
+		if !ok {
+			// msgstream is closed
+			return nil
+		}
+		for {
+			err := dn.reconnect(gap, position)
+			if err == nil {
+				break
+			}
+			log.Warn("failed to recreate dm stream", zap.Error(err))
+			select {
+			case <-dn.closeCh:
+				return nil
+			case <-time.After(reconnectInterval):
+			}
+		}
+	}
+}
+
+// reconnect replaces the msgstream closed by unblock with a new one seeking to the position
+func (dn *dmInputNode) reconnect(gap time.Duration, position *internalpb.MsgPosition) error {
+	stream, err := dn.newStream(position)
+	if err != nil {
+		return err
+	}
+	dn.streamMu.Lock()
+	defer dn.streamMu.Unlock()
+	select {
+	case <-dn.closeCh:
+		stream.Close()
+		return nil
+	default:
+	}
+	dn.SetInStream(stream)
+	stream.Start()
+	metrics.DataNodePulsarReconnections.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).Inc()
+	log.Info("dm stream recreated", zap.Duration("gap", gap), zap.String("channel", position.GetChannelName()),
+		zap.Uint64("timestamp", position.GetTimestamp()))
+	return nil
+}
+
+func (dn *dmInputNode) consumeLoop() {
+	defer close(dn.consumeCh)
+	for {
+		out := dn.consume()
+		if len(out) == 0 {
+			// msgstream is closed
+			return
+		}
+		select {
+		case dn.consumeCh <- out[0].(*flowgraph.MsgStreamMsg):
+		case <-dn.closeCh:
+			return
+		}
+	}
+}
+
+// Operate consumes a message pack from msgstream, an ingestion span is recorded for every insert message,
+//  which starts from the timestamp the message was produced.
+func (dn *dmInputNode) Operate(in []Msg) []Msg {
+	var out []Msg
+	if dn.reorder != nil {
+		out = dn.operateReordered()
+	} else {
+		out = dn.consume()
+	}
+	if dn.ingestionGate != nil && len(out) > 0 {
+		if err := dn.ingestionGate(dn.limiterCtx); err != nil {
+			// node is closed
+			return nil
+		}
+	}
+	if dn.recoveryLimiter != nil && len(out) > 0 {
+		if err := dn.recoveryLimiter.wait(dn.limiterCtx, out[0].(*MsgStreamMsg), time.Now()); err != nil {
+			// node is closed
+			return nil
+		}
+		if dn.recoveryLimiter.isCaughtUp() {
+			dn.recoveryLimiter = nil
+		}
+	}
+	for _, msg := range out {
+		msMsg, ok := msg.(*MsgStreamMsg)
+		if !ok {
+			continue
+		}
+		for _, tsMsg := range msMsg.TsMessages() {
+			insertMsg, ok := tsMsg.(*msgstream.InsertMsg)
+			if !ok {
+				continue
+			}
+			produced, _ := tsoutil.ParseTS(insertMsg.BeginTs())
+			sp, ctx := trace.StartSpanFromContextWithOperationName(insertMsg.TraceCtx(), "DataNode-IngestInsertMsg", opentracing.StartTime(produced))
+			setInsertSpanTags(sp, insertMsg.GetCollectionID(), insertMsg.GetSegmentID(), int64(len(insertMsg.RowIDs)), insertMsgSize(insertMsg))
+			sp.Finish()
+			insertMsg.SetTraceCtx(ctx)
+		}
+	}
+	return out
+}
+
+// operateReordered returns the next message pack released by the reorder buffer
+func (dn *dmInputNode) operateReordered() []Msg {
+	for {
+		if msg := dn.reorder.Pop(time.Now()); msg != nil {
+			return []Msg{msg}
+		}
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if deadline, ok := dn.reorder.NextDeadline(); ok {
+			timer = time.NewTimer(time.Until(deadline))
+			timeout = timer.C
+		}
+		select {
+		case msg, ok := <-dn.consumeCh:
+			if timer != nil {
+				timer.Stop()
+			}
+			if !ok {
+				// stream is closed, release the message packs held
+				if msg := dn.reorder.PopHead(); msg != nil {
+					return []Msg{msg}
+				}
+				return nil
+			}
+			dn.reorder.Push(msg, time.Now())
+		case <-timeout:
+		}
+	}
+}
+
+// ReorderBuffer holds message packs delivered out of order, and releases them sorted by their end positions.
+// A message pack is held until the buffer is full or it has been held for maxDelay
+type ReorderBuffer struct {
+	size     int
+	maxDelay time.Duration
+	packs    []*reorderEntry // sorted by end timestamp
+	released Timestamp       // end timestamp of the last message pack released
+}
+
+type reorderEntry struct {
+	msg     *flowgraph.MsgStreamMsg
+	arrival time.Time
+}
+
+func newReorderBuffer(size int, maxDelay time.Duration) *ReorderBuffer {
+	return &ReorderBuffer{
+		size:     size,
+		maxDelay: maxDelay,
+		packs:    make([]*reorderEntry, 0, size),
+	}
+}
+
+// Push adds the message pack arrived at now to the buffer
+func (b *ReorderBuffer) Push(msg *flowgraph.MsgStreamMsg, now time.Time) {
+	if msg.TimestampMax() < b.released {
+		log.Warn("message pack arrived later than the max delay of reorder buffer",
+			zap.Uint64("endTs", msg.TimestampMax()), zap.Uint64("released", b.released))
+	}
+	i := sort.Search(len(b.packs), func(i int) bool {
+		return b.packs[i].msg.TimestampMax() > msg.TimestampMax()
+	})
+	b.packs = append(b.packs, nil)
+	copy(b.packs[i+1:], b.packs[i:])
+	b.packs[i] = &reorderEntry{msg: msg, arrival: now}
+}
+
+// Pop releases the first message pack in order if the buffer is full, or any message pack expires at now,
+// nil is returned if no message pack shall be released
+func (b *ReorderBuffer) Pop(now time.Time) *flowgraph.MsgStreamMsg {
+	if len(b.packs) == 0 {
+		return nil
+	}
+	if deadline, _ := b.NextDeadline(); len(b.packs) < b.size && now.Before(deadline) {
+		return nil
+	}
+	return b.PopHead()
+}
+
+// PopHead releases the first message pack in order regardless of the delay
+func (b *ReorderBuffer) PopHead() *flowgraph.MsgStreamMsg {
+	if len(b.packs) == 0 {
+		return nil
+	}
+	msg := b.packs[0].msg
+	b.packs[0] = nil
+	b.packs = b.packs[1:]
+	if msg.TimestampMax() > b.released {
+		b.released = msg.TimestampMax()
+	}
+	return msg
+}
+
+// NextDeadline returns the time the earliest arrived message pack expires
+func (b *ReorderBuffer) NextDeadline() (time.Time, bool) {
+	if len(b.packs) == 0 {
+		return time.Time{}, false
+	}
+	earliest := b.packs[0].arrival
+	for _, entry := range b.packs[1:] {
+		if entry.arrival.Before(earliest) {
+			earliest = entry.arrival
+		}
+	}
+	return earliest.Add(b.maxDelay), true
+}
+
+// Len returns the number of message packs held
+func (b *ReorderBuffer) Len() int {
+	return len(b.packs)
+}
+
+// setInsertSpanTags sets the common attributes of spans on insert path
+func setInsertSpanTags(sp opentracing.Span, collectionID, segmentID UniqueID, numRows, bufferSizeBytes int64) {
+	sp.SetTag("collectionID", collectionID)
+	sp.SetTag("segmentID", segmentID)
+	sp.SetTag("numRows", numRows)
+	sp.SetTag("bufferSizeBytes", bufferSizeBytes)
+}
+
+// insertMsgSize returns the bytes of row data carried by the insert message
+func insertMsgSize(msg *msgstream.InsertMsg) int64 {
+	var size int64
+	for _, blob := range msg.RowData {
+		size += int64(len(blob.GetValue()))
+	}
+	return size
 }
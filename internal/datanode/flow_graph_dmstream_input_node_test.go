@@ -20,11 +20,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockMsgStreamFactory struct {
@@ -101,4 +104,102 @@ func TestNewDmInputNode(t *testing.T) {
 	ctx := context.Background()
 	_, err := newDmInputNode(ctx, new(internalpb.MsgPosition), &nodeConfig{msFactory: &mockMsgStreamFactory{}})
 	assert.Nil(t, err)
+
+	defer func(size int) { Params.ReorderBufferSize = size }(Params.ReorderBufferSize)
+	Params.ReorderBufferSize = 4
+	node, err := newDmInputNode(ctx, nil, &nodeConfig{msFactory: &mockMsgStreamFactory{}})
+	assert.Nil(t, err)
+	assert.NotNil(t, node.reorder)
+}
+
+// packMsgStream delivers the message packs sent to packCh, Consume returns nil once packCh is closed
+type packMsgStream struct {
+	mockTtMsgStream
+	packCh chan *msgstream.MsgPack
+}
+
+func (ps *packMsgStream) Consume() *msgstream.MsgPack {
+	return <-ps.packCh
+}
+
+func newReorderedDmInputNode(stream msgstream.MsgStream, size int, maxDelay time.Duration) *dmInputNode {
+	return &dmInputNode{
+		InputNode: flowgraph.NewInputNode(stream, "dmInputNode", 1024, 1024),
+		reorder:   newReorderBuffer(size, maxDelay),
+		consumeCh: make(chan *flowgraph.MsgStreamMsg, size),
+		closeCh:   make(chan struct{}),
+	}
+}
+
+func operateTimestamp(t *testing.T, node *dmInputNode) Timestamp {
+	out := node.Operate(nil)
+	require.Equal(t, 1, len(out))
+	return out[0].(*flowgraph.MsgStreamMsg).TimestampMax()
+}
+
+func TestReorderBuffer(t *testing.T) {
+	b := newReorderBuffer(3, 100*time.Millisecond)
+	now := time.Now()
+	assert.Nil(t, b.Pop(now))
+	_, ok := b.NextDeadline()
+	assert.False(t, ok)
+
+	for _, ts := range []Timestamp{20, 10} {
+		b.Push(flowgraph.GenerateMsgStreamMsg(nil, ts, ts, nil, nil), now)
+	}
+	// neither full nor expired
+	assert.Nil(t, b.Pop(now))
+	deadline, ok := b.NextDeadline()
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(100*time.Millisecond), deadline)
+
+	// full
+	b.Push(flowgraph.GenerateMsgStreamMsg(nil, 15, 15, nil, nil), now.Add(time.Millisecond))
+	assert.EqualValues(t, 10, b.Pop(now).TimestampMax())
+	assert.Nil(t, b.Pop(now))
+
+	// expired
+	assert.EqualValues(t, 15, b.Pop(deadline).TimestampMax())
+	assert.EqualValues(t, 20, b.Pop(deadline).TimestampMax())
+	assert.Nil(t, b.Pop(deadline))
+	assert.Equal(t, 0, b.Len())
+
+	// packs later than the window are still released
+	b.Push(flowgraph.GenerateMsgStreamMsg(nil, 5, 5, nil, nil), now)
+	assert.EqualValues(t, 5, b.PopHead().TimestampMax())
+	assert.Nil(t, b.PopHead())
+}
+
+func TestDmInputNode_Reorder(t *testing.T) {
+	t.Run("out of order", func(t *testing.T) {
+		stream := &packMsgStream{packCh: make(chan *msgstream.MsgPack, 10)}
+		for _, ts := range []Timestamp{2, 1, 4, 3, 6, 5} {
+			stream.packCh <- &msgstream.MsgPack{BeginTs: ts, EndTs: ts}
+		}
+		close(stream.packCh)
+
+		node := newReorderedDmInputNode(stream, 2, time.Minute)
+		node.Start()
+		defer node.Close()
+		for ts := Timestamp(1); ts <= 6; ts++ {
+			assert.Equal(t, ts, operateTimestamp(t, node))
+		}
+		// stream is closed and nothing is held
+		assert.Empty(t, node.Operate(nil))
+	})
+
+	t.Run("max delay", func(t *testing.T) {
+		stream := &packMsgStream{packCh: make(chan *msgstream.MsgPack, 10)}
+		stream.packCh <- &msgstream.MsgPack{BeginTs: 2, EndTs: 2}
+		stream.packCh <- &msgstream.MsgPack{BeginTs: 1, EndTs: 1}
+
+		node := newReorderedDmInputNode(stream, 10, 50*time.Millisecond)
+		node.Start()
+		defer node.Close()
+		start := time.Now()
+		assert.EqualValues(t, 1, operateTimestamp(t, node))
+		assert.GreaterOrEqual(t, int64(time.Since(start)), int64(50*time.Millisecond))
+		assert.EqualValues(t, 2, operateTimestamp(t, node))
+		close(stream.packCh)
+	})
 }
@@ -21,9 +21,11 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/opentracing/opentracing-go"
@@ -53,6 +55,7 @@ type (
 
 type insertBufferNode struct {
 	BaseNode
+	ctx          context.Context
 	channelName  string
 	insertBuffer sync.Map // SegmentID to BufferData
 	replica      Replica
@@ -63,10 +66,27 @@ type insertBufferNode struct {
 	flushingSegCache *Cache
 	flushManager     flushManager
 
+	memMonitor   *MemoryPressureMonitor
+	spilledFiles map[UniqueID][]string    // SegmentID to local files of spilled insert buffers
+	spilledStats map[UniqueID]spilledStat // SegmentID to the size of spilled insert buffers
+	pendingRows  atomic.Int64             // rows buffered but not flushed yet, spilled ones included
+
+	leaseRenewedAt map[UniqueID]time.Time // SegmentID to the last sync renewing its lease
+
 	timeTickStream          msgstream.MsgStream
 	segmentStatisticsStream msgstream.MsgStream
 	ttLogger                timeTickLogger
 	ttMerger                *mergedTimeTickerSender
+	readOnly                bool // neither time ticks nor segment statistics are sent if the vchannel is shadow-read
+
+	checkpoint *FlowGraphCheckpoint
+	validator  *insertMsgValidator // nil if insert validation is disabled
+	preCreator *segmentPreCreator  // nil if segments are never allocated ahead of time
+
+	segmentAllocator segmentAllocatorFunc // allocates segments for rows inserted into sealed segments, nil if read-only
+
+	schemaChange atomic.Value            // *schemaChange staged by the schema watcher
+	lastPosition *internalpb.MsgPosition // end position of the latest message pack, nil if none is processed
 }
 
 type timeTickLogger struct {
@@ -110,14 +130,16 @@ type segmentFlushUnit struct {
 // BufferData buffers insert data, monitoring buffer size and limit
 // size and limit both indicate numOfRows
 type BufferData struct {
-	buffer *InsertData
-	size   int64
-	limit  int64
+	buffer     *InsertData
+	size       int64
+	limit      int64
+	memorySize int64 // bytes of row data buffered
 }
 
 // newBufferData needs an input dimension to calculate the limit of this buffer
 //
-// `limit` is the segment numOfRows a buffer can buffer at most.
+// `limit` is the segment numOfRows a buffer can buffer at most. The 16 MB buffer size below
+// is adjusted to the flush latency if the dynamic flush policy is enabled.
 //
 // For a float32 vector field:
 //  limit = 16 * 2^20 Byte [By default] / (dimension * 4 Byte)
@@ -135,9 +157,9 @@ func newBufferData(dimension int64) (*BufferData, error) {
 		return nil, errors.New("Invalid dimension")
 	}
 
-	limit := Params.FlushInsertBufferSize / (dimension * 4)
+	limit := flushPolicy.bufferSize() / (dimension * 4)
 
-	return &BufferData{&InsertData{Data: make(map[UniqueID]storage.FieldData)}, 0, limit}, nil
+	return &BufferData{&InsertData{Data: make(map[UniqueID]storage.FieldData)}, 0, limit, 0}, nil
 }
 
 func (bd *BufferData) effectiveCap() int64 {
@@ -162,6 +184,14 @@ func (ibNode *insertBufferNode) Close() {
 	if ibNode.segmentStatisticsStream != nil {
 		ibNode.segmentStatisticsStream.Close()
 	}
+
+	if ibNode.validator != nil {
+		ibNode.validator.close()
+	}
+
+	for segID := range ibNode.spilledFiles {
+		ibNode.removeSpilledFiles(segID)
+	}
 }
 
 func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
@@ -200,10 +230,23 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		endPositions = append(endPositions, pos)
 	}
 
+	// rows buffered are synced in the schema they are buffered in before a newer schema applies
+	syncPosition := ibNode.lastPosition
+	segmentsToSync := ibNode.applySchemaChange(fgMsg.timeRange.timestampMin)
+	ibNode.lastPosition = endPositions[0]
+
+	// rows violating schema constraints are not buffered
+	if ibNode.validator != nil {
+		fgMsg.insertMessages = ibNode.validator.validate(fgMsg.insertMessages)
+	}
+
 	// Updating segment statistics in replica
 	seg2Upload, err := ibNode.updateSegStatesInReplica(fgMsg.insertMessages, startPositions[0], endPositions[0])
 	if err != nil {
 		log.Warn("update segment states in Replica wrong", zap.Error(err))
+		if len(segmentsToSync) > 0 {
+			return []Msg{&flowGraphMsg{timeRange: fgMsg.timeRange, segmentsToSync: segmentsToSync, syncPosition: syncPosition}}
+		}
 		return []Msg{}
 	}
 
@@ -216,10 +259,20 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 
 	// insert messages -> buffer
 	for _, msg := range fgMsg.insertMessages {
+		sp, _ := trace.StartSpanFromContextWithOperationName(msg.TraceCtx(), "DataNode-BufferInsertMsg")
 		err := ibNode.bufferInsertMsg(msg, endPositions[0])
 		if err != nil {
+			trace.LogError(sp, err)
 			log.Warn("msg to buffer failed", zap.Error(err))
+		} else {
+			ibNode.checkpoint.markDirty(msg.GetSegmentID(), startPositions[0], endPositions[0])
+		}
+		var bufferSize int64
+		if bd, ok := ibNode.insertBuffer.Load(msg.GetSegmentID()); ok {
+			bufferSize = bd.(*BufferData).memorySize
 		}
+		setInsertSpanTags(sp, msg.GetCollectionID(), msg.GetSegmentID(), int64(len(msg.RowIDs)), bufferSize)
+		sp.Finish()
 	}
 
 	// Find and return the smaller input
@@ -252,6 +305,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		segmentID UniqueID
 		flushed   bool
 		dropped   bool
+		manual    bool // triggered by flush message, the flush is completed after the write barrier is released
 	}
 
 	var (
@@ -289,7 +343,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		// Auto Flush
 		for _, segToFlush := range seg2Upload {
 			// If full, auto flush
-			if bd, ok := ibNode.insertBuffer.Load(segToFlush); ok && bd.(*BufferData).effectiveCap() <= 0 {
+			if bd, ok := ibNode.insertBuffer.Load(segToFlush); ok && ibNode.bufferFull(segToFlush, bd.(*BufferData)) {
 				log.Info("Auto flush",
 					zap.Int64("segment id", segToFlush),
 					zap.String("vchannel name", ibNode.channelName),
@@ -319,6 +373,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 			for i, task := range flushTaskList {
 				if task.segmentID == fmsg.segmentID {
 					flushTaskList[i].flushed = fmsg.flushed
+					flushTaskList[i].manual = true
 					dup = true
 					break
 				}
@@ -336,20 +391,86 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 					segmentID: currentSegID,
 					flushed:   fmsg.flushed,
 					dropped:   false,
+					manual:    true,
 				})
 			}
 		default:
 		}
+
+		// Lease renewal
+		for _, segID := range ibNode.segmentsToRenewLease(time.Now()) {
+			dup := false
+			for _, task := range flushTaskList {
+				if task.segmentID == segID {
+					dup = true
+					break
+				}
+			}
+			if dup {
+				continue
+			}
+			log.Debug("sync segment to renew lease",
+				zap.Int64("segmentID", segID),
+				zap.String("vchannel name", ibNode.channelName),
+			)
+			var buf *BufferData
+			if bd, ok := ibNode.insertBuffer.Load(segID); ok {
+				buf = bd.(*BufferData)
+			}
+			flushTaskList = append(flushTaskList, flushTask{
+				buffer:    buf,
+				segmentID: segID,
+				flushed:   false,
+				dropped:   false,
+			})
+		}
 	}
 
 	for _, task := range flushTaskList {
-		err := ibNode.flushManager.flushBufferData(task.buffer, task.segmentID, task.flushed, task.dropped, endPositions[0])
+		sp, _ := trace.StartSpanFromContextWithOperationName(context.Background(), "DataNode-FlushBufferData")
+		collID, _, _ := ibNode.getCollectionandPartitionIDbySegID(task.segmentID)
+		var numRows, bufferSize int64
+		if task.buffer != nil {
+			numRows, bufferSize = task.buffer.size, task.buffer.memorySize
+		}
+		setInsertSpanTags(sp, collID, task.segmentID, numRows, bufferSize)
+
+		// spilled data is uploaded together with the buffer
+		buffer, err := ibNode.loadSpilledBuffer(task.segmentID, task.buffer)
 		if err != nil {
+			trace.LogError(sp, err)
+			log.Warn("failed to load spilled insert buffer", zap.Int64("segmentID", task.segmentID), zap.Error(err))
+			sp.Finish()
+			continue
+		}
+
+		var flushedRows int64
+		if buffer != nil {
+			flushedRows = buffer.size
+		}
+		barrier, err := ibNode.flushManager.flushBufferData(buffer, task.segmentID, task.flushed, task.dropped, endPositions[0])
+		if err != nil {
+			trace.LogError(sp, err)
 			log.Warn("failed to invoke flushBufferData", zap.Error(err))
 		} else {
+			if task.manual {
+				go ibNode.completeSyncFlush(task.segmentID, barrier)
+			}
 			segmentsToFlush = append(segmentsToFlush, task.segmentID)
+			ibNode.leaseRenewed(task.segmentID, time.Now())
 			ibNode.insertBuffer.Delete(task.segmentID)
+			ibNode.removeSpilledFiles(task.segmentID)
+			ibNode.pendingRows.Sub(flushedRows)
+			// buffer data is recycled by flush manager, the buffer merged with spilled data is recycled here
+			if buffer != task.buffer {
+				bufferDataPool.Release(task.buffer)
+			}
 		}
+		sp.Finish()
+	}
+
+	if ibNode.memMonitor != nil && ibNode.memMonitor.UnderPressure() {
+		ibNode.spillInsertBuffers(endPositions[0])
 	}
 
 	if err := ibNode.writeHardTimeTick(fgMsg.timeRange.timestampMax); err != nil {
@@ -363,22 +484,38 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		endPositions:    fgMsg.endPositions,
 		segmentsToFlush: segmentsToFlush,
 		dropCollection:  fgMsg.dropCollection,
+		segmentsToSync:  segmentsToSync,
+		syncPosition:    syncPosition,
 	}
 
 	for _, sp := range spans {
 		sp.Finish()
 	}
+	ibNode.checkpoint.ack(ibNode.Name(), endPositions[0])
 
 	// send delete msg to DeleteNode
 	return []Msg{&res}
 }
 
+// completeSyncFlush removes the segment from flushing cache once the write barrier is released,
+// which makes sure the delete data of the segment flushed by DeleteNode is saved as well
+func (ibNode *insertBufferNode) completeSyncFlush(segmentID UniqueID, barrier *WriteBarrier) {
+	if err := barrier.Wait(ibNode.ctx); err != nil {
+		// flow graph is closed, allows the segment to be flushed again
+		log.Warn("failed to wait for flush write barrier", zap.Int64("segmentID", segmentID), zap.Error(err))
+	}
+	ibNode.flushingSegCache.Remove(segmentID)
+}
+
 // updateSegStatesInReplica updates statistics in replica for the segments in insertMsgs.
 //  If the segment doesn't exist, a new segment will be created.
 //  The segment number of rows will be updated in mem, waiting to be uploaded to DataCoord.
 func (ibNode *insertBufferNode) updateSegStatesInReplica(insertMsgs []*msgstream.InsertMsg, startPos, endPos *internalpb.MsgPosition) (seg2Upload []UniqueID, err error) {
 	uniqueSeg := make(map[UniqueID]int64)
 	for _, msg := range insertMsgs {
+		if err = ibNode.rerouteSealedSegment(msg); err != nil {
+			return
+		}
 
 		currentSegID := msg.GetSegmentID()
 		collID := msg.GetCollectionID()
@@ -406,11 +543,39 @@ func (ibNode *insertBufferNode) updateSegStatesInReplica(insertMsgs []*msgstream
 	for id, num := range uniqueSeg {
 		seg2Upload = append(seg2Upload, id)
 		ibNode.replica.updateStatistics(id, num)
+		if ibNode.preCreator != nil {
+			ibNode.preCreator.observe(id)
+		}
 	}
 
 	return
 }
 
+// rerouteSealedSegment moves the rows of msg into a segment allocated by DataCoord if its segment is sealed,
+// the rows may still be assigned to the sealed segment before the sealing is known to the proxy
+func (ibNode *insertBufferNode) rerouteSealedSegment(msg *msgstream.InsertMsg) error {
+	segID := msg.GetSegmentID()
+	if !ibNode.replica.isSegmentSealed(segID) {
+		return nil
+	}
+	if ibNode.segmentAllocator == nil {
+		return fmt.Errorf("segment %d is sealed, no segment is allocated for its rows", segID)
+	}
+	rows := int64(len(msg.RowIDs))
+	assignments, err := ibNode.segmentAllocator(msg.GetCollectionID(), msg.GetPartitionID(), rows)
+	if err != nil {
+		return err
+	}
+	if len(assignments) != 1 || assignments[0].GetStatus().GetErrorCode() != commonpb.ErrorCode_Success ||
+		int64(assignments[0].GetCount()) < rows {
+		return fmt.Errorf("failed to allocate a segment for %d rows of sealed segment %d", rows, segID)
+	}
+	msg.SegmentID = assignments[0].GetSegID()
+	log.Debug("reroute rows of sealed segment", zap.Int64("segmentID", segID),
+		zap.Int64("target", msg.SegmentID), zap.Int64("rows", rows))
+	return nil
+}
+
 /* #nosec G103 */
 // bufferInsertMsg put InsertMsg into buffer
 // 	1.1 fetch related schema from replica
@@ -451,11 +616,14 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 		}
 	}
 
-	newbd, err := newBufferData(int64(dimension))
+	newbd, err := bufferDataPool.Acquire(int64(dimension))
 	if err != nil {
 		return err
 	}
-	bd, _ := ibNode.insertBuffer.LoadOrStore(currentSegID, newbd)
+	bd, loaded := ibNode.insertBuffer.LoadOrStore(currentSegID, newbd)
+	if loaded {
+		bufferDataPool.Release(newbd)
+	}
 
 	buffer := bd.(*BufferData)
 	idata := buffer.buffer
@@ -683,6 +851,8 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 
 	// update buffer size
 	buffer.updateSize(int64(len(msg.RowData)))
+	buffer.memorySize += insertMsgSize(msg)
+	ibNode.pendingRows.Add(int64(len(msg.RowData)))
 
 	// store in buffer
 	ibNode.insertBuffer.Store(currentSegID, buffer)
@@ -706,6 +876,9 @@ func readBinary(reader io.Reader, receiver interface{}, dataType schemapb.DataTy
 // writeHardTimeTick writes timetick once insertBufferNode operates.
 func (ibNode *insertBufferNode) writeHardTimeTick(ts Timestamp) error {
 	ibNode.ttLogger.LogTs(ts)
+	if ibNode.readOnly {
+		return nil
+	}
 	ibNode.ttMerger.bufferTs(ts)
 	return nil
 }
@@ -715,6 +888,10 @@ func (ibNode *insertBufferNode) writeHardTimeTick(ts Timestamp) error {
 //
 // Currently, the statistics includes segment ID and its total number of rows in memory.
 func (ibNode *insertBufferNode) uploadMemStates2Coord(segIDs []UniqueID) error {
+	// DataCoord takes the statistics as the ones of the DataNode owning the vchannel
+	if ibNode.readOnly {
+		return nil
+	}
 	statsUpdates := make([]*internalpb.SegmentStatisticsUpdates, 0, len(segIDs))
 	for _, segID := range segIDs {
 		updates, err := ibNode.replica.getSegmentStatisticsUpdates(segID)
@@ -767,25 +944,29 @@ func newInsertBufferNode(ctx context.Context, flushCh <-chan flushMsg, fm flushM
 	baseNode.SetMaxQueueLength(config.maxQueueLength)
 	baseNode.SetMaxParallelism(config.maxParallelism)
 
-	//input stream, data node time tick
-	wTt, err := config.msFactory.NewMsgStream(ctx)
-	if err != nil {
-		return nil, err
-	}
-	wTt.AsProducer([]string{Params.TimeTickChannelName})
-	log.Debug("datanode AsProducer", zap.String("TimeTickChannelName", Params.TimeTickChannelName))
-	var wTtMsgStream msgstream.MsgStream = wTt
-	wTtMsgStream.Start()
+	// a shadow reader never produces to DataCoord, so the streams are not created
+	var wTtMsgStream, segStatisticsMsgStream msgstream.MsgStream
+	if !config.readOnly {
+		//input stream, data node time tick
+		wTt, err := config.msFactory.NewMsgStream(ctx)
+		if err != nil {
+			return nil, err
+		}
+		wTt.AsProducer([]string{Params.TimeTickChannelName})
+		log.Debug("datanode AsProducer", zap.String("TimeTickChannelName", Params.TimeTickChannelName))
+		wTtMsgStream = wTt
+		wTtMsgStream.Start()
 
-	// update statistics channel
-	segS, err := config.msFactory.NewMsgStream(ctx)
-	if err != nil {
-		return nil, err
+		// update statistics channel
+		segS, err := config.msFactory.NewMsgStream(ctx)
+		if err != nil {
+			return nil, err
+		}
+		segS.AsProducer([]string{Params.SegmentStatisticsChannelName})
+		log.Debug("datanode AsProducer", zap.String("SegmentStatisChannelName", Params.SegmentStatisticsChannelName))
+		segStatisticsMsgStream = segS
+		segStatisticsMsgStream.Start()
 	}
-	segS.AsProducer([]string{Params.SegmentStatisticsChannelName})
-	log.Debug("datanode AsProducer", zap.String("SegmentStatisChannelName", Params.SegmentStatisticsChannelName))
-	var segStatisticsMsgStream msgstream.MsgStream = segS
-	segStatisticsMsgStream.Start()
 
 	mt := newMergedTimeTickerSender(func(ts Timestamp) error {
 		msgPack := msgstream.MsgPack{}
@@ -809,8 +990,18 @@ func newInsertBufferNode(ctx context.Context, flushCh <-chan flushMsg, fm flushM
 		return wTtMsgStream.Produce(&msgPack)
 	})
 
+	var validator *insertMsgValidator
+	if Params.EnableInsertValidation {
+		var err error
+		validator, err = newInsertMsgValidator(ctx, config.msFactory, config.replica)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &insertBufferNode{
 		BaseNode:     baseNode,
+		ctx:          ctx,
 		insertBuffer: sync.Map{},
 
 		timeTickStream:          wTtMsgStream,
@@ -821,9 +1012,19 @@ func newInsertBufferNode(ctx context.Context, flushCh <-chan flushMsg, fm flushM
 		flushingSegCache: flushingSegCache,
 		flushManager:     fm,
 
+		memMonitor:   memoryPressureMonitor,
+		spilledFiles: make(map[UniqueID][]string),
+		spilledStats: make(map[UniqueID]spilledStat),
+
 		replica:     config.replica,
 		idAllocator: config.allocator,
 		channelName: config.vChannelName,
 		ttMerger:    mt,
+		readOnly:    config.readOnly,
+		checkpoint:  config.checkpoint,
+		validator:   validator,
+		preCreator:  config.preCreator,
+
+		segmentAllocator: config.segmentAllocator,
 	}, nil
 }
@@ -19,17 +19,26 @@ package datanode
 import (
 	"context"
 	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/opentracing/opentracing-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/trace"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -125,6 +134,33 @@ func (*mockMsg) TimeTick() Timestamp {
 	return 0
 }
 
+func TestFlowGraphInsertBufferNode_ReadOnly(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// no stream is created for a shadow reader, so the failing factory is never called
+	c := &nodeConfig{
+		replica:      newMockReplica(),
+		msFactory:    &mockMsgStreamFactory{true, false},
+		allocator:    NewAllocatorFactory(),
+		vChannelName: "string",
+		readOnly:     true,
+	}
+	fm := NewRendezvousFlushManager(NewAllocatorFactory(), memkv.NewMemoryKV(), c.replica, func(*segmentFlushPack) {})
+	iBNode, err := newInsertBufferNode(ctx, make(chan flushMsg), fm, newCache(), c)
+	require.NoError(t, err)
+	defer iBNode.Close()
+
+	assert.Nil(t, iBNode.timeTickStream)
+	assert.Nil(t, iBNode.segmentStatisticsStream)
+	assert.NoError(t, iBNode.writeHardTimeTick(100))
+	assert.NoError(t, iBNode.uploadMemStates2Coord([]UniqueID{1}))
+
+	c.readOnly = false
+	_, err = newInsertBufferNode(ctx, make(chan flushMsg), fm, newCache(), c)
+	assert.Error(t, err)
+}
+
 func TestFlowGraphInsertBufferNode_Operate(t *testing.T) {
 	t.Run("Test iBNode Operate invalid Msg", func(te *testing.T) {
 		invalidInTests := []struct {
@@ -758,3 +794,175 @@ func TestInsertBufferNode_BufferData(te *testing.T) {
 
 	}
 }
+
+// mockOTLPReceiver is an OTLP/HTTP trace receiver collecting all the exported spans
+type mockOTLPReceiver struct {
+	*httptest.Server
+	mu    sync.Mutex
+	spans []*tracepb.Span
+}
+
+func newMockOTLPReceiver() *mockOTLPReceiver {
+	r := &mockOTLPReceiver{}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		exportReq := &coltracepb.ExportTraceServiceRequest{}
+		if err := proto.Unmarshal(body, exportReq); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.mu.Lock()
+		for _, rs := range exportReq.GetResourceSpans() {
+			for _, ils := range rs.GetInstrumentationLibrarySpans() {
+				r.spans = append(r.spans, ils.GetSpans()...)
+			}
+		}
+		r.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	return r
+}
+
+// spansByName returns the received spans of operation name
+func (r *mockOTLPReceiver) spansByName(name string) []*tracepb.Span {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ret []*tracepb.Span
+	for _, span := range r.spans {
+		if span.GetName() == name {
+			ret = append(ret, span)
+		}
+	}
+	return ret
+}
+
+func spanAttributes(span *tracepb.Span) map[string]int64 {
+	attrs := make(map[string]int64)
+	for _, kv := range span.GetAttributes() {
+		attrs[kv.GetKey()] = kv.GetValue().GetIntValue()
+	}
+	return attrs
+}
+
+func TestInsertBufferNode_Tracing(t *testing.T) {
+	receiver := newMockOTLPReceiver()
+	defer receiver.Close()
+
+	prevTracer := opentracing.GlobalTracer()
+	defer opentracing.SetGlobalTracer(prevTracer)
+	closer, err := trace.InitOTLPTracing("datanode-tracing-test", strings.TrimPrefix(receiver.URL, "http://"))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	insertChannelName := "datanode-01-test-flowgraphinsertbuffernode-tracing"
+
+	testPath := "/test/datanode/root/meta"
+	err = clearEtcd(testPath)
+	require.NoError(t, err)
+	Params.MetaRootPath = testPath
+
+	Factory := &MetaFactory{}
+	collMeta := Factory.GetCollectionMeta(UniqueID(0), "coll1")
+	mockRootCoord := &RootCoordFactory{}
+
+	replica, err := newReplica(ctx, mockRootCoord, collMeta.ID)
+	require.NoError(t, err)
+
+	err = replica.addNewSegment(1, collMeta.ID, 0, insertChannelName, &internalpb.MsgPosition{}, &internalpb.MsgPosition{})
+	require.NoError(t, err)
+
+	msFactory := msgstream.NewPmsFactory()
+	m := map[string]interface{}{
+		"receiveBufSize": 1024,
+		"pulsarAddress":  Params.PulsarAddress,
+		"pulsarBufSize":  1024}
+	err = msFactory.SetParams(m)
+	require.NoError(t, err)
+
+	fm := NewRendezvousFlushManager(NewAllocatorFactory(), memkv.NewMemoryKV(), replica, func(*segmentFlushPack) {})
+
+	flushChan := make(chan flushMsg, 100)
+	c := &nodeConfig{
+		replica:      replica,
+		msFactory:    msFactory,
+		allocator:    NewAllocatorFactory(),
+		vChannelName: "string",
+	}
+
+	iBNode, err := newInsertBufferNode(ctx, flushChan, fm, newCache(), c)
+	require.NoError(t, err)
+
+	flushChan <- flushMsg{
+		msgID:        1,
+		timestamp:    2000,
+		segmentID:    UniqueID(1),
+		collectionID: UniqueID(1),
+	}
+
+	inMsg := genFlowGraphInsertMsg(insertChannelName)
+	for _, msg := range inMsg.insertMessages {
+		msg.SetTraceCtx(context.Background())
+	}
+	var fgMsg flowgraph.Msg = &inMsg
+	iBNode.Operate([]flowgraph.Msg{fgMsg})
+
+	// flush the pending spans to receiver
+	require.NoError(t, closer.Close())
+
+	bufferSpans := receiver.spansByName("DataNode-BufferInsertMsg")
+	assert.Equal(t, len(inMsg.insertMessages), len(bufferSpans))
+	for _, span := range bufferSpans {
+		attrs := spanAttributes(span)
+		assert.EqualValues(t, inMsg.insertMessages[0].GetSegmentID(), attrs["segmentID"])
+		assert.EqualValues(t, inMsg.insertMessages[0].GetCollectionID(), attrs["collectionID"])
+		assert.EqualValues(t, len(inMsg.insertMessages[0].RowIDs), attrs["numRows"])
+		assert.Greater(t, attrs["bufferSizeBytes"], int64(0))
+	}
+
+	flushSpans := receiver.spansByName("DataNode-FlushBufferData")
+	require.Equal(t, 1, len(flushSpans))
+	assert.EqualValues(t, 1, spanAttributes(flushSpans[0])["segmentID"])
+}
+
+func TestInsertBufferNode_completeSyncFlush(t *testing.T) {
+	t.Run("barrier released", func(t *testing.T) {
+		ibNode := &insertBufferNode{
+			ctx:              context.Background(),
+			flushingSegCache: newCache(),
+		}
+		ibNode.flushingSegCache.Cache(1)
+
+		barrier := newWriteBarrier()
+		done := make(chan struct{})
+		go func() {
+			ibNode.completeSyncFlush(1, barrier)
+			close(done)
+		}()
+		// flush is not completed before the barrier is released
+		time.Sleep(50 * time.Millisecond)
+		assert.True(t, ibNode.flushingSegCache.checkIfCached(1))
+
+		barrier.close()
+		<-done
+		assert.False(t, ibNode.flushingSegCache.checkIfCached(1))
+	})
+
+	t.Run("flow graph closed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ibNode := &insertBufferNode{
+			ctx:              ctx,
+			flushingSegCache: newCache(),
+		}
+		ibNode.flushingSegCache.Cache(1)
+
+		ibNode.completeSyncFlush(1, newWriteBarrier())
+		assert.False(t, ibNode.flushingSegCache.checkIfCached(1))
+	})
+}
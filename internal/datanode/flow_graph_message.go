@@ -39,6 +39,10 @@ type flowGraphMsg struct {
 	//segmentsToFlush is the signal used by insertBufferNode to notify deleteNode to flush
 	segmentsToFlush []UniqueID
 	dropCollection  bool
+	// segmentsToSync are synced by insertBufferNode at syncPosition before a schema change, whose deletes are flushed
+	// by deleteNode at the same position before the delete messages are buffered
+	segmentsToSync []UniqueID
+	syncPosition   *internalpb.MsgPosition
 }
 
 func (fgMsg *flowGraphMsg) TimeTick() Timestamp {
@@ -28,3 +28,23 @@ type (
 	// InputNode is flowgraph.InputNode
 	InputNode = flowgraph.InputNode
 )
+
+// recoverableNode wraps a flowgraph node, recovers the panic raised in Operate
+// and reports it to the handler instead of crashing the whole process
+type recoverableNode struct {
+	Node
+	handler panicHandlerFunc
+}
+
+func newRecoverableNode(node Node, handler panicHandlerFunc) *recoverableNode {
+	return &recoverableNode{
+		Node:    node,
+		handler: handler,
+	}
+}
+
+// Operate implements flowgraph.Node, returns no message if the wrapped node panics
+func (n *recoverableNode) Operate(in []Msg) []Msg {
+	defer recoverPanic(n.Name(), n.handler)
+	return n.Node.Operate(in)
+}
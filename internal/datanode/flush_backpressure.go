@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// errFlushBackpressure is the error of a flush not enqueued since pending flush tasks reach the limit
+var errFlushBackpressure = errors.New("too many pending flush tasks")
+
+// flushTaskLimiter is a semaphore bounding the flush tasks pending in the flush queues of a flush manager
+type flushTaskLimiter struct {
+	slots   chan struct{}
+	timeout time.Duration
+}
+
+// newFlushTaskLimiter creates a flushTaskLimiter of maxPending slots, nil if maxPending is not positive.
+// acquire blocks for at most timeout if it's positive, and until a slot is released otherwise
+func newFlushTaskLimiter(maxPending int, timeout time.Duration) *flushTaskLimiter {
+	if maxPending <= 0 {
+		return nil
+	}
+	return &flushTaskLimiter{
+		slots:   make(chan struct{}, maxPending),
+		timeout: timeout,
+	}
+}
+
+// acquire takes a slot, errFlushBackpressure is returned if no slot is released within the timeout
+func (l *flushTaskLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	var timeout <-chan time.Time
+	if l.timeout > 0 {
+		timer := time.NewTimer(l.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-timeout:
+		return errFlushBackpressure
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *flushTaskLimiter) release() {
+	<-l.slots
+}
+
+// pending returns the number of slots taken
+func (l *flushTaskLimiter) pending() int {
+	return len(l.slots)
+}
+
+// flushDelDataBlocking enqueues the delete data of segmentID, waiting for a slot of the limiter as long as it takes.
+// The delete data is the half of a flush enqueued after its insert data, the flush task never runs if it is dropped
+func flushDelDataBlocking(fm flushManager, data *DelDataBuf, segmentID UniqueID, pos *internalpb.MsgPosition) (*WriteBarrier, error) {
+	for {
+		barrier, err := fm.flushDelData(data, segmentID, pos)
+		if !errors.Is(err, errFlushBackpressure) {
+			return barrier, err
+		}
+		log.Warn("delete data waits for pending flush tasks to finish", zap.Int64("segmentID", segmentID))
+	}
+}
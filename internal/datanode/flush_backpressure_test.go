@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushTaskLimiter(t *testing.T) {
+	assert.Nil(t, newFlushTaskLimiter(0, 0))
+
+	l := newFlushTaskLimiter(2, 10*time.Millisecond)
+	require.NoError(t, l.acquire(context.Background()))
+	require.NoError(t, l.acquire(context.Background()))
+	assert.Equal(t, 2, l.pending())
+	assert.True(t, errors.Is(l.acquire(context.Background()), errFlushBackpressure))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	l.timeout = 0
+	assert.True(t, errors.Is(l.acquire(ctx), context.Canceled))
+
+	// blocks until a slot is released without timeout
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- l.acquire(context.Background())
+	}()
+	select {
+	case <-acquired:
+		assert.FailNow(t, "slot acquired while all slots are taken")
+	case <-time.After(20 * time.Millisecond):
+	}
+	l.release()
+	assert.NoError(t, <-acquired)
+	assert.Equal(t, 2, l.pending())
+}
+
+// blockingFlushTask uploads nothing until unblock is closed
+type blockingFlushTask struct {
+	unblock chan struct{}
+}
+
+func (t *blockingFlushTask) flushInsertData() error {
+	<-t.unblock
+	return nil
+}
+
+func TestRendezvousFlushManager_Backpressure(t *testing.T) {
+	m := NewRendezvousFlushManager(&allocator{}, NewInMemoryKV(0), newMockReplica(), func(*segmentFlushPack) {})
+	m.limiter = newFlushTaskLimiter(1, 20*time.Millisecond)
+
+	// the task of segment 1 takes the only slot once both insert and delete data are enqueued
+	pos1 := &internalpb.MsgPosition{MsgID: []byte{1}}
+	q := m.getFlushQueue(1)
+	require.NoError(t, q.acquireSlot(m.ctx, pos1))
+	task := &blockingFlushTask{unblock: make(chan struct{})}
+	q.enqueueInsertFlush(task, map[UniqueID]string{}, map[UniqueID]string{}, map[UniqueID]string{}, false, false, pos1)
+	assert.Equal(t, 0, m.limiter.pending())
+	_, err := m.flushDelData(nil, 1, pos1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, m.limiter.pending())
+
+	// a task waiting for its delete data takes no slot, the delete data is rejected until the slot is released
+	pos2 := &internalpb.MsgPosition{MsgID: []byte{2}}
+	_, err = m.flushBufferData(nil, 2, false, false, pos2)
+	require.NoError(t, err)
+	_, err = m.flushDelData(nil, 2, pos2)
+	assert.True(t, errors.Is(err, errFlushBackpressure))
+
+	// the delete data waits for the slot instead
+	enqueued := make(chan *WriteBarrier)
+	go func() {
+		barrier, err := flushDelDataBlocking(m, nil, 2, pos2)
+		assert.NoError(t, err)
+		enqueued <- barrier
+	}()
+	select {
+	case <-enqueued:
+		t.Fatal("delete data is enqueued before the slot is released")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(task.unblock)
+	barrier := <-enqueued
+	require.NoError(t, barrier.Wait(context.Background()))
+	require.NoError(t, m.waitForFlushTasks(context.Background()))
+	assert.Equal(t, 0, m.limiter.pending())
+}
+
+// BenchmarkRendezvousFlushManager_Backpressure measures empty flushes of 64 segments, which never reach the default
+// limit of pending flush tasks, with and without the limiter
+func BenchmarkRendezvousFlushManager_Backpressure(b *testing.B) {
+	const segments = 64
+	for _, maxPending := range []int{0, 128} {
+		b.Run(fmt.Sprintf("maxPending=%d", maxPending), func(b *testing.B) {
+			m := NewRendezvousFlushManager(&allocator{}, NewInMemoryKV(0), newMockReplica(), func(*segmentFlushPack) {})
+			m.limiter = newFlushTaskLimiter(maxPending, 0)
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				pos := &internalpb.MsgPosition{MsgID: []byte(fmt.Sprintf("%d", n))}
+				for segmentID := UniqueID(0); segmentID < segments; segmentID++ {
+					if _, err := m.flushBufferData(nil, segmentID, false, false, pos); err != nil {
+						b.Fatal(err)
+					}
+					if _, err := m.flushDelData(nil, segmentID, pos); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+			require.NoError(b, m.waitForFlushTasks(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+const (
+	flushOutcomeFlushed = "flushed"
+	flushOutcomeDropped = "dropped"
+	flushOutcomeSynced  = "synced"
+	flushOutcomeFailed  = "failed"
+)
+
+// flushOutcome returns the outcome of the flush task, failed if the task or saving its result fails,
+// synced if the segment is neither flushed nor dropped
+func flushOutcome(pack *segmentFlushPack) string {
+	switch {
+	case pack.err != nil || pack.saveErr != nil:
+		return flushOutcomeFailed
+	case pack.dropped:
+		return flushOutcomeDropped
+	case pack.flushed:
+		return flushOutcomeFlushed
+	default:
+		return flushOutcomeSynced
+	}
+}
+
+// flushLatencyStats summarizes segment flush latencies by outcome for GetMetrics, the histogram of which
+// is exported to Prometheus
+type flushLatencyStats struct {
+	mu    sync.Mutex
+	stats map[string]metricsinfo.DataNodeFlushLatency
+}
+
+var segmentFlushLatencies = &flushLatencyStats{stats: make(map[string]metricsinfo.DataNodeFlushLatency)}
+
+func (s *flushLatencyStats) observe(outcome string, ms float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stat := s.stats[outcome]
+	stat.Count++
+	stat.TotalMs += ms
+	if ms > stat.MaxMs {
+		stat.MaxMs = ms
+	}
+	s.stats[outcome] = stat
+}
+
+func (s *flushLatencyStats) snapshot() map[string]metricsinfo.DataNodeFlushLatency {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := make(map[string]metricsinfo.DataNodeFlushLatency, len(s.stats))
+	for outcome, stat := range s.stats {
+		snapshot[outcome] = stat
+	}
+	return snapshot
+}
+
+// observeSegmentFlushLatency records the latency of a flush task of the collection, from the task enqueued till it's done
+func observeSegmentFlushLatency(collectionID UniqueID, pack *segmentFlushPack, latency time.Duration) {
+	outcome := flushOutcome(pack)
+	ms := float64(latency.Milliseconds())
+	metrics.DataNodeSegmentFlushLatency.WithLabelValues(strconv.FormatInt(Params.NodeID, 10),
+		strconv.FormatInt(collectionID, 10), outcome).Observe(ms)
+	segmentFlushLatencies.observe(outcome, ms)
+}
@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushOutcome(t *testing.T) {
+	assert.Equal(t, flushOutcomeSynced, flushOutcome(&segmentFlushPack{}))
+	assert.Equal(t, flushOutcomeFlushed, flushOutcome(&segmentFlushPack{flushed: true}))
+	assert.Equal(t, flushOutcomeDropped, flushOutcome(&segmentFlushPack{flushed: true, dropped: true}))
+	assert.Equal(t, flushOutcomeFailed, flushOutcome(&segmentFlushPack{flushed: true, err: assert.AnError}))
+	assert.Equal(t, flushOutcomeFailed, flushOutcome(&segmentFlushPack{flushed: true, saveErr: assert.AnError}))
+}
+
+func TestRendezvousFlushManager_FlushLatency(t *testing.T) {
+	m := NewRendezvousFlushManager(&allocator{}, NewInMemoryKV(0), newMockReplica(), func(*segmentFlushPack) {})
+	m.retryOpts = []retry.Option{retry.Attempts(1)}
+	before := segmentFlushLatencies.snapshot()
+
+	// a successful flush and a failed one
+	pos1 := &internalpb.MsgPosition{MsgID: []byte{1}}
+	_, err := m.flushBufferData(nil, 1, true, false, pos1)
+	require.NoError(t, err)
+	_, err = m.flushDelData(nil, 1, pos1)
+	require.NoError(t, err)
+	pos2 := &internalpb.MsgPosition{MsgID: []byte{2}}
+	q := m.getFlushQueue(2)
+	q.enqueueInsertFlush(&errFlushTask{}, map[UniqueID]string{}, map[UniqueID]string{}, map[UniqueID]string{}, false, false, pos2)
+	q.enqueueDelFlush(&emptyFlushTask{}, nil, pos2)
+	require.NoError(t, m.waitForFlushTasks(context.Background()))
+
+	after := segmentFlushLatencies.snapshot()
+	assert.Equal(t, before[flushOutcomeFlushed].Count+1, after[flushOutcomeFlushed].Count)
+	assert.Equal(t, before[flushOutcomeFailed].Count+1, after[flushOutcomeFailed].Count)
+	assert.Equal(t, before[flushOutcomeSynced].Count, after[flushOutcomeSynced].Count)
+	assert.GreaterOrEqual(t, testutil.CollectAndCount(metrics.DataNodeSegmentFlushLatency), 2)
+}
+
+func TestRendezvousFlushManager_FlushLatencySaveFailed(t *testing.T) {
+	m := NewRendezvousFlushManager(&allocator{}, NewInMemoryKV(0), newMockReplica(), func(pack *segmentFlushPack) {
+		pack.saveErr = assert.AnError
+	})
+	before := segmentFlushLatencies.snapshot()
+
+	pos := &internalpb.MsgPosition{MsgID: []byte{1}}
+	_, err := m.flushBufferData(nil, 1, true, false, pos)
+	require.NoError(t, err)
+	_, err = m.flushDelData(nil, 1, pos)
+	require.NoError(t, err)
+	require.NoError(t, m.waitForFlushTasks(context.Background()))
+
+	after := segmentFlushLatencies.snapshot()
+	assert.Equal(t, before[flushOutcomeFailed].Count+1, after[flushOutcomeFailed].Count)
+	assert.Equal(t, before[flushOutcomeFlushed].Count, after[flushOutcomeFlushed].Count)
+}
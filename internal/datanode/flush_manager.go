@@ -20,11 +20,14 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -32,16 +35,22 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 // flushManager defines a flush manager signature
 type flushManager interface {
-	// notify flush manager insert buffer data
-	flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) error
-	// notify flush manager del buffer data
-	flushDelData(data *DelDataBuf, segmentID UniqueID, pos *internalpb.MsgPosition) error
+	// notify flush manager insert buffer data, the returned barrier is released after the flush result is saved.
+	// data is recycled by the flush manager once it is accepted, caller shall not use it if no error is returned
+	flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) (*WriteBarrier, error)
+	// notify flush manager del buffer data, the returned barrier is released after the flush result is saved
+	flushDelData(data *DelDataBuf, segmentID UniqueID, pos *internalpb.MsgPosition) (*WriteBarrier, error)
 	// injectFlush injects compaction or other blocking task before flush sync
 	injectFlush(injection taskInjection, segments ...UniqueID)
+	// waitForFlushTasks blocks until all the flush tasks enqueued are done or ctx is done
+	waitForFlushTasks(ctx context.Context) error
+	// GetPendingFlushCount returns the number of flush tasks running or waiting in the flush queues
+	GetPendingFlushCount() int
 	// close handles resource clean up
 	close()
 }
@@ -51,11 +60,13 @@ type segmentFlushPack struct {
 	segmentID  UniqueID
 	insertLogs map[UniqueID]string
 	statsLogs  map[UniqueID]string
+	sketchLogs map[UniqueID]string
 	deltaLogs  []*DelDataBuf
 	pos        *internalpb.MsgPosition
 	flushed    bool
 	dropped    bool
 	err        error // task execution error, if not nil, notify func should stop datanode
+	saveErr    error // set by notify func if the flush result is not saved
 }
 
 // notifyMetaFunc notify meta to persistent flush result
@@ -86,6 +97,20 @@ type orderFlushQueue struct {
 	runningTasks  int32
 	injectHandler *injectHandler
 	postInjection postInjectionFunc
+
+	panicHandler panicHandlerFunc
+
+	// retryOpts are options to retry failed uploads of flush tasks, default options are used if empty
+	retryOpts []retry.Option
+
+	// limiter bounds the flush tasks pending in the queues of the flush manager, nil if unlimited
+	limiter *flushTaskLimiter
+
+	// collectionID labels the flush latency of the segment
+	collectionID UniqueID
+
+	// deltaMerger merges the delete data of the flush tasks pending, nil if Params.DeltaLogMergeThreshold is less than 2
+	deltaMerger *deltaMerger
 }
 
 // newOrderFlushQueue creates a orderFlushQueue
@@ -108,13 +133,24 @@ func (q *orderFlushQueue) init() {
 	})
 }
 
+// notify calls notifyFunc with the pack of the runner, and then records the latency of the runner,
+// so that the outcome tells whether the flush result is saved
+func (q *orderFlushQueue) notify(runner *flushTaskRunner, pack *segmentFlushPack) {
+	q.notifyFunc(pack)
+	observeSegmentFlushLatency(q.collectionID, pack, time.Since(runner.enqueueTime))
+}
+
 func (q *orderFlushQueue) getFlushTaskRunner(pos *internalpb.MsgPosition) *flushTaskRunner {
-	actual, loaded := q.working.LoadOrStore(string(pos.MsgID), newFlushTaskRunner(q.segmentID, q.injectCh))
+	runner := newFlushTaskRunner(q.segmentID, q.injectCh)
+	runner.panicHandler = q.panicHandler
+	runner.headOfLineWarn = q.warnHeadOfLine
+	actual, loaded := q.working.LoadOrStore(string(pos.MsgID), runner)
 	t := actual.(*flushTaskRunner)
 	if !loaded {
 
 		q.injectMut.Lock()
 		q.runningTasks++
+		metrics.DataNodePendingFlushTasks.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).Inc()
 		if q.injectHandler != nil {
 			q.injectHandler.close()
 			q.injectHandler = nil
@@ -122,17 +158,43 @@ func (q *orderFlushQueue) getFlushTaskRunner(pos *internalpb.MsgPosition) *flush
 		q.injectMut.Unlock()
 
 		q.tailMut.Lock()
-		t.init(q.notifyFunc, q.postTask, q.tailCh)
+		t.init(func(pack *segmentFlushPack) { q.notify(t, pack) }, q.postTask, q.tailCh)
 		q.tailCh = t.finishSignal
 		q.tailMut.Unlock()
 	}
 	return t
 }
 
+// warnHeadOfLine logs the flush task blocking the queue, with the time each task queued behind it has waited so far
+func (q *orderFlushQueue) warnHeadOfLine(head *flushTaskRunner) {
+	now := time.Now()
+	var waits []time.Duration
+	q.working.Range(func(_, value interface{}) bool {
+		if t := value.(*flushTaskRunner); t != head {
+			waits = append(waits, now.Sub(t.enqueueTime))
+		}
+		return true
+	})
+	// longest waiting first, which is the order of the queue
+	sort.Slice(waits, func(i, j int) bool { return waits[i] > waits[j] })
+	log.Warn("flush task blocks the flush queue of segment",
+		zap.Int64("segmentID", q.segmentID),
+		zap.Int("queueDepth", len(waits)),
+		zap.Duration("headOfLine", now.Sub(head.startTime)),
+		zap.Duration("queued", head.startTime.Sub(head.enqueueTime)),
+		zap.Durations("estimatedWaits", waits))
+}
+
 func (q *orderFlushQueue) postTask(pack *segmentFlushPack, postInjection postInjectionFunc) {
-	q.working.Delete(string(pack.pos.MsgID))
+	if v, ok := q.working.LoadAndDelete(string(pack.pos.MsgID)); ok {
+		runner := v.(*flushTaskRunner)
+		if runner.holdsSlot {
+			q.limiter.release()
+		}
+	}
 	q.injectMut.Lock()
 	q.runningTasks--
+	metrics.DataNodePendingFlushTasks.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).Dec()
 	if q.runningTasks == 0 {
 		q.injectHandler = newInjectHandler(q)
 	}
@@ -146,14 +208,63 @@ func (q *orderFlushQueue) postTask(pack *segmentFlushPack, postInjection postInj
 	q.injectMut.Unlock()
 }
 
+// acquireSlot takes a slot of the limiter before the insert or delete data at pos is enqueued, if the other of them
+// is enqueued already. A task waits for both of them before it runs, so only tasks ready to run take slots, which
+// are released as the tasks finish, whatever the flow graph nodes enqueuing them are blocked by
+func (q *orderFlushQueue) acquireSlot(ctx context.Context, pos *internalpb.MsgPosition) error {
+	if q.limiter == nil {
+		return nil
+	}
+	v, ok := q.working.Load(string(pos.MsgID))
+	if !ok {
+		return nil
+	}
+	runner := v.(*flushTaskRunner)
+	if runner.holdsSlot {
+		return nil
+	}
+	if err := q.limiter.acquire(ctx); err != nil {
+		return fmt.Errorf("failed to enqueue flush of segment %d: %w", q.segmentID, err)
+	}
+	runner.holdsSlot = true
+	return nil
+}
+
 // enqueueInsertBuffer put insert buffer data into queue
-func (q *orderFlushQueue) enqueueInsertFlush(task flushInsertTask, binlogs, statslogs map[UniqueID]string, flushed bool, dropped bool, pos *internalpb.MsgPosition) {
-	q.getFlushTaskRunner(pos).runFlushInsert(task, binlogs, statslogs, flushed, dropped, pos)
+func (q *orderFlushQueue) enqueueInsertFlush(task flushInsertTask, binlogs, statslogs, sketchlogs map[UniqueID]string, flushed bool, dropped bool, pos *internalpb.MsgPosition) *WriteBarrier {
+	runner := q.getFlushTaskRunner(pos)
+	runner.runFlushInsert(task, binlogs, statslogs, sketchlogs, flushed, dropped, pos, q.retryOpts...)
+	return runner.barrier
+}
+
+// enqueuePipelinedInsertFlush put insert buffer data into the flush pipeline
+func (q *orderFlushQueue) enqueuePipelinedInsertFlush(p *flushPipeline, serialize serializeInsertFunc, flushed bool, dropped bool, pos *internalpb.MsgPosition) *WriteBarrier {
+	runner := q.getFlushTaskRunner(pos)
+	runner.runPipelinedFlushInsert(p, serialize, flushed, dropped, pos)
+	return runner.barrier
 }
 
 // enqueueDelBuffer put delete buffer data into queue
-func (q *orderFlushQueue) enqueueDelFlush(task flushDeleteTask, deltaLogs *DelDataBuf, pos *internalpb.MsgPosition) {
-	q.getFlushTaskRunner(pos).runFlushDel(task, deltaLogs)
+func (q *orderFlushQueue) enqueueDelFlush(task flushDeleteTask, deltaLogs []*DelDataBuf, pos *internalpb.MsgPosition) *WriteBarrier {
+	runner := q.getFlushTaskRunner(pos)
+	runner.runFlushDel(task, deltaLogs, q.retryOpts...)
+	return runner.barrier
+}
+
+// enqueueMergingDelFlush holds delete data in the deltaMerger of the queue, the delete data is written into the delta log
+// of the earliest flush task holding delete data once they are merged
+func (q *orderFlushQueue) enqueueMergingDelFlush(m *rendezvousFlushManager, collID, partID UniqueID, data *DelDataBuf,
+	pos *internalpb.MsgPosition) *WriteBarrier {
+	runner := q.getFlushTaskRunner(pos)
+	runner.runFlushDel(&mergingDeleteTask{
+		m:         m,
+		merger:    q.deltaMerger,
+		delta:     q.deltaMerger.add(runner, data),
+		collID:    collID,
+		partID:    partID,
+		segmentID: q.segmentID,
+	}, data.committedLogs, q.retryOpts...)
+	return runner.barrier
 }
 
 // inject performs injection for current task queue
@@ -180,6 +291,7 @@ func newInjectHandler(q *orderFlushQueue) *injectHandler {
 
 func (h *injectHandler) handleInjection(q *orderFlushQueue) {
 	defer h.wg.Done()
+	defer recoverPanic("inject handler", q.panicHandler)
 	for {
 		select {
 		case inject := <-q.injectCh:
@@ -188,7 +300,7 @@ func (h *injectHandler) handleInjection(q *orderFlushQueue) {
 			q.tailCh = injectDone
 			q.tailMut.Unlock()
 			inject.injected <- struct{}{}
-			<-inject.injectOver
+			waitInjectOver(q, inject)
 			close(injectDone)
 		case <-h.done:
 			return
@@ -196,6 +308,28 @@ func (h *injectHandler) handleInjection(q *orderFlushQueue) {
 	}
 }
 
+// waitInjectOver waits for the injection over, or Params.FlushInjectTimeoutSeconds if positive, so that an injection
+// never over, e.g. of a compaction leaked, doesn't stall the flush queue forever
+func waitInjectOver(q *orderFlushQueue, inject taskInjection) {
+	if Params.FlushInjectTimeoutSeconds <= 0 {
+		<-inject.injectOver
+		return
+	}
+	timeout := time.Duration(Params.FlushInjectTimeoutSeconds) * time.Second
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-inject.injectOver:
+	case <-timer.C:
+		log.Error("injection is not over in time, flush queue resumes", zap.Int64("SegmentID", q.segmentID),
+			zap.Duration("timeout", timeout))
+		// the injection over later is received here, so that its owner is not blocked
+		go func() {
+			<-inject.injectOver
+		}()
+	}
+}
+
 func (h *injectHandler) close() {
 	h.once.Do(func() {
 		close(h.done)
@@ -212,11 +346,42 @@ type rendezvousFlushManager struct {
 	// segment id => flush queue
 	dispatcher sync.Map
 	notifyFunc notifyMetaFunc
+
+	// panicHandler handles panics of background goroutines, isolating the failure within current vchannel
+	panicHandler panicHandlerFunc
+
+	// pipeline flushes insert buffer data in stages, nil if Params.FlushPipelineDepth is not positive
+	pipeline *flushPipeline
+
+	// ctx is passed to flush tasks, in-flight uploads are aborted once it is done
+	ctx context.Context
+
+	// segmentAllocator assigns segments for rows of a buffer exceeding the segment max size, nil if buffers are never split
+	segmentAllocator segmentAllocatorFunc
+
+	// retryOpts are options to retry failed uploads of flush tasks, default options are used if empty
+	retryOpts []retry.Option
+
+	// limiter applies backpressure on the flow graph when pending flush tasks reach Params.MaxPendingFlushTasks
+	limiter *flushTaskLimiter
+
+	// deltaMergeThreshold is the number of delete buffers of a segment pending in its flush queue merged into one delta log
+	deltaMergeThreshold int
+
+	// readOnly drops the buffers flushed instead of uploading them, for binlogs of a shadow-read vchannel are never saved
+	readOnly bool
 }
 
 // getFlushQueue
 func (m *rendezvousFlushManager) getFlushQueue(segmentID UniqueID) *orderFlushQueue {
 	newQueue := newOrderFlushQueue(segmentID, m.notifyFunc)
+	newQueue.panicHandler = m.panicHandler
+	newQueue.retryOpts = m.retryOpts
+	newQueue.limiter = m.limiter
+	newQueue.deltaMerger = newDeltaMerger(m.deltaMergeThreshold)
+	if m.Replica != nil {
+		newQueue.collectionID = m.getCollectionID()
+	}
 	actual, _ := m.dispatcher.LoadOrStore(segmentID, newQueue)
 	// all operation on dispatcher is private, assertion ok guaranteed
 	queue := actual.(*orderFlushQueue)
@@ -226,42 +391,112 @@ func (m *rendezvousFlushManager) getFlushQueue(segmentID UniqueID) *orderFlushQu
 
 // notify flush manager insert buffer data
 func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID UniqueID, flushed bool,
-	dropped bool, pos *internalpb.MsgPosition) error {
+	dropped bool, pos *internalpb.MsgPosition) (*WriteBarrier, error) {
+
+	if m.readOnly && data != nil && data.buffer != nil {
+		bufferDataPool.Release(data)
+		data = nil
+	}
 
 	// empty flush
 	if data == nil || data.buffer == nil {
-		m.getFlushQueue(segmentID).enqueueInsertFlush(&flushBufferInsertTask{},
-			map[UniqueID]string{}, map[UniqueID]string{}, flushed, dropped, pos)
-		return nil
+		queue := m.getFlushQueue(segmentID)
+		if err := queue.acquireSlot(m.ctx, pos); err != nil {
+			return nil, err
+		}
+		return queue.enqueueInsertFlush(&flushBufferInsertTask{},
+			map[UniqueID]string{}, map[UniqueID]string{}, map[UniqueID]string{}, flushed, dropped, pos), nil
 	}
 
 	collID, partID, meta, err := m.getSegmentMeta(segmentID, pos)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if m.segmentAllocator != nil {
+		maxRows, err := maxRowsPerSegment(meta.GetSchema())
+		if err != nil {
+			return nil, err
+		}
+		if maxRows > 0 && data.size > maxRows {
+			if err := m.splitBufferData(data, segmentID, collID, partID, meta, maxRows, flushed, dropped, pos); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if m.pipeline != nil {
+		queue := m.getFlushQueue(segmentID)
+		if err := queue.acquireSlot(m.ctx, pos); err != nil {
+			return nil, err
+		}
+		m.updateSegmentCheckPoint(segmentID)
+		return queue.enqueuePipelinedInsertFlush(m.pipeline, func() (flushInsertTask, map[UniqueID]string, map[UniqueID]string, map[UniqueID]string, error) {
+			defer bufferDataPool.Release(data)
+			return m.serializeInsertData(collID, partID, segmentID, meta, data)
+		}, flushed, dropped, pos), nil
 	}
 
+	task, field2Insert, field2Stats, field2Sketch, err := m.serializeInsertData(collID, partID, segmentID, meta, data)
+	if err != nil {
+		return nil, err
+	}
+	queue := m.getFlushQueue(segmentID)
+	if err := queue.acquireSlot(m.ctx, pos); err != nil {
+		return nil, err
+	}
+	bufferDataPool.Release(data)
+
+	m.updateSegmentCheckPoint(segmentID)
+	return queue.enqueueInsertFlush(task, field2Insert, field2Stats, field2Sketch, flushed, dropped, pos), nil
+}
+
+// serializeInsertData encodes insert buffer data into binlogs, returns the upload task together with
+// the insert, stats and sketch binlog paths of each field
+func (m *rendezvousFlushManager) serializeInsertData(collID, partID, segmentID UniqueID, meta *etcdpb.CollectionMeta,
+	data *BufferData) (*flushBufferInsertTask, map[UniqueID]string, map[UniqueID]string, map[UniqueID]string, error) {
 	// encode data and convert output data
-	inCodec := storage.NewInsertCodec(meta)
+	inCodec, err := storage.NewBinlogInsertCodec(meta, Params.BinlogFormat)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
 
 	binLogs, statsBinlogs, err := inCodec.Serialize(partID, segmentID, data.buffer)
 	if err != nil {
-		return err
+		return nil, nil, nil, nil, err
+	}
+	// stats and sketches are left uncompressed, which are small and read by others directly
+	if err := storage.CompressFieldBlobs(Params.BinlogCompressionCodec, binLogs); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	observeCompressionRatios(collID, data.buffer, binLogs)
+
+	// stats of fields other than int64 ones are generated concurrently
+	fieldStatsBinlogs, err := inCodec.SerializeFieldStats(data.buffer)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	statsBinlogs = append(statsBinlogs, fieldStatsBinlogs...)
+
+	sketchBinlogs, err := inCodec.SerializeSketches(data.buffer)
+	if err != nil {
+		return nil, nil, nil, nil, err
 	}
 
 	start, _, err := m.allocIDBatch(uint32(len(binLogs)))
 	if err != nil {
-		return err
+		return nil, nil, nil, nil, err
 	}
 
 	field2Insert := make(map[UniqueID]string, len(binLogs))
-	kvs := make(map[string]string, len(binLogs))
+	field2Kvs := make(map[UniqueID]map[string]string, len(binLogs))
 	paths := make([]string, 0, len(binLogs))
 	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
 	for idx, blob := range binLogs {
 		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
 		if err != nil {
 			log.Error("Flush failed ... cannot parse string to fieldID ..", zap.Error(err))
-			return err
+			return nil, nil, nil, nil, err
 		}
 
 		logidx := start + int64(idx)
@@ -271,7 +506,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 
 		key := path.Join(Params.InsertBinlogRootPath, k)
 		paths = append(paths, key)
-		kvs[key] = string(blob.Value[:])
+		addFieldKv(field2Kvs, fieldID, key, string(blob.Value[:]))
 		field2Insert[fieldID] = key
 		field2Logidx[fieldID] = logidx
 	}
@@ -282,7 +517,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
 		if err != nil {
 			log.Error("Flush failed ... cannot parse string to fieldID ..", zap.Error(err))
-			return err
+			return nil, nil, nil, nil, err
 		}
 
 		logidx := field2Logidx[fieldID]
@@ -291,58 +526,206 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		k, _ := m.genKey(false, collID, partID, segmentID, fieldID, logidx)
 
 		key := path.Join(Params.StatsBinlogRootPath, k)
-		kvs[key] = string(blob.Value[:])
+		addFieldKv(field2Kvs, fieldID, key, string(blob.Value[:]))
 		field2Stats[fieldID] = key
 	}
 
-	m.updateSegmentCheckPoint(segmentID)
-	m.getFlushQueue(segmentID).enqueueInsertFlush(&flushBufferInsertTask{
-		BaseKV: m.BaseKV,
-		data:   kvs,
-	}, field2Insert, field2Stats, flushed, dropped, pos)
-	return nil
+	field2Sketch := make(map[UniqueID]string)
+	// write sketch binlog
+	for _, blob := range sketchBinlogs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		if err != nil {
+			log.Error("Flush failed ... cannot parse string to fieldID ..", zap.Error(err))
+			return nil, nil, nil, nil, err
+		}
+
+		logidx := field2Logidx[fieldID]
+
+		// no error raise if alloc=false
+		k, _ := m.genKey(false, collID, partID, segmentID, fieldID, logidx)
+
+		key := path.Join(Params.SketchBinlogRootPath, k)
+		addFieldKv(field2Kvs, fieldID, key, string(blob.Value[:]))
+		field2Sketch[fieldID] = key
+	}
+
+	task := &flushBufferInsertTask{
+		ctx:         m.ctx,
+		BaseKV:      m.BaseKV,
+		data:        field2Kvs,
+		concurrency: Params.FlushUploadConcurrency,
+		tempPrefix:  Params.BinlogTempPathPrefix,
+	}
+	return task, field2Insert, field2Stats, field2Sketch, nil
 }
 
 // notify flush manager del buffer data
 func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID UniqueID,
-	pos *internalpb.MsgPosition) error {
+	pos *internalpb.MsgPosition) (*WriteBarrier, error) {
+
+	if m.readOnly {
+		data = nil
+	}
 
 	// del signal with empty data
 	if data == nil || data.delData == nil {
-		m.getFlushQueue(segmentID).enqueueDelFlush(&flushBufferDeleteTask{}, nil, pos)
-		return nil
+		queue := m.getFlushQueue(segmentID)
+		if err := queue.acquireSlot(m.ctx, pos); err != nil {
+			return nil, err
+		}
+		return queue.enqueueDelFlush(&flushBufferDeleteTask{}, nil, pos), nil
+	}
+
+	// delta logs finalized by delete transactions are saved already, and only saved into meta with the flush
+	if len(data.delData.Pks) == 0 && len(data.committedLogs) > 0 {
+		queue := m.getFlushQueue(segmentID)
+		if err := queue.acquireSlot(m.ctx, pos); err != nil {
+			return nil, err
+		}
+		return queue.enqueueDelFlush(&flushBufferDeleteTask{}, data.committedLogs, pos), nil
 	}
 
 	collID, partID, err := m.getCollectionAndPartitionID(segmentID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	queue := m.getFlushQueue(segmentID)
+	if queue.deltaMerger != nil {
+		if err := queue.acquireSlot(m.ctx, pos); err != nil {
+			return nil, err
+		}
+		return queue.enqueueMergingDelFlush(m, collID, partID, data, pos), nil
+	}
+
+	kvs, deltaLogs, err := m.serializeDelData(collID, partID, segmentID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := queue.acquireSlot(m.ctx, pos); err != nil {
+		return nil, err
 	}
+	return queue.enqueueDelFlush(&flushBufferDeleteTask{
+		ctx:    m.ctx,
+		BaseKV: m.BaseKV,
+		data:   kvs,
+	}, append(deltaLogs, data.committedLogs...), pos), nil
+}
 
+// serializeDelData serializes the delete data into delta logs, along with the index of each of them
+func (m *rendezvousFlushManager) serializeDelData(collID, partID, segmentID UniqueID, data *DelDataBuf) (map[string]string, []*DelDataBuf, error) {
 	delCodec := storage.NewDeleteCodec()
 
-	blob, err := delCodec.Serialize(collID, partID, segmentID, data.delData)
+	parts, blobs, err := serializeDeleteData(delCodec, collID, partID, segmentID, data.delData, Params.MaxDeltaLogFileSizeBytes)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	logID, err := m.allocID()
+	start, _, err := m.allocIDBatch(uint32(len(blobs)))
 	if err != nil {
 		log.Error("failed to alloc ID", zap.Error(err))
-		return err
+		return nil, nil, err
 	}
 
-	blobKey, _ := m.genKey(false, collID, partID, segmentID, logID)
-	blobPath := path.Join(Params.DeleteBinlogRootPath, blobKey)
-	kvs := map[string]string{blobPath: string(blob.Value[:])}
-	data.fileSize = int64(len(blob.Value))
-	data.filePath = blobPath
-	log.Debug("delete blob path", zap.String("path", blobPath))
+	kvs := make(map[string]string, len(blobs))
+	deltaLogs := make([]*DelDataBuf, 0, len(blobs))
+	for i, blob := range blobs {
+		buf := data
+		// each part of split data is reported as a separate delta log
+		if len(blobs) > 1 {
+			buf = newDelDataBufFromData(parts[i])
+		}
+		blobKey, _ := m.genKey(false, collID, partID, segmentID, start+int64(i))
+		blobPath := path.Join(Params.DeleteBinlogRootPath, blobKey)
+		kvs[blobPath] = string(blob.Value[:])
+		// the index is written alongside the delta log, so that deletions of a pk can be located by binary search
+		kvs[blobPath+storage.DeltaLogIndexSuffix] = string(buildDeltaLogIndex(parts[i]).Marshal())
+		buf.fileSize = int64(len(blob.Value))
+		buf.filePath = blobPath
+		deltaLogs = append(deltaLogs, buf)
+		log.Debug("delete blob path", zap.String("path", blobPath))
+	}
+	return kvs, deltaLogs, nil
+}
 
-	m.getFlushQueue(segmentID).enqueueDelFlush(&flushBufferDeleteTask{
-		BaseKV: m.BaseKV,
-		data:   kvs,
-	}, data, pos)
-	return nil
+// serializeDeleteData serializes delete data into blobs. If the blob exceeds maxSize, the data is sorted by
+// primary key and split into two halves of the key range recursively, so that each blob can be applied independently.
+// Deletions of the same primary key are never split, and maxSize not positive means no limit
+func serializeDeleteData(codec *storage.DeleteCodec, collID, partID, segmentID UniqueID, data *DeleteData,
+	maxSize int64) ([]*DeleteData, []*Blob, error) {
+	blob, err := codec.Serialize(collID, partID, segmentID, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if maxSize <= 0 || int64(len(blob.Value)) <= maxSize {
+		return []*DeleteData{data}, []*Blob{blob}, nil
+	}
+
+	left, right := splitDeleteData(data)
+	if left == nil {
+		log.Warn("delta log exceeds max size but cannot be split", zap.Int64("segmentID", segmentID),
+			zap.Int("size", len(blob.Value)), zap.Int64("maxSize", maxSize))
+		return []*DeleteData{data}, []*Blob{blob}, nil
+	}
+	leftParts, leftBlobs, err := serializeDeleteData(codec, collID, partID, segmentID, left, maxSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	rightParts, rightBlobs, err := serializeDeleteData(codec, collID, partID, segmentID, right, maxSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(leftParts, rightParts...), append(leftBlobs, rightBlobs...), nil
+}
+
+// buildDeltaLogIndex builds the index of a delta log and reports the build time
+func buildDeltaLogIndex(data *DeleteData) *storage.DeltaLogIndex {
+	start := time.Now()
+	idx := storage.NewDeltaLogIndex(data)
+	metrics.DataNodeDeltaLogIndexBuildLatency.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).
+		Observe(float64(time.Since(start).Microseconds()) / 1000)
+	return idx
+}
+
+// splitDeleteData splits delete data by primary key, the first half holds the smaller keys.
+// nil is returned if all deletions are of the same primary key
+func splitDeleteData(data *DeleteData) (*DeleteData, *DeleteData) {
+	if len(data.Pks) < 2 {
+		return nil, nil
+	}
+	idx := make([]int, len(data.Pks))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return data.Pks[idx[i]] < data.Pks[idx[j]]
+	})
+
+	// split at the middle, deletions of the same key are kept in one half
+	mid := len(idx) / 2
+	for mid < len(idx) && data.Pks[idx[mid]] == data.Pks[idx[mid-1]] {
+		mid++
+	}
+	if mid == len(idx) {
+		mid = len(idx) / 2
+		for mid > 0 && data.Pks[idx[mid]] == data.Pks[idx[mid-1]] {
+			mid--
+		}
+	}
+	if mid == 0 {
+		return nil, nil
+	}
+
+	left, right := &DeleteData{}, &DeleteData{}
+	for i, j := range idx {
+		if i < mid {
+			left.Append(data.Pks[j], data.Tss[j])
+		} else {
+			right.Append(data.Pks[j], data.Tss[j])
+		}
+	}
+	return left, right
 }
 
 // injectFlush inject process before task finishes
@@ -375,8 +758,44 @@ func (m *rendezvousFlushManager) getSegmentMeta(segmentID UniqueID, pos *interna
 	return collID, partID, meta, nil
 }
 
+// GetPendingFlushCount sums the running tasks of all the flush queues
+func (m *rendezvousFlushManager) GetPendingFlushCount() int {
+	count := 0
+	m.dispatcher.Range(func(_, value interface{}) bool {
+		queue := value.(*orderFlushQueue)
+		queue.injectMut.Lock()
+		count += int(queue.runningTasks)
+		queue.injectMut.Unlock()
+		return true
+	})
+	return count
+}
+
+// waitForFlushTasks waits for the tail task of each flush queue, since tasks in a queue finish in order
+func (m *rendezvousFlushManager) waitForFlushTasks(ctx context.Context) error {
+	var err error
+	m.dispatcher.Range(func(k, v interface{}) bool {
+		//assertion ok
+		queue := v.(*orderFlushQueue)
+		queue.tailMut.Lock()
+		tailCh := queue.tailCh
+		queue.tailMut.Unlock()
+		select {
+		case <-tailCh:
+			return true
+		case <-ctx.Done():
+			err = ctx.Err()
+			return false
+		}
+	})
+	return err
+}
+
 // close cleans up all the left members
 func (m *rendezvousFlushManager) close() {
+	if m.pipeline != nil {
+		m.pipeline.close()
+	}
 	m.dispatcher.Range(func(k, v interface{}) bool {
 		//assertion ok
 		queue := v.(*orderFlushQueue)
@@ -389,20 +808,137 @@ func (m *rendezvousFlushManager) close() {
 	})
 }
 
+func addFieldKv(field2Kvs map[UniqueID]map[string]string, fieldID UniqueID, key, value string) {
+	kvs, ok := field2Kvs[fieldID]
+	if !ok {
+		kvs = make(map[string]string)
+		field2Kvs[fieldID] = kvs
+	}
+	kvs[key] = value
+}
+
 type flushBufferInsertTask struct {
+	ctx context.Context
 	kv.BaseKV
-	data map[string]string
+	data        map[UniqueID]map[string]string // field id => binlog kvs of the field
+	concurrency int                            // number of concurrent MultiSave calls
+	tempPrefix  string                         // binlogs are saved under it first if not empty, see twoPhaseSave
 }
 
 // flushInsertData implements flushInsertTask
+// binlogs are partitioned by field and uploaded with concurrent MultiSave calls,
+// the whole task fails if any of the partitions fails, and it will be retried by the flush queue
 func (t *flushBufferInsertTask) flushInsertData() error {
-	if t.BaseKV != nil && len(t.data) > 0 {
-		return t.MultiSave(t.data)
+	if t.BaseKV == nil || len(t.data) == 0 {
+		return nil
+	}
+	start := time.Now()
+	partitions := partitionFieldKvs(t.data, t.concurrency)
+	if len(partitions) == 1 {
+		if err := t.saveWithLimit(partitions[0]); err != nil {
+			return err
+		}
+		observeFlushLatency(time.Since(start))
+		return nil
+	}
+	var g errgroup.Group
+	for _, kvs := range partitions {
+		kvs := kvs
+		g.Go(func() error {
+			return t.saveWithLimit(kvs)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	observeFlushLatency(time.Since(start))
+	return nil
+}
+
+// saveWithLimit saves kvs once the blob storage bandwidth allows, and validates the checksum of the binlogs saved
+// if Params.EnableBinlogChecksumValidation
+func (t *flushBufferInsertTask) saveWithLimit(kvs map[string]string) error {
+	if err := waitBlobIO(t.ctx, kvs, blobIOTypeFlush); err != nil {
+		return err
+	}
+	var checksums map[string]uint32
+	if Params.EnableBinlogChecksumValidation {
+		checksums = binlogChecksums(kvs)
+	}
+	var err error
+	if t.tempPrefix != "" {
+		err = twoPhaseSave(t.ctx, t.BaseKV, t.tempPrefix, kvs)
+	} else {
+		err = t.MultiSaveWithContext(t.ctx, kvs)
+	}
+	if err != nil || checksums == nil {
+		return err
+	}
+	return validateSavedBinlogs(t.BaseKV, checksums)
+}
+
+// twoPhaseSave saves kvs under tempPrefix first, and then copies them to their keys and removes the temporary ones,
+// so that a binlog never appears partially written at its key. A failure of the first phase leaves temporary objects
+// only, and a failure of the second phase may leave both, the temporary objects are removed by the storage audit
+func twoPhaseSave(ctx context.Context, blobKV kv.BaseKV, tempPrefix string, kvs map[string]string) error {
+	temps := make(map[string]string, len(kvs))
+	dst2src := make(map[string]string, len(kvs))
+	tempKeys := make([]string, 0, len(kvs))
+	for key, value := range kvs {
+		tempKey := path.Join(tempPrefix, key)
+		temps[tempKey] = value
+		dst2src[key] = tempKey
+		tempKeys = append(tempKeys, tempKey)
+	}
+	if err := blobKV.MultiSaveWithContext(ctx, temps); err != nil {
+		return err
+	}
+
+	var err error
+	if copyKV, ok := blobKV.(kv.CopyKV); ok {
+		err = copyKV.MultiCopyWithContext(ctx, dst2src)
+	} else {
+		// kvs unable to copy on the server side have the values saved again
+		err = blobKV.MultiSaveWithContext(ctx, kvs)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := blobKV.MultiRemove(tempKeys); err != nil {
+		log.Warn("failed to remove temporary binlogs, left to the storage audit", zap.Strings("keys", tempKeys), zap.Error(err))
 	}
 	return nil
 }
 
+// partitionFieldKvs splits the kvs into at most n partitions, kvs of the same field are kept in one partition
+func partitionFieldKvs(field2Kvs map[UniqueID]map[string]string, n int) []map[string]string {
+	if n < 1 {
+		n = 1
+	}
+	if n > len(field2Kvs) {
+		n = len(field2Kvs)
+	}
+	fieldIDs := make([]UniqueID, 0, len(field2Kvs))
+	for fieldID := range field2Kvs {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	sort.Slice(fieldIDs, func(i, j int) bool { return fieldIDs[i] < fieldIDs[j] })
+
+	partitions := make([]map[string]string, n)
+	for i := range partitions {
+		partitions[i] = make(map[string]string)
+	}
+	for i, fieldID := range fieldIDs {
+		for k, v := range field2Kvs[fieldID] {
+			partitions[i%n][k] = v
+		}
+	}
+	return partitions
+}
+
 type flushBufferDeleteTask struct {
+	ctx context.Context
 	kv.BaseKV
 	data map[string]string
 }
@@ -410,30 +946,48 @@ type flushBufferDeleteTask struct {
 // flushDeleteData implements flushDeleteTask
 func (t *flushBufferDeleteTask) flushDeleteData() error {
 	if len(t.data) > 0 && t.BaseKV != nil {
-		return t.MultiSave(t.data)
+		return t.MultiSaveWithContext(t.ctx, t.data)
 	}
 	return nil
 }
 
 // NewRendezvousFlushManager create rendezvousFlushManager with provided allocator and kv
 func NewRendezvousFlushManager(allocator allocatorInterface, kv kv.BaseKV, replica Replica, f notifyMetaFunc) *rendezvousFlushManager {
-	return &rendezvousFlushManager{
-		allocatorInterface: allocator,
-		BaseKV:             kv,
-		notifyFunc:         f,
-		Replica:            replica,
+	m := &rendezvousFlushManager{
+		allocatorInterface:  allocator,
+		BaseKV:              kv,
+		notifyFunc:          f,
+		Replica:             replica,
+		ctx:                 context.Background(),
+		limiter:             newFlushTaskLimiter(Params.MaxPendingFlushTasks, time.Duration(Params.FlushBackpressureTimeoutMs)*time.Millisecond),
+		deltaMergeThreshold: Params.DeltaLogMergeThreshold,
+	}
+	if Params.FlushPipelineDepth > 0 {
+		// flush results of insert & delete data are all saved by the checkpointer of the pipeline
+		m.pipeline = newFlushPipeline(Params.FlushPipelineDepth, f)
+		m.notifyFunc = m.pipeline.checkpoint
+		m.pipeline.start()
 	}
+	return m
 }
 
 func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMetaFunc {
 	return func(pack *segmentFlushPack) {
 		if pack.err != nil {
-			log.Warn("flush pack with error, data node quit now")
-			// TODO silverxia change to graceful stop datanode
-			panic(pack.err)
+			log.Warn("flush pack with error, restart the vchannel", zap.Int64("SegmentID", pack.segmentID),
+				zap.Error(pack.err))
+			dsService.reportFlushError(pack.err)
+			return
+		}
+		// checkpoints of later packs would move past the binlogs never saved, whose rows are never replayed then
+		if dsService.flushFailed.Load() {
+			log.Warn("skip SaveBinlogPaths after a flush failed permanently", zap.Int64("SegmentID", pack.segmentID))
+			pack.saveErr = errFlushFailed
+			return
 		}
 		fieldInsert := []*datapb.FieldBinlog{}
 		fieldStats := []*datapb.FieldBinlog{}
+		fieldSketch := []*datapb.FieldBinlog{}
 		deltaInfos := []*datapb.DeltaLogInfo{}
 		checkPoints := []*datapb.CheckPoint{}
 		for k, v := range pack.insertLogs {
@@ -442,6 +996,9 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 		for k, v := range pack.statsLogs {
 			fieldStats = append(fieldStats, &datapb.FieldBinlog{FieldID: k, Binlogs: []string{v}})
 		}
+		for k, v := range pack.sketchLogs {
+			fieldSketch = append(fieldSketch, &datapb.FieldBinlog{FieldID: k, Binlogs: []string{v}})
+		}
 		for _, delData := range pack.deltaLogs {
 			deltaInfos = append(deltaInfos, &datapb.DeltaLogInfo{RecordEntries: uint64(delData.size), TimestampFrom: delData.tsFrom, TimestampTo: delData.tsTo, DeltaLogPath: delData.filePath, DeltaLogSize: delData.fileSize})
 		}
@@ -459,6 +1016,7 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 			zap.Int64("CollectionID", dsService.collectionID),
 			zap.Int("Length of Field2BinlogPaths", len(fieldInsert)),
 			zap.Int("Length of Field2Stats", len(fieldStats)),
+			zap.Int("Length of Field2Sketches", len(fieldSketch)),
 			zap.Int("Length of Field2Deltalogs", len(deltaInfos)),
 		)
 
@@ -469,11 +1027,12 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 				Timestamp: 0, //TODO time stamp
 				SourceID:  Params.NodeID,
 			},
-			SegmentID:           pack.segmentID,
-			CollectionID:        dsService.collectionID,
-			Field2BinlogPaths:   fieldInsert,
-			Field2StatslogPaths: fieldStats,
-			Deltalogs:           deltaInfos,
+			SegmentID:            pack.segmentID,
+			CollectionID:         dsService.collectionID,
+			Field2BinlogPaths:    fieldInsert,
+			Field2StatslogPaths:  fieldStats,
+			Field2SketchlogPaths: fieldSketch,
+			Deltalogs:            deltaInfos,
 
 			CheckPoints: checkPoints,
 
@@ -481,28 +1040,138 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 			Flushed:        pack.flushed,
 			Dropped:        pack.dropped,
 		}
-		err := retry.Do(context.Background(), func() error {
-			rsp, err := dsService.dataCoord.SaveBinlogPaths(context.Background(), req)
-			// should be network issue, return error and retry
+		_, req.Rebuilt = dsService.rebuiltSegments.Load(pack.segmentID)
+
+		if dsService.readOnly {
+			log.Debug("skip SaveBinlogPaths of read-only data sync service", zap.Int64("SegmentID", pack.segmentID))
+			if pack.flushed || pack.dropped {
+				dsService.replica.segmentFlushed(pack.segmentID)
+			}
+			return
+		}
+
+		// block instead of failing when SaveBinlogPaths calls are throttled, so that flush order is preserved
+		if limiter := dsService.saveBinlogLimiter; limiter != nil {
+			err := limiter.wait(dsService.ctx)
+			metrics.DataNodeSaveBinlogTokens.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).Set(limiter.fillLevel())
 			if err != nil {
-				return fmt.Errorf(err.Error())
+				log.Warn("stop waiting for SaveBinlogPaths rate limiter", zap.Int64("SegmentID", pack.segmentID), zap.Error(err))
+				pack.saveErr = err
+				return
 			}
+		}
 
-			// TODO should retry only when datacoord status is unhealthy
-			if rsp.ErrorCode != commonpb.ErrorCode_Success {
-				return fmt.Errorf("data service save bin log path failed, reason = %s", rsp.Reason)
+		// the segment is errored while its circuit is open, its packs are held in the flush queue until the cooldown
+		// passes and a trial call is made, so that no binlogs are dropped. Other segments keep flushing
+		breaker := dsService.flushBreakers.get(pack.segmentID)
+
+		// calls failing to reach DataCoord are counted by the DataCoord circuit breaker instead of the segment's,
+		// once it opens, the flush queue waits until DataCoord is tried again, and ingestion pauses meanwhile
+		dataCoordBreaker := dsService.dataCoordBreaker
+		attempt := 0
+		leaseExpired := false
+		var err error
+		for {
+			if breaker != nil {
+				if err = breaker.Wait(dsService.ctx); err != nil {
+					log.Warn("stop waiting for the circuit of errored segment", zap.Int64("SegmentID", pack.segmentID),
+						zap.Error(err))
+					pack.saveErr = err
+					return
+				}
+				breaker.Allow()
+			}
+			if err = dsService.waitDataCoord(dsService.ctx); err != nil {
+				log.Warn("stop waiting for DataCoord", zap.Int64("SegmentID", pack.segmentID), zap.Error(err))
+				pack.saveErr = err
+				return
 			}
-			return nil
-		}, opts...)
+			err = retry.Do(context.Background(), func() error {
+				if dsService.flushFailed.Load() {
+					return retry.Unrecoverable(errFlushFailed)
+				}
+				if dataCoordBreaker != nil && !dataCoordBreaker.Allow() {
+					return retry.Unrecoverable(fmt.Errorf("DataCoord %w", errCircuitOpen))
+				}
+				attempt++
+				rsp, err := dsService.dataCoord.SaveBinlogPaths(context.Background(), req)
+				if err == nil && rsp.GetErrorCode() == commonpb.ErrorCode_Success {
+					if dataCoordBreaker != nil {
+						dataCoordBreaker.Success()
+					}
+					return nil
+				}
+				// binlogs are saved, while the segment is sealed by DataCoord and rows are assigned to other segments
+				if err == nil && rsp.GetErrorCode() == commonpb.ErrorCode_SegmentLeaseExpired {
+					log.Warn("segment lease expired, the segment is sealed by DataCoord",
+						zap.Int64("SegmentID", pack.segmentID), zap.String("reason", rsp.GetReason()))
+					leaseExpired = true
+					return nil
+				}
+				if err != nil && dataCoordBreaker != nil {
+					log.Warn("SaveBinlogPaths failed to reach DataCoord", zap.Int64("SegmentID", pack.segmentID),
+						zap.Int("retry_number", attempt), zap.Error(err))
+					if dataCoordBreaker.Failure() {
+						log.Warn("DataCoord circuit opens, flush and ingestion pause", zap.Error(err))
+						return retry.Unrecoverable(fmt.Errorf("DataCoord %w: %v", errCircuitOpen, err))
+					}
+					return err
+				}
+				// the error code decides whether to retry, err is replaced with it below
+				retryable := IsRetryable(err, rsp)
+				if err == nil {
+					err = fmt.Errorf("data service save bin log path failed, error code = %s, reason = %s",
+						rsp.GetErrorCode(), rsp.GetReason())
+				}
+				log.Warn("SaveBinlogPaths attempt failed", zap.Int64("SegmentID", pack.segmentID),
+					zap.Int("retry_number", attempt), zap.Bool("retryable", retryable), zap.Error(err))
+				if breaker != nil && breaker.Failure() {
+					return retry.Unrecoverable(fmt.Errorf("%w after %d attempts: %v", errCircuitOpen, attempt, err))
+				}
+				if !retryable {
+					return retry.Unrecoverable(err)
+				}
+				return err
+			}, opts...)
+			if err != nil && breaker != nil && breaker.State() == circuitOpen {
+				log.Warn("hold SaveBinlogPaths of segment, the segment is errored", zap.Int64("SegmentID", pack.segmentID),
+					zap.Error(err))
+				dsService.reportSegmentError(pack.segmentID, err)
+				continue
+			}
+			if err == nil || dataCoordBreaker == nil || dataCoordBreaker.State() != circuitOpen {
+				break
+			}
+		}
 		if err != nil {
-			log.Warn("failed to SaveBinlogPaths", zap.Error(err))
-			// TODO change to graceful stop
-			panic(err)
+			log.Warn("failed to SaveBinlogPaths, restart the vchannel", zap.Int64("SegmentID", pack.segmentID),
+				zap.Error(err))
+			dsService.reportFlushError(err)
+			pack.saveErr = err
+			return
+		}
+		if breaker != nil {
+			breaker.Success()
+		}
+		// rows inserted into the segment afterwards are moved to segments allocated by the insert buffer node
+		if leaseExpired {
+			dsService.replica.sealSegment(pack.segmentID)
+		}
+		if req.Rebuilt {
+			dsService.rebuiltSegments.Delete(pack.segmentID)
+		}
+
+		// binlogs are saved, messages of the segment up to the flushed position are durable now
+		if dsService.ackPublisher != nil && pack.pos != nil {
+			if err := dsService.ackPublisher.publish(pack.segmentID, pack.pos); err != nil {
+				log.Warn("failed to publish durability ack", zap.Int64("segmentID", pack.segmentID), zap.Error(err))
+			}
 		}
+		dsService.checkpoint.segmentSaved(pack.segmentID, pack.pos)
 
 		if pack.flushed || pack.dropped {
 			dsService.replica.segmentFlushed(pack.segmentID)
+			dsService.flushBreakers.remove(pack.segmentID)
 		}
-		dsService.flushingSegCache.Remove(req.GetSegmentID())
 	}
 }
@@ -20,11 +20,18 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +48,16 @@ func (t *emptyFlushTask) flushDeleteData() error {
 	return nil
 }
 
+type panicFlushTask struct{}
+
+func (t *panicFlushTask) flushInsertData() error {
+	panic("mock panic")
+}
+
+func (t *panicFlushTask) flushDeleteData() error {
+	panic("mock panic")
+}
+
 type errFlushTask struct{}
 
 func (t *errFlushTask) flushInsertData() error {
@@ -74,13 +91,13 @@ func TestOrderFlushQueue_Execute(t *testing.T) {
 	wg.Add(2 * size)
 	for i := 0; i < size; i++ {
 		go func(id []byte) {
-			q.enqueueDelFlush(&emptyFlushTask{}, &DelDataBuf{}, &internalpb.MsgPosition{
+			q.enqueueDelFlush(&emptyFlushTask{}, []*DelDataBuf{{}}, &internalpb.MsgPosition{
 				MsgID: id,
 			})
 			wg.Done()
 		}(ids[i])
 		go func(id []byte) {
-			q.enqueueInsertFlush(&emptyFlushTask{}, map[UniqueID]string{}, map[UniqueID]string{}, false, false, &internalpb.MsgPosition{
+			q.enqueueInsertFlush(&emptyFlushTask{}, map[UniqueID]string{}, map[UniqueID]string{}, map[UniqueID]string{}, false, false, &internalpb.MsgPosition{
 				MsgID: id,
 			})
 			wg.Done()
@@ -115,10 +132,10 @@ func TestOrderFlushQueue_Order(t *testing.T) {
 	wg := sync.WaitGroup{}
 	wg.Add(size)
 	for i := 0; i < size; i++ {
-		q.enqueueDelFlush(&emptyFlushTask{}, &DelDataBuf{}, &internalpb.MsgPosition{
+		q.enqueueDelFlush(&emptyFlushTask{}, []*DelDataBuf{{}}, &internalpb.MsgPosition{
 			MsgID: ids[i],
 		})
-		q.enqueueInsertFlush(&emptyFlushTask{}, map[UniqueID]string{}, map[UniqueID]string{}, false, false, &internalpb.MsgPosition{
+		q.enqueueInsertFlush(&emptyFlushTask{}, map[UniqueID]string{}, map[UniqueID]string{}, map[UniqueID]string{}, false, false, &internalpb.MsgPosition{
 			MsgID: ids[i],
 		})
 		wg.Done()
@@ -135,7 +152,7 @@ func TestOrderFlushQueue_Order(t *testing.T) {
 }
 
 func TestRendezvousFlushManager(t *testing.T) {
-	kv := memkv.NewMemoryKV()
+	kv := NewInMemoryKV(0)
 
 	size := 1000
 	var counter atomic.Int64
@@ -170,8 +187,258 @@ func TestRendezvousFlushManager(t *testing.T) {
 	assert.EqualValues(t, size, counter.Load())
 }
 
+func TestRendezvousFlushManager_WaitForFlushTasks(t *testing.T) {
+	kv := NewInMemoryKV(0)
+
+	var counter atomic.Int64
+	m := NewRendezvousFlushManager(&allocator{}, kv, newMockReplica(), func(pack *segmentFlushPack) {
+		counter.Inc()
+	})
+	assert.NoError(t, m.waitForFlushTasks(context.Background()))
+
+	pos := &internalpb.MsgPosition{MsgID: []byte{1}}
+	// task is not done until both insert and delete data are flushed
+	m.flushDelData(nil, 1, pos)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.Error(t, m.waitForFlushTasks(ctx))
+	assert.EqualValues(t, 0, counter.Load())
+
+	m.flushBufferData(nil, 1, true, false, pos)
+	assert.NoError(t, m.waitForFlushTasks(context.Background()))
+	assert.EqualValues(t, 1, counter.Load())
+}
+
+func TestRendezvousFlushManager_GetPendingFlushCount(t *testing.T) {
+	kv := NewInMemoryKV(0)
+	m := NewRendezvousFlushManager(&allocator{}, kv, newMockReplica(), func(pack *segmentFlushPack) {})
+	assert.Equal(t, 0, m.GetPendingFlushCount())
+
+	// tasks are pending until both insert and delete data are flushed
+	for i := 0; i < 3; i++ {
+		pos := &internalpb.MsgPosition{MsgID: []byte{byte(i)}}
+		m.flushDelData(nil, UniqueID(i%2), pos)
+	}
+	assert.Equal(t, 3, m.GetPendingFlushCount())
+
+	for i := 0; i < 3; i++ {
+		pos := &internalpb.MsgPosition{MsgID: []byte{byte(i)}}
+		m.flushBufferData(nil, UniqueID(i%2), true, false, pos)
+	}
+	assert.NoError(t, m.waitForFlushTasks(context.Background()))
+	assert.Equal(t, 0, m.GetPendingFlushCount())
+}
+
+func TestRendezvousFlushManager_WriteBarrier(t *testing.T) {
+	kv := NewInMemoryKV(0)
+
+	var saved atomic.Bool
+	m := NewRendezvousFlushManager(&allocator{}, kv, newMockReplica(), func(pack *segmentFlushPack) {
+		saved.Store(true)
+	})
+
+	pos := &internalpb.MsgPosition{MsgID: []byte{1}}
+	delBarrier, err := m.flushDelData(nil, 1, pos)
+	require.NoError(t, err)
+	// barrier is not released until insert data is flushed
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.Error(t, delBarrier.Wait(ctx))
+
+	insertBarrier, err := m.flushBufferData(nil, 1, true, false, pos)
+	require.NoError(t, err)
+	assert.Same(t, delBarrier, insertBarrier)
+	assert.NoError(t, delBarrier.Wait(context.Background()))
+	assert.True(t, saved.Load())
+}
+
+func TestSplitDeleteData(t *testing.T) {
+	t.Run("split by key range", func(t *testing.T) {
+		data := &DeleteData{}
+		for i, pk := range []int64{5, 1, 3, 3, 2, 4} {
+			data.Append(pk, Timestamp(100+i))
+		}
+		left, right := splitDeleteData(data)
+		require.NotNil(t, left)
+		require.NotNil(t, right)
+		// deletions of key 3 are kept in one half
+		assert.Equal(t, []int64{1, 2, 3, 3}, left.Pks)
+		assert.Equal(t, []Timestamp{101, 104, 102, 103}, left.Tss)
+		assert.EqualValues(t, 4, left.RowCount)
+		assert.Equal(t, []int64{4, 5}, right.Pks)
+		assert.Equal(t, []Timestamp{105, 100}, right.Tss)
+		assert.EqualValues(t, 2, right.RowCount)
+	})
+
+	t.Run("split before duplicate keys", func(t *testing.T) {
+		data := &DeleteData{}
+		for i, pk := range []int64{1, 2, 2, 2} {
+			data.Append(pk, Timestamp(100+i))
+		}
+		left, right := splitDeleteData(data)
+		require.NotNil(t, left)
+		assert.Equal(t, []int64{1}, left.Pks)
+		assert.Equal(t, []int64{2, 2, 2}, right.Pks)
+	})
+
+	t.Run("cannot split", func(t *testing.T) {
+		left, right := splitDeleteData(&DeleteData{Pks: []int64{1}, Tss: []Timestamp{1}, RowCount: 1})
+		assert.Nil(t, left)
+		assert.Nil(t, right)
+
+		left, right = splitDeleteData(&DeleteData{Pks: []int64{1, 1}, Tss: []Timestamp{1, 2}, RowCount: 2})
+		assert.Nil(t, left)
+		assert.Nil(t, right)
+	})
+}
+
+func TestSerializeDeleteData(t *testing.T) {
+	codec := storage.NewDeleteCodec()
+	data := &DeleteData{}
+	for i := 0; i < 100; i++ {
+		data.Append(int64(100-i), Timestamp(i+1))
+	}
+	full, err := codec.Serialize(0, 1, 1, data)
+	require.NoError(t, err)
+
+	parts, blobs, err := serializeDeleteData(codec, 0, 1, 1, data, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []*DeleteData{data}, parts)
+	assert.Equal(t, 1, len(blobs))
+
+	maxSize := int64(len(full.Value)) / 3
+	parts, blobs, err = serializeDeleteData(codec, 0, 1, 1, data, maxSize)
+	require.NoError(t, err)
+	require.Equal(t, len(parts), len(blobs))
+	assert.Greater(t, len(blobs), 2)
+
+	var rows int64
+	lastPk := int64(0)
+	for i, part := range parts {
+		assert.LessOrEqual(t, int64(len(blobs[i].Value)), maxSize)
+		rows += part.RowCount
+		// parts hold ascending key ranges
+		for _, pk := range part.Pks {
+			assert.Greater(t, pk, lastPk)
+			lastPk = pk
+		}
+		_, _, deserialized, err := codec.Deserialize([]*Blob{blobs[i]})
+		require.NoError(t, err)
+		assert.Equal(t, part.Pks, deserialized.Pks)
+	}
+	assert.EqualValues(t, 100, rows)
+}
+
+func TestRendezvousFlushManager_SplitDeltaLog(t *testing.T) {
+	defer func(origin int64) { Params.MaxDeltaLogFileSizeBytes = origin }(Params.MaxDeltaLogFileSizeBytes)
+
+	kv := NewInMemoryKV(0)
+	packCh := make(chan *segmentFlushPack, 1)
+	m := NewRendezvousFlushManager(NewAllocatorFactory(), kv, newMockReplica(), func(pack *segmentFlushPack) {
+		packCh <- pack
+	})
+
+	buf := newDelDataBuf()
+	for i := 0; i < 100; i++ {
+		buf.delData.Append(int64(i), Timestamp(i+1))
+	}
+	buf.updateSize(100)
+	buf.updateTimeRange(TimeRange{timestampMin: 1, timestampMax: 100})
+	full, err := storage.NewDeleteCodec().Serialize(0, 1, 1, buf.delData)
+	require.NoError(t, err)
+	Params.MaxDeltaLogFileSizeBytes = int64(len(full.Value)) / 2
+
+	pos := &internalpb.MsgPosition{MsgID: []byte{1}}
+	_, err = m.flushDelData(buf, 1, pos)
+	require.NoError(t, err)
+	_, err = m.flushBufferData(nil, 1, true, false, pos)
+	require.NoError(t, err)
+
+	pack := <-packCh
+	require.Greater(t, len(pack.deltaLogs), 1)
+	var rows int64
+	paths := make(map[string]struct{})
+	for _, deltaLog := range pack.deltaLogs {
+		rows += deltaLog.size
+		assert.LessOrEqual(t, deltaLog.fileSize, Params.MaxDeltaLogFileSizeBytes)
+		assert.EqualValues(t, deltaLog.delData.Tss[0], deltaLog.tsFrom)
+		assert.EqualValues(t, deltaLog.delData.Tss[len(deltaLog.delData.Tss)-1], deltaLog.tsTo)
+		value, err := kv.Load(deltaLog.filePath)
+		require.NoError(t, err)
+		assert.EqualValues(t, deltaLog.fileSize, len(value))
+		paths[deltaLog.filePath] = struct{}{}
+
+		value, err = kv.Load(deltaLog.filePath + storage.DeltaLogIndexSuffix)
+		require.NoError(t, err)
+		idx, err := storage.UnmarshalDeltaLogIndex([]byte(value))
+		require.NoError(t, err)
+		assert.Equal(t, len(deltaLog.delData.Pks), idx.Len())
+		for offset, pk := range deltaLog.delData.Pks {
+			assert.Equal(t, []int64{int64(offset)}, idx.Lookup(pk))
+		}
+	}
+	assert.EqualValues(t, 100, rows)
+	assert.Equal(t, len(pack.deltaLogs), len(paths))
+}
+
+func TestRendezvousFlushManager_ReadOnly(t *testing.T) {
+	kv := NewInMemoryKV(0)
+	packCh := make(chan *segmentFlushPack, 1)
+	m := NewRendezvousFlushManager(NewAllocatorFactory(), kv, newMockReplica(), func(pack *segmentFlushPack) {
+		packCh <- pack
+	})
+	m.readOnly = true
+
+	buf := newDelDataBuf()
+	for i := 0; i < 10; i++ {
+		buf.delData.Append(int64(i), Timestamp(i+1))
+	}
+	buf.updateSize(10)
+	buf.updateTimeRange(TimeRange{timestampMin: 1, timestampMax: 10})
+
+	pos := &internalpb.MsgPosition{MsgID: []byte{1}}
+	_, err := m.flushDelData(buf, 1, pos)
+	require.NoError(t, err)
+	_, err = m.flushBufferData(nil, 1, true, false, pos)
+	require.NoError(t, err)
+
+	pack := <-packCh
+	assert.NoError(t, pack.err)
+	assert.True(t, pack.flushed)
+	assert.Empty(t, pack.deltaLogs)
+	keys, _, err := kv.LoadWithPrefix("")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestRendezvousFlushManager_CommittedDeltaLogs(t *testing.T) {
+	kv := NewInMemoryKV(0)
+	packCh := make(chan *segmentFlushPack, 1)
+	m := NewRendezvousFlushManager(NewAllocatorFactory(), kv, newMockReplica(), func(pack *segmentFlushPack) {
+		packCh <- pack
+	})
+
+	buf := newDelDataBuf()
+	buf.committedLogs = append(buf.committedLogs, &DelDataBuf{
+		size:     2,
+		filePath: "committed/delta/log",
+		fileSize: 16,
+	})
+
+	pos := &internalpb.MsgPosition{MsgID: []byte{1}}
+	_, err := m.flushDelData(buf, 1, pos)
+	require.NoError(t, err)
+	_, err = m.flushBufferData(nil, 1, true, false, pos)
+	require.NoError(t, err)
+
+	pack := <-packCh
+	assert.NoError(t, pack.err)
+	require.Equal(t, 1, len(pack.deltaLogs))
+	assert.Equal(t, "committed/delta/log", pack.deltaLogs[0].filePath)
+}
+
 func TestRendezvousFlushManager_Inject(t *testing.T) {
-	kv := memkv.NewMemoryKV()
+	kv := NewInMemoryKV(0)
 
 	size := 1000
 	var counter atomic.Int64
@@ -259,10 +526,53 @@ func TestRendezvousFlushManager_Inject(t *testing.T) {
 
 }
 
+func TestRendezvousFlushManager_InjectTimeout(t *testing.T) {
+	timeout := Params.FlushInjectTimeoutSeconds
+	defer func() { Params.FlushInjectTimeoutSeconds = timeout }()
+	Params.FlushInjectTimeoutSeconds = 1
+
+	kv := NewInMemoryKV(0)
+	notified := make(chan *segmentFlushPack, 1)
+	m := NewRendezvousFlushManager(&allocator{}, kv, newMockReplica(), func(pack *segmentFlushPack) {
+		notified <- pack
+	})
+	defer m.close()
+
+	// the injection is never over
+	injection := taskInjection{
+		injected:   make(chan struct{}),
+		injectOver: make(chan bool),
+		postInjection: func(pack *segmentFlushPack) {
+			pack.segmentID = 3
+		},
+	}
+	m.injectFlush(injection, 1)
+	<-injection.injected
+
+	id := make([]byte, 10)
+	rand.Read(id)
+	m.flushBufferData(nil, 1, true, false, &internalpb.MsgPosition{MsgID: id})
+	m.flushDelData(nil, 1, &internalpb.MsgPosition{MsgID: id})
+
+	select {
+	case pack := <-notified:
+		assert.EqualValues(t, 1, pack.segmentID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("flush task blocked by the injection not over")
+	}
+
+	// the injection over later doesn't block its owner
+	select {
+	case injection.injectOver <- true:
+	case <-time.After(time.Second):
+		t.Fatal("injection over blocked")
+	}
+}
+
 func TestRendezvousFlushManager_getSegmentMeta(t *testing.T) {
-	memkv := memkv.NewMemoryKV()
+	kv := NewInMemoryKV(0)
 	replica := newMockReplica()
-	fm := NewRendezvousFlushManager(NewAllocatorFactory(), memkv, replica, func(*segmentFlushPack) {
+	fm := NewRendezvousFlushManager(NewAllocatorFactory(), kv, replica, func(*segmentFlushPack) {
 	})
 
 	// non exists segment
@@ -281,7 +591,7 @@ func TestRendezvousFlushManager_getSegmentMeta(t *testing.T) {
 }
 
 func TestRendezvousFlushManager_close(t *testing.T) {
-	kv := memkv.NewMemoryKV()
+	kv := NewInMemoryKV(0)
 
 	size := 1000
 	var counter atomic.Int64
@@ -329,18 +639,32 @@ func TestFlushNotifyFunc(t *testing.T) {
 	dataCoord := &DataCoordFactory{}
 	flushingCache := newCache()
 	dsService := &dataSyncService{
+		ctx:              ctx,
 		collectionID:     1,
 		replica:          replica,
 		dataCoord:        dataCoord,
 		flushingSegCache: flushingCache,
+		flushErrCh:       make(chan error, 1),
 	}
 	notifyFunc := flushNotifyFunc(dsService, retry.Attempts(1))
+	// flushErr returns the error reported to restart the vchannel, nil if none,
+	// and binlog paths are saved again as if the vchannel is restarted
+	flushErr := func() error {
+		defer dsService.flushFailed.Store(false)
+		select {
+		case err := <-dsService.flushErrCh:
+			return err
+		default:
+			return nil
+		}
+	}
 
 	t.Run("normal run", func(t *testing.T) {
 		assert.NotPanics(t, func() {
 			notifyFunc(&segmentFlushPack{
 				insertLogs: map[UniqueID]string{1: "/dev/test/id"},
 				statsLogs:  map[UniqueID]string{1: "/dev/test/id-stats"},
+				sketchLogs: map[UniqueID]string{1: "/dev/test/id-sketch"},
 				deltaLogs:  []*DelDataBuf{{filePath: "/dev/test/del"}},
 				flushed:    true,
 			})
@@ -348,24 +672,693 @@ func TestFlushNotifyFunc(t *testing.T) {
 	})
 
 	t.Run("pack has error", func(t *testing.T) {
-		assert.Panics(t, func() {
+		assert.NotPanics(t, func() {
 			notifyFunc(&segmentFlushPack{
 				err: errors.New("mocked pack error"),
 			})
 		})
+		assert.EqualError(t, flushErr(), "mocked pack error")
+	})
+
+	t.Run("refused after a permanent failure", func(t *testing.T) {
+		refusing := &DataCoordFactory{}
+		dsService.dataCoord = refusing
+		defer func() { dsService.dataCoord = dataCoord }()
+		notifyFunc(&segmentFlushPack{segmentID: 1, err: errors.New("mocked pack error")})
+		// later packs of the segment must not move its checkpoint past the binlogs not saved
+		notifyFunc(&segmentFlushPack{segmentID: 1, flushed: true})
+		notifyFunc(&segmentFlushPack{segmentID: 2})
+		assert.Equal(t, 0, refusing.SaveBinlogPathCalls)
+		assert.EqualError(t, flushErr(), "mocked pack error")
+	})
+
+	t.Run("read only", func(t *testing.T) {
+		dataCoord.SaveBinlogPathError = true
+		defer func() { dataCoord.SaveBinlogPathError = false }()
+		dsService.readOnly = true
+		defer func() { dsService.readOnly = false }()
+		assert.NotPanics(t, func() {
+			notifyFunc(&segmentFlushPack{flushed: true})
+		})
 	})
 
 	t.Run("datacoord Save fails", func(t *testing.T) {
 		dataCoord.SaveBinlogPathNotSuccess = true
-		assert.Panics(t, func() {
+		assert.NotPanics(t, func() {
 			notifyFunc(&segmentFlushPack{})
 		})
+		assert.Error(t, flushErr())
 	})
 
 	t.Run("datacoord call error", func(t *testing.T) {
 		dataCoord.SaveBinlogPathError = true
-		assert.Panics(t, func() {
+		assert.NotPanics(t, func() {
 			notifyFunc(&segmentFlushPack{})
 		})
+		assert.Error(t, flushErr())
+
+		// only the first error is kept until handled
+		notifyFunc(&segmentFlushPack{})
+		notifyFunc(&segmentFlushPack{})
+		assert.Error(t, flushErr())
+		assert.NoError(t, flushErr())
 	})
+
+	t.Run("permanent error is not retried", func(t *testing.T) {
+		dataCoord := &DataCoordFactory{}
+		dsService.dataCoord = dataCoord
+		notifyFunc := flushNotifyFunc(dsService, retry.Attempts(3), retry.Sleep(time.Millisecond))
+
+		dataCoord.SaveBinlogPathStatus = &commonpb.Status{ErrorCode: commonpb.ErrorCode_VersionMismatch}
+		assert.NotPanics(t, func() {
+			notifyFunc(&segmentFlushPack{})
+		})
+		assert.Error(t, flushErr())
+		assert.Equal(t, 1, dataCoord.SaveBinlogPathCalls)
+
+		dataCoord.SaveBinlogPathCalls = 0
+		dataCoord.SaveBinlogPathStatus = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+		assert.NotPanics(t, func() {
+			notifyFunc(&segmentFlushPack{})
+		})
+		assert.Error(t, flushErr())
+		assert.Equal(t, 3, dataCoord.SaveBinlogPathCalls)
+	})
+
+	t.Run("circuit breaker", func(t *testing.T) {
+		dataCoord := &failingSegmentDataCoord{failing: 1, calls: make(map[UniqueID]int)}
+		dsService.dataCoord = dataCoord
+		dsService.vchannelName = "ch1"
+		dsService.flushBreakers = newSegmentCircuitBreakers(3, 10*time.Millisecond)
+		defer func() { dsService.flushBreakers = nil }()
+		notifyFunc := flushNotifyFunc(dsService, retry.Attempts(10), retry.Sleep(time.Millisecond))
+
+		// the circuit opens after 3 consecutive failures, the segment is reported as errored instead of panicking,
+		// and its pack is held and tried again after each cooldown
+		done := make(chan struct{})
+		go func() {
+			notifyFunc(&segmentFlushPack{segmentID: 1, flushed: true})
+			close(done)
+		}()
+		assert.Eventually(t, func() bool { return len(dataCoord.getReports()) > 1 }, time.Second, 10*time.Millisecond)
+		assert.True(t, dataCoord.get(1) > 3)
+		report := dataCoord.getReports()[0]
+		assert.EqualValues(t, 1, report.GetSegmentID())
+		assert.Equal(t, "ch1", report.GetChannel())
+		select {
+		case <-done:
+			t.Fatal("pack of errored segment is dropped")
+		default:
+		}
+
+		// other segments keep flushing
+		notifyFunc(&segmentFlushPack{segmentID: 2})
+		assert.Equal(t, 1, dataCoord.get(2))
+
+		// the circuit closes once a trial call succeeds
+		breaker := dsService.flushBreakers.get(1)
+		dataCoord.heal()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("pack of healed segment is not saved")
+		}
+		assert.Equal(t, circuitClosed, breaker.State())
+		// the breaker is removed once the segment is flushed
+		assert.NotSame(t, breaker, dsService.flushBreakers.get(1))
+		assert.NoError(t, flushErr())
+	})
+
+	t.Run("segment lease expired", func(t *testing.T) {
+		dataCoord := &DataCoordFactory{
+			SaveBinlogPathStatus: &commonpb.Status{ErrorCode: commonpb.ErrorCode_SegmentLeaseExpired},
+		}
+		dsService.dataCoord = dataCoord
+		notifyFunc := flushNotifyFunc(dsService, retry.Attempts(3), retry.Sleep(time.Millisecond))
+		assert.NotPanics(t, func() {
+			notifyFunc(&segmentFlushPack{})
+		})
+		assert.Equal(t, 1, dataCoord.SaveBinlogPathCalls)
+	})
+}
+
+// failingSegmentDataCoord fails SaveBinlogPaths of a segment until healed
+type failingSegmentDataCoord struct {
+	types.DataCoord
+	mu      sync.Mutex
+	failing UniqueID
+	healed  bool
+	calls   map[UniqueID]int
+	reports []*datapb.ReportSegmentErrorRequest
+}
+
+func (dc *failingSegmentDataCoord) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.calls[req.GetSegmentID()]++
+	if req.GetSegmentID() == dc.failing && !dc.healed {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}, nil
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (dc *failingSegmentDataCoord) ReportSegmentError(ctx context.Context, req *datapb.ReportSegmentErrorRequest) (*commonpb.Status, error) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.reports = append(dc.reports, req)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (dc *failingSegmentDataCoord) get(segmentID UniqueID) int {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return dc.calls[segmentID]
+}
+
+func (dc *failingSegmentDataCoord) getReports() []*datapb.ReportSegmentErrorRequest {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return append([]*datapb.ReportSegmentErrorRequest(nil), dc.reports...)
+}
+
+func (dc *failingSegmentDataCoord) heal() {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.healed = true
+}
+
+// latencyKV simulates a remote storage which uploads kvs of a MultiSave one by one with network latency
+type latencyKV struct {
+	kv.BaseKV
+	latency time.Duration
+	err     error
+	copyErr error
+}
+
+func (l *latencyKV) MultiSave(kvs map[string]string) error {
+	return l.MultiSaveWithContext(context.Background(), kvs)
+}
+
+// MultiSaveWithContext simulates an in-flight write which is aborted once ctx is done
+func (l *latencyKV) MultiSaveWithContext(ctx context.Context, kvs map[string]string) error {
+	select {
+	case <-time.After(time.Duration(len(kvs)) * l.latency):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if l.err != nil {
+		return l.err
+	}
+	return l.BaseKV.MultiSave(kvs)
+}
+
+// MultiCopyWithContext simulates a server side copy, which takes the network latency of each key as well
+func (l *latencyKV) MultiCopyWithContext(ctx context.Context, dst2src map[string]string) error {
+	select {
+	case <-time.After(time.Duration(len(dst2src)) * l.latency):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if l.copyErr != nil {
+		return l.copyErr
+	}
+	for dst, src := range dst2src {
+		value, err := l.BaseKV.Load(src)
+		if err != nil {
+			return err
+		}
+		if err := l.BaseKV.Save(dst, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func genFieldKvs(fieldNum int) map[UniqueID]map[string]string {
+	field2Kvs := make(map[UniqueID]map[string]string, fieldNum)
+	for i := 0; i < fieldNum; i++ {
+		fieldID := UniqueID(100 + i)
+		addFieldKv(field2Kvs, fieldID, fmt.Sprintf("insert_log/%d", fieldID), "binlog")
+		addFieldKv(field2Kvs, fieldID, fmt.Sprintf("stats_log/%d", fieldID), "statslog")
+	}
+	return field2Kvs
+}
+
+func TestPartitionFieldKvs(t *testing.T) {
+	field2Kvs := genFieldKvs(10)
+
+	for _, n := range []int{-1, 0, 1, 3, 10, 20} {
+		partitions := partitionFieldKvs(field2Kvs, n)
+		expected := n
+		if expected < 1 {
+			expected = 1
+		}
+		if expected > 10 {
+			expected = 10
+		}
+		assert.Equal(t, expected, len(partitions))
+
+		total := 0
+		for _, kvs := range partitions {
+			total += len(kvs)
+			// binlog and stats log of the same field are in the same partition
+			for k := range kvs {
+				var fieldID UniqueID
+				if _, err := fmt.Sscanf(k, "insert_log/%d", &fieldID); err == nil {
+					assert.Contains(t, kvs, fmt.Sprintf("stats_log/%d", fieldID))
+				}
+			}
+		}
+		assert.Equal(t, 20, total)
+	}
+}
+
+func TestFlushBufferInsertTask(t *testing.T) {
+	t.Run("test concurrent upload", func(t *testing.T) {
+		memKV := NewInMemoryKV(0)
+		task := &flushBufferInsertTask{
+			ctx:         context.Background(),
+			BaseKV:      &latencyKV{BaseKV: memKV},
+			data:        genFieldKvs(10),
+			concurrency: 4,
+		}
+		assert.NoError(t, task.flushInsertData())
+		for fieldID, kvs := range task.data {
+			for k, v := range kvs {
+				saved, err := memKV.Load(k)
+				assert.NoError(t, err, "field %d", fieldID)
+				assert.Equal(t, v, saved)
+			}
+		}
+	})
+
+	t.Run("test partition upload failed", func(t *testing.T) {
+		task := &flushBufferInsertTask{
+			ctx:         context.Background(),
+			BaseKV:      &latencyKV{BaseKV: NewInMemoryKV(0), err: errors.New("mocked error")},
+			data:        genFieldKvs(10),
+			concurrency: 4,
+		}
+		assert.Error(t, task.flushInsertData())
+	})
+
+	t.Run("test two-phase upload", func(t *testing.T) {
+		for _, useCopy := range []bool{true, false} {
+			memKV := NewInMemoryKV(0)
+			var blobKV kv.BaseKV = memKV
+			if useCopy {
+				blobKV = &latencyKV{BaseKV: memKV}
+			}
+			task := &flushBufferInsertTask{
+				ctx:         context.Background(),
+				BaseKV:      blobKV,
+				data:        genFieldKvs(10),
+				concurrency: 4,
+				tempPrefix:  "tmp",
+			}
+			assert.NoError(t, task.flushInsertData())
+			for _, kvs := range task.data {
+				for k, v := range kvs {
+					saved, err := memKV.Load(k)
+					assert.NoError(t, err)
+					assert.Equal(t, v, saved)
+				}
+			}
+			// temporary binlogs are removed once copied
+			keys, _, err := memKV.LoadWithPrefix("tmp/")
+			assert.NoError(t, err)
+			assert.Empty(t, keys, "copy %v", useCopy)
+		}
+	})
+
+	t.Run("test two-phase upload failed", func(t *testing.T) {
+		memKV := NewInMemoryKV(0)
+		task := &flushBufferInsertTask{
+			ctx:         context.Background(),
+			BaseKV:      &latencyKV{BaseKV: memKV, copyErr: errors.New("mocked error")},
+			data:        genFieldKvs(10),
+			concurrency: 4,
+			tempPrefix:  "tmp",
+		}
+		assert.Error(t, task.flushInsertData())
+		// temporary binlogs are left to the storage audit, and no binlog is saved to its final key
+		keys, _, err := memKV.LoadWithPrefix("")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, keys)
+		for _, key := range keys {
+			assert.True(t, strings.HasPrefix(key, "tmp/"), key)
+		}
+	})
+
+	t.Run("test empty task", func(t *testing.T) {
+		task := &flushBufferInsertTask{}
+		assert.NoError(t, task.flushInsertData())
+	})
+
+	t.Run("test cancel in-flight upload", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		memKV := NewInMemoryKV(0)
+		task := &flushBufferInsertTask{
+			ctx:         ctx,
+			BaseKV:      &latencyKV{BaseKV: memKV, latency: time.Minute},
+			data:        genFieldKvs(10),
+			concurrency: 4,
+		}
+		time.AfterFunc(10*time.Millisecond, cancel)
+		start := time.Now()
+		assert.ErrorIs(t, task.flushInsertData(), context.Canceled)
+		assert.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+		_, values, err := memKV.LoadWithPrefix("")
+		assert.NoError(t, err)
+		assert.Empty(t, values)
+	})
+}
+
+func TestFlushBufferDeleteTask(t *testing.T) {
+	t.Run("test upload", func(t *testing.T) {
+		memKV := NewInMemoryKV(0)
+		task := &flushBufferDeleteTask{
+			ctx:    context.Background(),
+			BaseKV: &latencyKV{BaseKV: memKV},
+			data:   map[string]string{"delta_log/1": "deltalog"},
+		}
+		assert.NoError(t, task.flushDeleteData())
+		value, err := memKV.Load("delta_log/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "deltalog", value)
+	})
+
+	t.Run("test cancel in-flight upload", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		task := &flushBufferDeleteTask{
+			ctx:    ctx,
+			BaseKV: &latencyKV{BaseKV: NewInMemoryKV(0), latency: time.Minute},
+			data:   map[string]string{"delta_log/1": "deltalog"},
+		}
+		time.AfterFunc(10*time.Millisecond, cancel)
+		start := time.Now()
+		assert.ErrorIs(t, task.flushDeleteData(), context.Canceled)
+		assert.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+	})
+}
+
+func TestInMemoryFlushManager(t *testing.T) {
+	collMeta := (&MetaFactory{}).GetCollectionMeta(1, "coll1")
+	newReplica := func() *SegmentReplica {
+		replica := &SegmentReplica{
+			collectionID:    1,
+			newSegments:     make(map[UniqueID]*Segment),
+			normalSegments:  make(map[UniqueID]*Segment),
+			flushedSegments: make(map[UniqueID]*Segment),
+			metaService:     newMetaService(&RootCoordFactory{collectionID: 1}, 1),
+		}
+		pos := &internalpb.MsgPosition{ChannelName: "ch1"}
+		require.NoError(t, replica.addNewSegment(100, 1, 10, "ch1", pos, pos))
+		return replica
+	}
+	pos := &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1}}
+	var pack atomic.Value
+	notify := func(p *segmentFlushPack) { pack.Store(p) }
+	// flush flushes the data into segment 100, and returns the flush pack of it
+	flush := func(m *rendezvousFlushManager, data *InsertData) *segmentFlushPack {
+		_, err := m.flushBufferData(&BufferData{buffer: data, size: 2}, 100, false, false, pos)
+		require.NoError(t, err)
+		_, err = m.flushDelData(nil, 100, pos)
+		require.NoError(t, err)
+		require.NoError(t, m.waitForFlushTasks(context.Background()))
+		return pack.Load().(*segmentFlushPack)
+	}
+
+	t.Run("flush", func(t *testing.T) {
+		m, kv := NewInMemoryFlushManager(newReplica(), 0, notify)
+		pack := flush(m, genInsertData())
+		require.NoError(t, pack.err)
+		blobs := make([]*Blob, 0, len(pack.insertLogs))
+		for _, p := range pack.insertLogs {
+			v, err := kv.Load(p)
+			require.NoError(t, err)
+			blobs = append(blobs, &Blob{Key: p, Value: []byte(v)})
+		}
+		_, _, insertData, err := storage.NewInsertCodec(collMeta).Deserialize(blobs)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, insertData.Data[106].(*storage.Int64FieldData).Data)
+	})
+
+	t.Run("arrow binlog format", func(t *testing.T) {
+		defer func(format string) { Params.BinlogFormat = format }(Params.BinlogFormat)
+		Params.BinlogFormat = storage.BinlogFormatArrowIPC
+
+		m, kv := NewInMemoryFlushManager(newReplica(), 0, notify)
+		pack := flush(m, genInsertData())
+		require.NoError(t, pack.err)
+		blobs := make([]*Blob, 0, len(pack.insertLogs))
+		for _, p := range pack.insertLogs {
+			v, err := kv.Load(p)
+			require.NoError(t, err)
+			assert.True(t, storage.IsArrowBinlog([]byte(v)))
+			blobs = append(blobs, &Blob{Key: p, Value: []byte(v)})
+		}
+		_, _, insertData, err := storage.NewInsertCodec(collMeta).Deserialize(blobs)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, insertData.Data[106].(*storage.Int64FieldData).Data)
+	})
+
+	t.Run("binlog compression", func(t *testing.T) {
+		defer func(codec string) { Params.BinlogCompressionCodec = codec }(Params.BinlogCompressionCodec)
+		Params.BinlogCompressionCodec = storage.BinlogCompressionZstd
+
+		m, kv := NewInMemoryFlushManager(newReplica(), 0, notify)
+		pack := flush(m, genInsertData())
+		require.NoError(t, pack.err)
+		blobs := make([]*Blob, 0, len(pack.insertLogs))
+		compressed := 0
+		for _, p := range pack.insertLogs {
+			v, err := kv.Load(p)
+			require.NoError(t, err)
+			if storage.IsCompressedBinlog([]byte(v)) {
+				compressed++
+			}
+			blobs = append(blobs, &Blob{Key: p, Value: []byte(v)})
+		}
+		assert.NotZero(t, compressed)
+		_, _, insertData, err := storage.NewInsertCodec(collMeta).Deserialize(blobs)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, insertData.Data[106].(*storage.Int64FieldData).Data)
+	})
+
+	t.Run("codec failure", func(t *testing.T) {
+		m, kv := NewInMemoryFlushManager(newReplica(), 0, notify)
+		data := genInsertData()
+		delete(data.Data, 1)
+		_, err := m.flushBufferData(&BufferData{buffer: data, size: 2}, 100, false, false, pos)
+		assert.Error(t, err)
+		keys, _, err := kv.LoadWithPrefix("")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("key collision", func(t *testing.T) {
+		// the mock allocator returns the same log id for both flushes
+		m, kv := NewInMemoryFlushManager(newReplica(), 0, notify)
+		kv.DetectCollision = true
+		m.retryOpts = []retry.Option{retry.Attempts(1)}
+		pack := flush(m, genInsertDataWithPKs([2]int64{1, 2}))
+		require.NoError(t, pack.err)
+		pack = flush(m, genInsertDataWithPKs([2]int64{3, 4}))
+		assert.Error(t, pack.err)
+	})
+
+	t.Run("partial flush", func(t *testing.T) {
+		defer func(origin int) { Params.FlushUploadConcurrency = origin }(Params.FlushUploadConcurrency)
+		Params.FlushUploadConcurrency = 1
+
+		// failed uploads are retried until all binlogs are saved
+		m, kv := NewInMemoryFlushManager(newReplica(), 0.5, notify)
+		pack := flush(m, genInsertData())
+		require.NoError(t, pack.err)
+		for _, p := range pack.insertLogs {
+			_, err := kv.Load(p)
+			assert.NoError(t, err)
+		}
+
+		// part of binlogs are saved when retries are exhausted
+		m, kv = NewInMemoryFlushManager(newReplica(), 1, notify)
+		pack = flush(m, genInsertData())
+		assert.Error(t, pack.err)
+		keys, _, err := kv.LoadWithPrefix("")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, keys)
+		assert.Less(t, len(keys), len(pack.insertLogs)+len(pack.statsLogs))
+	})
+}
+
+// BenchmarkFlushBufferInsertTask uploads binlogs of a 128-field schema over a simulated 100ms-latency network,
+// two-phase uploads show the latency added by copying binlogs from the temporary paths
+func BenchmarkFlushBufferInsertTask(b *testing.B) {
+	field2Kvs := genFieldKvs(128)
+	for _, tempPrefix := range []string{"", "tmp"} {
+		for _, concurrency := range []int{1, 16} {
+			name := fmt.Sprintf("concurrency-%d", concurrency)
+			if tempPrefix != "" {
+				name = "two-phase-" + name
+			}
+			b.Run(name, func(b *testing.B) {
+				task := &flushBufferInsertTask{
+					ctx:         context.Background(),
+					BaseKV:      &latencyKV{BaseKV: NewInMemoryKV(0), latency: 100 * time.Millisecond},
+					data:        field2Kvs,
+					concurrency: concurrency,
+					tempPrefix:  tempPrefix,
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if err := task.flushInsertData(); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkTwoPhaseSave compares saving binlogs directly with the two phases of twoPhaseSave, over a simulated
+// 10ms-latency storage, either copying on the server side or saving the values again if the kv is unable to copy
+func BenchmarkTwoPhaseSave(b *testing.B) {
+	kvs := make(map[string]string)
+	for _, fieldKvs := range genFieldKvs(16) {
+		for k, v := range fieldKvs {
+			kvs[k] = v
+		}
+	}
+	newCopyKV := func() kv.BaseKV {
+		return &latencyKV{BaseKV: NewInMemoryKV(0), latency: 10 * time.Millisecond}
+	}
+	newResaveKV := func() kv.BaseKV {
+		// hides MultiCopyWithContext of latencyKV
+		return struct{ kv.BaseKV }{newCopyKV()}
+	}
+
+	b.Run("direct", func(b *testing.B) {
+		blobKV := newCopyKV()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if err := blobKV.MultiSaveWithContext(context.Background(), kvs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	for _, c := range []struct {
+		name  string
+		newKV func() kv.BaseKV
+	}{{"two-phase-copy", newCopyKV}, {"two-phase-resave", newResaveKV}} {
+		newKV := c.newKV
+		b.Run(c.name, func(b *testing.B) {
+			blobKV := newKV()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := twoPhaseSave(context.Background(), blobKV, "tmp", kvs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// unreachableDataCoord fails SaveBinlogPaths with an rpc error while unreachable
+type unreachableDataCoord struct {
+	DataCoordFactory
+	unreachable atomic.Bool
+	calls       atomic.Int64
+}
+
+func (dc *unreachableDataCoord) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
+	dc.calls.Inc()
+	if dc.unreachable.Load() {
+		return nil, errors.New("connection refused")
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func TestFlushNotifyFunc_SaveBinlogLimiter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	dataCoord := &DataCoordFactory{}
+	dsService := &dataSyncService{
+		ctx:          ctx,
+		collectionID: 1,
+		replica: &SegmentReplica{
+			collectionID:    1,
+			newSegments:     make(map[UniqueID]*Segment),
+			normalSegments:  make(map[UniqueID]*Segment),
+			flushedSegments: make(map[UniqueID]*Segment),
+		},
+		dataCoord:         dataCoord,
+		flushingSegCache:  newCache(),
+		flushErrCh:        make(chan error, 1),
+		saveBinlogLimiter: newTokenBucket(0.001, 1),
+	}
+	notifyFunc := flushNotifyFunc(dsService, retry.Attempts(1))
+
+	pack := &segmentFlushPack{segmentID: 1, pos: &internalpb.MsgPosition{}}
+	notifyFunc(pack)
+	assert.NoError(t, pack.saveErr)
+	assert.Equal(t, 1, dataCoord.SaveBinlogPathCalls)
+
+	// the bucket is empty, SaveBinlogPaths is skipped once the data sync service stops waiting
+	cancel()
+	pack = &segmentFlushPack{segmentID: 1, pos: &internalpb.MsgPosition{}}
+	notifyFunc(pack)
+	assert.ErrorIs(t, pack.saveErr, context.Canceled)
+	assert.Equal(t, 1, dataCoord.SaveBinlogPathCalls)
+}
+
+func TestFlushNotifyFunc_DataCoordCircuitBreaker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dataCoord := &unreachableDataCoord{}
+	dataCoord.unreachable.Store(true)
+	breaker := NewWindowedCircuitBreaker(3, time.Minute, 100*time.Millisecond)
+	dsService := &dataSyncService{
+		ctx:          ctx,
+		collectionID: 1,
+		replica: &SegmentReplica{
+			collectionID:    1,
+			newSegments:     make(map[UniqueID]*Segment),
+			normalSegments:  make(map[UniqueID]*Segment),
+			flushedSegments: make(map[UniqueID]*Segment),
+		},
+		dataCoord:        dataCoord,
+		flushingSegCache: newCache(),
+		flushErrCh:       make(chan error, 1),
+		dataCoordBreaker: breaker,
+	}
+	notifyFunc := flushNotifyFunc(dsService, retry.Attempts(10), retry.Sleep(time.Millisecond))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		notifyFunc(&segmentFlushPack{segmentID: 1, pos: &internalpb.MsgPosition{}})
+	}()
+
+	// the circuit opens after 3 consecutive failures, the flush waits and ingestion pauses
+	assert.Eventually(t, func() bool { return breaker.State() == circuitOpen }, time.Second, time.Millisecond)
+	assert.EqualValues(t, 3, dataCoord.calls.Load())
+	waitCtx, waitCancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer waitCancel()
+	assert.True(t, errors.Is(dsService.waitDataCoord(waitCtx), context.DeadlineExceeded))
+
+	// the trial call after the cooldown succeeds once DataCoord is reachable again, and the circuit closes
+	dataCoord.unreachable.Store(false)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "flush not done after DataCoord is reachable")
+	}
+	assert.EqualValues(t, 4, dataCoord.calls.Load())
+	assert.Equal(t, circuitClosed, breaker.State())
+	assert.NoError(t, dsService.waitDataCoord(ctx))
+	select {
+	case err := <-dsService.flushErrCh:
+		assert.FailNow(t, "vchannel restarted", err.Error())
+	default:
+	}
 }
@@ -0,0 +1,193 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// serializeInsertFunc encodes the insert data of a flush into binlogs,
+// returns the upload task together with the insert, stats and sketch binlog paths of each field
+type serializeInsertFunc func() (flushInsertTask, map[UniqueID]string, map[UniqueID]string, map[UniqueID]string, error)
+
+// pipelineInsertTask is the insert part of a flush task passed through the stages of flushPipeline
+type pipelineInsertTask struct {
+	runner     *flushTaskRunner
+	serialize  serializeInsertFunc
+	task       flushInsertTask
+	binlogs    map[UniqueID]string
+	statslogs  map[UniqueID]string
+	sketchlogs map[UniqueID]string
+	err        error
+}
+
+// checkpointRequest asks the checkpointer to save a flush pack
+type checkpointRequest struct {
+	pack *segmentFlushPack
+	done chan interface{} // receives the panic of notifyFunc, nil if there is none
+}
+
+// flushPipeline flushes insert buffers in three stages connected by channels.
+// The serializer encodes insert data into binlogs, the uploader saves the binlogs into blob storage,
+// and the checkpointer saves the binlog paths with notifyFunc, so the stages of consecutive flushes overlap
+// and the flush latency is bounded by the slowest stage instead of the sum of them.
+// Flush packs are still handed to the checkpointer by flush task runners, which keeps the flush order of
+// a segment and waits for the delete data of the same position
+type flushPipeline struct {
+	serializeCh  chan *pipelineInsertTask
+	uploadCh     chan *pipelineInsertTask
+	checkpointCh chan *checkpointRequest
+	notifyFunc   notifyMetaFunc
+	retryOpts    []retry.Option
+
+	closeOnce sync.Once
+	done      chan struct{}
+	wg        sync.WaitGroup
+}
+
+// newFlushPipeline creates a flushPipeline, at most depth tasks are buffered between two stages
+func newFlushPipeline(depth int, f notifyMetaFunc, opts ...retry.Option) *flushPipeline {
+	if depth < 1 {
+		depth = 1
+	}
+	return &flushPipeline{
+		serializeCh:  make(chan *pipelineInsertTask, depth),
+		uploadCh:     make(chan *pipelineInsertTask, depth),
+		checkpointCh: make(chan *checkpointRequest, depth),
+		notifyFunc:   f,
+		retryOpts:    opts,
+		done:         make(chan struct{}),
+	}
+}
+
+// start runs a goroutine for each stage
+func (p *flushPipeline) start() {
+	p.wg.Add(3)
+	go p.serializeLoop()
+	go p.uploadLoop()
+	go p.checkpointLoop()
+}
+
+// submit puts the task into the serializer, it blocks if the serializer is full
+func (p *flushPipeline) submit(t *pipelineInsertTask) {
+	select {
+	case p.serializeCh <- t:
+	case <-p.done:
+	}
+}
+
+func (p *flushPipeline) serializeLoop() {
+	defer p.wg.Done()
+	for {
+		select {
+		case t := <-p.serializeCh:
+			p.serialize(t)
+			select {
+			case p.uploadCh <- t:
+			case <-p.done:
+				return
+			}
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *flushPipeline) serialize(t *pipelineInsertTask) {
+	defer t.runner.recoverTaskPanic("flush pipeline serialize", &t.err)
+	t.task, t.binlogs, t.statslogs, t.sketchlogs, t.err = t.serialize()
+}
+
+func (p *flushPipeline) uploadLoop() {
+	defer p.wg.Done()
+	for {
+		select {
+		case t := <-p.uploadCh:
+			p.upload(t)
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// upload saves the binlogs with retry, and then completes the insert part of the flush task
+func (p *flushPipeline) upload(t *pipelineInsertTask) {
+	defer func() {
+		t.runner.finishFlushInsert(t.binlogs, t.statslogs, t.sketchlogs, t.err)
+	}()
+	defer t.runner.recoverTaskPanic("flush pipeline upload", &t.err)
+	if t.err != nil {
+		return
+	}
+	t.err = retry.Do(context.Background(), t.task.flushInsertData, p.retryOpts...)
+}
+
+func (p *flushPipeline) checkpointLoop() {
+	defer p.wg.Done()
+	for {
+		select {
+		case req := <-p.checkpointCh:
+			req.done <- p.notify(req.pack)
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// notify calls notifyFunc and returns its panic, so that the checkpointer survives a failed flush
+func (p *flushPipeline) notify(pack *segmentFlushPack) (r interface{}) {
+	defer func() {
+		r = recover()
+	}()
+	p.notifyFunc(pack)
+	return nil
+}
+
+// checkpoint implements notifyMetaFunc, it hands the pack to the checkpointer and waits until the pack is saved.
+// Panic of notifyFunc is raised again here, so that it is handled by the flush task runner as without the pipeline
+func (p *flushPipeline) checkpoint(pack *segmentFlushPack) {
+	req := &checkpointRequest{
+		pack: pack,
+		done: make(chan interface{}, 1),
+	}
+	select {
+	case p.checkpointCh <- req:
+	case <-p.done:
+		log.Warn("flush pipeline closed before checkpoint")
+		return
+	}
+	select {
+	case r := <-req.done:
+		if r != nil {
+			panic(r)
+		}
+	case <-p.done:
+		log.Warn("flush pipeline closed during checkpoint")
+	}
+}
+
+// close stops all the stages, tasks left in the pipeline are abandoned
+func (p *flushPipeline) close() {
+	p.closeOnce.Do(func() {
+		close(p.done)
+		p.wg.Wait()
+	})
+}
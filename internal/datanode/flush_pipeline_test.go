@@ -0,0 +1,257 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serializeWith(task flushInsertTask, binlog string, err error) serializeInsertFunc {
+	return func() (flushInsertTask, map[UniqueID]string, map[UniqueID]string, map[UniqueID]string, error) {
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		return task, map[UniqueID]string{100: binlog}, map[UniqueID]string{}, map[UniqueID]string{}, nil
+	}
+}
+
+func TestFlushPipeline(t *testing.T) {
+	var mut sync.Mutex
+	packs := make([]*segmentFlushPack, 0)
+	p := newFlushPipeline(2, func(pack *segmentFlushPack) {
+		mut.Lock()
+		defer mut.Unlock()
+		packs = append(packs, pack)
+	}, retry.Attempts(1))
+	p.start()
+	defer p.close()
+
+	q := newOrderFlushQueue(1, p.checkpoint)
+	q.init()
+
+	size := 100
+	barriers := make([]*WriteBarrier, 0, size)
+	for i := 0; i < size; i++ {
+		pos := &internalpb.MsgPosition{MsgID: []byte(strconv.Itoa(i))}
+		barrier := q.enqueuePipelinedInsertFlush(p, serializeWith(&emptyFlushTask{}, strconv.Itoa(i), nil), i == size-1, false, pos)
+		q.enqueueDelFlush(&emptyFlushTask{}, nil, pos)
+		barriers = append(barriers, barrier)
+	}
+	for _, barrier := range barriers {
+		require.NoError(t, barrier.Wait(context.Background()))
+	}
+
+	mut.Lock()
+	require.Equal(t, size, len(packs))
+	for i, pack := range packs {
+		assert.NoError(t, pack.err)
+		assert.Equal(t, strconv.Itoa(i), string(pack.pos.MsgID))
+		assert.Equal(t, strconv.Itoa(i), pack.insertLogs[100])
+		assert.Equal(t, i == size-1, pack.flushed)
+	}
+	packs = packs[:0]
+	mut.Unlock()
+
+	t.Run("serialize error", func(t *testing.T) {
+		pos := &internalpb.MsgPosition{MsgID: []byte("serialize error")}
+		barrier := q.enqueuePipelinedInsertFlush(p, serializeWith(nil, "", errors.New("mocked error")), false, false, pos)
+		q.enqueueDelFlush(&emptyFlushTask{}, nil, pos)
+		require.NoError(t, barrier.Wait(context.Background()))
+
+		mut.Lock()
+		defer mut.Unlock()
+		require.Equal(t, 1, len(packs))
+		assert.Error(t, packs[0].err)
+		packs = packs[:0]
+	})
+
+	t.Run("upload error", func(t *testing.T) {
+		pos := &internalpb.MsgPosition{MsgID: []byte("upload error")}
+		barrier := q.enqueuePipelinedInsertFlush(p, serializeWith(&errFlushTask{}, "", nil), false, false, pos)
+		q.enqueueDelFlush(&emptyFlushTask{}, nil, pos)
+		require.NoError(t, barrier.Wait(context.Background()))
+
+		mut.Lock()
+		defer mut.Unlock()
+		require.Equal(t, 1, len(packs))
+		assert.Error(t, packs[0].err)
+		packs = packs[:0]
+	})
+}
+
+func TestFlushPipeline_checkpoint(t *testing.T) {
+	p := newFlushPipeline(0, func(pack *segmentFlushPack) {
+		if pack.err != nil {
+			panic(pack.err)
+		}
+	})
+	p.start()
+
+	// panic of notify func is raised in the caller, and the checkpointer keeps working
+	assert.Panics(t, func() {
+		p.checkpoint(&segmentFlushPack{err: errors.New("mocked error")})
+	})
+	assert.NotPanics(t, func() {
+		p.checkpoint(&segmentFlushPack{})
+	})
+
+	p.close()
+	p.close()
+	assert.NotPanics(t, func() {
+		p.checkpoint(&segmentFlushPack{err: errors.New("mocked error")})
+	})
+}
+
+func TestRendezvousFlushManager_Pipeline(t *testing.T) {
+	defer func(origin int) { Params.FlushPipelineDepth = origin }(Params.FlushPipelineDepth)
+	Params.FlushPipelineDepth = 2
+
+	packCh := make(chan *segmentFlushPack, 10)
+	m := NewRendezvousFlushManager(&allocator{}, memkv.NewMemoryKV(), newMockReplica(), func(pack *segmentFlushPack) {
+		packCh <- pack
+	})
+	require.NotNil(t, m.pipeline)
+	defer m.close()
+
+	// segment not in replica
+	_, err := m.flushBufferData(&BufferData{buffer: &InsertData{}}, 1, true, false, &internalpb.MsgPosition{MsgID: []byte{1}})
+	assert.Error(t, err)
+
+	for i := 0; i < 3; i++ {
+		pos := &internalpb.MsgPosition{MsgID: []byte{byte(i)}}
+		_, err := m.flushDelData(nil, 1, pos)
+		require.NoError(t, err)
+		barrier, err := m.flushBufferData(nil, 1, i == 2, false, pos)
+		require.NoError(t, err)
+		require.NoError(t, barrier.Wait(context.Background()))
+	}
+	for i := 0; i < 3; i++ {
+		pack := <-packCh
+		assert.EqualValues(t, []byte{byte(i)}, pack.pos.MsgID)
+		assert.Equal(t, i == 2, pack.flushed)
+	}
+}
+
+// bandwidthKV simulates the latency of blob storage with a limited bandwidth
+type bandwidthKV struct {
+	kv.BaseKV
+	bytesPerSec float64
+}
+
+func (b *bandwidthKV) MultiSaveWithContext(ctx context.Context, kvs map[string]string) error {
+	size := 0
+	for _, v := range kvs {
+		size += len(v)
+	}
+	time.Sleep(time.Duration(float64(size) / b.bytesPerSec * float64(time.Second)))
+	return b.BaseKV.MultiSave(kvs)
+}
+
+// genBenchmarkInsertData generates insert data of a float vector field in about size bytes
+func genBenchmarkInsertData(size int) (*etcdpb.CollectionMeta, *BufferData) {
+	const dim = 128
+	rows := size / (dim * 4)
+	meta := &etcdpb.CollectionMeta{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Name: "benchmark",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+				{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "vector", DataType: schemapb.DataType_FloatVector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: strconv.Itoa(dim)}}},
+			},
+		},
+	}
+	ids := make([]int64, rows)
+	for i := range ids {
+		ids[i] = int64(i)
+	}
+	data := &InsertData{
+		Data: map[UniqueID]storage.FieldData{
+			0:   &storage.Int64FieldData{NumRows: []int64{int64(rows)}, Data: ids},
+			1:   &storage.Int64FieldData{NumRows: []int64{int64(rows)}, Data: ids},
+			100: &storage.Int64FieldData{NumRows: []int64{int64(rows)}, Data: ids},
+			101: &storage.FloatVectorFieldData{NumRows: []int64{int64(rows)}, Data: make([]float32, rows*dim), Dim: dim},
+		},
+	}
+	return meta, &BufferData{buffer: data, size: int64(rows)}
+}
+
+// BenchmarkFlushPipeline measures the latency of 4 consecutive flushes of 256 MB insert buffers,
+// blob storage is simulated with 1 GB/s bandwidth, and SaveBinlogPaths with 50 ms latency
+func BenchmarkFlushPipeline(b *testing.B) {
+	const flushes = 4
+	meta, data := genBenchmarkInsertData(256 << 20)
+	m := NewRendezvousFlushManager(NewAllocatorFactory(), &bandwidthKV{BaseKV: memkv.NewMemoryKV(), bytesPerSec: 1 << 30}, newMockReplica(), nil)
+	serialize := func() (flushInsertTask, map[UniqueID]string, map[UniqueID]string, map[UniqueID]string, error) {
+		return m.serializeInsertData(meta.ID, 1, 1, meta, data)
+	}
+	checkpoint := func(*segmentFlushPack) {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	b.Run("sequential", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			for i := 0; i < flushes; i++ {
+				task, binlogs, statslogs, sketchlogs, err := serialize()
+				require.NoError(b, err)
+				require.NoError(b, task.flushInsertData())
+				checkpoint(&segmentFlushPack{insertLogs: binlogs, statsLogs: statslogs, sketchLogs: sketchlogs})
+			}
+		}
+	})
+
+	b.Run("pipelined", func(b *testing.B) {
+		p := newFlushPipeline(flushes, checkpoint)
+		p.start()
+		defer p.close()
+		for n := 0; n < b.N; n++ {
+			q := newOrderFlushQueue(1, p.checkpoint)
+			q.init()
+			barriers := make([]*WriteBarrier, 0, flushes)
+			for i := 0; i < flushes; i++ {
+				pos := &internalpb.MsgPosition{MsgID: []byte{byte(i)}}
+				barriers = append(barriers, q.enqueuePipelinedInsertFlush(p, serialize, false, false, pos))
+				q.enqueueDelFlush(&emptyFlushTask{}, nil, pos)
+			}
+			for _, barrier := range barriers {
+				require.NoError(b, barrier.Wait(context.Background()))
+			}
+			q.injectMut.Lock()
+			q.injectHandler.close()
+			q.injectMut.Unlock()
+		}
+	})
+}
@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	// flushLatencyWindow is the number of flushes the p99 latency is computed over for each adjustment
+	flushLatencyWindow = 100
+	// the buffer size is grown when the p99 latency is below this fraction of the target
+	flushLatencyLowWatermark = 0.5
+	flushSizeShrinkRatio     = 0.75
+	flushSizeGrowRatio       = 1.25
+)
+
+// flushPolicy adjusts the insert buffer size to the flush latency, it is shared by all flowgraphs of the DataNode.
+// Segments are flushed at Params.FlushInsertBufferSize if nil
+var flushPolicy *DynamicFlushPolicy
+
+// DynamicFlushPolicy adjusts the insert buffer size segments are flushed at to the write throughput of blob storage.
+// The size is shrunk when the p99 latency of the latest flushes exceeds the target, so that smaller flushes reduce
+// the tail latency, and grown when the p99 latency is well below the target, so that fewer flushes improve throughput
+type DynamicFlushPolicy struct {
+	mu        sync.Mutex
+	target    time.Duration
+	minSize   int64
+	maxSize   int64
+	size      int64
+	latencies []time.Duration // latencies of the flushes since the last adjustment
+}
+
+// NewDynamicFlushPolicy creates a DynamicFlushPolicy starting from initSize, the size is clamped to [minSize, maxSize]
+func NewDynamicFlushPolicy(target time.Duration, minSize, maxSize, initSize int64) *DynamicFlushPolicy {
+	p := &DynamicFlushPolicy{
+		target:    target,
+		minSize:   minSize,
+		maxSize:   maxSize,
+		latencies: make([]time.Duration, 0, flushLatencyWindow),
+	}
+	p.size = p.clamp(initSize)
+	return p
+}
+
+func (p *DynamicFlushPolicy) clamp(size int64) int64 {
+	if size > p.maxSize {
+		size = p.maxSize
+	}
+	if size < p.minSize {
+		size = p.minSize
+	}
+	return size
+}
+
+// bufferSize returns the insert buffer size in bytes segments are flushed at
+func (p *DynamicFlushPolicy) bufferSize() int64 {
+	if p == nil {
+		return Params.FlushInsertBufferSize
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.size
+}
+
+// observe records the latency of a flush, the buffer size is adjusted once every flushLatencyWindow flushes
+func (p *DynamicFlushPolicy) observe(latency time.Duration) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.latencies = append(p.latencies, latency)
+	if len(p.latencies) < flushLatencyWindow {
+		return
+	}
+	sort.Slice(p.latencies, func(i, j int) bool { return p.latencies[i] < p.latencies[j] })
+	p99 := p.latencies[int(float64(len(p.latencies))*0.99)]
+	// latencies of the flushes buffered with the old size are not taken into the next adjustment
+	p.latencies = p.latencies[:0]
+
+	var size int64
+	switch {
+	case p99 > p.target:
+		size = p.clamp(int64(float64(p.size) * flushSizeShrinkRatio))
+	case float64(p99) < float64(p.target)*flushLatencyLowWatermark:
+		size = p.clamp(int64(float64(p.size) * flushSizeGrowRatio))
+	default:
+		return
+	}
+	if size == p.size {
+		return
+	}
+	log.Info("flush buffer size adjusted", zap.Duration("p99 latency", p99), zap.Duration("target", p.target),
+		zap.Int64("old size", p.size), zap.Int64("new size", size))
+	p.size = size
+	metrics.DataNodeFlushBufferSize.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).Set(float64(size))
+}
+
+// observeFlushLatency records the latency of uploading the insert binlogs of a flush
+func observeFlushLatency(latency time.Duration) {
+	metrics.DataNodeFlushLatency.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).
+		Observe(float64(latency.Milliseconds()))
+	flushPolicy.observe(latency)
+}
@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDynamicFlushPolicy(t *testing.T) {
+	const mb = 1 << 20
+	observe := func(p *DynamicFlushPolicy, latency time.Duration) {
+		for i := 0; i < flushLatencyWindow; i++ {
+			p.observe(latency)
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		var p *DynamicFlushPolicy
+		p.observe(time.Hour)
+		assert.Equal(t, Params.FlushInsertBufferSize, p.bufferSize())
+	})
+
+	t.Run("clamped initial size", func(t *testing.T) {
+		assert.EqualValues(t, 64*mb, NewDynamicFlushPolicy(time.Second, 4*mb, 64*mb, 128*mb).bufferSize())
+		assert.EqualValues(t, 4*mb, NewDynamicFlushPolicy(time.Second, 4*mb, 64*mb, mb).bufferSize())
+	})
+
+	t.Run("shrink on slow flushes", func(t *testing.T) {
+		p := NewDynamicFlushPolicy(time.Second, 4*mb, 64*mb, 16*mb)
+		// not adjusted until a window of flushes is observed
+		for i := 0; i < flushLatencyWindow-1; i++ {
+			p.observe(2 * time.Second)
+		}
+		assert.EqualValues(t, 16*mb, p.bufferSize())
+		p.observe(2 * time.Second)
+		assert.EqualValues(t, 12*mb, p.bufferSize())
+
+		for i := 0; i < 10; i++ {
+			observe(p, 2*time.Second)
+		}
+		assert.EqualValues(t, 4*mb, p.bufferSize())
+	})
+
+	t.Run("grow on fast flushes", func(t *testing.T) {
+		p := NewDynamicFlushPolicy(time.Second, 4*mb, 64*mb, 16*mb)
+		observe(p, 100*time.Millisecond)
+		assert.EqualValues(t, 20*mb, p.bufferSize())
+
+		for i := 0; i < 10; i++ {
+			observe(p, 100*time.Millisecond)
+		}
+		assert.EqualValues(t, 64*mb, p.bufferSize())
+	})
+
+	t.Run("kept around target", func(t *testing.T) {
+		p := NewDynamicFlushPolicy(time.Second, 4*mb, 64*mb, 16*mb)
+		observe(p, 800*time.Millisecond)
+		assert.EqualValues(t, 16*mb, p.bufferSize())
+
+		// only the tail latency matters
+		for i := 0; i < flushLatencyWindow-1; i++ {
+			p.observe(100 * time.Millisecond)
+		}
+		p.observe(10 * time.Second)
+		assert.EqualValues(t, 12*mb, p.bufferSize())
+	})
+}
@@ -19,7 +19,9 @@ package datanode
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
@@ -55,10 +57,12 @@ type flushTaskRunner struct {
 	startSignal  <-chan struct{}
 	finishSignal chan struct{}
 	injectSignal <-chan taskInjection
+	barrier      *WriteBarrier
 
 	segmentID  UniqueID
 	insertLogs map[UniqueID]string
 	statsLogs  map[UniqueID]string
+	sketchLogs map[UniqueID]string
 	deltaLogs  []*DelDataBuf
 	pos        *internalpb.MsgPosition
 	flushed    bool
@@ -66,6 +70,44 @@ type flushTaskRunner struct {
 
 	insertErr error // task execution error
 	deleteErr error // task execution error
+
+	panicHandler panicHandlerFunc // handles panic in task goroutines, panic propagates if nil
+
+	enqueueTime time.Time // time the task enters the flush queue
+	startTime   time.Time // time the previous task is done, and the task becomes head-of-line of the queue
+	// headOfLineWarn is called periodically while the task stays head-of-line longer than Params.FlushHeadOfLineWarnThresholdMs
+	headOfLineWarn func(head *flushTaskRunner)
+
+	holdsSlot bool // whether a slot of the flush task limiter is taken by the task, released once the task is done
+}
+
+// WriteBarrier is released after the result of a flush task is saved by SaveBinlogPaths,
+// so that the insert & delete data flushed by the task is visible to readers
+type WriteBarrier struct {
+	once sync.Once
+	done chan struct{}
+}
+
+func newWriteBarrier() *WriteBarrier {
+	return &WriteBarrier{
+		done: make(chan struct{}),
+	}
+}
+
+// Wait blocks until the barrier is released or ctx is done
+func (b *WriteBarrier) Wait(ctx context.Context) error {
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *WriteBarrier) close() {
+	b.once.Do(func() {
+		close(b.done)
+	})
 }
 
 type taskInjection struct {
@@ -79,57 +121,101 @@ func (t *flushTaskRunner) init(f notifyMetaFunc, postFunc taskPostFunc, signal <
 	t.initOnce.Do(func() {
 		t.startSignal = signal
 		t.finishSignal = make(chan struct{})
-		go t.waitFinish(f, postFunc)
+		go func() {
+			defer recoverPanic("flush task waitFinish", t.panicHandler)
+			t.waitFinish(f, postFunc)
+		}()
 	})
 }
 
 // runFlushInsert executei flush insert task with once and retry
 func (t *flushTaskRunner) runFlushInsert(task flushInsertTask,
-	binlogs, statslogs map[UniqueID]string, flushed bool, dropped bool, pos *internalpb.MsgPosition, opts ...retry.Option) {
+	binlogs, statslogs, sketchlogs map[UniqueID]string, flushed bool, dropped bool, pos *internalpb.MsgPosition, opts ...retry.Option) {
 	t.insertOnce.Do(func() {
 		t.insertLogs = binlogs
 		t.statsLogs = statslogs
+		t.sketchLogs = sketchlogs
 		t.flushed = flushed
 		t.pos = pos
 		t.dropped = dropped
 		go func() {
+			defer t.Done()
+			defer t.recoverTaskPanic("flush insert data", &t.insertErr)
 			err := retry.Do(context.Background(), func() error {
 				return task.flushInsertData()
 			}, opts...)
 			if err != nil {
 				t.insertErr = err
 			}
-			t.Done()
 		}()
 	})
 }
 
+// runPipelinedFlushInsert hands insert data to the flush pipeline with once,
+// the pipeline serializes and uploads it, and then calls finishFlushInsert
+func (t *flushTaskRunner) runPipelinedFlushInsert(p *flushPipeline, serialize serializeInsertFunc,
+	flushed bool, dropped bool, pos *internalpb.MsgPosition) {
+	t.insertOnce.Do(func() {
+		t.flushed = flushed
+		t.pos = pos
+		t.dropped = dropped
+		p.submit(&pipelineInsertTask{
+			runner:    t,
+			serialize: serialize,
+		})
+	})
+}
+
+// finishFlushInsert completes the insert part of the task run by the flush pipeline
+func (t *flushTaskRunner) finishFlushInsert(binlogs, statslogs, sketchlogs map[UniqueID]string, err error) {
+	t.insertLogs = binlogs
+	t.statsLogs = statslogs
+	t.sketchLogs = sketchlogs
+	t.insertErr = err
+	t.Done()
+}
+
 // runFlushDel execute flush delete task with once and retry
-func (t *flushTaskRunner) runFlushDel(task flushDeleteTask, deltaLogs *DelDataBuf, opts ...retry.Option) {
+func (t *flushTaskRunner) runFlushDel(task flushDeleteTask, deltaLogs []*DelDataBuf, opts ...retry.Option) {
 	t.deleteOnce.Do(func() {
 		if deltaLogs == nil {
 			t.deltaLogs = []*DelDataBuf{}
 		} else {
-			t.deltaLogs = []*DelDataBuf{deltaLogs}
+			t.deltaLogs = deltaLogs
 		}
 		go func() {
+			defer t.Done()
+			defer t.recoverTaskPanic("flush delete data", &t.deleteErr)
 			err := retry.Do(context.Background(), func() error {
 				return task.flushDeleteData()
 			}, opts...)
 			if err != nil {
 				t.deleteErr = err
 			}
-			t.Done()
 		}()
 	})
 }
 
+// recoverTaskPanic recovers panic in flush insert/delete goroutine, marks the task failed
+// so that the incomplete result never goes to meta, and then reports the panic
+func (t *flushTaskRunner) recoverTaskPanic(source string, taskErr *error) {
+	if t.panicHandler == nil {
+		return
+	}
+	if r := recover(); r != nil {
+		*taskErr = fmt.Errorf("%s panicked: %v", source, r)
+		t.panicHandler(source, r)
+	}
+}
+
 // waitFinish waits flush & insert done
 func (t *flushTaskRunner) waitFinish(notifyFunc notifyMetaFunc, postFunc taskPostFunc) {
-	// wait insert & del done
-	t.Wait()
 	// wait previous task done
 	<-t.startSignal
+	t.startTime = time.Now()
+	stopWatch := t.watchHeadOfLine()
+	// wait insert & del done
+	t.Wait()
 
 	pack := t.getFlushPack()
 	var postInjection postInjectionFunc = nil
@@ -149,15 +235,46 @@ func (t *flushTaskRunner) waitFinish(notifyFunc notifyMetaFunc, postFunc taskPos
 	// execution done, dequeue and make count --
 	notifyFunc(pack)
 
+	// binlogs and delta logs are saved into meta
+	t.barrier.close()
+	stopWatch()
+
 	// notify next task
 	close(t.finishSignal)
 }
 
+// watchHeadOfLine calls headOfLineWarn every Params.FlushHeadOfLineWarnThresholdMs until the returned func is called,
+// which tells the tasks blocked by a slow task, e.g. one uploading large binlogs or retrying SaveBinlogPaths
+func (t *flushTaskRunner) watchHeadOfLine() func() {
+	threshold := time.Duration(Params.FlushHeadOfLineWarnThresholdMs) * time.Millisecond
+	if t.headOfLineWarn == nil || threshold <= 0 {
+		return func() {}
+	}
+	done := make(chan struct{})
+	go func() {
+		defer recoverPanic("flush task head-of-line watch", t.panicHandler)
+		ticker := time.NewTicker(threshold)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.headOfLineWarn(t)
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		close(done)
+	}
+}
+
 func (t *flushTaskRunner) getFlushPack() *segmentFlushPack {
 	pack := &segmentFlushPack{
 		segmentID:  t.segmentID,
 		insertLogs: t.insertLogs,
 		statsLogs:  t.statsLogs,
+		sketchLogs: t.sketchLogs,
 		pos:        t.pos,
 		deltaLogs:  t.deltaLogs,
 		flushed:    t.flushed,
@@ -177,6 +294,8 @@ func newFlushTaskRunner(segmentID UniqueID, injectCh <-chan taskInjection) *flus
 		WaitGroup:    sync.WaitGroup{},
 		segmentID:    segmentID,
 		injectSignal: injectCh,
+		barrier:      newWriteBarrier(),
+		enqueueTime:  time.Now(),
 	}
 	// insert & del
 	t.Add(2)
@@ -17,10 +17,13 @@
 package datanode
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
 )
 
 func TestFlushTaskRunner(t *testing.T) {
@@ -44,8 +47,8 @@ func TestFlushTaskRunner(t *testing.T) {
 	assert.False(t, saveFlag)
 	assert.False(t, nextFlag)
 
-	task.runFlushInsert(&emptyFlushTask{}, nil, nil, false, false, nil)
-	task.runFlushDel(&emptyFlushTask{}, &DelDataBuf{})
+	task.runFlushInsert(&emptyFlushTask{}, nil, nil, nil, false, false, nil)
+	task.runFlushDel(&emptyFlushTask{}, []*DelDataBuf{{}})
 
 	assert.False(t, saveFlag)
 	assert.False(t, nextFlag)
@@ -55,6 +58,59 @@ func TestFlushTaskRunner(t *testing.T) {
 
 	assert.True(t, saveFlag)
 	assert.True(t, nextFlag)
+	assert.NoError(t, task.barrier.Wait(context.Background()))
+}
+
+func TestFlushTaskRunner_HeadOfLine(t *testing.T) {
+	threshold := Params.FlushHeadOfLineWarnThresholdMs
+	defer func() { Params.FlushHeadOfLineWarnThresholdMs = threshold }()
+	Params.FlushHeadOfLineWarnThresholdMs = 10
+
+	q := newOrderFlushQueue(1, func(*segmentFlushPack) {})
+	warned := atomic.Int64{}
+	task := newFlushTaskRunner(1, nil)
+	task.headOfLineWarn = func(head *flushTaskRunner) {
+		warned.Inc()
+		q.warnHeadOfLine(head)
+	}
+	signal := make(chan struct{})
+	release := make(chan struct{})
+	task.init(func(*segmentFlushPack) {
+		// mocks SaveBinlogPaths retrying
+		<-release
+	}, func(pack *segmentFlushPack, i postInjectionFunc) {}, signal)
+	// tasks queued behind
+	q.working.Store("1", newFlushTaskRunner(1, nil))
+	q.working.Store("2", newFlushTaskRunner(1, nil))
+
+	task.runFlushInsert(&emptyFlushTask{}, nil, nil, nil, false, false, nil)
+	task.runFlushDel(&emptyFlushTask{}, []*DelDataBuf{{}})
+
+	// not head-of-line before the previous task is done
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int64(0), warned.Load())
+
+	close(signal)
+	assert.Eventually(t, func() bool { return warned.Load() >= 2 }, time.Second, 10*time.Millisecond)
+
+	close(release)
+	<-task.finishSignal
+	time.Sleep(20 * time.Millisecond)
+	count := warned.Load()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, count, warned.Load())
+}
+
+func TestWriteBarrier(t *testing.T) {
+	barrier := newWriteBarrier()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, barrier.Wait(ctx), context.DeadlineExceeded)
+
+	barrier.close()
+	// close is idempotent
+	barrier.close()
+	assert.NoError(t, barrier.Wait(context.Background()))
 }
 
 func TestFlushTaskRunner_FailError(t *testing.T) {
@@ -80,8 +136,8 @@ func TestFlushTaskRunner_FailError(t *testing.T) {
 	assert.False(t, errFlag)
 	assert.False(t, nextFlag)
 
-	task.runFlushInsert(&errFlushTask{}, nil, nil, false, false, nil, retry.Attempts(1))
-	task.runFlushDel(&errFlushTask{}, &DelDataBuf{}, retry.Attempts(1))
+	task.runFlushInsert(&errFlushTask{}, nil, nil, nil, false, false, nil, retry.Attempts(1))
+	task.runFlushDel(&errFlushTask{}, []*DelDataBuf{{}}, retry.Attempts(1))
 
 	assert.False(t, errFlag)
 	assert.False(t, nextFlag)
@@ -94,6 +150,39 @@ func TestFlushTaskRunner_FailError(t *testing.T) {
 
 }
 
+func TestFlushTaskRunner_Panic(t *testing.T) {
+	task := newFlushTaskRunner(1, nil)
+	signal := make(chan struct{})
+
+	sources := make(chan string, 2)
+	task.panicHandler = func(source string, r interface{}) {
+		sources <- source
+	}
+
+	errFlag := false
+	processed := make(chan struct{})
+
+	task.init(func(pack *segmentFlushPack) {
+		if pack.err != nil {
+			errFlag = true
+		}
+	}, func(pack *segmentFlushPack, i postInjectionFunc) {}, signal)
+
+	go func() {
+		<-task.finishSignal
+		processed <- struct{}{}
+	}()
+
+	task.runFlushInsert(&panicFlushTask{}, nil, nil, nil, false, false, nil, retry.Attempts(1))
+	task.runFlushDel(&emptyFlushTask{}, []*DelDataBuf{{}}, retry.Attempts(1))
+
+	close(signal)
+	<-processed
+
+	assert.True(t, errFlag)
+	assert.Equal(t, "flush insert data", <-sources)
+}
+
 func TestFlushTaskRunner_Injection(t *testing.T) {
 	injectCh := make(chan taskInjection, 1)
 	task := newFlushTaskRunner(1, injectCh)
@@ -138,8 +227,8 @@ func TestFlushTaskRunner_Injection(t *testing.T) {
 	assert.False(t, saveFlag)
 	assert.False(t, nextFlag)
 
-	task.runFlushInsert(&emptyFlushTask{}, nil, nil, false, false, nil)
-	task.runFlushDel(&emptyFlushTask{}, &DelDataBuf{})
+	task.runFlushInsert(&emptyFlushTask{}, nil, nil, nil, false, false, nil)
+	task.runFlushDel(&emptyFlushTask{}, []*DelDataBuf{{}})
 
 	assert.False(t, saveFlag)
 	assert.False(t, nextFlag)
@@ -0,0 +1,260 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+var errInjectedFault = errors.New("injected fault")
+
+// InMemoryKV is a kv.BaseKV keeping values in a sync.Map, so that flush is tested without MinIO.
+// Each write call fails with probability FaultRate, a MultiSave failed may have saved part of the kvs.
+// If DetectCollision is set, overwriting a key with a different value fails, rewriting the same value succeeds
+type InMemoryKV struct {
+	data sync.Map // key => value
+
+	FaultRate       float64
+	DetectCollision bool
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+var _ kv.BaseKV = (*InMemoryKV)(nil)
+
+// NewInMemoryKV creates an InMemoryKV, faults are injected with a fixed seed so that tests are reproducible
+func NewInMemoryKV(faultRate float64) *InMemoryKV {
+	return &InMemoryKV{
+		FaultRate: faultRate,
+		rand:      rand.New(rand.NewSource(1)),
+	}
+}
+
+// fault returns errInjectedFault with probability FaultRate
+func (kv *InMemoryKV) fault() error {
+	if kv.FaultRate <= 0 {
+		return nil
+	}
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.rand.Float64() < kv.FaultRate {
+		return errInjectedFault
+	}
+	return nil
+}
+
+func (kv *InMemoryKV) Load(key string) (string, error) {
+	value, ok := kv.data.Load(key)
+	if !ok {
+		return "", fmt.Errorf("key %s not found", key)
+	}
+	return value.(string), nil
+}
+
+func (kv *InMemoryKV) MultiLoad(keys []string) ([]string, error) {
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value, err := kv.Load(key)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// LoadWithPrefix returns kvs of the prefix sorted by key
+func (kv *InMemoryKV) LoadWithPrefix(prefix string) ([]string, []string, error) {
+	var keys []string
+	kv.data.Range(func(key, value interface{}) bool {
+		if strings.HasPrefix(key.(string), prefix) {
+			keys = append(keys, key.(string))
+		}
+		return true
+	})
+	sort.Strings(keys)
+	values, err := kv.MultiLoad(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
+func (kv *InMemoryKV) save(key, value string) error {
+	if kv.DetectCollision {
+		if saved, loaded := kv.data.LoadOrStore(key, value); loaded && saved.(string) != value {
+			return fmt.Errorf("key %s collides with a different value", key)
+		}
+		return nil
+	}
+	kv.data.Store(key, value)
+	return nil
+}
+
+func (kv *InMemoryKV) Save(key, value string) error {
+	if err := kv.fault(); err != nil {
+		return err
+	}
+	return kv.save(key, value)
+}
+
+// MultiSave saves kvs in key order, a fault is injected after half of the kvs are saved
+func (kv *InMemoryKV) MultiSave(kvs map[string]string) error {
+	keys := make([]string, 0, len(kvs))
+	for key := range kvs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fault := kv.fault()
+	for i, key := range keys {
+		if fault != nil && i >= len(keys)/2 {
+			return fault
+		}
+		if err := kv.save(key, kvs[key]); err != nil {
+			return err
+		}
+	}
+	return fault
+}
+
+func (kv *InMemoryKV) MultiSaveWithContext(ctx context.Context, kvs map[string]string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return kv.MultiSave(kvs)
+}
+
+func (kv *InMemoryKV) Remove(key string) error {
+	if err := kv.fault(); err != nil {
+		return err
+	}
+	kv.data.Delete(key)
+	return nil
+}
+
+func (kv *InMemoryKV) MultiRemove(keys []string) error {
+	if err := kv.fault(); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		kv.data.Delete(key)
+	}
+	return nil
+}
+
+func (kv *InMemoryKV) RemoveWithPrefix(prefix string) error {
+	if err := kv.fault(); err != nil {
+		return err
+	}
+	kv.data.Range(func(key, value interface{}) bool {
+		if strings.HasPrefix(key.(string), prefix) {
+			kv.data.Delete(key)
+		}
+		return true
+	})
+	return nil
+}
+
+func (kv *InMemoryKV) Close() {}
+
+// NewInMemoryFlushManager creates a flush manager uploading into an InMemoryKV with ids of a mock allocator,
+// no network call is made. Failed uploads are retried without backoff
+func NewInMemoryFlushManager(replica Replica, faultRate float64, f notifyMetaFunc) (*rendezvousFlushManager, *InMemoryKV) {
+	kv := NewInMemoryKV(faultRate)
+	m := NewRendezvousFlushManager(NewAllocatorFactory(), kv, replica, f)
+	m.retryOpts = []retry.Option{retry.Attempts(10), retry.Sleep(time.Millisecond), retry.MaxSleepTime(time.Millisecond)}
+	if m.pipeline != nil {
+		m.pipeline.retryOpts = m.retryOpts
+	}
+	return m, kv
+}
+
+func TestInMemoryKV(t *testing.T) {
+	kv := NewInMemoryKV(0)
+	assert.NoError(t, kv.Save("a/1", "1"))
+	assert.NoError(t, kv.MultiSave(map[string]string{"a/2": "2", "b/1": "3"}))
+
+	value, err := kv.Load("a/1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", value)
+	_, err = kv.Load("c")
+	assert.Error(t, err)
+	_, err = kv.MultiLoad([]string{"a/1", "c"})
+	assert.Error(t, err)
+
+	keys, values, err := kv.LoadWithPrefix("a/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a/1", "a/2"}, keys)
+	assert.Equal(t, []string{"1", "2"}, values)
+
+	assert.NoError(t, kv.Remove("a/1"))
+	assert.NoError(t, kv.MultiRemove([]string{"a/2"}))
+	assert.NoError(t, kv.RemoveWithPrefix("b/"))
+	keys, _, err = kv.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, kv.MultiSaveWithContext(ctx, map[string]string{"a": "1"}), context.Canceled)
+
+	t.Run("collision", func(t *testing.T) {
+		kv := NewInMemoryKV(0)
+		kv.DetectCollision = true
+		assert.NoError(t, kv.Save("a", "1"))
+		assert.NoError(t, kv.Save("a", "1"))
+		assert.Error(t, kv.Save("a", "2"))
+		assert.Error(t, kv.MultiSave(map[string]string{"a": "2"}))
+	})
+
+	t.Run("fault injection", func(t *testing.T) {
+		kv := NewInMemoryKV(1)
+		assert.ErrorIs(t, kv.Save("a", "1"), errInjectedFault)
+		assert.ErrorIs(t, kv.Remove("a"), errInjectedFault)
+		assert.ErrorIs(t, kv.MultiRemove([]string{"a"}), errInjectedFault)
+		assert.ErrorIs(t, kv.RemoveWithPrefix("a"), errInjectedFault)
+
+		// half of the kvs are saved
+		assert.ErrorIs(t, kv.MultiSave(map[string]string{"a": "1", "b": "2", "c": "3", "d": "4"}), errInjectedFault)
+		keys, _, err := kv.LoadWithPrefix("")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, keys)
+
+		kv.FaultRate = 0.5
+		var faults int
+		for i := 0; i < 100; i++ {
+			if kv.Save("e", "5") != nil {
+				faults++
+			}
+		}
+		assert.True(t, faults > 0 && faults < 100)
+	})
+}
@@ -0,0 +1,233 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// spilledStat is the size of the insert buffers spilled for a segment
+type spilledStat struct {
+	rows       int64
+	memorySize int64
+}
+
+// bufferFull tells whether the segment is to be flushed automatically, the rows and bytes spilled to disk are counted
+// in with the buffer, otherwise a segment spilled under memory pressure keeps growing on disk until flushed manually
+func (ibNode *insertBufferNode) bufferFull(segID UniqueID, bd *BufferData) bool {
+	spilled := ibNode.spilledStats[segID]
+	if bd.effectiveCap()-spilled.rows <= 0 {
+		return true
+	}
+	return spilled.memorySize > 0 && bd.memorySize+spilled.memorySize >= flushPolicy.bufferSize()
+}
+
+// spillInsertBuffers serializes all non-empty insert buffers into local temp files and frees them,
+// the spilled data is read back by loadSpilledBuffer when the segment is flushed
+func (ibNode *insertBufferNode) spillInsertBuffers(pos *internalpb.MsgPosition) {
+	ibNode.insertBuffer.Range(func(k, v interface{}) bool {
+		segID := k.(UniqueID)
+		bd := v.(*BufferData)
+		if bd.size <= 0 {
+			return true
+		}
+		filePath, err := ibNode.spillBuffer(segID, bd, pos)
+		if err != nil {
+			log.Warn("failed to spill insert buffer", zap.Int64("segmentID", segID), zap.Error(err))
+			return true
+		}
+		log.Info("insert buffer spilled to disk",
+			zap.Int64("segmentID", segID),
+			zap.String("vchannel name", ibNode.channelName),
+			zap.Int64("numRows", bd.size),
+			zap.Int64("memorySize", bd.memorySize),
+			zap.String("file", filePath))
+		ibNode.spilledFiles[segID] = append(ibNode.spilledFiles[segID], filePath)
+		stat := ibNode.spilledStats[segID]
+		stat.rows += bd.size
+		stat.memorySize += bd.memorySize
+		ibNode.spilledStats[segID] = stat
+		ibNode.insertBuffer.Delete(segID)
+		bufferDataPool.Release(bd)
+		return true
+	})
+}
+
+// spillBuffer writes the binlogs of the buffer into a local temp file and returns the file path
+func (ibNode *insertBufferNode) spillBuffer(segID UniqueID, bd *BufferData, pos *internalpb.MsgPosition) (string, error) {
+	collID, partID, err := ibNode.replica.getCollectionAndPartitionID(segID)
+	if err != nil {
+		return "", err
+	}
+	sch, err := ibNode.replica.getCollectionSchema(collID, pos.GetTimestamp())
+	if err != nil {
+		return "", err
+	}
+	inCodec := storage.NewInsertCodec(&etcdpb.CollectionMeta{
+		ID:     collID,
+		Schema: sch,
+	})
+	binLogs, _, err := inCodec.Serialize(partID, segID, bd.buffer)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := ioutil.TempFile("", fmt.Sprintf("datanode-spill-%d-", segID))
+	if err != nil {
+		return "", err
+	}
+	err = gob.NewEncoder(f).Encode(binLogs)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// loadSpilledBuffer merges the spilled data of the segment and the in-memory buffer into a new BufferData,
+// the spilled data goes first. bd is kept untouched, and returned as it is if nothing is spilled
+func (ibNode *insertBufferNode) loadSpilledBuffer(segID UniqueID, bd *BufferData) (*BufferData, error) {
+	files := ibNode.spilledFiles[segID]
+	if len(files) == 0 {
+		return bd, nil
+	}
+
+	merged := &InsertData{Data: make(map[UniqueID]storage.FieldData)}
+	for _, filePath := range files {
+		data, err := readSpilledFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		if err := mergeInsertData(merged, data); err != nil {
+			return nil, err
+		}
+	}
+
+	var size, limit, memorySize int64
+	if bd != nil && bd.buffer != nil {
+		if err := mergeInsertData(merged, bd.buffer); err != nil {
+			return nil, err
+		}
+		limit, memorySize = bd.limit, bd.memorySize
+	}
+	for _, fieldData := range merged.Data {
+		size = int64(fieldData.RowNum())
+		break
+	}
+	return &BufferData{buffer: merged, size: size, limit: limit, memorySize: memorySize}, nil
+}
+
+// removeSpilledFiles deletes the local files spilled for the segment
+func (ibNode *insertBufferNode) removeSpilledFiles(segID UniqueID) {
+	for _, filePath := range ibNode.spilledFiles[segID] {
+		if err := os.Remove(filePath); err != nil {
+			log.Warn("failed to remove spilled insert buffer", zap.String("file", filePath), zap.Error(err))
+		}
+	}
+	delete(ibNode.spilledFiles, segID)
+	delete(ibNode.spilledStats, segID)
+}
+
+func readSpilledFile(filePath string) (*InsertData, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var blobs []*Blob
+	if err := gob.NewDecoder(f).Decode(&blobs); err != nil {
+		return nil, err
+	}
+	inCodec := storage.NewInsertCodec(nil)
+	defer inCodec.Close()
+	_, _, data, err := inCodec.Deserialize(blobs)
+	return data, err
+}
+
+// mergeInsertData appends the rows of src to dst
+func mergeInsertData(dst, src *InsertData) error {
+	for fieldID, srcField := range src.Data {
+		dstField, ok := dst.Data[fieldID]
+		if !ok {
+			dst.Data[fieldID] = srcField
+			continue
+		}
+		switch d := dstField.(type) {
+		case *storage.BoolFieldData:
+			s := srcField.(*storage.BoolFieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		case *storage.Int8FieldData:
+			s := srcField.(*storage.Int8FieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		case *storage.Int16FieldData:
+			s := srcField.(*storage.Int16FieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		case *storage.Int32FieldData:
+			s := srcField.(*storage.Int32FieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		case *storage.Int64FieldData:
+			s := srcField.(*storage.Int64FieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		case *storage.FloatFieldData:
+			s := srcField.(*storage.FloatFieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		case *storage.DoubleFieldData:
+			s := srcField.(*storage.DoubleFieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		case *storage.StringFieldData:
+			s := srcField.(*storage.StringFieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		case *storage.BinaryVectorFieldData:
+			s := srcField.(*storage.BinaryVectorFieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		case *storage.FloatVectorFieldData:
+			s := srcField.(*storage.FloatVectorFieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		case *storage.DynamicFieldData:
+			s := srcField.(*storage.DynamicFieldData)
+			d.Data = append(d.Data, s.Data...)
+			d.NumRows = append(d.NumRows, s.NumRows...)
+		default:
+			return fmt.Errorf("unsupported field data type %T of field %d", dstField, fieldID)
+		}
+	}
+	return nil
+}
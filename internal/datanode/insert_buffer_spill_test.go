@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+)
+
+func TestInsertBufferNode_SpillUnderMemoryPressure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	testPath := "/test/datanode/root/meta"
+	err := clearEtcd(testPath)
+	require.NoError(t, err)
+	Params.MetaRootPath = testPath
+
+	Factory := &MetaFactory{}
+	collMeta := Factory.GetCollectionMeta(UniqueID(0), "coll1")
+
+	colRep := &SegmentReplica{
+		collectionID:    collMeta.ID,
+		newSegments:     make(map[UniqueID]*Segment),
+		normalSegments:  make(map[UniqueID]*Segment),
+		flushedSegments: make(map[UniqueID]*Segment),
+	}
+	colRep.metaService = newMetaService(&RootCoordFactory{}, collMeta.ID)
+
+	msFactory := msgstream.NewPmsFactory()
+	m := map[string]interface{}{
+		"receiveBufSize": 1024,
+		"pulsarAddress":  Params.PulsarAddress,
+		"pulsarBufSize":  1024}
+	err = msFactory.SetParams(m)
+	assert.Nil(t, err)
+
+	flushPacks := []*segmentFlushPack{}
+	fpMut := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	fm := NewRendezvousFlushManager(NewAllocatorFactory(), memkv.NewMemoryKV(), colRep, func(pack *segmentFlushPack) {
+		fpMut.Lock()
+		flushPacks = append(flushPacks, pack)
+		fpMut.Unlock()
+		wg.Done()
+	})
+
+	flushChan := make(chan flushMsg, 100)
+	c := &nodeConfig{
+		replica:      colRep,
+		msFactory:    msFactory,
+		allocator:    NewAllocatorFactory(),
+		vChannelName: "string",
+	}
+	iBNode, err := newInsertBufferNode(ctx, flushChan, fm, newCache(), c)
+	require.NoError(t, err)
+
+	// fill memory over the high watermark
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	monitor := NewMemoryPressureMonitor(time.Second, stats.HeapInuse)
+	ballast := make([]byte, 64<<20)
+	monitor.check()
+	runtime.KeepAlive(ballast)
+	require.True(t, monitor.UnderPressure())
+	iBNode.memMonitor = monitor
+
+	dataFactory := NewDataFactory()
+	inMsg := genFlowGraphInsertMsg("datanode-03-test-spill")
+	inMsg.insertMessages = dataFactory.GetMsgStreamInsertMsgs(2)
+	for _, msg := range inMsg.insertMessages {
+		msg.SegmentID = 1
+	}
+	inMsg.startPositions = []*internalpb.MsgPosition{{Timestamp: 100}}
+	inMsg.endPositions = []*internalpb.MsgPosition{{Timestamp: 123}}
+	iBNode.Operate([]flowgraph.Msg{&inMsg})
+
+	// buffer is spilled to disk and freed
+	_, ok := iBNode.insertBuffer.Load(UniqueID(1))
+	assert.False(t, ok)
+	require.Equal(t, 1, len(iBNode.spilledFiles[1]))
+	assert.EqualValues(t, 2, iBNode.spilledStats[1].rows)
+	spilledFile := iBNode.spilledFiles[1][0]
+	_, err = os.Stat(spilledFile)
+	assert.NoError(t, err)
+
+	// pressure relieved, new rows stay in memory
+	monitor.underPressure.Store(false)
+	inMsg.startPositions = []*internalpb.MsgPosition{{Timestamp: 200}}
+	inMsg.endPositions = []*internalpb.MsgPosition{{Timestamp: 234}}
+	iBNode.Operate([]flowgraph.Msg{&inMsg})
+	bd, ok := iBNode.insertBuffer.Load(UniqueID(1))
+	require.True(t, ok)
+
+	merged, err := iBNode.loadSpilledBuffer(1, bd.(*BufferData))
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), merged.size)
+	for _, fieldData := range merged.buffer.Data {
+		assert.Equal(t, 4, fieldData.RowNum())
+	}
+
+	// spilled rows are uploaded in the next flush
+	flushChan <- flushMsg{segmentID: 1, flushed: true}
+	inMsg.insertMessages = nil
+	inMsg.startPositions = []*internalpb.MsgPosition{{Timestamp: 300}}
+	inMsg.endPositions = []*internalpb.MsgPosition{{Timestamp: 345}}
+	output := iBNode.Operate([]flowgraph.Msg{&inMsg})
+	fgm := output[0].(*flowGraphMsg)
+	require.Equal(t, []UniqueID{1}, fgm.segmentsToFlush)
+	wg.Add(1)
+	fm.flushDelData(nil, 1, fgm.endPositions[0])
+	wg.Wait()
+
+	require.Equal(t, 1, len(flushPacks))
+	assert.Less(t, 0, len(flushPacks[0].insertLogs))
+	assert.Equal(t, 0, len(iBNode.spilledFiles))
+	assert.Equal(t, 0, len(iBNode.spilledStats))
+	_, err = os.Stat(spilledFile)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestInsertBufferNode_BufferFull(t *testing.T) {
+	ibNode := &insertBufferNode{spilledStats: make(map[UniqueID]spilledStat)}
+	bd := &BufferData{size: 10, limit: 100, memorySize: 1}
+	assert.False(t, ibNode.bufferFull(1, bd))
+
+	// spilled rows fill the buffer
+	ibNode.spilledStats[1] = spilledStat{rows: 90, memorySize: 1}
+	assert.True(t, ibNode.bufferFull(1, bd))
+	assert.False(t, ibNode.bufferFull(2, bd))
+
+	// spilled bytes fill the buffer
+	ibNode.spilledStats[1] = spilledStat{rows: 10, memorySize: flushPolicy.bufferSize()}
+	assert.True(t, ibNode.bufferFull(1, bd))
+}
+
+func TestMergeInsertData(t *testing.T) {
+	dst := &InsertData{Data: map[UniqueID]storage.FieldData{
+		1: &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+		2: &storage.FloatVectorFieldData{NumRows: []int64{1}, Data: []float32{0.1, 0.2}, Dim: 2},
+	}}
+	src := &InsertData{Data: map[UniqueID]storage.FieldData{
+		1: &storage.Int64FieldData{NumRows: []int64{1}, Data: []int64{3}},
+		2: &storage.FloatVectorFieldData{NumRows: []int64{1}, Data: []float32{0.3, 0.4}, Dim: 2},
+		3: &storage.StringFieldData{NumRows: []int64{1}, Data: []string{"a"}},
+	}}
+	err := mergeInsertData(dst, src)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, dst.Data[1].(*storage.Int64FieldData).Data)
+	assert.Equal(t, []int64{2, 1}, dst.Data[1].(*storage.Int64FieldData).NumRows)
+	assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, dst.Data[2].(*storage.FloatVectorFieldData).Data)
+	assert.Equal(t, 2, dst.Data[2].RowNum())
+	assert.Equal(t, []string{"a"}, dst.Data[3].(*storage.StringFieldData).Data)
+}
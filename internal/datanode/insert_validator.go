@@ -0,0 +1,339 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"go.uber.org/zap"
+)
+
+// schema constraints rows may violate
+const (
+	constraintRowSize   = "row_size"   // size of row data differs from the fields of schema
+	constraintFinite    = "finite"     // NaN or infinity in float, double or float vector field
+	constraintBoolValue = "bool_value" // byte of bool field other than 0 or 1
+)
+
+// constraintViolation is the first schema constraint a row violates
+type constraintViolation struct {
+	fieldID    UniqueID
+	constraint string
+}
+
+// rowField is the layout of a field in row data
+type rowField struct {
+	fieldID  UniqueID
+	dataType schemapb.DataType
+	offset   int
+	size     int
+}
+
+// SchemaConstraintValidator checks rows of insert messages against the collection schema. bufferInsertMsg reads
+// rows by the schema layout without any check, so a malformed row would be buffered silently and only fail when
+// its binlog is read. Rows hold fixed size fields only, the constraints are on the row size and the values of
+// float and bool fields
+type SchemaConstraintValidator struct {
+	fields  []rowField
+	rowSize int
+}
+
+// NewSchemaConstraintValidator creates the validator of rows of the schema
+func NewSchemaConstraintValidator(schema *schemapb.CollectionSchema) (*SchemaConstraintValidator, error) {
+	v := &SchemaConstraintValidator{}
+	for _, field := range schema.GetFields() {
+		var size int
+		switch field.GetDataType() {
+		case schemapb.DataType_Bool, schemapb.DataType_Int8:
+			size = 1
+		case schemapb.DataType_Int16:
+			size = 2
+		case schemapb.DataType_Int32, schemapb.DataType_Float:
+			size = 4
+		case schemapb.DataType_Int64:
+			// row ids and timestamps are not in row data
+			if field.GetFieldID() == common.RowIDField || field.GetFieldID() == common.TimeStampField {
+				continue
+			}
+			size = 8
+		case schemapb.DataType_Double:
+			size = 8
+		case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+			dim := -1
+			for _, t := range field.GetTypeParams() {
+				if t.GetKey() == "dim" {
+					var err error
+					if dim, err = strconv.Atoi(t.GetValue()); err != nil {
+						return nil, fmt.Errorf("invalid dim of field %d: %w", field.GetFieldID(), err)
+					}
+					break
+				}
+			}
+			if dim <= 0 {
+				return nil, fmt.Errorf("invalid dim %d of field %d", dim, field.GetFieldID())
+			}
+			if field.GetDataType() == schemapb.DataType_FloatVector {
+				size = dim * 4
+			} else {
+				if dim%8 != 0 {
+					return nil, fmt.Errorf("dim %d of binary vector field %d is not a multiple of 8", dim, field.GetFieldID())
+				}
+				size = dim / 8
+			}
+		default:
+			// not read from row data
+			continue
+		}
+		v.fields = append(v.fields, rowField{
+			fieldID:  field.GetFieldID(),
+			dataType: field.GetDataType(),
+			offset:   v.rowSize,
+			size:     size,
+		})
+		v.rowSize += size
+	}
+	return v, nil
+}
+
+// Validate returns the first constraint the row violates, nil if the row is valid
+func (v *SchemaConstraintValidator) Validate(row []byte) *constraintViolation {
+	if len(row) != v.rowSize {
+		// the field truncated, or no field if the row is longer
+		fieldID := common.InvalidFieldID
+		for _, field := range v.fields {
+			if field.offset+field.size > len(row) {
+				fieldID = field.fieldID
+				break
+			}
+		}
+		return &constraintViolation{fieldID: fieldID, constraint: constraintRowSize}
+	}
+	for _, field := range v.fields {
+		data := row[field.offset : field.offset+field.size]
+		switch field.dataType {
+		case schemapb.DataType_Bool:
+			if data[0] > 1 {
+				return &constraintViolation{fieldID: field.fieldID, constraint: constraintBoolValue}
+			}
+		case schemapb.DataType_Float, schemapb.DataType_FloatVector:
+			for i := 0; i < len(data); i += 4 {
+				f := float64(math.Float32frombits(common.Endian.Uint32(data[i:])))
+				if math.IsNaN(f) || math.IsInf(f, 0) {
+					return &constraintViolation{fieldID: field.fieldID, constraint: constraintFinite}
+				}
+			}
+		case schemapb.DataType_Double:
+			f := math.Float64frombits(common.Endian.Uint64(data))
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return &constraintViolation{fieldID: field.fieldID, constraint: constraintFinite}
+			}
+		}
+	}
+	return nil
+}
+
+// insertMsgValidator removes rows violating schema constraints from insert messages before they're buffered,
+// and publishes the rows removed to the insert error channel rather than dropping them silently
+type insertMsgValidator struct {
+	ctx       context.Context
+	replica   Replica
+	stream    msgstream.MsgStream
+	retryOpts []retry.Option
+
+	// validator of the schema last validated against, the replica replaces the schema on reload,
+	// so a schema is a version of the collection schema
+	schema       *schemapb.CollectionSchema
+	validator    *SchemaConstraintValidator
+	validatorErr error
+}
+
+func newInsertMsgValidator(ctx context.Context, factory msgstream.Factory, replica Replica) (*insertMsgValidator, error) {
+	stream, err := factory.NewMsgStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// messages of invalid rows are published as is
+	stream.SetRepackFunc(msgstream.DefaultRepackFunc)
+	stream.AsProducer([]string{Params.InsertErrorTopic})
+	log.Debug("datanode AsProducer", zap.String("InsertErrorTopic", Params.InsertErrorTopic))
+	stream.Start()
+
+	return &insertMsgValidator{
+		ctx:     ctx,
+		replica: replica,
+		stream:  stream,
+	}, nil
+}
+
+// validate returns the insert messages with invalid rows removed, messages without valid rows are removed
+func (v *insertMsgValidator) validate(msgs []*msgstream.InsertMsg) []*msgstream.InsertMsg {
+	ret := make([]*msgstream.InsertMsg, 0, len(msgs))
+	rejected := &msgstream.MsgPack{}
+	for _, msg := range msgs {
+		valid, invalid := v.validateMsg(msg)
+		if valid != nil {
+			ret = append(ret, valid)
+		}
+		if invalid != nil {
+			rejected.Msgs = append(rejected.Msgs, invalid)
+		}
+	}
+	if len(rejected.Msgs) > 0 {
+		rejected.BeginTs = rejected.Msgs[0].BeginTs()
+		rejected.EndTs = rejected.Msgs[len(rejected.Msgs)-1].EndTs()
+		v.publish(rejected)
+	}
+	return ret
+}
+
+// publish produces the invalid rows to the insert error channel. The flowgraph is blocked until they're published,
+// since the rows are removed from the messages and would be lost otherwise, unless the validator is closing
+func (v *insertMsgValidator) publish(pack *msgstream.MsgPack) {
+	for {
+		err := retry.Do(v.ctx, func() error {
+			return v.stream.Produce(pack)
+		}, v.retryOpts...)
+		if err == nil {
+			return
+		}
+		if v.ctx.Err() != nil {
+			log.Error("failed to publish invalid rows to insert error channel, validator closed",
+				zap.String("channel", Params.InsertErrorTopic), zap.Int("messages", len(pack.Msgs)), zap.Error(err))
+			return
+		}
+		log.Warn("failed to publish invalid rows to insert error channel, keep retrying",
+			zap.String("channel", Params.InsertErrorTopic), zap.Int("messages", len(pack.Msgs)), zap.Error(err))
+	}
+}
+
+// schemaValidator returns the validator of the schema, which is created once per schema
+func (v *insertMsgValidator) schemaValidator(schema *schemapb.CollectionSchema) (*SchemaConstraintValidator, error) {
+	if schema != v.schema {
+		v.schema = schema
+		v.validator, v.validatorErr = NewSchemaConstraintValidator(schema)
+	}
+	return v.validator, v.validatorErr
+}
+
+// validateMsg splits the message into the valid rows and the invalid rows, either is nil if there's no such row.
+// Messages which can't be validated are kept as is, bufferInsertMsg reports their errors
+func (v *insertMsgValidator) validateMsg(msg *msgstream.InsertMsg) (valid, invalid *msgstream.InsertMsg) {
+	if len(msg.RowIDs) != len(msg.Timestamps) || len(msg.RowIDs) != len(msg.RowData) {
+		return msg, nil
+	}
+	schema, err := v.replica.getCollectionSchema(msg.GetCollectionID(), msg.EndTs())
+	if err != nil {
+		return msg, nil
+	}
+	validator, err := v.schemaValidator(schema)
+	if err != nil {
+		log.Warn("failed to validate insert rows", zap.Int64("collectionID", msg.GetCollectionID()), zap.Error(err))
+		return msg, nil
+	}
+
+	nodeID := strconv.FormatInt(Params.NodeID, 10)
+	invalidRows := make([]bool, len(msg.RowData))
+	var invalidNum int
+	for i, row := range msg.RowData {
+		violation := validator.Validate(row.GetValue())
+		if violation == nil {
+			continue
+		}
+		invalidRows[i] = true
+		invalidNum++
+		metrics.DataNodeInsertConstraintViolations.WithLabelValues(nodeID, violation.constraint,
+			strconv.FormatInt(violation.fieldID, 10)).Inc()
+	}
+	if invalidNum == 0 {
+		return msg, nil
+	}
+	log.Warn("insert rows violate schema constraints", zap.Int64("collectionID", msg.GetCollectionID()),
+		zap.Int64("segmentID", msg.GetSegmentID()), zap.Int("rows", invalidNum))
+
+	valid, invalid = splitInsertMsg(msg, invalidRows)
+	if invalidNum == len(msg.RowData) {
+		valid = nil
+	}
+	return valid, invalid
+}
+
+// splitInsertMsg splits the rows of the message into two messages by the flags of rows
+func splitInsertMsg(msg *msgstream.InsertMsg, flags []bool) (unflagged, flagged *msgstream.InsertMsg) {
+	newMsg := func() *msgstream.InsertMsg {
+		return &msgstream.InsertMsg{
+			BaseMsg: msgstream.BaseMsg{
+				Ctx:            msg.Ctx,
+				BeginTimestamp: msg.BeginTimestamp,
+				EndTimestamp:   msg.EndTimestamp,
+				MsgPosition:    msg.MsgPosition,
+			},
+			InsertRequest: internalpb.InsertRequest{
+				Base:           msg.Base,
+				ShardName:      msg.ShardName,
+				DbName:         msg.DbName,
+				CollectionName: msg.CollectionName,
+				PartitionName:  msg.PartitionName,
+				DbID:           msg.DbID,
+				CollectionID:   msg.CollectionID,
+				PartitionID:    msg.PartitionID,
+				SegmentID:      msg.SegmentID,
+				Timestamps:     make([]uint64, 0),
+				RowIDs:         make([]int64, 0),
+				RowData:        make([]*commonpb.Blob, 0),
+			},
+		}
+	}
+	unflagged, flagged = newMsg(), newMsg()
+	// hash values are kept per row if they're aligned with rows
+	hashPerRow := len(msg.HashValues) == len(msg.RowData)
+	for i := range msg.RowData {
+		target := unflagged
+		if flags[i] {
+			target = flagged
+		}
+		target.Timestamps = append(target.Timestamps, msg.Timestamps[i])
+		target.RowIDs = append(target.RowIDs, msg.RowIDs[i])
+		target.RowData = append(target.RowData, msg.RowData[i])
+		if hashPerRow {
+			target.HashValues = append(target.HashValues, msg.HashValues[i])
+		}
+	}
+	for _, m := range []*msgstream.InsertMsg{unflagged, flagged} {
+		if len(m.HashValues) == 0 {
+			m.HashValues = msg.HashValues
+		}
+		if len(m.HashValues) == 0 {
+			m.HashValues = []uint32{0}
+		}
+	}
+	return unflagged, flagged
+}
+
+func (v *insertMsgValidator) close() {
+	v.stream.Close()
+}
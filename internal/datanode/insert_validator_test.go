@@ -0,0 +1,238 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaConstraintValidator(t *testing.T) {
+	schema := NewMetaFactory().GetCollectionMeta(1, "col").GetSchema()
+	v, err := NewSchemaConstraintValidator(schema)
+	require.NoError(t, err)
+
+	row := GenRowData()
+	assert.Nil(t, v.Validate(row))
+
+	// truncated in the binary vector field
+	assert.Equal(t, &constraintViolation{fieldID: 101, constraint: constraintRowSize}, v.Validate(row[:10]))
+	assert.Equal(t, &constraintViolation{fieldID: common.InvalidFieldID, constraint: constraintRowSize},
+		v.Validate(append(append([]byte{}, row...), 0)))
+
+	invalid := append([]byte{}, row...)
+	common.Endian.PutUint32(invalid[4:], math.Float32bits(float32(math.NaN())))
+	assert.Equal(t, &constraintViolation{fieldID: 100, constraint: constraintFinite}, v.Validate(invalid))
+
+	invalid = append([]byte{}, row...)
+	common.Endian.PutUint64(invalid[32:], math.Float64bits(math.Inf(-1)))
+	assert.Equal(t, &constraintViolation{fieldID: 108, constraint: constraintFinite}, v.Validate(invalid))
+
+	invalid = append([]byte{}, row...)
+	invalid[12] = 2
+	assert.Equal(t, &constraintViolation{fieldID: 102, constraint: constraintBoolValue}, v.Validate(invalid))
+
+	for _, dim := range []string{"", "0", "a", "12"} {
+		_, err = NewSchemaConstraintValidator(&schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{{
+				FieldID:    100,
+				DataType:   schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: dim}},
+			}},
+		})
+		assert.Error(t, err, dim)
+	}
+}
+
+type schemaReplica struct {
+	Replica
+	schema *schemapb.CollectionSchema
+}
+
+func (r *schemaReplica) getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error) {
+	if r.schema == nil {
+		return nil, errors.New("collection not found")
+	}
+	return r.schema, nil
+}
+
+type insertRecordMsgStream struct {
+	mockTtMsgStream
+	mu       sync.Mutex
+	msgs     []*msgstream.InsertMsg
+	failures int // number of Produce calls to fail, negative to fail forever
+	produced int
+}
+
+func (s *insertRecordMsgStream) Produce(pack *msgstream.MsgPack) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.produced++
+	if s.failures != 0 {
+		s.failures--
+		return errors.New("produce failed")
+	}
+	for _, msg := range pack.Msgs {
+		s.msgs = append(s.msgs, msg.(*msgstream.InsertMsg))
+	}
+	return nil
+}
+
+type insertRecordMsgStreamFactory struct {
+	mockMsgStreamFactory
+	stream *insertRecordMsgStream
+}
+
+func (f *insertRecordMsgStreamFactory) NewMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	return f.stream, nil
+}
+
+func TestInsertMsgValidator(t *testing.T) {
+	_, err := newInsertMsgValidator(context.Background(), &mockMsgStreamFactory{}, &schemaReplica{})
+	assert.Error(t, err)
+
+	stream := &insertRecordMsgStream{}
+	replica := &schemaReplica{schema: NewMetaFactory().GetCollectionMeta(1, "col").GetSchema()}
+	v, err := newInsertMsgValidator(context.Background(), &insertRecordMsgStreamFactory{stream: stream}, replica)
+	require.NoError(t, err)
+	defer v.close()
+
+	row := GenRowData()
+	nan := append([]byte{}, row...)
+	common.Endian.PutUint32(nan, math.Float32bits(float32(math.NaN())))
+	newMsg := func(rows ...[]byte) *msgstream.InsertMsg {
+		msg := NewDataFactory().GenMsgStreamInsertMsg(0, "ch1")
+		msg.HashValues, msg.Timestamps, msg.RowIDs, msg.RowData = nil, nil, nil, nil
+		for i, r := range rows {
+			msg.HashValues = append(msg.HashValues, uint32(i))
+			msg.Timestamps = append(msg.Timestamps, Timestamp(1000+i))
+			msg.RowIDs = append(msg.RowIDs, UniqueID(i))
+			msg.RowData = append(msg.RowData, &commonpb.Blob{Value: r})
+		}
+		return msg
+	}
+
+	valid := newMsg(row, row)
+	mixed := newMsg(row, nan, row[:10])
+	allInvalid := newMsg(nan)
+	msgs := v.validate([]*msgstream.InsertMsg{valid, mixed, allInvalid})
+	require.Len(t, msgs, 2)
+	assert.Same(t, valid, msgs[0])
+	assert.Equal(t, []UniqueID{0}, msgs[1].RowIDs)
+	assert.Equal(t, []Timestamp{1000}, msgs[1].Timestamps)
+	assert.Equal(t, []uint32{0}, msgs[1].HashValues)
+	assert.Equal(t, mixed.GetSegmentID(), msgs[1].GetSegmentID())
+
+	require.Len(t, stream.msgs, 2)
+	assert.Equal(t, []UniqueID{1, 2}, stream.msgs[0].RowIDs)
+	assert.Equal(t, []uint32{1, 2}, stream.msgs[0].HashValues)
+	assert.Equal(t, row[:10], stream.msgs[0].RowData[1].GetValue())
+	assert.Equal(t, []UniqueID{0}, stream.msgs[1].RowIDs)
+
+	// messages which can't be validated are kept as is
+	misaligned := newMsg(nan)
+	misaligned.RowIDs = nil
+	replica.schema = nil
+	unknown := newMsg(nan)
+	msgs = v.validate([]*msgstream.InsertMsg{misaligned, unknown})
+	assert.Equal(t, []*msgstream.InsertMsg{misaligned, unknown}, msgs)
+	assert.Len(t, stream.msgs, 2)
+}
+
+func TestInsertMsgValidator_Publish(t *testing.T) {
+	row := GenRowData()
+	nan := append([]byte{}, row...)
+	common.Endian.PutUint32(nan, math.Float32bits(float32(math.NaN())))
+	newMsg := func() *msgstream.InsertMsg {
+		msg := NewDataFactory().GenMsgStreamInsertMsg(0, "ch1")
+		msg.HashValues = []uint32{0}
+		msg.Timestamps = []Timestamp{1000}
+		msg.RowIDs = []UniqueID{0}
+		msg.RowData = []*commonpb.Blob{{Value: nan}}
+		return msg
+	}
+	schema := NewMetaFactory().GetCollectionMeta(1, "col").GetSchema()
+
+	t.Run("blocked until published", func(t *testing.T) {
+		// more failures than the attempts of one retry
+		stream := &insertRecordMsgStream{failures: 5}
+		v, err := newInsertMsgValidator(context.Background(), &insertRecordMsgStreamFactory{stream: stream}, &schemaReplica{schema: schema})
+		require.NoError(t, err)
+		v.retryOpts = []retry.Option{retry.Attempts(2), retry.Sleep(time.Millisecond)}
+
+		msgs := v.validate([]*msgstream.InsertMsg{newMsg()})
+		assert.Empty(t, msgs)
+		assert.Equal(t, 6, stream.produced)
+		assert.Len(t, stream.msgs, 1)
+	})
+
+	t.Run("validator closing", func(t *testing.T) {
+		stream := &insertRecordMsgStream{failures: -1}
+		ctx, cancel := context.WithCancel(context.Background())
+		v, err := newInsertMsgValidator(ctx, &insertRecordMsgStreamFactory{stream: stream}, &schemaReplica{schema: schema})
+		require.NoError(t, err)
+		v.retryOpts = []retry.Option{retry.Sleep(time.Millisecond)}
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			v.validate([]*msgstream.InsertMsg{newMsg()})
+		}()
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatal("validate is not stopped by the context")
+		}
+		assert.Empty(t, stream.msgs)
+	})
+}
+
+func TestInsertMsgValidator_SchemaValidator(t *testing.T) {
+	replica := &schemaReplica{schema: NewMetaFactory().GetCollectionMeta(1, "col").GetSchema()}
+	v, err := newInsertMsgValidator(context.Background(), &insertRecordMsgStreamFactory{stream: &insertRecordMsgStream{}}, replica)
+	require.NoError(t, err)
+
+	validator, err := v.schemaValidator(replica.schema)
+	require.NoError(t, err)
+	cached, err := v.schemaValidator(replica.schema)
+	require.NoError(t, err)
+	assert.Same(t, validator, cached)
+
+	// a reloaded schema is a new version
+	replica.schema = NewMetaFactory().GetCollectionMeta(1, "col").GetSchema()
+	reloaded, err := v.schemaValidator(replica.schema)
+	require.NoError(t, err)
+	assert.NotSame(t, validator, reloaded)
+
+	_, err = v.schemaValidator(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 100, DataType: schemapb.DataType_FloatVector}},
+	})
+	assert.Error(t, err)
+}
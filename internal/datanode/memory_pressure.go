@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// MemoryPressureMonitor samples the heap usage periodically, and reports memory pressure
+// when heap-in-use exceeds the high watermark
+type MemoryPressureMonitor struct {
+	interval      time.Duration
+	highWatermark uint64
+	readMemStats  func(*runtime.MemStats)
+	underPressure atomic.Bool
+}
+
+// memoryPressureMonitor is the MemoryPressureMonitor shared by all insertBufferNodes,
+// nil means insert buffers are never spilled to disk
+var memoryPressureMonitor *MemoryPressureMonitor
+
+// NewMemoryPressureMonitor creates a MemoryPressureMonitor sampling heap usage every interval
+func NewMemoryPressureMonitor(interval time.Duration, highWatermark uint64) *MemoryPressureMonitor {
+	return &MemoryPressureMonitor{
+		interval:      interval,
+		highWatermark: highWatermark,
+		readMemStats:  runtime.ReadMemStats,
+	}
+}
+
+// Start samples heap usage in background until ctx is done
+func (m *MemoryPressureMonitor) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug("memory pressure monitor quit")
+				return
+			case <-ticker.C:
+				m.check()
+			}
+		}
+	}()
+}
+
+// UnderPressure returns true if heap-in-use exceeded the high watermark at the last sample
+func (m *MemoryPressureMonitor) UnderPressure() bool {
+	return m.underPressure.Load()
+}
+
+func (m *MemoryPressureMonitor) check() {
+	var stats runtime.MemStats
+	m.readMemStats(&stats)
+	pressure := stats.HeapInuse > m.highWatermark
+	if pressure != m.underPressure.Swap(pressure) {
+		log.Info("memory pressure changed", zap.Bool("underPressure", pressure),
+			zap.Uint64("heapInuse", stats.HeapInuse), zap.Uint64("highWatermark", m.highWatermark))
+	}
+}
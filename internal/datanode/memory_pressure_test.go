@@ -0,0 +1,60 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+)
+
+func TestMemoryPressureMonitor(t *testing.T) {
+	var heapInuse atomic.Uint64
+	m := NewMemoryPressureMonitor(10*time.Millisecond, 100)
+	m.readMemStats = func(stats *runtime.MemStats) {
+		stats.HeapInuse = heapInuse.Load()
+	}
+
+	t.Run("test check", func(t *testing.T) {
+		heapInuse.Store(100)
+		m.check()
+		assert.False(t, m.UnderPressure())
+
+		heapInuse.Store(101)
+		m.check()
+		assert.True(t, m.UnderPressure())
+
+		heapInuse.Store(50)
+		m.check()
+		assert.False(t, m.UnderPressure())
+	})
+
+	t.Run("test start", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		m.Start(ctx)
+
+		heapInuse.Store(200)
+		assert.Eventually(t, m.UnderPressure, time.Second, 10*time.Millisecond)
+		heapInuse.Store(0)
+		assert.Eventually(t, func() bool { return !m.UnderPressure() }, time.Second, 10*time.Millisecond)
+	})
+}
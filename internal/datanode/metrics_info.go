@@ -26,6 +26,36 @@ import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// getPendingFlushCount sums the flush tasks pending of all the vchannels
+func (node *DataNode) getPendingFlushCount() int {
+	node.chanMut.RLock()
+	defer node.chanMut.RUnlock()
+	count := 0
+	// flushManager is replaced while the flowgraph is restarted, it's read through the nodes guarded by nodesMut
+	for _, dsService := range node.vchan2SyncService {
+		count += dsService.GetStatus().PendingFlushCount
+	}
+	return count
+}
+
+// getChannelStatuses returns the status of the flowgraph of every vchannel
+func (node *DataNode) getChannelStatuses() map[string]metricsinfo.DataNodeChannelStatus {
+	node.chanMut.RLock()
+	defer node.chanMut.RUnlock()
+	statuses := make(map[string]metricsinfo.DataNodeChannelStatus, len(node.vchan2SyncService))
+	for vchannel, dsService := range node.vchan2SyncService {
+		status := dsService.GetStatus()
+		statuses[vchannel] = metricsinfo.DataNodeChannelStatus{
+			FlowGraphRunning:        status.FlowGraphRunning,
+			TailMsgTimestamp:        status.TailMsgTimestamp,
+			InsertBufferPendingRows: status.InsertBufferPendingRows,
+			PendingFlushCount:       status.PendingFlushCount,
+			LastCheckpointTimestamp: status.LastCheckpointPosition.GetTimestamp(),
+		}
+	}
+	return statuses
+}
+
 func (node *DataNode) getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	// TODO(dragondriver): add more metrics
 	nodeInfos := metricsinfo.DataNodeInfos{
@@ -52,6 +82,9 @@ func (node *DataNode) getSystemInfoMetrics(ctx context.Context, req *milvuspb.Ge
 		SystemConfigurations: metricsinfo.DataNodeConfiguration{
 			FlushInsertBufferSize: Params.FlushInsertBufferSize,
 		},
+		FlushLatencies:    segmentFlushLatencies.snapshot(),
+		PendingFlushCount: node.getPendingFlushCount(),
+		Channels:          node.getChannelStatuses(),
 	}
 	resp, err := metricsinfo.MarshalComponentInfos(nodeInfos)
 	if err != nil {
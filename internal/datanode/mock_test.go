@@ -157,9 +157,61 @@ type DataCoordFactory struct {
 
 	SaveBinlogPathError      bool
 	SaveBinlogPathNotSuccess bool
+	// returned by SaveBinlogPaths if not nil
+	SaveBinlogPathStatus *commonpb.Status
+	SaveBinlogPathCalls  int
+	// requests of SaveBinlogPaths received
+	SaveBinlogPathRequests []*datapb.SaveBinlogPathsRequest
 
 	CompleteCompactionError      bool
 	CompleteCompactionNotSuccess bool
+
+	// requests of ReportSegmentError received
+	SegmentErrorReports []*datapb.ReportSegmentErrorRequest
+
+	healthReportsMu sync.Mutex
+	// requests of ReportDataNodeHealth received
+	HealthReports []*datapb.ReportDataNodeHealthRequest
+
+	// segments returned by GetSegmentInfo if found
+	SegmentInfos []*datapb.SegmentInfo
+
+	// vchannels returned by GetRecoveryInfo
+	RecoveryChannels []*datapb.VchannelInfo
+}
+
+func (ds *DataCoordFactory) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error) {
+	resp := &datapb.GetRecoveryInfoResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	for _, vchan := range ds.RecoveryChannels {
+		if vchan.GetCollectionID() == req.GetCollectionID() {
+			resp.Channels = append(resp.Channels, vchan)
+		}
+	}
+	return resp, nil
+}
+
+func (ds *DataCoordFactory) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	resp := &datapb.GetSegmentInfoResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	for _, info := range ds.SegmentInfos {
+		for _, segmentID := range req.GetSegmentIDs() {
+			if info.GetID() == segmentID {
+				resp.Infos = append(resp.Infos, info)
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (ds *DataCoordFactory) ReportDataNodeHealth(ctx context.Context, req *datapb.ReportDataNodeHealthRequest) (*commonpb.Status, error) {
+	ds.healthReportsMu.Lock()
+	defer ds.healthReportsMu.Unlock()
+	ds.HealthReports = append(ds.HealthReports, req)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (ds *DataCoordFactory) ReportSegmentError(ctx context.Context, req *datapb.ReportSegmentErrorRequest) (*commonpb.Status, error) {
+	ds.SegmentErrorReports = append(ds.SegmentErrorReports, req)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
 func (ds *DataCoordFactory) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error) {
@@ -174,6 +226,11 @@ func (ds *DataCoordFactory) CompleteCompaction(ctx context.Context, req *datapb.
 }
 
 func (ds *DataCoordFactory) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
+	ds.SaveBinlogPathCalls++
+	ds.SaveBinlogPathRequests = append(ds.SaveBinlogPathRequests, req)
+	if ds.SaveBinlogPathStatus != nil {
+		return ds.SaveBinlogPathStatus, nil
+	}
 	if ds.SaveBinlogPathError {
 		return nil, errors.New("Error")
 	}
@@ -17,12 +17,15 @@
 package datanode
 
 import (
+	"fmt"
 	"path"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
@@ -43,9 +46,153 @@ type ParamTable struct {
 	FlushInsertBufferSize   int64
 	InsertBinlogRootPath    string
 	StatsBinlogRootPath     string
+	SketchBinlogRootPath    string
 	DeleteBinlogRootPath    string
+	PendingDeltaLogRootPath string
 	Alias                   string // Different datanode in one machine
 
+	// Number of message packs held to reorder by positions in dmInputNode, 0 means no reorder
+	ReorderBufferSize int
+	// Maximum time in milliseconds a message pack is held in the reorder buffer
+	ReorderMaxDelayMs int64
+	// Milliseconds without any message pack consumed from a dm stream after which the consumer is recreated,
+	// 0 means never recreate
+	PulsarHeartbeatTimeoutMs int64
+	// Messages per second replayed by a vchannel during recovery, non-positive value means unlimited
+	RecoveryMaxMessagesPerSecond float64
+	// Milliseconds behind the stream head within which a recovering vchannel is caught up and no longer limited
+	RecoveryCatchupGapMs int64
+
+	// SaveBinlogPaths rate limit
+	MaxSaveBinlogRatePerSec float64
+	SaveBinlogBurstSize     int
+
+	// Bytes per second written into blob storage by flush and compaction
+	MaxBlobStorageBandwidthBytesPerSec float64
+
+	// Number of BufferData objects preallocated in pool at startup
+	BufferDataPoolPreallocSize int
+
+	// Number of concurrent MultiSave calls to upload binlogs of a flush
+	FlushUploadConcurrency int
+
+	// Number of flush tasks buffered between stages of the flush pipeline, 0 means flush without the pipeline
+	FlushPipelineDepth int
+
+	// Maximum number of flush tasks pending in the flush queues of a vchannel, non-positive value means unlimited
+	MaxPendingFlushTasks int
+	// Milliseconds the insert data of a flush waits for a pending flush task to finish once the limit is reached,
+	// 0 means waiting until one finishes. The delete data of a flush waits until one finishes anyway
+	FlushBackpressureTimeoutMs int64
+
+	// Timeout in seconds of FlushAll waiting for all segments to be flushed
+	FlushAllTimeoutSeconds int64
+
+	// Seconds a flush queue waits for an injection, e.g. of a compaction, to be over, non-positive value means waiting forever
+	FlushInjectTimeoutSeconds int64
+
+	// Maximum size in bytes of a delta log file, delete data exceeding it is split into multiple files
+	MaxDeltaLogFileSizeBytes int64
+
+	// Number of delete buffers of a segment pending in its flush queue merged into one delta log, less than 2 means no merge
+	DeltaLogMergeThreshold int
+
+	// Delete transactions not committed within it in milliseconds are aborted by the recovery GC
+	DeleteTransactionTimeoutMs int64
+
+	// Whether to buffer only the latest delete of each primary key, so that a flush writes one delta log entry per key
+	EnableDeleteDeduplication bool
+
+	// Interval in milliseconds to sample heap usage
+	MemPressureCheckIntervalMs int64
+
+	// Insert buffers are spilled to disk when heap-in-use exceeds it in bytes, 0 means never spill
+	MemPressureHighWatermark int64
+
+	// Whether to publish flushed positions of segments to the durability ack channel
+	EnableDurabilityAck bool
+
+	// Whether to validate insert rows against the schema before buffering, invalid rows are published to InsertErrorTopic
+	EnableInsertValidation bool
+
+	// Minimal id of schema-less dynamic fields, which are stored as JSON in binlogs
+	DynamicFieldIDBase int64
+
+	// Comma separated collectionID/fieldID:keyID of the fields whose insert binlogs are encrypted with the column key
+	ColumnEncryptionFieldKeys string
+	// Comma separated keyID:base64 encoded AES-256 key of the column keys
+	ColumnEncryptionKeys string
+
+	// Percentage of the max rows of a segment, when a segment is filled beyond it the next segment of the partition
+	// is allocated from DataCoord in background, 0 means disabled
+	SegmentPreCreateThreshold int64
+	// Seconds a segment allocated ahead of time is kept, it's returned to DataCoord if not used in time
+	SegmentPreCreateTTL int64
+
+	// OTLP/HTTP endpoint to export trace spans, jaeger configured by env is used if empty
+	OTLPEndpoint string
+
+	// Lease in seconds of growing segments granted by DataCoord, segments are synced within half of it to renew the lease
+	SegmentLeaseDuration int64
+
+	// Maximum size in MB of a segment configured for DataCoord, insert buffers exceeding it are split into multiple segments
+	SegmentMaxSize float64
+
+	// Interval in seconds to poll the collection schema from RootCoord and reload it once changed, 0 means never reload
+	SchemaWatchIntervalSeconds int64
+
+	// SaveBinlogPaths of a segment stops after consecutive failures reaching it, and the segment is reported as errored,
+	// 0 means retry until the vchannel is released
+	FlushCircuitBreakerThreshold int64
+
+	// Seconds after which the flushes held of a segment stopped by the flush circuit breaker are tried again
+	FlushCircuitBreakerCooldownSeconds int64
+
+	// SaveBinlogPaths of all vchannels stop and ingestion pauses after consecutive calls failing to reach DataCoord
+	// within the window reach it, 0 means no DataCoord circuit breaker
+	DataCoordCircuitBreakerThreshold int64
+	// Seconds within which failures of SaveBinlogPaths are counted by the DataCoord circuit breaker
+	DataCoordCircuitBreakerWindowSeconds int64
+	// Seconds after which SaveBinlogPaths stopped by the DataCoord circuit breaker is tried again
+	DataCoordCircuitBreakerCooldownSeconds int64
+
+	// Format of insert binlogs written by flush and compaction, storage.BinlogFormatCustom or storage.BinlogFormatArrowIPC,
+	// binlogs of both formats are readable regardless of it
+	BinlogFormat string
+
+	// Codec insert binlogs are compressed with by flush, storage.BinlogCompressionNone, storage.BinlogCompressionLZ4
+	// or storage.BinlogCompressionZstd, binlogs compressed are readable regardless of it
+	BinlogCompressionCodec string
+
+	// Milliseconds a flush task may stay at the head of its segment flush queue before a warning of the tasks blocked
+	// behind it is logged, 0 means never warn
+	FlushHeadOfLineWarnThresholdMs int64
+
+	// Prefix of the temporary paths insert binlogs are written to before being copied to their final paths,
+	// so that a binlog object never appears partially written at its final path. Empty means written directly
+	BinlogTempPathPrefix string
+
+	// Whether to read insert binlogs back after saved and validate their CRC32C, binlogs mismatched are removed
+	// and the flush is retried
+	EnableBinlogChecksumValidation bool
+
+	// Milliseconds, the insert buffer size is adjusted to keep the p99 flush latency around it, 0 means the buffer size
+	// is always FlushInsertBufferSize. The adjusted size is clamped to [MinFlushSize, MaxFlushSize] bytes
+	TargetFlushLatencyMs int64
+	MinFlushSize         int64
+	MaxFlushSize         int64
+
+	// Interval in seconds to persist the flow graph checkpoint, the minimum position acknowledged by all nodes of
+	// the flow graph with no unsaved data behind, vchannels recover from it instead of the segment DML positions.
+	// 0 means disabled
+	FlowGraphCheckpointIntervalSeconds int64
+	// Path of the local RocksDB the flow graph checkpoints are persisted to
+	FlowGraphCheckpointPath string
+
+	// Seconds to wait for a vchannel to start when multiple vchannels are assigned at once, vchannels not started
+	// in time are reported to DataCoord and keep starting in background
+	ChannelRecoveryTimeout int64
+
 	// Channel Name
 	DmlChannelName   string
 	DeltaChannelName string
@@ -53,6 +200,9 @@ type ParamTable struct {
 	// Pulsar address
 	PulsarAddress string
 
+	// Subscription type of consumers
+	PulsarSubscriptionType mqclient.SubscriptionType
+
 	// Rocksmq path
 	RocksmqPath string
 
@@ -65,6 +215,12 @@ type ParamTable struct {
 	// Timetick channel
 	TimeTickChannelName string
 
+	// Durability ack channel
+	DurabilityAckChannelName string
+
+	// Channel of insert rows violating schema constraints
+	InsertErrorTopic string
+
 	// Channel subscribition name -
 	MsgChannelSubName string
 
@@ -107,18 +263,71 @@ func (p *ParamTable) Init() {
 
 	p.initFlowGraphMaxQueueLength()
 	p.initFlowGraphMaxParallelism()
+	p.initReorderBufferSize()
+	p.initReorderMaxDelayMs()
+	p.initPulsarHeartbeatTimeoutMs()
+	p.initRecoveryMaxMessagesPerSecond()
+	p.initRecoveryCatchupGapMs()
 	p.initFlushInsertBufferSize()
 	p.initInsertBinlogRootPath()
 	p.initStatsBinlogRootPath()
+	p.initSketchBinlogRootPath()
 	p.initDeleteBinlogRootPath()
+	p.initPendingDeltaLogRootPath()
+	p.initMaxSaveBinlogRatePerSec()
+	p.initSaveBinlogBurstSize()
+	p.initMaxBlobStorageBandwidthBytesPerSec()
+	p.initBufferDataPoolPreallocSize()
+	p.initFlushUploadConcurrency()
+	p.initFlushPipelineDepth()
+	p.initMaxPendingFlushTasks()
+	p.initFlushBackpressureTimeoutMs()
+	p.initFlushAllTimeoutSeconds()
+	p.initFlushInjectTimeoutSeconds()
+	p.initMaxDeltaLogFileSizeBytes()
+	p.initDeltaLogMergeThreshold()
+	p.initDeleteTransactionTimeoutMs()
+	p.initEnableDeleteDeduplication()
+	p.initMemPressureCheckIntervalMs()
+	p.initMemPressureHighWatermark()
+	p.initEnableDurabilityAck()
+	p.initEnableInsertValidation()
+	p.initDynamicFieldIDBase()
+	p.initColumnEncryptionFieldKeys()
+	p.initColumnEncryptionKeys()
+	p.initSegmentPreCreateThreshold()
+	p.initSegmentPreCreateTTL()
+	p.initOTLPEndpoint()
+	p.initSegmentLeaseDuration()
+	p.initSegmentMaxSize()
+	p.initSchemaWatchIntervalSeconds()
+	p.initFlushCircuitBreakerThreshold()
+	p.initFlushCircuitBreakerCooldownSeconds()
+	p.initDataCoordCircuitBreakerThreshold()
+	p.initDataCoordCircuitBreakerWindowSeconds()
+	p.initDataCoordCircuitBreakerCooldownSeconds()
+	p.initBinlogFormat()
+	p.initBinlogCompressionCodec()
+	p.initFlushHeadOfLineWarnThresholdMs()
+	p.initBinlogTempPathPrefix()
+	p.initEnableBinlogChecksumValidation()
+	p.initTargetFlushLatencyMs()
+	p.initMinFlushSize()
+	p.initMaxFlushSize()
+	p.initFlowGraphCheckpointIntervalSeconds()
+	p.initFlowGraphCheckpointPath()
+	p.initChannelRecoveryTimeout()
 
 	p.initPulsarAddress()
+	p.initPulsarSubscriptionType()
 	p.initRocksmqPath()
 
 	// Must init global msgchannel prefix before other channel names
 	p.initClusterMsgChannelPrefix()
 	p.initSegmentStatisticsChannelName()
 	p.initTimeTickChannelName()
+	p.initDurabilityAckChannelName()
+	p.initInsertErrorTopic()
 
 	p.initEtcdEndpoints()
 	p.initMetaRootPath()
@@ -144,10 +353,211 @@ func (p *ParamTable) initFlowGraphMaxParallelism() {
 	p.FlowGraphMaxParallelism = p.ParseInt32WithDefault("dataNode.dataSync.flowGraph.maxParallelism", 1024)
 }
 
+func (p *ParamTable) initReorderBufferSize() {
+	p.ReorderBufferSize = p.ParseIntWithDefault("dataNode.dataSync.reorder.bufferSize", 0)
+}
+
+func (p *ParamTable) initReorderMaxDelayMs() {
+	p.ReorderMaxDelayMs = p.ParseInt64WithDefault("dataNode.dataSync.reorder.maxDelayMs", 100)
+}
+
+func (p *ParamTable) initPulsarHeartbeatTimeoutMs() {
+	p.PulsarHeartbeatTimeoutMs = p.ParseInt64WithDefault("dataNode.dataSync.pulsar.heartbeatTimeoutMs", 0)
+}
+
+func (p *ParamTable) initRecoveryMaxMessagesPerSecond() {
+	p.RecoveryMaxMessagesPerSecond = p.ParseFloatWithDefault("dataNode.dataSync.recovery.maxMessagesPerSecond", 0)
+}
+
+func (p *ParamTable) initRecoveryCatchupGapMs() {
+	p.RecoveryCatchupGapMs = p.ParseInt64WithDefault("dataNode.dataSync.recovery.catchupGapMs", 1000)
+}
+
 func (p *ParamTable) initFlushInsertBufferSize() {
 	p.FlushInsertBufferSize = p.ParseInt64("_DATANODE_INSERTBUFSIZE")
 }
 
+func (p *ParamTable) initMaxSaveBinlogRatePerSec() {
+	p.MaxSaveBinlogRatePerSec = p.ParseFloatWithDefault("dataNode.flush.maxSaveBinlogRatePerSec", 100)
+}
+
+func (p *ParamTable) initSaveBinlogBurstSize() {
+	p.SaveBinlogBurstSize = p.ParseIntWithDefault("dataNode.flush.saveBinlogBurstSize", 100)
+}
+
+func (p *ParamTable) initMaxBlobStorageBandwidthBytesPerSec() {
+	p.MaxBlobStorageBandwidthBytesPerSec = p.ParseFloatWithDefault("dataNode.io.maxBlobStorageBandwidthBytesPerSec", 0)
+}
+
+func (p *ParamTable) initBufferDataPoolPreallocSize() {
+	p.BufferDataPoolPreallocSize = p.ParseIntWithDefault("dataNode.flush.bufferDataPoolPreallocSize", 32)
+}
+
+func (p *ParamTable) initFlushUploadConcurrency() {
+	p.FlushUploadConcurrency = p.ParseIntWithDefault("dataNode.flush.uploadConcurrency", 16)
+}
+
+func (p *ParamTable) initFlushPipelineDepth() {
+	p.FlushPipelineDepth = p.ParseIntWithDefault("dataNode.flush.pipelineDepth", 0)
+}
+
+func (p *ParamTable) initMaxPendingFlushTasks() {
+	p.MaxPendingFlushTasks = p.ParseIntWithDefault("dataNode.flush.maxPendingTasks", 128)
+}
+
+func (p *ParamTable) initFlushBackpressureTimeoutMs() {
+	p.FlushBackpressureTimeoutMs = p.ParseInt64WithDefault("dataNode.flush.backpressureTimeout", 0)
+}
+
+func (p *ParamTable) initFlushAllTimeoutSeconds() {
+	p.FlushAllTimeoutSeconds = p.ParseInt64WithDefault("dataNode.flush.flushAllTimeout", 60)
+}
+
+func (p *ParamTable) initFlushInjectTimeoutSeconds() {
+	p.FlushInjectTimeoutSeconds = p.ParseInt64WithDefault("dataNode.flush.injectTimeout", 300)
+}
+
+func (p *ParamTable) initMaxDeltaLogFileSizeBytes() {
+	p.MaxDeltaLogFileSizeBytes = p.ParseInt64WithDefault("dataNode.flush.maxDeltaLogFileSize", 16777216)
+}
+
+func (p *ParamTable) initDeltaLogMergeThreshold() {
+	p.DeltaLogMergeThreshold = p.ParseIntWithDefault("dataNode.flush.deltaLogMergeThreshold", 0)
+}
+
+func (p *ParamTable) initDeleteTransactionTimeoutMs() {
+	p.DeleteTransactionTimeoutMs = p.ParseInt64WithDefault("dataNode.delete.transactionTimeoutMs", 10000)
+}
+
+func (p *ParamTable) initEnableDeleteDeduplication() {
+	p.EnableDeleteDeduplication = p.ParseBool("dataNode.delete.deduplication", true)
+}
+
+func (p *ParamTable) initMemPressureCheckIntervalMs() {
+	p.MemPressureCheckIntervalMs = p.ParseInt64WithDefault("dataNode.memPressure.checkIntervalMs", 1000)
+}
+
+func (p *ParamTable) initMemPressureHighWatermark() {
+	p.MemPressureHighWatermark = p.ParseInt64WithDefault("dataNode.memPressure.highWatermark", 0)
+}
+
+func (p *ParamTable) initEnableDurabilityAck() {
+	p.EnableDurabilityAck = p.ParseBool("dataNode.durabilityAck.enabled", false)
+}
+
+func (p *ParamTable) initEnableInsertValidation() {
+	p.EnableInsertValidation = p.ParseBool("dataNode.insertValidation.enabled", false)
+}
+
+func (p *ParamTable) initDynamicFieldIDBase() {
+	p.DynamicFieldIDBase = p.ParseInt64WithDefault("dataNode.dynamicField.idBase", 65536)
+}
+
+func (p *ParamTable) initColumnEncryptionFieldKeys() {
+	p.ColumnEncryptionFieldKeys = p.LoadWithDefault("encryption.fieldKeys", "")
+}
+
+func (p *ParamTable) initColumnEncryptionKeys() {
+	p.ColumnEncryptionKeys = p.LoadWithDefault("encryption.keys", "")
+}
+
+func (p *ParamTable) initSegmentPreCreateThreshold() {
+	p.SegmentPreCreateThreshold = p.ParseInt64WithDefault("dataNode.segment.preCreateThreshold", 0)
+}
+
+func (p *ParamTable) initSegmentPreCreateTTL() {
+	p.SegmentPreCreateTTL = p.ParseInt64WithDefault("dataNode.segment.preCreateTTL", 60)
+}
+
+func (p *ParamTable) initOTLPEndpoint() {
+	p.OTLPEndpoint = p.LoadWithDefault("dataNode.trace.otlpEndpoint", "")
+}
+
+// initSegmentLeaseDuration loads the lease configured for DataCoord, so that both sides agree on it
+func (p *ParamTable) initSegmentLeaseDuration() {
+	p.SegmentLeaseDuration = p.ParseInt64WithDefault("dataCoord.segment.leaseDuration", 0)
+}
+
+func (p *ParamTable) initSegmentMaxSize() {
+	p.SegmentMaxSize = p.ParseFloatWithDefault("dataCoord.segment.maxSize", 512.0)
+}
+
+func (p *ParamTable) initSchemaWatchIntervalSeconds() {
+	p.SchemaWatchIntervalSeconds = p.ParseInt64WithDefault("dataNode.schemaWatch.intervalSeconds", 10)
+}
+
+func (p *ParamTable) initFlushCircuitBreakerThreshold() {
+	p.FlushCircuitBreakerThreshold = p.ParseInt64WithDefault("dataNode.flush.circuitBreaker.threshold", 5)
+}
+
+func (p *ParamTable) initFlushCircuitBreakerCooldownSeconds() {
+	p.FlushCircuitBreakerCooldownSeconds = p.ParseInt64WithDefault("dataNode.flush.circuitBreaker.cooldown", 60)
+}
+
+func (p *ParamTable) initDataCoordCircuitBreakerThreshold() {
+	p.DataCoordCircuitBreakerThreshold = p.ParseInt64WithDefault("dataNode.flush.dataCoordCircuitBreaker.threshold", 3)
+}
+
+func (p *ParamTable) initDataCoordCircuitBreakerWindowSeconds() {
+	p.DataCoordCircuitBreakerWindowSeconds = p.ParseInt64WithDefault("dataNode.flush.dataCoordCircuitBreaker.window", 30)
+}
+
+func (p *ParamTable) initDataCoordCircuitBreakerCooldownSeconds() {
+	p.DataCoordCircuitBreakerCooldownSeconds = p.ParseInt64WithDefault("dataNode.flush.dataCoordCircuitBreaker.cooldown", 10)
+}
+
+func (p *ParamTable) initBinlogFormat() {
+	format := p.LoadWithDefault("dataNode.flush.binlogFormat", storage.BinlogFormatCustom)
+	if format != storage.BinlogFormatCustom && format != storage.BinlogFormatArrowIPC {
+		panic(fmt.Sprintf("invalid binlog format %s", format))
+	}
+	p.BinlogFormat = format
+}
+
+func (p *ParamTable) initBinlogCompressionCodec() {
+	codec := p.LoadWithDefault("dataNode.flush.binlogCompression", storage.BinlogCompressionNone)
+	if err := storage.ValidateBinlogCompression(codec); err != nil {
+		panic(err)
+	}
+	p.BinlogCompressionCodec = codec
+}
+
+func (p *ParamTable) initFlushHeadOfLineWarnThresholdMs() {
+	p.FlushHeadOfLineWarnThresholdMs = p.ParseInt64WithDefault("dataNode.flush.headOfLineWarnThreshold", 10000)
+}
+
+func (p *ParamTable) initBinlogTempPathPrefix() {
+	p.BinlogTempPathPrefix = p.LoadWithDefault("dataNode.flush.binlogTempPathPrefix", "")
+}
+
+func (p *ParamTable) initEnableBinlogChecksumValidation() {
+	p.EnableBinlogChecksumValidation = p.ParseBool("dataNode.flush.binlogChecksumValidation", true)
+}
+
+func (p *ParamTable) initTargetFlushLatencyMs() {
+	p.TargetFlushLatencyMs = p.ParseInt64WithDefault("dataNode.flush.dynamicPolicy.targetLatency", 0)
+}
+
+func (p *ParamTable) initMinFlushSize() {
+	p.MinFlushSize = p.ParseInt64WithDefault("dataNode.flush.dynamicPolicy.minSize", 4194304)
+}
+
+func (p *ParamTable) initMaxFlushSize() {
+	p.MaxFlushSize = p.ParseInt64WithDefault("dataNode.flush.dynamicPolicy.maxSize", 67108864)
+}
+
+func (p *ParamTable) initFlowGraphCheckpointIntervalSeconds() {
+	p.FlowGraphCheckpointIntervalSeconds = p.ParseInt64WithDefault("dataNode.dataSync.checkpoint.interval", 0)
+}
+
+func (p *ParamTable) initFlowGraphCheckpointPath() {
+	p.FlowGraphCheckpointPath = p.LoadWithDefault("dataNode.dataSync.checkpoint.path", "/var/lib/milvus/datanode_checkpoint")
+}
+
+func (p *ParamTable) initChannelRecoveryTimeout() {
+	p.ChannelRecoveryTimeout = p.ParseInt64WithDefault("dataNode.dataSync.recoveryTimeout", 60)
+}
+
 func (p *ParamTable) initInsertBinlogRootPath() {
 	// GOOSE TODO: rootPath change to  TenentID
 	rootPath, err := p.Load("minio.rootPath")
@@ -165,6 +575,14 @@ func (p *ParamTable) initStatsBinlogRootPath() {
 	p.StatsBinlogRootPath = path.Join(rootPath, "stats_log")
 }
 
+func (p *ParamTable) initSketchBinlogRootPath() {
+	rootPath, err := p.Load("minio.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.SketchBinlogRootPath = path.Join(rootPath, "sketch_log")
+}
+
 func (p *ParamTable) initDeleteBinlogRootPath() {
 	rootPath, err := p.Load("minio.rootPath")
 	if err != nil {
@@ -173,6 +591,14 @@ func (p *ParamTable) initDeleteBinlogRootPath() {
 	p.DeleteBinlogRootPath = path.Join(rootPath, "delta_log")
 }
 
+func (p *ParamTable) initPendingDeltaLogRootPath() {
+	rootPath, err := p.Load("minio.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.PendingDeltaLogRootPath = path.Join(rootPath, "pending_delta_log")
+}
+
 func (p *ParamTable) initPulsarAddress() {
 	url, err := p.Load("_PulsarAddress")
 	if err != nil {
@@ -181,6 +607,21 @@ func (p *ParamTable) initPulsarAddress() {
 	p.PulsarAddress = url
 }
 
+func (p *ParamTable) initPulsarSubscriptionType() {
+	subscriptionTypes := map[string]mqclient.SubscriptionType{
+		"Exclusive": mqclient.Exclusive,
+		"Shared":    mqclient.Shared,
+		"Failover":  mqclient.Failover,
+		"KeyShared": mqclient.KeyShared,
+	}
+	config := p.LoadWithDefault("dataNode.pulsar.subscriptionType", "KeyShared")
+	subscriptionType, ok := subscriptionTypes[config]
+	if !ok {
+		panic(fmt.Sprintf("invalid pulsar subscription type %s", config))
+	}
+	p.PulsarSubscriptionType = subscriptionType
+}
+
 func (p *ParamTable) initRocksmqPath() {
 	path, err := p.Load("_RocksmqPath")
 	if err != nil {
@@ -215,6 +656,24 @@ func (p *ParamTable) initTimeTickChannelName() {
 	p.TimeTickChannelName = strings.Join(s, "-")
 }
 
+func (p *ParamTable) initDurabilityAckChannelName() {
+	config, err := p.Load("msgChannel.chanNamePrefix.dataNodeDurabilityAck")
+	if err != nil {
+		panic(err)
+	}
+	s := []string{p.ClusterChannelPrefix, config}
+	p.DurabilityAckChannelName = strings.Join(s, "-")
+}
+
+func (p *ParamTable) initInsertErrorTopic() {
+	config, err := p.Load("msgChannel.chanNamePrefix.dataNodeInsertError")
+	if err != nil {
+		panic(err)
+	}
+	s := []string{p.ClusterChannelPrefix, config}
+	p.InsertErrorTopic = strings.Join(s, "-")
+}
+
 func (p *ParamTable) initMsgChannelSubName() {
 	config, err := p.Load("msgChannel.subNamePrefix.dataNodeSubNamePrefix")
 	if err != nil {
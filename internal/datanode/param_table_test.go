@@ -22,6 +22,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,6 +57,162 @@ func TestParamTable(t *testing.T) {
 		log.Println("FlushInsertBufferSize:", size)
 	})
 
+	t.Run("Test SaveBinlog rate limit", func(t *testing.T) {
+		assert.EqualValues(t, 100, Params.MaxSaveBinlogRatePerSec)
+		assert.Equal(t, 100, Params.SaveBinlogBurstSize)
+	})
+
+	t.Run("Test MaxBlobStorageBandwidthBytesPerSec", func(t *testing.T) {
+		assert.EqualValues(t, 0, Params.MaxBlobStorageBandwidthBytesPerSec)
+	})
+
+	t.Run("Test BufferDataPoolPreallocSize", func(t *testing.T) {
+		assert.Equal(t, 32, Params.BufferDataPoolPreallocSize)
+	})
+
+	t.Run("Test FlushUploadConcurrency", func(t *testing.T) {
+		assert.Equal(t, 16, Params.FlushUploadConcurrency)
+	})
+
+	t.Run("Test ReorderBuffer", func(t *testing.T) {
+		assert.Equal(t, 0, Params.ReorderBufferSize)
+		assert.Equal(t, int64(100), Params.ReorderMaxDelayMs)
+	})
+
+	t.Run("Test PulsarHeartbeatTimeoutMs", func(t *testing.T) {
+		assert.Equal(t, int64(0), Params.PulsarHeartbeatTimeoutMs)
+	})
+
+	t.Run("Test Recovery", func(t *testing.T) {
+		assert.Equal(t, float64(0), Params.RecoveryMaxMessagesPerSecond)
+		assert.Equal(t, int64(1000), Params.RecoveryCatchupGapMs)
+	})
+
+	t.Run("Test FlushPipelineDepth", func(t *testing.T) {
+		assert.Equal(t, 0, Params.FlushPipelineDepth)
+	})
+
+	t.Run("Test MaxPendingFlushTasks", func(t *testing.T) {
+		assert.Equal(t, 128, Params.MaxPendingFlushTasks)
+		assert.Equal(t, int64(0), Params.FlushBackpressureTimeoutMs)
+	})
+
+	t.Run("Test FlushAllTimeoutSeconds", func(t *testing.T) {
+		assert.Equal(t, int64(60), Params.FlushAllTimeoutSeconds)
+	})
+
+	t.Run("Test FlushInjectTimeoutSeconds", func(t *testing.T) {
+		assert.Equal(t, int64(300), Params.FlushInjectTimeoutSeconds)
+	})
+
+	t.Run("Test MaxDeltaLogFileSizeBytes", func(t *testing.T) {
+		assert.Equal(t, int64(16777216), Params.MaxDeltaLogFileSizeBytes)
+	})
+
+	t.Run("Test DeltaLogMergeThreshold", func(t *testing.T) {
+		assert.Equal(t, 0, Params.DeltaLogMergeThreshold)
+	})
+
+	t.Run("Test DeleteTransactionTimeoutMs", func(t *testing.T) {
+		assert.Equal(t, int64(10000), Params.DeleteTransactionTimeoutMs)
+	})
+
+	t.Run("Test EnableDeleteDeduplication", func(t *testing.T) {
+		assert.True(t, Params.EnableDeleteDeduplication)
+	})
+
+	t.Run("Test MemPressureCheckIntervalMs", func(t *testing.T) {
+		assert.Equal(t, int64(1000), Params.MemPressureCheckIntervalMs)
+	})
+
+	t.Run("Test MemPressureHighWatermark", func(t *testing.T) {
+		assert.Equal(t, int64(0), Params.MemPressureHighWatermark)
+	})
+
+	t.Run("Test EnableDurabilityAck", func(t *testing.T) {
+		assert.False(t, Params.EnableDurabilityAck)
+	})
+
+	t.Run("Test EnableInsertValidation", func(t *testing.T) {
+		assert.False(t, Params.EnableInsertValidation)
+	})
+
+	t.Run("Test DynamicFieldIDBase", func(t *testing.T) {
+		assert.Equal(t, int64(65536), Params.DynamicFieldIDBase)
+	})
+
+	t.Run("Test ColumnEncryption", func(t *testing.T) {
+		assert.Equal(t, "", Params.ColumnEncryptionFieldKeys)
+		assert.Equal(t, "", Params.ColumnEncryptionKeys)
+	})
+
+	t.Run("Test SegmentPreCreate", func(t *testing.T) {
+		assert.Equal(t, int64(0), Params.SegmentPreCreateThreshold)
+		assert.Equal(t, int64(60), Params.SegmentPreCreateTTL)
+	})
+
+	t.Run("Test OTLPEndpoint", func(t *testing.T) {
+		assert.Equal(t, "", Params.OTLPEndpoint)
+	})
+
+	t.Run("Test SegmentLeaseDuration", func(t *testing.T) {
+		assert.Equal(t, int64(0), Params.SegmentLeaseDuration)
+	})
+
+	t.Run("Test SegmentMaxSize", func(t *testing.T) {
+		assert.Equal(t, 512.0, Params.SegmentMaxSize)
+	})
+
+	t.Run("Test SchemaWatchIntervalSeconds", func(t *testing.T) {
+		assert.EqualValues(t, 10, Params.SchemaWatchIntervalSeconds)
+	})
+
+	t.Run("Test FlushCircuitBreaker", func(t *testing.T) {
+		assert.EqualValues(t, 5, Params.FlushCircuitBreakerThreshold)
+		assert.EqualValues(t, 60, Params.FlushCircuitBreakerCooldownSeconds)
+	})
+
+	t.Run("Test DataCoordCircuitBreaker", func(t *testing.T) {
+		assert.EqualValues(t, 3, Params.DataCoordCircuitBreakerThreshold)
+		assert.EqualValues(t, 30, Params.DataCoordCircuitBreakerWindowSeconds)
+		assert.EqualValues(t, 10, Params.DataCoordCircuitBreakerCooldownSeconds)
+	})
+
+	t.Run("Test BinlogFormat", func(t *testing.T) {
+		assert.Equal(t, storage.BinlogFormatCustom, Params.BinlogFormat)
+	})
+
+	t.Run("Test BinlogCompressionCodec", func(t *testing.T) {
+		assert.Equal(t, storage.BinlogCompressionNone, Params.BinlogCompressionCodec)
+	})
+
+	t.Run("Test FlushHeadOfLineWarnThresholdMs", func(t *testing.T) {
+		assert.EqualValues(t, 10000, Params.FlushHeadOfLineWarnThresholdMs)
+	})
+
+	t.Run("Test BinlogTempPathPrefix", func(t *testing.T) {
+		assert.Equal(t, "", Params.BinlogTempPathPrefix)
+	})
+
+	t.Run("Test EnableBinlogChecksumValidation", func(t *testing.T) {
+		assert.True(t, Params.EnableBinlogChecksumValidation)
+	})
+
+	t.Run("Test DynamicFlushPolicy", func(t *testing.T) {
+		assert.EqualValues(t, 0, Params.TargetFlushLatencyMs)
+		assert.EqualValues(t, 4194304, Params.MinFlushSize)
+		assert.EqualValues(t, 67108864, Params.MaxFlushSize)
+	})
+
+	t.Run("Test FlowGraphCheckpoint", func(t *testing.T) {
+		assert.EqualValues(t, 0, Params.FlowGraphCheckpointIntervalSeconds)
+		assert.Equal(t, "/var/lib/milvus/datanode_checkpoint", Params.FlowGraphCheckpointPath)
+	})
+
+	t.Run("Test ChannelRecoveryTimeout", func(t *testing.T) {
+		assert.EqualValues(t, 60, Params.ChannelRecoveryTimeout)
+	})
+
 	t.Run("Test InsertBinlogRootPath", func(t *testing.T) {
 		path := Params.InsertBinlogRootPath
 		log.Println("InsertBinlogRootPath:", path)
@@ -65,6 +223,10 @@ func TestParamTable(t *testing.T) {
 		log.Println("PulsarAddress:", address)
 	})
 
+	t.Run("Test PulsarSubscriptionType", func(t *testing.T) {
+		assert.Equal(t, mqclient.KeyShared, Params.PulsarSubscriptionType)
+	})
+
 	t.Run("Test ClusterChannelPrefix", func(t *testing.T) {
 		path := Params.ClusterChannelPrefix
 		assert.Equal(t, path, "by-dev")
@@ -83,6 +245,18 @@ func TestParamTable(t *testing.T) {
 		log.Println("TimeTickChannelName:", name)
 	})
 
+	t.Run("Test DurabilityAckChannelName", func(t *testing.T) {
+		name := Params.DurabilityAckChannelName
+		assert.Equal(t, name, "by-dev-datanode-durability-ack")
+		log.Println("DurabilityAckChannelName:", name)
+	})
+
+	t.Run("Test InsertErrorTopic", func(t *testing.T) {
+		name := Params.InsertErrorTopic
+		assert.Equal(t, name, "by-dev-datanode-insert-error")
+		log.Println("InsertErrorTopic:", name)
+	})
+
 	t.Run("Test msgChannelSubName", func(t *testing.T) {
 		name := Params.MsgChannelSubName
 		assert.Equal(t, name, "by-dev-dataNode-2")
@@ -139,4 +313,10 @@ func TestParamTable(t *testing.T) {
 		p.Init()
 		assert.Equal(t, path.Join("files", "stats_log"), Params.StatsBinlogRootPath)
 	})
+
+	t.Run("Test SketchBinlogRootPath", func(t *testing.T) {
+		p := new(ParamTable)
+		p.Init()
+		assert.Equal(t, path.Join("files", "sketch_log"), Params.SketchBinlogRootPath)
+	})
 }
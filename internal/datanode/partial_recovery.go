@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"go.uber.org/zap"
+)
+
+// incompleteBinlogFields returns the fields of the schema with fewer binlogs than other fields of the segment,
+// which are left by a crash in the middle of a flush. nil is returned if the segment has no binlogs.
+// The schema is the one the segment is created with, fields added later have binlogs of later flushes only
+func incompleteBinlogFields(schema *schemapb.CollectionSchema, binlogs []*datapb.FieldBinlog) []UniqueID {
+	counts := make(map[UniqueID]int)
+	maxCount := 0
+	for _, binlog := range binlogs {
+		counts[binlog.GetFieldID()] += len(binlog.GetBinlogs())
+		if counts[binlog.GetFieldID()] > maxCount {
+			maxCount = counts[binlog.GetFieldID()]
+		}
+	}
+	if maxCount == 0 {
+		return nil
+	}
+
+	var incomplete []UniqueID
+	for _, field := range schema.GetFields() {
+		if counts[field.GetFieldID()] < maxCount {
+			incomplete = append(incomplete, field.GetFieldID())
+		}
+	}
+	return incomplete
+}
+
+// rewindPartialSegments rewinds the checkpoints of unflushed segments with incomplete binlogs to their start positions,
+// so that the data of the segments is replayed from the message stream, and their binlogs are rebuilt by the next flush.
+// It returns the vchannel info to recover with, and marks the segments rewound in rebuiltSegments
+func (dsService *dataSyncService) rewindPartialSegments(vchanInfo *datapb.VchannelInfo) (*datapb.VchannelInfo, error) {
+	var recovered *datapb.VchannelInfo
+	for i, us := range vchanInfo.GetUnflushedSegments() {
+		if len(us.GetBinlogs()) == 0 {
+			continue
+		}
+		if us.GetStartPosition() == nil {
+			log.Warn("binlogs of segment are not checked, since it cannot be replayed without start position",
+				zap.Int64("segmentID", us.GetID()))
+			continue
+		}
+		schema, err := dsService.replica.getCollectionSchemaAt(dsService.collectionID, us.GetStartPosition().GetTimestamp())
+		if err != nil {
+			return nil, err
+		}
+		incomplete := incompleteBinlogFields(schema, us.GetBinlogs())
+		if len(incomplete) == 0 {
+			continue
+		}
+
+		if recovered == nil {
+			recovered = proto.Clone(vchanInfo).(*datapb.VchannelInfo)
+		}
+		segment := recovered.GetUnflushedSegments()[i]
+		log.Warn("binlogs of segment are incomplete, replay the segment from its start position",
+			zap.Int64("segmentID", segment.GetID()), zap.Int64s("incompleteFields", incomplete),
+			zap.Uint64("checkpoint", segment.GetDmlPosition().GetTimestamp()),
+			zap.Uint64("startPosition", segment.GetStartPosition().GetTimestamp()))
+		segment.DmlPosition = proto.Clone(segment.GetStartPosition()).(*internalpb.MsgPosition)
+		segment.NumOfRows = 0
+		if seek := recovered.GetSeekPosition(); seek == nil || segment.GetStartPosition().GetTimestamp() < seek.GetTimestamp() {
+			recovered.SeekPosition = proto.Clone(segment.GetStartPosition()).(*internalpb.MsgPosition)
+		}
+		dsService.rebuiltSegments.Store(segment.GetID(), segment.GetStartPosition())
+		metrics.DataNodePartialRecoveryCounter.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).Inc()
+	}
+
+	if recovered == nil {
+		return vchanInfo, nil
+	}
+	return recovered, nil
+}
+
+// rebuiltBefore returns a segment to rebuild whose start position is before pos, from where it cannot be replayed
+func (dsService *dataSyncService) rebuiltBefore(pos *internalpb.MsgPosition) (UniqueID, bool) {
+	segmentID, found := UniqueID(0), false
+	dsService.rebuiltSegments.Range(func(key, value interface{}) bool {
+		if pos == nil || value.(*internalpb.MsgPosition).GetTimestamp() < pos.GetTimestamp() {
+			segmentID, found = key.(UniqueID), true
+			return false
+		}
+		return true
+	})
+	return segmentID, found
+}
+
+// confirmRebuiltSegments confirms the flowgraph seeks to the start positions of the segments to rebuild, otherwise the
+// segments are not fully replayed, and the next flushes of them append binlogs instead of replacing the recorded ones
+func (dsService *dataSyncService) confirmRebuiltSegments(seekPos *internalpb.MsgPosition) {
+	for {
+		segmentID, ok := dsService.rebuiltBefore(seekPos)
+		if !ok {
+			return
+		}
+		log.Warn("segment is not replayed from its start position, its binlogs are not replaced",
+			zap.Int64("segmentID", segmentID), zap.Uint64("seekPosition", seekPos.GetTimestamp()))
+		dsService.rebuiltSegments.Delete(segmentID)
+	}
+}
@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// genFieldBinlogs generates a binlog per flush for each field of the schema, except the fields skipped in the last flush
+func genFieldBinlogs(schema *schemapb.CollectionSchema, segmentID UniqueID, flushes int, skipped ...UniqueID) []*datapb.FieldBinlog {
+	skip := make(map[UniqueID]bool)
+	for _, fieldID := range skipped {
+		skip[fieldID] = true
+	}
+	var binlogs []*datapb.FieldBinlog
+	for _, field := range schema.GetFields() {
+		binlog := &datapb.FieldBinlog{FieldID: field.GetFieldID()}
+		for i := 0; i < flushes; i++ {
+			if i == flushes-1 && skip[field.GetFieldID()] {
+				continue
+			}
+			binlog.Binlogs = append(binlog.Binlogs, "insert_log/"+strconv.FormatInt(segmentID, 10)+"/"+
+				strconv.FormatInt(field.GetFieldID(), 10)+"/"+strconv.Itoa(i))
+		}
+		if len(binlog.Binlogs) > 0 {
+			binlogs = append(binlogs, binlog)
+		}
+	}
+	return binlogs
+}
+
+func TestIncompleteBinlogFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 0}, {FieldID: 1}, {FieldID: 100}, {FieldID: 101}}}
+
+	assert.Nil(t, incompleteBinlogFields(schema, nil))
+	assert.Nil(t, incompleteBinlogFields(schema, genFieldBinlogs(schema, 1, 2)))
+	// crashed in the middle of the first flush
+	assert.Equal(t, []UniqueID{100, 101}, incompleteBinlogFields(schema, genFieldBinlogs(schema, 1, 1, 100, 101)))
+	// crashed in the middle of a later flush
+	assert.Equal(t, []UniqueID{101}, incompleteBinlogFields(schema, genFieldBinlogs(schema, 1, 3, 101)))
+
+	// field 102 added after the segment is created has binlogs of the last flush only
+	altered := proto.Clone(schema).(*schemapb.CollectionSchema)
+	altered.Fields = append(altered.Fields, &schemapb.FieldSchema{FieldID: 102})
+	binlogs := append(genFieldBinlogs(schema, 1, 2), &datapb.FieldBinlog{FieldID: 102, Binlogs: []string{"insert_log/1/102/1"}})
+	assert.Nil(t, incompleteBinlogFields(schema, binlogs))
+	assert.Equal(t, []UniqueID{102}, incompleteBinlogFields(altered, binlogs))
+}
+
+func TestDataSyncService_ConfirmRebuiltSegments(t *testing.T) {
+	ds := &dataSyncService{}
+	ds.rebuiltSegments.Store(UniqueID(1), &internalpb.MsgPosition{Timestamp: 100})
+	ds.rebuiltSegments.Store(UniqueID(2), &internalpb.MsgPosition{Timestamp: 200})
+
+	_, ok := ds.rebuiltBefore(&internalpb.MsgPosition{Timestamp: 100})
+	assert.False(t, ok)
+	segmentID, ok := ds.rebuiltBefore(&internalpb.MsgPosition{Timestamp: 150})
+	assert.True(t, ok)
+	assert.EqualValues(t, 1, segmentID)
+
+	// the seek position reaches the start position of segment 2 only
+	ds.confirmRebuiltSegments(&internalpb.MsgPosition{Timestamp: 200})
+	_, ok = ds.rebuiltSegments.Load(UniqueID(1))
+	assert.False(t, ok)
+	_, ok = ds.rebuiltSegments.Load(UniqueID(2))
+	assert.True(t, ok)
+
+	ds.confirmRebuiltSegments(nil)
+	_, ok = ds.rebuiltSegments.Load(UniqueID(2))
+	assert.False(t, ok)
+}
+
+func TestDataSyncService_PartialRecovery(t *testing.T) {
+	ctx := context.Background()
+	schema := (&MetaFactory{}).GetCollectionMeta(1, "test").GetSchema()
+	fields := schema.GetFields()
+	chanName := "by-dev-rootcoord-dml-test_v1"
+	label := strconv.FormatInt(Params.NodeID, 10)
+	recovered := testutil.ToFloat64(metrics.DataNodePartialRecoveryCounter.WithLabelValues(label))
+
+	// segment 1 crashed in the middle of its second flush, segment 2 is intact
+	vchan := &datapb.VchannelInfo{
+		CollectionID: 1,
+		ChannelName:  chanName,
+		SeekPosition: &internalpb.MsgPosition{ChannelName: chanName, Timestamp: 300},
+		UnflushedSegments: []*datapb.SegmentInfo{
+			{
+				ID: 1, CollectionID: 1, PartitionID: 1, InsertChannel: chanName, NumOfRows: 20,
+				StartPosition: &internalpb.MsgPosition{ChannelName: chanName, MsgID: []byte{1}, Timestamp: 100},
+				DmlPosition:   &internalpb.MsgPosition{ChannelName: chanName, MsgID: []byte{3}, Timestamp: 300},
+				Binlogs:       genFieldBinlogs(schema, 1, 2, fields[len(fields)-1].GetFieldID()),
+			},
+			{
+				ID: 2, CollectionID: 1, PartitionID: 1, InsertChannel: chanName, NumOfRows: 10,
+				StartPosition: &internalpb.MsgPosition{ChannelName: chanName, MsgID: []byte{2}, Timestamp: 200},
+				DmlPosition:   &internalpb.MsgPosition{ChannelName: chanName, MsgID: []byte{4}, Timestamp: 400},
+				Binlogs:       genFieldBinlogs(schema, 2, 1),
+			},
+		},
+	}
+
+	replica, err := newReplica(ctx, &RootCoordFactory{}, 1)
+	require.NoError(t, err)
+	dataCoord := &DataCoordFactory{}
+	ds, err := newDataSyncService(ctx, make(chan flushMsg), replica, NewAllocatorFactory(), &mockMsgStreamFactory{true, true},
+		vchan, make(chan UniqueID), make(chan *shutdownSignal), dataCoord, newCache(), memkv.NewMemoryKV())
+	require.NoError(t, err)
+	assert.Equal(t, recovered+1, testutil.ToFloat64(metrics.DataNodePartialRecoveryCounter.WithLabelValues(label)))
+
+	// segment 1 is replayed from its start position, and segment 2 from its checkpoint
+	checkpoints := replica.listSegmentsCheckPoints()
+	assert.EqualValues(t, 0, checkpoints[1].numRows)
+	assert.EqualValues(t, 100, checkpoints[1].pos.Timestamp)
+	assert.EqualValues(t, 10, checkpoints[2].numRows)
+	assert.EqualValues(t, 400, checkpoints[2].pos.Timestamp)
+	_, ok := ds.rebuiltSegments.Load(UniqueID(1))
+	assert.True(t, ok)
+	_, ok = ds.rebuiltSegments.Load(UniqueID(2))
+	assert.False(t, ok)
+	// vchannel info of DataCoord is not changed
+	assert.EqualValues(t, 300, vchan.GetSeekPosition().GetTimestamp())
+	assert.EqualValues(t, 300, vchan.GetUnflushedSegments()[0].GetDmlPosition().GetTimestamp())
+
+	// the first flush after replay replaces the binlogs of segment 1, the following ones append
+	notifyFunc := flushNotifyFunc(ds, retry.Attempts(1))
+	for i := 0; i < 2; i++ {
+		notifyFunc(&segmentFlushPack{
+			segmentID:  1,
+			insertLogs: map[UniqueID]string{100: "insert_log/1/100/rebuilt" + strconv.Itoa(i)},
+			pos:        &internalpb.MsgPosition{ChannelName: chanName, Timestamp: 500},
+		})
+	}
+	notifyFunc(&segmentFlushPack{segmentID: 2, pos: &internalpb.MsgPosition{ChannelName: chanName, Timestamp: 500}})
+	require.Equal(t, 3, len(dataCoord.SaveBinlogPathRequests))
+	assert.True(t, dataCoord.SaveBinlogPathRequests[0].GetRebuilt())
+	assert.False(t, dataCoord.SaveBinlogPathRequests[1].GetRebuilt())
+	assert.False(t, dataCoord.SaveBinlogPathRequests[2].GetRebuilt())
+}
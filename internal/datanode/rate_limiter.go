@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"go.uber.org/zap"
+)
+
+const (
+	blobIOTypeFlush      = "flush"
+	blobIOTypeCompaction = "compaction"
+)
+
+// blobIOLimiter limits the bytes per second written into blob storage by flush and compaction,
+// it is shared by all flowgraphs and compaction tasks of the DataNode, no limit if nil
+var blobIOLimiter *tokenBucket
+
+// tokenBucket is a token bucket rate limiter which blocks the caller until a token is available.
+// A non-positive rate means unlimited.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens refilled per second
+	burst  float64 // capacity of the bucket
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// refill adds the tokens generated since last refill, caller must hold the lock
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	b.last = now
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens += elapsed * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+// wait blocks until a token is taken or ctx is done
+func (b *tokenBucket) wait(ctx context.Context) error {
+	return b.waitN(ctx, 1)
+}
+
+// waitN blocks until n tokens are taken or ctx is done,
+// n larger than burst is taken once the bucket is full, leaving the bucket in debt
+func (b *tokenBucket) waitN(ctx context.Context, n float64) error {
+	if b.rate <= 0 {
+		return nil
+	}
+	need := math.Min(n, b.burst)
+	for {
+		b.mu.Lock()
+		b.refill(time.Now())
+		if b.tokens >= need {
+			b.tokens -= n
+			b.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((need - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// fillLevel returns the number of tokens currently available
+func (b *tokenBucket) fillLevel() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(time.Now())
+	return b.tokens
+}
+
+// waitBlobIO blocks until the blob storage bandwidth allows writing kvs, the time waited is recorded by ioType
+func waitBlobIO(ctx context.Context, kvs map[string]string, ioType string) error {
+	limiter := blobIOLimiter
+	if limiter == nil {
+		return nil
+	}
+	size := 0
+	for _, v := range kvs {
+		size += len(v)
+	}
+	start := time.Now()
+	err := limiter.waitN(ctx, float64(size))
+	metrics.DataNodeBlobIOWaitLatency.WithLabelValues(strconv.FormatInt(Params.NodeID, 10), ioType).
+		Observe(float64(time.Since(start).Milliseconds()))
+	return err
+}
+
+// RecoveryRateLimiter caps the messages per second replayed by a flowgraph during recovery, so that replaying the
+// backlog doesn't overwhelm AssignSegmentID and SaveBinlogPaths of DataCoord. Recovery lasts from the start of the
+// flowgraph until it catches up within catchupGap of the stream head, which is measured by the end timestamp of
+// message packs since a time tick is produced at the current time. The limit is removed once caught up
+type RecoveryRateLimiter struct {
+	bucket     *tokenBucket
+	catchupGap time.Duration
+	start      time.Time
+	vchannel   string
+	caughtUp   int32 // 1 once caught up
+}
+
+// newRecoveryRateLimiter creates a RecoveryRateLimiter, nil is returned if the rate is unlimited
+func newRecoveryRateLimiter(rate float64, catchupGap time.Duration, vchannel string) *RecoveryRateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &RecoveryRateLimiter{
+		// at most a second of messages is replayed in a burst
+		bucket:     newTokenBucket(rate, int(math.Ceil(rate))),
+		catchupGap: catchupGap,
+		start:      time.Now(),
+		vchannel:   vchannel,
+	}
+}
+
+// isCaughtUp returns whether recovery ends, always true if l is nil
+func (l *RecoveryRateLimiter) isCaughtUp() bool {
+	return l == nil || atomic.LoadInt32(&l.caughtUp) == 1
+}
+
+// wait blocks until the messages of the message pack consumed at now are allowed to be replayed, or ctx is done.
+// Message packs within catchupGap of the stream head end recovery and are never blocked
+func (l *RecoveryRateLimiter) wait(ctx context.Context, msg *MsgStreamMsg, now time.Time) error {
+	if l.isCaughtUp() {
+		return nil
+	}
+	produced, _ := tsoutil.ParseTS(msg.TimestampMax())
+	if lag := now.Sub(produced); lag <= l.catchupGap {
+		atomic.StoreInt32(&l.caughtUp, 1)
+		log.Info("flowgraph caught up with the stream head, recovery rate limit removed", zap.String("vchannel", l.vchannel),
+			zap.Duration("lag", lag), zap.Duration("recovery", now.Sub(l.start)))
+		return nil
+	}
+	n := len(msg.TsMessages())
+	if n == 0 {
+		return nil
+	}
+	return l.bucket.waitN(ctx, float64(n))
+}
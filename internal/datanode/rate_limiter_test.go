@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	t.Run("unlimited", func(t *testing.T) {
+		b := newTokenBucket(0, 1)
+		for i := 0; i < 100; i++ {
+			assert.Nil(t, b.wait(context.Background()))
+		}
+	})
+
+	t.Run("burst then block", func(t *testing.T) {
+		b := newTokenBucket(20, 2)
+		assert.InDelta(t, 2, b.fillLevel(), 0.1)
+
+		start := time.Now()
+		assert.Nil(t, b.wait(context.Background()))
+		assert.Nil(t, b.wait(context.Background()))
+		assert.Less(t, b.fillLevel(), float64(1))
+
+		// third call blocks until a token is refilled
+		assert.Nil(t, b.wait(context.Background()))
+		assert.GreaterOrEqual(t, int64(time.Since(start)), int64(40*time.Millisecond))
+	})
+
+	t.Run("wait n tokens", func(t *testing.T) {
+		b := newTokenBucket(1000, 100)
+		start := time.Now()
+		assert.Nil(t, b.waitN(context.Background(), 100))
+		assert.Less(t, int64(time.Since(start)), int64(50*time.Millisecond))
+
+		// n larger than burst is taken once the bucket is full
+		assert.Nil(t, b.waitN(context.Background(), 300))
+		assert.GreaterOrEqual(t, int64(time.Since(start)), int64(100*time.Millisecond))
+		assert.Less(t, b.fillLevel(), float64(0))
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		b := newTokenBucket(0.01, 1)
+		assert.Nil(t, b.wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.Error(t, b.wait(ctx))
+	})
+}
+
+func TestWaitBlobIO(t *testing.T) {
+	defer func(origin *tokenBucket) { blobIOLimiter = origin }(blobIOLimiter)
+	kvs := map[string]string{"a": string(make([]byte, 60)), "b": string(make([]byte, 40))}
+
+	blobIOLimiter = nil
+	assert.Nil(t, waitBlobIO(context.Background(), kvs, blobIOTypeFlush))
+
+	blobIOLimiter = newTokenBucket(1000, 100)
+	start := time.Now()
+	assert.Nil(t, waitBlobIO(context.Background(), kvs, blobIOTypeFlush))
+	// the second batch is backpressured until 100 bytes are refilled
+	assert.Nil(t, waitBlobIO(context.Background(), kvs, blobIOTypeCompaction))
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(100*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, waitBlobIO(ctx, kvs, blobIOTypeCompaction))
+}
+
+func TestRecoveryRateLimiter(t *testing.T) {
+	assert.Nil(t, newRecoveryRateLimiter(0, time.Second, "ch1"))
+	var unlimited *RecoveryRateLimiter
+	assert.True(t, unlimited.isCaughtUp())
+
+	newPack := func(produced time.Time, n int) *MsgStreamMsg {
+		ts := tsoutil.ComposeTS(produced.UnixNano()/int64(time.Millisecond), 0)
+		msgs := make([]msgstream.TsMsg, 0, n)
+		for i := 0; i < n; i++ {
+			msgs = append(msgs, &msgstream.InsertMsg{})
+		}
+		return flowgraph.GenerateMsgStreamMsg(msgs, ts, ts, nil, nil)
+	}
+
+	l := newRecoveryRateLimiter(1000, time.Second, "ch1")
+	behind := time.Now().Add(-time.Minute)
+	start := time.Now()
+	assert.Nil(t, l.wait(context.Background(), newPack(behind, 1000), time.Now()))
+	assert.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+	// replay is limited until caught up
+	assert.Nil(t, l.wait(context.Background(), newPack(behind, 200), time.Now()))
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(200*time.Millisecond))
+	assert.False(t, l.isCaughtUp())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, l.wait(ctx, newPack(behind, 1000), time.Now()))
+
+	// message pack within the catch up gap removes the limit
+	assert.Nil(t, l.wait(ctx, newPack(time.Now(), 1000), time.Now()))
+	assert.True(t, l.isCaughtUp())
+	assert.Nil(t, l.wait(ctx, newPack(behind, 1000), time.Now()))
+}
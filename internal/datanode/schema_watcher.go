@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// schemaVersion returns the version of the collection schema. Fields are appended to a schema with
+// increasing ids, so the maximum field id increments whenever a field is added
+func schemaVersion(schema *schemapb.CollectionSchema) int64 {
+	var version int64
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() > version {
+			version = field.GetFieldID()
+		}
+	}
+	return version
+}
+
+// schemaChange is a collection schema newer than the one of the replica, found by the schema watcher. It applies
+// to the messages from the timestamp the schema is altered at, which is unknown to the watcher, so the insert
+// buffer node asks meta whether the messages it receives are in it
+type schemaChange struct {
+	version int64
+	meta    *metaService
+}
+
+// watchCollectionSchemaChange polls the collection schema from rootcoord every interval until the service is closed,
+// the change is staged for the insert buffer node once the version increments
+func (dsService *dataSyncService) watchCollectionSchemaChange(meta *metaService, interval time.Duration) {
+	defer recoverPanic("watchCollectionSchemaChange", dsService.handlePanic)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-dsService.ctx.Done():
+			return
+		case <-ticker.C:
+			dsService.checkCollectionSchemaChange(meta)
+		}
+	}
+}
+
+// checkCollectionSchemaChange stages a schema change for the insert buffer node if the latest schema of rootcoord
+// is newer than the one of the replica and the one staged, returns whether the change is staged
+func (dsService *dataSyncService) checkCollectionSchemaChange(meta *metaService) bool {
+	current, err := dsService.replica.getCollectionSchema(dsService.collectionID, 0)
+	if err != nil {
+		log.Warn("failed to get collection schema of replica", zap.Int64("collectionID", dsService.collectionID), zap.Error(err))
+		return false
+	}
+	latest, err := meta.getCollectionSchema(dsService.ctx, dsService.collectionID, 0)
+	if err != nil {
+		log.Warn("failed to poll collection schema", zap.Int64("collectionID", dsService.collectionID), zap.Error(err))
+		return false
+	}
+
+	currentVersion, latestVersion := schemaVersion(current), schemaVersion(latest)
+	if latestVersion <= currentVersion {
+		return false
+	}
+
+	dsService.nodesMut.RLock()
+	ibNode := dsService.ibNode
+	dsService.nodesMut.RUnlock()
+	if ibNode == nil {
+		return false
+	}
+	if staged, ok := ibNode.schemaChange.Load().(*schemaChange); ok && staged.version >= latestVersion {
+		return false
+	}
+	log.Info("collection schema changed", zap.Int64("collectionID", dsService.collectionID),
+		zap.String("vChannelName", dsService.vchannelName),
+		zap.Int64("version", currentVersion),
+		zap.Int64("latestVersion", latestVersion))
+	ibNode.schemaChange.Store(&schemaChange{version: latestVersion, meta: meta})
+	return true
+}
+
+// applySchemaChange reloads the collection schema of the replica if a change is staged and the messages at ts are
+// in the newer schema. Beforehand, the rows buffered are synced at the end position of the previous message pack
+// with the schema they are buffered in, the segments synced are returned for the delete node to flush their
+// deletes at the same position. The schema is kept if any sync fails, and the change is applied again with the
+// next message pack. Fields are appended to a schema, so the rows in the newer schema parsed with the former
+// one lose the values of the fields added only
+func (ibNode *insertBufferNode) applySchemaChange(ts Timestamp) []UniqueID {
+	change, _ := ibNode.schemaChange.Load().(*schemaChange)
+	if change == nil {
+		return nil
+	}
+	collID := ibNode.replica.getCollectionID()
+	current, err := ibNode.replica.getCollectionSchema(collID, 0)
+	if err != nil || schemaVersion(current) >= change.version {
+		return nil
+	}
+	schema, err := change.meta.getCollectionSchema(ibNode.ctx, collID, ts)
+	if err != nil {
+		log.Warn("failed to get collection schema of messages", zap.Int64("collectionID", collID),
+			zap.Uint64("timestamp", ts), zap.Error(err))
+		return nil
+	}
+	if schemaVersion(schema) < change.version {
+		return nil
+	}
+
+	synced, err := ibNode.syncAllBuffers(ibNode.lastPosition)
+	if err != nil {
+		log.Warn("failed to sync insert buffers before schema change", zap.Int64("collectionID", collID),
+			zap.String("vChannelName", ibNode.channelName), zap.Error(err))
+		return synced
+	}
+	if err := ibNode.replica.ReloadCollectionSchema(collID, ts); err != nil {
+		return synced
+	}
+	log.Info("collection schema of messages changed", zap.Int64("collectionID", collID),
+		zap.String("vChannelName", ibNode.channelName),
+		zap.Uint64("timestamp", ts),
+		zap.Int("numOfSegmentsSynced", len(synced)))
+	return synced
+}
+
+// syncAllBuffers flushes the insert buffers of all the segments, spilled ones included, at pos, returns the
+// segments flushed
+func (ibNode *insertBufferNode) syncAllBuffers(pos *internalpb.MsgPosition) ([]UniqueID, error) {
+	segIDs := make(map[UniqueID]struct{})
+	ibNode.insertBuffer.Range(func(k, _ interface{}) bool {
+		segIDs[k.(UniqueID)] = struct{}{}
+		return true
+	})
+	for segID := range ibNode.spilledFiles {
+		segIDs[segID] = struct{}{}
+	}
+
+	var synced []UniqueID
+	for segID := range segIDs {
+		var buf *BufferData
+		if bd, ok := ibNode.insertBuffer.Load(segID); ok {
+			buf = bd.(*BufferData)
+		}
+		buffer, err := ibNode.loadSpilledBuffer(segID, buf)
+		if err != nil {
+			return synced, err
+		}
+		var rows int64
+		if buffer != nil {
+			rows = buffer.size
+		}
+		if _, err := ibNode.flushManager.flushBufferData(buffer, segID, false, false, pos); err != nil {
+			return synced, err
+		}
+		synced = append(synced, segID)
+		ibNode.leaseRenewed(segID, time.Now())
+		ibNode.insertBuffer.Delete(segID)
+		ibNode.removeSpilledFiles(segID)
+		ibNode.pendingRows.Sub(rows)
+		if buffer != buf {
+			bufferDataPool.Release(buf)
+		}
+	}
+	return synced, nil
+}
@@ -0,0 +1,220 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// schemaRootCoord returns the schema set by tests, or the former one before alteredAt
+type schemaRootCoord struct {
+	types.RootCoord
+	mu        sync.Mutex
+	schema    *schemapb.CollectionSchema
+	former    *schemapb.CollectionSchema
+	alteredAt Timestamp
+	err       error
+}
+
+func (rc *schemaRootCoord) setSchema(schema *schemapb.CollectionSchema, err error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.schema, rc.err = schema, err
+}
+
+func (rc *schemaRootCoord) alterSchema(schema *schemapb.CollectionSchema, ts Timestamp) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.former, rc.schema, rc.alteredAt = rc.schema, schema, ts
+}
+
+func (rc *schemaRootCoord) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.err != nil {
+		return nil, rc.err
+	}
+	schema := rc.schema
+	if req.GetTimeStamp() != 0 && req.GetTimeStamp() < rc.alteredAt {
+		schema = rc.former
+	}
+	return &milvuspb.DescribeCollectionResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		CollectionID: req.GetCollectionID(),
+		Schema:       schema,
+	}, nil
+}
+
+func newSchemaWithFields(fieldIDs ...int64) *schemapb.CollectionSchema {
+	schema := &schemapb.CollectionSchema{}
+	for _, fieldID := range fieldIDs {
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: fieldID, DataType: schemapb.DataType_Int64})
+	}
+	return schema
+}
+
+func TestSchemaVersion(t *testing.T) {
+	assert.EqualValues(t, 0, schemaVersion(nil))
+	assert.EqualValues(t, 102, schemaVersion(newSchemaWithFields(0, 1, 102, 100)))
+}
+
+func TestSegmentReplica_ReloadCollectionSchema(t *testing.T) {
+	rc := &schemaRootCoord{schema: newSchemaWithFields(100)}
+	replica := &SegmentReplica{collectionID: 1, metaService: newMetaService(rc, 1)}
+
+	schema, err := replica.getCollectionSchema(1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(schema.GetFields()))
+
+	rc.setSchema(newSchemaWithFields(100, 101), nil)
+	// cached schema is kept until reloaded
+	schema, err = replica.getCollectionSchema(1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(schema.GetFields()))
+
+	assert.NoError(t, replica.ReloadCollectionSchema(1, 0))
+	schema, err = replica.getCollectionSchema(1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(schema.GetFields()))
+
+	assert.Error(t, replica.ReloadCollectionSchema(2, 0))
+
+	rc.setSchema(nil, errors.New("mocked error"))
+	assert.Error(t, replica.ReloadCollectionSchema(1, 0))
+	// schema is kept on failure
+	schema, err = replica.getCollectionSchema(1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(schema.GetFields()))
+}
+
+func TestDataSyncService_watchCollectionSchemaChange(t *testing.T) {
+	rc := &schemaRootCoord{schema: newSchemaWithFields(100)}
+	replica := &SegmentReplica{collectionID: 1, metaService: newMetaService(rc, 1)}
+	ctx, cancel := context.WithCancel(context.Background())
+	ibNode := &insertBufferNode{ctx: ctx, replica: replica, flushManager: &mockFlushManager{}}
+	dsService := &dataSyncService{ctx: ctx, cancelFn: cancel, collectionID: 1, replica: replica, ibNode: ibNode}
+	meta := newMetaService(rc, 1)
+
+	assert.False(t, dsService.checkCollectionSchemaChange(meta))
+
+	rc.setSchema(nil, errors.New("mocked error"))
+	assert.False(t, dsService.checkCollectionSchemaChange(meta))
+
+	rc.setSchema(newSchemaWithFields(100, 101), nil)
+	assert.True(t, dsService.checkCollectionSchemaChange(meta))
+	// staged only, the schema of the replica is reloaded by the insert buffer node
+	assert.False(t, dsService.checkCollectionSchemaChange(meta))
+	schema, err := replica.getCollectionSchema(1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(schema.GetFields()))
+	assert.Equal(t, int64(101), ibNode.schemaChange.Load().(*schemaChange).version)
+
+	done := make(chan struct{})
+	go func() {
+		dsService.watchCollectionSchemaChange(meta, 10*time.Millisecond)
+		close(done)
+	}()
+	rc.setSchema(newSchemaWithFields(100, 101, 102), nil)
+	assert.Eventually(t, func() bool {
+		return ibNode.schemaChange.Load().(*schemaChange).version == 102
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+// syncRecordingFlushManager records the positions the insert buffers are flushed at
+type syncRecordingFlushManager struct {
+	mockFlushManager
+	err     error
+	flushed map[UniqueID]*internalpb.MsgPosition
+}
+
+func (m *syncRecordingFlushManager) flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) (*WriteBarrier, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.flushed[segmentID] = pos
+	return m.mockFlushManager.flushBufferData(data, segmentID, flushed, dropped, pos)
+}
+
+func TestInsertBufferNode_applySchemaChange(t *testing.T) {
+	rc := &schemaRootCoord{schema: newSchemaWithFields(100)}
+	replica := &SegmentReplica{collectionID: 1, metaService: newMetaService(rc, 1)}
+	fm := &syncRecordingFlushManager{flushed: make(map[UniqueID]*internalpb.MsgPosition)}
+	ibNode := &insertBufferNode{
+		ctx:          context.Background(),
+		replica:      replica,
+		flushManager: fm,
+		spilledFiles: make(map[UniqueID][]string),
+		spilledStats: make(map[UniqueID]spilledStat),
+	}
+	meta := newMetaService(rc, 1)
+	schema, err := replica.getCollectionSchema(1, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(schema.GetFields()))
+	lastPos := &internalpb.MsgPosition{MsgID: []byte{1}, Timestamp: 100}
+	ibNode.lastPosition = lastPos
+
+	// nothing staged
+	assert.Empty(t, ibNode.applySchemaChange(200))
+
+	ibNode.insertBuffer.Store(UniqueID(10), &BufferData{buffer: &InsertData{}, size: 2})
+	ibNode.insertBuffer.Store(UniqueID(11), &BufferData{buffer: &InsertData{}, size: 3})
+	ibNode.pendingRows.Store(5)
+	rc.alterSchema(newSchemaWithFields(100, 101), 300)
+	ibNode.schemaChange.Store(&schemaChange{version: 101, meta: meta})
+
+	// the messages before the schema is altered are in the former schema
+	assert.Empty(t, ibNode.applySchemaChange(200))
+	assert.Empty(t, fm.flushed)
+
+	// the buffers fail to be synced, the schema is kept
+	fm.err = errors.New("mocked error")
+	assert.Empty(t, ibNode.applySchemaChange(300))
+	schema, err = replica.getCollectionSchema(1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(schema.GetFields()))
+
+	// the buffers are synced at the end of the previous message pack before the schema is reloaded
+	fm.err = nil
+	assert.ElementsMatch(t, []UniqueID{10, 11}, ibNode.applySchemaChange(300))
+	assert.Equal(t, lastPos, fm.flushed[10])
+	assert.Equal(t, lastPos, fm.flushed[11])
+	_, ok := ibNode.insertBuffer.Load(UniqueID(10))
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), ibNode.pendingRows.Load())
+	schema, err = replica.getCollectionSchema(1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(schema.GetFields()))
+
+	// applied once
+	ibNode.insertBuffer.Store(UniqueID(10), &BufferData{buffer: &InsertData{}, size: 2})
+	assert.Empty(t, ibNode.applySchemaChange(400))
+}
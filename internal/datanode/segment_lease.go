@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"sort"
+	"time"
+)
+
+// segmentsToRenewLease returns the growing segments not synced within half of Params.SegmentLeaseDuration.
+// DataCoord renews the lease of a segment once its checkpoint is saved by SaveBinlogPaths,
+// so syncing these segments, even with nothing buffered, keeps them growing
+func (ibNode *insertBufferNode) segmentsToRenewLease(now time.Time) []UniqueID {
+	if Params.SegmentLeaseDuration <= 0 {
+		return nil
+	}
+	if ibNode.leaseRenewedAt == nil {
+		ibNode.leaseRenewedAt = make(map[UniqueID]time.Time)
+	}
+	interval := time.Duration(Params.SegmentLeaseDuration) * time.Second / 2
+
+	checkPoints := ibNode.replica.listSegmentsCheckPoints()
+	for segID := range ibNode.leaseRenewedAt {
+		if _, ok := checkPoints[segID]; !ok {
+			delete(ibNode.leaseRenewedAt, segID)
+		}
+	}
+
+	var ret []UniqueID
+	for segID := range checkPoints {
+		renewedAt, ok := ibNode.leaseRenewedAt[segID]
+		if !ok {
+			// the lease is granted when DataCoord finds the segment, which is no earlier than now
+			ibNode.leaseRenewedAt[segID] = now
+			continue
+		}
+		// segments being flushed are synced anyway, and segments sealed need no lease
+		if now.Sub(renewedAt) < interval || ibNode.flushingSegCache.checkIfCached(segID) || ibNode.replica.isSegmentSealed(segID) {
+			continue
+		}
+		ret = append(ret, segID)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret
+}
+
+// leaseRenewed records the segment is synced, which renews its lease once the binlogs are saved
+func (ibNode *insertBufferNode) leaseRenewed(segID UniqueID, now time.Time) {
+	if ibNode.leaseRenewedAt != nil {
+		ibNode.leaseRenewedAt[segID] = now
+	}
+}
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertBufferNode_segmentsToRenewLease(t *testing.T) {
+	leaseDuration := Params.SegmentLeaseDuration
+	defer func() { Params.SegmentLeaseDuration = leaseDuration }()
+
+	replica := &SegmentReplica{
+		collectionID:    1,
+		newSegments:     make(map[UniqueID]*Segment),
+		normalSegments:  make(map[UniqueID]*Segment),
+		flushedSegments: make(map[UniqueID]*Segment),
+	}
+	for _, segID := range []UniqueID{100, 101, 102} {
+		assert.Nil(t, replica.addNewSegment(segID, 1, 0, "ch1", &internalpb.MsgPosition{}, &internalpb.MsgPosition{}))
+	}
+	ibNode := &insertBufferNode{
+		replica:          replica,
+		flushingSegCache: newCache(),
+	}
+
+	now := time.Now()
+	Params.SegmentLeaseDuration = 0
+	assert.Empty(t, ibNode.segmentsToRenewLease(now))
+	assert.Nil(t, ibNode.leaseRenewedAt)
+
+	Params.SegmentLeaseDuration = 10
+	// segments found are regarded as renewed
+	assert.Empty(t, ibNode.segmentsToRenewLease(now))
+	assert.Empty(t, ibNode.segmentsToRenewLease(now.Add(4*time.Second)))
+
+	ibNode.leaseRenewed(100, now.Add(4*time.Second))
+	ibNode.flushingSegCache.Cache(101)
+	assert.Equal(t, []UniqueID{102}, ibNode.segmentsToRenewLease(now.Add(6*time.Second)))
+	ibNode.flushingSegCache.Remove(101)
+	assert.Equal(t, []UniqueID{100, 101, 102}, ibNode.segmentsToRenewLease(now.Add(10*time.Second)))
+
+	// flushed segments are not renewed any more
+	replica.segmentFlushed(102)
+	assert.Equal(t, []UniqueID{100, 101}, ibNode.segmentsToRenewLease(now.Add(10*time.Second)))
+	assert.Equal(t, 2, len(ibNode.leaseRenewedAt))
+
+	// neither are sealed ones
+	replica.sealSegment(101)
+	assert.Equal(t, []UniqueID{100}, ibNode.segmentsToRenewLease(now.Add(10*time.Second)))
+}
+
+func TestSegmentLeaseExpired(t *testing.T) {
+	replica := &SegmentReplica{
+		collectionID:    1,
+		newSegments:     make(map[UniqueID]*Segment),
+		normalSegments:  make(map[UniqueID]*Segment),
+		flushedSegments: make(map[UniqueID]*Segment),
+	}
+	pos := &internalpb.MsgPosition{ChannelName: "ch1"}
+	require.NoError(t, replica.addNewSegment(100, 1, 10, "ch1", pos, pos))
+	replica.updateStatistics(100, 2)
+
+	dataCoord := &DataCoordFactory{
+		SaveBinlogPathStatus: &commonpb.Status{ErrorCode: commonpb.ErrorCode_SegmentLeaseExpired},
+	}
+	dsService := &dataSyncService{
+		ctx:              context.Background(),
+		collectionID:     1,
+		replica:          replica,
+		dataCoord:        dataCoord,
+		flushingSegCache: newCache(),
+		flushErrCh:       make(chan error, 1),
+	}
+	notifyFunc := flushNotifyFunc(dsService, retry.Attempts(3), retry.Sleep(time.Millisecond))
+	pack := &segmentFlushPack{segmentID: 100, pos: pos}
+	notifyFunc(pack)
+	// binlogs are saved, and the segment is sealed
+	assert.Equal(t, 1, dataCoord.SaveBinlogPathCalls)
+	assert.NoError(t, pack.saveErr)
+	assert.True(t, replica.isSegmentSealed(100))
+	assert.False(t, replica.isSegmentSealed(101))
+
+	var requested []int64
+	ibNode := &insertBufferNode{
+		replica: replica,
+		segmentAllocator: func(collID, partID UniqueID, rows int64) ([]*datapb.SegmentIDAssignment, error) {
+			assert.EqualValues(t, 1, collID)
+			assert.EqualValues(t, 10, partID)
+			requested = append(requested, rows)
+			return []*datapb.SegmentIDAssignment{{
+				SegID:  200,
+				Count:  uint32(rows),
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			}}, nil
+		},
+	}
+	newInsertMsg := func(segID UniqueID, rows int) *msgstream.InsertMsg {
+		return &msgstream.InsertMsg{InsertRequest: internalpb.InsertRequest{
+			CollectionID: 1,
+			PartitionID:  10,
+			SegmentID:    segID,
+			ShardName:    "ch1",
+			RowIDs:       make([]int64, rows),
+		}}
+	}
+
+	// no further rows are inserted into the sealed segment
+	msgs := []*msgstream.InsertMsg{newInsertMsg(100, 3), newInsertMsg(100, 1)}
+	seg2Upload, err := ibNode.updateSegStatesInReplica(msgs, pos, pos)
+	require.NoError(t, err)
+	assert.Equal(t, []UniqueID{200}, seg2Upload)
+	assert.Equal(t, []int64{3, 1}, requested)
+	for _, msg := range msgs {
+		assert.EqualValues(t, 200, msg.GetSegmentID())
+	}
+	for segID, numRows := range map[UniqueID]int64{100: 2, 200: 4} {
+		updates, err := replica.getSegmentStatisticsUpdates(segID)
+		require.NoError(t, err)
+		assert.EqualValues(t, numRows, updates.GetNumRows(), "segment %d", segID)
+	}
+
+	// rows of the sealed segment are dropped rather than inserted into it if no segment is allocated
+	ibNode.segmentAllocator = nil
+	_, err = ibNode.updateSegStatesInReplica([]*msgstream.InsertMsg{newInsertMsg(100, 1)}, pos, pos)
+	assert.Error(t, err)
+	updates, err := replica.getSegmentStatisticsUpdates(100)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, updates.GetNumRows())
+}
@@ -0,0 +1,215 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"go.uber.org/zap"
+)
+
+// segmentDiscardFunc returns an unused segment allocated ahead of time to DataCoord
+type segmentDiscardFunc func(segmentID UniqueID) error
+
+// segmentPreCreator allocates the next segment of a partition from DataCoord in background once a segment of the
+// partition is filled beyond Params.SegmentPreCreateThreshold percent, so that a burst of inserts overflowing the
+// segment is split without waiting for the allocation. Segments not taken in Params.SegmentPreCreateTTL are discarded
+type segmentPreCreator struct {
+	replica Replica
+	alloc   segmentAllocatorFunc
+	discard segmentDiscardFunc
+	ttl     time.Duration
+
+	mu        sync.Mutex
+	closed    bool
+	segments  map[UniqueID]*preCreatedSegment // partition id => segments allocated ahead of time
+	pending   map[UniqueID]struct{}           // ids of partitions being allocated
+	triggered map[UniqueID]UniqueID           // partition id => id of the segment triggering the last allocation
+}
+
+// preCreatedSegment is the allocation made ahead of time for a partition, discarded once its timer fires
+type preCreatedSegment struct {
+	assignments []*datapb.SegmentIDAssignment
+	timer       *time.Timer
+}
+
+func newSegmentPreCreator(replica Replica, alloc segmentAllocatorFunc, discard segmentDiscardFunc) *segmentPreCreator {
+	return &segmentPreCreator{
+		replica:   replica,
+		alloc:     alloc,
+		discard:   discard,
+		ttl:       time.Duration(Params.SegmentPreCreateTTL) * time.Second,
+		segments:  make(map[UniqueID]*preCreatedSegment),
+		pending:   make(map[UniqueID]struct{}),
+		triggered: make(map[UniqueID]UniqueID),
+	}
+}
+
+// observe starts allocating the next segment of the partition of the segment in background if the segment is
+// filled beyond the threshold, a segment triggers the allocation once
+func (p *segmentPreCreator) observe(segmentID UniqueID) {
+	if Params.SegmentPreCreateThreshold <= 0 {
+		return
+	}
+	collID, partID, err := p.replica.getCollectionAndPartitionID(segmentID)
+	if err != nil {
+		return
+	}
+	stats, err := p.replica.getSegmentStatisticsUpdates(segmentID)
+	if err != nil {
+		return
+	}
+	schema, err := p.replica.getCollectionSchema(collID, 0)
+	if err != nil {
+		return
+	}
+	maxRows, err := maxRowsPerSegment(schema)
+	if err != nil || maxRows <= 0 || stats.GetNumRows()*100 < maxRows*Params.SegmentPreCreateThreshold {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed || p.triggered[partID] == segmentID {
+		return
+	}
+	if _, ok := p.segments[partID]; ok {
+		return
+	}
+	if _, ok := p.pending[partID]; ok {
+		return
+	}
+	p.triggered[partID] = segmentID
+	p.pending[partID] = struct{}{}
+	log.Debug("allocate segment ahead of time", zap.Int64("segmentID", segmentID), zap.Int64("partitionID", partID),
+		zap.Int64("numRows", stats.GetNumRows()), zap.Int64("maxRows", maxRows))
+	go p.preCreate(collID, partID, maxRows)
+}
+
+// preCreate allocates a segment for rows of the partition and keeps it until taken or expired
+func (p *segmentPreCreator) preCreate(collID, partID UniqueID, rows int64) {
+	assignments, err := p.alloc(collID, partID, rows)
+	if err == nil {
+		for _, assignment := range assignments {
+			if assignment.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+				log.Warn("failed to allocate segment ahead of time", zap.Int64("partitionID", partID),
+					zap.String("reason", assignment.GetStatus().GetReason()))
+				assignments = nil
+				break
+			}
+		}
+	} else {
+		log.Warn("failed to allocate segment ahead of time", zap.Int64("partitionID", partID), zap.Error(err))
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.pending, partID)
+	if len(assignments) == 0 {
+		return
+	}
+	seg := &preCreatedSegment{assignments: assignments}
+	if p.closed {
+		go p.discardSegment(seg)
+		return
+	}
+	p.keep(partID, seg)
+}
+
+// keep caches the segment of the partition until the ttl elapses, must be called with mu held
+func (p *segmentPreCreator) keep(partID UniqueID, seg *preCreatedSegment) {
+	seg.timer = time.AfterFunc(p.ttl, func() {
+		p.mu.Lock()
+		if p.segments[partID] != seg {
+			p.mu.Unlock()
+			return
+		}
+		delete(p.segments, partID)
+		p.mu.Unlock()
+		p.discardSegment(seg)
+	})
+	p.segments[partID] = seg
+}
+
+// allocate assigns rows of the partition to the segment allocated ahead of time if any, rows beyond the segment
+// are assigned by DataCoord at once
+func (p *segmentPreCreator) allocate(collID, partID UniqueID, rows int64) ([]*datapb.SegmentIDAssignment, error) {
+	p.mu.Lock()
+	seg, ok := p.segments[partID]
+	if ok {
+		seg.timer.Stop()
+		delete(p.segments, partID)
+	}
+	p.mu.Unlock()
+	if !ok {
+		return p.alloc(collID, partID, rows)
+	}
+
+	var count int64
+	for _, assignment := range seg.assignments {
+		count += int64(assignment.GetCount())
+	}
+	if count >= rows {
+		return seg.assignments, nil
+	}
+	more, err := p.alloc(collID, partID, rows-count)
+	if err != nil {
+		// kept for the next allocation
+		p.mu.Lock()
+		if _, ok := p.segments[partID]; !ok && !p.closed {
+			p.keep(partID, seg)
+		} else {
+			go p.discardSegment(seg)
+		}
+		p.mu.Unlock()
+		return nil, err
+	}
+	return append(seg.assignments, more...), nil
+}
+
+// discardSegment returns the segments allocated ahead of time to DataCoord
+func (p *segmentPreCreator) discardSegment(seg *preCreatedSegment) {
+	for _, assignment := range seg.assignments {
+		if err := p.discard(assignment.GetSegID()); err != nil {
+			log.Warn("failed to discard segment allocated ahead of time", zap.Int64("segmentID", assignment.GetSegID()),
+				zap.Error(err))
+			continue
+		}
+		log.Info("discard segment allocated ahead of time", zap.Int64("segmentID", assignment.GetSegID()))
+	}
+}
+
+// close discards the segments not taken, segments being allocated are discarded once allocated
+func (p *segmentPreCreator) close() {
+	p.mu.Lock()
+	p.closed = true
+	segments := make([]*preCreatedSegment, 0, len(p.segments))
+	for partID, seg := range p.segments {
+		seg.timer.Stop()
+		segments = append(segments, seg)
+		delete(p.segments, partID)
+	}
+	p.mu.Unlock()
+
+	for _, seg := range segments {
+		p.discardSegment(seg)
+	}
+}
@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rowsReplica holds segments of partition 10 of collection 1 with the number of rows of each
+type rowsReplica struct {
+	Replica
+	mu     sync.Mutex
+	schema *schemapb.CollectionSchema
+	rows   map[UniqueID]int64
+}
+
+func (r *rowsReplica) getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.rows[segID]; !ok {
+		return 0, 0, errors.New("segment not found")
+	}
+	return 1, 10, nil
+}
+
+func (r *rowsReplica) getSegmentStatisticsUpdates(segID UniqueID) (*internalpb.SegmentStatisticsUpdates, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &internalpb.SegmentStatisticsUpdates{SegmentID: segID, NumRows: r.rows[segID]}, nil
+}
+
+func (r *rowsReplica) getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error) {
+	return r.schema, nil
+}
+
+func TestSegmentPreCreator(t *testing.T) {
+	defer func(threshold int64) { Params.SegmentPreCreateThreshold = threshold }(Params.SegmentPreCreateThreshold)
+	Params.SegmentPreCreateThreshold = 80
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}}},
+		},
+	}
+	maxRows, err := maxRowsPerSegment(schema)
+	require.NoError(t, err)
+	replica := &rowsReplica{schema: schema, rows: map[UniqueID]int64{1: maxRows / 2, 2: maxRows, 3: maxRows, 4: maxRows}}
+
+	var mu sync.Mutex
+	var allocated []int64
+	nextID := UniqueID(100)
+	alloc := func(collID, partID UniqueID, rows int64) ([]*datapb.SegmentIDAssignment, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		allocated = append(allocated, rows)
+		nextID++
+		return []*datapb.SegmentIDAssignment{{
+			SegID:  nextID,
+			Count:  uint32(rows),
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		}}, nil
+	}
+	allocCount := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(allocated)
+	}
+	discarded := make(chan UniqueID, 10)
+	discard := func(segmentID UniqueID) error {
+		discarded <- segmentID
+		return nil
+	}
+	hasSegment := func(p *segmentPreCreator) func() bool {
+		return func() bool {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			_, ok := p.segments[10]
+			return ok
+		}
+	}
+
+	t.Run("allocate ahead of time", func(t *testing.T) {
+		p := newSegmentPreCreator(replica, alloc, discard)
+		defer p.close()
+
+		p.observe(1)
+		assert.Empty(t, p.pending)
+		assert.Equal(t, 0, allocCount())
+
+		p.observe(2)
+		require.Eventually(t, hasSegment(p), time.Second, 10*time.Millisecond)
+		assert.Equal(t, []int64{maxRows}, allocated)
+		// triggered once by a segment
+		p.observe(2)
+		assert.Empty(t, p.pending)
+
+		assignments, err := p.allocate(1, 10, 10)
+		require.NoError(t, err)
+		require.Len(t, assignments, 1)
+		assert.Equal(t, UniqueID(101), assignments[0].GetSegID())
+		assert.Equal(t, 1, allocCount())
+		assert.False(t, hasSegment(p)())
+
+		// allocated at once if no segment allocated ahead of time
+		_, err = p.allocate(1, 10, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, allocCount())
+
+		// rows beyond the segment allocated ahead of time are allocated at once
+		p.observe(3)
+		require.Eventually(t, hasSegment(p), time.Second, 10*time.Millisecond)
+		assignments, err = p.allocate(1, 10, maxRows+10)
+		require.NoError(t, err)
+		require.Len(t, assignments, 2)
+		assert.Equal(t, uint32(10), assignments[1].GetCount())
+	})
+
+	t.Run("discard", func(t *testing.T) {
+		p := newSegmentPreCreator(replica, alloc, discard)
+		p.ttl = 10 * time.Millisecond
+		p.observe(2)
+		segmentID := <-discarded
+		assert.False(t, hasSegment(p)())
+
+		p.ttl = time.Hour
+		p.observe(3)
+		require.Eventually(t, hasSegment(p), time.Second, 10*time.Millisecond)
+		p.close()
+		assert.Equal(t, segmentID+1, <-discarded)
+		p.observe(4)
+		assert.Empty(t, p.pending)
+	})
+}
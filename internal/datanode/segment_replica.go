@@ -48,6 +48,8 @@ const (
 type Replica interface {
 	getCollectionID() UniqueID
 	getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
+	ReloadCollectionSchema(collectionID UniqueID, ts Timestamp) error
+	getCollectionSchemaAt(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
 
 	listAllSegmentIDs() []UniqueID
@@ -69,6 +71,8 @@ type Replica interface {
 	refreshFlushedSegStatistics(segID UniqueID, numRows int64)
 	getSegmentStatisticsUpdates(segID UniqueID) (*internalpb.SegmentStatisticsUpdates, error)
 	segmentFlushed(segID UniqueID)
+	sealSegment(segID UniqueID)
+	isSegmentSealed(segID UniqueID) bool
 }
 
 // Segment is the data structure of segments in data node replica.
@@ -90,12 +94,15 @@ type Segment struct {
 	// TODO silverxia, needs to change to interface to support `string` type PK
 	minPK int64 //	minimal pk value, shortcut for checking whether a pk is inside this segment
 	maxPK int64 //  maximal pk value, same above
+
+	sealed bool // sealed by DataCoord, rows inserted into it afterwards go to other segments
 }
 
 // SegmentReplica is the data replication of persistent data in datanode.
 // It implements `Replica` interface.
 type SegmentReplica struct {
 	collectionID UniqueID
+	schemaMu     sync.RWMutex
 	collSchema   *schemapb.CollectionSchema
 
 	segMu           sync.RWMutex
@@ -169,6 +176,32 @@ func (replica *SegmentReplica) segmentFlushed(segID UniqueID) {
 	}
 }
 
+// sealSegment marks a segment sealed by DataCoord, e.g. its lease expired, no more rows are inserted into it
+func (replica *SegmentReplica) sealSegment(segID UniqueID) {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	for _, segments := range []map[UniqueID]*Segment{replica.newSegments, replica.normalSegments, replica.flushedSegments} {
+		if seg, ok := segments[segID]; ok {
+			seg.sealed = true
+			return
+		}
+	}
+}
+
+// isSegmentSealed returns whether a segment is sealed by DataCoord
+func (replica *SegmentReplica) isSegmentSealed(segID UniqueID) bool {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	for _, segments := range []map[UniqueID]*Segment{replica.newSegments, replica.normalSegments, replica.flushedSegments} {
+		if seg, ok := segments[segID]; ok {
+			return seg.sealed
+		}
+	}
+	return false
+}
+
 func (replica *SegmentReplica) new2NormalSegment(segID UniqueID) {
 	var seg Segment = *replica.newSegments[segID]
 
@@ -620,18 +653,61 @@ func (replica *SegmentReplica) getCollectionSchema(collID UniqueID, ts Timestamp
 		return nil, fmt.Errorf("Not supported collection %v", collID)
 	}
 
+	replica.schemaMu.RLock()
+	sch := replica.collSchema
+	replica.schemaMu.RUnlock()
+	if sch != nil {
+		return sch, nil
+	}
+
+	sch, err := replica.metaService.getCollectionSchema(context.Background(), collID, ts)
+	if err != nil {
+		log.Error("Grpc error", zap.Error(err))
+		return nil, err
+	}
+
+	replica.schemaMu.Lock()
+	defer replica.schemaMu.Unlock()
+	// reloaded meanwhile
 	if replica.collSchema == nil {
-		sch, err := replica.metaService.getCollectionSchema(context.Background(), collID, ts)
-		if err != nil {
-			log.Error("Grpc error", zap.Error(err))
-			return nil, err
-		}
 		replica.collSchema = sch
 	}
-
 	return replica.collSchema, nil
 }
 
+// getCollectionSchemaAt fetches the collection schema at ts from rootcoord, the cached one is neither used nor replaced.
+func (replica *SegmentReplica) getCollectionSchemaAt(collID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error) {
+	if !replica.validCollection(collID) {
+		return nil, fmt.Errorf("Not supported collection %v", collID)
+	}
+	return replica.metaService.getCollectionSchema(context.Background(), collID, ts)
+}
+
+// ReloadCollectionSchema fetches the collection schema at ts from rootcoord and replaces the cached one.
+func (replica *SegmentReplica) ReloadCollectionSchema(collID UniqueID, ts Timestamp) error {
+	if !replica.validCollection(collID) {
+		log.Warn("Mismatch collection for the replica",
+			zap.Int64("Want", replica.collectionID),
+			zap.Int64("Actual", collID),
+		)
+		return fmt.Errorf("Not supported collection %v", collID)
+	}
+
+	sch, err := replica.metaService.getCollectionSchema(context.Background(), collID, ts)
+	if err != nil {
+		log.Warn("failed to reload collection schema", zap.Int64("collectionID", collID), zap.Error(err))
+		return err
+	}
+
+	replica.schemaMu.Lock()
+	replica.collSchema = sch
+	replica.schemaMu.Unlock()
+
+	log.Info("collection schema reloaded", zap.Int64("collectionID", collID),
+		zap.Int("numFields", len(sch.GetFields())))
+	return nil
+}
+
 func (replica *SegmentReplica) validCollection(collID UniqueID) bool {
 	return collID == replica.collectionID
 }
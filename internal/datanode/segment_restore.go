@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"crypto/md5" // #nosec G501, ETag of MinIO objects is MD5
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// versionedKV is a blob storage keeping versions of objects, e.g. MinIO with versioning enabled on the bucket
+type versionedKV interface {
+	ListVersions(key string) ([]miniokv.ObjectVersion, error)
+	LoadVersion(key, versionID string) ([]byte, error)
+	RestoreVersion(key, versionID string) error
+	Remove(key string) error
+}
+
+// restoredObject is the version of a binlog restored
+type restoredObject struct {
+	path     string
+	version  miniokv.ObjectVersion
+	previous miniokv.ObjectVersion // the latest version before restored, which it's rolled back to
+}
+
+// segmentLogPaths returns the paths of insert, stats, sketch and delta logs of the segment
+func segmentLogPaths(segment *datapb.SegmentInfo) []string {
+	var paths []string
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs(), segment.GetSketchlogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			paths = append(paths, fieldBinlog.GetBinlogs()...)
+		}
+	}
+	for _, deltalog := range segment.GetDeltalogs() {
+		paths = append(paths, deltalog.GetDeltaLogPath())
+	}
+	return paths
+}
+
+// selectVersion returns the latest version of the object written no later than ts, versions are sorted newest first
+func selectVersion(path string, versions []miniokv.ObjectVersion, ts time.Time) (miniokv.ObjectVersion, error) {
+	for _, version := range versions {
+		if version.LastModified.After(ts) {
+			continue
+		}
+		if version.IsDeleteMarker {
+			return miniokv.ObjectVersion{}, fmt.Errorf("object %s is deleted at %v", path, ts)
+		}
+		return version, nil
+	}
+	return miniokv.ObjectVersion{}, fmt.Errorf("object %s has no version written before %v", path, ts)
+}
+
+// verifyChecksum checks the data of the version by its size and ETag, the ETag is the MD5 of the object
+// unless it's uploaded in multiple parts, which is checked by size only
+func verifyChecksum(path string, version miniokv.ObjectVersion, data []byte) error {
+	if int64(len(data)) != version.Size {
+		return fmt.Errorf("object %s version %s has %d bytes, expect %d", path, version.VersionID, len(data), version.Size)
+	}
+	etag := strings.Trim(version.ETag, "\"")
+	if etag == "" || strings.Contains(etag, "-") {
+		return nil
+	}
+	sum := md5.Sum(data) // #nosec G401
+	if hex.EncodeToString(sum[:]) != etag {
+		return fmt.Errorf("object %s version %s checksum mismatch", path, version.VersionID)
+	}
+	return nil
+}
+
+// restoreSegmentBinlogs restores every log of the segment to its latest version written no later than ts.
+// The versions are downloaded and verified before any of them is restored, and the logs restored are rolled back
+// to their previous versions if the blob storage fails in the middle, so that either all the logs are restored or
+// none of them is, unless the rollback fails as well. Logs already at the version are left as they are
+func restoreSegmentBinlogs(kv versionedKV, segment *datapb.SegmentInfo, ts time.Time) ([]restoredObject, error) {
+	var objects []restoredObject
+	for _, path := range segmentLogPaths(segment) {
+		versions, err := kv.ListVersions(path)
+		if err != nil {
+			return nil, err
+		}
+		version, err := selectVersion(path, versions, ts)
+		if err != nil {
+			return nil, err
+		}
+		data, err := kv.LoadVersion(path, version.VersionID)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifyChecksum(path, version, data); err != nil {
+			return nil, err
+		}
+		if version.IsLatest {
+			continue
+		}
+		// versions are sorted newest first
+		objects = append(objects, restoredObject{path: path, version: version, previous: versions[0]})
+	}
+
+	for i, object := range objects {
+		if err := kv.RestoreVersion(object.path, object.version.VersionID); err != nil {
+			err = fmt.Errorf("failed to restore object %s version %s: %w", object.path, object.version.VersionID, err)
+			if rollbackErr := rollbackRestoredObjects(kv, objects[:i]); rollbackErr != nil {
+				return nil, fmt.Errorf("%v, and failed to roll back restored objects: %w", err, rollbackErr)
+			}
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+// rollbackRestoredObjects restores the objects to their versions before restored, objects deleted before are
+// deleted again. All the objects are tried even if some of them fail
+func rollbackRestoredObjects(kv versionedKV, objects []restoredObject) error {
+	var failed []string
+	for i := len(objects) - 1; i >= 0; i-- {
+		object := objects[i]
+		var err error
+		if object.previous.IsDeleteMarker {
+			err = kv.Remove(object.path)
+		} else {
+			err = kv.RestoreVersion(object.path, object.previous.VersionID)
+		}
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", object.path, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to roll back objects [%s]", strings.Join(failed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"crypto/md5" // #nosec G501
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memVersionedKV keeps versions of objects in memory like a bucket with versioning enabled
+type memVersionedKV struct {
+	kv.BaseKV
+	versions map[string][]miniokv.ObjectVersion // newest first
+	data     map[string][]byte                  // version id => data
+	restored []string                           // version ids restored
+	removed  []string                           // keys removed
+
+	failVersion string // version id failed to be restored
+}
+
+func newMemVersionedKV() *memVersionedKV {
+	return &memVersionedKV{
+		versions: make(map[string][]miniokv.ObjectVersion),
+		data:     make(map[string][]byte),
+	}
+}
+
+// put writes a version of the object modified at the time, a nil value writes a delete marker
+func (m *memVersionedKV) put(key string, value []byte, modified time.Time) string {
+	versions := m.versions[key]
+	for i := range versions {
+		versions[i].IsLatest = false
+	}
+	versionID := fmt.Sprintf("%s@%d", key, len(versions))
+	sum := md5.Sum(value) // #nosec G401
+	version := miniokv.ObjectVersion{
+		VersionID:      versionID,
+		LastModified:   modified,
+		ETag:           hex.EncodeToString(sum[:]),
+		Size:           int64(len(value)),
+		IsLatest:       true,
+		IsDeleteMarker: value == nil,
+	}
+	m.versions[key] = append([]miniokv.ObjectVersion{version}, versions...)
+	m.data[versionID] = value
+	return versionID
+}
+
+func (m *memVersionedKV) ListVersions(key string) ([]miniokv.ObjectVersion, error) {
+	return m.versions[key], nil
+}
+
+func (m *memVersionedKV) LoadVersion(key, versionID string) ([]byte, error) {
+	data, ok := m.data[versionID]
+	if !ok {
+		return nil, fmt.Errorf("version %s not found", versionID)
+	}
+	return data, nil
+}
+
+func (m *memVersionedKV) RestoreVersion(key, versionID string) error {
+	if versionID == m.failVersion {
+		return errors.New("mock error")
+	}
+	m.restored = append(m.restored, versionID)
+	return nil
+}
+
+func (m *memVersionedKV) Remove(key string) error {
+	m.removed = append(m.removed, key)
+	return nil
+}
+
+func TestRestoreSegmentBinlogs(t *testing.T) {
+	start := time.Now()
+	snapshot := start.Add(time.Minute)
+	segment := &datapb.SegmentInfo{
+		ID:        1,
+		Binlogs:   []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"insert/1"}}},
+		Statslogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"stats/1"}}},
+		Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "delta/1"}},
+	}
+	assert.Equal(t, []string{"insert/1", "stats/1", "delta/1"}, segmentLogPaths(segment))
+
+	newKV := func() *memVersionedKV {
+		kv := newMemVersionedKV()
+		kv.put("insert/1", []byte("insert"), start)
+		kv.put("insert/1", []byte("corrupted"), snapshot.Add(time.Minute))
+		kv.put("stats/1", []byte("stats"), start)
+		kv.put("delta/1", []byte("delta"), start)
+		return kv
+	}
+
+	t.Run("restore", func(t *testing.T) {
+		kv := newKV()
+		restored, err := restoreSegmentBinlogs(kv, segment, snapshot)
+		require.NoError(t, err)
+		// logs not changed after the snapshot are left as they are
+		require.Equal(t, 1, len(restored))
+		assert.Equal(t, "insert/1", restored[0].path)
+		assert.Equal(t, []string{"insert/1@0"}, kv.restored)
+	})
+
+	t.Run("rolled back", func(t *testing.T) {
+		kv := newKV()
+		kv.put("stats/1", []byte("corrupted"), snapshot.Add(time.Minute))
+		kv.put("delta/1", nil, snapshot.Add(time.Minute))
+		kv.failVersion = "delta/1@0"
+		_, err := restoreSegmentBinlogs(kv, segment, snapshot)
+		assert.Error(t, err)
+		// objects restored are rolled back to their latest versions in reverse order
+		assert.Equal(t, []string{"insert/1@0", "stats/1@0", "stats/1@1", "insert/1@1"}, kv.restored)
+		assert.Empty(t, kv.removed)
+
+		// objects deleted are deleted again
+		kv = newKV()
+		kv.put("delta/1", nil, snapshot.Add(time.Minute))
+		restored, err := restoreSegmentBinlogs(kv, segment, snapshot)
+		require.NoError(t, err)
+		require.NoError(t, rollbackRestoredObjects(kv, restored))
+		assert.Equal(t, []string{"insert/1@0", "delta/1@0", "insert/1@1"}, kv.restored)
+		assert.Equal(t, []string{"delta/1"}, kv.removed)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		kv := newKV()
+		kv.data["insert/1@0"] = []byte("INSERT")
+		_, err := restoreSegmentBinlogs(kv, segment, snapshot)
+		assert.Error(t, err)
+		assert.Empty(t, kv.restored)
+	})
+
+	t.Run("no version before snapshot", func(t *testing.T) {
+		kv := newKV()
+		_, err := restoreSegmentBinlogs(kv, segment, start.Add(-time.Minute))
+		assert.Error(t, err)
+		assert.Empty(t, kv.restored)
+	})
+
+	t.Run("deleted before snapshot", func(t *testing.T) {
+		kv := newKV()
+		kv.put("delta/1", nil, start.Add(time.Second))
+		_, err := restoreSegmentBinlogs(kv, segment, snapshot)
+		assert.Error(t, err)
+		assert.Empty(t, kv.restored)
+	})
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	data := []byte("binlog")
+	sum := md5.Sum(data) // #nosec G401
+	version := miniokv.ObjectVersion{VersionID: "v1", Size: int64(len(data)), ETag: "\"" + hex.EncodeToString(sum[:]) + "\""}
+	assert.NoError(t, verifyChecksum("p", version, data))
+	assert.Error(t, verifyChecksum("p", version, []byte("BINLOG")))
+	assert.Error(t, verifyChecksum("p", version, []byte("binlog binlog")))
+
+	// ETag of objects uploaded in multiple parts is not the digest of content
+	version.ETag = "d41d8cd98f00b204e9800998ecf8427e-2"
+	assert.NoError(t, verifyChecksum("p", version, []byte("BINLOG")))
+}
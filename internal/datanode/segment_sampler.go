@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// fieldSampler accumulates the statistics of a field over the sampled rows
+type fieldSampler struct {
+	stats    *datapb.FieldSampleStats
+	hasValue bool
+	distinct map[string]struct{}
+}
+
+func newFieldSampler(fieldID UniqueID, data storage.FieldData) *fieldSampler {
+	return &fieldSampler{
+		stats:    &datapb.FieldSampleStats{FieldID: fieldID, DataType: sampleDataType(data)},
+		distinct: make(map[string]struct{}),
+	}
+}
+
+func sampleDataType(data storage.FieldData) schemapb.DataType {
+	switch data.(type) {
+	case *storage.BoolFieldData:
+		return schemapb.DataType_Bool
+	case *storage.Int8FieldData:
+		return schemapb.DataType_Int8
+	case *storage.Int16FieldData:
+		return schemapb.DataType_Int16
+	case *storage.Int32FieldData:
+		return schemapb.DataType_Int32
+	case *storage.Int64FieldData:
+		return schemapb.DataType_Int64
+	case *storage.FloatFieldData:
+		return schemapb.DataType_Float
+	case *storage.DoubleFieldData:
+		return schemapb.DataType_Double
+	case *storage.StringFieldData:
+		return schemapb.DataType_String
+	case *storage.BinaryVectorFieldData:
+		return schemapb.DataType_BinaryVector
+	case *storage.FloatVectorFieldData:
+		return schemapb.DataType_FloatVector
+	default:
+		return schemapb.DataType_None
+	}
+}
+
+// add accumulates a sampled value. NaN and Inf in float vectors are counted once per row
+func (f *fieldSampler) add(value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		f.stats.NullCount++
+	case int8:
+		f.addNumber(float64(v))
+	case int16:
+		f.addNumber(float64(v))
+	case int32:
+		f.addNumber(float64(v))
+	case int64:
+		f.addNumber(float64(v))
+	case float32:
+		f.addNumber(float64(v))
+	case float64:
+		f.addNumber(v)
+	case string:
+		f.distinct[v] = struct{}{}
+		f.stats.DistinctCount = int64(len(f.distinct))
+	case []float32:
+		var hasNaN, hasInf bool
+		for _, e := range v {
+			hasNaN = hasNaN || math.IsNaN(float64(e))
+			hasInf = hasInf || math.IsInf(float64(e), 0)
+		}
+		if hasNaN {
+			f.stats.NanCount++
+		}
+		if hasInf {
+			f.stats.InfCount++
+		}
+	}
+}
+
+func (f *fieldSampler) addNumber(v float64) {
+	if math.IsNaN(v) {
+		f.stats.NanCount++
+		return
+	}
+	if math.IsInf(v, 0) {
+		f.stats.InfCount++
+	}
+	if !f.hasValue || v < f.stats.Min {
+		f.stats.Min = v
+	}
+	if !f.hasValue || v > f.stats.Max {
+		f.stats.Max = v
+	}
+	f.hasValue = true
+}
+
+// sampleSegment reads the binlogs of the segment, and returns the statistics of user fields over rows
+// sampled at the rate. Rows are sampled independently, so the sampled rows are about rate of all rows
+func sampleSegment(ctx context.Context, dl downloader, req *datapb.SampleSegmentRequest, rnd *rand.Rand) (*datapb.SampleSegmentResponse, error) {
+	resp := &datapb.SampleSegmentResponse{SegmentID: req.GetSegmentID()}
+	fieldBinlogs := req.GetFieldBinlogs()
+	if len(fieldBinlogs) == 0 {
+		return resp, nil
+	}
+
+	samplers := make(map[UniqueID]*fieldSampler)
+	// binlogs at the same position of fields hold the same rows
+	numOfBatches := len(fieldBinlogs[0].GetBinlogs())
+	for idx := 0; idx < numOfBatches; idx++ {
+		paths := make([]string, 0, len(fieldBinlogs))
+		for _, f := range fieldBinlogs {
+			if idx >= len(f.GetBinlogs()) {
+				return nil, fmt.Errorf("binlogs of field %d are fewer than %d", f.GetFieldID(), numOfBatches)
+			}
+			paths = append(paths, f.GetBinlogs()[idx])
+		}
+		blobs, err := dl.download(ctx, paths)
+		if err != nil {
+			return nil, err
+		}
+		codec := storage.NewInsertCodec(nil)
+		_, _, data, err := codec.Deserialize(blobs)
+		codec.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		var numRows int
+		fieldIDs := make([]UniqueID, 0, len(data.Data))
+		for fieldID, fieldData := range data.Data {
+			if fieldID < common.StartOfUserFieldID {
+				continue
+			}
+			if _, ok := samplers[fieldID]; !ok {
+				samplers[fieldID] = newFieldSampler(fieldID, fieldData)
+			}
+			fieldIDs = append(fieldIDs, fieldID)
+			numRows = fieldData.RowNum()
+		}
+		resp.NumRows += int64(numRows)
+
+		for i := 0; i < numRows; i++ {
+			if rnd.Float64() >= req.GetSampleRate() {
+				continue
+			}
+			resp.SampledRows++
+			for _, fieldID := range fieldIDs {
+				samplers[fieldID].add(data.Data[fieldID].GetRow(i))
+			}
+		}
+	}
+
+	for _, sampler := range samplers {
+		resp.FieldStats = append(resp.FieldStats, sampler.stats)
+	}
+	sort.Slice(resp.FieldStats, func(i, j int) bool { return resp.FieldStats[i].FieldID < resp.FieldStats[j].FieldID })
+	return resp, nil
+}
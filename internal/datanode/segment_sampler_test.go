@@ -0,0 +1,138 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	s "github.com/milvus-io/milvus/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldSampler(t *testing.T) {
+	t.Run("numeric field", func(t *testing.T) {
+		f := newFieldSampler(100, &s.DoubleFieldData{})
+		for _, v := range []float64{3, math.NaN(), -1, math.Inf(1), 2} {
+			f.add(v)
+		}
+		f.add(nil)
+		assert.Equal(t, schemapb.DataType_Double, f.stats.GetDataType())
+		assert.Equal(t, float64(-1), f.stats.GetMin())
+		assert.True(t, math.IsInf(f.stats.GetMax(), 1))
+		assert.EqualValues(t, 1, f.stats.GetNanCount())
+		assert.EqualValues(t, 1, f.stats.GetInfCount())
+		assert.EqualValues(t, 1, f.stats.GetNullCount())
+	})
+
+	t.Run("string field", func(t *testing.T) {
+		f := newFieldSampler(100, &s.StringFieldData{})
+		for _, v := range []string{"a", "b", "a"} {
+			f.add(v)
+		}
+		assert.EqualValues(t, 2, f.stats.GetDistinctCount())
+	})
+
+	t.Run("float vector field", func(t *testing.T) {
+		f := newFieldSampler(100, &s.FloatVectorFieldData{})
+		f.add([]float32{1, float32(math.NaN()), float32(math.NaN())})
+		f.add([]float32{float32(math.Inf(-1)), 1})
+		f.add([]float32{1, 2})
+		assert.EqualValues(t, 1, f.stats.GetNanCount())
+		assert.EqualValues(t, 1, f.stats.GetInfCount())
+	})
+}
+
+func TestSampleSegment(t *testing.T) {
+	b := &binlogIO{memkv.NewMemoryKV(), NewAllocatorFactory()}
+	meta := (&MetaFactory{}).GetCollectionMeta(UniqueID(10001), "samples")
+	iData := genInsertData()
+	iData.Data[108].(*s.DoubleFieldData).Data[1] = math.NaN()
+	// binlogs of each field are merged as DataCoord keeps them
+	binlogs := make(map[int64]*datapb.FieldBinlog)
+	var fieldBinlogs []*datapb.FieldBinlog
+	for _, data := range []*InsertData{iData, genInsertData()} {
+		paths, err := b.upload(context.TODO(), 1, 10, []*InsertData{data}, &DeleteData{}, meta)
+		require.NoError(t, err)
+		for _, fieldBinlog := range paths.inPaths {
+			if merged, ok := binlogs[fieldBinlog.GetFieldID()]; ok {
+				merged.Binlogs = append(merged.Binlogs, fieldBinlog.GetBinlogs()...)
+				continue
+			}
+			binlogs[fieldBinlog.GetFieldID()] = fieldBinlog
+			fieldBinlogs = append(fieldBinlogs, fieldBinlog)
+		}
+	}
+
+	t.Run("sample all rows", func(t *testing.T) {
+		resp, err := sampleSegment(context.TODO(), b, &datapb.SampleSegmentRequest{
+			SegmentID:    1,
+			SampleRate:   1,
+			FieldBinlogs: fieldBinlogs,
+		}, rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+		assert.EqualValues(t, 4, resp.GetNumRows())
+		assert.EqualValues(t, 4, resp.GetSampledRows())
+
+		stats := make(map[int64]*datapb.FieldSampleStats)
+		for _, stat := range resp.GetFieldStats() {
+			// system fields are not sampled
+			assert.GreaterOrEqual(t, stat.GetFieldID(), int64(100))
+			stats[stat.GetFieldID()] = stat
+		}
+		assert.Equal(t, schemapb.DataType_Int8, stats[103].GetDataType())
+		assert.Equal(t, float64(5), stats[103].GetMin())
+		assert.Equal(t, float64(6), stats[103].GetMax())
+		assert.EqualValues(t, 1, stats[108].GetNanCount())
+		assert.Equal(t, 3.333, stats[108].GetMax())
+	})
+
+	t.Run("sample part of rows", func(t *testing.T) {
+		resp, err := sampleSegment(context.TODO(), b, &datapb.SampleSegmentRequest{
+			SegmentID:    1,
+			SampleRate:   0.5,
+			FieldBinlogs: fieldBinlogs,
+		}, rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+		assert.EqualValues(t, 4, resp.GetNumRows())
+		assert.Less(t, resp.GetSampledRows(), int64(4))
+	})
+
+	t.Run("empty segment", func(t *testing.T) {
+		resp, err := sampleSegment(context.TODO(), b, &datapb.SampleSegmentRequest{SegmentID: 1, SampleRate: 1}, rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, resp.GetNumRows())
+		assert.Empty(t, resp.GetFieldStats())
+	})
+
+	t.Run("download fails", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		_, err := sampleSegment(ctx, b, &datapb.SampleSegmentRequest{
+			SegmentID:    1,
+			SampleRate:   1,
+			FieldBinlogs: fieldBinlogs,
+		}, rand.New(rand.NewSource(1)))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"go.uber.org/zap"
+)
+
+// reconnectInterval is the interval to retry recreating a dm stream
+var reconnectInterval = time.Second
+
+// TopicWatcher watches the message packs consumed from a dm stream. A message pack is delivered on every time tick
+// even if nothing is inserted, so a gap longer than the timeout means the consumer is stuck, e.g. it's not
+// reconnected after a leader election of the Pulsar broker. Then onStall is called to unblock the consumer, and the
+// position to recreate the consumer at is taken by the consuming goroutine with recover
+type TopicWatcher struct {
+	timeout time.Duration
+	onStall func()
+
+	mu       sync.Mutex
+	lastRecv time.Time
+	position *internalpb.MsgPosition // end position of the last message pack consumed
+	stalled  bool
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+func newTopicWatcher(timeout time.Duration, position *internalpb.MsgPosition, onStall func()) *TopicWatcher {
+	return &TopicWatcher{
+		timeout:  timeout,
+		onStall:  onStall,
+		lastRecv: time.Now(),
+		position: position,
+		closeCh:  make(chan struct{}),
+	}
+}
+
+// start starts the loop checking the gap of message packs
+func (w *TopicWatcher) start() {
+	go func() {
+		ticker := time.NewTicker(w.timeout / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.closeCh:
+				return
+			case now := <-ticker.C:
+				if w.check(now) {
+					w.onStall()
+				}
+			}
+		}
+	}()
+}
+
+// close stops the loop, recover returns false afterwards
+func (w *TopicWatcher) close() {
+	w.closeOnce.Do(func() {
+		close(w.closeCh)
+	})
+}
+
+// received records the message pack consumed at now
+func (w *TopicWatcher) received(msg *MsgStreamMsg, now time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastRecv = now
+	if positions := msg.EndPositions(); len(positions) > 0 {
+		w.position = positions[0]
+	}
+}
+
+// check returns true if no message pack is consumed within the timeout before now, a stall is reported once
+// until recovered
+func (w *TopicWatcher) check(now time.Time) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stalled || now.Sub(w.lastRecv) < w.timeout {
+		return false
+	}
+	w.stalled = true
+	return true
+}
+
+// recover returns the gap since the last message pack and the position to recreate the consumer at if a stall is
+// reported, the watcher restarts timing from now
+func (w *TopicWatcher) recover(now time.Time) (time.Duration, *internalpb.MsgPosition, bool) {
+	select {
+	case <-w.closeCh:
+		return 0, nil, false
+	default:
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.stalled {
+		return 0, nil, false
+	}
+	gap := now.Sub(w.lastRecv)
+	w.stalled = false
+	w.lastRecv = now
+	log.Warn("no message pack consumed from dm stream within the heartbeat timeout", zap.Duration("gap", gap),
+		zap.String("channel", w.position.GetChannelName()), zap.Uint64("timestamp", w.position.GetTimestamp()))
+	return gap, w.position, true
+}
@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stuckMsgStream delivers the message packs sent to packCh, Consume blocks once packCh is drained until closed
+type stuckMsgStream struct {
+	mockTtMsgStream
+	packCh    chan *msgstream.MsgPack
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+func newStuckMsgStream(packs ...*msgstream.MsgPack) *stuckMsgStream {
+	stream := &stuckMsgStream{packCh: make(chan *msgstream.MsgPack, len(packs)), closeCh: make(chan struct{})}
+	for _, pack := range packs {
+		stream.packCh <- pack
+	}
+	return stream
+}
+
+func (ss *stuckMsgStream) Consume() *msgstream.MsgPack {
+	select {
+	case pack := <-ss.packCh:
+		return pack
+	case <-ss.closeCh:
+		return nil
+	}
+}
+
+func (ss *stuckMsgStream) Close() {
+	ss.closeOnce.Do(func() {
+		close(ss.closeCh)
+	})
+}
+
+func newTestPack(ts Timestamp) *msgstream.MsgPack {
+	return &msgstream.MsgPack{
+		BeginTs:      ts,
+		EndTs:        ts,
+		EndPositions: []*internalpb.MsgPosition{{ChannelName: "p1", Timestamp: ts}},
+	}
+}
+
+func TestTopicWatcher(t *testing.T) {
+	now := time.Now()
+	w := newTopicWatcher(time.Second, &internalpb.MsgPosition{Timestamp: 1}, func() {})
+	w.lastRecv = now
+	assert.False(t, w.check(now.Add(time.Second/2)))
+	_, _, ok := w.recover(now)
+	assert.False(t, ok)
+
+	w.received(flowgraph.GenerateMsgStreamMsg(nil, 5, 5, nil, newTestPack(5).EndPositions), now)
+	assert.True(t, w.check(now.Add(time.Second)))
+	// reported once
+	assert.False(t, w.check(now.Add(2*time.Second)))
+	gap, position, ok := w.recover(now.Add(3 * time.Second))
+	require.True(t, ok)
+	assert.Equal(t, 3*time.Second, gap)
+	assert.Equal(t, Timestamp(5), position.GetTimestamp())
+	assert.False(t, w.check(now.Add(3*time.Second)))
+
+	assert.True(t, w.check(now.Add(4*time.Second)))
+	w.close()
+	_, _, ok = w.recover(now.Add(4 * time.Second))
+	assert.False(t, ok)
+}
+
+func TestDmInputNode_Reconnect(t *testing.T) {
+	defer func(interval time.Duration) { reconnectInterval = interval }(reconnectInterval)
+	reconnectInterval = 10 * time.Millisecond
+
+	stuck := newStuckMsgStream(newTestPack(10))
+	recreated := newStuckMsgStream(newTestPack(20))
+	var mu sync.Mutex
+	var seeks []Timestamp
+	newStream := func(seekPos *internalpb.MsgPosition) (msgstream.MsgStream, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		seeks = append(seeks, seekPos.GetTimestamp())
+		if len(seeks) == 1 {
+			return nil, errors.New("broker unavailable")
+		}
+		return recreated, nil
+	}
+	node := &dmInputNode{
+		InputNode: flowgraph.NewInputNode(stuck, "dmInputNode", 1024, 1024),
+		newStream: newStream,
+		closeCh:   make(chan struct{}),
+	}
+	node.watcher = newTopicWatcher(50*time.Millisecond, nil, node.unblock)
+	node.Start()
+
+	assert.EqualValues(t, 10, operateTimestamp(t, node))
+	// recreated at the last position consumed after a failure
+	assert.EqualValues(t, 20, operateTimestamp(t, node))
+	mu.Lock()
+	assert.Equal(t, []Timestamp{10, 10}, seeks)
+	mu.Unlock()
+	assert.Equal(t, recreated, node.InStream())
+
+	node.Close()
+	assert.Empty(t, node.Operate(nil))
+}
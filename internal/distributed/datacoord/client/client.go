@@ -19,6 +19,7 @@ package grpcdatacoordclient
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -159,6 +160,7 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
 					grpc_opentracing.UnaryClientInterceptor(opts...),
+					compressionInterceptor(Params.GRPCCompressionThresholdBytes, saveBinlogPathsMethod),
 				)),
 			grpc.WithStreamInterceptor(
 				grpc_middleware.ChainStreamClient(
@@ -605,3 +607,576 @@ func (c *Client) WatchChannels(ctx context.Context, req *datapb.WatchChannelsReq
 	}
 	return ret.(*datapb.WatchChannelsResponse), err
 }
+
+// GetChannelHistory returns the watcher assignment events of a vchannel
+func (c *Client) GetChannelHistory(ctx context.Context, req *datapb.GetChannelHistoryRequest) (*datapb.GetChannelHistoryResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetChannelHistory(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetChannelHistoryResponse), err
+}
+
+// ImportSegmentManifest registers flushed segments described by an external manifest
+func (c *Client) ImportSegmentManifest(ctx context.Context, req *datapb.ImportManifestRequest) (*datapb.ImportManifestResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.ImportSegmentManifest(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.ImportManifestResponse), err
+}
+
+// GetCompactionScoreCard explains whether a segment is eligible for each compaction policy
+func (c *Client) GetCompactionScoreCard(ctx context.Context, req *datapb.GetCompactionScoreCardRequest) (*datapb.GetCompactionScoreCardResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetCompactionScoreCard(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetCompactionScoreCardResponse), err
+}
+
+// WatchChannelsV2 notifies DataCoord to watch vchannels of a collection from the specified seek positions
+func (c *Client) WatchChannelsV2(ctx context.Context, req *datapb.WatchChannelsV2Request) (*datapb.WatchChannelsResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.WatchChannelsV2(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.WatchChannelsResponse), err
+}
+
+// MigrateChannel moves a channel from the DataNode watching it to another one
+func (c *Client) MigrateChannel(ctx context.Context, req *datapb.MigrateChannelRequest) (*datapb.MigrateChannelResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.MigrateChannel(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.MigrateChannelResponse), err
+}
+
+// ListCompactionPlans lists compaction plans of all collections, filtered and paged by the request
+func (c *Client) ListCompactionPlans(ctx context.Context, req *datapb.ListCompactionPlansRequest) (*datapb.ListCompactionPlansResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.ListCompactionPlans(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.ListCompactionPlansResponse), err
+}
+
+// GetFlushedSegmentsV2 returns segments of the collection in the requested states page by page
+func (c *Client) GetFlushedSegmentsV2(ctx context.Context, req *datapb.GetFlushedSegmentsV2Request) (*datapb.GetFlushedSegmentsV2Response, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetFlushedSegmentsV2(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetFlushedSegmentsV2Response), err
+}
+
+// ReclaimFailedCompaction removes output binlogs of a failed compaction plan
+func (c *Client) ReclaimFailedCompaction(ctx context.Context, req *datapb.ReclaimFailedCompactionRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.ReclaimFailedCompaction(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// PinSegments marks segments pinned so that compaction never merges them with other segments
+func (c *Client) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.PinSegments(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// UnpinSegments clears the pinned flag of segments
+func (c *Client) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.UnpinSegments(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// ListManagedCollections lists the collections in DataCoord meta with a summary of their segments
+func (c *Client) ListManagedCollections(ctx context.Context, req *datapb.ListManagedCollectionsRequest) (*datapb.ListManagedCollectionsResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.ListManagedCollections(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.ListManagedCollectionsResponse), err
+}
+
+// MigrateEtcdPrefix moves all etcd keys under the old prefix to the new prefix
+func (c *Client) MigrateEtcdPrefix(ctx context.Context, req *datapb.MigrateEtcdPrefixRequest) (*datapb.MigrateEtcdPrefixResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.MigrateEtcdPrefix(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.MigrateEtcdPrefixResponse), err
+}
+
+// RegisterDataNodeQuota registers the resource quota of a DataNode, which limits the channels assigned to it
+func (c *Client) RegisterDataNodeQuota(ctx context.Context, req *datapb.RegisterDataNodeQuotaRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.RegisterDataNodeQuota(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// StorageAudit reports the binlog objects not referenced by segment meta, and removes them unless it's a dry run
+func (c *Client) StorageAudit(ctx context.Context, req *datapb.StorageAuditRequest) (*datapb.StorageAuditResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.StorageAudit(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.StorageAuditResponse), err
+}
+
+// SampledSegmentInspector returns the statistics of fields over a random sample of rows in a segment
+func (c *Client) SampledSegmentInspector(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.SampledSegmentInspector(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.SampleSegmentResponse), err
+}
+
+// GetStorageUsage returns the storage usage of collections grouped by field and log type
+func (c *Client) GetStorageUsage(ctx context.Context, req *datapb.GetStorageUsageRequest) (*datapb.GetStorageUsageResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetStorageUsage(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetStorageUsageResponse), err
+}
+
+// CancelCompaction stops an executing compaction plan on DataNode and returns the final state of the plan
+func (c *Client) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.CancelCompaction(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.CancelCompactionResponse), err
+}
+
+// GetGCEvents returns the latest objects removed by garbage collection and storage audit
+func (c *Client) GetGCEvents(ctx context.Context, req *datapb.GetGCEventsRequest) (*datapb.GetGCEventsResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetGCEvents(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetGCEventsResponse), err
+}
+
+// ReportSegmentError is called by DataNode once it stops saving binlog paths of a segment after consecutive failures
+func (c *Client) ReportSegmentError(ctx context.Context, req *datapb.ReportSegmentErrorRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.ReportSegmentError(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// SetCollectionProperty sets properties of a collection managed by DataCoord, e.g. the data retention policy
+func (c *Client) SetCollectionProperty(ctx context.Context, req *datapb.SetCollectionPropertyRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.SetCollectionProperty(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// ComputeSegmentOverlap quantifies the primary key range overlap between flushed segments of a partition
+func (c *Client) ComputeSegmentOverlap(ctx context.Context, req *datapb.ComputeSegmentOverlapRequest) (*datapb.ComputeSegmentOverlapResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.ComputeSegmentOverlap(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.ComputeSegmentOverlapResponse), err
+}
+
+// GetCompactionROI returns the bytes read and written by the compaction plans completed
+func (c *Client) GetCompactionROI(ctx context.Context, req *datapb.GetCompactionROIRequest) (*datapb.GetCompactionROIResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetCompactionROI(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetCompactionROIResponse), err
+}
+
+// FreezePartition rejects new segment allocations of a partition until it's unfrozen or the freeze times out
+func (c *Client) FreezePartition(ctx context.Context, req *datapb.FreezePartitionRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.FreezePartition(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// UnfreezePartition resumes segment allocations of a frozen partition
+func (c *Client) UnfreezePartition(ctx context.Context, req *datapb.UnfreezePartitionRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.UnfreezePartition(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// GetSegmentPath returns the object storage paths of all binlogs of a segment, with the endpoint and bucket to access them
+func (c *Client) GetSegmentPath(ctx context.Context, req *datapb.GetSegmentPathRequest) (*datapb.GetSegmentPathResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetSegmentPath(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetSegmentPathResponse), err
+}
+
+// GetSegmentsForCollection returns all segments of the collection grouped by state, in pages of segments sorted by ID
+func (c *Client) GetSegmentsForCollection(ctx context.Context, req *datapb.GetSegmentsForCollectionRequest) (*datapb.GetSegmentsForCollectionResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetSegmentsForCollection(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetSegmentsForCollectionResponse), err
+}
+
+// ReportDataNodeHealth reports the vchannels of a DataNode failing to start
+func (c *Client) ReportDataNodeHealth(ctx context.Context, req *datapb.ReportDataNodeHealthRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.ReportDataNodeHealth(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// GetSegmentLineageDOT returns the compaction lineage of segments of the collection as a Graphviz DOT graph
+func (c *Client) GetSegmentLineageDOT(ctx context.Context, req *datapb.GetSegmentLineageDOTRequest) (*datapb.GetSegmentLineageDOTResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetSegmentLineageDOT(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetSegmentLineageDOTResponse), err
+}
+
+// ReadSegment streams a sample of rows of a flushed segment into stream for debugging,
+// the admin token is passed in the outgoing metadata of the context of stream
+func (c *Client) ReadSegment(req *datapb.ReadSegmentRequest, stream datapb.DataCoord_ReadSegmentServer) error {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.ReadSegment(stream.Context(), req)
+	})
+	if err != nil || ret == nil {
+		return err
+	}
+	reader := ret.(datapb.DataCoord_ReadSegmentClient)
+	for {
+		resp, err := reader.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// DiscardSegment drops an empty growing segment allocated ahead of time by a DataNode and never used
+func (c *Client) DiscardSegment(ctx context.Context, req *datapb.DiscardSegmentRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.DiscardSegment(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// GetChannelSegmentStats returns the compaction related statistics of segments of a vchannel
+func (c *Client) GetChannelSegmentStats(ctx context.Context, req *datapb.GetChannelSegmentStatsRequest) (*datapb.GetChannelSegmentStatsResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetChannelSegmentStats(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetChannelSegmentStatsResponse), err
+}
+
+// GetFlushProgress returns the flush progress of the segments of a collection
+func (c *Client) GetFlushProgress(ctx context.Context, req *datapb.GetFlushProgressRequest) (*datapb.FlushProgressResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetFlushProgress(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.FlushProgressResponse), err
+}
+
+// CancelFlush reopens the segments sealed by Flush unless they start flushing
+func (c *Client) CancelFlush(ctx context.Context, req *datapb.CancelFlushRequest) (*datapb.CancelFlushResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.CancelFlush(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.CancelFlushResponse), err
+}
+
+// PauseCompaction stops dispatching new compaction plans until ResumeCompaction is called
+func (c *Client) PauseCompaction(ctx context.Context, req *datapb.PauseCompactionRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.PauseCompaction(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// ResumeCompaction dispatches compaction plans again after PauseCompaction
+func (c *Client) ResumeCompaction(ctx context.Context, req *datapb.ResumeCompactionRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.ResumeCompaction(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
@@ -19,6 +19,7 @@ package grpcdatacoordclient
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -114,6 +115,177 @@ func (m *MockDataCoordClient) WatchChannels(ctx context.Context, req *datapb.Wat
 	return &datapb.WatchChannelsResponse{}, m.err
 }
 
+func (m *MockDataCoordClient) GetChannelHistory(ctx context.Context, req *datapb.GetChannelHistoryRequest, opts ...grpc.CallOption) (*datapb.GetChannelHistoryResponse, error) {
+	return &datapb.GetChannelHistoryResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) ImportSegmentManifest(ctx context.Context, req *datapb.ImportManifestRequest, opts ...grpc.CallOption) (*datapb.ImportManifestResponse, error) {
+	return &datapb.ImportManifestResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) GetCompactionScoreCard(ctx context.Context, req *datapb.GetCompactionScoreCardRequest, opts ...grpc.CallOption) (*datapb.GetCompactionScoreCardResponse, error) {
+	return &datapb.GetCompactionScoreCardResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) WatchChannelsV2(ctx context.Context, req *datapb.WatchChannelsV2Request, opts ...grpc.CallOption) (*datapb.WatchChannelsResponse, error) {
+	return &datapb.WatchChannelsResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) MigrateChannel(ctx context.Context, req *datapb.MigrateChannelRequest, opts ...grpc.CallOption) (*datapb.MigrateChannelResponse, error) {
+	return &datapb.MigrateChannelResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) ListCompactionPlans(ctx context.Context, req *datapb.ListCompactionPlansRequest, opts ...grpc.CallOption) (*datapb.ListCompactionPlansResponse, error) {
+	return &datapb.ListCompactionPlansResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) GetFlushedSegmentsV2(ctx context.Context, req *datapb.GetFlushedSegmentsV2Request, opts ...grpc.CallOption) (*datapb.GetFlushedSegmentsV2Response, error) {
+	return &datapb.GetFlushedSegmentsV2Response{}, m.err
+}
+
+func (m *MockDataCoordClient) ReclaimFailedCompaction(ctx context.Context, req *datapb.ReclaimFailedCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) ListManagedCollections(ctx context.Context, req *datapb.ListManagedCollectionsRequest, opts ...grpc.CallOption) (*datapb.ListManagedCollectionsResponse, error) {
+	return &datapb.ListManagedCollectionsResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) MigrateEtcdPrefix(ctx context.Context, req *datapb.MigrateEtcdPrefixRequest, opts ...grpc.CallOption) (*datapb.MigrateEtcdPrefixResponse, error) {
+	return &datapb.MigrateEtcdPrefixResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) RegisterDataNodeQuota(ctx context.Context, req *datapb.RegisterDataNodeQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) StorageAudit(ctx context.Context, req *datapb.StorageAuditRequest, opts ...grpc.CallOption) (*datapb.StorageAuditResponse, error) {
+	return &datapb.StorageAuditResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) SampledSegmentInspector(ctx context.Context, req *datapb.SampleSegmentRequest, opts ...grpc.CallOption) (*datapb.SampleSegmentResponse, error) {
+	return &datapb.SampleSegmentResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) GetStorageUsage(ctx context.Context, req *datapb.GetStorageUsageRequest, opts ...grpc.CallOption) (*datapb.GetStorageUsageResponse, error) {
+	return &datapb.GetStorageUsageResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest, opts ...grpc.CallOption) (*datapb.CancelCompactionResponse, error) {
+	return &datapb.CancelCompactionResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) GetGCEvents(ctx context.Context, req *datapb.GetGCEventsRequest, opts ...grpc.CallOption) (*datapb.GetGCEventsResponse, error) {
+	return &datapb.GetGCEventsResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) ReportSegmentError(ctx context.Context, req *datapb.ReportSegmentErrorRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) SetCollectionProperty(ctx context.Context, req *datapb.SetCollectionPropertyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) ComputeSegmentOverlap(ctx context.Context, req *datapb.ComputeSegmentOverlapRequest, opts ...grpc.CallOption) (*datapb.ComputeSegmentOverlapResponse, error) {
+	return &datapb.ComputeSegmentOverlapResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) GetCompactionROI(ctx context.Context, req *datapb.GetCompactionROIRequest, opts ...grpc.CallOption) (*datapb.GetCompactionROIResponse, error) {
+	return &datapb.GetCompactionROIResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) FreezePartition(ctx context.Context, req *datapb.FreezePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) UnfreezePartition(ctx context.Context, req *datapb.UnfreezePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) GetSegmentPath(ctx context.Context, req *datapb.GetSegmentPathRequest, opts ...grpc.CallOption) (*datapb.GetSegmentPathResponse, error) {
+	return &datapb.GetSegmentPathResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) GetSegmentsForCollection(ctx context.Context, req *datapb.GetSegmentsForCollectionRequest, opts ...grpc.CallOption) (*datapb.GetSegmentsForCollectionResponse, error) {
+	return &datapb.GetSegmentsForCollectionResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) ReportDataNodeHealth(ctx context.Context, req *datapb.ReportDataNodeHealthRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) GetSegmentLineageDOT(ctx context.Context, req *datapb.GetSegmentLineageDOTRequest, opts ...grpc.CallOption) (*datapb.GetSegmentLineageDOTResponse, error) {
+	return &datapb.GetSegmentLineageDOTResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) ReadSegment(ctx context.Context, req *datapb.ReadSegmentRequest, opts ...grpc.CallOption) (datapb.DataCoord_ReadSegmentClient, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &mockReadSegmentClient{resps: []*datapb.ReadSegmentResponse{{}}}, nil
+}
+
+type mockReadSegmentClient struct {
+	grpc.ClientStream
+	resps []*datapb.ReadSegmentResponse
+}
+
+func (c *mockReadSegmentClient) Recv() (*datapb.ReadSegmentResponse, error) {
+	if len(c.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := c.resps[0]
+	c.resps = c.resps[1:]
+	return resp, nil
+}
+
+type mockReadSegmentServer struct {
+	grpc.ServerStream
+	resps []*datapb.ReadSegmentResponse
+}
+
+func (s *mockReadSegmentServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *mockReadSegmentServer) Send(resp *datapb.ReadSegmentResponse) error {
+	s.resps = append(s.resps, resp)
+	return nil
+}
+
+func (m *MockDataCoordClient) DiscardSegment(ctx context.Context, req *datapb.DiscardSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) GetChannelSegmentStats(ctx context.Context, req *datapb.GetChannelSegmentStatsRequest, opts ...grpc.CallOption) (*datapb.GetChannelSegmentStatsResponse, error) {
+	return &datapb.GetChannelSegmentStatsResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) GetFlushProgress(ctx context.Context, req *datapb.GetFlushProgressRequest, opts ...grpc.CallOption) (*datapb.FlushProgressResponse, error) {
+	return &datapb.FlushProgressResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) CancelFlush(ctx context.Context, req *datapb.CancelFlushRequest, opts ...grpc.CallOption) (*datapb.CancelFlushResponse, error) {
+	return &datapb.CancelFlushResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) PauseCompaction(ctx context.Context, req *datapb.PauseCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) ResumeCompaction(ctx context.Context, req *datapb.ResumeCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
 func Test_NewClient(t *testing.T) {
 	proxy.Params.InitOnce()
 
@@ -201,6 +373,112 @@ func Test_NewClient(t *testing.T) {
 
 		r20, err := client.WatchChannels(ctx, nil)
 		retCheck(retNotNil, r20, err)
+
+		r21, err := client.GetChannelHistory(ctx, nil)
+		retCheck(retNotNil, r21, err)
+
+		r22, err := client.ImportSegmentManifest(ctx, nil)
+		retCheck(retNotNil, r22, err)
+
+		r23, err := client.GetCompactionScoreCard(ctx, nil)
+		retCheck(retNotNil, r23, err)
+
+		r24, err := client.WatchChannelsV2(ctx, nil)
+		retCheck(retNotNil, r24, err)
+
+		r25, err := client.MigrateChannel(ctx, nil)
+		retCheck(retNotNil, r25, err)
+
+		r26, err := client.ListCompactionPlans(ctx, nil)
+		retCheck(retNotNil, r26, err)
+
+		r27, err := client.GetFlushedSegmentsV2(ctx, nil)
+		retCheck(retNotNil, r27, err)
+
+		r28, err := client.ReclaimFailedCompaction(ctx, nil)
+		retCheck(retNotNil, r28, err)
+
+		r29, err := client.PinSegments(ctx, nil)
+		retCheck(retNotNil, r29, err)
+
+		r30, err := client.UnpinSegments(ctx, nil)
+		retCheck(retNotNil, r30, err)
+
+		r31, err := client.ListManagedCollections(ctx, nil)
+		retCheck(retNotNil, r31, err)
+
+		r32, err := client.MigrateEtcdPrefix(ctx, nil)
+		retCheck(retNotNil, r32, err)
+
+		r33, err := client.RegisterDataNodeQuota(ctx, nil)
+		retCheck(retNotNil, r33, err)
+
+		r34, err := client.StorageAudit(ctx, nil)
+		retCheck(retNotNil, r34, err)
+
+		r35, err := client.SampledSegmentInspector(ctx, nil)
+		retCheck(retNotNil, r35, err)
+
+		r36, err := client.GetStorageUsage(ctx, nil)
+		retCheck(retNotNil, r36, err)
+
+		r37, err := client.CancelCompaction(ctx, nil)
+		retCheck(retNotNil, r37, err)
+
+		r38, err := client.GetGCEvents(ctx, nil)
+		retCheck(retNotNil, r38, err)
+
+		r39, err := client.ReportSegmentError(ctx, nil)
+		retCheck(retNotNil, r39, err)
+
+		r40, err := client.SetCollectionProperty(ctx, nil)
+		retCheck(retNotNil, r40, err)
+
+		r41, err := client.ComputeSegmentOverlap(ctx, nil)
+		retCheck(retNotNil, r41, err)
+
+		r42, err := client.GetCompactionROI(ctx, nil)
+		retCheck(retNotNil, r42, err)
+
+		r43, err := client.FreezePartition(ctx, nil)
+		retCheck(retNotNil, r43, err)
+
+		r44, err := client.UnfreezePartition(ctx, nil)
+		retCheck(retNotNil, r44, err)
+
+		r45, err := client.GetSegmentPath(ctx, nil)
+		retCheck(retNotNil, r45, err)
+
+		r46, err := client.GetSegmentsForCollection(ctx, nil)
+		retCheck(retNotNil, r46, err)
+
+		r47, err := client.ReportDataNodeHealth(ctx, nil)
+		retCheck(retNotNil, r47, err)
+
+		r48, err := client.GetSegmentLineageDOT(ctx, nil)
+		retCheck(retNotNil, r48, err)
+
+		sink := &mockReadSegmentServer{}
+		err = client.ReadSegment(nil, sink)
+		retCheck(retNotNil, sink.resps, err)
+
+		r49, err := client.DiscardSegment(ctx, nil)
+		retCheck(retNotNil, r49, err)
+
+		r50, err := client.GetChannelSegmentStats(ctx, nil)
+		retCheck(retNotNil, r50, err)
+
+		r51, err := client.GetFlushProgress(ctx, nil)
+		retCheck(retNotNil, r51, err)
+
+		r52, err := client.CancelFlush(ctx, nil)
+		retCheck(retNotNil, r52, err)
+
+		r53, err := client.PauseCompaction(ctx, nil)
+		retCheck(retNotNil, r53, err)
+
+		r54, err := client.ResumeCompaction(ctx, nil)
+		retCheck(retNotNil, r54, err)
 	}
 
 	client.getGrpcClient = func() (datapb.DataCoordClient, error) {
@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcdatacoordclient
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// saveBinlogPathsMethod is the full grpc method name of SaveBinlogPaths
+const saveBinlogPathsMethod = "/milvus.proto.data.DataCoord/SaveBinlogPaths"
+
+// compressionInterceptor compresses the requests of methods larger than threshold bytes with gzip,
+// grpc sets the grpc-encoding header so that the server decompresses them. Non-positive threshold disables it
+func compressionInterceptor(threshold int, methods ...string) grpc.UnaryClientInterceptor {
+	compressed := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		compressed[method] = struct{}{}
+	}
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := compressed[method]; ok && threshold > 0 {
+			if msg, ok := req.(proto.Message); ok && proto.Size(msg) > threshold {
+				opts = append(opts, grpc.UseCompressor(gzip.Name))
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcdatacoordclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/stats"
+)
+
+// genSaveBinlogPathsRequest generates a request of a segment with numOfFields fields, each of which has numOfBinlogs binlogs
+func genSaveBinlogPathsRequest(numOfFields, numOfBinlogs int) *datapb.SaveBinlogPathsRequest {
+	req := &datapb.SaveBinlogPathsRequest{
+		SegmentID:    434343434343434343,
+		CollectionID: 434343434343434341,
+	}
+	for fieldID := 100; fieldID < 100+numOfFields; fieldID++ {
+		insertLogs := &datapb.FieldBinlog{FieldID: int64(fieldID)}
+		statsLogs := &datapb.FieldBinlog{FieldID: int64(fieldID)}
+		for i := 0; i < numOfBinlogs; i++ {
+			insertLogs.Binlogs = append(insertLogs.Binlogs,
+				fmt.Sprintf("files/insert_log/434343434343434341/434343434343434342/434343434343434343/%d/43434343434343%04d", fieldID, i))
+			statsLogs.Binlogs = append(statsLogs.Binlogs,
+				fmt.Sprintf("files/stats_log/434343434343434341/434343434343434342/434343434343434343/%d/43434343434343%04d", fieldID, i))
+		}
+		req.Field2BinlogPaths = append(req.Field2BinlogPaths, insertLogs)
+		req.Field2StatslogPaths = append(req.Field2StatslogPaths, statsLogs)
+	}
+	return req
+}
+
+func TestCompressionInterceptor(t *testing.T) {
+	large := genSaveBinlogPathsRequest(100, 1)
+	small := genSaveBinlogPathsRequest(1, 1)
+	threshold := proto.Size(small)
+
+	compressed := func(interceptor grpc.UnaryClientInterceptor, method string, req interface{}) bool {
+		var ret bool
+		err := interceptor(context.TODO(), method, req, nil, nil,
+			func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				for _, opt := range opts {
+					if c, ok := opt.(grpc.CompressorCallOption); ok && c.CompressorType == gzip.Name {
+						ret = true
+					}
+				}
+				return nil
+			})
+		assert.Nil(t, err)
+		return ret
+	}
+
+	interceptor := compressionInterceptor(threshold, saveBinlogPathsMethod)
+	assert.True(t, compressed(interceptor, saveBinlogPathsMethod, large))
+	assert.False(t, compressed(interceptor, saveBinlogPathsMethod, small))
+	assert.False(t, compressed(interceptor, "/milvus.proto.data.DataCoord/Flush", large))
+	assert.False(t, compressed(compressionInterceptor(0, saveBinlogPathsMethod), saveBinlogPathsMethod, large))
+}
+
+type mockSaveBinlogPathsServer struct {
+	datapb.UnimplementedDataCoordServer
+	received *datapb.SaveBinlogPathsRequest
+}
+
+func (s *mockSaveBinlogPathsServer) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
+	s.received = req
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+// wireSizeRecorder records the wire length of the last payload received by the server
+type wireSizeRecorder struct {
+	wireLength int64
+}
+
+func (r *wireSizeRecorder) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (r *wireSizeRecorder) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	if in, ok := s.(*stats.InPayload); ok {
+		atomic.StoreInt64(&r.wireLength, int64(in.WireLength))
+	}
+}
+
+func (r *wireSizeRecorder) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (r *wireSizeRecorder) HandleConn(ctx context.Context, s stats.ConnStats) {}
+
+// startCompressionTestServer serves a DataCoord receiving SaveBinlogPaths, and returns a client
+// compressing requests larger than threshold
+func startCompressionTestServer(tb testing.TB, threshold int) (datapb.DataCoordClient, *mockSaveBinlogPathsServer, *wireSizeRecorder) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(tb, err)
+	recorder := &wireSizeRecorder{}
+	svr := &mockSaveBinlogPathsServer{}
+	grpcServer := grpc.NewServer(grpc.StatsHandler(recorder))
+	datapb.RegisterDataCoordServer(grpcServer, svr)
+	go grpcServer.Serve(lis)
+	tb.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(compressionInterceptor(threshold, saveBinlogPathsMethod)))
+	require.NoError(tb, err)
+	tb.Cleanup(func() { conn.Close() })
+	return datapb.NewDataCoordClient(conn), svr, recorder
+}
+
+func TestCompressionInterceptor_decompressedByServer(t *testing.T) {
+	client, svr, recorder := startCompressionTestServer(t, 1024)
+	req := genSaveBinlogPathsRequest(100, 10)
+
+	status, err := client.SaveBinlogPaths(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.True(t, proto.Equal(req, svr.received))
+	assert.Less(t, atomic.LoadInt64(&recorder.wireLength), int64(proto.Size(req)))
+}
+
+func BenchmarkSaveBinlogPathsCompression(b *testing.B) {
+	req := genSaveBinlogPathsRequest(100, 10)
+	for _, threshold := range []int{0, 65536} {
+		b.Run(fmt.Sprintf("threshold-%d", threshold), func(b *testing.B) {
+			client, _, recorder := startCompressionTestServer(b, threshold)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := client.SaveBinlogPaths(context.TODO(), req); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(proto.Size(req)), "request-bytes")
+			b.ReportMetric(float64(atomic.LoadInt64(&recorder.wireLength)), "wire-bytes")
+		})
+	}
+}
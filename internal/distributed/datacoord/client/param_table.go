@@ -34,6 +34,9 @@ type ParamTable struct {
 
 	ClientMaxSendSize int
 	ClientMaxRecvSize int
+
+	// SaveBinlogPaths requests larger than it are compressed with gzip, non-positive value means no compression
+	GRPCCompressionThresholdBytes int
 }
 
 // Params is a package scoped variable of type ParamTable.
@@ -48,6 +51,7 @@ func (pt *ParamTable) Init() {
 
 		pt.initClientMaxSendSize()
 		pt.initClientMaxRecvSize()
+		pt.initGRPCCompressionThresholdBytes()
 	})
 }
 
@@ -96,3 +100,9 @@ func (pt *ParamTable) initClientMaxRecvSize() {
 	log.Debug("initClientMaxRecvSize",
 		zap.Int("dataCoord.grpc.clientMaxRecvSize", pt.ClientMaxRecvSize))
 }
+
+func (pt *ParamTable) initGRPCCompressionThresholdBytes() {
+	pt.GRPCCompressionThresholdBytes = pt.ParseIntWithDefault("dataCoord.grpc.clientCompressionThreshold", 65536)
+	log.Debug("initGRPCCompressionThresholdBytes",
+		zap.Int("dataCoord.grpc.clientCompressionThreshold", pt.GRPCCompressionThresholdBytes))
+}
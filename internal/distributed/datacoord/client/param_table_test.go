@@ -40,4 +40,6 @@ func TestParamTable(t *testing.T) {
 	assert.Nil(t, err)
 	Params.initClientMaxRecvSize()
 	assert.Equal(t, Params.ClientMaxRecvSize, grpcconfigs.DefaultClientMaxRecvSize)
+
+	assert.Equal(t, 65536, Params.GRPCCompressionThresholdBytes)
 }
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcdatacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"google.golang.org/grpc"
+)
+
+// RPCPriority is the priority tier of a DataCoord rpc used by load shedding
+type RPCPriority int
+
+const (
+	// RPCPriorityLow rpcs are rejected once inflight rpcs exceed MaxInflightRPCs
+	RPCPriorityLow RPCPriority = iota
+	// RPCPriorityNormal rpcs are rejected once inflight rpcs exceed twice of MaxInflightRPCs
+	RPCPriorityNormal
+	// RPCPriorityCritical rpcs are never rejected
+	RPCPriorityCritical
+)
+
+var statusType = reflect.TypeOf(&commonpb.Status{})
+
+// loadShedder rejects rpcs by priority when there are too many inflight rpcs
+type loadShedder struct {
+	maxInflight int64
+	priorities  map[string]RPCPriority // method name => priority
+	inflight    int64
+
+	respTypes sync.Map // full method => reflect.Type of response
+}
+
+func newLoadShedder(maxInflight int, priorities map[string]RPCPriority) *loadShedder {
+	return &loadShedder{
+		maxInflight: int64(maxInflight),
+		priorities:  priorities,
+	}
+}
+
+// priority returns the priority of method, rpcs not configured are normal priority
+func (ls *loadShedder) priority(fullMethod string) RPCPriority {
+	if p, ok := ls.priorities[path.Base(fullMethod)]; ok {
+		return p
+	}
+	return RPCPriorityNormal
+}
+
+// shouldShed checks whether a rpc of priority p shall be rejected with inflight rpcs
+func (ls *loadShedder) shouldShed(p RPCPriority, inflight int64) bool {
+	if ls.maxInflight <= 0 {
+		return false
+	}
+	switch p {
+	case RPCPriorityLow:
+		return inflight > ls.maxInflight
+	case RPCPriorityNormal:
+		return inflight > 2*ls.maxInflight
+	default:
+		return false
+	}
+}
+
+// unaryServerInterceptor returns a grpc.UnaryServerInterceptor applying load shedding
+func (ls *loadShedder) unaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		inflight := atomic.AddInt64(&ls.inflight, 1)
+		defer atomic.AddInt64(&ls.inflight, -1)
+
+		if ls.shouldShed(ls.priority(info.FullMethod), inflight) {
+			metrics.DataCoordRejectedRPCCounter.WithLabelValues(path.Base(info.FullMethod)).Inc()
+			return ls.busyResponse(info.Server, info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// busyResponse builds a response of the method whose status is ErrorCode_Busy,
+// the response type is derived from the method signature of the grpc server
+func (ls *loadShedder) busyResponse(server interface{}, fullMethod string) (interface{}, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Busy,
+		Reason:    "DataCoord is overloaded, please retry later",
+	}
+	respType, err := ls.responseType(server, fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if respType == statusType {
+		return status, nil
+	}
+	resp := reflect.New(respType.Elem())
+	field := resp.Elem().FieldByName("Status")
+	if !field.IsValid() || field.Type() != statusType {
+		return nil, fmt.Errorf("response of %s has no status field", fullMethod)
+	}
+	field.Set(reflect.ValueOf(status))
+	return resp.Interface(), nil
+}
+
+func (ls *loadShedder) responseType(server interface{}, fullMethod string) (reflect.Type, error) {
+	if t, ok := ls.respTypes.Load(fullMethod); ok {
+		return t.(reflect.Type), nil
+	}
+	method := reflect.ValueOf(server).MethodByName(path.Base(fullMethod))
+	if !method.IsValid() || method.Type().NumOut() != 2 || method.Type().Out(0).Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("method %s not found", fullMethod)
+	}
+	t := method.Type().Out(0)
+	ls.respTypes.Store(fullMethod, t)
+	return t, nil
+}
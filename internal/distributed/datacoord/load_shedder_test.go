@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcdatacoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+const dataCoordService = "/milvus.proto.data.DataCoord/"
+
+var testRPCPriorities = map[string]RPCPriority{
+	"AssignSegmentID":         RPCPriorityCritical,
+	"SaveBinlogPaths":         RPCPriorityCritical,
+	"GetRecoveryInfo":         RPCPriorityCritical,
+	"GetMetrics":              RPCPriorityLow,
+	"GetCollectionStatistics": RPCPriorityLow,
+}
+
+func TestLoadShedder_Priority(t *testing.T) {
+	ls := newLoadShedder(10, testRPCPriorities)
+	assert.Equal(t, RPCPriorityCritical, ls.priority(dataCoordService+"SaveBinlogPaths"))
+	assert.Equal(t, RPCPriorityLow, ls.priority(dataCoordService+"GetMetrics"))
+	assert.Equal(t, RPCPriorityNormal, ls.priority(dataCoordService+"Flush"))
+
+	assert.False(t, ls.shouldShed(RPCPriorityLow, 10))
+	assert.True(t, ls.shouldShed(RPCPriorityLow, 11))
+	assert.False(t, ls.shouldShed(RPCPriorityNormal, 20))
+	assert.True(t, ls.shouldShed(RPCPriorityNormal, 21))
+	assert.False(t, ls.shouldShed(RPCPriorityCritical, 1000))
+
+	unlimited := newLoadShedder(0, testRPCPriorities)
+	assert.False(t, unlimited.shouldShed(RPCPriorityLow, 1000))
+}
+
+func TestLoadShedder_BusyResponse(t *testing.T) {
+	ls := newLoadShedder(10, testRPCPriorities)
+	server := &Server{}
+
+	resp, err := ls.busyResponse(server, dataCoordService+"GetMetrics")
+	assert.Nil(t, err)
+	metricsResp, ok := resp.(*milvuspb.GetMetricsResponse)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.ErrorCode_Busy, metricsResp.GetStatus().GetErrorCode())
+
+	resp, err = ls.busyResponse(server, dataCoordService+"GetCollectionStatistics")
+	assert.Nil(t, err)
+	statsResp, ok := resp.(*datapb.GetCollectionStatisticsResponse)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.ErrorCode_Busy, statsResp.GetStatus().GetErrorCode())
+
+	resp, err = ls.busyResponse(server, dataCoordService+"SaveBinlogPaths")
+	assert.Nil(t, err)
+	status, ok := resp.(*commonpb.Status)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.ErrorCode_Busy, status.GetErrorCode())
+
+	_, err = ls.busyResponse(server, dataCoordService+"NotExist")
+	assert.NotNil(t, err)
+}
+
+func TestLoadShedder_Interceptor(t *testing.T) {
+	ls := newLoadShedder(1, testRPCPriorities)
+	interceptor := ls.unaryServerInterceptor()
+	server := &Server{}
+
+	block := make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		_, _ = interceptor(context.TODO(), nil, &grpc.UnaryServerInfo{Server: server, FullMethod: dataCoordService + "Flush"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				close(started)
+				<-block
+				return &datapb.FlushResponse{}, nil
+			})
+	}()
+	<-started
+	defer close(block)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	resp, err := interceptor(context.TODO(), nil, &grpc.UnaryServerInfo{Server: server, FullMethod: dataCoordService + "GetMetrics"}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Busy, resp.(*milvuspb.GetMetricsResponse).GetStatus().GetErrorCode())
+
+	resp, err = interceptor(context.TODO(), nil, &grpc.UnaryServerInfo{Server: server, FullMethod: dataCoordService + "SaveBinlogPaths"}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.(*commonpb.Status).GetErrorCode())
+}
+
+// TestLoadShedder_Overload simulates a server able to process maxInflight rpcs concurrently,
+// with low priority load of twice of its capacity, critical rpcs shall still be served in time
+func TestLoadShedder_Overload(t *testing.T) {
+	const (
+		maxInflight = 16
+		serviceTime = 10 * time.Millisecond
+		duration    = time.Second
+	)
+	ls := newLoadShedder(maxInflight, testRPCPriorities)
+	interceptor := ls.unaryServerInterceptor()
+	server := &Server{}
+
+	workers := make(chan struct{}, maxInflight)
+	process := func() {
+		workers <- struct{}{}
+		defer func() { <-workers }()
+		time.Sleep(serviceTime)
+	}
+	lowHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		process()
+		return &milvuspb.GetMetricsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+	}
+	criticalHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		process()
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	defer cancel()
+	var wg sync.WaitGroup
+	for i := 0; i < 2*maxInflight; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				resp, _ := interceptor(ctx, nil, &grpc.UnaryServerInfo{Server: server, FullMethod: dataCoordService + "GetMetrics"}, lowHandler)
+				if resp.(*milvuspb.GetMetricsResponse).GetStatus().GetErrorCode() == commonpb.ErrorCode_Busy {
+					// back off like a client would do
+					time.Sleep(time.Millisecond)
+				}
+			}
+		}()
+	}
+
+	var latencies []time.Duration
+	for ctx.Err() == nil {
+		start := time.Now()
+		resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{Server: server, FullMethod: dataCoordService + "SaveBinlogPaths"}, criticalHandler)
+		latencies = append(latencies, time.Since(start))
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.(*commonpb.Status).GetErrorCode())
+	}
+	wg.Wait()
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	p99 := latencies[len(latencies)*99/100]
+	t.Logf("critical rpcs: %d, p99 latency: %v", len(latencies), p99)
+	assert.Less(t, int64(p99), int64(100*time.Millisecond))
+}
@@ -18,6 +18,7 @@ package grpcdatacoord
 
 import (
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/distributed/grpcconfigs"
@@ -38,6 +39,9 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+
+	MaxInflightRPCs int
+	RPCPriorities   map[string]RPCPriority
 }
 
 // Params is a package scoped variable of type ParamTable.
@@ -61,6 +65,9 @@ func (pt *ParamTable) initParams() {
 
 	pt.initServerMaxSendSize()
 	pt.initServerMaxRecvSize()
+
+	pt.initMaxInflightRPCs()
+	pt.initRPCPriorities()
 }
 
 func (pt *ParamTable) loadFromEnv() {
@@ -121,3 +128,26 @@ func (pt *ParamTable) initServerMaxRecvSize() {
 	log.Debug("initServerMaxRecvSize",
 		zap.Int("dataCoord.grpc.serverMaxRecvSize", pt.ServerMaxRecvSize))
 }
+
+func (pt *ParamTable) initMaxInflightRPCs() {
+	pt.MaxInflightRPCs = pt.ParseIntWithDefault("dataCoord.grpc.maxInflightRPCs", 2048)
+}
+
+func (pt *ParamTable) initRPCPriorities() {
+	pt.RPCPriorities = make(map[string]RPCPriority)
+	tiers := []struct {
+		key      string
+		value    string
+		priority RPCPriority
+	}{
+		{"dataCoord.grpc.rpcPriority.critical", "AssignSegmentID,SaveBinlogPaths,GetRecoveryInfo", RPCPriorityCritical},
+		{"dataCoord.grpc.rpcPriority.low", "GetMetrics,GetCollectionStatistics,GetPartitionStatistics", RPCPriorityLow},
+	}
+	for _, tier := range tiers {
+		for _, method := range strings.Split(pt.LoadWithDefault(tier.key, tier.value), ",") {
+			if method = strings.TrimSpace(method); method != "" {
+				pt.RPCPriorities[method] = tier.priority
+			}
+		}
+	}
+}
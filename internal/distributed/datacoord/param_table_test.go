@@ -44,4 +44,8 @@ func TestParamTable(t *testing.T) {
 	assert.Nil(t, err)
 	Params.initServerMaxRecvSize()
 	assert.Equal(t, Params.ServerMaxRecvSize, grpcconfigs.DefaultServerMaxRecvSize)
+
+	assert.Equal(t, RPCPriorityCritical, Params.RPCPriorities["SaveBinlogPaths"])
+	assert.Equal(t, RPCPriorityLow, Params.RPCPriorities["GetMetrics"])
+	t.Logf("DataCoord MaxInflightRPCs:%d", Params.MaxInflightRPCs)
 }
@@ -21,6 +21,7 @@ import (
 	"context"
 	"io"
 	"net"
+	"net/http"
 	"strconv"
 	"sync"
 
@@ -30,6 +31,8 @@ import (
 	"go.uber.org/zap"
 
 	"google.golang.org/grpc"
+	// registers the gzip compressor, so that requests compressed by DataNodes are decompressed automatically
+	_ "google.golang.org/grpc/encoding/gzip"
 
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -133,7 +136,8 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(
+		grpc.ChainUnaryInterceptor(
+			newLoadShedder(Params.MaxInflightRPCs, Params.RPCPriorities).unaryServerInterceptor(),
 			grpc_opentracing.UnaryServerInterceptor(opts...)),
 		grpc.StreamInterceptor(
 			grpc_opentracing.StreamServerInterceptor(opts...)))
@@ -189,6 +193,15 @@ func (s *Server) Run() error {
 	return nil
 }
 
+// DashboardHandler returns the http handler serving the dashboard payload of datacoord,
+// nil if datacoord doesn't serve one
+func (s *Server) DashboardHandler() http.Handler {
+	if dc, ok := s.dataCoord.(interface{ DashboardHandler() http.Handler }); ok {
+		return dc.DashboardHandler()
+	}
+	return nil
+}
+
 // GetComponentStates gets states of datacoord and datanodes
 func (s *Server) GetComponentStates(ctx context.Context, req *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
 	return s.dataCoord.GetComponentStates(ctx)
@@ -286,3 +299,178 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return s.dataCoord.WatchChannels(ctx, req)
 }
+
+// GetChannelHistory returns the watcher assignment events of a vchannel
+func (s *Server) GetChannelHistory(ctx context.Context, req *datapb.GetChannelHistoryRequest) (*datapb.GetChannelHistoryResponse, error) {
+	return s.dataCoord.GetChannelHistory(ctx, req)
+}
+
+// ImportSegmentManifest registers flushed segments described by an external manifest
+func (s *Server) ImportSegmentManifest(ctx context.Context, req *datapb.ImportManifestRequest) (*datapb.ImportManifestResponse, error) {
+	return s.dataCoord.ImportSegmentManifest(ctx, req)
+}
+
+// GetCompactionScoreCard explains whether a segment is eligible for each compaction policy
+func (s *Server) GetCompactionScoreCard(ctx context.Context, req *datapb.GetCompactionScoreCardRequest) (*datapb.GetCompactionScoreCardResponse, error) {
+	return s.dataCoord.GetCompactionScoreCard(ctx, req)
+}
+
+// WatchChannelsV2 notifies DataCoord to watch vchannels of a collection from the specified seek positions
+func (s *Server) WatchChannelsV2(ctx context.Context, req *datapb.WatchChannelsV2Request) (*datapb.WatchChannelsResponse, error) {
+	return s.dataCoord.WatchChannelsV2(ctx, req)
+}
+
+// MigrateChannel moves a channel from the DataNode watching it to another one
+func (s *Server) MigrateChannel(ctx context.Context, req *datapb.MigrateChannelRequest) (*datapb.MigrateChannelResponse, error) {
+	return s.dataCoord.MigrateChannel(ctx, req)
+}
+
+// ListCompactionPlans lists compaction plans of all collections, filtered and paged by the request
+func (s *Server) ListCompactionPlans(ctx context.Context, req *datapb.ListCompactionPlansRequest) (*datapb.ListCompactionPlansResponse, error) {
+	return s.dataCoord.ListCompactionPlans(ctx, req)
+}
+
+// GetFlushedSegmentsV2 returns segments of the collection in the requested states page by page
+func (s *Server) GetFlushedSegmentsV2(ctx context.Context, req *datapb.GetFlushedSegmentsV2Request) (*datapb.GetFlushedSegmentsV2Response, error) {
+	return s.dataCoord.GetFlushedSegmentsV2(ctx, req)
+}
+
+// ReclaimFailedCompaction removes output binlogs of a failed compaction plan
+func (s *Server) ReclaimFailedCompaction(ctx context.Context, req *datapb.ReclaimFailedCompactionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ReclaimFailedCompaction(ctx, req)
+}
+
+// PinSegments marks segments pinned so that compaction never merges them with other segments
+func (s *Server) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.PinSegments(ctx, req)
+}
+
+// UnpinSegments clears the pinned flag of segments
+func (s *Server) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.UnpinSegments(ctx, req)
+}
+
+// ListManagedCollections lists the collections in DataCoord meta with a summary of their segments
+func (s *Server) ListManagedCollections(ctx context.Context, req *datapb.ListManagedCollectionsRequest) (*datapb.ListManagedCollectionsResponse, error) {
+	return s.dataCoord.ListManagedCollections(ctx, req)
+}
+
+// MigrateEtcdPrefix moves all etcd keys under the old prefix to the new prefix
+func (s *Server) MigrateEtcdPrefix(ctx context.Context, req *datapb.MigrateEtcdPrefixRequest) (*datapb.MigrateEtcdPrefixResponse, error) {
+	return s.dataCoord.MigrateEtcdPrefix(ctx, req)
+}
+
+// RegisterDataNodeQuota registers the resource quota of a DataNode, which limits the channels assigned to it
+func (s *Server) RegisterDataNodeQuota(ctx context.Context, req *datapb.RegisterDataNodeQuotaRequest) (*commonpb.Status, error) {
+	return s.dataCoord.RegisterDataNodeQuota(ctx, req)
+}
+
+// StorageAudit reports the binlog objects not referenced by segment meta, and removes them unless it's a dry run
+func (s *Server) StorageAudit(ctx context.Context, req *datapb.StorageAuditRequest) (*datapb.StorageAuditResponse, error) {
+	return s.dataCoord.StorageAudit(ctx, req)
+}
+
+// SampledSegmentInspector returns the statistics of fields over a random sample of rows in a segment
+func (s *Server) SampledSegmentInspector(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	return s.dataCoord.SampledSegmentInspector(ctx, req)
+}
+
+// GetStorageUsage returns the storage usage of collections grouped by field and log type
+func (s *Server) GetStorageUsage(ctx context.Context, req *datapb.GetStorageUsageRequest) (*datapb.GetStorageUsageResponse, error) {
+	return s.dataCoord.GetStorageUsage(ctx, req)
+}
+
+// CancelCompaction stops an executing compaction plan on DataNode and returns the final state of the plan
+func (s *Server) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	return s.dataCoord.CancelCompaction(ctx, req)
+}
+
+// GetGCEvents returns the latest objects removed by garbage collection and storage audit
+func (s *Server) GetGCEvents(ctx context.Context, req *datapb.GetGCEventsRequest) (*datapb.GetGCEventsResponse, error) {
+	return s.dataCoord.GetGCEvents(ctx, req)
+}
+
+// ReportSegmentError is called by DataNode once it stops saving binlog paths of a segment after consecutive failures
+func (s *Server) ReportSegmentError(ctx context.Context, req *datapb.ReportSegmentErrorRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ReportSegmentError(ctx, req)
+}
+
+// SetCollectionProperty sets properties of a collection managed by DataCoord, e.g. the data retention policy
+func (s *Server) SetCollectionProperty(ctx context.Context, req *datapb.SetCollectionPropertyRequest) (*commonpb.Status, error) {
+	return s.dataCoord.SetCollectionProperty(ctx, req)
+}
+
+// ComputeSegmentOverlap quantifies the primary key range overlap between flushed segments of a partition
+func (s *Server) ComputeSegmentOverlap(ctx context.Context, req *datapb.ComputeSegmentOverlapRequest) (*datapb.ComputeSegmentOverlapResponse, error) {
+	return s.dataCoord.ComputeSegmentOverlap(ctx, req)
+}
+
+// GetCompactionROI returns the bytes read and written by the compaction plans completed
+func (s *Server) GetCompactionROI(ctx context.Context, req *datapb.GetCompactionROIRequest) (*datapb.GetCompactionROIResponse, error) {
+	return s.dataCoord.GetCompactionROI(ctx, req)
+}
+
+// FreezePartition rejects new segment allocations of a partition until it's unfrozen or the freeze times out
+func (s *Server) FreezePartition(ctx context.Context, req *datapb.FreezePartitionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.FreezePartition(ctx, req)
+}
+
+// UnfreezePartition resumes segment allocations of a frozen partition
+func (s *Server) UnfreezePartition(ctx context.Context, req *datapb.UnfreezePartitionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.UnfreezePartition(ctx, req)
+}
+
+// GetSegmentPath returns the object storage paths of all binlogs of a segment, with the endpoint and bucket to access them
+func (s *Server) GetSegmentPath(ctx context.Context, req *datapb.GetSegmentPathRequest) (*datapb.GetSegmentPathResponse, error) {
+	return s.dataCoord.GetSegmentPath(ctx, req)
+}
+
+// GetSegmentsForCollection returns all segments of the collection grouped by state, in pages of segments sorted by ID
+func (s *Server) GetSegmentsForCollection(ctx context.Context, req *datapb.GetSegmentsForCollectionRequest) (*datapb.GetSegmentsForCollectionResponse, error) {
+	return s.dataCoord.GetSegmentsForCollection(ctx, req)
+}
+
+// ReportDataNodeHealth reports the vchannels of a DataNode failing to start
+func (s *Server) ReportDataNodeHealth(ctx context.Context, req *datapb.ReportDataNodeHealthRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ReportDataNodeHealth(ctx, req)
+}
+
+// GetSegmentLineageDOT returns the compaction lineage of segments of the collection as a Graphviz DOT graph
+func (s *Server) GetSegmentLineageDOT(ctx context.Context, req *datapb.GetSegmentLineageDOTRequest) (*datapb.GetSegmentLineageDOTResponse, error) {
+	return s.dataCoord.GetSegmentLineageDOT(ctx, req)
+}
+
+// ReadSegment streams a sample of rows of a flushed segment for debugging, only admin requests are served
+func (s *Server) ReadSegment(req *datapb.ReadSegmentRequest, stream datapb.DataCoord_ReadSegmentServer) error {
+	return s.dataCoord.ReadSegment(req, stream)
+}
+
+// DiscardSegment drops an empty growing segment allocated ahead of time by a DataNode and never used
+func (s *Server) DiscardSegment(ctx context.Context, req *datapb.DiscardSegmentRequest) (*commonpb.Status, error) {
+	return s.dataCoord.DiscardSegment(ctx, req)
+}
+
+// GetChannelSegmentStats returns the compaction related statistics of segments of a vchannel
+func (s *Server) GetChannelSegmentStats(ctx context.Context, req *datapb.GetChannelSegmentStatsRequest) (*datapb.GetChannelSegmentStatsResponse, error) {
+	return s.dataCoord.GetChannelSegmentStats(ctx, req)
+}
+
+// GetFlushProgress returns the flush progress of the segments of a collection
+func (s *Server) GetFlushProgress(ctx context.Context, req *datapb.GetFlushProgressRequest) (*datapb.FlushProgressResponse, error) {
+	return s.dataCoord.GetFlushProgress(ctx, req)
+}
+
+// CancelFlush reopens the segments sealed by Flush unless they start flushing
+func (s *Server) CancelFlush(ctx context.Context, req *datapb.CancelFlushRequest) (*datapb.CancelFlushResponse, error) {
+	return s.dataCoord.CancelFlush(ctx, req)
+}
+
+// PauseCompaction stops dispatching new compaction plans until ResumeCompaction is called
+func (s *Server) PauseCompaction(ctx context.Context, req *datapb.PauseCompactionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.PauseCompaction(ctx, req)
+}
+
+// ResumeCompaction dispatches compaction plans again after PauseCompaction
+func (s *Server) ResumeCompaction(ctx context.Context, req *datapb.ResumeCompactionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ResumeCompaction(ctx, req)
+}
@@ -30,28 +30,62 @@ import (
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockDataCoord struct {
-	states               *internalpb.ComponentStates
-	status               *commonpb.Status
-	err                  error
-	initErr              error
-	startErr             error
-	stopErr              error
-	regErr               error
-	strResp              *milvuspb.StringResponse
-	infoResp             *datapb.GetSegmentInfoResponse
-	flushResp            *datapb.FlushResponse
-	assignResp           *datapb.AssignSegmentIDResponse
-	segStateResp         *datapb.GetSegmentStatesResponse
-	binResp              *datapb.GetInsertBinlogPathsResponse
-	colStatResp          *datapb.GetCollectionStatisticsResponse
-	partStatResp         *datapb.GetPartitionStatisticsResponse
-	recoverResp          *datapb.GetRecoveryInfoResponse
-	flushSegResp         *datapb.GetFlushedSegmentsResponse
-	metricResp           *milvuspb.GetMetricsResponse
-	compactionStateResp  *milvuspb.GetCompactionStateResponse
-	manualCompactionResp *milvuspb.ManualCompactionResponse
-	compactionPlansResp  *milvuspb.GetCompactionPlansResponse
-	watchChannelsResp    *datapb.WatchChannelsResponse
+	states                       *internalpb.ComponentStates
+	status                       *commonpb.Status
+	err                          error
+	initErr                      error
+	startErr                     error
+	stopErr                      error
+	regErr                       error
+	strResp                      *milvuspb.StringResponse
+	infoResp                     *datapb.GetSegmentInfoResponse
+	flushResp                    *datapb.FlushResponse
+	assignResp                   *datapb.AssignSegmentIDResponse
+	segStateResp                 *datapb.GetSegmentStatesResponse
+	binResp                      *datapb.GetInsertBinlogPathsResponse
+	colStatResp                  *datapb.GetCollectionStatisticsResponse
+	partStatResp                 *datapb.GetPartitionStatisticsResponse
+	recoverResp                  *datapb.GetRecoveryInfoResponse
+	flushSegResp                 *datapb.GetFlushedSegmentsResponse
+	metricResp                   *milvuspb.GetMetricsResponse
+	compactionStateResp          *milvuspb.GetCompactionStateResponse
+	manualCompactionResp         *milvuspb.ManualCompactionResponse
+	compactionPlansResp          *milvuspb.GetCompactionPlansResponse
+	watchChannelsResp            *datapb.WatchChannelsResponse
+	getChannelHistoryResp        *datapb.GetChannelHistoryResponse
+	importSegmentManifestResp    *datapb.ImportManifestResponse
+	getCompactionScoreCardResp   *datapb.GetCompactionScoreCardResponse
+	watchChannelsV2Resp          *datapb.WatchChannelsResponse
+	migrateChannelResp           *datapb.MigrateChannelResponse
+	listCompactionPlansResp      *datapb.ListCompactionPlansResponse
+	getFlushedSegmentsV2Resp     *datapb.GetFlushedSegmentsV2Response
+	reclaimFailedCompactionResp  *commonpb.Status
+	pinSegmentsResp              *commonpb.Status
+	unpinSegmentsResp            *commonpb.Status
+	listManagedCollectionsResp   *datapb.ListManagedCollectionsResponse
+	migrateEtcdPrefixResp        *datapb.MigrateEtcdPrefixResponse
+	registerDataNodeQuotaResp    *commonpb.Status
+	storageAuditResp             *datapb.StorageAuditResponse
+	sampledSegmentInspectorResp  *datapb.SampleSegmentResponse
+	getStorageUsageResp          *datapb.GetStorageUsageResponse
+	cancelCompactionResp         *datapb.CancelCompactionResponse
+	getGCEventsResp              *datapb.GetGCEventsResponse
+	reportSegmentErrorResp       *commonpb.Status
+	setCollectionPropertyResp    *commonpb.Status
+	computeSegmentOverlapResp    *datapb.ComputeSegmentOverlapResponse
+	getCompactionROIResp         *datapb.GetCompactionROIResponse
+	freezePartitionResp          *commonpb.Status
+	unfreezePartitionResp        *commonpb.Status
+	getSegmentPathResp           *datapb.GetSegmentPathResponse
+	getSegmentsForCollectionResp *datapb.GetSegmentsForCollectionResponse
+	reportDataNodeHealthResp     *commonpb.Status
+	getSegmentLineageDOTResp     *datapb.GetSegmentLineageDOTResponse
+	discardSegmentResp           *commonpb.Status
+	getChannelSegmentStatsResp   *datapb.GetChannelSegmentStatsResponse
+	getFlushProgressResp         *datapb.FlushProgressResponse
+	cancelFlushResp              *datapb.CancelFlushResponse
+	pauseCompactionResp          *commonpb.Status
+	resumeCompactionResp         *commonpb.Status
 }
 
 func (m *MockDataCoord) Init() error {
@@ -150,6 +184,146 @@ func (m *MockDataCoord) WatchChannels(ctx context.Context, req *datapb.WatchChan
 	return m.watchChannelsResp, m.err
 }
 
+func (m *MockDataCoord) GetChannelHistory(ctx context.Context, req *datapb.GetChannelHistoryRequest) (*datapb.GetChannelHistoryResponse, error) {
+	return m.getChannelHistoryResp, m.err
+}
+
+func (m *MockDataCoord) ImportSegmentManifest(ctx context.Context, req *datapb.ImportManifestRequest) (*datapb.ImportManifestResponse, error) {
+	return m.importSegmentManifestResp, m.err
+}
+
+func (m *MockDataCoord) GetCompactionScoreCard(ctx context.Context, req *datapb.GetCompactionScoreCardRequest) (*datapb.GetCompactionScoreCardResponse, error) {
+	return m.getCompactionScoreCardResp, m.err
+}
+
+func (m *MockDataCoord) WatchChannelsV2(ctx context.Context, req *datapb.WatchChannelsV2Request) (*datapb.WatchChannelsResponse, error) {
+	return m.watchChannelsV2Resp, m.err
+}
+
+func (m *MockDataCoord) MigrateChannel(ctx context.Context, req *datapb.MigrateChannelRequest) (*datapb.MigrateChannelResponse, error) {
+	return m.migrateChannelResp, m.err
+}
+
+func (m *MockDataCoord) ListCompactionPlans(ctx context.Context, req *datapb.ListCompactionPlansRequest) (*datapb.ListCompactionPlansResponse, error) {
+	return m.listCompactionPlansResp, m.err
+}
+
+func (m *MockDataCoord) GetFlushedSegmentsV2(ctx context.Context, req *datapb.GetFlushedSegmentsV2Request) (*datapb.GetFlushedSegmentsV2Response, error) {
+	return m.getFlushedSegmentsV2Resp, m.err
+}
+
+func (m *MockDataCoord) ReclaimFailedCompaction(ctx context.Context, req *datapb.ReclaimFailedCompactionRequest) (*commonpb.Status, error) {
+	return m.reclaimFailedCompactionResp, m.err
+}
+
+func (m *MockDataCoord) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*commonpb.Status, error) {
+	return m.pinSegmentsResp, m.err
+}
+
+func (m *MockDataCoord) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	return m.unpinSegmentsResp, m.err
+}
+
+func (m *MockDataCoord) ListManagedCollections(ctx context.Context, req *datapb.ListManagedCollectionsRequest) (*datapb.ListManagedCollectionsResponse, error) {
+	return m.listManagedCollectionsResp, m.err
+}
+
+func (m *MockDataCoord) MigrateEtcdPrefix(ctx context.Context, req *datapb.MigrateEtcdPrefixRequest) (*datapb.MigrateEtcdPrefixResponse, error) {
+	return m.migrateEtcdPrefixResp, m.err
+}
+
+func (m *MockDataCoord) RegisterDataNodeQuota(ctx context.Context, req *datapb.RegisterDataNodeQuotaRequest) (*commonpb.Status, error) {
+	return m.registerDataNodeQuotaResp, m.err
+}
+
+func (m *MockDataCoord) StorageAudit(ctx context.Context, req *datapb.StorageAuditRequest) (*datapb.StorageAuditResponse, error) {
+	return m.storageAuditResp, m.err
+}
+
+func (m *MockDataCoord) SampledSegmentInspector(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	return m.sampledSegmentInspectorResp, m.err
+}
+
+func (m *MockDataCoord) GetStorageUsage(ctx context.Context, req *datapb.GetStorageUsageRequest) (*datapb.GetStorageUsageResponse, error) {
+	return m.getStorageUsageResp, m.err
+}
+
+func (m *MockDataCoord) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	return m.cancelCompactionResp, m.err
+}
+
+func (m *MockDataCoord) GetGCEvents(ctx context.Context, req *datapb.GetGCEventsRequest) (*datapb.GetGCEventsResponse, error) {
+	return m.getGCEventsResp, m.err
+}
+
+func (m *MockDataCoord) ReportSegmentError(ctx context.Context, req *datapb.ReportSegmentErrorRequest) (*commonpb.Status, error) {
+	return m.reportSegmentErrorResp, m.err
+}
+
+func (m *MockDataCoord) SetCollectionProperty(ctx context.Context, req *datapb.SetCollectionPropertyRequest) (*commonpb.Status, error) {
+	return m.setCollectionPropertyResp, m.err
+}
+
+func (m *MockDataCoord) ComputeSegmentOverlap(ctx context.Context, req *datapb.ComputeSegmentOverlapRequest) (*datapb.ComputeSegmentOverlapResponse, error) {
+	return m.computeSegmentOverlapResp, m.err
+}
+
+func (m *MockDataCoord) GetCompactionROI(ctx context.Context, req *datapb.GetCompactionROIRequest) (*datapb.GetCompactionROIResponse, error) {
+	return m.getCompactionROIResp, m.err
+}
+
+func (m *MockDataCoord) FreezePartition(ctx context.Context, req *datapb.FreezePartitionRequest) (*commonpb.Status, error) {
+	return m.freezePartitionResp, m.err
+}
+
+func (m *MockDataCoord) UnfreezePartition(ctx context.Context, req *datapb.UnfreezePartitionRequest) (*commonpb.Status, error) {
+	return m.unfreezePartitionResp, m.err
+}
+
+func (m *MockDataCoord) GetSegmentPath(ctx context.Context, req *datapb.GetSegmentPathRequest) (*datapb.GetSegmentPathResponse, error) {
+	return m.getSegmentPathResp, m.err
+}
+
+func (m *MockDataCoord) GetSegmentsForCollection(ctx context.Context, req *datapb.GetSegmentsForCollectionRequest) (*datapb.GetSegmentsForCollectionResponse, error) {
+	return m.getSegmentsForCollectionResp, m.err
+}
+
+func (m *MockDataCoord) ReportDataNodeHealth(ctx context.Context, req *datapb.ReportDataNodeHealthRequest) (*commonpb.Status, error) {
+	return m.reportDataNodeHealthResp, m.err
+}
+
+func (m *MockDataCoord) GetSegmentLineageDOT(ctx context.Context, req *datapb.GetSegmentLineageDOTRequest) (*datapb.GetSegmentLineageDOTResponse, error) {
+	return m.getSegmentLineageDOTResp, m.err
+}
+
+func (m *MockDataCoord) ReadSegment(req *datapb.ReadSegmentRequest, stream datapb.DataCoord_ReadSegmentServer) error {
+	return m.err
+}
+
+func (m *MockDataCoord) DiscardSegment(ctx context.Context, req *datapb.DiscardSegmentRequest) (*commonpb.Status, error) {
+	return m.discardSegmentResp, m.err
+}
+
+func (m *MockDataCoord) GetChannelSegmentStats(ctx context.Context, req *datapb.GetChannelSegmentStatsRequest) (*datapb.GetChannelSegmentStatsResponse, error) {
+	return m.getChannelSegmentStatsResp, m.err
+}
+
+func (m *MockDataCoord) GetFlushProgress(ctx context.Context, req *datapb.GetFlushProgressRequest) (*datapb.FlushProgressResponse, error) {
+	return m.getFlushProgressResp, m.err
+}
+
+func (m *MockDataCoord) CancelFlush(ctx context.Context, req *datapb.CancelFlushRequest) (*datapb.CancelFlushResponse, error) {
+	return m.cancelFlushResp, m.err
+}
+
+func (m *MockDataCoord) PauseCompaction(ctx context.Context, req *datapb.PauseCompactionRequest) (*commonpb.Status, error) {
+	return m.pauseCompactionResp, m.err
+}
+
+func (m *MockDataCoord) ResumeCompaction(ctx context.Context, req *datapb.ResumeCompactionRequest) (*commonpb.Status, error) {
+	return m.resumeCompactionResp, m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 func Test_NewServer(t *testing.T) {
 	ctx := context.Background()
@@ -307,6 +481,318 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("GetChannelHistory", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getChannelHistoryResp: &datapb.GetChannelHistoryResponse{},
+		}
+		resp, err := server.GetChannelHistory(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ImportSegmentManifest", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			importSegmentManifestResp: &datapb.ImportManifestResponse{},
+		}
+		resp, err := server.ImportSegmentManifest(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetCompactionScoreCard", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getCompactionScoreCardResp: &datapb.GetCompactionScoreCardResponse{},
+		}
+		resp, err := server.GetCompactionScoreCard(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("WatchChannelsV2", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			watchChannelsV2Resp: &datapb.WatchChannelsResponse{},
+		}
+		resp, err := server.WatchChannelsV2(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("MigrateChannel", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			migrateChannelResp: &datapb.MigrateChannelResponse{},
+		}
+		resp, err := server.MigrateChannel(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ListCompactionPlans", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			listCompactionPlansResp: &datapb.ListCompactionPlansResponse{},
+		}
+		resp, err := server.ListCompactionPlans(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetFlushedSegmentsV2", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getFlushedSegmentsV2Resp: &datapb.GetFlushedSegmentsV2Response{},
+		}
+		resp, err := server.GetFlushedSegmentsV2(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ReclaimFailedCompaction", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			reclaimFailedCompactionResp: &commonpb.Status{},
+		}
+		resp, err := server.ReclaimFailedCompaction(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("PinSegments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			pinSegmentsResp: &commonpb.Status{},
+		}
+		resp, err := server.PinSegments(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("UnpinSegments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			unpinSegmentsResp: &commonpb.Status{},
+		}
+		resp, err := server.UnpinSegments(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ListManagedCollections", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			listManagedCollectionsResp: &datapb.ListManagedCollectionsResponse{},
+		}
+		resp, err := server.ListManagedCollections(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("MigrateEtcdPrefix", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			migrateEtcdPrefixResp: &datapb.MigrateEtcdPrefixResponse{},
+		}
+		resp, err := server.MigrateEtcdPrefix(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("RegisterDataNodeQuota", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			registerDataNodeQuotaResp: &commonpb.Status{},
+		}
+		resp, err := server.RegisterDataNodeQuota(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("StorageAudit", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			storageAuditResp: &datapb.StorageAuditResponse{},
+		}
+		resp, err := server.StorageAudit(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("SampledSegmentInspector", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			sampledSegmentInspectorResp: &datapb.SampleSegmentResponse{},
+		}
+		resp, err := server.SampledSegmentInspector(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetStorageUsage", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getStorageUsageResp: &datapb.GetStorageUsageResponse{},
+		}
+		resp, err := server.GetStorageUsage(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("CancelCompaction", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			cancelCompactionResp: &datapb.CancelCompactionResponse{},
+		}
+		resp, err := server.CancelCompaction(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetGCEvents", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getGCEventsResp: &datapb.GetGCEventsResponse{},
+		}
+		resp, err := server.GetGCEvents(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ReportSegmentError", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			reportSegmentErrorResp: &commonpb.Status{},
+		}
+		resp, err := server.ReportSegmentError(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("SetCollectionProperty", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			setCollectionPropertyResp: &commonpb.Status{},
+		}
+		resp, err := server.SetCollectionProperty(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ComputeSegmentOverlap", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			computeSegmentOverlapResp: &datapb.ComputeSegmentOverlapResponse{},
+		}
+		resp, err := server.ComputeSegmentOverlap(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetCompactionROI", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getCompactionROIResp: &datapb.GetCompactionROIResponse{},
+		}
+		resp, err := server.GetCompactionROI(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("FreezePartition", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			freezePartitionResp: &commonpb.Status{},
+		}
+		resp, err := server.FreezePartition(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("UnfreezePartition", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			unfreezePartitionResp: &commonpb.Status{},
+		}
+		resp, err := server.UnfreezePartition(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetSegmentPath", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getSegmentPathResp: &datapb.GetSegmentPathResponse{},
+		}
+		resp, err := server.GetSegmentPath(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetSegmentsForCollection", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getSegmentsForCollectionResp: &datapb.GetSegmentsForCollectionResponse{},
+		}
+		resp, err := server.GetSegmentsForCollection(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ReportDataNodeHealth", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			reportDataNodeHealthResp: &commonpb.Status{},
+		}
+		resp, err := server.ReportDataNodeHealth(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetSegmentLineageDOT", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getSegmentLineageDOTResp: &datapb.GetSegmentLineageDOTResponse{},
+		}
+		resp, err := server.GetSegmentLineageDOT(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ReadSegment", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		err := server.ReadSegment(nil, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DiscardSegment", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			discardSegmentResp: &commonpb.Status{},
+		}
+		resp, err := server.DiscardSegment(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetChannelSegmentStats", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getChannelSegmentStatsResp: &datapb.GetChannelSegmentStatsResponse{},
+		}
+		resp, err := server.GetChannelSegmentStats(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetFlushProgress", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getFlushProgressResp: &datapb.FlushProgressResponse{},
+		}
+		resp, err := server.GetFlushProgress(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("CancelFlush", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			cancelFlushResp: &datapb.CancelFlushResponse{},
+		}
+		resp, err := server.CancelFlush(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("PauseCompaction", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			pauseCompactionResp: &commonpb.Status{},
+		}
+		resp, err := server.PauseCompaction(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ResumeCompaction", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			resumeCompactionResp: &commonpb.Status{},
+		}
+		resp, err := server.ResumeCompaction(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
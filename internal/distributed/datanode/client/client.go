@@ -305,3 +305,67 @@ func (c *Client) Compaction(ctx context.Context, req *datapb.CompactionPlan) (*c
 	}
 	return ret.(*commonpb.Status), err
 }
+
+// FlushAll seals and flushes all the active segments in DataNode
+func (c *Client) FlushAll(ctx context.Context, req *datapb.FlushAllRequest) (*datapb.FlushAllResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.FlushAll(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.FlushAllResponse), err
+}
+
+// SampleSegment returns the statistics of fields over a random sample of rows in the segment
+func (c *Client) SampleSegment(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.SampleSegment(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.SampleSegmentResponse), err
+}
+
+// CancelCompaction stops the executing compaction plan
+func (c *Client) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.CancelCompaction(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.CancelCompactionResponse), err
+}
+
+// RestoreSegmentFromSnapshot restores binlogs of the segment to their object versions at the timestamp
+func (c *Client) RestoreSegmentFromSnapshot(ctx context.Context, req *datapb.RestoreSegmentRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.RestoreSegmentFromSnapshot(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
@@ -58,6 +58,22 @@ func (m *MockDataNodeClient) Compaction(ctx context.Context, req *datapb.Compact
 	return &commonpb.Status{}, m.err
 }
 
+func (m *MockDataNodeClient) FlushAll(ctx context.Context, req *datapb.FlushAllRequest, opts ...grpc.CallOption) (*datapb.FlushAllResponse, error) {
+	return &datapb.FlushAllResponse{}, m.err
+}
+
+func (m *MockDataNodeClient) SampleSegment(ctx context.Context, req *datapb.SampleSegmentRequest, opts ...grpc.CallOption) (*datapb.SampleSegmentResponse, error) {
+	return &datapb.SampleSegmentResponse{}, m.err
+}
+
+func (m *MockDataNodeClient) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest, opts ...grpc.CallOption) (*datapb.CancelCompactionResponse, error) {
+	return &datapb.CancelCompactionResponse{}, m.err
+}
+
+func (m *MockDataNodeClient) RestoreSegmentFromSnapshot(ctx context.Context, req *datapb.RestoreSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
 func Test_NewClient(t *testing.T) {
 	proxy.Params.InitOnce()
 
@@ -107,6 +123,18 @@ func Test_NewClient(t *testing.T) {
 
 		r6, err := client.Compaction(ctx, nil)
 		retCheck(retNotNil, r6, err)
+
+		r7, err := client.FlushAll(ctx, nil)
+		retCheck(retNotNil, r7, err)
+
+		r8, err := client.SampleSegment(ctx, nil)
+		retCheck(retNotNil, r8, err)
+
+		r9, err := client.CancelCompaction(ctx, nil)
+		retCheck(retNotNil, r9, err)
+
+		r10, err := client.RestoreSegmentFromSnapshot(ctx, nil)
+		retCheck(retNotNil, r10, err)
 	}
 
 	client.getGrpcClient = func() (datapb.DataNodeClient, error) {
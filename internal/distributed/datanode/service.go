@@ -179,8 +179,17 @@ func (s *Server) init() error {
 	dn.Params.Port = Params.Port
 	dn.Params.IP = Params.IP
 
-	closer := trace.InitTracing(fmt.Sprintf("data_node ip: %s, port: %d", Params.IP, Params.Port))
-	s.closer = closer
+	serviceName := fmt.Sprintf("data_node ip: %s, port: %d", Params.IP, Params.Port)
+	if dn.Params.OTLPEndpoint != "" {
+		closer, err := trace.InitOTLPTracing(serviceName, dn.Params.OTLPEndpoint)
+		if err != nil {
+			log.Warn("DataNode init OTLP tracing failed", zap.String("endpoint", dn.Params.OTLPEndpoint), zap.Error(err))
+			return err
+		}
+		s.closer = closer
+	} else {
+		s.closer = trace.InitTracing(serviceName)
+	}
 	addr := Params.IP + ":" + strconv.Itoa(Params.Port)
 	log.Debug("DataNode address", zap.String("address", addr))
 
@@ -295,3 +304,23 @@ func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsReq
 func (s *Server) Compaction(ctx context.Context, request *datapb.CompactionPlan) (*commonpb.Status, error) {
 	return s.datanode.Compaction(ctx, request)
 }
+
+// FlushAll seals and flushes all the active segments in DataNode
+func (s *Server) FlushAll(ctx context.Context, request *datapb.FlushAllRequest) (*datapb.FlushAllResponse, error) {
+	return s.datanode.FlushAll(ctx, request)
+}
+
+// SampleSegment returns the statistics of fields over a random sample of rows in the segment
+func (s *Server) SampleSegment(ctx context.Context, request *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	return s.datanode.SampleSegment(ctx, request)
+}
+
+// CancelCompaction stops the executing compaction plan
+func (s *Server) CancelCompaction(ctx context.Context, request *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	return s.datanode.CancelCompaction(ctx, request)
+}
+
+// RestoreSegmentFromSnapshot restores binlogs of the segment to their object versions at the timestamp
+func (s *Server) RestoreSegmentFromSnapshot(ctx context.Context, request *datapb.RestoreSegmentRequest) (*commonpb.Status, error) {
+	return s.datanode.RestoreSegmentFromSnapshot(ctx, request)
+}
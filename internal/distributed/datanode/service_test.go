@@ -107,6 +107,22 @@ func (m *MockDataNode) Compaction(ctx context.Context, req *datapb.CompactionPla
 	return m.status, m.err
 }
 
+func (m *MockDataNode) FlushAll(ctx context.Context, req *datapb.FlushAllRequest) (*datapb.FlushAllResponse, error) {
+	return &datapb.FlushAllResponse{Status: m.status}, m.err
+}
+
+func (m *MockDataNode) SampleSegment(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	return &datapb.SampleSegmentResponse{Status: m.status}, m.err
+}
+
+func (m *MockDataNode) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	return &datapb.CancelCompactionResponse{Status: m.status}, m.err
+}
+
+func (m *MockDataNode) RestoreSegmentFromSnapshot(ctx context.Context, req *datapb.RestoreSegmentRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type mockDataCoord struct {
 	types.DataCoord
@@ -233,6 +249,42 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("FlushAll", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.FlushAll(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("SampleSegment", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.SampleSegment(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("CancelCompaction", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.CancelCompaction(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("RestoreSegmentFromSnapshot", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.RestoreSegmentFromSnapshot(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
@@ -301,6 +301,14 @@ func (m *MockQueryCoord) LoadBalance(ctx context.Context, req *querypb.LoadBalan
 	return nil, nil
 }
 
+func (m *MockQueryCoord) PreFetchSegments(ctx context.Context, req *querypb.PreFetchSegmentsRequest) (*querypb.PreFetchSegmentsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockQueryCoord) GetLoadProgress(ctx context.Context, req *querypb.GetLoadProgressRequest) (*querypb.GetLoadProgressResponse, error) {
+	return nil, nil
+}
+
 func (m *MockQueryCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, nil
 }
@@ -399,6 +407,146 @@ func (m *MockDataCoord) WatchChannels(ctx context.Context, req *datapb.WatchChan
 	return nil, nil
 }
 
+func (m *MockDataCoord) GetChannelHistory(ctx context.Context, req *datapb.GetChannelHistoryRequest) (*datapb.GetChannelHistoryResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ImportSegmentManifest(ctx context.Context, req *datapb.ImportManifestRequest) (*datapb.ImportManifestResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetCompactionScoreCard(ctx context.Context, req *datapb.GetCompactionScoreCardRequest) (*datapb.GetCompactionScoreCardResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) WatchChannelsV2(ctx context.Context, req *datapb.WatchChannelsV2Request) (*datapb.WatchChannelsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) MigrateChannel(ctx context.Context, req *datapb.MigrateChannelRequest) (*datapb.MigrateChannelResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ListCompactionPlans(ctx context.Context, req *datapb.ListCompactionPlansRequest) (*datapb.ListCompactionPlansResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetFlushedSegmentsV2(ctx context.Context, req *datapb.GetFlushedSegmentsV2Request) (*datapb.GetFlushedSegmentsV2Response, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ReclaimFailedCompaction(ctx context.Context, req *datapb.ReclaimFailedCompactionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ListManagedCollections(ctx context.Context, req *datapb.ListManagedCollectionsRequest) (*datapb.ListManagedCollectionsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) MigrateEtcdPrefix(ctx context.Context, req *datapb.MigrateEtcdPrefixRequest) (*datapb.MigrateEtcdPrefixResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) RegisterDataNodeQuota(ctx context.Context, req *datapb.RegisterDataNodeQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) StorageAudit(ctx context.Context, req *datapb.StorageAuditRequest) (*datapb.StorageAuditResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) SampledSegmentInspector(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetStorageUsage(ctx context.Context, req *datapb.GetStorageUsageRequest) (*datapb.GetStorageUsageResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetGCEvents(ctx context.Context, req *datapb.GetGCEventsRequest) (*datapb.GetGCEventsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ReportSegmentError(ctx context.Context, req *datapb.ReportSegmentErrorRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) SetCollectionProperty(ctx context.Context, req *datapb.SetCollectionPropertyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ComputeSegmentOverlap(ctx context.Context, req *datapb.ComputeSegmentOverlapRequest) (*datapb.ComputeSegmentOverlapResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetCompactionROI(ctx context.Context, req *datapb.GetCompactionROIRequest) (*datapb.GetCompactionROIResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) FreezePartition(ctx context.Context, req *datapb.FreezePartitionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) UnfreezePartition(ctx context.Context, req *datapb.UnfreezePartitionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetSegmentPath(ctx context.Context, req *datapb.GetSegmentPathRequest) (*datapb.GetSegmentPathResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetSegmentsForCollection(ctx context.Context, req *datapb.GetSegmentsForCollectionRequest) (*datapb.GetSegmentsForCollectionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ReportDataNodeHealth(ctx context.Context, req *datapb.ReportDataNodeHealthRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetSegmentLineageDOT(ctx context.Context, req *datapb.GetSegmentLineageDOTRequest) (*datapb.GetSegmentLineageDOTResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ReadSegment(req *datapb.ReadSegmentRequest, stream datapb.DataCoord_ReadSegmentServer) error {
+	return nil
+}
+
+func (m *MockDataCoord) DiscardSegment(ctx context.Context, req *datapb.DiscardSegmentRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetChannelSegmentStats(ctx context.Context, req *datapb.GetChannelSegmentStatsRequest) (*datapb.GetChannelSegmentStatsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetFlushProgress(ctx context.Context, req *datapb.GetFlushProgressRequest) (*datapb.FlushProgressResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CancelFlush(ctx context.Context, req *datapb.CancelFlushRequest) (*datapb.CancelFlushResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) PauseCompaction(ctx context.Context, req *datapb.PauseCompactionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ResumeCompaction(ctx context.Context, req *datapb.ResumeCompactionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockProxy struct {
 	MockBase
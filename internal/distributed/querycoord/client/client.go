@@ -435,6 +435,38 @@ func (c *Client) LoadBalance(ctx context.Context, req *querypb.LoadBalanceReques
 	return ret.(*commonpb.Status), err
 }
 
+// PreFetchSegments loads segments restored in cold storage into QueryNodes ahead of queries
+func (c *Client) PreFetchSegments(ctx context.Context, req *querypb.PreFetchSegmentsRequest) (*querypb.PreFetchSegmentsResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.PreFetchSegments(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*querypb.PreFetchSegmentsResponse), err
+}
+
+// GetLoadProgress returns the loading progress of a pre-fetch batch
+func (c *Client) GetLoadProgress(ctx context.Context, req *querypb.GetLoadProgressRequest) (*querypb.GetLoadProgressResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		client, err := c.getGrpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.GetLoadProgress(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*querypb.GetLoadProgressResponse), err
+}
+
 // GetMetrics gets the metrics information of QueryCoord.
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
@@ -86,6 +86,14 @@ func (m *MockQueryCoordClient) LoadBalance(ctx context.Context, in *querypb.Load
 	return &commonpb.Status{}, m.err
 }
 
+func (m *MockQueryCoordClient) PreFetchSegments(ctx context.Context, in *querypb.PreFetchSegmentsRequest, opts ...grpc.CallOption) (*querypb.PreFetchSegmentsResponse, error) {
+	return &querypb.PreFetchSegmentsResponse{}, m.err
+}
+
+func (m *MockQueryCoordClient) GetLoadProgress(ctx context.Context, in *querypb.GetLoadProgressRequest, opts ...grpc.CallOption) (*querypb.GetLoadProgressResponse, error) {
+	return &querypb.GetLoadProgressResponse{}, m.err
+}
+
 func (m *MockQueryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{}, m.err
 }
@@ -165,6 +173,12 @@ func Test_NewClient(t *testing.T) {
 
 		r16, err := client.LoadBalance(ctx, nil)
 		retCheck(retNotNil, r16, err)
+
+		r17, err := client.PreFetchSegments(ctx, nil)
+		retCheck(retNotNil, r17, err)
+
+		r18, err := client.GetLoadProgress(ctx, nil)
+		retCheck(retNotNil, r18, err)
 	}
 
 	client.getGrpcClient = func() (querypb.QueryCoordClient, error) {
@@ -351,6 +351,16 @@ func (s *Server) LoadBalance(ctx context.Context, req *querypb.LoadBalanceReques
 	return s.queryCoord.LoadBalance(ctx, req)
 }
 
+// PreFetchSegments loads segments restored in cold storage into QueryNodes ahead of queries
+func (s *Server) PreFetchSegments(ctx context.Context, req *querypb.PreFetchSegmentsRequest) (*querypb.PreFetchSegmentsResponse, error) {
+	return s.queryCoord.PreFetchSegments(ctx, req)
+}
+
+// GetLoadProgress returns the loading progress of a pre-fetch batch
+func (s *Server) GetLoadProgress(ctx context.Context, req *querypb.GetLoadProgressRequest) (*querypb.GetLoadProgressResponse, error) {
+	return s.queryCoord.GetLoadProgress(ctx, req)
+}
+
 // GetMetrics gets the metrics information of QueryCoord.
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.queryCoord.GetMetrics(ctx, req)
@@ -46,6 +46,8 @@ type MockQueryCoord struct {
 	partResp     *querypb.GetPartitionStatesResponse
 	channelResp  *querypb.CreateQueryChannelResponse
 	infoResp     *querypb.GetSegmentInfoResponse
+	preFetchResp *querypb.PreFetchSegmentsResponse
+	progressResp *querypb.GetLoadProgressResponse
 	metricResp   *milvuspb.GetMetricsResponse
 }
 
@@ -134,6 +136,14 @@ func (m *MockQueryCoord) LoadBalance(ctx context.Context, req *querypb.LoadBalan
 	return m.status, m.err
 }
 
+func (m *MockQueryCoord) PreFetchSegments(ctx context.Context, req *querypb.PreFetchSegmentsRequest) (*querypb.PreFetchSegmentsResponse, error) {
+	return m.preFetchResp, m.err
+}
+
+func (m *MockQueryCoord) GetLoadProgress(ctx context.Context, req *querypb.GetLoadProgressRequest) (*querypb.GetLoadProgressResponse, error) {
+	return m.progressResp, m.err
+}
+
 func (m *MockQueryCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return m.metricResp, m.err
 }
@@ -257,6 +267,8 @@ func Test_NewServer(t *testing.T) {
 		partResp:     &querypb.GetPartitionStatesResponse{},
 		channelResp:  &querypb.CreateQueryChannelResponse{},
 		infoResp:     &querypb.GetSegmentInfoResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+		preFetchResp: &querypb.PreFetchSegmentsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+		progressResp: &querypb.GetLoadProgressResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
 		metricResp:   &milvuspb.GetMetricsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
 	}
 
@@ -370,6 +382,20 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("PreFetchSegments", func(t *testing.T) {
+		req := &querypb.PreFetchSegmentsRequest{}
+		resp, err := server.PreFetchSegments(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("GetLoadProgress", func(t *testing.T) {
+		req := &querypb.GetLoadProgressRequest{}
+		resp, err := server.GetLoadProgress(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{
 			Request: "",
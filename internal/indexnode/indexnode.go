@@ -51,6 +51,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -138,6 +139,12 @@ func (i *IndexNode) Init() error {
 		Params.Init()
 		i.UpdateStateCode(internalpb.StateCode_Initializing)
 		log.Debug("IndexNode init", zap.Any("State", internalpb.StateCode_Initializing))
+		// vectors of encrypted fields are read from binlogs to build index
+		if err := storage.InitColumnKeyManager(Params.ColumnEncryptionFieldKeys, Params.ColumnEncryptionKeys); err != nil {
+			log.Error("IndexNode invalid column encryption keys", zap.Error(err))
+			initErr = err
+			return
+		}
 		connectEtcdFn := func() error {
 			etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
 			i.etcdKV = etcdKV
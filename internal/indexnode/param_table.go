@@ -52,6 +52,11 @@ type ParamTable struct {
 
 	SimdType string
 
+	// Comma separated collectionID/fieldID:keyID of the fields whose insert binlogs are encrypted with the column key
+	ColumnEncryptionFieldKeys string
+	// Comma separated keyID:base64 encoded AES-256 key of the column keys
+	ColumnEncryptionKeys string
+
 	CreatedTime time.Time
 	UpdatedTime time.Time
 }
@@ -89,6 +94,8 @@ func (pt *ParamTable) initParams() {
 	pt.initIndexStorageRootPath()
 	pt.initRoleName()
 	pt.initKnowhereSimdType()
+	pt.initColumnEncryptionFieldKeys()
+	pt.initColumnEncryptionKeys()
 }
 
 func (pt *ParamTable) initMinIOAddress() {
@@ -171,3 +178,11 @@ func (pt *ParamTable) initKnowhereSimdType() {
 	pt.SimdType = simdType
 	log.Debug("initialize the knowhere simd type", zap.String("simd_type", pt.SimdType))
 }
+
+func (pt *ParamTable) initColumnEncryptionFieldKeys() {
+	pt.ColumnEncryptionFieldKeys = pt.LoadWithDefault("encryption.fieldKeys", "")
+}
+
+func (pt *ParamTable) initColumnEncryptionKeys() {
+	pt.ColumnEncryptionKeys = pt.LoadWithDefault("encryption.keys", "")
+}
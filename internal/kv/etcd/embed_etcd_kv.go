@@ -204,12 +204,17 @@ func (kv *EmbedEtcdKV) SaveWithLease(key, value string, id clientv3.LeaseID) err
 }
 
 func (kv *EmbedEtcdKV) MultiSave(kvs map[string]string) error {
+	return kv.MultiSaveWithContext(context.TODO(), kvs)
+}
+
+// MultiSaveWithContext saves the key-value pairs in a transaction, it returns once ctx is done.
+func (kv *EmbedEtcdKV) MultiSaveWithContext(ctx context.Context, kvs map[string]string) error {
 	ops := make([]clientv3.Op, 0, len(kvs))
 	for key, value := range kvs {
 		ops = append(ops, clientv3.OpPut(path.Join(kv.rootPath, key), value))
 	}
 
-	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
 	defer cancel()
 
 	_, err := kv.client.Txn(ctx).If().Then(ops...).Commit()
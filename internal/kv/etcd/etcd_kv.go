@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -119,6 +120,56 @@ func (kv *EtcdKV) LoadWithPrefix2(key string) ([]string, []string, []int64, erro
 	return keys, values, versions, nil
 }
 
+// prefixPath returns the absolute path of prefix, the trailing slash of a directory prefix is kept
+func (kv *EtcdKV) prefixPath(prefix string) string {
+	key := path.Join(kv.rootPath, prefix)
+	if strings.HasSuffix(prefix, "/") {
+		key += "/"
+	}
+	return key
+}
+
+// LoadKeysWithPrefix returns at most limit keys with the given prefix without values, all the keys are returned if limit is 0
+func (kv *EtcdKV) LoadKeysWithPrefix(key string, limit int64) ([]string, error) {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	resp, err := kv.client.Get(ctx, kv.prefixPath(key), clientv3.WithPrefix(), clientv3.WithKeysOnly(), clientv3.WithLimit(limit),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		keys = append(keys, string(kv.Key))
+	}
+	CheckElapseAndWarn(start, "Slow etcd operation load keys with prefix")
+	return keys, nil
+}
+
+// LoadWithPrefixAndModRevisions returns at most limit keys, values and mod revisions with the given prefix,
+// all of them are returned if limit is 0
+func (kv *EtcdKV) LoadWithPrefixAndModRevisions(key string, limit int64) ([]string, []string, []int64, error) {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	resp, err := kv.client.Get(ctx, kv.prefixPath(key), clientv3.WithPrefix(), clientv3.WithLimit(limit),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keys := make([]string, 0, len(resp.Kvs))
+	values := make([]string, 0, len(resp.Kvs))
+	revisions := make([]int64, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		keys = append(keys, string(kv.Key))
+		values = append(values, string(kv.Value))
+		revisions = append(revisions, kv.ModRevision)
+	}
+	CheckElapseAndWarn(start, "Slow etcd operation load with prefix and mod revisions")
+	return keys, values, revisions, nil
+}
+
 // Load returns value of the key.
 func (kv *EtcdKV) Load(key string) (string, error) {
 	start := time.Now()
@@ -213,13 +264,18 @@ func (kv *EtcdKV) SaveWithLease(key, value string, id clientv3.LeaseID) error {
 }
 
 func (kv *EtcdKV) MultiSave(kvs map[string]string) error {
+	return kv.MultiSaveWithContext(context.TODO(), kvs)
+}
+
+// MultiSaveWithContext saves the key-value pairs in a transaction, it returns once ctx is done.
+func (kv *EtcdKV) MultiSaveWithContext(ctx context.Context, kvs map[string]string) error {
 	start := time.Now()
 	ops := make([]clientv3.Op, 0, len(kvs))
 	for key, value := range kvs {
 		ops = append(ops, clientv3.OpPut(path.Join(kv.rootPath, key), value))
 	}
 
-	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
 	defer cancel()
 
 	_, err := kv.client.Txn(ctx).If().Then(ops...).Commit()
@@ -283,6 +339,34 @@ func (kv *EtcdKV) MultiSaveAndRemove(saves map[string]string, removals []string)
 	return err
 }
 
+// MultiSaveAndRemoveWithModRevisions saves and removes the keys in a transaction if the mod revision of each key
+// in revisions is unchanged, the mod revision of a key not existing is 0.
+// It returns false without changing any key if any key in revisions is modified.
+func (kv *EtcdKV) MultiSaveAndRemoveWithModRevisions(saves map[string]string, removals []string, revisions map[string]int64) (bool, error) {
+	start := time.Now()
+	cmps := make([]clientv3.Cmp, 0, len(revisions))
+	for key, revision := range revisions {
+		cmps = append(cmps, clientv3.Compare(clientv3.ModRevision(path.Join(kv.rootPath, key)), "=", revision))
+	}
+	ops := make([]clientv3.Op, 0, len(saves)+len(removals))
+	for key, value := range saves {
+		ops = append(ops, clientv3.OpPut(path.Join(kv.rootPath, key), value))
+	}
+	for _, keyDelete := range removals {
+		ops = append(ops, clientv3.OpDelete(path.Join(kv.rootPath, keyDelete)))
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+
+	resp, err := kv.client.Txn(ctx).If(cmps...).Then(ops...).Commit()
+	if err != nil {
+		return false, err
+	}
+	CheckElapseAndWarn(start, "Slow etcd operation multi save and remove with mod revisions")
+	return resp.Succeeded, nil
+}
+
 func (kv *EtcdKV) Watch(key string) clientv3.WatchChan {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
@@ -465,6 +465,52 @@ func TestEtcdKV_Load(te *testing.T) {
 		assert.Error(t, err)
 	})
 
+	te.Run("Etcd ModRevisions", func(t *testing.T) {
+		rootPath := "/etcd/test/root/modrevisions"
+		etcdKV, err := etcdkv.NewEtcdKV(etcdEndPoints, rootPath)
+		require.NoError(t, err)
+
+		defer etcdKV.Close()
+		defer etcdKV.RemoveWithPrefix("")
+
+		err = etcdKV.MultiSave(map[string]string{"a/1": "v1", "a/2": "v2", "a2": "v3"})
+		require.NoError(t, err)
+
+		keys, err := etcdKV.LoadKeysWithPrefix("a/", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{rootPath + "/a/1", rootPath + "/a/2"}, keys)
+		keys, err = etcdKV.LoadKeysWithPrefix("a", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{rootPath + "/a/1"}, keys)
+
+		keys, values, revisions, err := etcdKV.LoadWithPrefixAndModRevisions("a/", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{rootPath + "/a/1", rootPath + "/a/2"}, keys)
+		assert.Equal(t, []string{"v1", "v2"}, values)
+		assert.Equal(t, 2, len(revisions))
+
+		// a/1 is modified
+		err = etcdKV.Save("a/1", "v4")
+		require.NoError(t, err)
+		ok, err := etcdKV.MultiSaveAndRemoveWithModRevisions(map[string]string{"b/1": "v1"}, []string{"a/1"},
+			map[string]int64{"a/1": revisions[0], "b/1": 0})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		value, err := etcdKV.Load("a/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "v4", value)
+
+		ok, err = etcdKV.MultiSaveAndRemoveWithModRevisions(map[string]string{"b/2": "v2"}, []string{"a/2"},
+			map[string]int64{"a/2": revisions[1], "b/2": 0})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		value, err = etcdKV.Load("b/2")
+		assert.NoError(t, err)
+		assert.Equal(t, "v2", value)
+		_, err = etcdKV.Load("a/2")
+		assert.Error(t, err)
+	})
+
 	te.Run("Etcd Lease", func(t *testing.T) {
 		rootPath := "/etcd/test/root/lease"
 		etcdKV, err := etcdkv.NewEtcdKV(etcdEndPoints, rootPath)
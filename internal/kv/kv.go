@@ -17,6 +17,8 @@
 package kv
 
 import (
+	"context"
+
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
@@ -66,6 +68,8 @@ type BaseKV interface {
 	LoadWithPrefix(key string) ([]string, []string, error)
 	Save(key, value string) error
 	MultiSave(kvs map[string]string) error
+	// MultiSaveWithContext is MultiSave which returns once ctx is done
+	MultiSaveWithContext(ctx context.Context, kvs map[string]string) error
 	Remove(key string) error
 	MultiRemove(keys []string) error
 	RemoveWithPrefix(key string) error
@@ -80,6 +84,12 @@ type DataKV interface {
 	GetSize(key string) (int64, error)
 }
 
+// CopyKV copies objects inside the kv without transferring their values through the client.
+type CopyKV interface {
+	// MultiCopyWithContext copies the value of each source key to its destination key, dst2src is destination key => source key
+	MultiCopyWithContext(ctx context.Context, dst2src map[string]string) error
+}
+
 // TxnKV contains extra txn operations of kv. The extra operations is transactional.
 type TxnKV interface {
 	BaseKV
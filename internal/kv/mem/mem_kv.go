@@ -17,6 +17,7 @@
 package memkv
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -126,6 +127,14 @@ func (kv *MemoryKV) MultiSave(kvs map[string]string) error {
 	return nil
 }
 
+// MultiSaveWithContext saves the key-value pairs unless ctx is done, saving in memory never blocks.
+func (kv *MemoryKV) MultiSaveWithContext(ctx context.Context, kvs map[string]string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return kv.MultiSave(kvs)
+}
+
 func (kv *MemoryKV) MultiRemove(keys []string) error {
 	kv.Lock()
 	defer kv.Unlock()
@@ -17,6 +17,7 @@
 package memkv
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -62,6 +63,23 @@ func TestMemoryKV_LoadPartial(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestMemoryKV_MultiSaveWithContext(t *testing.T) {
+	memKV := NewMemoryKV()
+	err := memKV.MultiSaveWithContext(context.Background(), map[string]string{"k1": "v1"})
+	assert.NoError(t, err)
+	value, err := memKV.Load("k1")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", value)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = memKV.MultiSaveWithContext(ctx, map[string]string{"k2": "v2"})
+	assert.ErrorIs(t, err, context.Canceled)
+	value, err = memKV.Load("k2")
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+}
+
 func TestMemoryKV_GetSize(t *testing.T) {
 	memKV := NewMemoryKV()
 
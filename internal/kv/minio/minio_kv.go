@@ -20,7 +20,9 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"sort"
 	"sync"
+	"time"
 
 	"io"
 	"strings"
@@ -202,9 +204,37 @@ func (kv *MinIOKV) Save(key, value string) error {
 // MultiSave save multiple objects, the path is the key of @kvs.
 // The object value is the value of @kvs.
 func (kv *MinIOKV) MultiSave(kvs map[string]string) error {
+	return kv.MultiSaveWithContext(kv.ctx, kvs)
+}
+
+// MultiSaveWithContext is MultiSave with in-flight writes aborted once ctx is done.
+func (kv *MinIOKV) MultiSaveWithContext(ctx context.Context, kvs map[string]string) error {
 	var resultErr error
 	for key, value := range kvs {
-		err := kv.Save(key, value)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		reader := strings.NewReader(value)
+		_, err := kv.minioClient.PutObject(ctx, kv.bucketName, key, reader, int64(len(value)), minio.PutObjectOptions{})
+		if err != nil {
+			if resultErr == nil {
+				resultErr = err
+			}
+		}
+	}
+	return resultErr
+}
+
+// MultiCopyWithContext copies objects on the server side, the destination key of @dst2src is copied from its source key.
+func (kv *MinIOKV) MultiCopyWithContext(ctx context.Context, dst2src map[string]string) error {
+	var resultErr error
+	for dst, src := range dst2src {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		_, err := kv.minioClient.CopyObject(ctx,
+			minio.CopyDestOptions{Bucket: kv.bucketName, Object: dst},
+			minio.CopySrcOptions{Bucket: kv.bucketName, Object: src})
 		if err != nil {
 			if resultErr == nil {
 				resultErr = err
@@ -290,6 +320,67 @@ func (kv *MinIOKV) GetSize(key string) (int64, error) {
 	return objectInfo.Size, nil
 }
 
+// ObjectVersion is a version of an object in a bucket with versioning enabled.
+type ObjectVersion struct {
+	VersionID      string
+	LastModified   time.Time
+	ETag           string
+	Size           int64
+	IsLatest       bool
+	IsDeleteMarker bool
+}
+
+// EnableVersioning enables versioning on the bucket, so that the versions of an object are kept when it's overwritten.
+func (kv *MinIOKV) EnableVersioning() error {
+	return kv.minioClient.EnableVersioning(kv.ctx, kv.bucketName)
+}
+
+// ListVersions lists the versions of the object with @key, the newest first.
+// Only the latest version is listed if versioning is not enabled on the bucket.
+func (kv *MinIOKV) ListVersions(key string) ([]ObjectVersion, error) {
+	var versions []ObjectVersion
+	for object := range kv.minioClient.ListObjects(kv.ctx, kv.bucketName, minio.ListObjectsOptions{Prefix: key, WithVersions: true}) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		if object.Key != key {
+			continue
+		}
+		versions = append(versions, ObjectVersion{
+			VersionID:      object.VersionID,
+			LastModified:   object.LastModified,
+			ETag:           object.ETag,
+			Size:           object.Size,
+			IsLatest:       object.IsLatest,
+			IsDeleteMarker: object.IsDeleteMarker,
+		})
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].LastModified.After(versions[j].LastModified)
+	})
+	return versions, nil
+}
+
+// LoadVersion loads the version @versionID of the object with @key.
+func (kv *MinIOKV) LoadVersion(key, versionID string) ([]byte, error) {
+	object, err := kv.minioClient.GetObject(kv.ctx, kv.bucketName, key, minio.GetObjectOptions{VersionID: versionID})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	return ioutil.ReadAll(object)
+}
+
+// RestoreVersion makes the version @versionID of the object with @key its latest version,
+// the version is copied on the server side and the versions after it are kept.
+func (kv *MinIOKV) RestoreVersion(key, versionID string) error {
+	_, err := kv.minioClient.CopyObject(kv.ctx,
+		minio.CopyDestOptions{Bucket: kv.bucketName, Object: key},
+		minio.CopySrcOptions{Bucket: kv.bucketName, Object: key, VersionID: versionID})
+	return err
+}
+
 // Close close the MinIOKV.
 func (kv *MinIOKV) Close() {
 
@@ -23,6 +23,7 @@ import (
 	"path"
 	"strconv"
 	"testing"
+	"time"
 
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -199,6 +200,55 @@ func TestMinIOKV(t *testing.T) {
 		assert.Equal(t, "123", val)
 	})
 
+	t.Run("test MultiSaveWithContext", func(t *testing.T) {
+		testMultiSaveRoot := path.Join(testMinIOKVRoot, "test_multisave_with_context")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testKV, err := newMinIOKVClient(ctx, testBucket)
+		assert.Nil(t, err)
+		defer testKV.RemoveWithPrefix(testMultiSaveRoot)
+
+		err = testKV.MultiSaveWithContext(ctx, map[string]string{path.Join(testMultiSaveRoot, "key_1"): "123"})
+		assert.Nil(t, err)
+		val, err := testKV.Load(path.Join(testMultiSaveRoot, "key_1"))
+		assert.Nil(t, err)
+		assert.Equal(t, "123", val)
+
+		// cancelled writes return at once
+		saveCtx, saveCancel := context.WithCancel(ctx)
+		saveCancel()
+		start := time.Now()
+		err = testKV.MultiSaveWithContext(saveCtx, map[string]string{path.Join(testMultiSaveRoot, "key_2"): "456"})
+		assert.Error(t, err)
+		assert.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+		_, err = testKV.Load(path.Join(testMultiSaveRoot, "key_2"))
+		assert.Error(t, err)
+	})
+
+	t.Run("test MultiCopyWithContext", func(t *testing.T) {
+		testMultiCopyRoot := path.Join(testMinIOKVRoot, "test_multicopy_with_context")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testKV, err := newMinIOKVClient(ctx, testBucket)
+		assert.Nil(t, err)
+		defer testKV.RemoveWithPrefix(testMultiCopyRoot)
+
+		err = testKV.Save(path.Join(testMultiCopyRoot, "src"), "123")
+		assert.Nil(t, err)
+		err = testKV.MultiCopyWithContext(ctx, map[string]string{path.Join(testMultiCopyRoot, "dst"): path.Join(testMultiCopyRoot, "src")})
+		assert.Nil(t, err)
+		val, err := testKV.Load(path.Join(testMultiCopyRoot, "dst"))
+		assert.Nil(t, err)
+		assert.Equal(t, "123", val)
+
+		err = testKV.MultiCopyWithContext(ctx, map[string]string{path.Join(testMultiCopyRoot, "dst_2"): path.Join(testMultiCopyRoot, "not_exist")})
+		assert.Error(t, err)
+	})
+
 	t.Run("test Remove", func(t *testing.T) {
 		testRemoveRoot := path.Join(testMinIOKVRoot, "test_remove")
 		ctx, cancel := context.WithCancel(context.Background())
@@ -441,4 +491,46 @@ func TestMinIOKV(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, int64(0), size)
 	})
+
+	t.Run("test versions", func(t *testing.T) {
+		testVersionsRoot := path.Join(testMinIOKVRoot, "versions")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testKV, err := newMinIOKVClient(ctx, testBucket+"-versioning")
+		require.NoError(t, err)
+		if err := testKV.EnableVersioning(); err != nil {
+			t.Skipf("versioning is not supported by the MinIO deployment: %v", err)
+		}
+		defer testKV.RemoveWithPrefix(testVersionsRoot)
+
+		key := path.Join(testVersionsRoot, "TestMinIOKV_Versions_key")
+		err = testKV.Save(key, "value1")
+		require.NoError(t, err)
+		err = testKV.Save(key, "value2")
+		require.NoError(t, err)
+
+		versions, err := testKV.ListVersions(key)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(versions))
+		assert.True(t, versions[0].IsLatest)
+		assert.False(t, versions[1].IsLatest)
+
+		value, err := testKV.LoadVersion(key, versions[1].VersionID)
+		assert.NoError(t, err)
+		assert.Equal(t, "value1", string(value))
+
+		err = testKV.RestoreVersion(key, versions[1].VersionID)
+		assert.NoError(t, err)
+		latest, err := testKV.Load(key)
+		assert.NoError(t, err)
+		assert.Equal(t, "value1", latest)
+
+		versions, err = testKV.ListVersions(key)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(versions))
+
+		_, err = testKV.LoadVersion(key, "not-exist")
+		assert.Error(t, err)
+	})
 }
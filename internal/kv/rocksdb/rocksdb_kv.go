@@ -17,6 +17,7 @@
 package rocksdbkv
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -178,6 +179,14 @@ func (kv *RocksdbKV) MultiSave(kvs map[string]string) error {
 	return err
 }
 
+// MultiSaveWithContext saves a batch of key-values unless ctx is done, writes of rocksdb are local
+func (kv *RocksdbKV) MultiSaveWithContext(ctx context.Context, kvs map[string]string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return kv.MultiSave(kvs)
+}
+
 // RemoveWithPrefix removes a batch of key-values with specified prefix
 func (kv *RocksdbKV) RemoveWithPrefix(prefix string) error {
 	if kv.DB == nil {
@@ -27,11 +27,12 @@ import (
 )
 
 const (
-	milvusNamespace    = "milvus"
-	subSystemRootCoord = "rootcoord"
-	subSystemDataCoord = "dataCoord"
-	subSystemDataNode  = "dataNode"
-	subSystemProxy     = "proxy"
+	milvusNamespace     = "milvus"
+	subSystemRootCoord  = "rootcoord"
+	subSystemDataCoord  = "dataCoord"
+	subSystemQueryCoord = "queryCoord"
+	subSystemDataNode   = "dataNode"
+	subSystemProxy      = "proxy"
 )
 
 var (
@@ -587,9 +588,21 @@ func RegisterProxy() {
 	prometheus.MustRegister(ProxyDmlChannelTimeTick)
 }
 
+var (
+	//QueryCoordEvictedSegmentCounter counts the segments evicted from query nodes by auto eviction
+	QueryCoordEvictedSegmentCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryCoord,
+			Name:      "evicted_segment_total",
+			Help:      "Counter of segments evicted from query nodes whose memory usage is too high",
+		}, []string{"node_id"},
+	)
+)
+
 //RegisterQueryCoord register QueryCoord metrics
 func RegisterQueryCoord() {
-
+	prometheus.MustRegister(QueryCoordEvictedSegmentCounter)
 }
 
 //RegisterQueryNode register QueryNode metrics
@@ -607,11 +620,111 @@ var (
 			Help:      "List of data nodes registered within etcd",
 		}, []string{"status"},
 	)
+
+	//DataCoordCompactionQueueFairness records the ratio of the longest to the shortest wait time of
+	//the oldest queued compaction plans across partitions, lower is fairer
+	DataCoordCompactionQueueFairness = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "compaction_queue_fairness",
+			Help:      "Ratio of the longest to the shortest wait time of the oldest queued compaction plans across partitions",
+		},
+	)
+
+	//DataCoordRejectedRPCCounter counts the rpcs rejected by load shedding
+	DataCoordRejectedRPCCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "rejected_rpc_total",
+			Help:      "Counter of rpcs rejected when DataCoord is overloaded",
+		}, []string{"method"},
+	)
+
+	//DataCoordSegmentTooSmallCounter counts the segment allocations rejected since new segments would be too small
+	DataCoordSegmentTooSmallCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "segment_too_small_total",
+			Help:      "Counter of segment allocations rejected since new segments would hold fewer rows than the minimum",
+		},
+	)
+
+	//DataCoordSmallSegmentMergeCounter counts the compaction plans scheduled to merge flushed segments being too small
+	DataCoordSmallSegmentMergeCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "small_segment_merge_total",
+			Help:      "Counter of compaction plans scheduled to merge flushed segments with fewer rows than the minimum",
+		},
+	)
+
+	//DataCoordAssignSegmentRateLimitedCounter counts the segment allocations rejected by the rate limit of collections
+	DataCoordAssignSegmentRateLimitedCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "assign_segment_rate_limited_total",
+			Help:      "Counter of segment allocations rejected since the request rate of the collection exceeds the limit",
+		},
+	)
+
+	//DataCoordBinlogGrowthRate records the binlog files added per minute of collections
+	DataCoordBinlogGrowthRate = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "binlog_growth_rate",
+			Help:      "Binlog files added per minute of collections over the sliding window",
+		}, []string{"collection_id"},
+	)
+
+	//DataCoordDataNodeQuotaHeadroom records the channels or segments that can still be assigned to data nodes with quotas
+	DataCoordDataNodeQuotaHeadroom = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "data_node_quota_headroom",
+			Help:      "Number of channels or segments under the resource quota of data nodes",
+		}, []string{"node_id", "resource"},
+	)
+
+	//DataCoordCollectionStorageUsage records the storage usage of collections using the most storage
+	DataCoordCollectionStorageUsage = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "collection_storage_usage",
+			Help:      "Bytes of binlogs of the top collections by storage usage",
+		}, []string{"collection_id"},
+	)
+
+	//DataCoordCompactionHistorySize records the compaction history records kept of collections
+	DataCoordCompactionHistorySize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "compaction_history_size",
+			Help:      "Number of compaction history records kept in etcd of collections",
+		}, []string{"collection_id"},
+	)
 )
 
 //RegisterDataCoord register DataCoord metrics
 func RegisterDataCoord() {
 	prometheus.MustRegister(DataCoordDataNodeList)
+	prometheus.MustRegister(DataCoordRejectedRPCCounter)
+	prometheus.MustRegister(DataCoordCompactionQueueFairness)
+	prometheus.MustRegister(DataCoordSegmentTooSmallCounter)
+	prometheus.MustRegister(DataCoordSmallSegmentMergeCounter)
+	prometheus.MustRegister(DataCoordAssignSegmentRateLimitedCounter)
+	prometheus.MustRegister(DataCoordBinlogGrowthRate)
+	prometheus.MustRegister(DataCoordDataNodeQuotaHeadroom)
+	prometheus.MustRegister(DataCoordCollectionStorageUsage)
+	prometheus.MustRegister(DataCoordCompactionHistorySize)
 }
 
 var (
@@ -632,12 +745,138 @@ var (
 			Name:      "watch_dm_channels_total",
 			Help:      "Counter of watch dm channel",
 		}, []string{"type"})
+
+	// DataNodeSaveBinlogTokens records the tokens left in the SaveBinlogPaths rate limiter
+	DataNodeSaveBinlogTokens = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "save_binlog_tokens",
+			Help:      "Tokens left in the SaveBinlogPaths rate limiter",
+		}, []string{"node_id"})
+
+	// DataNodeDurabilityAckLatency records the latency in milliseconds from the flushed position to its durability ack
+	DataNodeDurabilityAckLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "durability_ack_latency",
+			Help:      "Latency in milliseconds from the flushed position to its durability ack",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 18), // 1ms to about 2 minutes
+		}, []string{"node_id"})
+
+	// DataNodeBlobIOWaitLatency records the time in milliseconds flush and compaction wait for blob storage bandwidth
+	DataNodeBlobIOWaitLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "blob_io_wait_latency",
+			Help:      "Time in milliseconds waiting for blob storage bandwidth",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 18), // 1ms to about 2 minutes
+		}, []string{"node_id", "type"})
+
+	// DataNodeDeltaLogIndexBuildLatency records the time in milliseconds to build the index of a delta log in flush
+	DataNodeDeltaLogIndexBuildLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "delta_log_index_build_latency",
+			Help:      "Time in milliseconds to build the index of a delta log",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 16), // 0.1ms to about 3 seconds
+		}, []string{"node_id"})
+
+	// DataNodePartialRecoveryCounter counts the segments recovered from incomplete binlogs left by a crash in the middle of a flush
+	DataNodePartialRecoveryCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "partial_recovery_total",
+			Help:      "Counter of segments recovered from incomplete binlogs",
+		}, []string{"node_id"})
+
+	// DataNodeFieldCompressionRatio records the ratio of the in-memory size of field data to its insert binlog size,
+	// averaged over the latest flushes of the field
+	DataNodeFieldCompressionRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "field_compression_ratio",
+			Help:      "Ratio of uncompressed size to compressed binlog size of a field",
+		}, []string{"collection_id", "field_id"})
+
+	// DataNodeFlushLatency records the time in milliseconds to upload the insert binlogs of a flush
+	DataNodeFlushLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "flush_latency",
+			Help:      "Time in milliseconds to upload the insert binlogs of a flush",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 18), // 1ms to about 2 minutes
+		}, []string{"node_id"})
+
+	// DataNodeSegmentFlushLatency records the time in milliseconds from a flush of a segment enqueued till it's done
+	DataNodeSegmentFlushLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "segment_flush_latency",
+			Help:      "Time in milliseconds from a flush of a segment enqueued till it's done",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 18), // 1ms to about 2 minutes
+		}, []string{"node_id", "collection_id", "outcome"})
+
+	// DataNodeFlushBufferSize records the insert buffer size in bytes segments are flushed at
+	DataNodeFlushBufferSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "flush_buffer_size",
+			Help:      "Insert buffer size in bytes segments are flushed at",
+		}, []string{"node_id"})
+
+	// DataNodePendingFlushTasks records the number of flush tasks running or waiting in the flush queues of all segments
+	DataNodePendingFlushTasks = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "pending_flush_tasks",
+			Help:      "Number of flush tasks running or waiting in the flush queues",
+		}, []string{"node_id"})
+
+	// DataNodeInsertConstraintViolations counts the insert rows violating schema constraints per constraint and field
+	DataNodeInsertConstraintViolations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "insert_constraint_violations_total",
+			Help:      "Counter of insert rows violating schema constraints",
+		}, []string{"node_id", "constraint", "field_id"})
+
+	// DataNodePulsarReconnections counts the dm stream consumers recreated after no message pack was consumed in time
+	DataNodePulsarReconnections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "pulsar_reconnections_total",
+			Help:      "Counter of dm stream consumers recreated",
+		}, []string{"node_id"})
 )
 
 //RegisterDataNode register DataNode metrics
 func RegisterDataNode() {
 	prometheus.MustRegister(DataNodeFlushSegmentsCounter)
 	prometheus.MustRegister(DataNodeWatchDmChannelsCounter)
+	prometheus.MustRegister(DataNodeSaveBinlogTokens)
+	prometheus.MustRegister(DataNodeDurabilityAckLatency)
+	prometheus.MustRegister(DataNodeBlobIOWaitLatency)
+	prometheus.MustRegister(DataNodeDeltaLogIndexBuildLatency)
+	prometheus.MustRegister(DataNodePartialRecoveryCounter)
+	prometheus.MustRegister(DataNodeFieldCompressionRatio)
+	prometheus.MustRegister(DataNodeFlushLatency)
+	prometheus.MustRegister(DataNodeFlushBufferSize)
+	prometheus.MustRegister(DataNodeSegmentFlushLatency)
+	prometheus.MustRegister(DataNodePendingFlushTasks)
+	prometheus.MustRegister(DataNodeInsertConstraintViolations)
+	prometheus.MustRegister(DataNodePulsarReconnections)
 }
 
 //RegisterIndexCoord register IndexCoord metrics
@@ -34,6 +34,8 @@ type PmsFactory struct {
 	PulsarAddress  string
 	ReceiveBufSize int64
 	PulsarBufSize  int64
+	// subscription type of consumers, KeyShared by default
+	SubscriptionType mqclient.SubscriptionType
 }
 
 // SetParams is used to set parameters for PmsFactory
@@ -51,7 +53,12 @@ func (f *PmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.PulsarBufSize, pulsarClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	stream, err := NewMqMsgStream(ctx, f.ReceiveBufSize, f.PulsarBufSize, pulsarClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	if err != nil {
+		return nil, err
+	}
+	stream.subscriptionType = f.SubscriptionType
+	return stream, nil
 }
 
 // NewTtMsgStream is used to generate a new TtMsgstream object
@@ -60,7 +67,12 @@ func (f *PmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.PulsarBufSize, pulsarClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	stream, err := NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.PulsarBufSize, pulsarClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	if err != nil {
+		return nil, err
+	}
+	stream.subscriptionType = f.SubscriptionType
+	return stream, nil
 }
 
 // NewQueryMsgStream is used to generate a new QueryMsgstream object
@@ -74,6 +86,7 @@ func NewPmsFactory() Factory {
 		dispatcherFactory: ProtoUDFactory{},
 		ReceiveBufSize:    64,
 		PulsarBufSize:     64,
+		SubscriptionType:  mqclient.KeyShared,
 	}
 	return f
 }
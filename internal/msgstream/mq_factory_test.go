@@ -21,6 +21,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -59,6 +60,28 @@ func TestPmsFactory_SetParams(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestPmsFactory_SubscriptionType(t *testing.T) {
+	pmsFactory := NewPmsFactory()
+	assert.Equal(t, mqclient.KeyShared, pmsFactory.(*PmsFactory).SubscriptionType)
+
+	pulsarAddress, _ := Params.Load("_PulsarAddress")
+	m := map[string]interface{}{
+		"PulsarAddress":    pulsarAddress,
+		"SubscriptionType": mqclient.Shared,
+	}
+	err := pmsFactory.SetParams(m)
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+	stream, err := pmsFactory.NewMsgStream(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, mqclient.Shared, stream.(*mqMsgStream).subscriptionType)
+
+	ttStream, err := pmsFactory.NewTtMsgStream(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, mqclient.Shared, ttStream.(*MqTtMsgStream).subscriptionType)
+}
+
 func TestRmsFactory(t *testing.T) {
 	os.Setenv("ROCKSMQ_PATH", "/tmp/milvus")
 	defer os.Unsetenv("ROCKSMQ_PATH")
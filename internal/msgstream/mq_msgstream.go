@@ -54,6 +54,8 @@ type mqMsgStream struct {
 	bufSize          int64
 	producerLock     *sync.Mutex
 	consumerLock     *sync.Mutex
+
+	subscriptionType mqclient.SubscriptionType // subscription type of consumers
 }
 
 // NewMqMsgStream is used to generate a new mqMsgStream object
@@ -84,6 +86,7 @@ func NewMqMsgStream(ctx context.Context,
 		producerLock:     &sync.Mutex{},
 		consumerLock:     &sync.Mutex{},
 		wait:             &sync.WaitGroup{},
+		subscriptionType: mqclient.KeyShared,
 	}
 
 	return stream, nil
@@ -135,7 +138,7 @@ func (ms *mqMsgStream) AsConsumerWithPosition(channels []string, subName string,
 			pc, err := ms.client.Subscribe(mqclient.ConsumerOptions{
 				Topic:                       channel,
 				SubscriptionName:            subName,
-				Type:                        mqclient.KeyShared,
+				Type:                        ms.subscriptionType,
 				SubscriptionInitialPosition: position,
 				MessageChannel:              receiveChannel,
 			})
@@ -623,7 +626,7 @@ func (ms *MqTtMsgStream) AsConsumerWithPosition(channels []string, subName strin
 			pc, err := ms.client.Subscribe(mqclient.ConsumerOptions{
 				Topic:                       channel,
 				SubscriptionName:            subName,
-				Type:                        mqclient.KeyShared,
+				Type:                        ms.subscriptionType,
 				SubscriptionInitialPosition: position,
 				MessageChannel:              receiveChannel,
 			})
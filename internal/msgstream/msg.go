@@ -1049,3 +1049,56 @@ func (m *DataNodeTtMsg) Unmarshal(input MarshalType) (TsMsg, error) {
 		DataNodeTtMsg: msg,
 	}, nil
 }
+
+/////////////////////////////////////////DurabilityAck//////////////////////////////////////////
+
+// DurabilityAckMsg is a message pack that contains the persisted position of a segment
+type DurabilityAckMsg struct {
+	BaseMsg
+	datapb.DurabilityAckMsg
+}
+
+// interface implementation validation
+var _ TsMsg = &DurabilityAckMsg{}
+
+// ID returns the ID of this message pack
+func (m *DurabilityAckMsg) ID() UniqueID {
+	return m.Base.MsgID
+}
+
+// Type returns the type of this message pack
+func (m *DurabilityAckMsg) Type() MsgType {
+	return m.Base.MsgType
+}
+
+// SourceID indicated which component generated this message
+func (m *DurabilityAckMsg) SourceID() int64 {
+	return m.Base.SourceID
+}
+
+// Marshal is used to serializing a message pack to byte array
+func (m *DurabilityAckMsg) Marshal(input TsMsg) (MarshalType, error) {
+	msg := input.(*DurabilityAckMsg)
+	t, err := proto.Marshal(&msg.DurabilityAckMsg)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Unmarshal is used to deserializing a message pack from byte array
+func (m *DurabilityAckMsg) Unmarshal(input MarshalType) (TsMsg, error) {
+	msg := datapb.DurabilityAckMsg{}
+	in, err := convertToByteArray(input)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(in, &msg)
+	if err != nil {
+		return nil, err
+	}
+	ackMsg := &DurabilityAckMsg{DurabilityAckMsg: msg}
+	ackMsg.BeginTimestamp = msg.GetFlushedPosition().GetTimestamp()
+	ackMsg.EndTimestamp = msg.GetFlushedPosition().GetTimestamp()
+	return ackMsg, nil
+}
@@ -792,6 +792,61 @@ func TestDataNodeTtMsg_Unmarshal_IllegalParameter(t *testing.T) {
 	assert.Nil(t, tsMsg)
 }
 
+func TestDurabilityAckMsg(t *testing.T) {
+	durabilityAckMsg := &DurabilityAckMsg{
+		BaseMsg: generateBaseMsg(),
+		DurabilityAckMsg: datapb.DurabilityAckMsg{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DurabilityAck,
+				MsgID:     1,
+				Timestamp: 2,
+				SourceID:  3,
+			},
+			ChannelName:  "test-channel",
+			CollectionID: 4,
+			SegmentID:    5,
+			FlushedPosition: &internalpb.MsgPosition{
+				ChannelName: "test-channel",
+				MsgID:       []byte{1, 2, 3},
+				Timestamp:   6,
+			},
+		},
+	}
+
+	assert.NotNil(t, durabilityAckMsg.TraceCtx())
+
+	ctx := context.Background()
+	durabilityAckMsg.SetTraceCtx(ctx)
+	assert.Equal(t, ctx, durabilityAckMsg.TraceCtx())
+
+	assert.Equal(t, int64(1), durabilityAckMsg.ID())
+	assert.Equal(t, commonpb.MsgType_DurabilityAck, durabilityAckMsg.Type())
+	assert.Equal(t, int64(3), durabilityAckMsg.SourceID())
+
+	bytes, err := durabilityAckMsg.Marshal(durabilityAckMsg)
+	assert.Nil(t, err)
+
+	tsMsg, err := durabilityAckMsg.Unmarshal(bytes)
+	assert.Nil(t, err)
+
+	durabilityAckMsg2, ok := tsMsg.(*DurabilityAckMsg)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), durabilityAckMsg2.ID())
+	assert.Equal(t, commonpb.MsgType_DurabilityAck, durabilityAckMsg2.Type())
+	assert.Equal(t, int64(3), durabilityAckMsg2.SourceID())
+	assert.Equal(t, int64(5), durabilityAckMsg2.GetSegmentID())
+	assert.Equal(t, []byte{1, 2, 3}, durabilityAckMsg2.GetFlushedPosition().GetMsgID())
+	assert.Equal(t, uint64(6), durabilityAckMsg2.BeginTs())
+	assert.Equal(t, uint64(6), durabilityAckMsg2.EndTs())
+}
+
+func TestDurabilityAckMsg_Unmarshal_IllegalParameter(t *testing.T) {
+	durabilityAckMsg := &DurabilityAckMsg{}
+	tsMsg, err := durabilityAckMsg.Unmarshal(10)
+	assert.NotNil(t, err)
+	assert.Nil(t, tsMsg)
+}
+
 func TestSealedSegmentsChangeInfoMsg(t *testing.T) {
 	genSimpleSegmentInfo := func(segmentID UniqueID) *querypb.SegmentInfo {
 		return &querypb.SegmentInfo{
@@ -49,6 +49,14 @@ const (
 	ErrorCode_OutOfMemory           ErrorCode = 24
 	ErrorCode_IndexNotExist         ErrorCode = 25
 	ErrorCode_EmptyCollection       ErrorCode = 26
+	ErrorCode_Busy                  ErrorCode = 27
+	ErrorCode_SegmentTooSmall       ErrorCode = 28
+	ErrorCode_DuplicateSegment      ErrorCode = 29
+	ErrorCode_VersionMismatch       ErrorCode = 30
+	ErrorCode_SegmentNotFound       ErrorCode = 31
+	ErrorCode_SegmentLeaseExpired   ErrorCode = 32
+	ErrorCode_PartitionFrozen       ErrorCode = 33
+	ErrorCode_SegmentFlushStarted   ErrorCode = 34
 	// internal error code.
 	ErrorCode_DDRequestRace ErrorCode = 1000
 )
@@ -80,6 +88,14 @@ var ErrorCode_name = map[int32]string{
 	24:   "OutOfMemory",
 	25:   "IndexNotExist",
 	26:   "EmptyCollection",
+	27:   "Busy",
+	28:   "SegmentTooSmall",
+	29:   "DuplicateSegment",
+	30:   "VersionMismatch",
+	31:   "SegmentNotFound",
+	32:   "SegmentLeaseExpired",
+	33:   "PartitionFrozen",
+	34:   "SegmentFlushStarted",
 	1000: "DDRequestRace",
 }
 
@@ -110,6 +126,14 @@ var ErrorCode_value = map[string]int32{
 	"OutOfMemory":           24,
 	"IndexNotExist":         25,
 	"EmptyCollection":       26,
+	"Busy":                  27,
+	"SegmentTooSmall":       28,
+	"DuplicateSegment":      29,
+	"VersionMismatch":       30,
+	"SegmentNotFound":       31,
+	"SegmentLeaseExpired":   32,
+	"PartitionFrozen":       33,
+	"SegmentFlushStarted":   34,
 	"DDRequestRace":         1000,
 }
 
@@ -226,6 +250,7 @@ const (
 	MsgType_ReleaseSegments     MsgType = 253
 	MsgType_HandoffSegments     MsgType = 254
 	MsgType_LoadBalanceSegments MsgType = 255
+	MsgType_PreFetchSegments    MsgType = 256
 	// DEFINITION REQUESTS: INDEX
 	MsgType_CreateIndex   MsgType = 300
 	MsgType_DescribeIndex MsgType = 301
@@ -262,6 +287,7 @@ const (
 	MsgType_SegmentStatistics MsgType = 1206
 	MsgType_SegmentFlushDone  MsgType = 1207
 	MsgType_DataNodeTt        MsgType = 1208
+	MsgType_DurabilityAck     MsgType = 1209
 )
 
 var MsgType_name = map[int32]string{
@@ -290,6 +316,7 @@ var MsgType_name = map[int32]string{
 	253:  "ReleaseSegments",
 	254:  "HandoffSegments",
 	255:  "LoadBalanceSegments",
+	256:  "PreFetchSegments",
 	300:  "CreateIndex",
 	301:  "DescribeIndex",
 	302:  "DropIndex",
@@ -321,6 +348,7 @@ var MsgType_name = map[int32]string{
 	1206: "SegmentStatistics",
 	1207: "SegmentFlushDone",
 	1208: "DataNodeTt",
+	1209: "DurabilityAck",
 }
 
 var MsgType_value = map[string]int32{
@@ -349,6 +377,7 @@ var MsgType_value = map[string]int32{
 	"ReleaseSegments":          253,
 	"HandoffSegments":          254,
 	"LoadBalanceSegments":      255,
+	"PreFetchSegments":         256,
 	"CreateIndex":              300,
 	"DescribeIndex":            301,
 	"DropIndex":                302,
@@ -380,6 +409,7 @@ var MsgType_value = map[string]int32{
 	"SegmentStatistics":        1206,
 	"SegmentFlushDone":         1207,
 	"DataNodeTt":               1208,
+	"DurabilityAck":            1209,
 }
 
 func (x MsgType) String() string {
@@ -841,95 +871,102 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x73, 0x1b, 0xc7,
-	0x11, 0xe6, 0x62, 0x41, 0x82, 0x68, 0x82, 0xe4, 0x70, 0xf8, 0x10, 0xa5, 0x30, 0x29, 0x15, 0x4e,
-	0x2a, 0x56, 0x89, 0x4c, 0xa2, 0x4a, 0x72, 0xd2, 0x81, 0xc4, 0xf2, 0x81, 0x92, 0xf8, 0xc8, 0x82,
-	0x52, 0x52, 0x39, 0x44, 0x35, 0xdc, 0x6d, 0x02, 0x13, 0xed, 0xee, 0x20, 0x3b, 0xb3, 0x14, 0x71,
-	0x4b, 0xfe, 0x81, 0xad, 0xdf, 0x61, 0xbb, 0xfc, 0xb6, 0xcb, 0xbf, 0xc0, 0xef, 0xb3, 0xfd, 0x0f,
-	0xfc, 0x03, 0xfc, 0xd4, 0xd3, 0xd5, 0xb3, 0x0b, 0x60, 0x55, 0x25, 0x9d, 0x7c, 0x9b, 0xfe, 0xa6,
-	0xfb, 0x9b, 0x9e, 0xaf, 0x7b, 0x7a, 0x17, 0x1a, 0x81, 0x8a, 0x63, 0x95, 0x6c, 0xf4, 0x53, 0x65,
-	0x14, 0x5f, 0x8c, 0x65, 0x74, 0x9e, 0xe9, 0xdc, 0xda, 0xc8, 0xb7, 0x9a, 0xf7, 0x60, 0xaa, 0x63,
-	0x84, 0xc9, 0x34, 0xbf, 0x09, 0x80, 0x69, 0xaa, 0xd2, 0x7b, 0x81, 0x0a, 0x71, 0xd5, 0xb9, 0xea,
-	0x5c, 0x9b, 0xfb, 0xf3, 0x1f, 0x36, 0x5e, 0x12, 0xb3, 0xb1, 0x43, 0x6e, 0x2d, 0x15, 0xa2, 0x5f,
-	0xc7, 0xe1, 0x92, 0xaf, 0xc0, 0x54, 0x8a, 0x42, 0xab, 0x64, 0xb5, 0x72, 0xd5, 0xb9, 0x56, 0xf7,
-	0x0b, 0xab, 0xf9, 0x57, 0x68, 0xdc, 0xc2, 0xc1, 0x5d, 0x11, 0x65, 0x78, 0x2c, 0x64, 0xca, 0x19,
-	0xb8, 0xf7, 0x71, 0x60, 0xf9, 0xeb, 0x3e, 0x2d, 0xf9, 0x12, 0x4c, 0x9e, 0xd3, 0x76, 0x11, 0x98,
-	0x1b, 0xcd, 0x1b, 0x30, 0x73, 0x0b, 0x07, 0x9e, 0x30, 0xe2, 0x15, 0x61, 0x1c, 0xaa, 0xa1, 0x30,
-	0xc2, 0x46, 0x35, 0x7c, 0xbb, 0x6e, 0xae, 0x41, 0x75, 0x3b, 0x52, 0xa7, 0x63, 0x4a, 0xc7, 0x6e,
-	0x16, 0x94, 0xd7, 0xa1, 0xb6, 0x15, 0x86, 0x29, 0x6a, 0xcd, 0xe7, 0xa0, 0x22, 0xfb, 0x05, 0x5b,
-	0x45, 0xf6, 0x89, 0xac, 0xaf, 0x52, 0x63, 0xc9, 0x5c, 0xdf, 0xae, 0x9b, 0x0f, 0x1d, 0xa8, 0x1d,
-	0xe8, 0xee, 0xb6, 0xd0, 0xc8, 0xff, 0x06, 0xd3, 0xb1, 0xee, 0xde, 0x33, 0x83, 0xfe, 0x50, 0x9a,
-	0xb5, 0x97, 0x4a, 0x73, 0xa0, 0xbb, 0x27, 0x83, 0x3e, 0xfa, 0xb5, 0x38, 0x5f, 0x50, 0x26, 0xb1,
-	0xee, 0xb6, 0xbd, 0x82, 0x39, 0x37, 0xf8, 0x1a, 0xd4, 0x8d, 0x8c, 0x51, 0x1b, 0x11, 0xf7, 0x57,
-	0xdd, 0xab, 0xce, 0xb5, 0xaa, 0x3f, 0x06, 0xf8, 0x15, 0x98, 0xd6, 0x2a, 0x4b, 0x03, 0x6c, 0x7b,
-	0xab, 0x55, 0x1b, 0x36, 0xb2, 0x9b, 0x37, 0xa1, 0x7e, 0xa0, 0xbb, 0xfb, 0x28, 0x42, 0x4c, 0xf9,
-	0x1f, 0xa1, 0x7a, 0x2a, 0x74, 0x9e, 0xd1, 0xcc, 0xab, 0x33, 0xa2, 0x1b, 0xf8, 0xd6, 0xb3, 0xf9,
-	0x6f, 0x68, 0x78, 0x07, 0xb7, 0x7f, 0x03, 0x03, 0xa5, 0xae, 0x7b, 0x22, 0x0d, 0x0f, 0x45, 0x3c,
-	0xac, 0xd8, 0x18, 0x58, 0xff, 0xa4, 0x0a, 0xf5, 0x51, 0x7b, 0xf0, 0x19, 0xa8, 0x75, 0xb2, 0x20,
-	0x40, 0xad, 0xd9, 0x04, 0x5f, 0x84, 0xf9, 0x3b, 0x09, 0x5e, 0xf4, 0x31, 0x30, 0x18, 0x5a, 0x1f,
-	0xe6, 0xf0, 0x05, 0x98, 0x6d, 0xa9, 0x24, 0xc1, 0xc0, 0xec, 0x0a, 0x19, 0x61, 0xc8, 0x2a, 0x7c,
-	0x09, 0xd8, 0x31, 0xa6, 0xb1, 0xd4, 0x5a, 0xaa, 0xc4, 0xc3, 0x44, 0x62, 0xc8, 0x5c, 0x7e, 0x09,
-	0x16, 0x5b, 0x2a, 0x8a, 0x30, 0x30, 0x52, 0x25, 0x87, 0xca, 0xec, 0x5c, 0x48, 0x6d, 0x34, 0xab,
-	0x12, 0x6d, 0x3b, 0x8a, 0xb0, 0x2b, 0xa2, 0xad, 0xb4, 0x9b, 0xc5, 0x98, 0x18, 0x36, 0x49, 0x1c,
-	0x05, 0xe8, 0xc9, 0x18, 0x13, 0x62, 0x62, 0xb5, 0x12, 0xda, 0x4e, 0x42, 0xbc, 0xa0, 0xfa, 0xb0,
-	0x69, 0x7e, 0x19, 0x96, 0x0b, 0xb4, 0x74, 0x80, 0x88, 0x91, 0xd5, 0xf9, 0x3c, 0xcc, 0x14, 0x5b,
-	0x27, 0x47, 0xc7, 0xb7, 0x18, 0x94, 0x18, 0x7c, 0xf5, 0xc0, 0xc7, 0x40, 0xa5, 0x21, 0x9b, 0x29,
-	0xa5, 0x70, 0x17, 0x03, 0xa3, 0xd2, 0xb6, 0xc7, 0x1a, 0x94, 0x70, 0x01, 0x76, 0x50, 0xa4, 0x41,
-	0xcf, 0x47, 0x9d, 0x45, 0x86, 0xcd, 0x72, 0x06, 0x8d, 0x5d, 0x19, 0xe1, 0xa1, 0x32, 0xbb, 0x2a,
-	0x4b, 0x42, 0x36, 0xc7, 0xe7, 0x00, 0x0e, 0xd0, 0x88, 0x42, 0x81, 0x79, 0x3a, 0xb6, 0x25, 0x82,
-	0x1e, 0x16, 0x00, 0xe3, 0x2b, 0xc0, 0x5b, 0x22, 0x49, 0x94, 0x69, 0xa5, 0x28, 0x0c, 0xee, 0xaa,
-	0x28, 0xc4, 0x94, 0x2d, 0x50, 0x3a, 0x2f, 0xe0, 0x32, 0x42, 0xc6, 0xc7, 0xde, 0x1e, 0x46, 0x38,
-	0xf2, 0x5e, 0x1c, 0x7b, 0x17, 0x38, 0x79, 0x2f, 0x51, 0xf2, 0xdb, 0x99, 0x8c, 0x42, 0x2b, 0x49,
-	0x5e, 0x96, 0x65, 0xca, 0xb1, 0x48, 0xfe, 0xf0, 0x76, 0xbb, 0x73, 0xc2, 0x56, 0xf8, 0x32, 0x2c,
-	0x14, 0xc8, 0x01, 0x9a, 0x54, 0x06, 0x56, 0xbc, 0x4b, 0x94, 0xea, 0x51, 0x66, 0x8e, 0xce, 0x0e,
-	0x30, 0x56, 0xe9, 0x80, 0xad, 0x52, 0x41, 0x2d, 0xd3, 0xb0, 0x44, 0xec, 0x32, 0x9d, 0xb0, 0x13,
-	0xf7, 0xcd, 0x60, 0x2c, 0x2f, 0xbb, 0xc2, 0x39, 0xcc, 0x7a, 0x9e, 0x8f, 0xff, 0xcd, 0x50, 0x1b,
-	0x5f, 0x04, 0xc8, 0xbe, 0xab, 0xad, 0xff, 0x13, 0xc0, 0xc6, 0xd2, 0x40, 0x42, 0xce, 0x61, 0x6e,
-	0x6c, 0x1d, 0xaa, 0x04, 0xd9, 0x04, 0x6f, 0xc0, 0xf4, 0x9d, 0x44, 0x6a, 0x9d, 0x61, 0xc8, 0x1c,
-	0xd2, 0xad, 0x9d, 0x1c, 0xa7, 0xaa, 0x4b, 0x4f, 0x9a, 0x55, 0x68, 0x77, 0x57, 0x26, 0x52, 0xf7,
-	0x6c, 0xc7, 0x00, 0x4c, 0x15, 0x02, 0x56, 0xd7, 0x35, 0x34, 0x3a, 0xd8, 0xa5, 0xe6, 0xc8, 0xb9,
-	0x97, 0x80, 0x95, 0xed, 0x31, 0xfb, 0x28, 0x6d, 0x87, 0x9a, 0x77, 0x2f, 0x55, 0x0f, 0x64, 0xd2,
-	0x65, 0x15, 0x22, 0xeb, 0xa0, 0x88, 0x2c, 0xf1, 0x0c, 0xd4, 0x76, 0xa3, 0xcc, 0x9e, 0x52, 0xb5,
-	0x67, 0x92, 0x41, 0x6e, 0x93, 0xb4, 0xe5, 0xa5, 0xaa, 0xdf, 0xc7, 0x90, 0x4d, 0xad, 0x3f, 0x9d,
-	0xb6, 0xf3, 0xc3, 0x8e, 0x81, 0x59, 0xa8, 0xdf, 0x49, 0x42, 0x3c, 0x93, 0x09, 0x86, 0x6c, 0xc2,
-	0x96, 0xc2, 0x96, 0xac, 0xa4, 0x49, 0x48, 0x37, 0xa6, 0xe8, 0x12, 0x86, 0xa4, 0xe7, 0xbe, 0xd0,
-	0x25, 0xe8, 0x8c, 0xea, 0xeb, 0xa1, 0x0e, 0x52, 0x79, 0x5a, 0x0e, 0xef, 0x92, 0xce, 0x9d, 0x9e,
-	0x7a, 0x30, 0xc6, 0x34, 0xeb, 0xd1, 0x49, 0x7b, 0x68, 0x3a, 0x03, 0x6d, 0x30, 0x6e, 0xa9, 0xe4,
-	0x4c, 0x76, 0x35, 0x93, 0x74, 0xd2, 0x6d, 0x25, 0xc2, 0x52, 0xf8, 0x7f, 0xa8, 0xc2, 0x3e, 0x46,
-	0x28, 0x74, 0x99, 0xf5, 0xbe, 0x6d, 0x46, 0x9b, 0xea, 0x56, 0x24, 0x85, 0x66, 0x11, 0x5d, 0x85,
-	0xb2, 0xcc, 0xcd, 0x98, 0x8a, 0xb0, 0x15, 0x19, 0x4c, 0x73, 0x3b, 0xe1, 0x4b, 0x30, 0x9f, 0xfb,
-	0x1f, 0x8b, 0xd4, 0x48, 0x4b, 0xf2, 0xa9, 0x63, 0xcb, 0x9d, 0xaa, 0xfe, 0x18, 0xfb, 0x8c, 0xde,
-	0x7e, 0x63, 0x5f, 0xe8, 0x31, 0xf4, 0xb9, 0xc3, 0x57, 0x60, 0x61, 0x78, 0xb5, 0x31, 0xfe, 0x85,
-	0xc3, 0x17, 0x61, 0x8e, 0xae, 0x36, 0xc2, 0x34, 0xfb, 0xd2, 0x82, 0x74, 0x89, 0x12, 0xf8, 0x95,
-	0x65, 0x28, 0x6e, 0x51, 0xc2, 0xbf, 0xb6, 0x87, 0x11, 0x43, 0x51, 0x75, 0xcd, 0x1e, 0x39, 0x94,
-	0xe9, 0xf0, 0xb0, 0x02, 0x66, 0x8f, 0xad, 0x23, 0xb1, 0x8e, 0x1c, 0x9f, 0x58, 0xc7, 0x82, 0x73,
-	0x84, 0x3e, 0xb5, 0xe8, 0xbe, 0x48, 0x42, 0x75, 0x76, 0x36, 0x42, 0x9f, 0x39, 0x7c, 0x15, 0x16,
-	0x29, 0x7c, 0x5b, 0x44, 0x22, 0x09, 0xc6, 0xfe, 0xcf, 0x1d, 0xce, 0x86, 0x42, 0xda, 0xae, 0x66,
-	0x6f, 0x54, 0xac, 0x28, 0x45, 0x02, 0x39, 0xf6, 0x66, 0x85, 0xcf, 0xe5, 0xea, 0xe6, 0xf6, 0x5b,
-	0x15, 0x3e, 0x03, 0x53, 0xed, 0x44, 0x63, 0x6a, 0xd8, 0x6b, 0xd4, 0x79, 0x53, 0xf9, 0xdb, 0x65,
-	0xaf, 0x53, 0x7f, 0x4f, 0xda, 0xce, 0x63, 0x0f, 0xed, 0x46, 0x3e, 0x65, 0xd8, 0xf7, 0xae, 0xbd,
-	0x6a, 0x79, 0xe4, 0xfc, 0xe0, 0xd2, 0x49, 0x7b, 0x68, 0xc6, 0xcf, 0x89, 0xfd, 0xe8, 0xf2, 0x2b,
-	0xb0, 0x3c, 0xc4, 0xec, 0x00, 0x18, 0x3d, 0xa4, 0x9f, 0x5c, 0xbe, 0x06, 0x97, 0xf6, 0xd0, 0x8c,
-	0xfb, 0x80, 0x82, 0xa4, 0x36, 0x32, 0xd0, 0xec, 0x67, 0x97, 0xff, 0x0e, 0x56, 0xf6, 0xd0, 0x8c,
-	0xf4, 0x2d, 0x6d, 0xfe, 0xe2, 0xf2, 0x59, 0x98, 0xf6, 0x69, 0x42, 0xe0, 0x39, 0xb2, 0x47, 0x2e,
-	0x15, 0x69, 0x68, 0x16, 0xe9, 0x3c, 0x76, 0x49, 0xba, 0x7f, 0x08, 0x13, 0xf4, 0xbc, 0xb8, 0xd5,
-	0x13, 0x49, 0x82, 0x91, 0x66, 0x4f, 0x5c, 0xbe, 0x0c, 0xcc, 0xc7, 0x58, 0x9d, 0x63, 0x09, 0x7e,
-	0x4a, 0x93, 0x9f, 0x5b, 0xe7, 0xbf, 0x67, 0x98, 0x0e, 0x46, 0x1b, 0xcf, 0x5c, 0x92, 0x3a, 0xf7,
-	0x7f, 0x71, 0xe7, 0xb9, 0xcb, 0x7f, 0x0f, 0xab, 0xf9, 0x6b, 0x1d, 0xea, 0x4f, 0x9b, 0x5d, 0x6c,
-	0x27, 0x67, 0x8a, 0xfd, 0xaf, 0x3a, 0x62, 0xf4, 0x30, 0x32, 0x62, 0x14, 0xf7, 0xff, 0x2a, 0x95,
-	0xa8, 0x88, 0xb0, 0xae, 0xdf, 0x54, 0xf9, 0x3c, 0x40, 0xfe, 0x76, 0x2c, 0xf0, 0x6d, 0x95, 0xae,
-	0x77, 0x22, 0x63, 0x3c, 0x91, 0xc1, 0x7d, 0xf6, 0x76, 0x9d, 0xae, 0x67, 0x4f, 0x3f, 0x54, 0x21,
-	0x92, 0x0e, 0x9a, 0xbd, 0x53, 0xa7, 0x1a, 0x52, 0x0f, 0xe4, 0x35, 0x7c, 0xd7, 0xda, 0xc5, 0xa4,
-	0x6b, 0x7b, 0xec, 0x3d, 0xfa, 0xac, 0x40, 0x61, 0x9f, 0x74, 0x8e, 0xd8, 0xfb, 0x75, 0xd2, 0x63,
-	0x2b, 0x8a, 0x54, 0x20, 0xcc, 0xa8, 0x13, 0x3f, 0xa8, 0x53, 0x2b, 0x97, 0x86, 0x54, 0xa1, 0xf0,
-	0x87, 0x75, 0xd2, 0xa9, 0xc0, 0x6d, 0xfd, 0x3d, 0x1a, 0x5e, 0x1f, 0x59, 0x56, 0xfa, 0x5b, 0xa2,
-	0x4c, 0x4e, 0x0c, 0xfb, 0xb8, 0xbe, 0xde, 0x84, 0x9a, 0xa7, 0x23, 0x3b, 0x7e, 0x6a, 0xe0, 0x7a,
-	0x3a, 0x62, 0x13, 0xf4, 0x5a, 0xb7, 0x95, 0x8a, 0x76, 0x2e, 0xfa, 0xe9, 0xdd, 0x3f, 0x31, 0x67,
-	0x7d, 0x1b, 0xe6, 0x5b, 0x2a, 0xee, 0x8b, 0x51, 0x95, 0xed, 0xc4, 0xc9, 0x47, 0x15, 0x86, 0x79,
-	0xaf, 0x4c, 0xd0, 0x93, 0xdf, 0xb9, 0xc0, 0x20, 0x33, 0x34, 0xe5, 0x1c, 0x32, 0x29, 0x88, 0x1a,
-	0x31, 0x64, 0x95, 0xed, 0xbf, 0xfc, 0xeb, 0x46, 0x57, 0x9a, 0x5e, 0x76, 0x4a, 0x3f, 0x0c, 0x9b,
-	0xf9, 0x1f, 0xc4, 0x75, 0xa9, 0x8a, 0xd5, 0xa6, 0x4c, 0x0c, 0xa6, 0x89, 0x88, 0x36, 0xed, 0x4f,
-	0xc5, 0x66, 0xfe, 0x53, 0xd1, 0x3f, 0x3d, 0x9d, 0xb2, 0xf6, 0x8d, 0x5f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x87, 0x06, 0x40, 0xd1, 0xa5, 0x0a, 0x00, 0x00,
+	// 1537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x73, 0x1c, 0x49,
+	0x11, 0x56, 0xcf, 0x8c, 0x34, 0x9a, 0xd2, 0x48, 0x4a, 0x97, 0x1e, 0xd6, 0x7a, 0xb5, 0x8b, 0x99,
+	0x93, 0x43, 0x11, 0x6b, 0x03, 0x0e, 0xe0, 0xb4, 0x07, 0x69, 0x5a, 0x92, 0x27, 0x6c, 0xc9, 0x62,
+	0x66, 0x6c, 0x08, 0x0e, 0x38, 0x4a, 0xdd, 0xa9, 0x99, 0xc2, 0xd5, 0x5d, 0x4d, 0x55, 0xb5, 0xad,
+	0xe1, 0xb4, 0xfc, 0x03, 0xd8, 0x5f, 0xc1, 0x01, 0x08, 0xde, 0x8f, 0x7f, 0xc0, 0xfb, 0x0c, 0x37,
+	0x8e, 0xfc, 0x00, 0x9e, 0xfb, 0x24, 0xb2, 0xba, 0xa7, 0xa7, 0x37, 0x62, 0x7d, 0xe2, 0x56, 0xf9,
+	0x65, 0xe6, 0x57, 0xf9, 0xaa, 0xec, 0x66, 0xdd, 0x48, 0x27, 0x89, 0x4e, 0xef, 0x66, 0x46, 0x3b,
+	0xcd, 0xb7, 0x12, 0xa9, 0x5e, 0xe4, 0xb6, 0x90, 0xee, 0x16, 0xaa, 0xde, 0x33, 0xb6, 0x32, 0x72,
+	0xc2, 0xe5, 0x96, 0xbf, 0xcd, 0x18, 0x1a, 0xa3, 0xcd, 0xb3, 0x48, 0xc7, 0xb8, 0x17, 0xdc, 0x0e,
+	0xee, 0x6c, 0x7c, 0xe1, 0xcd, 0xbb, 0x9f, 0xe2, 0x73, 0xf7, 0x98, 0xcc, 0xfa, 0x3a, 0xc6, 0x61,
+	0x07, 0xe7, 0x47, 0xbe, 0xcb, 0x56, 0x0c, 0x0a, 0xab, 0xd3, 0xbd, 0xc6, 0xed, 0xe0, 0x4e, 0x67,
+	0x58, 0x4a, 0xbd, 0x2f, 0xb1, 0xee, 0x43, 0x9c, 0x3d, 0x15, 0x2a, 0xc7, 0x0b, 0x21, 0x0d, 0x07,
+	0xd6, 0x7c, 0x8e, 0x33, 0xcf, 0xdf, 0x19, 0xd2, 0x91, 0x6f, 0xb3, 0xe5, 0x17, 0xa4, 0x2e, 0x1d,
+	0x0b, 0xa1, 0x77, 0x9f, 0xad, 0x3d, 0xc4, 0x59, 0x28, 0x9c, 0x78, 0x85, 0x1b, 0x67, 0xad, 0x58,
+	0x38, 0xe1, 0xbd, 0xba, 0x43, 0x7f, 0xee, 0xed, 0xb3, 0xd6, 0x91, 0xd2, 0x97, 0x0b, 0xca, 0xc0,
+	0x2b, 0x4b, 0xca, 0xb7, 0x58, 0xfb, 0x30, 0x8e, 0x0d, 0x5a, 0xcb, 0x37, 0x58, 0x43, 0x66, 0x25,
+	0x5b, 0x43, 0x66, 0x44, 0x96, 0x69, 0xe3, 0x3c, 0x59, 0x73, 0xe8, 0xcf, 0xbd, 0x77, 0x03, 0xd6,
+	0x3e, 0xb3, 0x93, 0x23, 0x61, 0x91, 0x7f, 0x99, 0xad, 0x26, 0x76, 0xf2, 0xcc, 0xcd, 0xb2, 0x79,
+	0x69, 0xf6, 0x3f, 0xb5, 0x34, 0x67, 0x76, 0x32, 0x9e, 0x65, 0x38, 0x6c, 0x27, 0xc5, 0x81, 0x22,
+	0x49, 0xec, 0x64, 0x10, 0x96, 0xcc, 0x85, 0xc0, 0xf7, 0x59, 0xc7, 0xc9, 0x04, 0xad, 0x13, 0x49,
+	0xb6, 0xd7, 0xbc, 0x1d, 0xdc, 0x69, 0x0d, 0x17, 0x00, 0xbf, 0xc5, 0x56, 0xad, 0xce, 0x4d, 0x84,
+	0x83, 0x70, 0xaf, 0xe5, 0xdd, 0x2a, 0xb9, 0xf7, 0x36, 0xeb, 0x9c, 0xd9, 0xc9, 0x03, 0x14, 0x31,
+	0x1a, 0xfe, 0x39, 0xd6, 0xba, 0x14, 0xb6, 0x88, 0x68, 0xed, 0xd5, 0x11, 0x51, 0x06, 0x43, 0x6f,
+	0xd9, 0xfb, 0x06, 0xeb, 0x86, 0x67, 0x8f, 0xfe, 0x0f, 0x06, 0x0a, 0xdd, 0x4e, 0x85, 0x89, 0xcf,
+	0x45, 0x32, 0xef, 0xd8, 0x02, 0x38, 0xf8, 0xdb, 0x32, 0xeb, 0x54, 0xe3, 0xc1, 0xd7, 0x58, 0x7b,
+	0x94, 0x47, 0x11, 0x5a, 0x0b, 0x4b, 0x7c, 0x8b, 0x6d, 0x3e, 0x49, 0xf1, 0x3a, 0xc3, 0xc8, 0x61,
+	0xec, 0x6d, 0x20, 0xe0, 0x37, 0xd8, 0x7a, 0x5f, 0xa7, 0x29, 0x46, 0xee, 0x44, 0x48, 0x85, 0x31,
+	0x34, 0xf8, 0x36, 0x83, 0x0b, 0x34, 0x89, 0xb4, 0x56, 0xea, 0x34, 0xc4, 0x54, 0x62, 0x0c, 0x4d,
+	0x7e, 0x93, 0x6d, 0xf5, 0xb5, 0x52, 0x18, 0x39, 0xa9, 0xd3, 0x73, 0xed, 0x8e, 0xaf, 0xa5, 0x75,
+	0x16, 0x5a, 0x44, 0x3b, 0x50, 0x0a, 0x27, 0x42, 0x1d, 0x9a, 0x49, 0x9e, 0x60, 0xea, 0x60, 0x99,
+	0x38, 0x4a, 0x30, 0x94, 0x09, 0xa6, 0xc4, 0x04, 0xed, 0x1a, 0x3a, 0x48, 0x63, 0xbc, 0xa6, 0xfe,
+	0xc0, 0x2a, 0x7f, 0x8d, 0xed, 0x94, 0x68, 0xed, 0x02, 0x91, 0x20, 0x74, 0xf8, 0x26, 0x5b, 0x2b,
+	0x55, 0xe3, 0xc7, 0x17, 0x0f, 0x81, 0xd5, 0x18, 0x86, 0xfa, 0xe5, 0x10, 0x23, 0x6d, 0x62, 0x58,
+	0xab, 0x85, 0xf0, 0x14, 0x23, 0xa7, 0xcd, 0x20, 0x84, 0x2e, 0x05, 0x5c, 0x82, 0x23, 0x14, 0x26,
+	0x9a, 0x0e, 0xd1, 0xe6, 0xca, 0xc1, 0x3a, 0x07, 0xd6, 0x3d, 0x91, 0x0a, 0xcf, 0xb5, 0x3b, 0xd1,
+	0x79, 0x1a, 0xc3, 0x06, 0xdf, 0x60, 0xec, 0x0c, 0x9d, 0x28, 0x2b, 0xb0, 0x49, 0xd7, 0xf6, 0x45,
+	0x34, 0xc5, 0x12, 0x00, 0xbe, 0xcb, 0x78, 0x5f, 0xa4, 0xa9, 0x76, 0x7d, 0x83, 0xc2, 0xe1, 0x89,
+	0x56, 0x31, 0x1a, 0xb8, 0x41, 0xe1, 0x7c, 0x02, 0x97, 0x0a, 0x81, 0x2f, 0xac, 0x43, 0x54, 0x58,
+	0x59, 0x6f, 0x2d, 0xac, 0x4b, 0x9c, 0xac, 0xb7, 0x29, 0xf8, 0xa3, 0x5c, 0xaa, 0xd8, 0x97, 0xa4,
+	0x68, 0xcb, 0x0e, 0xc5, 0x58, 0x06, 0x7f, 0xfe, 0x68, 0x30, 0x1a, 0xc3, 0x2e, 0xdf, 0x61, 0x37,
+	0x4a, 0xe4, 0x0c, 0x9d, 0x91, 0x91, 0x2f, 0xde, 0x4d, 0x0a, 0xf5, 0x71, 0xee, 0x1e, 0x5f, 0x9d,
+	0x61, 0xa2, 0xcd, 0x0c, 0xf6, 0xa8, 0xa1, 0x9e, 0x69, 0xde, 0x22, 0x78, 0x8d, 0x6e, 0x38, 0x4e,
+	0x32, 0x37, 0x5b, 0x94, 0x17, 0x6e, 0xf1, 0x55, 0xd6, 0x3a, 0xca, 0xed, 0x0c, 0x5e, 0x27, 0xf5,
+	0x08, 0x27, 0xd4, 0xb8, 0xb1, 0xd6, 0xa3, 0x44, 0x28, 0x05, 0xfb, 0x14, 0x6b, 0x98, 0x67, 0x4a,
+	0x46, 0xc2, 0x61, 0xa9, 0x85, 0x37, 0xc8, 0xf4, 0x29, 0x1a, 0xea, 0xe6, 0x99, 0xb4, 0x89, 0x70,
+	0xd1, 0x14, 0xde, 0xac, 0xf9, 0x57, 0x25, 0xfd, 0x0c, 0x55, 0xbf, 0x04, 0x1f, 0xa1, 0xb0, 0x78,
+	0x7c, 0x9d, 0x49, 0x83, 0x31, 0xdc, 0x26, 0xeb, 0x0b, 0x61, 0x9c, 0xa4, 0x30, 0x4e, 0x8c, 0xfe,
+	0x36, 0xa6, 0xf0, 0xd9, 0x9a, 0xf5, 0x89, 0xca, 0xed, 0x74, 0xe4, 0x84, 0x71, 0x18, 0x43, 0x8f,
+	0x73, 0xb6, 0x1e, 0x86, 0x43, 0xfc, 0x56, 0x8e, 0xd6, 0x0d, 0x45, 0x84, 0xf0, 0xf7, 0xf6, 0xc1,
+	0xd7, 0x18, 0xf3, 0x19, 0xd2, 0xda, 0x44, 0xce, 0xd9, 0xc6, 0x42, 0x3a, 0xd7, 0x29, 0xc2, 0x12,
+	0xef, 0xb2, 0xd5, 0x27, 0xa9, 0xb4, 0x36, 0xc7, 0x18, 0x02, 0xea, 0xee, 0x20, 0xbd, 0x30, 0x7a,
+	0x42, 0x8b, 0x07, 0x1a, 0xa4, 0x3d, 0x91, 0xa9, 0xb4, 0x53, 0x3f, 0xd7, 0x8c, 0xad, 0x94, 0x6d,
+	0x6e, 0x1d, 0x58, 0xd6, 0x2d, 0xc3, 0x28, 0xb8, 0xb7, 0x19, 0xd4, 0xe5, 0x05, 0x7b, 0x55, 0xdc,
+	0x80, 0x9e, 0xd8, 0xa9, 0xd1, 0x2f, 0x65, 0x3a, 0x81, 0x06, 0x91, 0x8d, 0x50, 0x28, 0x4f, 0xbc,
+	0xc6, 0xda, 0x3e, 0x19, 0x62, 0xf6, 0x77, 0x92, 0x40, 0x66, 0xcb, 0xa4, 0x0a, 0x8d, 0xce, 0x32,
+	0x8c, 0x61, 0xe5, 0xe0, 0xfb, 0x1d, 0xbf, 0xe5, 0xfc, 0xb2, 0x5a, 0x67, 0x9d, 0x27, 0x69, 0x8c,
+	0x57, 0x32, 0xc5, 0x18, 0x96, 0xfc, 0xc0, 0xf8, 0xc1, 0xaa, 0x75, 0x2e, 0xa6, 0x8c, 0xc9, 0xbb,
+	0x86, 0x21, 0x75, 0xfd, 0x81, 0xb0, 0x35, 0xe8, 0x8a, 0xa6, 0x30, 0x44, 0x1b, 0x19, 0x79, 0x59,
+	0x77, 0x9f, 0xf8, 0x76, 0x4d, 0xf5, 0xcb, 0x05, 0x66, 0x61, 0x4a, 0x37, 0x9d, 0xa2, 0x1b, 0xcd,
+	0xac, 0xc3, 0xa4, 0xaf, 0xd3, 0x2b, 0x39, 0xb1, 0x20, 0xe9, 0xa6, 0x47, 0x5a, 0xc4, 0x35, 0xf7,
+	0x6f, 0xd2, 0x1c, 0x0e, 0x51, 0x51, 0x4f, 0x6b, 0xf0, 0x73, 0xff, 0x64, 0x7c, 0xa8, 0x87, 0x4a,
+	0x0a, 0x0b, 0x8a, 0x52, 0xa1, 0x28, 0x0b, 0x31, 0xa1, 0x26, 0x1c, 0x2a, 0x87, 0xa6, 0x90, 0x53,
+	0xbe, 0xcd, 0x36, 0x0b, 0xfb, 0x6a, 0x18, 0xe0, 0xb7, 0x81, 0x6f, 0xb7, 0xd1, 0xd9, 0x02, 0xfb,
+	0x1d, 0x6d, 0xa8, 0xee, 0x03, 0x61, 0x17, 0xd0, 0xef, 0x03, 0xbe, 0xcb, 0x6e, 0xcc, 0x53, 0x5b,
+	0xe0, 0x7f, 0x08, 0xf8, 0x16, 0xdb, 0xa0, 0xd4, 0x2a, 0xcc, 0xc2, 0x1f, 0x3d, 0x48, 0x49, 0xd4,
+	0xc0, 0x3f, 0x79, 0x86, 0x32, 0x8b, 0x1a, 0xfe, 0x67, 0x7f, 0x19, 0x31, 0x94, 0x5d, 0xb7, 0xf0,
+	0x5e, 0x40, 0x91, 0xce, 0x2f, 0x2b, 0x61, 0x78, 0xdf, 0x1b, 0x12, 0x6b, 0x65, 0xf8, 0x81, 0x37,
+	0x2c, 0x39, 0x2b, 0xf4, 0x43, 0x8f, 0x3e, 0x10, 0x69, 0xac, 0xaf, 0xae, 0x2a, 0xf4, 0xa3, 0x80,
+	0xef, 0xb1, 0x2d, 0x72, 0x3f, 0x12, 0x4a, 0xa4, 0xd1, 0xc2, 0xfe, 0xe3, 0x80, 0xef, 0x30, 0xb8,
+	0x30, 0x78, 0x82, 0x2e, 0x9a, 0x56, 0xf0, 0x3b, 0x0d, 0x0e, 0xf3, 0xfa, 0xfa, 0x61, 0x87, 0x1f,
+	0x34, 0x7c, 0xad, 0xca, 0xb8, 0x0a, 0xec, 0x87, 0x0d, 0xbe, 0x51, 0x14, 0xbd, 0x90, 0x7f, 0xd4,
+	0xe0, 0x6b, 0x6c, 0x65, 0x90, 0x5a, 0x34, 0x0e, 0xbe, 0x4b, 0x03, 0xb9, 0x52, 0x2c, 0x1e, 0xf8,
+	0x1e, 0x8d, 0xfd, 0xb2, 0x1f, 0x48, 0x78, 0xd7, 0x2b, 0x8a, 0x15, 0x09, 0xff, 0x68, 0xfa, 0x0a,
+	0xd4, 0xf7, 0xe5, 0x3f, 0x9b, 0x74, 0xd3, 0x29, 0xba, 0xc5, 0x2b, 0x83, 0x7f, 0x35, 0xf9, 0x2d,
+	0xb6, 0x33, 0xc7, 0xfc, 0xf6, 0xaa, 0xde, 0xd7, 0xbf, 0x9b, 0x7c, 0x9f, 0xdd, 0x3c, 0x45, 0xb7,
+	0x18, 0x0f, 0x72, 0x92, 0xd6, 0xc9, 0xc8, 0xc2, 0x7f, 0x9a, 0xfc, 0x75, 0xb6, 0x7b, 0x8a, 0xae,
+	0x2a, 0x7b, 0x4d, 0xf9, 0xdf, 0x26, 0x5f, 0x67, 0xab, 0x43, 0x5a, 0x6f, 0xf8, 0x02, 0xe1, 0xbd,
+	0x26, 0xf5, 0x6e, 0x2e, 0x96, 0xe1, 0xbc, 0xdf, 0xa4, 0x8a, 0x7e, 0x95, 0x56, 0x4f, 0x98, 0xf4,
+	0xa7, 0x22, 0x4d, 0x51, 0x59, 0xf8, 0xa0, 0x49, 0x75, 0x1b, 0x62, 0xa2, 0x5f, 0x60, 0x0d, 0xfe,
+	0x90, 0x3e, 0x5b, 0xdc, 0x1b, 0x7f, 0x25, 0x47, 0x33, 0xab, 0x14, 0x1f, 0x35, 0xa9, 0x03, 0x85,
+	0xfd, 0x27, 0x35, 0x1f, 0x37, 0xf9, 0x1b, 0x6c, 0xaf, 0x78, 0xc4, 0xf3, 0xfa, 0x93, 0x72, 0x82,
+	0x83, 0xf4, 0x4a, 0xc3, 0x3b, 0xad, 0x8a, 0x31, 0x44, 0xe5, 0x44, 0xe5, 0xf7, 0x9d, 0x16, 0xb5,
+	0xa8, 0xf4, 0xf0, 0xa6, 0x7f, 0x69, 0xf1, 0x4d, 0xc6, 0x8a, 0x27, 0xe5, 0x81, 0xbf, 0xb6, 0x28,
+	0xbd, 0xb1, 0x4c, 0x70, 0x2c, 0xa3, 0xe7, 0xf0, 0xe3, 0x0e, 0xa5, 0xe7, 0x6f, 0x3f, 0xd7, 0x31,
+	0x52, 0x1d, 0x2c, 0xfc, 0xa4, 0x43, 0x3d, 0xa4, 0xd1, 0x28, 0x7a, 0xf8, 0x53, 0x2f, 0x97, 0x0b,
+	0x70, 0x10, 0xc2, 0xcf, 0xe8, 0x9b, 0xc8, 0x4a, 0x79, 0x3c, 0x7a, 0x0c, 0x3f, 0xef, 0x50, 0x3d,
+	0x0e, 0x95, 0xd2, 0xf5, 0x4d, 0xfd, 0x8b, 0x0e, 0x4d, 0x78, 0x6d, 0x77, 0x95, 0x15, 0xfe, 0x65,
+	0x87, 0xea, 0x54, 0x5f, 0xb5, 0x21, 0xed, 0xb4, 0x5f, 0x79, 0x56, 0xfa, 0xd5, 0xa3, 0x48, 0xc6,
+	0x0e, 0x7e, 0xdd, 0xf1, 0xe3, 0x95, 0x1b, 0x71, 0x29, 0x95, 0x74, 0xb3, 0xc3, 0xe8, 0x39, 0xfc,
+	0xa6, 0x73, 0xd0, 0x63, 0xed, 0xd0, 0x2a, 0xbf, 0xa9, 0xda, 0xac, 0x19, 0x5a, 0x05, 0x4b, 0xf4,
+	0xb0, 0x8f, 0xb4, 0x56, 0xc7, 0xd7, 0x99, 0x79, 0xfa, 0x79, 0x08, 0x0e, 0x8e, 0xd8, 0x66, 0x5f,
+	0x27, 0x99, 0xa8, 0x3a, 0xef, 0x97, 0x53, 0xb1, 0xd5, 0x30, 0xf6, 0x00, 0x2c, 0xd1, 0x76, 0x38,
+	0xbe, 0xc6, 0x28, 0x77, 0xb4, 0x10, 0x03, 0x12, 0xc9, 0x89, 0x86, 0x33, 0x86, 0xc6, 0xd1, 0x17,
+	0xbf, 0x7e, 0x7f, 0x22, 0xdd, 0x34, 0xbf, 0xa4, 0x3f, 0xa0, 0x7b, 0xc5, 0x2f, 0xd1, 0x5b, 0x52,
+	0x97, 0xa7, 0x7b, 0x32, 0x75, 0x68, 0x52, 0xa1, 0xee, 0xf9, 0xbf, 0xa4, 0x7b, 0xc5, 0x5f, 0x52,
+	0x76, 0x79, 0xb9, 0xe2, 0xe5, 0xfb, 0xff, 0x1b, 0x00, 0x6c, 0x6e, 0x9a, 0xe6, 0x76, 0x0b, 0x00,
+	0x00,
 }
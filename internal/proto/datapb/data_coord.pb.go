@@ -81,6 +81,71 @@ func (CompactionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_82cd95f524594f49, []int{1}
 }
 
+type ChannelEventType int32
+
+const (
+	ChannelEventType_ChannelWatched  ChannelEventType = 0
+	ChannelEventType_ChannelReleased ChannelEventType = 1
+)
+
+var ChannelEventType_name = map[int32]string{
+	0: "ChannelWatched",
+	1: "ChannelReleased",
+}
+
+var ChannelEventType_value = map[string]int32{
+	"ChannelWatched":  0,
+	"ChannelReleased": 1,
+}
+
+func (x ChannelEventType) String() string {
+	return proto.EnumName(ChannelEventType_name, int32(x))
+}
+
+func (ChannelEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{2}
+}
+
+type CompactionPlanState int32
+
+const (
+	CompactionPlanState_AllPlanStates CompactionPlanState = 0
+	CompactionPlanState_PlanExecuting CompactionPlanState = 1
+	CompactionPlanState_PlanCompleted CompactionPlanState = 2
+	CompactionPlanState_PlanTimeout   CompactionPlanState = 3
+	CompactionPlanState_PlanFailed    CompactionPlanState = 4
+	CompactionPlanState_PlanReclaimed CompactionPlanState = 5
+	CompactionPlanState_PlanCancelled CompactionPlanState = 6
+)
+
+var CompactionPlanState_name = map[int32]string{
+	0: "AllPlanStates",
+	1: "PlanExecuting",
+	2: "PlanCompleted",
+	3: "PlanTimeout",
+	4: "PlanFailed",
+	5: "PlanReclaimed",
+	6: "PlanCancelled",
+}
+
+var CompactionPlanState_value = map[string]int32{
+	"AllPlanStates": 0,
+	"PlanExecuting": 1,
+	"PlanCompleted": 2,
+	"PlanTimeout":   3,
+	"PlanFailed":    4,
+	"PlanReclaimed": 5,
+	"PlanCancelled": 6,
+}
+
+func (x CompactionPlanState) String() string {
+	return proto.EnumName(CompactionPlanState_name, int32(x))
+}
+
+func (CompactionPlanState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{3}
+}
+
 type FlushRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -1372,6 +1437,11 @@ type SegmentInfo struct {
 	CreatedByCompaction  bool            `protobuf:"varint,14,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
 	CompactionFrom       []int64         `protobuf:"varint,15,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
 	DroppedAt            uint64          `protobuf:"varint,16,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
+	IsImported           bool            `protobuf:"varint,17,opt,name=is_imported,json=isImported,proto3" json:"is_imported,omitempty"`
+	Sketchlogs           []*FieldBinlog  `protobuf:"bytes,18,rep,name=sketchlogs,proto3" json:"sketchlogs,omitempty"`
+	Pinned               bool            `protobuf:"varint,19,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Version              int64           `protobuf:"varint,20,opt,name=version,proto3" json:"version,omitempty"`
+	FlushStarted         bool            `protobuf:"varint,21,opt,name=flush_started,json=flushStarted,proto3" json:"flush_started,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -1514,6 +1584,41 @@ func (m *SegmentInfo) GetDroppedAt() uint64 {
 	return 0
 }
 
+func (m *SegmentInfo) GetIsImported() bool {
+	if m != nil {
+		return m.IsImported
+	}
+	return false
+}
+
+func (m *SegmentInfo) GetSketchlogs() []*FieldBinlog {
+	if m != nil {
+		return m.Sketchlogs
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+func (m *SegmentInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetFlushStarted() bool {
+	if m != nil {
+		return m.FlushStarted
+	}
+	return false
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1562,19 +1667,29 @@ func (m *SegmentStartPosition) GetSegmentID() int64 {
 }
 
 type SaveBinlogPathsRequest struct {
-	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                   `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	Field2BinlogPaths    []*FieldBinlog          `protobuf:"bytes,4,rep,name=field2BinlogPaths,proto3" json:"field2BinlogPaths,omitempty"`
-	CheckPoints          []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
-	StartPositions       []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
-	Flushed              bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog          `protobuf:"bytes,8,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*DeltaLogInfo         `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	Dropped              bool                    `protobuf:"varint,10,opt,name=dropped,proto3" json:"dropped,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Base                *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID           int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID        int64                   `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Field2BinlogPaths   []*FieldBinlog          `protobuf:"bytes,4,rep,name=field2BinlogPaths,proto3" json:"field2BinlogPaths,omitempty"`
+	CheckPoints         []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
+	StartPositions      []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	Flushed             bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Field2StatslogPaths []*FieldBinlog          `protobuf:"bytes,8,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs           []*DeltaLogInfo         `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	Dropped             bool                    `protobuf:"varint,10,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// HyperLogLog sketches of non-vector numeric fields
+	Field2SketchlogPaths []*FieldBinlog `protobuf:"bytes,11,rep,name=field2SketchlogPaths,proto3" json:"field2SketchlogPaths,omitempty"`
+	// version of the segment meta expected by the caller, not checked if 0
+	ExpectedVersion int64 `protobuf:"varint,12,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// binlogs replace the ones recorded instead of being appended, set by the first flush of a segment
+	// recovered from incomplete binlogs, whose data is replayed from its start position
+	Rebuilt bool `protobuf:"varint,13,opt,name=rebuilt,proto3" json:"rebuilt,omitempty"`
+	// binlogs replace the ones recorded, set when binlogs of a flushed segment are restored from earlier
+	// object versions by a DataNode which may not watch the channel of the segment
+	IsRestored           bool     `protobuf:"varint,14,opt,name=is_restored,json=isRestored,proto3" json:"is_restored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SaveBinlogPathsRequest) Reset()         { *m = SaveBinlogPathsRequest{} }
@@ -1672,6 +1787,34 @@ func (m *SaveBinlogPathsRequest) GetDropped() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetField2SketchlogPaths() []*FieldBinlog {
+	if m != nil {
+		return m.Field2SketchlogPaths
+	}
+	return nil
+}
+
+func (m *SaveBinlogPathsRequest) GetExpectedVersion() int64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+func (m *SaveBinlogPathsRequest) GetRebuilt() bool {
+	if m != nil {
+		return m.Rebuilt
+	}
+	return false
+}
+
+func (m *SaveBinlogPathsRequest) GetIsRestored() bool {
+	if m != nil {
+		return m.IsRestored
+	}
+	return false
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -1853,6 +1996,78 @@ func (m *DataNodeTtMsg) GetTimestamp() uint64 {
 	return 0
 }
 
+// DurabilityAckMsg notifies that messages of the segment up to the position are persisted
+type DurabilityAckMsg struct {
+	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ChannelName          string                  `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	CollectionID         int64                   `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentID            int64                   `protobuf:"varint,4,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FlushedPosition      *internalpb.MsgPosition `protobuf:"bytes,5,opt,name=flushed_position,json=flushedPosition,proto3" json:"flushed_position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DurabilityAckMsg) Reset()         { *m = DurabilityAckMsg{} }
+func (m *DurabilityAckMsg) String() string { return proto.CompactTextString(m) }
+func (*DurabilityAckMsg) ProtoMessage()    {}
+func (*DurabilityAckMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{29}
+}
+
+func (m *DurabilityAckMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DurabilityAckMsg.Unmarshal(m, b)
+}
+func (m *DurabilityAckMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DurabilityAckMsg.Marshal(b, m, deterministic)
+}
+func (m *DurabilityAckMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurabilityAckMsg.Merge(m, src)
+}
+func (m *DurabilityAckMsg) XXX_Size() int {
+	return xxx_messageInfo_DurabilityAckMsg.Size(m)
+}
+func (m *DurabilityAckMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurabilityAckMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurabilityAckMsg proto.InternalMessageInfo
+
+func (m *DurabilityAckMsg) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DurabilityAckMsg) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *DurabilityAckMsg) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DurabilityAckMsg) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *DurabilityAckMsg) GetFlushedPosition() *internalpb.MsgPosition {
+	if m != nil {
+		return m.FlushedPosition
+	}
+	return nil
+}
+
 type ChannelStatus struct {
 	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	State                ChannelWatchState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.data.ChannelWatchState" json:"state,omitempty"`
@@ -1866,7 +2081,7 @@ func (m *ChannelStatus) Reset()         { *m = ChannelStatus{} }
 func (m *ChannelStatus) String() string { return proto.CompactTextString(m) }
 func (*ChannelStatus) ProtoMessage()    {}
 func (*ChannelStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{29}
+	return fileDescriptor_82cd95f524594f49, []int{30}
 }
 
 func (m *ChannelStatus) XXX_Unmarshal(b []byte) error {
@@ -1921,7 +2136,7 @@ func (m *DataNodeInfo) Reset()         { *m = DataNodeInfo{} }
 func (m *DataNodeInfo) String() string { return proto.CompactTextString(m) }
 func (*DataNodeInfo) ProtoMessage()    {}
 func (*DataNodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{30}
+	return fileDescriptor_82cd95f524594f49, []int{31}
 }
 
 func (m *DataNodeInfo) XXX_Unmarshal(b []byte) error {
@@ -1978,7 +2193,7 @@ func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
 func (m *SegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*SegmentBinlogs) ProtoMessage()    {}
 func (*SegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{31}
+	return fileDescriptor_82cd95f524594f49, []int{32}
 }
 
 func (m *SegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2046,7 +2261,7 @@ func (m *FieldBinlog) Reset()         { *m = FieldBinlog{} }
 func (m *FieldBinlog) String() string { return proto.CompactTextString(m) }
 func (*FieldBinlog) ProtoMessage()    {}
 func (*FieldBinlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{32}
+	return fileDescriptor_82cd95f524594f49, []int{33}
 }
 
 func (m *FieldBinlog) XXX_Unmarshal(b []byte) error {
@@ -2094,7 +2309,7 @@ func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{33}
+	return fileDescriptor_82cd95f524594f49, []int{34}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -2149,7 +2364,7 @@ func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{}
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{34}
+	return fileDescriptor_82cd95f524594f49, []int{35}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2192,19 +2407,23 @@ func (m *GetRecoveryInfoRequest) GetPartitionID() int64 {
 }
 
 type GetFlushedSegmentsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// max number of segments returned, all segments are returned if limit is 0
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token of the previous page, empty for the first page
+	PageToken            string   `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetFlushedSegmentsRequest) Reset()         { *m = GetFlushedSegmentsRequest{} }
 func (m *GetFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsRequest) ProtoMessage()    {}
 func (*GetFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{35}
+	return fileDescriptor_82cd95f524594f49, []int{36}
 }
 
 func (m *GetFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2246,19 +2465,35 @@ func (m *GetFlushedSegmentsRequest) GetPartitionID() int64 {
 	return 0
 }
 
+func (m *GetFlushedSegmentsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetFlushedSegmentsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type GetFlushedSegmentsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Segments             []int64          `protobuf:"varint,2,rep,packed,name=segments,proto3" json:"segments,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Status   *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Segments []int64          `protobuf:"varint,2,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	// token of the next page, empty if there are no more segments
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetFlushedSegmentsResponse) Reset()         { *m = GetFlushedSegmentsResponse{} }
 func (m *GetFlushedSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsResponse) ProtoMessage()    {}
 func (*GetFlushedSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{36}
+	return fileDescriptor_82cd95f524594f49, []int{37}
 }
 
 func (m *GetFlushedSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2293,110 +2528,263 @@ func (m *GetFlushedSegmentsResponse) GetSegments() []int64 {
 	return nil
 }
 
-type SegmentFlushCompletedMsg struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Segment              *SegmentInfo      `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *GetFlushedSegmentsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
 }
 
-func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedMsg{} }
-func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
-func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
-func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{37}
+type GetFlushedSegmentsV2Request struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// partition filter is ignored if partitionID < 0
+	PartitionID int64 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// states of segments to return, [Flushed] if empty
+	States           []commonpb.SegmentState `protobuf:"varint,4,rep,packed,name=states,proto3,enum=milvus.proto.common.SegmentState" json:"states,omitempty"`
+	IncludeDeltalogs bool                    `protobuf:"varint,5,opt,name=include_deltalogs,json=includeDeltalogs,proto3" json:"include_deltalogs,omitempty"`
+	// segmentID of the last segment in the previous page, 0 for the first page
+	Cursor               int64    `protobuf:"varint,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int64    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SegmentFlushCompletedMsg.Unmarshal(m, b)
+func (m *GetFlushedSegmentsV2Request) Reset()         { *m = GetFlushedSegmentsV2Request{} }
+func (m *GetFlushedSegmentsV2Request) String() string { return proto.CompactTextString(m) }
+func (*GetFlushedSegmentsV2Request) ProtoMessage()    {}
+func (*GetFlushedSegmentsV2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{38}
 }
-func (m *SegmentFlushCompletedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SegmentFlushCompletedMsg.Marshal(b, m, deterministic)
+
+func (m *GetFlushedSegmentsV2Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushedSegmentsV2Request.Unmarshal(m, b)
 }
-func (m *SegmentFlushCompletedMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SegmentFlushCompletedMsg.Merge(m, src)
+func (m *GetFlushedSegmentsV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushedSegmentsV2Request.Marshal(b, m, deterministic)
 }
-func (m *SegmentFlushCompletedMsg) XXX_Size() int {
-	return xxx_messageInfo_SegmentFlushCompletedMsg.Size(m)
+func (m *GetFlushedSegmentsV2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushedSegmentsV2Request.Merge(m, src)
 }
-func (m *SegmentFlushCompletedMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_SegmentFlushCompletedMsg.DiscardUnknown(m)
+func (m *GetFlushedSegmentsV2Request) XXX_Size() int {
+	return xxx_messageInfo_GetFlushedSegmentsV2Request.Size(m)
+}
+func (m *GetFlushedSegmentsV2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushedSegmentsV2Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SegmentFlushCompletedMsg proto.InternalMessageInfo
+var xxx_messageInfo_GetFlushedSegmentsV2Request proto.InternalMessageInfo
 
-func (m *SegmentFlushCompletedMsg) GetBase() *commonpb.MsgBase {
+func (m *GetFlushedSegmentsV2Request) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *SegmentFlushCompletedMsg) GetSegment() *SegmentInfo {
+func (m *GetFlushedSegmentsV2Request) GetCollectionID() int64 {
 	if m != nil {
-		return m.Segment
+		return m.CollectionID
 	}
-	return nil
-}
-
-type ChannelWatchInfo struct {
-	Vchan                *VchannelInfo     `protobuf:"bytes,1,opt,name=vchan,proto3" json:"vchan,omitempty"`
-	StartTs              int64             `protobuf:"varint,2,opt,name=startTs,proto3" json:"startTs,omitempty"`
-	State                ChannelWatchState `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.data.ChannelWatchState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	return 0
 }
 
-func (m *ChannelWatchInfo) Reset()         { *m = ChannelWatchInfo{} }
-func (m *ChannelWatchInfo) String() string { return proto.CompactTextString(m) }
-func (*ChannelWatchInfo) ProtoMessage()    {}
-func (*ChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{38}
+func (m *GetFlushedSegmentsV2Request) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
 }
 
-func (m *ChannelWatchInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelWatchInfo.Unmarshal(m, b)
-}
-func (m *ChannelWatchInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelWatchInfo.Marshal(b, m, deterministic)
-}
-func (m *ChannelWatchInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelWatchInfo.Merge(m, src)
-}
-func (m *ChannelWatchInfo) XXX_Size() int {
-	return xxx_messageInfo_ChannelWatchInfo.Size(m)
-}
-func (m *ChannelWatchInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelWatchInfo.DiscardUnknown(m)
+func (m *GetFlushedSegmentsV2Request) GetStates() []commonpb.SegmentState {
+	if m != nil {
+		return m.States
+	}
+	return nil
 }
 
-var xxx_messageInfo_ChannelWatchInfo proto.InternalMessageInfo
-
-func (m *ChannelWatchInfo) GetVchan() *VchannelInfo {
+func (m *GetFlushedSegmentsV2Request) GetIncludeDeltalogs() bool {
 	if m != nil {
-		return m.Vchan
+		return m.IncludeDeltalogs
 	}
-	return nil
+	return false
 }
 
-func (m *ChannelWatchInfo) GetStartTs() int64 {
+func (m *GetFlushedSegmentsV2Request) GetCursor() int64 {
 	if m != nil {
-		return m.StartTs
+		return m.Cursor
 	}
 	return 0
 }
 
-func (m *ChannelWatchInfo) GetState() ChannelWatchState {
+func (m *GetFlushedSegmentsV2Request) GetLimit() int64 {
 	if m != nil {
-		return m.State
+		return m.Limit
 	}
-	return ChannelWatchState_Uncomplete
+	return 0
 }
 
-type CompactionSegmentBinlogs struct {
-	SegmentID            int64           `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+type GetFlushedSegmentsV2Response struct {
+	Status   *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Segments []*SegmentInfo   `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	// cursor of the next page, 0 if there are no more segments
+	NextCursor           int64    `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFlushedSegmentsV2Response) Reset()         { *m = GetFlushedSegmentsV2Response{} }
+func (m *GetFlushedSegmentsV2Response) String() string { return proto.CompactTextString(m) }
+func (*GetFlushedSegmentsV2Response) ProtoMessage()    {}
+func (*GetFlushedSegmentsV2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{39}
+}
+
+func (m *GetFlushedSegmentsV2Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushedSegmentsV2Response.Unmarshal(m, b)
+}
+func (m *GetFlushedSegmentsV2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushedSegmentsV2Response.Marshal(b, m, deterministic)
+}
+func (m *GetFlushedSegmentsV2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushedSegmentsV2Response.Merge(m, src)
+}
+func (m *GetFlushedSegmentsV2Response) XXX_Size() int {
+	return xxx_messageInfo_GetFlushedSegmentsV2Response.Size(m)
+}
+func (m *GetFlushedSegmentsV2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushedSegmentsV2Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushedSegmentsV2Response proto.InternalMessageInfo
+
+func (m *GetFlushedSegmentsV2Response) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetFlushedSegmentsV2Response) GetSegments() []*SegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *GetFlushedSegmentsV2Response) GetNextCursor() int64 {
+	if m != nil {
+		return m.NextCursor
+	}
+	return 0
+}
+
+type SegmentFlushCompletedMsg struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Segment              *SegmentInfo      `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedMsg{} }
+func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
+func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
+func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{40}
+}
+
+func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentFlushCompletedMsg.Unmarshal(m, b)
+}
+func (m *SegmentFlushCompletedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentFlushCompletedMsg.Marshal(b, m, deterministic)
+}
+func (m *SegmentFlushCompletedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentFlushCompletedMsg.Merge(m, src)
+}
+func (m *SegmentFlushCompletedMsg) XXX_Size() int {
+	return xxx_messageInfo_SegmentFlushCompletedMsg.Size(m)
+}
+func (m *SegmentFlushCompletedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentFlushCompletedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentFlushCompletedMsg proto.InternalMessageInfo
+
+func (m *SegmentFlushCompletedMsg) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SegmentFlushCompletedMsg) GetSegment() *SegmentInfo {
+	if m != nil {
+		return m.Segment
+	}
+	return nil
+}
+
+type ChannelWatchInfo struct {
+	Vchan                *VchannelInfo     `protobuf:"bytes,1,opt,name=vchan,proto3" json:"vchan,omitempty"`
+	StartTs              int64             `protobuf:"varint,2,opt,name=startTs,proto3" json:"startTs,omitempty"`
+	State                ChannelWatchState `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.data.ChannelWatchState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ChannelWatchInfo) Reset()         { *m = ChannelWatchInfo{} }
+func (m *ChannelWatchInfo) String() string { return proto.CompactTextString(m) }
+func (*ChannelWatchInfo) ProtoMessage()    {}
+func (*ChannelWatchInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{41}
+}
+
+func (m *ChannelWatchInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelWatchInfo.Unmarshal(m, b)
+}
+func (m *ChannelWatchInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelWatchInfo.Marshal(b, m, deterministic)
+}
+func (m *ChannelWatchInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelWatchInfo.Merge(m, src)
+}
+func (m *ChannelWatchInfo) XXX_Size() int {
+	return xxx_messageInfo_ChannelWatchInfo.Size(m)
+}
+func (m *ChannelWatchInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelWatchInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelWatchInfo proto.InternalMessageInfo
+
+func (m *ChannelWatchInfo) GetVchan() *VchannelInfo {
+	if m != nil {
+		return m.Vchan
+	}
+	return nil
+}
+
+func (m *ChannelWatchInfo) GetStartTs() int64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *ChannelWatchInfo) GetState() ChannelWatchState {
+	if m != nil {
+		return m.State
+	}
+	return ChannelWatchState_Uncomplete
+}
+
+type CompactionSegmentBinlogs struct {
+	SegmentID            int64           `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	FieldBinlogs         []*FieldBinlog  `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
 	Field2StatslogPaths  []*FieldBinlog  `protobuf:"bytes,3,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
 	Deltalogs            []*DeltaLogInfo `protobuf:"bytes,4,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
@@ -2409,7 +2797,7 @@ func (m *CompactionSegmentBinlogs) Reset()         { *m = CompactionSegmentBinlo
 func (m *CompactionSegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*CompactionSegmentBinlogs) ProtoMessage()    {}
 func (*CompactionSegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+	return fileDescriptor_82cd95f524594f49, []int{42}
 }
 
 func (m *CompactionSegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2459,23 +2847,25 @@ func (m *CompactionSegmentBinlogs) GetDeltalogs() []*DeltaLogInfo {
 }
 
 type CompactionPlan struct {
-	PlanID               int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
-	StartTime            uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	TimeoutInSeconds     int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
-	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
-	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
-	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	PlanID           int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentBinlogs   []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
+	StartTime        uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeoutInSeconds int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
+	Type             CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
+	Timetravel       uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	Channel          string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	// ids of plans which must be completed before the plan starts, e.g. L1 to L2 compaction depends on L0 to L1 compaction
+	DependsOn            []int64  `protobuf:"varint,8,rep,packed,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
 func (m *CompactionPlan) String() string { return proto.CompactTextString(m) }
 func (*CompactionPlan) ProtoMessage()    {}
 func (*CompactionPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{40}
+	return fileDescriptor_82cd95f524594f49, []int{43}
 }
 
 func (m *CompactionPlan) XXX_Unmarshal(b []byte) error {
@@ -2545,6 +2935,13 @@ func (m *CompactionPlan) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionPlan) GetDependsOn() []int64 {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
 type CompactionResult struct {
 	PlanID               int64           `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
 	SegmentID            int64           `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -2561,7 +2958,7 @@ func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
 func (m *CompactionResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionResult) ProtoMessage()    {}
 func (*CompactionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{41}
+	return fileDescriptor_82cd95f524594f49, []int{44}
 }
 
 func (m *CompactionResult) XXX_Unmarshal(b []byte) error {
@@ -2637,7 +3034,7 @@ func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{}
 func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
 func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{42}
+	return fileDescriptor_82cd95f524594f49, []int{45}
 }
 
 func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
@@ -2684,7 +3081,7 @@ func (m *WatchChannelsRequest) Reset()         { *m = WatchChannelsRequest{} }
 func (m *WatchChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsRequest) ProtoMessage()    {}
 func (*WatchChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{43}
+	return fileDescriptor_82cd95f524594f49, []int{46}
 }
 
 func (m *WatchChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -2730,7 +3127,7 @@ func (m *WatchChannelsResponse) Reset()         { *m = WatchChannelsResponse{} }
 func (m *WatchChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsResponse) ProtoMessage()    {}
 func (*WatchChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{44}
+	return fileDescriptor_82cd95f524594f49, []int{47}
 }
 
 func (m *WatchChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -2758,898 +3155,6843 @@ func (m *WatchChannelsResponse) GetStatus() *commonpb.Status {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
-	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
-	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
-	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
-	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
-	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
-	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
-	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
-	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
-	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
-	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
-	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
-	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
-	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
-	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
-	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
-	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
-	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
-	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
-	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
-	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
-	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
-	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
-	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
-	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
-	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
-	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
-	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
-	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
-	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
-	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
-	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
-	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
-	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
-	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
-	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
-	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
-	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
-	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
-	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
+type WatchChannelsV2Request struct {
+	CollectionID int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ChannelNames []string `protobuf:"bytes,2,rep,name=channelNames,proto3" json:"channelNames,omitempty"`
+	// vchannel name to the position to resume watching from,
+	// channels absent are watched from the position recovered from segment checkpoints
+	SeekPositions        map[string]*internalpb.MsgPosition `protobuf:"bytes,3,rep,name=seek_positions,json=seekPositions,proto3" json:"seek_positions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
-func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+func (m *WatchChannelsV2Request) Reset()         { *m = WatchChannelsV2Request{} }
+func (m *WatchChannelsV2Request) String() string { return proto.CompactTextString(m) }
+func (*WatchChannelsV2Request) ProtoMessage()    {}
+func (*WatchChannelsV2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{48}
+}
 
-var fileDescriptor_82cd95f524594f49 = []byte{
-	// 2602 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xdd, 0x6f, 0x1b, 0xc7,
-	0x11, 0xf7, 0xf1, 0x43, 0x22, 0x87, 0x14, 0x45, 0xad, 0x1d, 0x99, 0xa5, 0x6d, 0x59, 0xbe, 0x24,
-	0xb6, 0xe2, 0x38, 0x92, 0x2d, 0x37, 0x68, 0x50, 0x27, 0x0d, 0x2c, 0xcb, 0x56, 0x85, 0x4a, 0xae,
-	0x7a, 0x54, 0xe2, 0xa2, 0x01, 0x4a, 0x9c, 0x78, 0x2b, 0xea, 0x6a, 0xde, 0x1d, 0x7d, 0xbb, 0x94,
-	0xad, 0xbc, 0xc4, 0x48, 0x81, 0x02, 0x2d, 0xda, 0xa6, 0x45, 0x5f, 0x0b, 0xb4, 0xe8, 0x53, 0x81,
-	0xbe, 0xb4, 0x05, 0xfa, 0xd2, 0xfe, 0x03, 0x45, 0xfb, 0xde, 0x7f, 0xa3, 0xff, 0x42, 0xb0, 0x1f,
-	0xb7, 0xf7, 0xc1, 0x23, 0x79, 0x92, 0xfc, 0xf1, 0xc6, 0xdd, 0x9b, 0x9d, 0x99, 0x9d, 0x9d, 0xf9,
-	0xcd, 0xcc, 0x2e, 0xa1, 0x6e, 0x99, 0xd4, 0x6c, 0x77, 0x3c, 0xcf, 0xb7, 0x96, 0xfb, 0xbe, 0x47,
-	0x3d, 0x34, 0xe7, 0xd8, 0xbd, 0xc3, 0x01, 0x11, 0xa3, 0x65, 0xf6, 0xb9, 0x59, 0xed, 0x78, 0x8e,
-	0xe3, 0xb9, 0x62, 0xaa, 0x59, 0xb3, 0x5d, 0x8a, 0x7d, 0xd7, 0xec, 0xc9, 0x71, 0x35, 0xba, 0xa0,
-	0x59, 0x25, 0x9d, 0x03, 0xec, 0x98, 0x62, 0xa4, 0x3f, 0x83, 0xea, 0x83, 0xde, 0x80, 0x1c, 0x18,
-	0xf8, 0xc9, 0x00, 0x13, 0x8a, 0x6e, 0x42, 0x61, 0xcf, 0x24, 0xb8, 0xa1, 0x2d, 0x6a, 0x4b, 0x95,
-	0xd5, 0x8b, 0xcb, 0x31, 0x59, 0x52, 0xca, 0x36, 0xe9, 0xae, 0x99, 0x04, 0x1b, 0x9c, 0x12, 0x21,
-	0x28, 0x58, 0x7b, 0x9b, 0xeb, 0x8d, 0xdc, 0xa2, 0xb6, 0x94, 0x37, 0xf8, 0x6f, 0xa4, 0x43, 0xb5,
-	0xe3, 0xf5, 0x7a, 0xb8, 0x43, 0x6d, 0xcf, 0xdd, 0x5c, 0x6f, 0x14, 0xf8, 0xb7, 0xd8, 0x9c, 0xfe,
-	0x7b, 0x0d, 0x66, 0xa4, 0x68, 0xd2, 0xf7, 0x5c, 0x82, 0xd1, 0x6d, 0x98, 0x22, 0xd4, 0xa4, 0x03,
-	0x22, 0xa5, 0x5f, 0x48, 0x95, 0xde, 0xe2, 0x24, 0x86, 0x24, 0xcd, 0x24, 0x3e, 0x3f, 0x2c, 0x1e,
-	0x2d, 0x00, 0x10, 0xdc, 0x75, 0xb0, 0x4b, 0x37, 0xd7, 0x49, 0xa3, 0xb0, 0x98, 0x5f, 0xca, 0x1b,
-	0x91, 0x19, 0xfd, 0xb7, 0x1a, 0xd4, 0x5b, 0xc1, 0x30, 0xb0, 0xce, 0x39, 0x28, 0x76, 0xbc, 0x81,
-	0x4b, 0xb9, 0x82, 0x33, 0x86, 0x18, 0xa0, 0x2b, 0x50, 0xed, 0x1c, 0x98, 0xae, 0x8b, 0x7b, 0x6d,
-	0xd7, 0x74, 0x30, 0x57, 0xa5, 0x6c, 0x54, 0xe4, 0xdc, 0x43, 0xd3, 0xc1, 0x99, 0x34, 0x5a, 0x84,
-	0x4a, 0xdf, 0xf4, 0xa9, 0x1d, 0xb3, 0x59, 0x74, 0x4a, 0xff, 0xa3, 0x06, 0xf3, 0x77, 0x09, 0xb1,
-	0xbb, 0xee, 0x90, 0x66, 0xf3, 0x30, 0xe5, 0x7a, 0x16, 0xde, 0x5c, 0xe7, 0xaa, 0xe5, 0x0d, 0x39,
-	0x42, 0x17, 0xa0, 0xdc, 0xc7, 0xd8, 0x6f, 0xfb, 0x5e, 0x2f, 0x50, 0xac, 0xc4, 0x26, 0x0c, 0xaf,
-	0x87, 0xd1, 0x0f, 0x60, 0x8e, 0x24, 0x18, 0x91, 0x46, 0x7e, 0x31, 0xbf, 0x54, 0x59, 0x7d, 0x73,
-	0x79, 0xc8, 0xcb, 0x96, 0x93, 0x42, 0x8d, 0xe1, 0xd5, 0xfa, 0xf3, 0x1c, 0x9c, 0x55, 0x74, 0x42,
-	0x57, 0xf6, 0x9b, 0x59, 0x8e, 0xe0, 0xae, 0x52, 0x4f, 0x0c, 0xb2, 0x58, 0x4e, 0x99, 0x3c, 0x1f,
-	0x35, 0x79, 0x06, 0x07, 0x4b, 0xda, 0xb3, 0x38, 0x64, 0x4f, 0x74, 0x19, 0x2a, 0xf8, 0x59, 0xdf,
-	0xf6, 0x71, 0x9b, 0xda, 0x0e, 0x6e, 0x4c, 0x2d, 0x6a, 0x4b, 0x05, 0x03, 0xc4, 0xd4, 0xae, 0xed,
-	0x44, 0x3d, 0x72, 0x3a, 0xb3, 0x47, 0xea, 0x7f, 0xd2, 0xe0, 0xfc, 0xd0, 0x29, 0x49, 0x17, 0x37,
-	0xa0, 0xce, 0x77, 0x1e, 0x5a, 0x86, 0x39, 0x3b, 0x33, 0xf8, 0xd5, 0x71, 0x06, 0x0f, 0xc9, 0x8d,
-	0xa1, 0xf5, 0x11, 0x25, 0x73, 0xd9, 0x95, 0x7c, 0x0c, 0xe7, 0x37, 0x30, 0x95, 0x02, 0xd8, 0x37,
-	0x4c, 0x4e, 0x0e, 0x01, 0xf1, 0x58, 0xca, 0x0d, 0xc5, 0xd2, 0x5f, 0x73, 0x2a, 0x96, 0xb8, 0xa8,
-	0x4d, 0x77, 0xdf, 0x43, 0x17, 0xa1, 0xac, 0x48, 0xa4, 0x57, 0x84, 0x13, 0xe8, 0x5b, 0x50, 0x64,
-	0x9a, 0x0a, 0x97, 0xa8, 0xad, 0x5e, 0x49, 0xdf, 0x53, 0x84, 0xa7, 0x21, 0xe8, 0xd1, 0x26, 0xd4,
-	0x08, 0x35, 0x7d, 0xda, 0xee, 0x7b, 0x84, 0x9f, 0x33, 0x77, 0x9c, 0xca, 0xaa, 0x1e, 0xe7, 0xa0,
-	0x20, 0x72, 0x9b, 0x74, 0x77, 0x24, 0xa5, 0x31, 0xc3, 0x57, 0x06, 0x43, 0x74, 0x1f, 0xaa, 0xd8,
-	0xb5, 0x42, 0x46, 0x85, 0xcc, 0x8c, 0x2a, 0xd8, 0xb5, 0x14, 0x9b, 0xf0, 0x7c, 0x8a, 0xd9, 0xcf,
-	0xe7, 0x97, 0x1a, 0x34, 0x86, 0x0f, 0xe8, 0x34, 0x40, 0x79, 0x47, 0x2c, 0xc2, 0xe2, 0x80, 0xc6,
-	0x46, 0xb8, 0x3a, 0x24, 0x43, 0x2e, 0xd1, 0x6d, 0x78, 0x23, 0xd4, 0x86, 0x7f, 0x79, 0x69, 0xce,
-	0xf2, 0x53, 0x0d, 0xe6, 0x93, 0xb2, 0x4e, 0xb3, 0xef, 0x6f, 0x42, 0xd1, 0x76, 0xf7, 0xbd, 0x60,
-	0xdb, 0x0b, 0x63, 0xe2, 0x8c, 0xc9, 0x12, 0xc4, 0xba, 0x03, 0x17, 0x36, 0x30, 0xdd, 0x74, 0x09,
-	0xf6, 0xe9, 0x9a, 0xed, 0xf6, 0xbc, 0xee, 0x8e, 0x49, 0x0f, 0x4e, 0x11, 0x23, 0x31, 0x77, 0xcf,
-	0x25, 0xdc, 0x5d, 0xff, 0xb3, 0x06, 0x17, 0xd3, 0xe5, 0xc9, 0xad, 0x37, 0xa1, 0xb4, 0x6f, 0xe3,
-	0x9e, 0xc5, 0x6c, 0xa6, 0x71, 0x9b, 0xa9, 0x31, 0x8b, 0x95, 0x3e, 0x23, 0x96, 0x3b, 0xbc, 0x32,
-	0xc2, 0x41, 0x5b, 0xd4, 0xb7, 0xdd, 0xee, 0x96, 0x4d, 0xa8, 0x21, 0xe8, 0x23, 0xf6, 0xcc, 0x67,
-	0xf7, 0xcc, 0x5f, 0x68, 0xb0, 0xb0, 0x81, 0xe9, 0x3d, 0x05, 0xb5, 0xec, 0xbb, 0x4d, 0xa8, 0xdd,
-	0x21, 0x2f, 0xb7, 0x88, 0x48, 0xc9, 0x99, 0xfa, 0x57, 0x1a, 0x5c, 0x1e, 0xa9, 0x8c, 0x34, 0x9d,
-	0x84, 0x92, 0x00, 0x68, 0xd3, 0xa1, 0xe4, 0x7b, 0xf8, 0xe8, 0x53, 0xb3, 0x37, 0xc0, 0x3b, 0xa6,
-	0xed, 0x0b, 0x28, 0x39, 0x21, 0xb0, 0xfe, 0x45, 0x83, 0x4b, 0x1b, 0x98, 0xee, 0x04, 0x69, 0xe6,
-	0x35, 0x5a, 0x27, 0x43, 0x45, 0xf1, 0x6b, 0x71, 0x98, 0xa9, 0xda, 0xbe, 0x16, 0xf3, 0x2d, 0xf0,
-	0x38, 0x88, 0x04, 0xe4, 0x3d, 0x51, 0x0b, 0x48, 0xe3, 0xe9, 0xff, 0xc8, 0x41, 0xf5, 0x53, 0x59,
-	0x1f, 0xf0, 0x34, 0x92, 0xb4, 0x83, 0x96, 0x6e, 0x87, 0x48, 0x49, 0x91, 0x56, 0x65, 0x6c, 0xc0,
-	0x0c, 0xc1, 0xf8, 0xf1, 0x49, 0x92, 0x46, 0x95, 0x2d, 0x54, 0x60, 0xbf, 0x05, 0x73, 0x03, 0x77,
-	0x9f, 0x95, 0xb5, 0xd8, 0x92, 0xbb, 0x10, 0xd5, 0xe5, 0x64, 0xe4, 0x19, 0x5e, 0x88, 0xbe, 0x0b,
-	0xb3, 0x49, 0x5e, 0xc5, 0x4c, 0xbc, 0x92, 0xcb, 0xf4, 0x9f, 0x6b, 0x30, 0xff, 0xc8, 0xa4, 0x9d,
-	0x83, 0x75, 0x47, 0x5a, 0xf4, 0x14, 0xfe, 0xf8, 0x11, 0x94, 0x0f, 0xa5, 0xf5, 0x02, 0xd0, 0xb9,
-	0x9c, 0xa2, 0x50, 0xf4, 0x9c, 0x8c, 0x70, 0x85, 0xfe, 0x6f, 0x0d, 0xce, 0xf1, 0xca, 0x3f, 0xd0,
-	0xee, 0xd5, 0x47, 0xc6, 0x84, 0xea, 0x1f, 0x5d, 0x85, 0x9a, 0x63, 0xfa, 0x8f, 0x5b, 0x21, 0x4d,
-	0x91, 0xd3, 0x24, 0x66, 0xf5, 0x67, 0x00, 0x72, 0xb4, 0x4d, 0xba, 0x27, 0xd0, 0xff, 0x03, 0x98,
-	0x96, 0x52, 0x65, 0x90, 0x4c, 0x3a, 0xd8, 0x80, 0x5c, 0xff, 0x8f, 0x06, 0xb5, 0x10, 0xf6, 0x78,
-	0x28, 0xd4, 0x20, 0xa7, 0x02, 0x20, 0xb7, 0xb9, 0x8e, 0x3e, 0x82, 0x29, 0xd1, 0xeb, 0x49, 0xde,
-	0x6f, 0xc7, 0x79, 0xcb, 0x3e, 0x30, 0x82, 0x9d, 0x7c, 0xc2, 0x90, 0x8b, 0x98, 0x8d, 0x14, 0x54,
-	0x88, 0xb6, 0x20, 0x6f, 0x44, 0x66, 0xd0, 0x26, 0xcc, 0xc6, 0x2b, 0xad, 0xc0, 0xd1, 0x17, 0x47,
-	0x41, 0xc4, 0xba, 0x49, 0x4d, 0x8e, 0x10, 0xb5, 0x58, 0xa1, 0x45, 0xf4, 0xff, 0x17, 0xa1, 0x12,
-	0xd9, 0xe5, 0xd0, 0x4e, 0x92, 0x47, 0x9a, 0x9b, 0x0c, 0x76, 0xf9, 0xe1, 0x72, 0xff, 0x6d, 0xa8,
-	0xd9, 0x3c, 0xc1, 0xb6, 0xa5, 0x2b, 0x72, 0x44, 0x2c, 0x1b, 0x33, 0x62, 0x56, 0xc6, 0x05, 0x5a,
-	0x80, 0x8a, 0x3b, 0x70, 0xda, 0xde, 0x7e, 0xdb, 0xf7, 0x9e, 0x12, 0xd9, 0x37, 0x94, 0xdd, 0x81,
-	0xf3, 0xfd, 0x7d, 0xc3, 0x7b, 0x4a, 0xc2, 0xd2, 0x74, 0xea, 0x98, 0xa5, 0xe9, 0x02, 0x54, 0x1c,
-	0xf3, 0x19, 0xe3, 0xda, 0x76, 0x07, 0x0e, 0x6f, 0x29, 0xf2, 0x46, 0xd9, 0x31, 0x9f, 0x19, 0xde,
-	0xd3, 0x87, 0x03, 0x07, 0x2d, 0x41, 0xbd, 0x67, 0x12, 0xda, 0x8e, 0xf6, 0x24, 0x25, 0xde, 0x93,
-	0xd4, 0xd8, 0xfc, 0xfd, 0xb0, 0x2f, 0x19, 0x2e, 0x72, 0xcb, 0xa7, 0x28, 0x72, 0x2d, 0xa7, 0x17,
-	0x32, 0x82, 0xec, 0x45, 0xae, 0xe5, 0xf4, 0x14, 0x9b, 0x0f, 0x60, 0x7a, 0x8f, 0x97, 0x2d, 0xa4,
-	0x51, 0x19, 0x89, 0x50, 0x0f, 0x58, 0xc5, 0x22, 0xaa, 0x1b, 0x23, 0x20, 0x47, 0x1f, 0x42, 0x99,
-	0xe7, 0x0b, 0xbe, 0xb6, 0x9a, 0x69, 0x6d, 0xb8, 0x80, 0x41, 0x91, 0x85, 0x7b, 0xd4, 0xe4, 0xab,
-	0x67, 0x46, 0x42, 0xd1, 0x3a, 0xa3, 0xd9, 0xf2, 0xba, 0x02, 0x8a, 0xd4, 0x0a, 0x74, 0x13, 0xce,
-	0x76, 0x7c, 0x6c, 0x52, 0x6c, 0xad, 0x1d, 0xdd, 0xf3, 0x9c, 0xbe, 0xc9, 0xbd, 0xa9, 0x51, 0x5b,
-	0xd4, 0x96, 0x4a, 0x46, 0xda, 0x27, 0x86, 0x0c, 0x1d, 0x35, 0x7a, 0xe0, 0x7b, 0x4e, 0x63, 0x56,
-	0x20, 0x43, 0x7c, 0x16, 0x5d, 0x02, 0xb0, 0x7c, 0xaf, 0xdf, 0xc7, 0x56, 0xdb, 0xa4, 0x8d, 0x3a,
-	0x3f, 0xc6, 0xb2, 0x9c, 0xb9, 0x4b, 0xf5, 0x2f, 0xe0, 0x5c, 0xe8, 0x22, 0x91, 0xe3, 0x18, 0x3e,
-	0x59, 0xed, 0xa4, 0x27, 0x3b, 0xbe, 0xe2, 0xfc, 0x7b, 0x01, 0xe6, 0x5b, 0xe6, 0x21, 0x7e, 0xf9,
-	0xc5, 0x6d, 0x26, 0x40, 0xde, 0x82, 0x39, 0x5e, 0xcf, 0xae, 0x46, 0xf4, 0x19, 0x93, 0x37, 0xa3,
-	0xde, 0x30, 0xbc, 0x10, 0x7d, 0xcc, 0x12, 0x3e, 0xee, 0x3c, 0xde, 0xf1, 0xec, 0x30, 0x67, 0x5e,
-	0x4a, 0xe1, 0x73, 0x4f, 0x51, 0x19, 0xd1, 0x15, 0x68, 0x67, 0x18, 0xdb, 0xa6, 0x38, 0x93, 0x6b,
-	0x63, 0xbb, 0xa6, 0xd0, 0xfa, 0x49, 0x88, 0x43, 0x0d, 0x98, 0x96, 0x39, 0x99, 0x07, 0x7e, 0xc9,
-	0x08, 0x86, 0x68, 0x07, 0xce, 0x8a, 0x1d, 0xb4, 0xa4, 0x57, 0x8b, 0xcd, 0x97, 0x32, 0x6d, 0x3e,
-	0x6d, 0x69, 0x3c, 0x28, 0xca, 0xc7, 0x0e, 0x8a, 0x06, 0x4c, 0x4b, 0x47, 0xe5, 0x68, 0x50, 0x32,
-	0x82, 0x21, 0xab, 0xfd, 0x21, 0x34, 0xd9, 0x84, 0x16, 0xfe, 0x3b, 0x50, 0x52, 0x4e, 0x9c, 0xcb,
-	0xec, 0xc4, 0x6a, 0x4d, 0x12, 0x87, 0xf3, 0x09, 0x1c, 0xd6, 0xff, 0xab, 0x41, 0x35, 0xba, 0x05,
-	0x86, 0xef, 0x3e, 0xee, 0x78, 0xbe, 0xd5, 0xc6, 0x2e, 0xf5, 0x6d, 0x2c, 0xda, 0xc4, 0x82, 0x31,
-	0x23, 0x66, 0xef, 0x8b, 0x49, 0x46, 0xc6, 0xa0, 0x95, 0x50, 0xd3, 0xe9, 0xb7, 0xf7, 0x59, 0x04,
-	0xe7, 0x04, 0x99, 0x9a, 0xe5, 0x01, 0x7c, 0x05, 0xaa, 0x21, 0x19, 0xf5, 0xb8, 0xfc, 0x82, 0x51,
-	0x51, 0x73, 0xbb, 0x1e, 0x7a, 0x0b, 0x6a, 0xdc, 0x6a, 0xed, 0x9e, 0xd7, 0x6d, 0xb3, 0x96, 0x4a,
-	0x26, 0x94, 0xaa, 0x25, 0xd5, 0x62, 0xc7, 0x11, 0xa7, 0x22, 0xf6, 0xe7, 0x58, 0xa6, 0x14, 0x45,
-	0xd5, 0xb2, 0x3f, 0xc7, 0xfa, 0x97, 0x1a, 0xcc, 0xb0, 0xfc, 0xf8, 0xd0, 0xb3, 0xf0, 0xee, 0x09,
-	0xab, 0x89, 0x0c, 0xd7, 0x69, 0x17, 0xa1, 0xac, 0x76, 0x20, 0xb7, 0x14, 0x4e, 0xb0, 0xde, 0x7b,
-	0x46, 0xa6, 0xc1, 0x96, 0xba, 0x5e, 0xe5, 0xac, 0x34, 0xce, 0x8a, 0xff, 0x46, 0xdf, 0x8e, 0xdf,
-	0xcd, 0xbc, 0x95, 0x1a, 0x57, 0x9c, 0x09, 0xaf, 0x38, 0x63, 0x39, 0x30, 0x4b, 0x53, 0xf7, 0x9c,
-	0x1d, 0xac, 0x34, 0x05, 0x3f, 0xd8, 0x06, 0x4c, 0x9b, 0x96, 0xe5, 0x63, 0x42, 0xa4, 0x1e, 0xc1,
-	0x90, 0x7d, 0x39, 0xc4, 0x3e, 0x09, 0x5c, 0x2c, 0x6f, 0x04, 0x43, 0xf4, 0x21, 0x94, 0x54, 0x89,
-	0x9a, 0x4f, 0x2b, 0x4b, 0xa2, 0x7a, 0xca, 0x26, 0x44, 0xad, 0xd0, 0xbf, 0xca, 0x41, 0x4d, 0x86,
-	0xf5, 0x9a, 0xcc, 0x53, 0xe3, 0x9d, 0x7d, 0x0d, 0xaa, 0xfb, 0x61, 0x58, 0x8e, 0xbb, 0x6c, 0x88,
-	0x46, 0x6f, 0x6c, 0xcd, 0x24, 0x87, 0x8f, 0x67, 0xca, 0xc2, 0xa9, 0x32, 0x65, 0xf1, 0xb8, 0xa0,
-	0xa0, 0xdf, 0x85, 0x4a, 0x84, 0x31, 0x87, 0x33, 0x71, 0xff, 0x20, 0x6d, 0x11, 0x0c, 0xd9, 0x97,
-	0xbd, 0x88, 0x11, 0xca, 0x2a, 0xd3, 0xb3, 0xba, 0xff, 0xfc, 0x06, 0xa6, 0x06, 0xee, 0x78, 0x87,
-	0xd8, 0x3f, 0x3a, 0xfd, 0xd5, 0xce, 0x9d, 0xc8, 0x19, 0x67, 0x6c, 0x43, 0xd4, 0x02, 0x74, 0x27,
-	0xd4, 0x33, 0x9f, 0xd6, 0xd9, 0x46, 0xa1, 0x5d, 0x9e, 0x50, 0xb8, 0x95, 0xdf, 0x88, 0x4b, 0xaa,
-	0xf8, 0x56, 0x4e, 0x9a, 0x3d, 0x5f, 0x48, 0x75, 0xab, 0xff, 0x4e, 0x83, 0x6f, 0x6c, 0x60, 0xfa,
-	0x20, 0xde, 0xf8, 0xbd, 0x6e, 0xad, 0x1c, 0x68, 0xa6, 0x29, 0x75, 0x9a, 0x53, 0x6f, 0x42, 0x89,
-	0x04, 0xdd, 0xb0, 0xb8, 0x3e, 0x54, 0x63, 0xfd, 0x67, 0x1a, 0x34, 0xa4, 0x14, 0x2e, 0x93, 0x15,
-	0x6e, 0x3d, 0x4c, 0xb1, 0xf5, 0xaa, 0xdb, 0xb3, 0x3f, 0x68, 0x50, 0x8f, 0x82, 0x20, 0xc7, 0xb1,
-	0xf7, 0xa1, 0xc8, 0xbb, 0x60, 0xa9, 0xc1, 0x44, 0x67, 0x15, 0xd4, 0x2c, 0xa2, 0x78, 0x31, 0xb1,
-	0x4b, 0x02, 0x90, 0x93, 0xc3, 0x10, 0x89, 0xf3, 0xc7, 0x46, 0x62, 0xfd, 0x57, 0x39, 0x68, 0x84,
-	0x75, 0xed, 0x2b, 0x07, 0xbb, 0x11, 0x55, 0x4f, 0xfe, 0x05, 0x55, 0x3d, 0x85, 0x63, 0x03, 0xdc,
-	0xbf, 0x72, 0xac, 0xa1, 0x0e, 0xec, 0xb1, 0xd3, 0x33, 0x5d, 0x34, 0x0f, 0x53, 0xfd, 0x9e, 0x19,
-	0xde, 0x2a, 0xc9, 0x11, 0x6a, 0x41, 0x8d, 0xc4, 0xec, 0x25, 0x2d, 0xf0, 0x6e, 0x9a, 0xfd, 0x47,
-	0x98, 0xd8, 0x48, 0xb0, 0x60, 0x0d, 0x83, 0x28, 0x39, 0x79, 0xdf, 0x27, 0x53, 0xb3, 0x38, 0x68,
-	0xd6, 0xf2, 0xdd, 0x00, 0xc4, 0x3e, 0x78, 0x03, 0xda, 0xb6, 0xdd, 0x36, 0xc1, 0x1d, 0xcf, 0xb5,
-	0x08, 0xaf, 0x37, 0x8a, 0x46, 0x5d, 0x7e, 0xd9, 0x74, 0x5b, 0x62, 0x1e, 0xbd, 0x0f, 0x05, 0x7a,
-	0xd4, 0x17, 0x95, 0x46, 0x2d, 0x15, 0xd9, 0x42, 0xbd, 0x76, 0x8f, 0xfa, 0xd8, 0xe0, 0xe4, 0xac,
-	0xe5, 0x67, 0xac, 0xa8, 0x6f, 0x1e, 0xe2, 0x5e, 0xf0, 0x1e, 0x16, 0xce, 0x30, 0x4f, 0x0c, 0x5a,
-	0xe7, 0x69, 0x91, 0x88, 0xe5, 0x50, 0xff, 0x67, 0x0e, 0xea, 0x21, 0x4b, 0x03, 0x93, 0x41, 0x8f,
-	0x8e, 0xb4, 0xdf, 0xf8, 0x76, 0x61, 0x52, 0x1a, 0xfc, 0x18, 0x2a, 0xb2, 0x8d, 0x3f, 0x46, 0x22,
-	0x04, 0xb1, 0x64, 0x6b, 0x8c, 0xeb, 0x15, 0x5f, 0x90, 0xeb, 0x4d, 0x1d, 0xdb, 0xf5, 0x5a, 0x30,
-	0x1f, 0x80, 0x56, 0x28, 0x69, 0x1b, 0x53, 0x73, 0x4c, 0x9a, 0xbd, 0x0c, 0x15, 0x91, 0x8c, 0x44,
-	0xe1, 0x29, 0x4a, 0x3d, 0xd8, 0x53, 0x4d, 0x90, 0xfe, 0x63, 0x38, 0xc7, 0x83, 0x3e, 0x79, 0xdd,
-	0x97, 0xe5, 0xc2, 0x54, 0x57, 0x85, 0x24, 0x2b, 0x1a, 0x83, 0x44, 0x1e, 0x9b, 0xd3, 0xb7, 0xe0,
-	0x8d, 0x04, 0xff, 0x53, 0x80, 0xfa, 0xf5, 0x5b, 0x30, 0x37, 0x84, 0x54, 0xa8, 0x06, 0xf0, 0x89,
-	0xdb, 0x91, 0x10, 0x5e, 0x3f, 0x83, 0xaa, 0x50, 0x0a, 0x00, 0xbd, 0xae, 0x5d, 0x6f, 0x45, 0xe3,
-	0x95, 0x39, 0x31, 0x3a, 0x0f, 0x67, 0x3f, 0x71, 0x2d, 0xbc, 0x6f, 0xbb, 0xd8, 0x0a, 0x3f, 0xd5,
-	0xcf, 0xa0, 0xb3, 0x30, 0xbb, 0xe9, 0xba, 0xd8, 0x8f, 0x4c, 0x6a, 0x6c, 0x72, 0x1b, 0xfb, 0x5d,
-	0x1c, 0x99, 0xcc, 0xad, 0xfe, 0x6d, 0x0e, 0xca, 0xac, 0xf6, 0xbc, 0xe7, 0x79, 0xbe, 0x85, 0xfa,
-	0x80, 0xf8, 0xeb, 0x82, 0xd3, 0xf7, 0x5c, 0xf5, 0x0c, 0x87, 0x6e, 0x8e, 0x68, 0x63, 0x86, 0x49,
-	0xa5, 0xcd, 0x9b, 0x57, 0x47, 0xac, 0x48, 0x90, 0xeb, 0x67, 0x90, 0xc3, 0x25, 0xb2, 0x88, 0xdf,
-	0xb5, 0x3b, 0x8f, 0x83, 0x2b, 0xa9, 0x31, 0x12, 0x13, 0xa4, 0x81, 0xc4, 0xc4, 0xeb, 0x9e, 0x1c,
-	0x88, 0x27, 0xa0, 0xe0, 0xa4, 0xf4, 0x33, 0xe8, 0x09, 0x9c, 0xdb, 0xc0, 0x34, 0xbc, 0xf5, 0x0f,
-	0x04, 0xae, 0x8e, 0x16, 0x38, 0x44, 0x7c, 0x4c, 0x91, 0x5b, 0x50, 0xe4, 0xa9, 0x19, 0xa5, 0x45,
-	0x48, 0xf4, 0xbf, 0x28, 0xcd, 0xc5, 0xd1, 0x04, 0x8a, 0xdb, 0x4f, 0x60, 0x36, 0xf1, 0xd6, 0x8e,
-	0xde, 0x49, 0x59, 0x96, 0xfe, 0xaf, 0x89, 0xe6, 0xf5, 0x2c, 0xa4, 0x4a, 0x56, 0x17, 0x6a, 0xf1,
-	0xb7, 0x09, 0xb4, 0x94, 0xb2, 0x3e, 0xf5, 0x9d, 0xb4, 0xf9, 0x4e, 0x06, 0x4a, 0x25, 0xc8, 0x81,
-	0x7a, 0xf2, 0xed, 0x17, 0x5d, 0x1f, 0xcb, 0x20, 0xee, 0x6e, 0xef, 0x66, 0xa2, 0x55, 0xe2, 0x8e,
-	0xb8, 0x13, 0x0c, 0xbd, 0x3d, 0xa2, 0xe5, 0x74, 0x36, 0xa3, 0x1e, 0x45, 0x9b, 0x2b, 0x99, 0xe9,
-	0x95, 0xe8, 0x2f, 0x45, 0x4b, 0x90, 0xf6, 0x7e, 0x87, 0x6e, 0xa5, 0xb3, 0x1b, 0xf3, 0xf0, 0xd8,
-	0x5c, 0x3d, 0xce, 0x12, 0xa5, 0xc4, 0x17, 0xbc, 0x96, 0x4f, 0x79, 0x03, 0x4b, 0xc6, 0x5d, 0xc0,
-	0x6f, 0xf4, 0xe3, 0x5e, 0xf3, 0xd6, 0x31, 0x56, 0x28, 0x05, 0xbc, 0xe4, 0xeb, 0x7a, 0x10, 0x86,
-	0x2b, 0x13, 0xbd, 0xe6, 0x64, 0x31, 0xf8, 0x19, 0xcc, 0x26, 0xee, 0xfe, 0x52, 0xa3, 0x26, 0xfd,
-	0x7e, 0xb0, 0x39, 0x0e, 0xd0, 0x45, 0x48, 0x26, 0x5a, 0x23, 0x34, 0xc2, 0xfb, 0x53, 0xda, 0xa7,
-	0xe6, 0xf5, 0x2c, 0xa4, 0x6a, 0x23, 0x84, 0xc3, 0x65, 0xa2, 0xbd, 0x40, 0x37, 0xd2, 0x79, 0xa4,
-	0xb7, 0x46, 0xcd, 0xf7, 0x32, 0x52, 0x2b, 0xa1, 0x6d, 0x80, 0x0d, 0x4c, 0xb7, 0x31, 0xf5, 0x99,
-	0x8f, 0x5c, 0x4d, 0x35, 0x79, 0x48, 0x10, 0x88, 0xb9, 0x36, 0x91, 0x4e, 0x09, 0xf8, 0x21, 0xa0,
-	0x20, 0xcf, 0x45, 0x6e, 0x9e, 0xdf, 0x1c, 0x5b, 0xc5, 0x89, 0x92, 0x6b, 0xd2, 0xd9, 0x3c, 0x81,
-	0xfa, 0xb6, 0xe9, 0x0e, 0xcc, 0x5e, 0x84, 0xef, 0x8d, 0x54, 0xc5, 0x92, 0x64, 0x23, 0xac, 0x35,
-	0x92, 0x5a, 0x6d, 0xe6, 0xa9, 0xca, 0xa1, 0xa6, 0x0a, 0x41, 0x9c, 0xc4, 0x96, 0xd0, 0x1a, 0x09,
-	0xc2, 0x11, 0xd8, 0x32, 0x86, 0x5e, 0x09, 0x7e, 0xae, 0xf1, 0xff, 0x70, 0x24, 0x08, 0x1e, 0xd9,
-	0xf4, 0x80, 0x15, 0xf7, 0x24, 0x8b, 0x0a, 0x9c, 0xf0, 0x18, 0x2a, 0x48, 0x7a, 0xa5, 0x82, 0x05,
-	0x33, 0xb1, 0x1a, 0x09, 0xa5, 0x5d, 0x1f, 0xa7, 0x55, 0x69, 0xcd, 0xa5, 0xc9, 0x84, 0x81, 0x94,
-	0xd5, 0xff, 0x15, 0xa0, 0x14, 0xdc, 0x97, 0xbd, 0x86, 0x92, 0xe5, 0x35, 0xd4, 0x10, 0x9f, 0xc1,
-	0x6c, 0xe2, 0x31, 0x3b, 0x15, 0x62, 0xd2, 0x1f, 0xbc, 0x27, 0xc5, 0xc8, 0x23, 0xf9, 0xbf, 0x54,
-	0x05, 0x27, 0xd7, 0x46, 0xd5, 0x21, 0x49, 0x24, 0x99, 0xc0, 0xf8, 0xa5, 0xe3, 0xc6, 0x43, 0x80,
-	0x48, 0x5c, 0x8f, 0xef, 0xfa, 0x98, 0xab, 0x4e, 0x50, 0x78, 0xed, 0xf6, 0x8f, 0x6e, 0x75, 0x6d,
-	0x7a, 0x30, 0xd8, 0x63, 0x5f, 0x56, 0x04, 0xe9, 0x7b, 0xb6, 0x27, 0x7f, 0xad, 0x04, 0x27, 0xba,
-	0xc2, 0x57, 0xaf, 0x30, 0x01, 0xfd, 0xbd, 0xbd, 0x29, 0x3e, 0xba, 0xfd, 0x75, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x0f, 0x73, 0x18, 0x5f, 0xb9, 0x2c, 0x00, 0x00,
+func (m *WatchChannelsV2Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchChannelsV2Request.Unmarshal(m, b)
+}
+func (m *WatchChannelsV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchChannelsV2Request.Marshal(b, m, deterministic)
+}
+func (m *WatchChannelsV2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchChannelsV2Request.Merge(m, src)
+}
+func (m *WatchChannelsV2Request) XXX_Size() int {
+	return xxx_messageInfo_WatchChannelsV2Request.Size(m)
+}
+func (m *WatchChannelsV2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchChannelsV2Request.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_WatchChannelsV2Request proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *WatchChannelsV2Request) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
 
-// DataCoordClient is the client API for DataCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DataCoordClient interface {
-	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
-	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
-	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
-	CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error)
-	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
-	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
-	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
-	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
+func (m *WatchChannelsV2Request) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
 }
 
-type dataCoordClient struct {
-	cc *grpc.ClientConn
+func (m *WatchChannelsV2Request) GetSeekPositions() map[string]*internalpb.MsgPosition {
+	if m != nil {
+		return m.SeekPositions
+	}
+	return nil
+}
+
+type ChannelEvent struct {
+	ChannelName          string           `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	NodeID               int64            `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Action               ChannelEventType `protobuf:"varint,3,opt,name=action,proto3,enum=milvus.proto.data.ChannelEventType" json:"action,omitempty"`
+	Timestamp            int64            `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ChannelEvent) Reset()         { *m = ChannelEvent{} }
+func (m *ChannelEvent) String() string { return proto.CompactTextString(m) }
+func (*ChannelEvent) ProtoMessage()    {}
+func (*ChannelEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{49}
+}
+
+func (m *ChannelEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEvent.Unmarshal(m, b)
+}
+func (m *ChannelEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEvent.Marshal(b, m, deterministic)
+}
+func (m *ChannelEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEvent.Merge(m, src)
+}
+func (m *ChannelEvent) XXX_Size() int {
+	return xxx_messageInfo_ChannelEvent.Size(m)
+}
+func (m *ChannelEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEvent proto.InternalMessageInfo
+
+func (m *ChannelEvent) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ChannelEvent) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ChannelEvent) GetAction() ChannelEventType {
+	if m != nil {
+		return m.Action
+	}
+	return ChannelEventType_ChannelWatched
+}
+
+func (m *ChannelEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ChannelEventLog struct {
+	Events               []*ChannelEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ChannelEventLog) Reset()         { *m = ChannelEventLog{} }
+func (m *ChannelEventLog) String() string { return proto.CompactTextString(m) }
+func (*ChannelEventLog) ProtoMessage()    {}
+func (*ChannelEventLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{50}
+}
+
+func (m *ChannelEventLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEventLog.Unmarshal(m, b)
+}
+func (m *ChannelEventLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEventLog.Marshal(b, m, deterministic)
+}
+func (m *ChannelEventLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEventLog.Merge(m, src)
+}
+func (m *ChannelEventLog) XXX_Size() int {
+	return xxx_messageInfo_ChannelEventLog.Size(m)
+}
+func (m *ChannelEventLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEventLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEventLog proto.InternalMessageInfo
+
+func (m *ChannelEventLog) GetEvents() []*ChannelEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type GetChannelHistoryRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ChannelName          string            `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetChannelHistoryRequest) Reset()         { *m = GetChannelHistoryRequest{} }
+func (m *GetChannelHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetChannelHistoryRequest) ProtoMessage()    {}
+func (*GetChannelHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{51}
+}
+
+func (m *GetChannelHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetChannelHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetChannelHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelHistoryRequest.Merge(m, src)
+}
+func (m *GetChannelHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetChannelHistoryRequest.Size(m)
+}
+func (m *GetChannelHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelHistoryRequest proto.InternalMessageInfo
+
+func (m *GetChannelHistoryRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetChannelHistoryRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+type GetChannelHistoryResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Events               []*ChannelEvent  `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetChannelHistoryResponse) Reset()         { *m = GetChannelHistoryResponse{} }
+func (m *GetChannelHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetChannelHistoryResponse) ProtoMessage()    {}
+func (*GetChannelHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{52}
+}
+
+func (m *GetChannelHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetChannelHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetChannelHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelHistoryResponse.Merge(m, src)
+}
+func (m *GetChannelHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetChannelHistoryResponse.Size(m)
+}
+func (m *GetChannelHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelHistoryResponse proto.InternalMessageInfo
+
+func (m *GetChannelHistoryResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetChannelHistoryResponse) GetEvents() []*ChannelEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type ImportManifestRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ManifestUrl          string            `protobuf:"bytes,2,opt,name=manifest_url,json=manifestUrl,proto3" json:"manifest_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportManifestRequest) Reset()         { *m = ImportManifestRequest{} }
+func (m *ImportManifestRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportManifestRequest) ProtoMessage()    {}
+func (*ImportManifestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{53}
+}
+
+func (m *ImportManifestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportManifestRequest.Unmarshal(m, b)
+}
+func (m *ImportManifestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportManifestRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportManifestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportManifestRequest.Merge(m, src)
+}
+func (m *ImportManifestRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportManifestRequest.Size(m)
+}
+func (m *ImportManifestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportManifestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportManifestRequest proto.InternalMessageInfo
+
+func (m *ImportManifestRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ImportManifestRequest) GetManifestUrl() string {
+	if m != nil {
+		return m.ManifestUrl
+	}
+	return ""
+}
+
+type ImportManifestResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SegmentIDs           []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ImportManifestResponse) Reset()         { *m = ImportManifestResponse{} }
+func (m *ImportManifestResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportManifestResponse) ProtoMessage()    {}
+func (*ImportManifestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{54}
+}
+
+func (m *ImportManifestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportManifestResponse.Unmarshal(m, b)
+}
+func (m *ImportManifestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportManifestResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportManifestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportManifestResponse.Merge(m, src)
+}
+func (m *ImportManifestResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportManifestResponse.Size(m)
+}
+func (m *ImportManifestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportManifestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportManifestResponse proto.InternalMessageInfo
+
+func (m *ImportManifestResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ImportManifestResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type CompactionScoreCard struct {
+	PolicyName           string   `protobuf:"bytes,1,opt,name=policyName,proto3" json:"policyName,omitempty"`
+	Score                float64  `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Eligible             bool     `protobuf:"varint,4,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactionScoreCard) Reset()         { *m = CompactionScoreCard{} }
+func (m *CompactionScoreCard) String() string { return proto.CompactTextString(m) }
+func (*CompactionScoreCard) ProtoMessage()    {}
+func (*CompactionScoreCard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{55}
+}
+
+func (m *CompactionScoreCard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionScoreCard.Unmarshal(m, b)
+}
+func (m *CompactionScoreCard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionScoreCard.Marshal(b, m, deterministic)
+}
+func (m *CompactionScoreCard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionScoreCard.Merge(m, src)
+}
+func (m *CompactionScoreCard) XXX_Size() int {
+	return xxx_messageInfo_CompactionScoreCard.Size(m)
+}
+func (m *CompactionScoreCard) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionScoreCard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionScoreCard proto.InternalMessageInfo
+
+func (m *CompactionScoreCard) GetPolicyName() string {
+	if m != nil {
+		return m.PolicyName
+	}
+	return ""
+}
+
+func (m *CompactionScoreCard) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *CompactionScoreCard) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CompactionScoreCard) GetEligible() bool {
+	if m != nil {
+		return m.Eligible
+	}
+	return false
+}
+
+type GetCompactionScoreCardRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID            int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCompactionScoreCardRequest) Reset()         { *m = GetCompactionScoreCardRequest{} }
+func (m *GetCompactionScoreCardRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionScoreCardRequest) ProtoMessage()    {}
+func (*GetCompactionScoreCardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{56}
+}
+
+func (m *GetCompactionScoreCardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionScoreCardRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionScoreCardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionScoreCardRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionScoreCardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionScoreCardRequest.Merge(m, src)
+}
+func (m *GetCompactionScoreCardRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionScoreCardRequest.Size(m)
+}
+func (m *GetCompactionScoreCardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionScoreCardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionScoreCardRequest proto.InternalMessageInfo
+
+func (m *GetCompactionScoreCardRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCompactionScoreCardRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+type GetCompactionScoreCardResponse struct {
+	Status               *commonpb.Status       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ScoreCards           []*CompactionScoreCard `protobuf:"bytes,2,rep,name=scoreCards,proto3" json:"scoreCards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetCompactionScoreCardResponse) Reset()         { *m = GetCompactionScoreCardResponse{} }
+func (m *GetCompactionScoreCardResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionScoreCardResponse) ProtoMessage()    {}
+func (*GetCompactionScoreCardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{57}
+}
+
+func (m *GetCompactionScoreCardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionScoreCardResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionScoreCardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionScoreCardResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionScoreCardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionScoreCardResponse.Merge(m, src)
+}
+func (m *GetCompactionScoreCardResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionScoreCardResponse.Size(m)
+}
+func (m *GetCompactionScoreCardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionScoreCardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionScoreCardResponse proto.InternalMessageInfo
+
+func (m *GetCompactionScoreCardResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionScoreCardResponse) GetScoreCards() []*CompactionScoreCard {
+	if m != nil {
+		return m.ScoreCards
+	}
+	return nil
+}
+
+type MigrateChannelRequest struct {
+	Base        *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ChannelName string            `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	// the DataNode to move the channel to, the least loaded one is chosen when it's not positive
+	TargetNodeID         int64    `protobuf:"varint,3,opt,name=target_nodeID,json=targetNodeID,proto3" json:"target_nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrateChannelRequest) Reset()         { *m = MigrateChannelRequest{} }
+func (m *MigrateChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateChannelRequest) ProtoMessage()    {}
+func (*MigrateChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{58}
+}
+
+func (m *MigrateChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateChannelRequest.Unmarshal(m, b)
+}
+func (m *MigrateChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateChannelRequest.Marshal(b, m, deterministic)
+}
+func (m *MigrateChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateChannelRequest.Merge(m, src)
+}
+func (m *MigrateChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateChannelRequest.Size(m)
+}
+func (m *MigrateChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateChannelRequest proto.InternalMessageInfo
+
+func (m *MigrateChannelRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *MigrateChannelRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *MigrateChannelRequest) GetTargetNodeID() int64 {
+	if m != nil {
+		return m.TargetNodeID
+	}
+	return 0
+}
+
+type MigrateChannelResponse struct {
+	Status               *commonpb.Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NodeID               int64                   `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	SeekPosition         *internalpb.MsgPosition `protobuf:"bytes,3,opt,name=seek_position,json=seekPosition,proto3" json:"seek_position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *MigrateChannelResponse) Reset()         { *m = MigrateChannelResponse{} }
+func (m *MigrateChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrateChannelResponse) ProtoMessage()    {}
+func (*MigrateChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{59}
+}
+
+func (m *MigrateChannelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateChannelResponse.Unmarshal(m, b)
+}
+func (m *MigrateChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateChannelResponse.Marshal(b, m, deterministic)
+}
+func (m *MigrateChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateChannelResponse.Merge(m, src)
+}
+func (m *MigrateChannelResponse) XXX_Size() int {
+	return xxx_messageInfo_MigrateChannelResponse.Size(m)
+}
+func (m *MigrateChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateChannelResponse proto.InternalMessageInfo
+
+func (m *MigrateChannelResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *MigrateChannelResponse) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *MigrateChannelResponse) GetSeekPosition() *internalpb.MsgPosition {
+	if m != nil {
+		return m.SeekPosition
+	}
+	return nil
+}
+
+type ListCompactionPlansRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// filters, zero values match all plans
+	CollectionID int64               `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	State        CompactionPlanState `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.data.CompactionPlanState" json:"state,omitempty"`
+	CreatedAfter uint64              `protobuf:"varint,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// planID of the last plan in the previous page, 0 for the first page
+	Cursor               int64    `protobuf:"varint,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int64    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCompactionPlansRequest) Reset()         { *m = ListCompactionPlansRequest{} }
+func (m *ListCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCompactionPlansRequest) ProtoMessage()    {}
+func (*ListCompactionPlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{60}
+}
+
+func (m *ListCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCompactionPlansRequest.Unmarshal(m, b)
+}
+func (m *ListCompactionPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCompactionPlansRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCompactionPlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCompactionPlansRequest.Merge(m, src)
+}
+func (m *ListCompactionPlansRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCompactionPlansRequest.Size(m)
+}
+func (m *ListCompactionPlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCompactionPlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCompactionPlansRequest proto.InternalMessageInfo
+
+func (m *ListCompactionPlansRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListCompactionPlansRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ListCompactionPlansRequest) GetState() CompactionPlanState {
+	if m != nil {
+		return m.State
+	}
+	return CompactionPlanState_AllPlanStates
+}
+
+func (m *ListCompactionPlansRequest) GetCreatedAfter() uint64 {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return 0
+}
+
+func (m *ListCompactionPlansRequest) GetCursor() int64 {
+	if m != nil {
+		return m.Cursor
+	}
+	return 0
+}
+
+func (m *ListCompactionPlansRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type CompactionPlanInfo struct {
+	PlanID               int64               `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SignalID             int64               `protobuf:"varint,2,opt,name=signalID,proto3" json:"signalID,omitempty"`
+	CollectionID         int64               `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	State                CompactionPlanState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.data.CompactionPlanState" json:"state,omitempty"`
+	CreateTime           uint64              `protobuf:"varint,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ElapsedSeconds       int64               `protobuf:"varint,6,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"`
+	NumOfSegments        int64               `protobuf:"varint,7,opt,name=num_of_segments,json=numOfSegments,proto3" json:"num_of_segments,omitempty"`
+	EstimatedOutputSize  int64               `protobuf:"varint,8,opt,name=estimated_output_size,json=estimatedOutputSize,proto3" json:"estimated_output_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CompactionPlanInfo) Reset()         { *m = CompactionPlanInfo{} }
+func (m *CompactionPlanInfo) String() string { return proto.CompactTextString(m) }
+func (*CompactionPlanInfo) ProtoMessage()    {}
+func (*CompactionPlanInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{61}
+}
+
+func (m *CompactionPlanInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionPlanInfo.Unmarshal(m, b)
+}
+func (m *CompactionPlanInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionPlanInfo.Marshal(b, m, deterministic)
+}
+func (m *CompactionPlanInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionPlanInfo.Merge(m, src)
+}
+func (m *CompactionPlanInfo) XXX_Size() int {
+	return xxx_messageInfo_CompactionPlanInfo.Size(m)
+}
+func (m *CompactionPlanInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionPlanInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionPlanInfo proto.InternalMessageInfo
+
+func (m *CompactionPlanInfo) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+func (m *CompactionPlanInfo) GetSignalID() int64 {
+	if m != nil {
+		return m.SignalID
+	}
+	return 0
+}
+
+func (m *CompactionPlanInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CompactionPlanInfo) GetState() CompactionPlanState {
+	if m != nil {
+		return m.State
+	}
+	return CompactionPlanState_AllPlanStates
+}
+
+func (m *CompactionPlanInfo) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *CompactionPlanInfo) GetElapsedSeconds() int64 {
+	if m != nil {
+		return m.ElapsedSeconds
+	}
+	return 0
+}
+
+func (m *CompactionPlanInfo) GetNumOfSegments() int64 {
+	if m != nil {
+		return m.NumOfSegments
+	}
+	return 0
+}
+
+func (m *CompactionPlanInfo) GetEstimatedOutputSize() int64 {
+	if m != nil {
+		return m.EstimatedOutputSize
+	}
+	return 0
+}
+
+type ListCompactionPlansResponse struct {
+	Status *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Plans  []*CompactionPlanInfo `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans,omitempty"`
+	// cursor of the next page, 0 if there are no more plans
+	NextCursor           int64    `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCompactionPlansResponse) Reset()         { *m = ListCompactionPlansResponse{} }
+func (m *ListCompactionPlansResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCompactionPlansResponse) ProtoMessage()    {}
+func (*ListCompactionPlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{62}
+}
+
+func (m *ListCompactionPlansResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCompactionPlansResponse.Unmarshal(m, b)
+}
+func (m *ListCompactionPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCompactionPlansResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCompactionPlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCompactionPlansResponse.Merge(m, src)
+}
+func (m *ListCompactionPlansResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCompactionPlansResponse.Size(m)
+}
+func (m *ListCompactionPlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCompactionPlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCompactionPlansResponse proto.InternalMessageInfo
+
+func (m *ListCompactionPlansResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListCompactionPlansResponse) GetPlans() []*CompactionPlanInfo {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+func (m *ListCompactionPlansResponse) GetNextCursor() int64 {
+	if m != nil {
+		return m.NextCursor
+	}
+	return 0
+}
+
+type ReclaimFailedCompactionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PlanID               int64             `protobuf:"varint,2,opt,name=planID,proto3" json:"planID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReclaimFailedCompactionRequest) Reset()         { *m = ReclaimFailedCompactionRequest{} }
+func (m *ReclaimFailedCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReclaimFailedCompactionRequest) ProtoMessage()    {}
+func (*ReclaimFailedCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{63}
+}
+
+func (m *ReclaimFailedCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReclaimFailedCompactionRequest.Unmarshal(m, b)
+}
+func (m *ReclaimFailedCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReclaimFailedCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *ReclaimFailedCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReclaimFailedCompactionRequest.Merge(m, src)
+}
+func (m *ReclaimFailedCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReclaimFailedCompactionRequest.Size(m)
+}
+func (m *ReclaimFailedCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReclaimFailedCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReclaimFailedCompactionRequest proto.InternalMessageInfo
+
+func (m *ReclaimFailedCompactionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReclaimFailedCompactionRequest) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+type PinSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentIDs           []int64           `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PinSegmentsRequest) Reset()         { *m = PinSegmentsRequest{} }
+func (m *PinSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*PinSegmentsRequest) ProtoMessage()    {}
+func (*PinSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{64}
+}
+
+func (m *PinSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PinSegmentsRequest.Unmarshal(m, b)
+}
+func (m *PinSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PinSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *PinSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinSegmentsRequest.Merge(m, src)
+}
+func (m *PinSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_PinSegmentsRequest.Size(m)
+}
+func (m *PinSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinSegmentsRequest proto.InternalMessageInfo
+
+func (m *PinSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PinSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PinSegmentsRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type UnpinSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentIDs           []int64           `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UnpinSegmentsRequest) Reset()         { *m = UnpinSegmentsRequest{} }
+func (m *UnpinSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpinSegmentsRequest) ProtoMessage()    {}
+func (*UnpinSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{65}
+}
+
+func (m *UnpinSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpinSegmentsRequest.Unmarshal(m, b)
+}
+func (m *UnpinSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpinSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *UnpinSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpinSegmentsRequest.Merge(m, src)
+}
+func (m *UnpinSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_UnpinSegmentsRequest.Size(m)
+}
+func (m *UnpinSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpinSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpinSegmentsRequest proto.InternalMessageInfo
+
+func (m *UnpinSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UnpinSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *UnpinSegmentsRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type FlushAllRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FlushAllRequest) Reset()         { *m = FlushAllRequest{} }
+func (m *FlushAllRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushAllRequest) ProtoMessage()    {}
+func (*FlushAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{66}
+}
+
+func (m *FlushAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushAllRequest.Unmarshal(m, b)
+}
+func (m *FlushAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushAllRequest.Marshal(b, m, deterministic)
+}
+func (m *FlushAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushAllRequest.Merge(m, src)
+}
+func (m *FlushAllRequest) XXX_Size() int {
+	return xxx_messageInfo_FlushAllRequest.Size(m)
+}
+func (m *FlushAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushAllRequest proto.InternalMessageInfo
+
+func (m *FlushAllRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type FlushAllResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SegmentIDs           []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *FlushAllResponse) Reset()         { *m = FlushAllResponse{} }
+func (m *FlushAllResponse) String() string { return proto.CompactTextString(m) }
+func (*FlushAllResponse) ProtoMessage()    {}
+func (*FlushAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{67}
+}
+
+func (m *FlushAllResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushAllResponse.Unmarshal(m, b)
+}
+func (m *FlushAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushAllResponse.Marshal(b, m, deterministic)
+}
+func (m *FlushAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushAllResponse.Merge(m, src)
+}
+func (m *FlushAllResponse) XXX_Size() int {
+	return xxx_messageInfo_FlushAllResponse.Size(m)
+}
+func (m *FlushAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushAllResponse proto.InternalMessageInfo
+
+func (m *FlushAllResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *FlushAllResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type ListManagedCollectionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListManagedCollectionsRequest) Reset()         { *m = ListManagedCollectionsRequest{} }
+func (m *ListManagedCollectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListManagedCollectionsRequest) ProtoMessage()    {}
+func (*ListManagedCollectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{68}
+}
+
+func (m *ListManagedCollectionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListManagedCollectionsRequest.Unmarshal(m, b)
+}
+func (m *ListManagedCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListManagedCollectionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListManagedCollectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListManagedCollectionsRequest.Merge(m, src)
+}
+func (m *ListManagedCollectionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListManagedCollectionsRequest.Size(m)
+}
+func (m *ListManagedCollectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListManagedCollectionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListManagedCollectionsRequest proto.InternalMessageInfo
+
+func (m *ListManagedCollectionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type SegmentStateCount struct {
+	State                commonpb.SegmentState `protobuf:"varint,1,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	Count                int64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SegmentStateCount) Reset()         { *m = SegmentStateCount{} }
+func (m *SegmentStateCount) String() string { return proto.CompactTextString(m) }
+func (*SegmentStateCount) ProtoMessage()    {}
+func (*SegmentStateCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{69}
+}
+
+func (m *SegmentStateCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentStateCount.Unmarshal(m, b)
+}
+func (m *SegmentStateCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentStateCount.Marshal(b, m, deterministic)
+}
+func (m *SegmentStateCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentStateCount.Merge(m, src)
+}
+func (m *SegmentStateCount) XXX_Size() int {
+	return xxx_messageInfo_SegmentStateCount.Size(m)
+}
+func (m *SegmentStateCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentStateCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentStateCount proto.InternalMessageInfo
+
+func (m *SegmentStateCount) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+func (m *SegmentStateCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ManagedCollectionSummary struct {
+	CollectionID  int64                `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentCounts []*SegmentStateCount `protobuf:"bytes,2,rep,name=segment_counts,json=segmentCounts,proto3" json:"segment_counts,omitempty"`
+	// rows and estimated size in bytes of segments not dropped
+	NumOfRows     int64 `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	EstimatedSize int64 `protobuf:"varint,4,opt,name=estimated_size,json=estimatedSize,proto3" json:"estimated_size,omitempty"`
+	// start timestamp of the oldest growing or sealed segment, 0 if there is none
+	OldestUnflushedTimestamp uint64   `protobuf:"varint,5,opt,name=oldest_unflushed_timestamp,json=oldestUnflushedTimestamp,proto3" json:"oldest_unflushed_timestamp,omitempty"`
+	CompactionPaused         bool     `protobuf:"varint,6,opt,name=compaction_paused,json=compactionPaused,proto3" json:"compaction_paused,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *ManagedCollectionSummary) Reset()         { *m = ManagedCollectionSummary{} }
+func (m *ManagedCollectionSummary) String() string { return proto.CompactTextString(m) }
+func (*ManagedCollectionSummary) ProtoMessage()    {}
+func (*ManagedCollectionSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{70}
+}
+
+func (m *ManagedCollectionSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManagedCollectionSummary.Unmarshal(m, b)
+}
+func (m *ManagedCollectionSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManagedCollectionSummary.Marshal(b, m, deterministic)
+}
+func (m *ManagedCollectionSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedCollectionSummary.Merge(m, src)
+}
+func (m *ManagedCollectionSummary) XXX_Size() int {
+	return xxx_messageInfo_ManagedCollectionSummary.Size(m)
+}
+func (m *ManagedCollectionSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedCollectionSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedCollectionSummary proto.InternalMessageInfo
+
+func (m *ManagedCollectionSummary) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ManagedCollectionSummary) GetSegmentCounts() []*SegmentStateCount {
+	if m != nil {
+		return m.SegmentCounts
+	}
+	return nil
+}
+
+func (m *ManagedCollectionSummary) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *ManagedCollectionSummary) GetEstimatedSize() int64 {
+	if m != nil {
+		return m.EstimatedSize
+	}
+	return 0
+}
+
+func (m *ManagedCollectionSummary) GetOldestUnflushedTimestamp() uint64 {
+	if m != nil {
+		return m.OldestUnflushedTimestamp
+	}
+	return 0
+}
+
+func (m *ManagedCollectionSummary) GetCompactionPaused() bool {
+	if m != nil {
+		return m.CompactionPaused
+	}
+	return false
+}
+
+type ListManagedCollectionsResponse struct {
+	Status               *commonpb.Status            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Collections          []*ManagedCollectionSummary `protobuf:"bytes,2,rep,name=collections,proto3" json:"collections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ListManagedCollectionsResponse) Reset()         { *m = ListManagedCollectionsResponse{} }
+func (m *ListManagedCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListManagedCollectionsResponse) ProtoMessage()    {}
+func (*ListManagedCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{71}
+}
+
+func (m *ListManagedCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListManagedCollectionsResponse.Unmarshal(m, b)
+}
+func (m *ListManagedCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListManagedCollectionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListManagedCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListManagedCollectionsResponse.Merge(m, src)
+}
+func (m *ListManagedCollectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListManagedCollectionsResponse.Size(m)
+}
+func (m *ListManagedCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListManagedCollectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListManagedCollectionsResponse proto.InternalMessageInfo
+
+func (m *ListManagedCollectionsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListManagedCollectionsResponse) GetCollections() []*ManagedCollectionSummary {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+type MigrateEtcdPrefixRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// absolute etcd key prefixes, keys under old_prefix are moved under new_prefix
+	OldPrefix            string   `protobuf:"bytes,2,opt,name=old_prefix,json=oldPrefix,proto3" json:"old_prefix,omitempty"`
+	NewPrefix            string   `protobuf:"bytes,3,opt,name=new_prefix,json=newPrefix,proto3" json:"new_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrateEtcdPrefixRequest) Reset()         { *m = MigrateEtcdPrefixRequest{} }
+func (m *MigrateEtcdPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateEtcdPrefixRequest) ProtoMessage()    {}
+func (*MigrateEtcdPrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{72}
+}
+
+func (m *MigrateEtcdPrefixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateEtcdPrefixRequest.Unmarshal(m, b)
+}
+func (m *MigrateEtcdPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateEtcdPrefixRequest.Marshal(b, m, deterministic)
+}
+func (m *MigrateEtcdPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateEtcdPrefixRequest.Merge(m, src)
+}
+func (m *MigrateEtcdPrefixRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateEtcdPrefixRequest.Size(m)
+}
+func (m *MigrateEtcdPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateEtcdPrefixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateEtcdPrefixRequest proto.InternalMessageInfo
+
+func (m *MigrateEtcdPrefixRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *MigrateEtcdPrefixRequest) GetOldPrefix() string {
+	if m != nil {
+		return m.OldPrefix
+	}
+	return ""
+}
+
+func (m *MigrateEtcdPrefixRequest) GetNewPrefix() string {
+	if m != nil {
+		return m.NewPrefix
+	}
+	return ""
+}
+
+type MigrateEtcdPrefixResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	MigratedKeys         int64            `protobuf:"varint,2,opt,name=migrated_keys,json=migratedKeys,proto3" json:"migrated_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MigrateEtcdPrefixResponse) Reset()         { *m = MigrateEtcdPrefixResponse{} }
+func (m *MigrateEtcdPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrateEtcdPrefixResponse) ProtoMessage()    {}
+func (*MigrateEtcdPrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{73}
+}
+
+func (m *MigrateEtcdPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateEtcdPrefixResponse.Unmarshal(m, b)
+}
+func (m *MigrateEtcdPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateEtcdPrefixResponse.Marshal(b, m, deterministic)
+}
+func (m *MigrateEtcdPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateEtcdPrefixResponse.Merge(m, src)
+}
+func (m *MigrateEtcdPrefixResponse) XXX_Size() int {
+	return xxx_messageInfo_MigrateEtcdPrefixResponse.Size(m)
+}
+func (m *MigrateEtcdPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateEtcdPrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateEtcdPrefixResponse proto.InternalMessageInfo
+
+func (m *MigrateEtcdPrefixResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *MigrateEtcdPrefixResponse) GetMigratedKeys() int64 {
+	if m != nil {
+		return m.MigratedKeys
+	}
+	return 0
+}
+
+type RegisterDataNodeQuotaRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// non-positive value means unlimited
+	MaxSegments          int64    `protobuf:"varint,3,opt,name=max_segments,json=maxSegments,proto3" json:"max_segments,omitempty"`
+	MaxChannels          int64    `protobuf:"varint,4,opt,name=max_channels,json=maxChannels,proto3" json:"max_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterDataNodeQuotaRequest) Reset()         { *m = RegisterDataNodeQuotaRequest{} }
+func (m *RegisterDataNodeQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterDataNodeQuotaRequest) ProtoMessage()    {}
+func (*RegisterDataNodeQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{74}
+}
+
+func (m *RegisterDataNodeQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterDataNodeQuotaRequest.Unmarshal(m, b)
+}
+func (m *RegisterDataNodeQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterDataNodeQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterDataNodeQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterDataNodeQuotaRequest.Merge(m, src)
+}
+func (m *RegisterDataNodeQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterDataNodeQuotaRequest.Size(m)
+}
+func (m *RegisterDataNodeQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterDataNodeQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterDataNodeQuotaRequest proto.InternalMessageInfo
+
+func (m *RegisterDataNodeQuotaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RegisterDataNodeQuotaRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *RegisterDataNodeQuotaRequest) GetMaxSegments() int64 {
+	if m != nil {
+		return m.MaxSegments
+	}
+	return 0
+}
+
+func (m *RegisterDataNodeQuotaRequest) GetMaxChannels() int64 {
+	if m != nil {
+		return m.MaxChannels
+	}
+	return 0
+}
+
+type StorageAuditRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// orphaned objects older than the tolerance are removed only if remove is set, they are reported only by default
+	Remove               bool     `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageAuditRequest) Reset()         { *m = StorageAuditRequest{} }
+func (m *StorageAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*StorageAuditRequest) ProtoMessage()    {}
+func (*StorageAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{75}
+}
+
+func (m *StorageAuditRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageAuditRequest.Unmarshal(m, b)
+}
+func (m *StorageAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageAuditRequest.Marshal(b, m, deterministic)
+}
+func (m *StorageAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageAuditRequest.Merge(m, src)
+}
+func (m *StorageAuditRequest) XXX_Size() int {
+	return xxx_messageInfo_StorageAuditRequest.Size(m)
+}
+func (m *StorageAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageAuditRequest proto.InternalMessageInfo
+
+func (m *StorageAuditRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *StorageAuditRequest) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+type StorageObject struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageObject) Reset()         { *m = StorageObject{} }
+func (m *StorageObject) String() string { return proto.CompactTextString(m) }
+func (*StorageObject) ProtoMessage()    {}
+func (*StorageObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{76}
+}
+
+func (m *StorageObject) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageObject.Unmarshal(m, b)
+}
+func (m *StorageObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageObject.Marshal(b, m, deterministic)
+}
+func (m *StorageObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageObject.Merge(m, src)
+}
+func (m *StorageObject) XXX_Size() int {
+	return xxx_messageInfo_StorageObject.Size(m)
+}
+func (m *StorageObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageObject proto.InternalMessageInfo
+
+func (m *StorageObject) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StorageObject) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type StorageAuditReport struct {
+	ScannedObjects int64 `protobuf:"varint,1,opt,name=scanned_objects,json=scannedObjects,proto3" json:"scanned_objects,omitempty"`
+	// orphaned objects are not referenced by any segment, the list is truncated if too long
+	Orphans              []*StorageObject `protobuf:"bytes,2,rep,name=orphans,proto3" json:"orphans,omitempty"`
+	OrphanedObjects      int64            `protobuf:"varint,3,opt,name=orphaned_objects,json=orphanedObjects,proto3" json:"orphaned_objects,omitempty"`
+	OrphanedSize         int64            `protobuf:"varint,4,opt,name=orphaned_size,json=orphanedSize,proto3" json:"orphaned_size,omitempty"`
+	RemovedObjects       int64            `protobuf:"varint,5,opt,name=removed_objects,json=removedObjects,proto3" json:"removed_objects,omitempty"`
+	RemovedSize          int64            `protobuf:"varint,6,opt,name=removed_size,json=removedSize,proto3" json:"removed_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *StorageAuditReport) Reset()         { *m = StorageAuditReport{} }
+func (m *StorageAuditReport) String() string { return proto.CompactTextString(m) }
+func (*StorageAuditReport) ProtoMessage()    {}
+func (*StorageAuditReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{77}
+}
+
+func (m *StorageAuditReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageAuditReport.Unmarshal(m, b)
+}
+func (m *StorageAuditReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageAuditReport.Marshal(b, m, deterministic)
+}
+func (m *StorageAuditReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageAuditReport.Merge(m, src)
+}
+func (m *StorageAuditReport) XXX_Size() int {
+	return xxx_messageInfo_StorageAuditReport.Size(m)
+}
+func (m *StorageAuditReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageAuditReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageAuditReport proto.InternalMessageInfo
+
+func (m *StorageAuditReport) GetScannedObjects() int64 {
+	if m != nil {
+		return m.ScannedObjects
+	}
+	return 0
+}
+
+func (m *StorageAuditReport) GetOrphans() []*StorageObject {
+	if m != nil {
+		return m.Orphans
+	}
+	return nil
+}
+
+func (m *StorageAuditReport) GetOrphanedObjects() int64 {
+	if m != nil {
+		return m.OrphanedObjects
+	}
+	return 0
+}
+
+func (m *StorageAuditReport) GetOrphanedSize() int64 {
+	if m != nil {
+		return m.OrphanedSize
+	}
+	return 0
+}
+
+func (m *StorageAuditReport) GetRemovedObjects() int64 {
+	if m != nil {
+		return m.RemovedObjects
+	}
+	return 0
+}
+
+func (m *StorageAuditReport) GetRemovedSize() int64 {
+	if m != nil {
+		return m.RemovedSize
+	}
+	return 0
+}
+
+type StorageAuditResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Report               *StorageAuditReport `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *StorageAuditResponse) Reset()         { *m = StorageAuditResponse{} }
+func (m *StorageAuditResponse) String() string { return proto.CompactTextString(m) }
+func (*StorageAuditResponse) ProtoMessage()    {}
+func (*StorageAuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{78}
+}
+
+func (m *StorageAuditResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageAuditResponse.Unmarshal(m, b)
+}
+func (m *StorageAuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageAuditResponse.Marshal(b, m, deterministic)
+}
+func (m *StorageAuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageAuditResponse.Merge(m, src)
+}
+func (m *StorageAuditResponse) XXX_Size() int {
+	return xxx_messageInfo_StorageAuditResponse.Size(m)
+}
+func (m *StorageAuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageAuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageAuditResponse proto.InternalMessageInfo
+
+func (m *StorageAuditResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *StorageAuditResponse) GetReport() *StorageAuditReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+type SampleSegmentRequest struct {
+	Base       *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID  int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	SampleRate float64           `protobuf:"fixed64,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// filled by DataCoord for DataNode to read the segment
+	Channel              string         `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,5,rep,name=field_binlogs,json=fieldBinlogs,proto3" json:"field_binlogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SampleSegmentRequest) Reset()         { *m = SampleSegmentRequest{} }
+func (m *SampleSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*SampleSegmentRequest) ProtoMessage()    {}
+func (*SampleSegmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{79}
+}
+
+func (m *SampleSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SampleSegmentRequest.Unmarshal(m, b)
+}
+func (m *SampleSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SampleSegmentRequest.Marshal(b, m, deterministic)
+}
+func (m *SampleSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SampleSegmentRequest.Merge(m, src)
+}
+func (m *SampleSegmentRequest) XXX_Size() int {
+	return xxx_messageInfo_SampleSegmentRequest.Size(m)
+}
+func (m *SampleSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SampleSegmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SampleSegmentRequest proto.InternalMessageInfo
+
+func (m *SampleSegmentRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SampleSegmentRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SampleSegmentRequest) GetSampleRate() float64 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+func (m *SampleSegmentRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *SampleSegmentRequest) GetFieldBinlogs() []*FieldBinlog {
+	if m != nil {
+		return m.FieldBinlogs
+	}
+	return nil
+}
+
+type FieldSampleStats struct {
+	FieldID  int64             `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	DataType schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	// min and max exclude NaN values, they're valid for numeric fields only
+	Min                  float64  `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  float64  `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	NullCount            int64    `protobuf:"varint,5,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
+	NanCount             int64    `protobuf:"varint,6,opt,name=nan_count,json=nanCount,proto3" json:"nan_count,omitempty"`
+	InfCount             int64    `protobuf:"varint,7,opt,name=inf_count,json=infCount,proto3" json:"inf_count,omitempty"`
+	DistinctCount        int64    `protobuf:"varint,8,opt,name=distinct_count,json=distinctCount,proto3" json:"distinct_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldSampleStats) Reset()         { *m = FieldSampleStats{} }
+func (m *FieldSampleStats) String() string { return proto.CompactTextString(m) }
+func (*FieldSampleStats) ProtoMessage()    {}
+func (*FieldSampleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{80}
+}
+
+func (m *FieldSampleStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldSampleStats.Unmarshal(m, b)
+}
+func (m *FieldSampleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldSampleStats.Marshal(b, m, deterministic)
+}
+func (m *FieldSampleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldSampleStats.Merge(m, src)
+}
+func (m *FieldSampleStats) XXX_Size() int {
+	return xxx_messageInfo_FieldSampleStats.Size(m)
+}
+func (m *FieldSampleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldSampleStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldSampleStats proto.InternalMessageInfo
+
+func (m *FieldSampleStats) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *FieldSampleStats) GetDataType() schemapb.DataType {
+	if m != nil {
+		return m.DataType
+	}
+	return schemapb.DataType_None
+}
+
+func (m *FieldSampleStats) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *FieldSampleStats) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *FieldSampleStats) GetNullCount() int64 {
+	if m != nil {
+		return m.NullCount
+	}
+	return 0
+}
+
+func (m *FieldSampleStats) GetNanCount() int64 {
+	if m != nil {
+		return m.NanCount
+	}
+	return 0
+}
+
+func (m *FieldSampleStats) GetInfCount() int64 {
+	if m != nil {
+		return m.InfCount
+	}
+	return 0
+}
+
+func (m *FieldSampleStats) GetDistinctCount() int64 {
+	if m != nil {
+		return m.DistinctCount
+	}
+	return 0
+}
+
+type SampleSegmentResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SegmentID            int64               `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumRows              int64               `protobuf:"varint,3,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	SampledRows          int64               `protobuf:"varint,4,opt,name=sampled_rows,json=sampledRows,proto3" json:"sampled_rows,omitempty"`
+	FieldStats           []*FieldSampleStats `protobuf:"bytes,5,rep,name=field_stats,json=fieldStats,proto3" json:"field_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SampleSegmentResponse) Reset()         { *m = SampleSegmentResponse{} }
+func (m *SampleSegmentResponse) String() string { return proto.CompactTextString(m) }
+func (*SampleSegmentResponse) ProtoMessage()    {}
+func (*SampleSegmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{81}
+}
+
+func (m *SampleSegmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SampleSegmentResponse.Unmarshal(m, b)
+}
+func (m *SampleSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SampleSegmentResponse.Marshal(b, m, deterministic)
+}
+func (m *SampleSegmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SampleSegmentResponse.Merge(m, src)
+}
+func (m *SampleSegmentResponse) XXX_Size() int {
+	return xxx_messageInfo_SampleSegmentResponse.Size(m)
+}
+func (m *SampleSegmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SampleSegmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SampleSegmentResponse proto.InternalMessageInfo
+
+func (m *SampleSegmentResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SampleSegmentResponse) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SampleSegmentResponse) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *SampleSegmentResponse) GetSampledRows() int64 {
+	if m != nil {
+		return m.SampledRows
+	}
+	return 0
+}
+
+func (m *SampleSegmentResponse) GetFieldStats() []*FieldSampleStats {
+	if m != nil {
+		return m.FieldStats
+	}
+	return nil
+}
+
+type GetStorageUsageRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetStorageUsageRequest) Reset()         { *m = GetStorageUsageRequest{} }
+func (m *GetStorageUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStorageUsageRequest) ProtoMessage()    {}
+func (*GetStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{82}
+}
+
+func (m *GetStorageUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorageUsageRequest.Unmarshal(m, b)
+}
+func (m *GetStorageUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorageUsageRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStorageUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageUsageRequest.Merge(m, src)
+}
+func (m *GetStorageUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStorageUsageRequest.Size(m)
+}
+func (m *GetStorageUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorageUsageRequest proto.InternalMessageInfo
+
+func (m *GetStorageUsageRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetStorageUsageRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type LogStorageUsage struct {
+	LogType string `protobuf:"bytes,1,opt,name=log_type,json=logType,proto3" json:"log_type,omitempty"`
+	NumLogs int64  `protobuf:"varint,2,opt,name=num_logs,json=numLogs,proto3" json:"num_logs,omitempty"`
+	// bytes, sizes of insert logs are estimated by rows and field schema since they're not recorded in meta,
+	// sizes of stats logs are unknown and reported as 0
+	Size                 int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogStorageUsage) Reset()         { *m = LogStorageUsage{} }
+func (m *LogStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*LogStorageUsage) ProtoMessage()    {}
+func (*LogStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{83}
+}
+
+func (m *LogStorageUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogStorageUsage.Unmarshal(m, b)
+}
+func (m *LogStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogStorageUsage.Marshal(b, m, deterministic)
+}
+func (m *LogStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogStorageUsage.Merge(m, src)
+}
+func (m *LogStorageUsage) XXX_Size() int {
+	return xxx_messageInfo_LogStorageUsage.Size(m)
+}
+func (m *LogStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogStorageUsage proto.InternalMessageInfo
+
+func (m *LogStorageUsage) GetLogType() string {
+	if m != nil {
+		return m.LogType
+	}
+	return ""
+}
+
+func (m *LogStorageUsage) GetNumLogs() int64 {
+	if m != nil {
+		return m.NumLogs
+	}
+	return 0
+}
+
+func (m *LogStorageUsage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type FieldStorageUsage struct {
+	FieldID              int64              `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	Logs                 []*LogStorageUsage `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+	Size                 int64              `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *FieldStorageUsage) Reset()         { *m = FieldStorageUsage{} }
+func (m *FieldStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*FieldStorageUsage) ProtoMessage()    {}
+func (*FieldStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{84}
+}
+
+func (m *FieldStorageUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldStorageUsage.Unmarshal(m, b)
+}
+func (m *FieldStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldStorageUsage.Marshal(b, m, deterministic)
+}
+func (m *FieldStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldStorageUsage.Merge(m, src)
+}
+func (m *FieldStorageUsage) XXX_Size() int {
+	return xxx_messageInfo_FieldStorageUsage.Size(m)
+}
+func (m *FieldStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldStorageUsage proto.InternalMessageInfo
+
+func (m *FieldStorageUsage) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *FieldStorageUsage) GetLogs() []*LogStorageUsage {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *FieldStorageUsage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type CollectionStorageUsage struct {
+	CollectionID         int64                `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Fields               []*FieldStorageUsage `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Size                 int64                `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CollectionStorageUsage) Reset()         { *m = CollectionStorageUsage{} }
+func (m *CollectionStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*CollectionStorageUsage) ProtoMessage()    {}
+func (*CollectionStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{85}
+}
+
+func (m *CollectionStorageUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionStorageUsage.Unmarshal(m, b)
+}
+func (m *CollectionStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionStorageUsage.Marshal(b, m, deterministic)
+}
+func (m *CollectionStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionStorageUsage.Merge(m, src)
+}
+func (m *CollectionStorageUsage) XXX_Size() int {
+	return xxx_messageInfo_CollectionStorageUsage.Size(m)
+}
+func (m *CollectionStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionStorageUsage proto.InternalMessageInfo
+
+func (m *CollectionStorageUsage) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionStorageUsage) GetFields() []*FieldStorageUsage {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *CollectionStorageUsage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type GetStorageUsageResponse struct {
+	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Collections          []*CollectionStorageUsage `protobuf:"bytes,2,rep,name=collections,proto3" json:"collections,omitempty"`
+	TotalSize            int64                     `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetStorageUsageResponse) Reset()         { *m = GetStorageUsageResponse{} }
+func (m *GetStorageUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStorageUsageResponse) ProtoMessage()    {}
+func (*GetStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{86}
+}
+
+func (m *GetStorageUsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorageUsageResponse.Unmarshal(m, b)
+}
+func (m *GetStorageUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorageUsageResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStorageUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageUsageResponse.Merge(m, src)
+}
+func (m *GetStorageUsageResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStorageUsageResponse.Size(m)
+}
+func (m *GetStorageUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorageUsageResponse proto.InternalMessageInfo
+
+func (m *GetStorageUsageResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetStorageUsageResponse) GetCollections() []*CollectionStorageUsage {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+func (m *GetStorageUsageResponse) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type CancelCompactionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PlanID               int64             `protobuf:"varint,2,opt,name=planID,proto3" json:"planID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelCompactionRequest) Reset()         { *m = CancelCompactionRequest{} }
+func (m *CancelCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelCompactionRequest) ProtoMessage()    {}
+func (*CancelCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{87}
+}
+
+func (m *CancelCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelCompactionRequest.Unmarshal(m, b)
+}
+func (m *CancelCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCompactionRequest.Merge(m, src)
+}
+func (m *CancelCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelCompactionRequest.Size(m)
+}
+func (m *CancelCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCompactionRequest proto.InternalMessageInfo
+
+func (m *CancelCompactionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelCompactionRequest) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+type CancelCompactionResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// final state of the plan, PlanCancelled, or PlanCompleted if the plan is completed before it's cancelled.
+	// Replied by DataNode, PlanCancelled means the executing plan is stopped
+	State CompactionPlanState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.data.CompactionPlanState" json:"state,omitempty"`
+	// output segment of the merge compaction stopped by DataNode, 0 if not allocated yet
+	SegmentID            int64    `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelCompactionResponse) Reset()         { *m = CancelCompactionResponse{} }
+func (m *CancelCompactionResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelCompactionResponse) ProtoMessage()    {}
+func (*CancelCompactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{88}
+}
+
+func (m *CancelCompactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelCompactionResponse.Unmarshal(m, b)
+}
+func (m *CancelCompactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelCompactionResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelCompactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCompactionResponse.Merge(m, src)
+}
+func (m *CancelCompactionResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelCompactionResponse.Size(m)
+}
+func (m *CancelCompactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCompactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCompactionResponse proto.InternalMessageInfo
+
+func (m *CancelCompactionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CancelCompactionResponse) GetState() CompactionPlanState {
+	if m != nil {
+		return m.State
+	}
+	return CompactionPlanState_AllPlanStates
+}
+
+func (m *CancelCompactionResponse) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+type GCEvent struct {
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ObjectPath           string   `protobuf:"bytes,2,opt,name=object_path,json=objectPath,proto3" json:"object_path,omitempty"`
+	SizeBytes            int64    `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCEvent) Reset()         { *m = GCEvent{} }
+func (m *GCEvent) String() string { return proto.CompactTextString(m) }
+func (*GCEvent) ProtoMessage()    {}
+func (*GCEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{89}
+}
+
+func (m *GCEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCEvent.Unmarshal(m, b)
+}
+func (m *GCEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCEvent.Marshal(b, m, deterministic)
+}
+func (m *GCEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCEvent.Merge(m, src)
+}
+func (m *GCEvent) XXX_Size() int {
+	return xxx_messageInfo_GCEvent.Size(m)
+}
+func (m *GCEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCEvent proto.InternalMessageInfo
+
+func (m *GCEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *GCEvent) GetObjectPath() string {
+	if m != nil {
+		return m.ObjectPath
+	}
+	return ""
+}
+
+func (m *GCEvent) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *GCEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type GetGCEventsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// unix time in milliseconds, events in [start_time, end_time] are returned, 0 means no bound
+	StartTime            int64    `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64    `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGCEventsRequest) Reset()         { *m = GetGCEventsRequest{} }
+func (m *GetGCEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGCEventsRequest) ProtoMessage()    {}
+func (*GetGCEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{90}
+}
+
+func (m *GetGCEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGCEventsRequest.Unmarshal(m, b)
+}
+func (m *GetGCEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGCEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetGCEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGCEventsRequest.Merge(m, src)
+}
+func (m *GetGCEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGCEventsRequest.Size(m)
+}
+func (m *GetGCEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGCEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGCEventsRequest proto.InternalMessageInfo
+
+func (m *GetGCEventsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetGCEventsRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GetGCEventsRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *GetGCEventsRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type GetGCEventsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Events               []*GCEvent       `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetGCEventsResponse) Reset()         { *m = GetGCEventsResponse{} }
+func (m *GetGCEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGCEventsResponse) ProtoMessage()    {}
+func (*GetGCEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{91}
+}
+
+func (m *GetGCEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGCEventsResponse.Unmarshal(m, b)
+}
+func (m *GetGCEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGCEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetGCEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGCEventsResponse.Merge(m, src)
+}
+func (m *GetGCEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGCEventsResponse.Size(m)
+}
+func (m *GetGCEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGCEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGCEventsResponse proto.InternalMessageInfo
+
+func (m *GetGCEventsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetGCEventsResponse) GetEvents() []*GCEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type ReportSegmentErrorRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID    int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Channel      string            `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	// the last error of SaveBinlogPaths, binlogs of the segment are not saved until the circuit breaker of DataNode closes
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportSegmentErrorRequest) Reset()         { *m = ReportSegmentErrorRequest{} }
+func (m *ReportSegmentErrorRequest) String() string { return proto.CompactTextString(m) }
+func (*ReportSegmentErrorRequest) ProtoMessage()    {}
+func (*ReportSegmentErrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{92}
+}
+
+func (m *ReportSegmentErrorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportSegmentErrorRequest.Unmarshal(m, b)
+}
+func (m *ReportSegmentErrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportSegmentErrorRequest.Marshal(b, m, deterministic)
+}
+func (m *ReportSegmentErrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportSegmentErrorRequest.Merge(m, src)
+}
+func (m *ReportSegmentErrorRequest) XXX_Size() int {
+	return xxx_messageInfo_ReportSegmentErrorRequest.Size(m)
+}
+func (m *ReportSegmentErrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportSegmentErrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportSegmentErrorRequest proto.InternalMessageInfo
+
+func (m *ReportSegmentErrorRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReportSegmentErrorRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *ReportSegmentErrorRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReportSegmentErrorRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ReportSegmentErrorRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type SetCollectionPropertyRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// supported keys are retention.period, a duration like 720h where 0 removes the retention policy,
+	// and retention.mode, one of drop_segment, drop_partition and allow_empty
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SetCollectionPropertyRequest) Reset()         { *m = SetCollectionPropertyRequest{} }
+func (m *SetCollectionPropertyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCollectionPropertyRequest) ProtoMessage()    {}
+func (*SetCollectionPropertyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{93}
+}
+
+func (m *SetCollectionPropertyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCollectionPropertyRequest.Unmarshal(m, b)
+}
+func (m *SetCollectionPropertyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCollectionPropertyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetCollectionPropertyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCollectionPropertyRequest.Merge(m, src)
+}
+func (m *SetCollectionPropertyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetCollectionPropertyRequest.Size(m)
+}
+func (m *SetCollectionPropertyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCollectionPropertyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCollectionPropertyRequest proto.InternalMessageInfo
+
+func (m *SetCollectionPropertyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetCollectionPropertyRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SetCollectionPropertyRequest) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+type ComputeSegmentOverlapRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ComputeSegmentOverlapRequest) Reset()         { *m = ComputeSegmentOverlapRequest{} }
+func (m *ComputeSegmentOverlapRequest) String() string { return proto.CompactTextString(m) }
+func (*ComputeSegmentOverlapRequest) ProtoMessage()    {}
+func (*ComputeSegmentOverlapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{94}
+}
+
+func (m *ComputeSegmentOverlapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComputeSegmentOverlapRequest.Unmarshal(m, b)
+}
+func (m *ComputeSegmentOverlapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComputeSegmentOverlapRequest.Marshal(b, m, deterministic)
+}
+func (m *ComputeSegmentOverlapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComputeSegmentOverlapRequest.Merge(m, src)
+}
+func (m *ComputeSegmentOverlapRequest) XXX_Size() int {
+	return xxx_messageInfo_ComputeSegmentOverlapRequest.Size(m)
+}
+func (m *ComputeSegmentOverlapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComputeSegmentOverlapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComputeSegmentOverlapRequest proto.InternalMessageInfo
+
+func (m *ComputeSegmentOverlapRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ComputeSegmentOverlapRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ComputeSegmentOverlapRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+type SegmentOverlapRow struct {
+	SegmentID int64 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	// overlaps with each segment of the report in order, the length of the intersection of primary key ranges
+	// divided by the length of their union
+	Overlaps             []float64 `protobuf:"fixed64,2,rep,packed,name=overlaps,proto3" json:"overlaps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SegmentOverlapRow) Reset()         { *m = SegmentOverlapRow{} }
+func (m *SegmentOverlapRow) String() string { return proto.CompactTextString(m) }
+func (*SegmentOverlapRow) ProtoMessage()    {}
+func (*SegmentOverlapRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{95}
+}
+
+func (m *SegmentOverlapRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentOverlapRow.Unmarshal(m, b)
+}
+func (m *SegmentOverlapRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentOverlapRow.Marshal(b, m, deterministic)
+}
+func (m *SegmentOverlapRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentOverlapRow.Merge(m, src)
+}
+func (m *SegmentOverlapRow) XXX_Size() int {
+	return xxx_messageInfo_SegmentOverlapRow.Size(m)
+}
+func (m *SegmentOverlapRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentOverlapRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentOverlapRow proto.InternalMessageInfo
+
+func (m *SegmentOverlapRow) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentOverlapRow) GetOverlaps() []float64 {
+	if m != nil {
+		return m.Overlaps
+	}
+	return nil
+}
+
+type SuggestedMerge struct {
+	SegmentIDs []int64 `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	// mean pairwise overlap of the segments
+	OverlapRatio         float64  `protobuf:"fixed64,2,opt,name=overlap_ratio,json=overlapRatio,proto3" json:"overlap_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuggestedMerge) Reset()         { *m = SuggestedMerge{} }
+func (m *SuggestedMerge) String() string { return proto.CompactTextString(m) }
+func (*SuggestedMerge) ProtoMessage()    {}
+func (*SuggestedMerge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{96}
+}
+
+func (m *SuggestedMerge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestedMerge.Unmarshal(m, b)
+}
+func (m *SuggestedMerge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestedMerge.Marshal(b, m, deterministic)
+}
+func (m *SuggestedMerge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestedMerge.Merge(m, src)
+}
+func (m *SuggestedMerge) XXX_Size() int {
+	return xxx_messageInfo_SuggestedMerge.Size(m)
+}
+func (m *SuggestedMerge) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestedMerge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestedMerge proto.InternalMessageInfo
+
+func (m *SuggestedMerge) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *SuggestedMerge) GetOverlapRatio() float64 {
+	if m != nil {
+		return m.OverlapRatio
+	}
+	return 0
+}
+
+type OverlapReport struct {
+	CollectionID int64 `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// flushed segments with primary key ranges, in the order of the overlap matrix
+	SegmentIDs    []int64              `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	OverlapMatrix []*SegmentOverlapRow `protobuf:"bytes,4,rep,name=overlap_matrix,json=overlapMatrix,proto3" json:"overlap_matrix,omitempty"`
+	// mean pairwise overlap of the segments, 0 means no overlap and 1 means fully overlapping
+	OverlapRatio float64 `protobuf:"fixed64,5,opt,name=overlap_ratio,json=overlapRatio,proto3" json:"overlap_ratio,omitempty"`
+	// groups of segments overlapping each other, merging each group reduces the overlap
+	SuggestedMerges []*SuggestedMerge `protobuf:"bytes,6,rep,name=suggested_merges,json=suggestedMerges,proto3" json:"suggested_merges,omitempty"`
+	// flushed segments left out since their primary key ranges are unknown
+	SkippedSegmentIDs    []int64  `protobuf:"varint,7,rep,packed,name=skipped_segmentIDs,json=skippedSegmentIDs,proto3" json:"skipped_segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OverlapReport) Reset()         { *m = OverlapReport{} }
+func (m *OverlapReport) String() string { return proto.CompactTextString(m) }
+func (*OverlapReport) ProtoMessage()    {}
+func (*OverlapReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{97}
+}
+
+func (m *OverlapReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OverlapReport.Unmarshal(m, b)
+}
+func (m *OverlapReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OverlapReport.Marshal(b, m, deterministic)
+}
+func (m *OverlapReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverlapReport.Merge(m, src)
+}
+func (m *OverlapReport) XXX_Size() int {
+	return xxx_messageInfo_OverlapReport.Size(m)
+}
+func (m *OverlapReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverlapReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverlapReport proto.InternalMessageInfo
+
+func (m *OverlapReport) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *OverlapReport) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *OverlapReport) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *OverlapReport) GetOverlapMatrix() []*SegmentOverlapRow {
+	if m != nil {
+		return m.OverlapMatrix
+	}
+	return nil
+}
+
+func (m *OverlapReport) GetOverlapRatio() float64 {
+	if m != nil {
+		return m.OverlapRatio
+	}
+	return 0
+}
+
+func (m *OverlapReport) GetSuggestedMerges() []*SuggestedMerge {
+	if m != nil {
+		return m.SuggestedMerges
+	}
+	return nil
+}
+
+func (m *OverlapReport) GetSkippedSegmentIDs() []int64 {
+	if m != nil {
+		return m.SkippedSegmentIDs
+	}
+	return nil
+}
+
+type ComputeSegmentOverlapResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Report               *OverlapReport   `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ComputeSegmentOverlapResponse) Reset()         { *m = ComputeSegmentOverlapResponse{} }
+func (m *ComputeSegmentOverlapResponse) String() string { return proto.CompactTextString(m) }
+func (*ComputeSegmentOverlapResponse) ProtoMessage()    {}
+func (*ComputeSegmentOverlapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{98}
+}
+
+func (m *ComputeSegmentOverlapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComputeSegmentOverlapResponse.Unmarshal(m, b)
+}
+func (m *ComputeSegmentOverlapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComputeSegmentOverlapResponse.Marshal(b, m, deterministic)
+}
+func (m *ComputeSegmentOverlapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComputeSegmentOverlapResponse.Merge(m, src)
+}
+func (m *ComputeSegmentOverlapResponse) XXX_Size() int {
+	return xxx_messageInfo_ComputeSegmentOverlapResponse.Size(m)
+}
+func (m *ComputeSegmentOverlapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComputeSegmentOverlapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComputeSegmentOverlapResponse proto.InternalMessageInfo
+
+func (m *ComputeSegmentOverlapResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ComputeSegmentOverlapResponse) GetReport() *OverlapReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+type GetCompactionROIRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// milliseconds since epoch, plans completed in [start_time, end_time) are counted, 0 means unbounded
+	StartTime            int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64    `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionROIRequest) Reset()         { *m = GetCompactionROIRequest{} }
+func (m *GetCompactionROIRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionROIRequest) ProtoMessage()    {}
+func (*GetCompactionROIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{99}
+}
+
+func (m *GetCompactionROIRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionROIRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionROIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionROIRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionROIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionROIRequest.Merge(m, src)
+}
+func (m *GetCompactionROIRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionROIRequest.Size(m)
+}
+func (m *GetCompactionROIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionROIRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionROIRequest proto.InternalMessageInfo
+
+func (m *GetCompactionROIRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCompactionROIRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetCompactionROIRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GetCompactionROIRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+type GetCompactionROIResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// bytes, sizes of segments are estimated from meta when plans are completed
+	TotalBytesIn  int64 `protobuf:"varint,2,opt,name=total_bytes_in,json=totalBytesIn,proto3" json:"total_bytes_in,omitempty"`
+	TotalBytesOut int64 `protobuf:"varint,3,opt,name=total_bytes_out,json=totalBytesOut,proto3" json:"total_bytes_out,omitempty"`
+	// total_bytes_in divided by total_bytes_out, above 1 means compaction reduces storage, 0 if nothing is written
+	RoiRatio             float64  `protobuf:"fixed64,4,opt,name=roi_ratio,json=roiRatio,proto3" json:"roi_ratio,omitempty"`
+	PlanCount            int64    `protobuf:"varint,5,opt,name=plan_count,json=planCount,proto3" json:"plan_count,omitempty"`
+	AverageDuration      int64    `protobuf:"varint,6,opt,name=average_duration,json=averageDuration,proto3" json:"average_duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionROIResponse) Reset()         { *m = GetCompactionROIResponse{} }
+func (m *GetCompactionROIResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionROIResponse) ProtoMessage()    {}
+func (*GetCompactionROIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{100}
+}
+
+func (m *GetCompactionROIResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionROIResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionROIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionROIResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionROIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionROIResponse.Merge(m, src)
+}
+func (m *GetCompactionROIResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionROIResponse.Size(m)
+}
+func (m *GetCompactionROIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionROIResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionROIResponse proto.InternalMessageInfo
+
+func (m *GetCompactionROIResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionROIResponse) GetTotalBytesIn() int64 {
+	if m != nil {
+		return m.TotalBytesIn
+	}
+	return 0
+}
+
+func (m *GetCompactionROIResponse) GetTotalBytesOut() int64 {
+	if m != nil {
+		return m.TotalBytesOut
+	}
+	return 0
+}
+
+func (m *GetCompactionROIResponse) GetRoiRatio() float64 {
+	if m != nil {
+		return m.RoiRatio
+	}
+	return 0
+}
+
+func (m *GetCompactionROIResponse) GetPlanCount() int64 {
+	if m != nil {
+		return m.PlanCount
+	}
+	return 0
+}
+
+func (m *GetCompactionROIResponse) GetAverageDuration() int64 {
+	if m != nil {
+		return m.AverageDuration
+	}
+	return 0
+}
+
+type FreezePartitionRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// seconds after which the partition is unfrozen automatically, non-positive means frozen until unfrozen
+	AutoUnfreezeSeconds  int64    `protobuf:"varint,4,opt,name=auto_unfreeze_seconds,json=autoUnfreezeSeconds,proto3" json:"auto_unfreeze_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FreezePartitionRequest) Reset()         { *m = FreezePartitionRequest{} }
+func (m *FreezePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*FreezePartitionRequest) ProtoMessage()    {}
+func (*FreezePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{101}
+}
+
+func (m *FreezePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreezePartitionRequest.Unmarshal(m, b)
+}
+func (m *FreezePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreezePartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *FreezePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezePartitionRequest.Merge(m, src)
+}
+func (m *FreezePartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_FreezePartitionRequest.Size(m)
+}
+func (m *FreezePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezePartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezePartitionRequest proto.InternalMessageInfo
+
+func (m *FreezePartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *FreezePartitionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *FreezePartitionRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *FreezePartitionRequest) GetAutoUnfreezeSeconds() int64 {
+	if m != nil {
+		return m.AutoUnfreezeSeconds
+	}
+	return 0
+}
+
+type UnfreezePartitionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UnfreezePartitionRequest) Reset()         { *m = UnfreezePartitionRequest{} }
+func (m *UnfreezePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*UnfreezePartitionRequest) ProtoMessage()    {}
+func (*UnfreezePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{102}
+}
+
+func (m *UnfreezePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezePartitionRequest.Unmarshal(m, b)
+}
+func (m *UnfreezePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezePartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *UnfreezePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezePartitionRequest.Merge(m, src)
+}
+func (m *UnfreezePartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_UnfreezePartitionRequest.Size(m)
+}
+func (m *UnfreezePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezePartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezePartitionRequest proto.InternalMessageInfo
+
+func (m *UnfreezePartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UnfreezePartitionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *UnfreezePartitionRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+type GetSegmentPathRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID            int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetSegmentPathRequest) Reset()         { *m = GetSegmentPathRequest{} }
+func (m *GetSegmentPathRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentPathRequest) ProtoMessage()    {}
+func (*GetSegmentPathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{103}
+}
+
+func (m *GetSegmentPathRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentPathRequest.Unmarshal(m, b)
+}
+func (m *GetSegmentPathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentPathRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentPathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentPathRequest.Merge(m, src)
+}
+func (m *GetSegmentPathRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentPathRequest.Size(m)
+}
+func (m *GetSegmentPathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentPathRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentPathRequest proto.InternalMessageInfo
+
+func (m *GetSegmentPathRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSegmentPathRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+type GetSegmentPathResponse struct {
+	Status      *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	InsertPaths []*FieldBinlog   `protobuf:"bytes,2,rep,name=insert_paths,json=insertPaths,proto3" json:"insert_paths,omitempty"`
+	StatsPaths  []*FieldBinlog   `protobuf:"bytes,3,rep,name=stats_paths,json=statsPaths,proto3" json:"stats_paths,omitempty"`
+	DeltaPaths  []string         `protobuf:"bytes,4,rep,name=delta_paths,json=deltaPaths,proto3" json:"delta_paths,omitempty"`
+	// object storage the paths are in
+	Endpoint   string `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	BucketName string `protobuf:"bytes,6,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	UseSsl     bool   `protobuf:"varint,7,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	// seconds the signed urls are valid for, 0 if blob storage auth is disabled and no url is signed
+	AccessTokenTtl int64 `protobuf:"varint,8,opt,name=access_token_ttl,json=accessTokenTtl,proto3" json:"access_token_ttl,omitempty"`
+	// path => presigned url to get the object, only if blob storage auth is enabled
+	SignedUrls           map[string]string `protobuf:"bytes,9,rep,name=signed_urls,json=signedUrls,proto3" json:"signed_urls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetSegmentPathResponse) Reset()         { *m = GetSegmentPathResponse{} }
+func (m *GetSegmentPathResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentPathResponse) ProtoMessage()    {}
+func (*GetSegmentPathResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{104}
+}
+
+func (m *GetSegmentPathResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentPathResponse.Unmarshal(m, b)
+}
+func (m *GetSegmentPathResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentPathResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentPathResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentPathResponse.Merge(m, src)
+}
+func (m *GetSegmentPathResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentPathResponse.Size(m)
+}
+func (m *GetSegmentPathResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentPathResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentPathResponse proto.InternalMessageInfo
+
+func (m *GetSegmentPathResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentPathResponse) GetInsertPaths() []*FieldBinlog {
+	if m != nil {
+		return m.InsertPaths
+	}
+	return nil
+}
+
+func (m *GetSegmentPathResponse) GetStatsPaths() []*FieldBinlog {
+	if m != nil {
+		return m.StatsPaths
+	}
+	return nil
+}
+
+func (m *GetSegmentPathResponse) GetDeltaPaths() []string {
+	if m != nil {
+		return m.DeltaPaths
+	}
+	return nil
+}
+
+func (m *GetSegmentPathResponse) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *GetSegmentPathResponse) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *GetSegmentPathResponse) GetUseSsl() bool {
+	if m != nil {
+		return m.UseSsl
+	}
+	return false
+}
+
+func (m *GetSegmentPathResponse) GetAccessTokenTtl() int64 {
+	if m != nil {
+		return m.AccessTokenTtl
+	}
+	return 0
+}
+
+func (m *GetSegmentPathResponse) GetSignedUrls() map[string]string {
+	if m != nil {
+		return m.SignedUrls
+	}
+	return nil
+}
+
+type GetSegmentsForCollectionRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// segmentID of the last segment in the previous page, 0 for the first page
+	Cursor               int64    `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSegmentsForCollectionRequest) Reset()         { *m = GetSegmentsForCollectionRequest{} }
+func (m *GetSegmentsForCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentsForCollectionRequest) ProtoMessage()    {}
+func (*GetSegmentsForCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{105}
+}
+
+func (m *GetSegmentsForCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentsForCollectionRequest.Unmarshal(m, b)
+}
+func (m *GetSegmentsForCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentsForCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentsForCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentsForCollectionRequest.Merge(m, src)
+}
+func (m *GetSegmentsForCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentsForCollectionRequest.Size(m)
+}
+func (m *GetSegmentsForCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentsForCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentsForCollectionRequest proto.InternalMessageInfo
+
+func (m *GetSegmentsForCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSegmentsForCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetSegmentsForCollectionRequest) GetCursor() int64 {
+	if m != nil {
+		return m.Cursor
+	}
+	return 0
+}
+
+func (m *GetSegmentsForCollectionRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SegmentsByState struct {
+	State                commonpb.SegmentState `protobuf:"varint,1,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	Segments             []*SegmentInfo        `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SegmentsByState) Reset()         { *m = SegmentsByState{} }
+func (m *SegmentsByState) String() string { return proto.CompactTextString(m) }
+func (*SegmentsByState) ProtoMessage()    {}
+func (*SegmentsByState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{106}
+}
+
+func (m *SegmentsByState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentsByState.Unmarshal(m, b)
+}
+func (m *SegmentsByState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentsByState.Marshal(b, m, deterministic)
+}
+func (m *SegmentsByState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentsByState.Merge(m, src)
+}
+func (m *SegmentsByState) XXX_Size() int {
+	return xxx_messageInfo_SegmentsByState.Size(m)
+}
+func (m *SegmentsByState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentsByState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentsByState proto.InternalMessageInfo
+
+func (m *SegmentsByState) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+func (m *SegmentsByState) GetSegments() []*SegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type GetSegmentsForCollectionResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// segments of the page grouped by state, in ascending order of state, states without segments are omitted
+	SegmentsByState []*SegmentsByState `protobuf:"bytes,2,rep,name=segments_by_state,json=segmentsByState,proto3" json:"segments_by_state,omitempty"`
+	// cursor of the next page, 0 if there are no more segments
+	NextCursor           int64    `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSegmentsForCollectionResponse) Reset()         { *m = GetSegmentsForCollectionResponse{} }
+func (m *GetSegmentsForCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentsForCollectionResponse) ProtoMessage()    {}
+func (*GetSegmentsForCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{107}
+}
+
+func (m *GetSegmentsForCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentsForCollectionResponse.Unmarshal(m, b)
+}
+func (m *GetSegmentsForCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentsForCollectionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentsForCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentsForCollectionResponse.Merge(m, src)
+}
+func (m *GetSegmentsForCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentsForCollectionResponse.Size(m)
+}
+func (m *GetSegmentsForCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentsForCollectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentsForCollectionResponse proto.InternalMessageInfo
+
+func (m *GetSegmentsForCollectionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentsForCollectionResponse) GetSegmentsByState() []*SegmentsByState {
+	if m != nil {
+		return m.SegmentsByState
+	}
+	return nil
+}
+
+func (m *GetSegmentsForCollectionResponse) GetNextCursor() int64 {
+	if m != nil {
+		return m.NextCursor
+	}
+	return 0
+}
+
+type ReportDataNodeHealthRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// vchannel => error of the vchannel failing to start
+	ChannelErrors map[string]string `protobuf:"bytes,3,rep,name=channel_errors,json=channelErrors,proto3" json:"channel_errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// vchannels started, errors reported for them before are cleared
+	HealthyChannels      []string `protobuf:"bytes,4,rep,name=healthy_channels,json=healthyChannels,proto3" json:"healthy_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportDataNodeHealthRequest) Reset()         { *m = ReportDataNodeHealthRequest{} }
+func (m *ReportDataNodeHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*ReportDataNodeHealthRequest) ProtoMessage()    {}
+func (*ReportDataNodeHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{108}
+}
+
+func (m *ReportDataNodeHealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportDataNodeHealthRequest.Unmarshal(m, b)
+}
+func (m *ReportDataNodeHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportDataNodeHealthRequest.Marshal(b, m, deterministic)
+}
+func (m *ReportDataNodeHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportDataNodeHealthRequest.Merge(m, src)
+}
+func (m *ReportDataNodeHealthRequest) XXX_Size() int {
+	return xxx_messageInfo_ReportDataNodeHealthRequest.Size(m)
+}
+func (m *ReportDataNodeHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportDataNodeHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportDataNodeHealthRequest proto.InternalMessageInfo
+
+func (m *ReportDataNodeHealthRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReportDataNodeHealthRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ReportDataNodeHealthRequest) GetChannelErrors() map[string]string {
+	if m != nil {
+		return m.ChannelErrors
+	}
+	return nil
+}
+
+func (m *ReportDataNodeHealthRequest) GetHealthyChannels() []string {
+	if m != nil {
+		return m.HealthyChannels
+	}
+	return nil
+}
+
+type GetSegmentLineageDOTRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetSegmentLineageDOTRequest) Reset()         { *m = GetSegmentLineageDOTRequest{} }
+func (m *GetSegmentLineageDOTRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentLineageDOTRequest) ProtoMessage()    {}
+func (*GetSegmentLineageDOTRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{109}
+}
+
+func (m *GetSegmentLineageDOTRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentLineageDOTRequest.Unmarshal(m, b)
+}
+func (m *GetSegmentLineageDOTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentLineageDOTRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentLineageDOTRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentLineageDOTRequest.Merge(m, src)
+}
+func (m *GetSegmentLineageDOTRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentLineageDOTRequest.Size(m)
+}
+func (m *GetSegmentLineageDOTRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentLineageDOTRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentLineageDOTRequest proto.InternalMessageInfo
+
+func (m *GetSegmentLineageDOTRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSegmentLineageDOTRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetSegmentLineageDOTResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Graphviz DOT graph of the segments of the collection, edges point from compacted segments to their results
+	Dot                  string   `protobuf:"bytes,2,opt,name=dot,proto3" json:"dot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSegmentLineageDOTResponse) Reset()         { *m = GetSegmentLineageDOTResponse{} }
+func (m *GetSegmentLineageDOTResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentLineageDOTResponse) ProtoMessage()    {}
+func (*GetSegmentLineageDOTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{110}
+}
+
+func (m *GetSegmentLineageDOTResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentLineageDOTResponse.Unmarshal(m, b)
+}
+func (m *GetSegmentLineageDOTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentLineageDOTResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentLineageDOTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentLineageDOTResponse.Merge(m, src)
+}
+func (m *GetSegmentLineageDOTResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentLineageDOTResponse.Size(m)
+}
+func (m *GetSegmentLineageDOTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentLineageDOTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentLineageDOTResponse proto.InternalMessageInfo
+
+func (m *GetSegmentLineageDOTResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentLineageDOTResponse) GetDot() string {
+	if m != nil {
+		return m.Dot
+	}
+	return ""
+}
+
+type ReadSegmentRequest struct {
+	Base      *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	// fields to return, all fields if empty
+	FieldIDs []int64 `protobuf:"varint,3,rep,packed,name=fieldIDs,proto3" json:"fieldIDs,omitempty"`
+	// maximum number of rows to return, all rows if not positive
+	MaxRows              int64    `protobuf:"varint,4,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadSegmentRequest) Reset()         { *m = ReadSegmentRequest{} }
+func (m *ReadSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadSegmentRequest) ProtoMessage()    {}
+func (*ReadSegmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{111}
+}
+
+func (m *ReadSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadSegmentRequest.Unmarshal(m, b)
+}
+func (m *ReadSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadSegmentRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadSegmentRequest.Merge(m, src)
+}
+func (m *ReadSegmentRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadSegmentRequest.Size(m)
+}
+func (m *ReadSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadSegmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadSegmentRequest proto.InternalMessageInfo
+
+func (m *ReadSegmentRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReadSegmentRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *ReadSegmentRequest) GetFieldIDs() []int64 {
+	if m != nil {
+		return m.FieldIDs
+	}
+	return nil
+}
+
+func (m *ReadSegmentRequest) GetMaxRows() int64 {
+	if m != nil {
+		return m.MaxRows
+	}
+	return 0
+}
+
+type ReadSegmentResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// columns of a batch of rows
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	NumRows              int64                 `protobuf:"varint,3,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReadSegmentResponse) Reset()         { *m = ReadSegmentResponse{} }
+func (m *ReadSegmentResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadSegmentResponse) ProtoMessage()    {}
+func (*ReadSegmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{112}
+}
+
+func (m *ReadSegmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadSegmentResponse.Unmarshal(m, b)
+}
+func (m *ReadSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadSegmentResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadSegmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadSegmentResponse.Merge(m, src)
+}
+func (m *ReadSegmentResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadSegmentResponse.Size(m)
+}
+func (m *ReadSegmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadSegmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadSegmentResponse proto.InternalMessageInfo
+
+func (m *ReadSegmentResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ReadSegmentResponse) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *ReadSegmentResponse) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+type DiscardSegmentRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID            int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	ChannelName          string            `protobuf:"bytes,3,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DiscardSegmentRequest) Reset()         { *m = DiscardSegmentRequest{} }
+func (m *DiscardSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*DiscardSegmentRequest) ProtoMessage()    {}
+func (*DiscardSegmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{113}
+}
+
+func (m *DiscardSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscardSegmentRequest.Unmarshal(m, b)
+}
+func (m *DiscardSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscardSegmentRequest.Marshal(b, m, deterministic)
+}
+func (m *DiscardSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscardSegmentRequest.Merge(m, src)
+}
+func (m *DiscardSegmentRequest) XXX_Size() int {
+	return xxx_messageInfo_DiscardSegmentRequest.Size(m)
+}
+func (m *DiscardSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscardSegmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscardSegmentRequest proto.InternalMessageInfo
+
+func (m *DiscardSegmentRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DiscardSegmentRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *DiscardSegmentRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+type GetChannelSegmentStatsRequest struct {
+	Base        *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ChannelName string            `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	// number of eligible segments with the highest compaction scores to return, 10 if not positive
+	TopN                 int64    `protobuf:"varint,3,opt,name=topN,proto3" json:"topN,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetChannelSegmentStatsRequest) Reset()         { *m = GetChannelSegmentStatsRequest{} }
+func (m *GetChannelSegmentStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetChannelSegmentStatsRequest) ProtoMessage()    {}
+func (*GetChannelSegmentStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{114}
+}
+
+func (m *GetChannelSegmentStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelSegmentStatsRequest.Unmarshal(m, b)
+}
+func (m *GetChannelSegmentStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelSegmentStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetChannelSegmentStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelSegmentStatsRequest.Merge(m, src)
+}
+func (m *GetChannelSegmentStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetChannelSegmentStatsRequest.Size(m)
+}
+func (m *GetChannelSegmentStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelSegmentStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelSegmentStatsRequest proto.InternalMessageInfo
+
+func (m *GetChannelSegmentStatsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetChannelSegmentStatsRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *GetChannelSegmentStatsRequest) GetTopN() int64 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+type SegmentCompactionScore struct {
+	SegmentID   int64 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID int64 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NumRows     int64 `protobuf:"varint,3,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// the highest score among compaction policies, and the policy of it
+	Score                float64  `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	PolicyName           string   `protobuf:"bytes,5,opt,name=policyName,proto3" json:"policyName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentCompactionScore) Reset()         { *m = SegmentCompactionScore{} }
+func (m *SegmentCompactionScore) String() string { return proto.CompactTextString(m) }
+func (*SegmentCompactionScore) ProtoMessage()    {}
+func (*SegmentCompactionScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{115}
+}
+
+func (m *SegmentCompactionScore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentCompactionScore.Unmarshal(m, b)
+}
+func (m *SegmentCompactionScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentCompactionScore.Marshal(b, m, deterministic)
+}
+func (m *SegmentCompactionScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentCompactionScore.Merge(m, src)
+}
+func (m *SegmentCompactionScore) XXX_Size() int {
+	return xxx_messageInfo_SegmentCompactionScore.Size(m)
+}
+func (m *SegmentCompactionScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentCompactionScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentCompactionScore proto.InternalMessageInfo
+
+func (m *SegmentCompactionScore) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentCompactionScore) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *SegmentCompactionScore) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *SegmentCompactionScore) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SegmentCompactionScore) GetPolicyName() string {
+	if m != nil {
+		return m.PolicyName
+	}
+	return ""
+}
+
+type GetChannelSegmentStatsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// number of healthy segments of the channel
+	TotalSegments   int64 `protobuf:"varint,2,opt,name=totalSegments,proto3" json:"totalSegments,omitempty"`
+	FlushedSegments int64 `protobuf:"varint,3,opt,name=flushedSegments,proto3" json:"flushedSegments,omitempty"`
+	// number of segments eligible for any compaction policy
+	CompactionEligibleCount int64 `protobuf:"varint,4,opt,name=compactionEligibleCount,proto3" json:"compactionEligibleCount,omitempty"`
+	TotalRows               int64 `protobuf:"varint,5,opt,name=totalRows,proto3" json:"totalRows,omitempty"`
+	// estimated bytes of insert data by the schema, plus the size of delta logs
+	TotalBytes int64 `protobuf:"varint,6,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	// seconds since the data of the oldest eligible segment started, 0 if no segment is eligible
+	OldestEligibleSegmentAge int64 `protobuf:"varint,7,opt,name=oldestEligibleSegmentAge,proto3" json:"oldestEligibleSegmentAge,omitempty"`
+	// eligible segments in descending order of score
+	TopNEligibleSegments []*SegmentCompactionScore `protobuf:"bytes,8,rep,name=topN_eligible_segments,json=topNEligibleSegments,proto3" json:"topN_eligible_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetChannelSegmentStatsResponse) Reset()         { *m = GetChannelSegmentStatsResponse{} }
+func (m *GetChannelSegmentStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetChannelSegmentStatsResponse) ProtoMessage()    {}
+func (*GetChannelSegmentStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{116}
+}
+
+func (m *GetChannelSegmentStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelSegmentStatsResponse.Unmarshal(m, b)
+}
+func (m *GetChannelSegmentStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelSegmentStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetChannelSegmentStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelSegmentStatsResponse.Merge(m, src)
+}
+func (m *GetChannelSegmentStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetChannelSegmentStatsResponse.Size(m)
+}
+func (m *GetChannelSegmentStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelSegmentStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelSegmentStatsResponse proto.InternalMessageInfo
+
+func (m *GetChannelSegmentStatsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetChannelSegmentStatsResponse) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
+}
+
+func (m *GetChannelSegmentStatsResponse) GetFlushedSegments() int64 {
+	if m != nil {
+		return m.FlushedSegments
+	}
+	return 0
+}
+
+func (m *GetChannelSegmentStatsResponse) GetCompactionEligibleCount() int64 {
+	if m != nil {
+		return m.CompactionEligibleCount
+	}
+	return 0
+}
+
+func (m *GetChannelSegmentStatsResponse) GetTotalRows() int64 {
+	if m != nil {
+		return m.TotalRows
+	}
+	return 0
+}
+
+func (m *GetChannelSegmentStatsResponse) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *GetChannelSegmentStatsResponse) GetOldestEligibleSegmentAge() int64 {
+	if m != nil {
+		return m.OldestEligibleSegmentAge
+	}
+	return 0
+}
+
+func (m *GetChannelSegmentStatsResponse) GetTopNEligibleSegments() []*SegmentCompactionScore {
+	if m != nil {
+		return m.TopNEligibleSegments
+	}
+	return nil
+}
+
+type RestoreSegmentRequest struct {
+	Base      *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	// each binlog of the segment is restored to its latest object version written no later than it
+	Timestamp            uint64   `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreSegmentRequest) Reset()         { *m = RestoreSegmentRequest{} }
+func (m *RestoreSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreSegmentRequest) ProtoMessage()    {}
+func (*RestoreSegmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{117}
+}
+
+func (m *RestoreSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreSegmentRequest.Unmarshal(m, b)
+}
+func (m *RestoreSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreSegmentRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSegmentRequest.Merge(m, src)
+}
+func (m *RestoreSegmentRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreSegmentRequest.Size(m)
+}
+func (m *RestoreSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSegmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreSegmentRequest proto.InternalMessageInfo
+
+func (m *RestoreSegmentRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RestoreSegmentRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *RestoreSegmentRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetFlushProgressRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// segments returned by Flush
+	SegmentIDs           []int64  `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFlushProgressRequest) Reset()         { *m = GetFlushProgressRequest{} }
+func (m *GetFlushProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFlushProgressRequest) ProtoMessage()    {}
+func (*GetFlushProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{118}
+}
+
+func (m *GetFlushProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushProgressRequest.Unmarshal(m, b)
+}
+func (m *GetFlushProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFlushProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushProgressRequest.Merge(m, src)
+}
+func (m *GetFlushProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFlushProgressRequest.Size(m)
+}
+func (m *GetFlushProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushProgressRequest proto.InternalMessageInfo
+
+func (m *GetFlushProgressRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetFlushProgressRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetFlushProgressRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type SegmentFlushProgress struct {
+	SegmentID int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	State     commonpb.SegmentState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	NumRows   int64                 `protobuf:"varint,3,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// 0 when growing, 100 when flushed
+	Progress float64 `protobuf:"fixed64,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	// the segment is merged into another segment by compaction, which implies it was flushed
+	Compacted            bool     `protobuf:"varint,5,opt,name=compacted,proto3" json:"compacted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentFlushProgress) Reset()         { *m = SegmentFlushProgress{} }
+func (m *SegmentFlushProgress) String() string { return proto.CompactTextString(m) }
+func (*SegmentFlushProgress) ProtoMessage()    {}
+func (*SegmentFlushProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{119}
+}
+
+func (m *SegmentFlushProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentFlushProgress.Unmarshal(m, b)
+}
+func (m *SegmentFlushProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentFlushProgress.Marshal(b, m, deterministic)
+}
+func (m *SegmentFlushProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentFlushProgress.Merge(m, src)
+}
+func (m *SegmentFlushProgress) XXX_Size() int {
+	return xxx_messageInfo_SegmentFlushProgress.Size(m)
+}
+func (m *SegmentFlushProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentFlushProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentFlushProgress proto.InternalMessageInfo
+
+func (m *SegmentFlushProgress) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentFlushProgress) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+func (m *SegmentFlushProgress) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *SegmentFlushProgress) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *SegmentFlushProgress) GetCompacted() bool {
+	if m != nil {
+		return m.Compacted
+	}
+	return false
+}
+
+type FlushProgressResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// percentage of the segments flushed, weighted by the number of rows
+	Progress             float64                 `protobuf:"fixed64,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	Segments             []*SegmentFlushProgress `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *FlushProgressResponse) Reset()         { *m = FlushProgressResponse{} }
+func (m *FlushProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*FlushProgressResponse) ProtoMessage()    {}
+func (*FlushProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{120}
+}
+
+func (m *FlushProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushProgressResponse.Unmarshal(m, b)
+}
+func (m *FlushProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *FlushProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushProgressResponse.Merge(m, src)
+}
+func (m *FlushProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_FlushProgressResponse.Size(m)
+}
+func (m *FlushProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushProgressResponse proto.InternalMessageInfo
+
+func (m *FlushProgressResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *FlushProgressResponse) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *FlushProgressResponse) GetSegments() []*SegmentFlushProgress {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type CancelFlushRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// segments sealed by Flush to reopen
+	SegmentIDs           []int64  `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelFlushRequest) Reset()         { *m = CancelFlushRequest{} }
+func (m *CancelFlushRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelFlushRequest) ProtoMessage()    {}
+func (*CancelFlushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{121}
+}
+
+func (m *CancelFlushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelFlushRequest.Unmarshal(m, b)
+}
+func (m *CancelFlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelFlushRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelFlushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelFlushRequest.Merge(m, src)
+}
+func (m *CancelFlushRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelFlushRequest.Size(m)
+}
+func (m *CancelFlushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelFlushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelFlushRequest proto.InternalMessageInfo
+
+func (m *CancelFlushRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelFlushRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CancelFlushRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type CancelFlushResponse struct {
+	// SegmentFlushStarted if any segment is flushing or flushed, no segment is reopened then
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// segments growing after cancelled, including the ones not sealed
+	SegmentIDs           []int64  `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelFlushResponse) Reset()         { *m = CancelFlushResponse{} }
+func (m *CancelFlushResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelFlushResponse) ProtoMessage()    {}
+func (*CancelFlushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{122}
+}
+
+func (m *CancelFlushResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelFlushResponse.Unmarshal(m, b)
+}
+func (m *CancelFlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelFlushResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelFlushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelFlushResponse.Merge(m, src)
+}
+func (m *CancelFlushResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelFlushResponse.Size(m)
+}
+func (m *CancelFlushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelFlushResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelFlushResponse proto.InternalMessageInfo
+
+func (m *CancelFlushResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CancelFlushResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type PauseCompactionRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// why compaction is paused, e.g. a maintenance window
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseCompactionRequest) Reset()         { *m = PauseCompactionRequest{} }
+func (m *PauseCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseCompactionRequest) ProtoMessage()    {}
+func (*PauseCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{123}
+}
+
+func (m *PauseCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseCompactionRequest.Unmarshal(m, b)
+}
+func (m *PauseCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseCompactionRequest.Merge(m, src)
+}
+func (m *PauseCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseCompactionRequest.Size(m)
+}
+func (m *PauseCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseCompactionRequest proto.InternalMessageInfo
+
+func (m *PauseCompactionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PauseCompactionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ResumeCompactionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResumeCompactionRequest) Reset()         { *m = ResumeCompactionRequest{} }
+func (m *ResumeCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeCompactionRequest) ProtoMessage()    {}
+func (*ResumeCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{124}
+}
+
+func (m *ResumeCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeCompactionRequest.Unmarshal(m, b)
+}
+func (m *ResumeCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeCompactionRequest.Merge(m, src)
+}
+func (m *ResumeCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeCompactionRequest.Size(m)
+}
+func (m *ResumeCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeCompactionRequest proto.InternalMessageInfo
+
+func (m *ResumeCompactionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
+	proto.RegisterEnum("milvus.proto.data.ChannelEventType", ChannelEventType_name, ChannelEventType_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionPlanState", CompactionPlanState_name, CompactionPlanState_value)
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
+	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
+	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
+	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
+	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
+	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
+	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
+	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
+	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
+	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
+	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
+	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
+	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
+	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
+	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
+	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
+	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
+	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
+	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
+	proto.RegisterType((*DurabilityAckMsg)(nil), "milvus.proto.data.DurabilityAckMsg")
+	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
+	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
+	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
+	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
+	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*GetFlushedSegmentsV2Request)(nil), "milvus.proto.data.GetFlushedSegmentsV2Request")
+	proto.RegisterType((*GetFlushedSegmentsV2Response)(nil), "milvus.proto.data.GetFlushedSegmentsV2Response")
+	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
+	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
+	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
+	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
+	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
+	proto.RegisterType((*WatchChannelsV2Request)(nil), "milvus.proto.data.WatchChannelsV2Request")
+	proto.RegisterMapType((map[string]*internalpb.MsgPosition)(nil), "milvus.proto.data.WatchChannelsV2Request.SeekPositionsEntry")
+	proto.RegisterType((*ChannelEvent)(nil), "milvus.proto.data.ChannelEvent")
+	proto.RegisterType((*ChannelEventLog)(nil), "milvus.proto.data.ChannelEventLog")
+	proto.RegisterType((*GetChannelHistoryRequest)(nil), "milvus.proto.data.GetChannelHistoryRequest")
+	proto.RegisterType((*GetChannelHistoryResponse)(nil), "milvus.proto.data.GetChannelHistoryResponse")
+	proto.RegisterType((*ImportManifestRequest)(nil), "milvus.proto.data.ImportManifestRequest")
+	proto.RegisterType((*ImportManifestResponse)(nil), "milvus.proto.data.ImportManifestResponse")
+	proto.RegisterType((*CompactionScoreCard)(nil), "milvus.proto.data.CompactionScoreCard")
+	proto.RegisterType((*GetCompactionScoreCardRequest)(nil), "milvus.proto.data.GetCompactionScoreCardRequest")
+	proto.RegisterType((*GetCompactionScoreCardResponse)(nil), "milvus.proto.data.GetCompactionScoreCardResponse")
+	proto.RegisterType((*MigrateChannelRequest)(nil), "milvus.proto.data.MigrateChannelRequest")
+	proto.RegisterType((*MigrateChannelResponse)(nil), "milvus.proto.data.MigrateChannelResponse")
+	proto.RegisterType((*ListCompactionPlansRequest)(nil), "milvus.proto.data.ListCompactionPlansRequest")
+	proto.RegisterType((*CompactionPlanInfo)(nil), "milvus.proto.data.CompactionPlanInfo")
+	proto.RegisterType((*ListCompactionPlansResponse)(nil), "milvus.proto.data.ListCompactionPlansResponse")
+	proto.RegisterType((*ReclaimFailedCompactionRequest)(nil), "milvus.proto.data.ReclaimFailedCompactionRequest")
+	proto.RegisterType((*PinSegmentsRequest)(nil), "milvus.proto.data.PinSegmentsRequest")
+	proto.RegisterType((*UnpinSegmentsRequest)(nil), "milvus.proto.data.UnpinSegmentsRequest")
+	proto.RegisterType((*FlushAllRequest)(nil), "milvus.proto.data.FlushAllRequest")
+	proto.RegisterType((*FlushAllResponse)(nil), "milvus.proto.data.FlushAllResponse")
+	proto.RegisterType((*ListManagedCollectionsRequest)(nil), "milvus.proto.data.ListManagedCollectionsRequest")
+	proto.RegisterType((*SegmentStateCount)(nil), "milvus.proto.data.SegmentStateCount")
+	proto.RegisterType((*ManagedCollectionSummary)(nil), "milvus.proto.data.ManagedCollectionSummary")
+	proto.RegisterType((*ListManagedCollectionsResponse)(nil), "milvus.proto.data.ListManagedCollectionsResponse")
+	proto.RegisterType((*MigrateEtcdPrefixRequest)(nil), "milvus.proto.data.MigrateEtcdPrefixRequest")
+	proto.RegisterType((*MigrateEtcdPrefixResponse)(nil), "milvus.proto.data.MigrateEtcdPrefixResponse")
+	proto.RegisterType((*RegisterDataNodeQuotaRequest)(nil), "milvus.proto.data.RegisterDataNodeQuotaRequest")
+	proto.RegisterType((*StorageAuditRequest)(nil), "milvus.proto.data.StorageAuditRequest")
+	proto.RegisterType((*StorageObject)(nil), "milvus.proto.data.StorageObject")
+	proto.RegisterType((*StorageAuditReport)(nil), "milvus.proto.data.StorageAuditReport")
+	proto.RegisterType((*StorageAuditResponse)(nil), "milvus.proto.data.StorageAuditResponse")
+	proto.RegisterType((*SampleSegmentRequest)(nil), "milvus.proto.data.SampleSegmentRequest")
+	proto.RegisterType((*FieldSampleStats)(nil), "milvus.proto.data.FieldSampleStats")
+	proto.RegisterType((*SampleSegmentResponse)(nil), "milvus.proto.data.SampleSegmentResponse")
+	proto.RegisterType((*GetStorageUsageRequest)(nil), "milvus.proto.data.GetStorageUsageRequest")
+	proto.RegisterType((*LogStorageUsage)(nil), "milvus.proto.data.LogStorageUsage")
+	proto.RegisterType((*FieldStorageUsage)(nil), "milvus.proto.data.FieldStorageUsage")
+	proto.RegisterType((*CollectionStorageUsage)(nil), "milvus.proto.data.CollectionStorageUsage")
+	proto.RegisterType((*GetStorageUsageResponse)(nil), "milvus.proto.data.GetStorageUsageResponse")
+	proto.RegisterType((*CancelCompactionRequest)(nil), "milvus.proto.data.CancelCompactionRequest")
+	proto.RegisterType((*CancelCompactionResponse)(nil), "milvus.proto.data.CancelCompactionResponse")
+	proto.RegisterType((*GCEvent)(nil), "milvus.proto.data.GCEvent")
+	proto.RegisterType((*GetGCEventsRequest)(nil), "milvus.proto.data.GetGCEventsRequest")
+	proto.RegisterType((*GetGCEventsResponse)(nil), "milvus.proto.data.GetGCEventsResponse")
+	proto.RegisterType((*ReportSegmentErrorRequest)(nil), "milvus.proto.data.ReportSegmentErrorRequest")
+	proto.RegisterType((*SetCollectionPropertyRequest)(nil), "milvus.proto.data.SetCollectionPropertyRequest")
+	proto.RegisterType((*ComputeSegmentOverlapRequest)(nil), "milvus.proto.data.ComputeSegmentOverlapRequest")
+	proto.RegisterType((*SegmentOverlapRow)(nil), "milvus.proto.data.SegmentOverlapRow")
+	proto.RegisterType((*SuggestedMerge)(nil), "milvus.proto.data.SuggestedMerge")
+	proto.RegisterType((*OverlapReport)(nil), "milvus.proto.data.OverlapReport")
+	proto.RegisterType((*ComputeSegmentOverlapResponse)(nil), "milvus.proto.data.ComputeSegmentOverlapResponse")
+	proto.RegisterType((*GetCompactionROIRequest)(nil), "milvus.proto.data.GetCompactionROIRequest")
+	proto.RegisterType((*GetCompactionROIResponse)(nil), "milvus.proto.data.GetCompactionROIResponse")
+	proto.RegisterType((*FreezePartitionRequest)(nil), "milvus.proto.data.FreezePartitionRequest")
+	proto.RegisterType((*UnfreezePartitionRequest)(nil), "milvus.proto.data.UnfreezePartitionRequest")
+	proto.RegisterType((*GetSegmentPathRequest)(nil), "milvus.proto.data.GetSegmentPathRequest")
+	proto.RegisterType((*GetSegmentPathResponse)(nil), "milvus.proto.data.GetSegmentPathResponse")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.data.GetSegmentPathResponse.SignedUrlsEntry")
+	proto.RegisterType((*GetSegmentsForCollectionRequest)(nil), "milvus.proto.data.GetSegmentsForCollectionRequest")
+	proto.RegisterType((*SegmentsByState)(nil), "milvus.proto.data.SegmentsByState")
+	proto.RegisterType((*GetSegmentsForCollectionResponse)(nil), "milvus.proto.data.GetSegmentsForCollectionResponse")
+	proto.RegisterType((*ReportDataNodeHealthRequest)(nil), "milvus.proto.data.ReportDataNodeHealthRequest")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.data.ReportDataNodeHealthRequest.ChannelErrorsEntry")
+	proto.RegisterType((*GetSegmentLineageDOTRequest)(nil), "milvus.proto.data.GetSegmentLineageDOTRequest")
+	proto.RegisterType((*GetSegmentLineageDOTResponse)(nil), "milvus.proto.data.GetSegmentLineageDOTResponse")
+	proto.RegisterType((*ReadSegmentRequest)(nil), "milvus.proto.data.ReadSegmentRequest")
+	proto.RegisterType((*ReadSegmentResponse)(nil), "milvus.proto.data.ReadSegmentResponse")
+	proto.RegisterType((*DiscardSegmentRequest)(nil), "milvus.proto.data.DiscardSegmentRequest")
+	proto.RegisterType((*GetChannelSegmentStatsRequest)(nil), "milvus.proto.data.GetChannelSegmentStatsRequest")
+	proto.RegisterType((*SegmentCompactionScore)(nil), "milvus.proto.data.SegmentCompactionScore")
+	proto.RegisterType((*GetChannelSegmentStatsResponse)(nil), "milvus.proto.data.GetChannelSegmentStatsResponse")
+	proto.RegisterType((*RestoreSegmentRequest)(nil), "milvus.proto.data.RestoreSegmentRequest")
+	proto.RegisterType((*GetFlushProgressRequest)(nil), "milvus.proto.data.GetFlushProgressRequest")
+	proto.RegisterType((*SegmentFlushProgress)(nil), "milvus.proto.data.SegmentFlushProgress")
+	proto.RegisterType((*FlushProgressResponse)(nil), "milvus.proto.data.FlushProgressResponse")
+	proto.RegisterType((*CancelFlushRequest)(nil), "milvus.proto.data.CancelFlushRequest")
+	proto.RegisterType((*CancelFlushResponse)(nil), "milvus.proto.data.CancelFlushResponse")
+	proto.RegisterType((*PauseCompactionRequest)(nil), "milvus.proto.data.PauseCompactionRequest")
+	proto.RegisterType((*ResumeCompactionRequest)(nil), "milvus.proto.data.ResumeCompactionRequest")
+}
+
+func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+
+var fileDescriptor_82cd95f524594f49 = []byte{
+	// 6324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x5d, 0x6c, 0x1c, 0xc9,
+	0x71, 0xf0, 0xcd, 0xee, 0x92, 0x5c, 0xd6, 0xfe, 0x70, 0x39, 0xfc, 0xd1, 0xde, 0x4a, 0xa7, 0x9f,
+	0xd1, 0x9d, 0x4e, 0xd2, 0x9d, 0xf5, 0xc3, 0xfb, 0xfc, 0xf9, 0x7c, 0xa7, 0xb3, 0x43, 0x91, 0x92,
+	0x4c, 0x9f, 0x28, 0xd1, 0x43, 0xe9, 0x9c, 0xd8, 0x88, 0x37, 0xc3, 0x9d, 0xe6, 0x72, 0x8e, 0xb3,
+	0x33, 0xeb, 0xe9, 0x59, 0x8a, 0x3c, 0x04, 0x39, 0xc3, 0x8e, 0x83, 0xd8, 0xf0, 0x4f, 0x12, 0xc0,
+	0x41, 0x80, 0x24, 0x48, 0x10, 0xe4, 0x17, 0x46, 0x02, 0xbf, 0x04, 0x01, 0x0c, 0x24, 0x40, 0x82,
+	0x3c, 0x04, 0xc9, 0x4b, 0x9e, 0xf3, 0x1c, 0x04, 0x08, 0x10, 0x24, 0xaf, 0x79, 0x0c, 0xfa, 0x6f,
+	0xa6, 0x67, 0xb6, 0x67, 0x77, 0xc8, 0x15, 0x4f, 0x7e, 0xdb, 0xae, 0xa9, 0xee, 0xae, 0xae, 0xae,
+	0xae, 0xae, 0xaa, 0xae, 0xee, 0x85, 0x86, 0x6d, 0x85, 0x56, 0xbb, 0xe3, 0xfb, 0x81, 0x7d, 0xa3,
+	0x1f, 0xf8, 0xa1, 0xaf, 0xcf, 0xf7, 0x1c, 0xf7, 0x60, 0x80, 0x59, 0xe9, 0x06, 0xf9, 0xdc, 0xaa,
+	0x76, 0xfc, 0x5e, 0xcf, 0xf7, 0x18, 0xa8, 0x55, 0x77, 0xbc, 0x10, 0x05, 0x9e, 0xe5, 0xf2, 0x72,
+	0x55, 0xae, 0xd0, 0xaa, 0xe2, 0xce, 0x1e, 0xea, 0x59, 0xac, 0x64, 0x1c, 0x42, 0xf5, 0xbe, 0x3b,
+	0xc0, 0x7b, 0x26, 0xfa, 0xfa, 0x00, 0xe1, 0x50, 0xbf, 0x05, 0xa5, 0x1d, 0x0b, 0xa3, 0xa6, 0x76,
+	0x51, 0xbb, 0x5a, 0x59, 0x39, 0x77, 0x23, 0xd1, 0x17, 0xef, 0x65, 0x13, 0x77, 0xef, 0x5a, 0x18,
+	0x99, 0x14, 0x53, 0xd7, 0xa1, 0x64, 0xef, 0x6c, 0xac, 0x37, 0x0b, 0x17, 0xb5, 0xab, 0x45, 0x93,
+	0xfe, 0xd6, 0x0d, 0xa8, 0x76, 0x7c, 0xd7, 0x45, 0x9d, 0xd0, 0xf1, 0xbd, 0x8d, 0xf5, 0x66, 0x89,
+	0x7e, 0x4b, 0xc0, 0x8c, 0xdf, 0xd3, 0xa0, 0xc6, 0xbb, 0xc6, 0x7d, 0xdf, 0xc3, 0x48, 0x7f, 0x0b,
+	0xa6, 0x71, 0x68, 0x85, 0x03, 0xcc, 0x7b, 0x3f, 0xab, 0xec, 0x7d, 0x9b, 0xa2, 0x98, 0x1c, 0x35,
+	0x57, 0xf7, 0xc5, 0xe1, 0xee, 0xf5, 0xf3, 0x00, 0x18, 0x75, 0x7b, 0xc8, 0x0b, 0x37, 0xd6, 0x71,
+	0xb3, 0x74, 0xb1, 0x78, 0xb5, 0x68, 0x4a, 0x10, 0xe3, 0x37, 0x35, 0x68, 0x6c, 0x8b, 0xa2, 0xe0,
+	0xce, 0x22, 0x4c, 0x75, 0xfc, 0x81, 0x17, 0x52, 0x02, 0x6b, 0x26, 0x2b, 0xe8, 0x97, 0xa0, 0xda,
+	0xd9, 0xb3, 0x3c, 0x0f, 0xb9, 0x6d, 0xcf, 0xea, 0x21, 0x4a, 0xca, 0xac, 0x59, 0xe1, 0xb0, 0x47,
+	0x56, 0x0f, 0xe5, 0xa2, 0xe8, 0x22, 0x54, 0xfa, 0x56, 0x10, 0x3a, 0x09, 0x9e, 0xc9, 0x20, 0xe3,
+	0x0f, 0x35, 0x58, 0x5e, 0xc5, 0xd8, 0xe9, 0x7a, 0x43, 0x94, 0x2d, 0xc3, 0xb4, 0xe7, 0xdb, 0x68,
+	0x63, 0x9d, 0x92, 0x56, 0x34, 0x79, 0x49, 0x3f, 0x0b, 0xb3, 0x7d, 0x84, 0x82, 0x76, 0xe0, 0xbb,
+	0x82, 0xb0, 0x32, 0x01, 0x98, 0xbe, 0x8b, 0xf4, 0x2f, 0xc1, 0x3c, 0x4e, 0x35, 0x84, 0x9b, 0xc5,
+	0x8b, 0xc5, 0xab, 0x95, 0x95, 0xcb, 0x37, 0x86, 0xa4, 0xec, 0x46, 0xba, 0x53, 0x73, 0xb8, 0xb6,
+	0xf1, 0x8d, 0x02, 0x2c, 0x44, 0x78, 0x8c, 0x56, 0xf2, 0x9b, 0x70, 0x0e, 0xa3, 0x6e, 0x44, 0x1e,
+	0x2b, 0xe4, 0xe1, 0x5c, 0xc4, 0xf2, 0xa2, 0xcc, 0xf2, 0x1c, 0x02, 0x96, 0xe6, 0xe7, 0xd4, 0x10,
+	0x3f, 0xf5, 0x0b, 0x50, 0x41, 0x87, 0x7d, 0x27, 0x40, 0xed, 0xd0, 0xe9, 0xa1, 0xe6, 0xf4, 0x45,
+	0xed, 0x6a, 0xc9, 0x04, 0x06, 0x7a, 0xe2, 0xf4, 0x64, 0x89, 0x9c, 0xc9, 0x2d, 0x91, 0xc6, 0x1f,
+	0x69, 0x70, 0x66, 0x68, 0x96, 0xb8, 0x88, 0x9b, 0xd0, 0xa0, 0x23, 0x8f, 0x39, 0x43, 0x84, 0x9d,
+	0x30, 0xfc, 0xca, 0x28, 0x86, 0xc7, 0xe8, 0xe6, 0x50, 0x7d, 0x89, 0xc8, 0x42, 0x7e, 0x22, 0xf7,
+	0xe1, 0xcc, 0x03, 0x14, 0xf2, 0x0e, 0xc8, 0x37, 0x84, 0x4f, 0xae, 0x02, 0x92, 0x6b, 0xa9, 0x30,
+	0xb4, 0x96, 0x7e, 0x52, 0x80, 0x86, 0xdc, 0xd5, 0x86, 0xb7, 0xeb, 0xeb, 0xe7, 0x60, 0x36, 0x42,
+	0xe1, 0x52, 0x11, 0x03, 0xf4, 0xcf, 0xc0, 0x14, 0xa1, 0x94, 0x89, 0x44, 0x7d, 0xe5, 0x92, 0x7a,
+	0x4c, 0x52, 0x9b, 0x26, 0xc3, 0xd7, 0x37, 0xa0, 0x8e, 0x43, 0x2b, 0x08, 0xdb, 0x7d, 0x1f, 0xd3,
+	0x79, 0xa6, 0x82, 0x53, 0x59, 0x31, 0x92, 0x2d, 0x44, 0x2a, 0x72, 0x13, 0x77, 0xb7, 0x38, 0xa6,
+	0x59, 0xa3, 0x35, 0x45, 0x51, 0xbf, 0x07, 0x55, 0xe4, 0xd9, 0x71, 0x43, 0xa5, 0xdc, 0x0d, 0x55,
+	0x90, 0x67, 0x47, 0xcd, 0xc4, 0xf3, 0x33, 0x95, 0x7f, 0x7e, 0xbe, 0xa7, 0x41, 0x73, 0x78, 0x82,
+	0x26, 0x51, 0x94, 0xef, 0xb2, 0x4a, 0x88, 0x4d, 0xd0, 0xc8, 0x15, 0x1e, 0x4d, 0x92, 0xc9, 0xab,
+	0x18, 0x0e, 0x2c, 0xc5, 0xd4, 0xd0, 0x2f, 0xa7, 0x26, 0x2c, 0xdf, 0xd2, 0x60, 0x39, 0xdd, 0xd7,
+	0x24, 0xe3, 0xfe, 0x7f, 0x30, 0xe5, 0x78, 0xbb, 0xbe, 0x18, 0xf6, 0xf9, 0x11, 0xeb, 0x8c, 0xf4,
+	0xc5, 0x90, 0x8d, 0x1e, 0x9c, 0x7d, 0x80, 0xc2, 0x0d, 0x0f, 0xa3, 0x20, 0xbc, 0xeb, 0x78, 0xae,
+	0xdf, 0xdd, 0xb2, 0xc2, 0xbd, 0x09, 0xd6, 0x48, 0x42, 0xdc, 0x0b, 0x29, 0x71, 0x37, 0xfe, 0x5c,
+	0x83, 0x73, 0xea, 0xfe, 0xf8, 0xd0, 0x5b, 0x50, 0xde, 0x75, 0x90, 0x6b, 0x6f, 0xac, 0x33, 0x85,
+	0x51, 0x34, 0xa3, 0x32, 0x59, 0x2b, 0x7d, 0x82, 0xcc, 0x47, 0x78, 0x29, 0x43, 0x40, 0xb7, 0xc3,
+	0xc0, 0xf1, 0xba, 0x0f, 0x1d, 0x1c, 0x9a, 0x0c, 0x5f, 0xe2, 0x67, 0x31, 0xbf, 0x64, 0x7e, 0x57,
+	0x83, 0xf3, 0x0f, 0x50, 0xb8, 0x16, 0xa9, 0x5a, 0xf2, 0xdd, 0xc1, 0xa1, 0xd3, 0xc1, 0xa7, 0x6b,
+	0x44, 0x28, 0xf6, 0x4c, 0xe3, 0x87, 0x1a, 0x5c, 0xc8, 0x24, 0x86, 0xb3, 0x8e, 0xab, 0x12, 0xa1,
+	0x68, 0xd5, 0xaa, 0xe4, 0x7d, 0x74, 0xf4, 0x81, 0xe5, 0x0e, 0xd0, 0x96, 0xe5, 0x04, 0x4c, 0x95,
+	0x9c, 0x50, 0xb1, 0xfe, 0x58, 0x83, 0x57, 0x1e, 0xa0, 0x70, 0x4b, 0x6c, 0x33, 0x2f, 0x90, 0x3b,
+	0x39, 0x2c, 0x8a, 0x1f, 0xb0, 0xc9, 0x54, 0x52, 0xfb, 0x42, 0xd8, 0x77, 0x9e, 0xae, 0x03, 0x69,
+	0x41, 0xae, 0x31, 0x5b, 0x80, 0x33, 0xcf, 0xf8, 0xeb, 0x02, 0x54, 0x3f, 0xe0, 0xf6, 0x01, 0xf9,
+	0x3c, 0xc4, 0x07, 0x4d, 0xcd, 0x07, 0xc9, 0xa4, 0x50, 0x59, 0x19, 0x0f, 0xa0, 0x86, 0x11, 0xda,
+	0x3f, 0xc9, 0xa6, 0x51, 0x25, 0x15, 0x45, 0x49, 0x7f, 0x08, 0xf3, 0x03, 0x6f, 0x97, 0x98, 0xb5,
+	0xc8, 0xe6, 0xa3, 0x60, 0xd6, 0xe5, 0x78, 0xcd, 0x33, 0x5c, 0x51, 0xff, 0x02, 0xcc, 0xa5, 0xdb,
+	0x9a, 0xca, 0xd5, 0x56, 0xba, 0x9a, 0xf1, 0x1d, 0x0d, 0x96, 0xbf, 0x6c, 0x85, 0x9d, 0xbd, 0xf5,
+	0x1e, 0xe7, 0xe8, 0x04, 0xf2, 0xf8, 0x1e, 0xcc, 0x1e, 0x70, 0xee, 0x09, 0xa5, 0x73, 0x41, 0x41,
+	0x90, 0x3c, 0x4f, 0x66, 0x5c, 0xc3, 0xf8, 0x27, 0x0d, 0x16, 0xa9, 0xe5, 0x2f, 0xa8, 0xfb, 0xe4,
+	0x57, 0xc6, 0x18, 0xeb, 0x5f, 0xbf, 0x02, 0xf5, 0x9e, 0x15, 0xec, 0x6f, 0xc7, 0x38, 0x53, 0x14,
+	0x27, 0x05, 0x35, 0x0e, 0x01, 0x78, 0x69, 0x13, 0x77, 0x4f, 0x40, 0xff, 0xdb, 0x30, 0xc3, 0x7b,
+	0xe5, 0x8b, 0x64, 0xdc, 0xc4, 0x0a, 0x74, 0xe3, 0x9f, 0x35, 0xa8, 0xc7, 0x6a, 0x8f, 0x2e, 0x85,
+	0x3a, 0x14, 0xa2, 0x05, 0x50, 0xd8, 0x58, 0xd7, 0xdf, 0x83, 0x69, 0xe6, 0xeb, 0xf1, 0xb6, 0x5f,
+	0x4b, 0xb6, 0xcd, 0xbe, 0xdd, 0x90, 0x74, 0x27, 0x05, 0x98, 0xbc, 0x12, 0xe1, 0x51, 0xa4, 0x2a,
+	0x98, 0x5b, 0x50, 0x34, 0x25, 0x88, 0xbe, 0x01, 0x73, 0x49, 0x4b, 0x4b, 0x08, 0xfa, 0xc5, 0x2c,
+	0x15, 0xb1, 0x6e, 0x85, 0x16, 0xd5, 0x10, 0xf5, 0x84, 0xa1, 0x85, 0x8d, 0x3f, 0x9b, 0x81, 0x8a,
+	0x34, 0xca, 0xa1, 0x91, 0xa4, 0xa7, 0xb4, 0x30, 0x5e, 0xd9, 0x15, 0x87, 0xcd, 0xfd, 0xd7, 0xa0,
+	0xee, 0xd0, 0x0d, 0xb6, 0xcd, 0x45, 0x91, 0x6a, 0xc4, 0x59, 0xb3, 0xc6, 0xa0, 0x7c, 0x5d, 0xe8,
+	0xe7, 0xa1, 0xe2, 0x0d, 0x7a, 0x6d, 0x7f, 0xb7, 0x1d, 0xf8, 0xcf, 0x30, 0xf7, 0x1b, 0x66, 0xbd,
+	0x41, 0xef, 0xf1, 0xae, 0xe9, 0x3f, 0xc3, 0xb1, 0x69, 0x3a, 0x7d, 0x4c, 0xd3, 0xf4, 0x3c, 0x54,
+	0x7a, 0xd6, 0x21, 0x69, 0xb5, 0xed, 0x0d, 0x7a, 0xd4, 0xa5, 0x28, 0x9a, 0xb3, 0x3d, 0xeb, 0xd0,
+	0xf4, 0x9f, 0x3d, 0x1a, 0xf4, 0xf4, 0xab, 0xd0, 0x70, 0x2d, 0x1c, 0xb6, 0x65, 0x9f, 0xa4, 0x4c,
+	0x7d, 0x92, 0x3a, 0x81, 0xdf, 0x8b, 0xfd, 0x92, 0x61, 0x23, 0x77, 0x76, 0x02, 0x23, 0xd7, 0xee,
+	0xb9, 0x71, 0x43, 0x90, 0xdf, 0xc8, 0xb5, 0x7b, 0x6e, 0xd4, 0xcc, 0xdb, 0x30, 0xb3, 0x43, 0xcd,
+	0x16, 0xdc, 0xac, 0x64, 0x6a, 0xa8, 0xfb, 0xc4, 0x62, 0x61, 0xd6, 0x8d, 0x29, 0xd0, 0xf5, 0x3b,
+	0x30, 0x4b, 0xf7, 0x0b, 0x5a, 0xb7, 0x9a, 0xab, 0x6e, 0x5c, 0x81, 0xa8, 0x22, 0x1b, 0xb9, 0xa1,
+	0x45, 0x6b, 0xd7, 0x32, 0x55, 0xd1, 0x3a, 0xc1, 0x79, 0xe8, 0x77, 0x99, 0x2a, 0x8a, 0x6a, 0xe8,
+	0xb7, 0x60, 0xa1, 0x13, 0x20, 0x2b, 0x44, 0xf6, 0xdd, 0xa3, 0x35, 0xbf, 0xd7, 0xb7, 0xa8, 0x34,
+	0x35, 0xeb, 0x17, 0xb5, 0xab, 0x65, 0x53, 0xf5, 0x89, 0x68, 0x86, 0x4e, 0x54, 0xba, 0x1f, 0xf8,
+	0xbd, 0xe6, 0x1c, 0xd3, 0x0c, 0x49, 0xa8, 0xfe, 0x0a, 0x80, 0x1d, 0xf8, 0xfd, 0x3e, 0xb2, 0xdb,
+	0x56, 0xd8, 0x6c, 0xd0, 0x69, 0x9c, 0xe5, 0x90, 0xd5, 0x90, 0xb8, 0x9e, 0x0e, 0x6e, 0x3b, 0xbd,
+	0xbe, 0x1f, 0x84, 0xc8, 0x6e, 0xce, 0xd3, 0x0e, 0xc1, 0xc1, 0x1b, 0x1c, 0xa2, 0x7f, 0x0e, 0x00,
+	0xef, 0xa3, 0xb0, 0xb3, 0x47, 0x47, 0xa6, 0xe7, 0xe2, 0x8b, 0x54, 0x83, 0x04, 0x04, 0xfa, 0x8e,
+	0xe7, 0x21, 0xbb, 0xb9, 0x40, 0xdb, 0xe6, 0x25, 0xbd, 0x09, 0x33, 0x07, 0x28, 0xc0, 0x64, 0x94,
+	0x8b, 0x54, 0x00, 0x45, 0x51, 0xbf, 0x0c, 0x35, 0xba, 0x6b, 0xb4, 0xa9, 0x80, 0x20, 0xbb, 0xb9,
+	0x44, 0x2b, 0x56, 0x29, 0x70, 0x9b, 0xc1, 0x8c, 0x8f, 0x61, 0x31, 0x16, 0x6d, 0x49, 0x8c, 0x86,
+	0x25, 0x52, 0x3b, 0xa9, 0x44, 0x8e, 0xb6, 0x94, 0xff, 0x6b, 0x0a, 0x96, 0xb7, 0xad, 0x03, 0x74,
+	0xfa, 0x46, 0x79, 0xae, 0x8d, 0xe4, 0x21, 0xcc, 0x53, 0x3b, 0x7c, 0x45, 0xa2, 0xa7, 0x59, 0xca,
+	0x35, 0x5b, 0xc3, 0x15, 0xf5, 0xcf, 0x13, 0x43, 0x05, 0x75, 0xf6, 0xb7, 0x7c, 0x27, 0xde, 0xeb,
+	0x5f, 0x51, 0xb4, 0xb3, 0x16, 0x61, 0x99, 0x72, 0x0d, 0x7d, 0x6b, 0x58, 0x27, 0x4f, 0xd3, 0x46,
+	0x5e, 0x1f, 0xe9, 0xed, 0xc5, 0xdc, 0x4f, 0xab, 0x66, 0x22, 0x2f, 0xdc, 0x96, 0xa0, 0x0a, 0xab,
+	0x6c, 0x8a, 0xa2, 0xbe, 0x05, 0x0b, 0x6c, 0x04, 0xdb, 0x7c, 0x35, 0xb2, 0xc1, 0x97, 0x73, 0x0d,
+	0x5e, 0x55, 0x35, 0xb9, 0x98, 0x67, 0x8f, 0xbd, 0x98, 0x9b, 0x30, 0xc3, 0x17, 0x18, 0xd5, 0x62,
+	0x65, 0x53, 0x14, 0x75, 0x13, 0x16, 0x79, 0x7f, 0x62, 0x81, 0x30, 0x5a, 0xf3, 0xa9, 0x2a, 0x65,
+	0x5d, 0xfd, 0x1a, 0x34, 0xd0, 0x61, 0x1f, 0x75, 0x42, 0x64, 0xb7, 0xc5, 0x8a, 0xaa, 0x52, 0x09,
+	0x99, 0x13, 0xf0, 0x0f, 0xf8, 0xca, 0x6a, 0xc2, 0x4c, 0x80, 0x76, 0x06, 0x8e, 0x1b, 0x36, 0x6b,
+	0x8c, 0x30, 0x5e, 0xe4, 0x6a, 0x20, 0x40, 0x38, 0xf4, 0x03, 0x64, 0x73, 0xbd, 0x03, 0x0e, 0x36,
+	0x39, 0x84, 0x78, 0x5b, 0x10, 0x4f, 0xf6, 0x98, 0xa0, 0xc9, 0xe7, 0xa0, 0x1c, 0x2d, 0xbf, 0x42,
+	0xee, 0xe5, 0x17, 0xd5, 0x49, 0xef, 0x7c, 0xc5, 0xd4, 0xce, 0x67, 0xfc, 0x8b, 0x06, 0x55, 0x99,
+	0xf9, 0x64, 0x47, 0x0d, 0x50, 0xc7, 0x0f, 0xec, 0x36, 0xf2, 0xc2, 0xc0, 0x41, 0xcc, 0x31, 0x2f,
+	0x99, 0x35, 0x06, 0xbd, 0xc7, 0x80, 0x04, 0x8d, 0x6c, 0x66, 0x38, 0xb4, 0x7a, 0xfd, 0xf6, 0x2e,
+	0xd1, 0x99, 0x05, 0x86, 0x16, 0x41, 0xa9, 0xca, 0xbc, 0x04, 0xd5, 0x18, 0x2d, 0xf4, 0x69, 0xff,
+	0x25, 0xb3, 0x12, 0xc1, 0x9e, 0xf8, 0xfa, 0xab, 0x50, 0xa7, 0xf3, 0xdd, 0x76, 0xfd, 0x6e, 0x9b,
+	0x38, 0xb1, 0x7c, 0x0b, 0xaf, 0xda, 0x9c, 0x2c, 0x32, 0x37, 0x49, 0x2c, 0xec, 0x7c, 0x84, 0xf8,
+	0x26, 0x1e, 0x61, 0x6d, 0x3b, 0x1f, 0x21, 0xe3, 0x9b, 0x1a, 0xd4, 0x88, 0x45, 0xf2, 0xc8, 0xb7,
+	0xd1, 0x93, 0x13, 0xda, 0x6f, 0x39, 0x02, 0x98, 0xe7, 0x60, 0x36, 0x1a, 0x01, 0x1f, 0x52, 0x0c,
+	0x30, 0xfe, 0x57, 0x83, 0xc6, 0xfa, 0x20, 0xb0, 0x76, 0x1c, 0xd7, 0x09, 0x8f, 0x56, 0x3b, 0xfb,
+	0xa7, 0x46, 0x47, 0x1e, 0x6d, 0x96, 0x10, 0xaf, 0x52, 0x5a, 0xbc, 0x36, 0xa1, 0xc1, 0xd7, 0x7e,
+	0xac, 0xe5, 0xa7, 0x72, 0x8b, 0x99, 0x70, 0x49, 0x04, 0x80, 0x04, 0x7a, 0x6a, 0xdc, 0xe6, 0xda,
+	0x8e, 0x62, 0xf9, 0x94, 0x7a, 0x8d, 0x52, 0x4f, 0x7f, 0xeb, 0xef, 0x24, 0x03, 0x81, 0xaf, 0x2a,
+	0x95, 0x21, 0x6d, 0x84, 0xba, 0x37, 0x09, 0x83, 0x2b, 0x4f, 0x04, 0xe1, 0x1b, 0x44, 0xa6, 0xb9,
+	0x14, 0x50, 0x99, 0x6e, 0xc2, 0x8c, 0x65, 0xdb, 0x01, 0xc2, 0x98, 0xd3, 0x21, 0x8a, 0xf2, 0xd6,
+	0x59, 0x48, 0x6e, 0x9d, 0x77, 0xa0, 0x1c, 0xf9, 0x43, 0x45, 0x95, 0x0d, 0x2c, 0xd3, 0xc9, 0x3d,
+	0xde, 0xa8, 0x86, 0xf1, 0xc3, 0x02, 0xd4, 0xb9, 0x2e, 0xbe, 0xcb, 0x8d, 0xa2, 0xd1, 0xeb, 0xfc,
+	0x2e, 0x54, 0x77, 0x63, 0xfd, 0x34, 0x2a, 0xb2, 0x25, 0xab, 0xb1, 0x44, 0x9d, 0x71, 0x6b, 0x3d,
+	0x69, 0x96, 0x95, 0x26, 0x32, 0xcb, 0xa6, 0x8e, 0xab, 0xc9, 0x8d, 0x55, 0xa8, 0x48, 0x0d, 0xd3,
+	0x3d, 0x88, 0x05, 0xbb, 0x38, 0x2f, 0x44, 0x91, 0x7c, 0xd9, 0x91, 0x98, 0x30, 0x1b, 0x99, 0x95,
+	0xc4, 0xc9, 0x24, 0x11, 0x6e, 0x13, 0x75, 0xfc, 0x03, 0x14, 0x1c, 0x4d, 0x1e, 0x47, 0x7c, 0x57,
+	0x9a, 0xe3, 0x9c, 0x3e, 0x6f, 0x54, 0x41, 0x7f, 0x37, 0xa6, 0xb3, 0xa8, 0x0a, 0xa3, 0xc8, 0xfb,
+	0x31, 0x9f, 0xa1, 0x78, 0x28, 0xbf, 0xc1, 0x22, 0xa2, 0xc9, 0xa1, 0x9c, 0xd4, 0xe4, 0x79, 0x2e,
+	0xae, 0x14, 0xe1, 0xee, 0xcb, 0x0f, 0x50, 0x78, 0x3f, 0x19, 0x65, 0x78, 0xc1, 0x54, 0x91, 0xb3,
+	0x22, 0xd7, 0xe9, 0x39, 0x21, 0x57, 0x5d, 0xac, 0x40, 0x2c, 0xf1, 0xbe, 0xd5, 0x45, 0xed, 0xd0,
+	0xdf, 0x47, 0x4c, 0x61, 0xcd, 0x9a, 0xb3, 0x04, 0xf2, 0x84, 0x00, 0x8c, 0x1f, 0x69, 0xd0, 0x52,
+	0x0d, 0x65, 0x12, 0x59, 0x69, 0x41, 0x99, 0xaf, 0x56, 0x11, 0xe1, 0x8e, 0xca, 0xfa, 0x15, 0x98,
+	0xf3, 0xd0, 0x61, 0xd8, 0x96, 0x68, 0x2a, 0x32, 0x37, 0x94, 0x80, 0xb7, 0x22, 0xba, 0x7e, 0x5c,
+	0x80, 0xb3, 0xc3, 0x74, 0x7d, 0xb0, 0xf2, 0xa2, 0x99, 0xfc, 0xd9, 0xe8, 0x1c, 0x81, 0x68, 0x85,
+	0x5c, 0xfe, 0x2f, 0xaf, 0xa0, 0xbf, 0x01, 0xf3, 0x8e, 0xd7, 0x71, 0x07, 0x36, 0x6a, 0xcb, 0xda,
+	0x81, 0xd8, 0x3c, 0x0d, 0xfe, 0x61, 0x5d, 0xc0, 0x89, 0x03, 0xd3, 0x19, 0x04, 0xd8, 0x0f, 0xa8,
+	0x9f, 0x5d, 0x34, 0x79, 0x29, 0x9e, 0xe4, 0x19, 0x69, 0x92, 0x8d, 0x9f, 0xb0, 0x00, 0xba, 0x82,
+	0x5b, 0x93, 0xcc, 0xe3, 0x3b, 0xa9, 0x79, 0x1c, 0x1f, 0x9f, 0x89, 0xe7, 0xf9, 0x02, 0x54, 0xe8,
+	0x3c, 0xf3, 0x41, 0x30, 0x4e, 0x02, 0x01, 0xad, 0x51, 0x88, 0xf1, 0x6b, 0x1a, 0x34, 0x79, 0x55,
+	0x4a, 0x36, 0x71, 0x32, 0x5d, 0x14, 0x22, 0xfb, 0x93, 0x0e, 0x25, 0xfd, 0x81, 0x06, 0x0d, 0x79,
+	0x0f, 0x25, 0x5f, 0xf5, 0x4f, 0xc3, 0x14, 0x8d, 0xd8, 0x71, 0x0a, 0xc6, 0xea, 0x3a, 0x86, 0x4d,
+	0x14, 0x32, 0x75, 0x20, 0x9e, 0x60, 0xb1, 0x47, 0xf2, 0x62, 0xbc, 0x91, 0x17, 0x8f, 0xbd, 0x91,
+	0x1b, 0xdf, 0x2f, 0x40, 0x33, 0xf6, 0xc1, 0x3f, 0xf1, 0xbd, 0x32, 0xc3, 0xd3, 0x29, 0x3e, 0x27,
+	0x4f, 0xa7, 0x74, 0xec, 0xfd, 0xf1, 0xdf, 0x0a, 0x50, 0x8f, 0xf9, 0xb1, 0xe5, 0x5a, 0x1e, 0xf5,
+	0xf7, 0x5d, 0x2b, 0x8e, 0x80, 0xf3, 0x92, 0xbe, 0x0d, 0x75, 0x9c, 0xe0, 0x17, 0xe7, 0xc0, 0x1b,
+	0x2a, 0xfe, 0x67, 0xb0, 0xd8, 0x4c, 0x35, 0x41, 0x54, 0x2a, 0x73, 0x33, 0x69, 0x8c, 0x8a, 0x1b,
+	0xb5, 0x6c, 0xa2, 0x49, 0x78, 0xea, 0x4d, 0xd0, 0xc9, 0x07, 0x7f, 0x10, 0xb6, 0x1d, 0xaf, 0x8d,
+	0x51, 0xc7, 0xf7, 0x6c, 0x4c, 0x95, 0xf2, 0x94, 0xd9, 0xe0, 0x5f, 0x36, 0xbc, 0x6d, 0x06, 0xd7,
+	0x3f, 0x0d, 0xa5, 0xf0, 0xa8, 0xcf, 0x6c, 0xf4, 0xfa, 0xca, 0xa5, 0x91, 0x74, 0x3d, 0x39, 0xea,
+	0x23, 0x93, 0xa2, 0x93, 0xf0, 0x24, 0x69, 0x2a, 0x0c, 0xac, 0x03, 0xe4, 0x8a, 0xb3, 0xfb, 0x18,
+	0x42, 0x24, 0x51, 0x84, 0xf9, 0x66, 0x98, 0x1d, 0xc7, 0x8b, 0x34, 0x34, 0x83, 0xfa, 0xc8, 0xb3,
+	0x71, 0xdb, 0xf7, 0xa8, 0xbf, 0x5a, 0x34, 0x67, 0x39, 0xe4, 0xb1, 0x67, 0xfc, 0xb4, 0x00, 0x8d,
+	0xb8, 0x47, 0x13, 0xe1, 0x81, 0x1b, 0x66, 0xb2, 0x77, 0x74, 0x04, 0x61, 0x9c, 0x91, 0xf5, 0x79,
+	0xa8, 0xf0, 0x88, 0xe4, 0x31, 0xcc, 0x2c, 0x60, 0x55, 0x1e, 0x8e, 0x90, 0xcc, 0xa9, 0xe7, 0x24,
+	0x99, 0xd3, 0xc7, 0x96, 0xcc, 0x6d, 0x58, 0x16, 0x3a, 0x2d, 0xee, 0x69, 0x13, 0x85, 0xd6, 0x08,
+	0x23, 0xee, 0x02, 0x54, 0x98, 0xa9, 0xc3, 0x3c, 0x3a, 0xe6, 0xbb, 0xc0, 0x4e, 0x14, 0x17, 0x31,
+	0xbe, 0x06, 0x8b, 0x54, 0x27, 0xa4, 0x4f, 0x2e, 0xf2, 0x9c, 0xfd, 0x18, 0x50, 0x95, 0xbc, 0x20,
+	0x61, 0x26, 0x26, 0x60, 0xc6, 0x43, 0x58, 0x4a, 0xb5, 0x3f, 0xc1, 0xa6, 0x41, 0x36, 0xee, 0xe5,
+	0x44, 0x73, 0xf1, 0x9e, 0xfd, 0x9c, 0x08, 0xd6, 0x3b, 0x50, 0x4f, 0x1c, 0x57, 0x09, 0x5d, 0x74,
+	0x47, 0x31, 0x53, 0x6a, 0x52, 0x6e, 0x6c, 0x4b, 0xa7, 0x56, 0x98, 0x38, 0xea, 0x47, 0x66, 0x4d,
+	0x3e, 0xc9, 0xc2, 0x2d, 0x1b, 0xf4, 0x61, 0x24, 0xbd, 0x01, 0xc5, 0x7d, 0x74, 0xc4, 0x5d, 0x23,
+	0xf2, 0x53, 0x7f, 0x1b, 0xa6, 0x0e, 0x2c, 0x77, 0x80, 0x8e, 0x11, 0x72, 0x60, 0x15, 0xde, 0x29,
+	0xbc, 0xad, 0x19, 0x7f, 0xac, 0x41, 0x95, 0x53, 0x77, 0xef, 0x00, 0x29, 0xb2, 0xa9, 0xb4, 0x61,
+	0x57, 0x36, 0x4e, 0x76, 0x2a, 0x24, 0x92, 0x9d, 0xde, 0x85, 0x69, 0x1e, 0xc0, 0x65, 0x7b, 0xcc,
+	0xe5, 0xec, 0x3d, 0x86, 0xf6, 0x45, 0xb5, 0x09, 0xaf, 0x92, 0xf4, 0xd3, 0xb9, 0xef, 0x1b, 0x01,
+	0x8c, 0x2f, 0xc2, 0x9c, 0x5c, 0xf3, 0xa1, 0xdf, 0xd5, 0x3f, 0x03, 0xd3, 0xe8, 0x40, 0xca, 0xe0,
+	0xb9, 0x30, 0xa6, 0x37, 0x93, 0xa3, 0x1b, 0x3e, 0x4d, 0xed, 0xe0, 0x9f, 0xbe, 0xe0, 0xe0, 0xd0,
+	0x0f, 0x8e, 0x4e, 0x6e, 0xd5, 0x8d, 0x77, 0xfd, 0x8d, 0xef, 0x30, 0x6b, 0x3d, 0xdd, 0xe3, 0x24,
+	0x96, 0x51, 0x3c, 0xf8, 0xc2, 0xf1, 0x06, 0xef, 0xc2, 0x12, 0x8b, 0x71, 0x6f, 0x5a, 0x9e, 0xb3,
+	0x8b, 0x70, 0x38, 0xd1, 0xc8, 0x7b, 0xbc, 0x91, 0xf6, 0x20, 0x70, 0xc5, 0xc8, 0x05, 0xec, 0x69,
+	0xe0, 0x1a, 0x3d, 0x58, 0x4e, 0xf7, 0x36, 0xc9, 0xa8, 0xc7, 0xe5, 0xae, 0x7c, 0x0c, 0x0b, 0xd2,
+	0x1e, 0xda, 0xf1, 0x03, 0xb4, 0x66, 0x05, 0x36, 0xa9, 0xd6, 0xf7, 0x5d, 0xa7, 0x73, 0xf4, 0x28,
+	0x16, 0x68, 0x09, 0x42, 0x93, 0xe3, 0x08, 0x32, 0x1d, 0x81, 0x66, 0xb2, 0x02, 0x91, 0xf2, 0x00,
+	0x59, 0xd8, 0x17, 0xfe, 0x01, 0x2f, 0x11, 0xe7, 0x02, 0xb9, 0x4e, 0xd7, 0xd9, 0x71, 0x11, 0x95,
+	0xd3, 0xb2, 0x19, 0x95, 0x0d, 0x9f, 0x26, 0x1f, 0x28, 0x68, 0x38, 0xad, 0xc4, 0x95, 0xdf, 0x17,
+	0xd9, 0x20, 0x8a, 0x1e, 0x27, 0xe1, 0xf4, 0x7d, 0x00, 0x2c, 0x5a, 0x12, 0x32, 0x76, 0x65, 0xb4,
+	0xc9, 0x12, 0x75, 0x2c, 0xd5, 0x24, 0x69, 0x9c, 0x4b, 0x9b, 0x4e, 0x37, 0xb0, 0x42, 0x94, 0xcc,
+	0x24, 0x38, 0x9d, 0x20, 0xdb, 0x65, 0xa8, 0x85, 0x56, 0xd0, 0x45, 0x61, 0x9b, 0x2b, 0x28, 0x1e,
+	0x72, 0x62, 0x40, 0x1a, 0x63, 0x5a, 0x37, 0xfe, 0x4a, 0x83, 0xe5, 0x34, 0x4d, 0x93, 0xf0, 0x2a,
+	0x4b, 0x1d, 0x3e, 0xaf, 0xa4, 0x06, 0xe3, 0x5b, 0x05, 0x68, 0x91, 0xbc, 0xa1, 0xa4, 0xc9, 0x79,
+	0xca, 0xee, 0xfe, 0x9d, 0xa4, 0xbf, 0x30, 0x7a, 0xf2, 0x09, 0x3d, 0x89, 0xd0, 0xdf, 0x65, 0xa8,
+	0xf1, 0xd3, 0xbb, 0xb6, 0xb5, 0x1b, 0xa2, 0x80, 0xae, 0x94, 0x92, 0x59, 0xe5, 0xc0, 0x55, 0x02,
+	0x93, 0x5c, 0xcc, 0x29, 0xb5, 0x8b, 0x39, 0x2d, 0xbb, 0x98, 0xff, 0x5a, 0x00, 0x3d, 0xd9, 0x23,
+	0x75, 0x94, 0xb2, 0x2c, 0x43, 0x12, 0x03, 0x70, 0xba, 0x9e, 0xe5, 0x46, 0xe3, 0x8b, 0xca, 0xb9,
+	0x62, 0xb1, 0xd1, 0xf8, 0x4b, 0x27, 0x19, 0xff, 0x05, 0xa8, 0xb0, 0xa1, 0x32, 0x13, 0x7d, 0x8a,
+	0x99, 0xc7, 0x0c, 0x44, 0x6d, 0xf4, 0xd7, 0x61, 0x0e, 0xb9, 0x56, 0x1f, 0x23, 0x3b, 0x32, 0xd0,
+	0xd9, 0x68, 0xeb, 0x1c, 0x2c, 0xcc, 0x73, 0x12, 0xaf, 0x60, 0x36, 0x6c, 0xe4, 0x0a, 0x33, 0xcf,
+	0xbb, 0x46, 0xed, 0xd8, 0x28, 0x57, 0x65, 0x05, 0x96, 0x10, 0x0e, 0x9d, 0x1e, 0xe5, 0xb9, 0x3f,
+	0x08, 0xfb, 0x83, 0x90, 0xc5, 0xde, 0xcb, 0x14, 0x7b, 0x21, 0xfa, 0xf8, 0x98, 0x7e, 0xa3, 0x21,
+	0xf8, 0x9f, 0x68, 0x70, 0x56, 0x29, 0x58, 0x93, 0x05, 0xea, 0xa6, 0xc8, 0x14, 0x08, 0xad, 0xf1,
+	0xda, 0x58, 0xc6, 0x31, 0xff, 0x95, 0xd6, 0x19, 0xef, 0xb5, 0x7f, 0x08, 0xe7, 0x4d, 0xd4, 0x71,
+	0x2d, 0xa7, 0x77, 0xdf, 0x72, 0x5c, 0x64, 0xcb, 0x9e, 0xc2, 0x49, 0x97, 0x43, 0x2c, 0x42, 0x05,
+	0x59, 0x84, 0xc8, 0xe1, 0x8f, 0xbe, 0xe5, 0x78, 0x9f, 0x4c, 0x78, 0x2d, 0xb9, 0xb7, 0x15, 0x87,
+	0xf6, 0xb6, 0xef, 0x69, 0xb0, 0xf8, 0xd4, 0xeb, 0xff, 0xac, 0x90, 0xb3, 0x06, 0x73, 0x34, 0x6a,
+	0xb2, 0xea, 0x9e, 0x5c, 0xa3, 0x1b, 0x5d, 0x68, 0xc4, 0x8d, 0x9c, 0xa6, 0x61, 0xf0, 0x25, 0x78,
+	0x85, 0xc8, 0xf9, 0xa6, 0xe5, 0x59, 0x5d, 0x22, 0x33, 0x62, 0xa0, 0x27, 0x67, 0xa2, 0xb1, 0x03,
+	0xf3, 0x72, 0x90, 0x6d, 0x8d, 0xe6, 0xc5, 0x47, 0xb9, 0x29, 0xda, 0x31, 0x73, 0x53, 0xa2, 0x34,
+	0x7b, 0x36, 0x17, 0xac, 0x60, 0xfc, 0x6d, 0x01, 0x9a, 0x43, 0x34, 0x6f, 0x0f, 0x7a, 0x3d, 0x2b,
+	0x38, 0xca, 0xe5, 0xcc, 0xbc, 0x1f, 0x45, 0x1f, 0xda, 0xb4, 0x45, 0xb1, 0x28, 0x5f, 0x1d, 0x93,
+	0x7c, 0x4c, 0x47, 0x43, 0x1c, 0x12, 0x0a, 0xa2, 0xa5, 0xf1, 0x47, 0x16, 0xaf, 0x41, 0x3d, 0xd6,
+	0x40, 0x54, 0xf5, 0x30, 0x33, 0xbe, 0x16, 0x41, 0x89, 0xd2, 0xd1, 0xef, 0x40, 0xcb, 0x77, 0x6d,
+	0x6a, 0x34, 0x8a, 0x84, 0xbb, 0x76, 0x6c, 0xf9, 0x33, 0x4d, 0xd9, 0x64, 0x18, 0x4f, 0x05, 0xc2,
+	0x13, 0xf1, 0x9d, 0xc4, 0x30, 0xe3, 0x4c, 0x8f, 0x76, 0xdf, 0x1a, 0x60, 0x64, 0x53, 0xcd, 0x59,
+	0x36, 0x1b, 0xf1, 0x87, 0x2d, 0x0a, 0x27, 0xce, 0xcd, 0xf9, 0xac, 0x79, 0x9f, 0x44, 0xdc, 0x36,
+	0xa1, 0x12, 0xb3, 0x79, 0x54, 0x44, 0x27, 0x6b, 0xf2, 0x4c, 0xb9, 0x3e, 0xd1, 0x33, 0x4d, 0x6e,
+	0x90, 0xdc, 0x0b, 0x3b, 0xf6, 0x56, 0x80, 0x76, 0x9d, 0xc3, 0x93, 0x2f, 0xef, 0x57, 0x00, 0x7c,
+	0xd7, 0x6e, 0xf7, 0x69, 0x33, 0xdc, 0x4a, 0x9a, 0xf5, 0x5d, 0xde, 0x2e, 0xf9, 0xec, 0xa1, 0x67,
+	0xe2, 0x33, 0xb3, 0x6d, 0x67, 0x3d, 0xf4, 0x8c, 0x7d, 0x36, 0x06, 0xf0, 0xb2, 0x82, 0x96, 0x49,
+	0xb8, 0x75, 0x19, 0x6a, 0x3d, 0xd6, 0xa2, 0xdd, 0xde, 0x47, 0x47, 0x22, 0x32, 0x59, 0x15, 0xc0,
+	0xf7, 0xd1, 0x11, 0x26, 0x46, 0xd9, 0x39, 0x13, 0x75, 0x1d, 0x1c, 0xa2, 0x40, 0x9c, 0x07, 0x7e,
+	0x69, 0xe0, 0x87, 0xd6, 0x44, 0x6a, 0x5d, 0x69, 0x97, 0x51, 0xbf, 0xe5, 0x30, 0xde, 0x4e, 0x79,
+	0x90, 0xbd, 0x67, 0x1d, 0x46, 0x9b, 0x29, 0x47, 0x89, 0x0e, 0x9c, 0x4a, 0x11, 0x8a, 0xf0, 0xe4,
+	0x0d, 0x04, 0x0b, 0xdb, 0xa1, 0x1f, 0x58, 0x5d, 0xb4, 0x3a, 0xb0, 0x9d, 0x70, 0x22, 0x32, 0x03,
+	0xd4, 0xf3, 0x0f, 0x98, 0xa5, 0x55, 0x36, 0x79, 0xe9, 0x8b, 0xa5, 0x72, 0xa1, 0x51, 0x34, 0x3e,
+	0x0d, 0x35, 0xde, 0xcd, 0xe3, 0x9d, 0x0f, 0x51, 0x27, 0x54, 0x04, 0x00, 0x74, 0x28, 0xd1, 0xd5,
+	0xc6, 0x93, 0x30, 0xc9, 0x6f, 0xe3, 0x47, 0x05, 0xd0, 0x93, 0xe4, 0x11, 0x2f, 0x8c, 0x58, 0x1d,
+	0xb8, 0x43, 0x06, 0x60, 0xb7, 0x7d, 0xda, 0x1c, 0xe6, 0x6a, 0xa3, 0xce, 0xc1, 0xac, 0x13, 0x12,
+	0x2d, 0x9e, 0xf1, 0x83, 0xfe, 0x5e, 0xbc, 0x8d, 0xab, 0x0e, 0x54, 0x13, 0x84, 0x99, 0xa2, 0x02,
+	0xc9, 0xcc, 0x60, 0x3f, 0xa5, 0x5e, 0x18, 0x8f, 0xe7, 0x04, 0x5c, 0x74, 0x73, 0x19, 0x6a, 0x11,
+	0xaa, 0xa4, 0x31, 0xaa, 0x02, 0x48, 0x15, 0xc6, 0xeb, 0x30, 0xc7, 0x58, 0x12, 0x37, 0xc7, 0xec,
+	0xc5, 0x3a, 0x07, 0x8b, 0xd6, 0x2e, 0x41, 0x55, 0x20, 0xd2, 0xc6, 0x98, 0x41, 0x55, 0xe1, 0x30,
+	0x6a, 0xf1, 0x7c, 0x57, 0x83, 0xc5, 0x24, 0x5f, 0x26, 0x91, 0xec, 0xf7, 0xc8, 0xd4, 0x11, 0xc6,
+	0xaa, 0x33, 0x3c, 0x65, 0x26, 0x49, 0xb3, 0x60, 0xf2, 0x4a, 0xc6, 0x7f, 0x10, 0x62, 0x2c, 0x72,
+	0xea, 0xc0, 0x05, 0xef, 0xb4, 0x32, 0xa9, 0x2e, 0x40, 0x05, 0xd3, 0x7e, 0xda, 0x81, 0xb0, 0xe8,
+	0x35, 0x13, 0x18, 0xc8, 0x24, 0xdb, 0x8f, 0x14, 0xac, 0x2d, 0x25, 0x83, 0xb5, 0x6b, 0x50, 0xa3,
+	0x71, 0xc2, 0xb6, 0x38, 0x3f, 0x9d, 0x3a, 0x7e, 0x00, 0xdf, 0xf8, 0x5e, 0x01, 0x1a, 0xf4, 0x2b,
+	0x1f, 0x2d, 0xcd, 0x4f, 0xcf, 0x0e, 0x48, 0xbe, 0x03, 0xb3, 0xf4, 0xd6, 0x25, 0x0d, 0x4b, 0xb3,
+	0xbc, 0x83, 0x57, 0x94, 0xb9, 0xb3, 0x44, 0x51, 0xd0, 0x20, 0x52, 0xd9, 0xe6, 0xbf, 0xc8, 0xf2,
+	0xe8, 0x39, 0x1e, 0x1f, 0x22, 0xf9, 0x49, 0x21, 0xd6, 0x61, 0xb3, 0xc4, 0x21, 0x16, 0xd3, 0x80,
+	0x03, 0xd7, 0x65, 0x5b, 0x62, 0x9c, 0x60, 0xea, 0xba, 0x6c, 0x13, 0x3f, 0x0b, 0xb3, 0x9e, 0xe5,
+	0xf1, 0xaf, 0x4c, 0x86, 0xca, 0x9e, 0xe5, 0x45, 0x1f, 0x1d, 0x6f, 0x97, 0x7f, 0x64, 0x86, 0x78,
+	0xd9, 0xf1, 0x76, 0xd9, 0xc7, 0xd7, 0xa0, 0x6e, 0x3b, 0x38, 0x74, 0xbc, 0x0e, 0xdf, 0x6f, 0xb9,
+	0xf1, 0x5d, 0x13, 0x50, 0x8a, 0x66, 0xfc, 0xb7, 0x06, 0x4b, 0xa9, 0x79, 0x9f, 0x44, 0x0a, 0x47,
+	0xcf, 0xfd, 0xcb, 0x50, 0x26, 0xbb, 0xb6, 0xb4, 0x65, 0xcf, 0x78, 0x83, 0x1e, 0xdd, 0xb0, 0x2f,
+	0x41, 0x95, 0xc9, 0x80, 0xcd, 0x3e, 0x73, 0x2d, 0xc7, 0x61, 0x14, 0x65, 0x1d, 0x2a, 0x6c, 0xfa,
+	0xd9, 0x1d, 0x84, 0xa9, 0xcc, 0xab, 0x4b, 0xe9, 0xe9, 0x35, 0x81, 0xd6, 0xa3, 0xbf, 0x0d, 0x8f,
+	0x5d, 0x29, 0x62, 0x2b, 0xe1, 0x29, 0xb6, 0xba, 0xe8, 0x54, 0x8d, 0x57, 0xe3, 0xab, 0x30, 0x47,
+	0xb2, 0x8c, 0xa4, 0xfe, 0x08, 0x1b, 0x48, 0x84, 0x9b, 0x8a, 0x14, 0xcf, 0x2b, 0x71, 0xfd, 0x2e,
+	0x15, 0x19, 0xce, 0x21, 0x7e, 0x38, 0x23, 0x38, 0x44, 0xe3, 0xfb, 0x42, 0xb5, 0x16, 0x25, 0xd5,
+	0x7a, 0x04, 0xf3, 0x6c, 0xb0, 0x72, 0xf3, 0xd9, 0xc2, 0xfc, 0xff, 0xa1, 0x24, 0x1d, 0xfb, 0x18,
+	0x0a, 0xd6, 0xa5, 0x48, 0x35, 0x4b, 0x6e, 0x56, 0xd7, 0x3f, 0xd0, 0x60, 0x59, 0xbe, 0x6b, 0x23,
+	0x11, 0x90, 0xc7, 0x1a, 0xbc, 0x03, 0xd3, 0x94, 0xaa, 0x51, 0x56, 0xe0, 0xd0, 0xd0, 0x4c, 0x5e,
+	0x47, 0x49, 0xd0, 0x4f, 0x59, 0x96, 0x47, 0x72, 0x66, 0x27, 0x91, 0xe5, 0xf7, 0x55, 0x96, 0xd5,
+	0x35, 0xa5, 0x0b, 0xa9, 0x62, 0x43, 0xc2, 0xae, 0x22, 0xeb, 0x3c, 0xf4, 0x43, 0xcb, 0x6d, 0x4b,
+	0x74, 0xcf, 0x52, 0x08, 0xdd, 0x0b, 0x3a, 0x70, 0x66, 0xcd, 0xf2, 0x3a, 0xc8, 0x3d, 0x4d, 0x1f,
+	0xf2, 0xc7, 0x1a, 0x34, 0x87, 0x7b, 0x99, 0x84, 0x45, 0x77, 0x92, 0x19, 0x59, 0xc7, 0x0c, 0x4c,
+	0x24, 0x94, 0x45, 0x31, 0x1d, 0x4e, 0xfc, 0x18, 0x66, 0x1e, 0xac, 0xb1, 0x73, 0x80, 0x44, 0x3c,
+	0x5e, 0x4b, 0xc5, 0xe3, 0xc9, 0x8e, 0xc2, 0xf6, 0xe2, 0xc4, 0x99, 0x11, 0x03, 0xd1, 0x1c, 0x40,
+	0x72, 0x44, 0xe9, 0x7c, 0x84, 0xda, 0x3b, 0x47, 0x21, 0x8a, 0x7c, 0x05, 0x02, 0xb9, 0x4b, 0x00,
+	0x52, 0x70, 0xb5, 0x24, 0x07, 0x57, 0x8d, 0xdf, 0xd1, 0x40, 0x7f, 0x80, 0x42, 0x4e, 0x04, 0x9e,
+	0xc8, 0x08, 0x96, 0x8e, 0x48, 0x85, 0x56, 0x8c, 0x8e, 0x48, 0x5f, 0x86, 0x32, 0xb9, 0x5b, 0x1a,
+	0x9d, 0x9f, 0x16, 0xcd, 0x19, 0xe4, 0x51, 0x37, 0x23, 0x93, 0xb4, 0x5f, 0x81, 0x85, 0x04, 0x65,
+	0x93, 0xcc, 0xe1, 0x4a, 0x2a, 0x7c, 0xdf, 0x52, 0x4c, 0xe2, 0x83, 0xb5, 0x64, 0xe4, 0xfe, 0xef,
+	0x35, 0x78, 0x99, 0x19, 0x10, 0x7c, 0xd7, 0xb8, 0x17, 0x04, 0x7e, 0xf0, 0x22, 0x93, 0xaf, 0xb3,
+	0xad, 0x86, 0x98, 0x87, 0x53, 0x09, 0x1e, 0xfe, 0x8d, 0x06, 0xe7, 0xb6, 0xe5, 0xfb, 0x82, 0x5b,
+	0x81, 0xdf, 0x47, 0x41, 0x78, 0x74, 0xba, 0xc1, 0x8c, 0x55, 0x80, 0x3e, 0xeb, 0xc8, 0x41, 0x19,
+	0x19, 0x60, 0xaa, 0x8b, 0x74, 0x52, 0x25, 0xe3, 0xb7, 0x35, 0x38, 0x47, 0x96, 0xd5, 0x20, 0x14,
+	0x9b, 0xf6, 0xe3, 0x03, 0x14, 0xb8, 0x56, 0xff, 0x45, 0xa7, 0x82, 0x6d, 0xc2, 0x7c, 0x8a, 0x20,
+	0xff, 0xd9, 0x98, 0x9c, 0x8c, 0x16, 0x94, 0x7d, 0x86, 0xcb, 0xe4, 0x4f, 0x33, 0xa3, 0xb2, 0xf1,
+	0x14, 0xea, 0xdb, 0x83, 0x6e, 0x17, 0x61, 0x92, 0x08, 0x83, 0x82, 0x6e, 0xfa, 0xc2, 0xb0, 0x36,
+	0x74, 0x57, 0x8b, 0xd8, 0xf0, 0xac, 0x36, 0xb1, 0x2e, 0x1d, 0x9f, 0x9f, 0xa2, 0x54, 0x39, 0xd0,
+	0x24, 0x30, 0xe3, 0xdf, 0x0b, 0x50, 0x8b, 0x18, 0x46, 0x5d, 0x91, 0x9c, 0x17, 0x07, 0xe5, 0xd1,
+	0x17, 0x86, 0x46, 0x3f, 0x2e, 0x4c, 0x45, 0x02, 0x20, 0x82, 0xb8, 0x9e, 0x15, 0x06, 0xce, 0x61,
+	0xb3, 0x94, 0xb9, 0xf5, 0x0d, 0xb1, 0xd1, 0x14, 0x03, 0xdb, 0xa4, 0x55, 0x87, 0x47, 0x3a, 0x35,
+	0x3c, 0x52, 0xfd, 0x21, 0x34, 0xb0, 0x60, 0x60, 0xbb, 0x47, 0x38, 0x28, 0xce, 0xf1, 0x95, 0x39,
+	0x87, 0x09, 0x5e, 0x9b, 0x73, 0x38, 0x51, 0xc6, 0xfa, 0xa7, 0x40, 0xc7, 0xfb, 0x0e, 0xbd, 0xc6,
+	0x22, 0x8d, 0x73, 0x86, 0x8e, 0x73, 0x9e, 0x7f, 0x91, 0xee, 0xc3, 0xfd, 0x40, 0x83, 0x57, 0x32,
+	0xa4, 0x74, 0x12, 0x75, 0xf5, 0x76, 0xca, 0xcf, 0x51, 0x39, 0x83, 0x89, 0xd9, 0x8d, 0x5c, 0x9c,
+	0xbf, 0x60, 0x06, 0x82, 0xb4, 0xf7, 0x3d, 0xde, 0x38, 0xdd, 0x15, 0x33, 0x9c, 0x1b, 0x93, 0xa9,
+	0xf8, 0x4b, 0x09, 0xc5, 0x6f, 0xfc, 0x6a, 0x01, 0x9a, 0xc3, 0xb4, 0x4e, 0xc2, 0xb7, 0x57, 0xa1,
+	0xce, 0x0c, 0x10, 0xba, 0x0b, 0xb6, 0x1d, 0x91, 0xb8, 0x5c, 0xa5, 0x50, 0xba, 0x13, 0x6e, 0x90,
+	0x2b, 0x4d, 0x73, 0x32, 0x96, 0x3f, 0x08, 0x39, 0xd9, 0xb5, 0x18, 0xed, 0xf1, 0x80, 0xba, 0x1e,
+	0x81, 0xef, 0x70, 0xd1, 0x63, 0xee, 0x4c, 0x39, 0xf0, 0x1d, 0x26, 0x76, 0x24, 0xcb, 0xd2, 0x8d,
+	0xbc, 0x16, 0xee, 0xd3, 0x10, 0x08, 0xf3, 0x4c, 0xae, 0x41, 0xc3, 0x3a, 0x40, 0xc4, 0x4e, 0x6a,
+	0xdb, 0x03, 0xda, 0x82, 0xc7, 0x5d, 0x9b, 0x39, 0x0e, 0x5f, 0xe7, 0x60, 0xe3, 0x1f, 0x35, 0x58,
+	0xbe, 0x1f, 0x20, 0xf4, 0x11, 0x8a, 0xae, 0x25, 0xbf, 0xe8, 0x9c, 0xc7, 0x15, 0x58, 0xb2, 0x06,
+	0xa1, 0x4f, 0x02, 0x86, 0x94, 0xb0, 0x44, 0x4e, 0x53, 0xd1, 0x5c, 0x20, 0x1f, 0x9f, 0xf2, 0x6f,
+	0xfc, 0xdc, 0xc4, 0xf8, 0x2d, 0x0d, 0x9a, 0x02, 0xf6, 0xb3, 0x32, 0x10, 0xa3, 0x2b, 0xbf, 0xe3,
+	0x40, 0xec, 0xa4, 0xd3, 0x3a, 0x17, 0xfe, 0x7e, 0x09, 0x96, 0xd3, 0x3d, 0x4d, 0x22, 0xc9, 0xab,
+	0x50, 0xe5, 0x99, 0x52, 0xf2, 0x53, 0x07, 0xe3, 0xa2, 0x00, 0x3c, 0xbb, 0x2a, 0xba, 0x5c, 0x45,
+	0x1a, 0xc3, 0xbc, 0x85, 0x62, 0xce, 0x2b, 0x75, 0xa4, 0x0a, 0x6b, 0xe0, 0x02, 0x54, 0xd8, 0xb5,
+	0x92, 0x7e, 0x74, 0xcb, 0x6b, 0xd6, 0x04, 0x0a, 0x62, 0x08, 0x2d, 0xba, 0xb6, 0xfb, 0xbe, 0xc3,
+	0x57, 0xc0, 0xac, 0x19, 0x95, 0x49, 0xe5, 0x9d, 0x41, 0x67, 0x1f, 0x85, 0xec, 0xec, 0x78, 0x9a,
+	0x27, 0x39, 0x51, 0x10, 0x3d, 0x3a, 0x3e, 0x03, 0x33, 0x03, 0x8c, 0xda, 0x18, 0xbb, 0xfc, 0xa2,
+	0xd5, 0xf4, 0x00, 0xa3, 0x6d, 0xec, 0x92, 0x6b, 0xa1, 0x56, 0xa7, 0x83, 0x30, 0x66, 0xd9, 0xc2,
+	0xed, 0x30, 0x74, 0xb9, 0x5b, 0x5f, 0x67, 0x70, 0x9a, 0x2f, 0xfc, 0x24, 0x74, 0xf5, 0xaf, 0x40,
+	0x85, 0x1c, 0x31, 0x22, 0x9b, 0xa4, 0x43, 0x88, 0x1b, 0x54, 0x9f, 0x55, 0x99, 0x76, 0xca, 0x99,
+	0xb9, 0xb1, 0x4d, 0x2b, 0x3f, 0x0d, 0x5c, 0x9e, 0x10, 0x04, 0x38, 0x02, 0xb4, 0xde, 0x83, 0xb9,
+	0xd4, 0x67, 0x45, 0x24, 0x70, 0x51, 0x4e, 0x05, 0x9a, 0x95, 0xd3, 0x7c, 0xfe, 0x84, 0x3d, 0xd4,
+	0xc0, 0x7b, 0xc5, 0xf7, 0xfd, 0x20, 0xb6, 0xc1, 0x4e, 0x77, 0x51, 0xc4, 0x87, 0xbc, 0x45, 0xf5,
+	0x21, 0xaf, 0x9c, 0x2c, 0x4e, 0x92, 0x72, 0xe7, 0x04, 0x91, 0x77, 0x8f, 0xa8, 0xe7, 0x72, 0xf2,
+	0x43, 0x95, 0x09, 0xd2, 0x87, 0x49, 0x86, 0xfd, 0xc5, 0x6c, 0x86, 0x4d, 0xb2, 0x94, 0x1e, 0x45,
+	0xaf, 0x3e, 0xe1, 0xf6, 0xce, 0x51, 0x5b, 0xf8, 0x72, 0x59, 0xd1, 0x81, 0x14, 0x37, 0xcc, 0x39,
+	0x9c, 0x62, 0xcf, 0xd8, 0x23, 0xd3, 0xbf, 0x2b, 0xc0, 0x59, 0xb6, 0x2d, 0x8b, 0xc0, 0xfa, 0x17,
+	0x90, 0xe5, 0x86, 0x7b, 0xcf, 0x3f, 0xb2, 0xbe, 0x07, 0x75, 0x91, 0xa1, 0x81, 0x88, 0x73, 0x22,
+	0x56, 0xf9, 0xaa, 0x62, 0x5c, 0x23, 0x28, 0x8a, 0x32, 0x97, 0x68, 0x1b, 0x3c, 0x39, 0xae, 0x23,
+	0xc3, 0xc8, 0x7e, 0xb6, 0x47, 0xab, 0x1c, 0xc9, 0x41, 0x7a, 0xa2, 0x10, 0xe6, 0x38, 0x9c, 0xb7,
+	0x81, 0x5b, 0x3f, 0x07, 0xfa, 0x70, 0x7b, 0xc7, 0x5a, 0x3c, 0x98, 0xde, 0x04, 0xe0, 0x13, 0xf1,
+	0xd0, 0xf1, 0x10, 0xd9, 0x2e, 0x1f, 0x3f, 0x39, 0xdd, 0x18, 0x16, 0x82, 0x73, 0xea, 0x4e, 0x27,
+	0x91, 0xbd, 0x06, 0x14, 0x6d, 0x3f, 0xe4, 0x23, 0x24, 0x3f, 0x8d, 0xdf, 0xd5, 0x40, 0x37, 0x91,
+	0x65, 0x9f, 0x72, 0x04, 0x5a, 0x7e, 0x3f, 0xa7, 0x98, 0x7a, 0x3f, 0xe7, 0x65, 0x28, 0xf3, 0x7b,
+	0xf9, 0x62, 0x43, 0x9f, 0x61, 0x97, 0xf2, 0xb1, 0xf1, 0xa7, 0x1a, 0x2c, 0x24, 0xa8, 0x9b, 0x64,
+	0xf0, 0x9f, 0xe7, 0xb1, 0x4c, 0xdc, 0x26, 0x02, 0xa8, 0xd6, 0x08, 0x3c, 0xb0, 0x4c, 0xb7, 0x20,
+	0x22, 0x9b, 0x3c, 0x8c, 0x89, 0xc9, 0xef, 0x11, 0xa1, 0x54, 0x72, 0xae, 0xb0, 0xb4, 0xee, 0xe0,
+	0x8e, 0x15, 0x9c, 0x36, 0x27, 0xd3, 0x59, 0x50, 0xc5, 0xe1, 0x7c, 0xc3, 0x5f, 0x67, 0x6f, 0xe0,
+	0x88, 0xfb, 0x6e, 0xb1, 0x66, 0xc4, 0xa7, 0x9a, 0x7c, 0xa5, 0x43, 0x29, 0xf4, 0xfb, 0x8f, 0x44,
+	0x80, 0x90, 0xfc, 0x26, 0xf6, 0xbf, 0x48, 0x48, 0x4e, 0xa5, 0x8a, 0x8d, 0xf1, 0x51, 0xc7, 0xbb,
+	0x7e, 0x23, 0x02, 0xdb, 0x51, 0x42, 0x5f, 0x49, 0x4e, 0xe8, 0x4b, 0xa6, 0x01, 0x4e, 0xa5, 0xd3,
+	0x00, 0x8d, 0x9f, 0x16, 0x59, 0x2e, 0x9d, 0x8a, 0x6d, 0x93, 0x79, 0x01, 0xcc, 0x90, 0xdf, 0x8e,
+	0xf7, 0xa2, 0xd8, 0xba, 0x17, 0x40, 0xfd, 0xea, 0xf0, 0x5b, 0x33, 0xfc, 0xd0, 0x2c, 0x05, 0xd6,
+	0xdf, 0x86, 0x33, 0xf1, 0x49, 0xf7, 0x3d, 0x9e, 0x7a, 0x48, 0xcd, 0x7c, 0xbe, 0x7c, 0xb2, 0x3e,
+	0x13, 0x96, 0xd3, 0x4e, 0x4d, 0xe9, 0x61, 0x8d, 0x08, 0x40, 0xf8, 0x13, 0x3b, 0x1c, 0xdc, 0x3b,
+	0x90, 0x20, 0xfa, 0x3b, 0xc0, 0x8f, 0xe5, 0x45, 0xa3, 0x9c, 0xa2, 0xd5, 0x2e, 0xe2, 0x27, 0x21,
+	0x99, 0xdf, 0xf5, 0x36, 0x2c, 0x13, 0x79, 0x68, 0x8b, 0x4c, 0xc9, 0xf8, 0xf4, 0xb5, 0x9c, 0x19,
+	0xe2, 0x55, 0xcb, 0x8d, 0xb9, 0x48, 0x1a, 0x4a, 0x75, 0x81, 0x8d, 0x6f, 0x6b, 0xb0, 0xc4, 0x6f,
+	0x6d, 0x9f, 0xf2, 0x02, 0x1c, 0x7d, 0xa1, 0xf8, 0x87, 0xcc, 0xe1, 0xa5, 0x69, 0x2d, 0x5b, 0x81,
+	0xdf, 0x0d, 0x10, 0x7e, 0xc1, 0x99, 0x3a, 0xff, 0xa0, 0x45, 0x6f, 0x46, 0x24, 0xa8, 0x3a, 0xad,
+	0x17, 0x00, 0x47, 0xac, 0xcb, 0x16, 0x94, 0xfb, 0xbc, 0x77, 0xe1, 0xc0, 0xf6, 0x25, 0x6a, 0xb8,
+	0xd8, 0x22, 0x9b, 0x5f, 0x4a, 0x8b, 0x01, 0xc6, 0x5f, 0x6a, 0xb0, 0x94, 0xe2, 0xe9, 0x84, 0x17,
+	0x04, 0x23, 0x42, 0x0a, 0x29, 0x42, 0xd6, 0x24, 0xab, 0xb1, 0x38, 0xee, 0xf1, 0x86, 0x24, 0x4d,
+	0xb1, 0xf9, 0x48, 0x52, 0xc7, 0x58, 0xd8, 0x7f, 0xc2, 0xe7, 0x5d, 0x9f, 0x87, 0x04, 0x7c, 0x08,
+	0x0b, 0x09, 0x5a, 0x4e, 0x33, 0xd3, 0x6a, 0x07, 0x96, 0x69, 0xf2, 0xcd, 0x73, 0x3a, 0x53, 0xe1,
+	0x51, 0xe4, 0x42, 0x22, 0x8a, 0xfc, 0x3e, 0x9c, 0x31, 0x11, 0x1e, 0xf4, 0x9e, 0x47, 0x27, 0xd7,
+	0x6f, 0xc3, 0xfc, 0xd0, 0xbd, 0x37, 0xbd, 0x0e, 0xf0, 0xd4, 0xeb, 0xf0, 0x0b, 0x81, 0x8d, 0x97,
+	0xf4, 0x2a, 0x94, 0xc5, 0xf5, 0xc0, 0x86, 0x76, 0x7d, 0x5b, 0xbe, 0xfd, 0x45, 0x8f, 0x10, 0xcf,
+	0xc0, 0xc2, 0x53, 0xcf, 0x46, 0xbb, 0x8e, 0x27, 0x67, 0x24, 0x36, 0x5e, 0xd2, 0x17, 0x60, 0x6e,
+	0xc3, 0xf3, 0x50, 0x20, 0x01, 0x35, 0x02, 0xa4, 0xe1, 0x3d, 0x09, 0x58, 0xb8, 0xfe, 0x6e, 0x74,
+	0x09, 0x30, 0xba, 0x1b, 0xa1, 0xeb, 0x50, 0x97, 0x69, 0x43, 0x36, 0x6b, 0x91, 0xc3, 0x4c, 0xe4,
+	0x22, 0x0b, 0x23, 0xbb, 0xa1, 0x5d, 0xff, 0x91, 0x06, 0x0b, 0x8a, 0x43, 0x1f, 0x7d, 0x1e, 0x6a,
+	0xab, 0xae, 0x1b, 0x95, 0x71, 0xe3, 0x25, 0x02, 0x22, 0xe5, 0x7b, 0x87, 0xa8, 0x33, 0x08, 0x1d,
+	0xaf, 0xdb, 0xd0, 0x04, 0x48, 0x8c, 0xd0, 0x6e, 0x14, 0xf4, 0x39, 0xa8, 0x10, 0xd0, 0x13, 0x76,
+	0x59, 0xac, 0x51, 0x24, 0x1c, 0x21, 0x00, 0x96, 0x74, 0xd9, 0x28, 0x89, 0x3a, 0x3c, 0x17, 0x13,
+	0xd9, 0x8d, 0xa9, 0xa8, 0x19, 0x2a, 0x6a, 0x04, 0x6b, 0x7a, 0xe5, 0x7f, 0x6e, 0xc0, 0x2c, 0x31,
+	0x9d, 0xd6, 0x7c, 0x3f, 0xb0, 0xf5, 0x3e, 0x3d, 0xdb, 0x21, 0xdd, 0xf8, 0x9e, 0xd0, 0x1a, 0x58,
+	0xbf, 0x95, 0x91, 0x0f, 0x3d, 0x8c, 0xca, 0x27, 0xb9, 0x75, 0x25, 0xa3, 0x46, 0x0a, 0xdd, 0x78,
+	0x49, 0xef, 0xd1, 0x1e, 0xc9, 0x28, 0x9e, 0x38, 0x9d, 0x7d, 0xce, 0xb7, 0x51, 0x3d, 0xa6, 0x50,
+	0x45, 0x8f, 0xa9, 0x13, 0x6f, 0x5e, 0x60, 0x2f, 0x3a, 0x8a, 0xf5, 0x64, 0xbc, 0xa4, 0x7f, 0x1d,
+	0x16, 0xe9, 0x69, 0xa8, 0x78, 0xc4, 0x4f, 0x74, 0xb8, 0x92, 0xdd, 0xe1, 0x10, 0xf2, 0x31, 0xbb,
+	0x7c, 0x08, 0x53, 0x74, 0x55, 0xeb, 0xaa, 0x1b, 0x20, 0xb2, 0xee, 0x69, 0x5d, 0xcc, 0x46, 0x88,
+	0x5a, 0xfb, 0x10, 0xe6, 0x52, 0x4f, 0xe7, 0xea, 0xaa, 0x9d, 0x59, 0xfd, 0x08, 0x72, 0xeb, 0x7a,
+	0x1e, 0xd4, 0xa8, 0xaf, 0x2e, 0xd4, 0x93, 0x4f, 0x0d, 0xea, 0x57, 0x47, 0x86, 0x4a, 0xa4, 0x7b,
+	0xf7, 0xad, 0x6b, 0x39, 0x30, 0xa3, 0x8e, 0x7a, 0xd0, 0x48, 0x3f, 0xe5, 0xaa, 0x5f, 0x1f, 0xd9,
+	0x40, 0x52, 0xdc, 0xde, 0xc8, 0x85, 0x1b, 0x75, 0x77, 0x04, 0x8b, 0xaa, 0xa7, 0x44, 0xf5, 0x1b,
+	0xea, 0x66, 0xb2, 0xde, 0x38, 0x6d, 0xdd, 0xcc, 0x8d, 0x1f, 0x75, 0xfd, 0x4d, 0x11, 0x6d, 0x1f,
+	0x7e, 0x8e, 0x53, 0xbf, 0xad, 0x6e, 0x6e, 0xc4, 0x3b, 0xa2, 0xad, 0x95, 0xe3, 0x54, 0x89, 0x88,
+	0xf8, 0x98, 0x46, 0x1e, 0x15, 0x4f, 0x5a, 0xea, 0xb7, 0xd4, 0xed, 0x65, 0xbf, 0xd5, 0xd9, 0xba,
+	0x7d, 0x8c, 0x1a, 0x11, 0x01, 0x7e, 0xfa, 0xb1, 0x5c, 0xb1, 0x0c, 0x6f, 0x8e, 0x95, 0x9a, 0x93,
+	0xad, 0xc1, 0xaf, 0xc2, 0x5c, 0xea, 0x49, 0x2c, 0xe5, 0xaa, 0x51, 0x3f, 0x9b, 0xd5, 0x1a, 0xb5,
+	0xed, 0xb2, 0x25, 0x99, 0x7a, 0x7c, 0x42, 0xcf, 0x90, 0x7e, 0xc5, 0x03, 0x15, 0xad, 0xeb, 0x79,
+	0x50, 0xa3, 0x81, 0x60, 0xaa, 0x2e, 0x53, 0x97, 0xf8, 0xf5, 0x37, 0xd5, 0x6d, 0xa8, 0x1f, 0x9f,
+	0x68, 0x7d, 0x2a, 0x27, 0x76, 0xd4, 0x69, 0x1b, 0xe0, 0x01, 0x0a, 0x37, 0x51, 0x18, 0x10, 0x19,
+	0xb9, 0xa2, 0x64, 0x79, 0x8c, 0x20, 0xba, 0x79, 0x7d, 0x2c, 0x5e, 0xd4, 0xc1, 0xcf, 0x83, 0x2e,
+	0xb6, 0x36, 0xe9, 0x21, 0xb9, 0xcb, 0x23, 0xf3, 0x26, 0xd8, 0xb5, 0xe3, 0x71, 0x73, 0xf3, 0x75,
+	0x68, 0x6c, 0x5a, 0xde, 0xc0, 0x92, 0x72, 0x3b, 0xd2, 0xdc, 0xe2, 0x85, 0x34, 0x5a, 0x06, 0xb7,
+	0x32, 0xb1, 0xa3, 0xc1, 0x3c, 0x8b, 0xf6, 0x50, 0x2b, 0x5a, 0x82, 0x48, 0xbf, 0xa1, 0x6c, 0x66,
+	0x18, 0x31, 0x43, 0xb7, 0x8c, 0xc0, 0x8f, 0x3a, 0xfe, 0x86, 0x06, 0x67, 0x87, 0x11, 0xbe, 0xec,
+	0x84, 0x7b, 0xf4, 0xce, 0x48, 0x1e, 0x12, 0xe4, 0x5b, 0x4b, 0xad, 0x9b, 0xb9, 0xf1, 0x23, 0x12,
+	0x6c, 0xa8, 0x25, 0x6e, 0xd3, 0xea, 0xaf, 0x8f, 0xbb, 0x6f, 0x2b, 0x3a, 0xbb, 0x3a, 0x1e, 0x31,
+	0xea, 0x65, 0x0f, 0xe6, 0x52, 0x77, 0x76, 0x95, 0x0b, 0x4e, 0x7d, 0xaf, 0xf7, 0x58, 0x3d, 0xf5,
+	0x61, 0x7e, 0xe8, 0x5a, 0xa8, 0x9e, 0xb1, 0xdb, 0x28, 0xaf, 0xab, 0xb6, 0xde, 0xcc, 0x87, 0x1c,
+	0xf5, 0xe8, 0x89, 0xdb, 0x9f, 0xe2, 0xd5, 0x54, 0x7e, 0x2d, 0x53, 0xb9, 0xf5, 0x2a, 0xef, 0x89,
+	0xb6, 0xae, 0xe5, 0xc0, 0x4c, 0xed, 0x05, 0xaa, 0x3b, 0x99, 0xb7, 0xb2, 0xf6, 0x96, 0xac, 0xab,
+	0x93, 0xad, 0xdb, 0xc7, 0xa8, 0x21, 0x1b, 0x19, 0xc9, 0xab, 0x7e, 0xca, 0x91, 0x2a, 0x6f, 0x28,
+	0xb6, 0xae, 0xe5, 0xc0, 0x8c, 0x3a, 0x3a, 0x80, 0x05, 0xc5, 0x4d, 0x2a, 0x5d, 0xa5, 0x0d, 0xb3,
+	0xaf, 0xf2, 0xb5, 0x6e, 0xe4, 0x45, 0x4f, 0x59, 0x1b, 0x43, 0xef, 0xae, 0x64, 0x59, 0x1b, 0x59,
+	0xcf, 0xd9, 0xb4, 0x6e, 0xe6, 0xc6, 0x8f, 0xba, 0xde, 0x87, 0x33, 0xdc, 0xfc, 0x4f, 0x5f, 0xc5,
+	0x52, 0x1a, 0x1b, 0xa3, 0xaf, 0x6d, 0x8d, 0x53, 0xb5, 0xdb, 0x50, 0x91, 0xae, 0x62, 0xe9, 0xaa,
+	0x4c, 0xeb, 0xe1, 0xab, 0x5a, 0xe3, 0x1a, 0xfd, 0x32, 0xd4, 0x12, 0x57, 0xaa, 0x94, 0x0a, 0x45,
+	0x75, 0xe9, 0x6a, 0x5c, 0xc3, 0x1f, 0xc3, 0xb2, 0xfa, 0xde, 0x89, 0x52, 0xee, 0x47, 0x5e, 0x4d,
+	0x6a, 0xdd, 0x3e, 0x46, 0x0d, 0x59, 0xb5, 0x0c, 0xdd, 0xe2, 0x50, 0xaa, 0x96, 0xac, 0x7b, 0x27,
+	0xad, 0x37, 0xf3, 0x21, 0x4b, 0x2b, 0x6d, 0x49, 0x79, 0x7f, 0x43, 0x69, 0x75, 0x8d, 0xba, 0xe9,
+	0x31, 0x8e, 0xb7, 0x16, 0x54, 0xe5, 0x9c, 0x7a, 0xfd, 0xca, 0xd8, 0xa4, 0x7b, 0xa5, 0xc5, 0xa0,
+	0xc0, 0x93, 0xd4, 0xe4, 0x19, 0x96, 0xca, 0x1c, 0xe5, 0xd6, 0x78, 0xb8, 0x8f, 0x3a, 0xa1, 0x1f,
+	0x28, 0x25, 0x44, 0x95, 0xc3, 0xdf, 0xba, 0x3a, 0x1e, 0x51, 0x76, 0xbb, 0x52, 0x59, 0xb4, 0x59,
+	0x36, 0x9e, 0x22, 0x87, 0xba, 0x75, 0x3d, 0x0f, 0xaa, 0xec, 0x0d, 0xa5, 0xf3, 0x51, 0x95, 0xde,
+	0x50, 0x46, 0x6a, 0x6c, 0xeb, 0x8d, 0x5c, 0xb8, 0x51, 0x77, 0x5f, 0x83, 0x8a, 0x94, 0x35, 0xa9,
+	0x5c, 0xb7, 0xc3, 0xf9, 0x9e, 0xad, 0x2b, 0xe3, 0xd0, 0xa2, 0xf6, 0x2d, 0x72, 0x7c, 0x95, 0x4e,
+	0x8a, 0x54, 0x9a, 0xac, 0x99, 0xb9, 0x93, 0xe3, 0x04, 0xae, 0x0b, 0x4b, 0xca, 0x9c, 0x45, 0xa5,
+	0x64, 0x8f, 0xca, 0x6e, 0x1c, 0xd7, 0xd1, 0x2f, 0xc3, 0x92, 0x32, 0x79, 0x4b, 0xd9, 0xd1, 0xa8,
+	0x64, 0xc4, 0xd6, 0xad, 0xfc, 0x15, 0x52, 0x6e, 0x72, 0x22, 0xfb, 0x29, 0xcb, 0x4d, 0x56, 0xa5,
+	0x73, 0xb5, 0xde, 0xc8, 0x85, 0x2b, 0x3b, 0x4d, 0xa9, 0x2c, 0x23, 0xa5, 0xcc, 0xab, 0x33, 0x91,
+	0xc6, 0x71, 0xb2, 0x0d, 0xf3, 0x43, 0xb9, 0x3f, 0x4a, 0xf5, 0x97, 0x95, 0x21, 0x34, 0x5e, 0x26,
+	0xea, 0xc9, 0x24, 0x8e, 0x31, 0xc1, 0x0b, 0x29, 0xd7, 0xa7, 0x75, 0x2d, 0x07, 0x66, 0xc4, 0xa6,
+	0x6f, 0x27, 0xfe, 0x88, 0x26, 0x99, 0x87, 0xa0, 0xaf, 0x8c, 0x6c, 0x49, 0x99, 0xe5, 0xd1, 0x7a,
+	0xeb, 0x58, 0x75, 0x22, 0x3a, 0x10, 0x2c, 0xaa, 0x4e, 0xec, 0x95, 0x76, 0xc6, 0x88, 0xa3, 0xfd,
+	0x71, 0x7c, 0x65, 0xe6, 0xcc, 0xd0, 0xa9, 0x77, 0x96, 0x39, 0x93, 0x75, 0x26, 0xdf, 0xba, 0x99,
+	0x1b, 0x3f, 0x1a, 0xe1, 0x2f, 0x41, 0x45, 0x3a, 0x6a, 0x56, 0x6a, 0xaa, 0xe1, 0x83, 0xf2, 0xd6,
+	0x95, 0x71, 0x68, 0xa2, 0xfd, 0x5b, 0x9a, 0xfe, 0x0b, 0x50, 0x4f, 0x9e, 0x11, 0x2b, 0x85, 0x46,
+	0x79, 0x8c, 0x9c, 0xc3, 0xe0, 0x50, 0x1f, 0x5d, 0x66, 0x1a, 0xda, 0x99, 0x87, 0xc3, 0xad, 0xdb,
+	0xc7, 0xa8, 0x21, 0x6d, 0x61, 0x8d, 0xf4, 0xb1, 0x57, 0x96, 0xf6, 0x50, 0x9d, 0x8d, 0x29, 0xb7,
+	0x4b, 0xe5, 0x81, 0x0f, 0xdb, 0x53, 0xa4, 0xf3, 0x0c, 0xe5, 0x4c, 0x0d, 0x9f, 0xbd, 0xb4, 0xae,
+	0x8c, 0x43, 0x93, 0x55, 0x53, 0xea, 0x0c, 0x43, 0xa9, 0x9a, 0xd4, 0xe7, 0x1c, 0xe3, 0x66, 0xea,
+	0x17, 0xa1, 0x91, 0x3e, 0xbc, 0x50, 0x32, 0x2a, 0xe3, 0x84, 0x63, 0x4c, 0xf3, 0x2b, 0xff, 0x39,
+	0x03, 0x65, 0xb1, 0xf2, 0x5e, 0x40, 0xc0, 0xfd, 0x05, 0x44, 0xc0, 0xbf, 0x0a, 0x73, 0xa9, 0x7f,
+	0x56, 0xc9, 0xf6, 0xd7, 0x87, 0xfe, 0x7d, 0x25, 0x87, 0x87, 0x90, 0xf8, 0xab, 0x14, 0xa5, 0xfd,
+	0xa7, 0xfa, 0x33, 0x95, 0xf1, 0x3b, 0xd4, 0x29, 0x47, 0xbd, 0x1e, 0x01, 0x48, 0x12, 0x76, 0x69,
+	0xec, 0x2d, 0xa1, 0x71, 0x04, 0x3f, 0x85, 0xb2, 0x78, 0xab, 0x41, 0x37, 0xb2, 0x98, 0xb0, 0xea,
+	0x66, 0xcd, 0x5e, 0x0a, 0x47, 0x8e, 0xe9, 0x24, 0xac, 0xe2, 0xd3, 0x31, 0xb0, 0x3f, 0x61, 0xa3,
+	0x17, 0x41, 0x2b, 0x99, 0x8b, 0x40, 0xde, 0x57, 0xdf, 0xf6, 0xac, 0x3e, 0xde, 0xf3, 0xd5, 0x4a,
+	0x5f, 0x99, 0xba, 0x30, 0x66, 0x4a, 0xee, 0xbe, 0xf5, 0x95, 0xdb, 0x5d, 0x27, 0xdc, 0x1b, 0xec,
+	0x90, 0x2f, 0x37, 0x19, 0xea, 0xa7, 0x1c, 0x9f, 0xff, 0xba, 0x29, 0x16, 0xd9, 0x4d, 0x5a, 0xfb,
+	0x26, 0xe9, 0xa7, 0xbf, 0xb3, 0x33, 0x4d, 0x4b, 0x6f, 0xfd, 0xdf, 0x00, 0xf9, 0x0e, 0x4f, 0x77,
+	0xd9, 0x74, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataCoordClient is the client API for DataCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCoordClient interface {
+	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
+	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
+	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
+	WatchChannelsV2(ctx context.Context, in *WatchChannelsV2Request, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
+	GetChannelHistory(ctx context.Context, in *GetChannelHistoryRequest, opts ...grpc.CallOption) (*GetChannelHistoryResponse, error)
+	ImportSegmentManifest(ctx context.Context, in *ImportManifestRequest, opts ...grpc.CallOption) (*ImportManifestResponse, error)
+	GetCompactionScoreCard(ctx context.Context, in *GetCompactionScoreCardRequest, opts ...grpc.CallOption) (*GetCompactionScoreCardResponse, error)
+	MigrateChannel(ctx context.Context, in *MigrateChannelRequest, opts ...grpc.CallOption) (*MigrateChannelResponse, error)
+	ListCompactionPlans(ctx context.Context, in *ListCompactionPlansRequest, opts ...grpc.CallOption) (*ListCompactionPlansResponse, error)
+	GetFlushedSegmentsV2(ctx context.Context, in *GetFlushedSegmentsV2Request, opts ...grpc.CallOption) (*GetFlushedSegmentsV2Response, error)
+	ReclaimFailedCompaction(ctx context.Context, in *ReclaimFailedCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	PinSegments(ctx context.Context, in *PinSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UnpinSegments(ctx context.Context, in *UnpinSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListManagedCollections(ctx context.Context, in *ListManagedCollectionsRequest, opts ...grpc.CallOption) (*ListManagedCollectionsResponse, error)
+	MigrateEtcdPrefix(ctx context.Context, in *MigrateEtcdPrefixRequest, opts ...grpc.CallOption) (*MigrateEtcdPrefixResponse, error)
+	RegisterDataNodeQuota(ctx context.Context, in *RegisterDataNodeQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	StorageAudit(ctx context.Context, in *StorageAuditRequest, opts ...grpc.CallOption) (*StorageAuditResponse, error)
+	SampledSegmentInspector(ctx context.Context, in *SampleSegmentRequest, opts ...grpc.CallOption) (*SampleSegmentResponse, error)
+	GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error)
+	CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*CancelCompactionResponse, error)
+	GetGCEvents(ctx context.Context, in *GetGCEventsRequest, opts ...grpc.CallOption) (*GetGCEventsResponse, error)
+	ReportSegmentError(ctx context.Context, in *ReportSegmentErrorRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SetCollectionProperty(ctx context.Context, in *SetCollectionPropertyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ComputeSegmentOverlap(ctx context.Context, in *ComputeSegmentOverlapRequest, opts ...grpc.CallOption) (*ComputeSegmentOverlapResponse, error)
+	GetCompactionROI(ctx context.Context, in *GetCompactionROIRequest, opts ...grpc.CallOption) (*GetCompactionROIResponse, error)
+	FreezePartition(ctx context.Context, in *FreezePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UnfreezePartition(ctx context.Context, in *UnfreezePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetSegmentPath(ctx context.Context, in *GetSegmentPathRequest, opts ...grpc.CallOption) (*GetSegmentPathResponse, error)
+	GetSegmentsForCollection(ctx context.Context, in *GetSegmentsForCollectionRequest, opts ...grpc.CallOption) (*GetSegmentsForCollectionResponse, error)
+	ReportDataNodeHealth(ctx context.Context, in *ReportDataNodeHealthRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetSegmentLineageDOT(ctx context.Context, in *GetSegmentLineageDOTRequest, opts ...grpc.CallOption) (*GetSegmentLineageDOTResponse, error)
+	ReadSegment(ctx context.Context, in *ReadSegmentRequest, opts ...grpc.CallOption) (DataCoord_ReadSegmentClient, error)
+	DiscardSegment(ctx context.Context, in *DiscardSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetChannelSegmentStats(ctx context.Context, in *GetChannelSegmentStatsRequest, opts ...grpc.CallOption) (*GetChannelSegmentStatsResponse, error)
+	GetFlushProgress(ctx context.Context, in *GetFlushProgressRequest, opts ...grpc.CallOption) (*FlushProgressResponse, error)
+	CancelFlush(ctx context.Context, in *CancelFlushRequest, opts ...grpc.CallOption) (*CancelFlushResponse, error)
+	PauseCompaction(ctx context.Context, in *PauseCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ResumeCompaction(ctx context.Context, in *ResumeCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+}
+
+type dataCoordClient struct {
+	cc *grpc.ClientConn
 }
 
 func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
 	return &dataCoordClient{cc}
 }
 
-func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
-	out := new(internalpb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
+func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
+	out := new(internalpb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
+	out := new(AssignSegmentIDResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
+	out := new(GetSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
+	out := new(GetSegmentStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error) {
+	out := new(GetInsertBinlogPathsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetInsertBinlogPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
+	out := new(GetCollectionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCollectionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
+	out := new(GetPartitionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetPartitionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfoChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveBinlogPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
+	out := new(GetRecoveryInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetRecoveryInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error) {
+	out := new(GetFlushedSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushedSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	out := new(milvuspb.GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CompleteCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	out := new(milvuspb.ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ManualCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
+	out := new(milvuspb.GetCompactionStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error) {
+	out := new(milvuspb.GetCompactionPlansResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error) {
+	out := new(WatchChannelsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/WatchChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) WatchChannelsV2(ctx context.Context, in *WatchChannelsV2Request, opts ...grpc.CallOption) (*WatchChannelsResponse, error) {
+	out := new(WatchChannelsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/WatchChannelsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetChannelHistory(ctx context.Context, in *GetChannelHistoryRequest, opts ...grpc.CallOption) (*GetChannelHistoryResponse, error) {
+	out := new(GetChannelHistoryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetChannelHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ImportSegmentManifest(ctx context.Context, in *ImportManifestRequest, opts ...grpc.CallOption) (*ImportManifestResponse, error) {
+	out := new(ImportManifestResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ImportSegmentManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionScoreCard(ctx context.Context, in *GetCompactionScoreCardRequest, opts ...grpc.CallOption) (*GetCompactionScoreCardResponse, error) {
+	out := new(GetCompactionScoreCardResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionScoreCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) MigrateChannel(ctx context.Context, in *MigrateChannelRequest, opts ...grpc.CallOption) (*MigrateChannelResponse, error) {
+	out := new(MigrateChannelResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/MigrateChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ListCompactionPlans(ctx context.Context, in *ListCompactionPlansRequest, opts ...grpc.CallOption) (*ListCompactionPlansResponse, error) {
+	out := new(ListCompactionPlansResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ListCompactionPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetFlushedSegmentsV2(ctx context.Context, in *GetFlushedSegmentsV2Request, opts ...grpc.CallOption) (*GetFlushedSegmentsV2Response, error) {
+	out := new(GetFlushedSegmentsV2Response)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushedSegmentsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReclaimFailedCompaction(ctx context.Context, in *ReclaimFailedCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReclaimFailedCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) PinSegments(ctx context.Context, in *PinSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/PinSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UnpinSegments(ctx context.Context, in *UnpinSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UnpinSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ListManagedCollections(ctx context.Context, in *ListManagedCollectionsRequest, opts ...grpc.CallOption) (*ListManagedCollectionsResponse, error) {
+	out := new(ListManagedCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ListManagedCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) MigrateEtcdPrefix(ctx context.Context, in *MigrateEtcdPrefixRequest, opts ...grpc.CallOption) (*MigrateEtcdPrefixResponse, error) {
+	out := new(MigrateEtcdPrefixResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/MigrateEtcdPrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) RegisterDataNodeQuota(ctx context.Context, in *RegisterDataNodeQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/RegisterDataNodeQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) StorageAudit(ctx context.Context, in *StorageAuditRequest, opts ...grpc.CallOption) (*StorageAuditResponse, error) {
+	out := new(StorageAuditResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/StorageAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SampledSegmentInspector(ctx context.Context, in *SampleSegmentRequest, opts ...grpc.CallOption) (*SampleSegmentResponse, error) {
+	out := new(SampleSegmentResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SampledSegmentInspector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error) {
+	out := new(GetStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*CancelCompactionResponse, error) {
+	out := new(CancelCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CancelCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetGCEvents(ctx context.Context, in *GetGCEventsRequest, opts ...grpc.CallOption) (*GetGCEventsResponse, error) {
+	out := new(GetGCEventsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetGCEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportSegmentError(ctx context.Context, in *ReportSegmentErrorRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportSegmentError", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SetCollectionProperty(ctx context.Context, in *SetCollectionPropertyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SetCollectionProperty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ComputeSegmentOverlap(ctx context.Context, in *ComputeSegmentOverlapRequest, opts ...grpc.CallOption) (*ComputeSegmentOverlapResponse, error) {
+	out := new(ComputeSegmentOverlapResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ComputeSegmentOverlap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionROI(ctx context.Context, in *GetCompactionROIRequest, opts ...grpc.CallOption) (*GetCompactionROIResponse, error) {
+	out := new(GetCompactionROIResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionROI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) FreezePartition(ctx context.Context, in *FreezePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/FreezePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UnfreezePartition(ctx context.Context, in *UnfreezePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UnfreezePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentPath(ctx context.Context, in *GetSegmentPathRequest, opts ...grpc.CallOption) (*GetSegmentPathResponse, error) {
+	out := new(GetSegmentPathResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentsForCollection(ctx context.Context, in *GetSegmentsForCollectionRequest, opts ...grpc.CallOption) (*GetSegmentsForCollectionResponse, error) {
+	out := new(GetSegmentsForCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentsForCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportDataNodeHealth(ctx context.Context, in *ReportDataNodeHealthRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportDataNodeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentLineageDOT(ctx context.Context, in *GetSegmentLineageDOTRequest, opts ...grpc.CallOption) (*GetSegmentLineageDOTResponse, error) {
+	out := new(GetSegmentLineageDOTResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentLineageDOT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReadSegment(ctx context.Context, in *ReadSegmentRequest, opts ...grpc.CallOption) (DataCoord_ReadSegmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DataCoord_serviceDesc.Streams[0], "/milvus.proto.data.DataCoord/ReadSegment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dataCoordReadSegmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DataCoord_ReadSegmentClient interface {
+	Recv() (*ReadSegmentResponse, error)
+	grpc.ClientStream
+}
+
+type dataCoordReadSegmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *dataCoordReadSegmentClient) Recv() (*ReadSegmentResponse, error) {
+	m := new(ReadSegmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dataCoordClient) DiscardSegment(ctx context.Context, in *DiscardSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DiscardSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetChannelSegmentStats(ctx context.Context, in *GetChannelSegmentStatsRequest, opts ...grpc.CallOption) (*GetChannelSegmentStatsResponse, error) {
+	out := new(GetChannelSegmentStatsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetChannelSegmentStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetFlushProgress(ctx context.Context, in *GetFlushProgressRequest, opts ...grpc.CallOption) (*FlushProgressResponse, error) {
+	out := new(FlushProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CancelFlush(ctx context.Context, in *CancelFlushRequest, opts ...grpc.CallOption) (*CancelFlushResponse, error) {
+	out := new(CancelFlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CancelFlush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) PauseCompaction(ctx context.Context, in *PauseCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/PauseCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ResumeCompaction(ctx context.Context, in *ResumeCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ResumeCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataCoordServer is the server API for DataCoord service.
+type DataCoordServer interface {
+	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
+	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
+	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
+	AssignSegmentID(context.Context, *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(context.Context, *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(context.Context, *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(context.Context, *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(context.Context, *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(context.Context, *SaveBinlogPathsRequest) (*commonpb.Status, error)
+	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
+	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	CompleteCompaction(context.Context, *CompactionResult) (*commonpb.Status, error)
+	ManualCompaction(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
+	GetCompactionState(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
+	GetCompactionStateWithPlans(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
+	WatchChannelsV2(context.Context, *WatchChannelsV2Request) (*WatchChannelsResponse, error)
+	GetChannelHistory(context.Context, *GetChannelHistoryRequest) (*GetChannelHistoryResponse, error)
+	ImportSegmentManifest(context.Context, *ImportManifestRequest) (*ImportManifestResponse, error)
+	GetCompactionScoreCard(context.Context, *GetCompactionScoreCardRequest) (*GetCompactionScoreCardResponse, error)
+	MigrateChannel(context.Context, *MigrateChannelRequest) (*MigrateChannelResponse, error)
+	ListCompactionPlans(context.Context, *ListCompactionPlansRequest) (*ListCompactionPlansResponse, error)
+	GetFlushedSegmentsV2(context.Context, *GetFlushedSegmentsV2Request) (*GetFlushedSegmentsV2Response, error)
+	ReclaimFailedCompaction(context.Context, *ReclaimFailedCompactionRequest) (*commonpb.Status, error)
+	PinSegments(context.Context, *PinSegmentsRequest) (*commonpb.Status, error)
+	UnpinSegments(context.Context, *UnpinSegmentsRequest) (*commonpb.Status, error)
+	ListManagedCollections(context.Context, *ListManagedCollectionsRequest) (*ListManagedCollectionsResponse, error)
+	MigrateEtcdPrefix(context.Context, *MigrateEtcdPrefixRequest) (*MigrateEtcdPrefixResponse, error)
+	RegisterDataNodeQuota(context.Context, *RegisterDataNodeQuotaRequest) (*commonpb.Status, error)
+	StorageAudit(context.Context, *StorageAuditRequest) (*StorageAuditResponse, error)
+	SampledSegmentInspector(context.Context, *SampleSegmentRequest) (*SampleSegmentResponse, error)
+	GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error)
+	CancelCompaction(context.Context, *CancelCompactionRequest) (*CancelCompactionResponse, error)
+	GetGCEvents(context.Context, *GetGCEventsRequest) (*GetGCEventsResponse, error)
+	ReportSegmentError(context.Context, *ReportSegmentErrorRequest) (*commonpb.Status, error)
+	SetCollectionProperty(context.Context, *SetCollectionPropertyRequest) (*commonpb.Status, error)
+	ComputeSegmentOverlap(context.Context, *ComputeSegmentOverlapRequest) (*ComputeSegmentOverlapResponse, error)
+	GetCompactionROI(context.Context, *GetCompactionROIRequest) (*GetCompactionROIResponse, error)
+	FreezePartition(context.Context, *FreezePartitionRequest) (*commonpb.Status, error)
+	UnfreezePartition(context.Context, *UnfreezePartitionRequest) (*commonpb.Status, error)
+	GetSegmentPath(context.Context, *GetSegmentPathRequest) (*GetSegmentPathResponse, error)
+	GetSegmentsForCollection(context.Context, *GetSegmentsForCollectionRequest) (*GetSegmentsForCollectionResponse, error)
+	ReportDataNodeHealth(context.Context, *ReportDataNodeHealthRequest) (*commonpb.Status, error)
+	GetSegmentLineageDOT(context.Context, *GetSegmentLineageDOTRequest) (*GetSegmentLineageDOTResponse, error)
+	ReadSegment(*ReadSegmentRequest, DataCoord_ReadSegmentServer) error
+	DiscardSegment(context.Context, *DiscardSegmentRequest) (*commonpb.Status, error)
+	GetChannelSegmentStats(context.Context, *GetChannelSegmentStatsRequest) (*GetChannelSegmentStatsResponse, error)
+	GetFlushProgress(context.Context, *GetFlushProgressRequest) (*FlushProgressResponse, error)
+	CancelFlush(context.Context, *CancelFlushRequest) (*CancelFlushResponse, error)
+	PauseCompaction(context.Context, *PauseCompactionRequest) (*commonpb.Status, error)
+	ResumeCompaction(context.Context, *ResumeCompactionRequest) (*commonpb.Status, error)
+}
+
+// UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
+type UnimplementedDataCoordServer struct {
+}
+
+func (*UnimplementedDataCoordServer) GetComponentStates(ctx context.Context, req *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+}
+func (*UnimplementedDataCoordServer) GetTimeTickChannel(ctx context.Context, req *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeTickChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+}
+func (*UnimplementedDataCoordServer) AssignSegmentID(ctx context.Context, req *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignSegmentID not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentStates(ctx context.Context, req *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentStates not implemented")
+}
+func (*UnimplementedDataCoordServer) GetInsertBinlogPaths(ctx context.Context, req *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInsertBinlogPaths not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCollectionStatistics(ctx context.Context, req *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionStatistics not implemented")
+}
+func (*UnimplementedDataCoordServer) GetPartitionStatistics(ctx context.Context, req *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionStatistics not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentInfoChannel(ctx context.Context, req *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfoChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) SaveBinlogPaths(ctx context.Context, req *SaveBinlogPathsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveBinlogPaths not implemented")
+}
+func (*UnimplementedDataCoordServer) GetRecoveryInfo(ctx context.Context, req *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryInfo not implemented")
+}
+func (*UnimplementedDataCoordServer) GetFlushedSegments(ctx context.Context, req *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (*UnimplementedDataCoordServer) CompleteCompaction(ctx context.Context, req *CompactionResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
+}
+func (*UnimplementedDataCoordServer) WatchChannels(ctx context.Context, req *WatchChannelsRequest) (*WatchChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchChannels not implemented")
+}
+func (*UnimplementedDataCoordServer) WatchChannelsV2(ctx context.Context, req *WatchChannelsV2Request) (*WatchChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchChannelsV2 not implemented")
+}
+func (*UnimplementedDataCoordServer) GetChannelHistory(ctx context.Context, req *GetChannelHistoryRequest) (*GetChannelHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelHistory not implemented")
+}
+func (*UnimplementedDataCoordServer) ImportSegmentManifest(ctx context.Context, req *ImportManifestRequest) (*ImportManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSegmentManifest not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionScoreCard(ctx context.Context, req *GetCompactionScoreCardRequest) (*GetCompactionScoreCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionScoreCard not implemented")
+}
+func (*UnimplementedDataCoordServer) MigrateChannel(ctx context.Context, req *MigrateChannelRequest) (*MigrateChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) ListCompactionPlans(ctx context.Context, req *ListCompactionPlansRequest) (*ListCompactionPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompactionPlans not implemented")
+}
+func (*UnimplementedDataCoordServer) GetFlushedSegmentsV2(ctx context.Context, req *GetFlushedSegmentsV2Request) (*GetFlushedSegmentsV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegmentsV2 not implemented")
+}
+func (*UnimplementedDataCoordServer) ReclaimFailedCompaction(ctx context.Context, req *ReclaimFailedCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimFailedCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) PinSegments(ctx context.Context, req *PinSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) UnpinSegments(ctx context.Context, req *UnpinSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) ListManagedCollections(ctx context.Context, req *ListManagedCollectionsRequest) (*ListManagedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListManagedCollections not implemented")
+}
+func (*UnimplementedDataCoordServer) MigrateEtcdPrefix(ctx context.Context, req *MigrateEtcdPrefixRequest) (*MigrateEtcdPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateEtcdPrefix not implemented")
+}
+func (*UnimplementedDataCoordServer) RegisterDataNodeQuota(ctx context.Context, req *RegisterDataNodeQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDataNodeQuota not implemented")
+}
+func (*UnimplementedDataCoordServer) StorageAudit(ctx context.Context, req *StorageAuditRequest) (*StorageAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageAudit not implemented")
+}
+func (*UnimplementedDataCoordServer) SampledSegmentInspector(ctx context.Context, req *SampleSegmentRequest) (*SampleSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SampledSegmentInspector not implemented")
+}
+func (*UnimplementedDataCoordServer) GetStorageUsage(ctx context.Context, req *GetStorageUsageRequest) (*GetStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
+func (*UnimplementedDataCoordServer) CancelCompaction(ctx context.Context, req *CancelCompactionRequest) (*CancelCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) GetGCEvents(ctx context.Context, req *GetGCEventsRequest) (*GetGCEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGCEvents not implemented")
+}
+func (*UnimplementedDataCoordServer) ReportSegmentError(ctx context.Context, req *ReportSegmentErrorRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSegmentError not implemented")
+}
+func (*UnimplementedDataCoordServer) SetCollectionProperty(ctx context.Context, req *SetCollectionPropertyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionProperty not implemented")
+}
+func (*UnimplementedDataCoordServer) ComputeSegmentOverlap(ctx context.Context, req *ComputeSegmentOverlapRequest) (*ComputeSegmentOverlapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeSegmentOverlap not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionROI(ctx context.Context, req *GetCompactionROIRequest) (*GetCompactionROIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionROI not implemented")
+}
+func (*UnimplementedDataCoordServer) FreezePartition(ctx context.Context, req *FreezePartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezePartition not implemented")
+}
+func (*UnimplementedDataCoordServer) UnfreezePartition(ctx context.Context, req *UnfreezePartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezePartition not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentPath(ctx context.Context, req *GetSegmentPathRequest) (*GetSegmentPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentPath not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentsForCollection(ctx context.Context, req *GetSegmentsForCollectionRequest) (*GetSegmentsForCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentsForCollection not implemented")
+}
+func (*UnimplementedDataCoordServer) ReportDataNodeHealth(ctx context.Context, req *ReportDataNodeHealthRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDataNodeHealth not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentLineageDOT(ctx context.Context, req *GetSegmentLineageDOTRequest) (*GetSegmentLineageDOTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentLineageDOT not implemented")
+}
+func (*UnimplementedDataCoordServer) ReadSegment(req *ReadSegmentRequest, srv DataCoord_ReadSegmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadSegment not implemented")
+}
+func (*UnimplementedDataCoordServer) DiscardSegment(ctx context.Context, req *DiscardSegmentRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardSegment not implemented")
+}
+func (*UnimplementedDataCoordServer) GetChannelSegmentStats(ctx context.Context, req *GetChannelSegmentStatsRequest) (*GetChannelSegmentStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelSegmentStats not implemented")
+}
+func (*UnimplementedDataCoordServer) GetFlushProgress(ctx context.Context, req *GetFlushProgressRequest) (*FlushProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlushProgress not implemented")
+}
+func (*UnimplementedDataCoordServer) CancelFlush(ctx context.Context, req *CancelFlushRequest) (*CancelFlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFlush not implemented")
+}
+func (*UnimplementedDataCoordServer) PauseCompaction(ctx context.Context, req *PauseCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) ResumeCompaction(ctx context.Context, req *ResumeCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCompaction not implemented")
+}
+
+func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
+	s.RegisterService(&_DataCoord_serviceDesc, srv)
+}
+
+func _DataCoord_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetComponentStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetComponentStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetComponentStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetComponentStates(ctx, req.(*internalpb.GetComponentStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetTimeTickChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetTimeTickChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetTimeTickChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetTimeTickChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetTimeTickChannel(ctx, req.(*internalpb.GetTimeTickChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetStatisticsChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetStatisticsChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetStatisticsChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Flush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Flush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Flush(ctx, req.(*FlushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_AssignSegmentID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignSegmentIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).AssignSegmentID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/AssignSegmentID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).AssignSegmentID(ctx, req.(*AssignSegmentIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentInfo(ctx, req.(*GetSegmentInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetSegmentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentStates(ctx, req.(*GetSegmentStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetInsertBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInsertBinlogPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetInsertBinlogPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, req.(*GetInsertBinlogPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCollectionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCollectionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionStatisticsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetPartitionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetPartitionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
-	if err != nil {
+func _DataCoord_GetSegmentInfoChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentInfoChannelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfoChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, req.(*GetSegmentInfoChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
+func _DataCoord_SaveBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveBinlogPathsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).SaveBinlogPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/SaveBinlogPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).SaveBinlogPaths(ctx, req.(*SaveBinlogPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
-	out := new(FlushResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
-	if err != nil {
+func _DataCoord_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetRecoveryInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetRecoveryInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetRecoveryInfo(ctx, req.(*GetRecoveryInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
-	out := new(AssignSegmentIDResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
-	if err != nil {
+func _DataCoord_GetFlushedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlushedSegmentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetFlushedSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetFlushedSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetFlushedSegments(ctx, req.(*GetFlushedSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
-	out := new(GetSegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
-	if err != nil {
+func _DataCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetMetricsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
-	out := new(GetSegmentStatesResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
-	if err != nil {
+func _DataCoord_CompleteCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactionResult)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).CompleteCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CompleteCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CompleteCompaction(ctx, req.(*CompactionResult))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error) {
-	out := new(GetInsertBinlogPathsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetInsertBinlogPaths", in, out, opts...)
-	if err != nil {
+func _DataCoord_ManualCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ManualCompactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).ManualCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ManualCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ManualCompaction(ctx, req.(*milvuspb.ManualCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
-	out := new(GetCollectionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCollectionStatistics", in, out, opts...)
-	if err != nil {
+func _DataCoord_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetCompactionStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionState(ctx, req.(*milvuspb.GetCompactionStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
-	out := new(GetPartitionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetPartitionStatistics", in, out, opts...)
-	if err != nil {
+func _DataCoord_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetCompactionPlansRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, req.(*milvuspb.GetCompactionPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfoChannel", in, out, opts...)
-	if err != nil {
+func _DataCoord_WatchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).WatchChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/WatchChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).WatchChannels(ctx, req.(*WatchChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_WatchChannelsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchChannelsV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).WatchChannelsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/WatchChannelsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).WatchChannelsV2(ctx, req.(*WatchChannelsV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetChannelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetChannelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetChannelHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetChannelHistory(ctx, req.(*GetChannelHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ImportSegmentManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportManifestRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).ImportSegmentManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ImportSegmentManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ImportSegmentManifest(ctx, req.(*ImportManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveBinlogPaths", in, out, opts...)
-	if err != nil {
+func _DataCoord_GetCompactionScoreCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionScoreCardRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionScoreCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionScoreCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionScoreCard(ctx, req.(*GetCompactionScoreCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
-	out := new(GetRecoveryInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetRecoveryInfo", in, out, opts...)
-	if err != nil {
+func _DataCoord_MigrateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateChannelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).MigrateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/MigrateChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).MigrateChannel(ctx, req.(*MigrateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error) {
-	out := new(GetFlushedSegmentsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushedSegments", in, out, opts...)
-	if err != nil {
+func _DataCoord_ListCompactionPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompactionPlansRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).ListCompactionPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ListCompactionPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ListCompactionPlans(ctx, req.(*ListCompactionPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
-	out := new(milvuspb.GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetMetrics", in, out, opts...)
-	if err != nil {
+func _DataCoord_GetFlushedSegmentsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlushedSegmentsV2Request)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetFlushedSegmentsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetFlushedSegmentsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetFlushedSegmentsV2(ctx, req.(*GetFlushedSegmentsV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CompleteCompaction", in, out, opts...)
-	if err != nil {
+func _DataCoord_ReclaimFailedCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReclaimFailedCompactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).ReclaimFailedCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ReclaimFailedCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ReclaimFailedCompaction(ctx, req.(*ReclaimFailedCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
-	out := new(milvuspb.ManualCompactionResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ManualCompaction", in, out, opts...)
-	if err != nil {
+func _DataCoord_PinSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinSegmentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).PinSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/PinSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).PinSegments(ctx, req.(*PinSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
-	out := new(milvuspb.GetCompactionStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionState", in, out, opts...)
-	if err != nil {
+func _DataCoord_UnpinSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinSegmentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).UnpinSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/UnpinSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).UnpinSegments(ctx, req.(*UnpinSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error) {
-	out := new(milvuspb.GetCompactionPlansResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans", in, out, opts...)
-	if err != nil {
+func _DataCoord_ListManagedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListManagedCollectionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DataCoordServer).ListManagedCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ListManagedCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ListManagedCollections(ctx, req.(*ListManagedCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dataCoordClient) WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error) {
-	out := new(WatchChannelsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/WatchChannels", in, out, opts...)
-	if err != nil {
+func _DataCoord_MigrateEtcdPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateEtcdPrefixRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// DataCoordServer is the server API for DataCoord service.
-type DataCoordServer interface {
-	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
-	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
-	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
-	AssignSegmentID(context.Context, *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(context.Context, *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(context.Context, *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(context.Context, *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(context.Context, *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(context.Context, *SaveBinlogPathsRequest) (*commonpb.Status, error)
-	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
-	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
-	CompleteCompaction(context.Context, *CompactionResult) (*commonpb.Status, error)
-	ManualCompaction(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
-	GetCompactionState(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
-	GetCompactionStateWithPlans(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
-	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
-}
-
-// UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
-type UnimplementedDataCoordServer struct {
+	if interceptor == nil {
+		return srv.(DataCoordServer).MigrateEtcdPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/MigrateEtcdPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).MigrateEtcdPrefix(ctx, req.(*MigrateEtcdPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedDataCoordServer) GetComponentStates(ctx context.Context, req *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
-}
-func (*UnimplementedDataCoordServer) GetTimeTickChannel(ctx context.Context, req *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTimeTickChannel not implemented")
-}
-func (*UnimplementedDataCoordServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
-}
-func (*UnimplementedDataCoordServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
-}
-func (*UnimplementedDataCoordServer) AssignSegmentID(ctx context.Context, req *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignSegmentID not implemented")
-}
-func (*UnimplementedDataCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
-}
-func (*UnimplementedDataCoordServer) GetSegmentStates(ctx context.Context, req *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentStates not implemented")
-}
-func (*UnimplementedDataCoordServer) GetInsertBinlogPaths(ctx context.Context, req *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInsertBinlogPaths not implemented")
-}
-func (*UnimplementedDataCoordServer) GetCollectionStatistics(ctx context.Context, req *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionStatistics not implemented")
-}
-func (*UnimplementedDataCoordServer) GetPartitionStatistics(ctx context.Context, req *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionStatistics not implemented")
-}
-func (*UnimplementedDataCoordServer) GetSegmentInfoChannel(ctx context.Context, req *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfoChannel not implemented")
-}
-func (*UnimplementedDataCoordServer) SaveBinlogPaths(ctx context.Context, req *SaveBinlogPathsRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveBinlogPaths not implemented")
-}
-func (*UnimplementedDataCoordServer) GetRecoveryInfo(ctx context.Context, req *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryInfo not implemented")
-}
-func (*UnimplementedDataCoordServer) GetFlushedSegments(ctx context.Context, req *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegments not implemented")
-}
-func (*UnimplementedDataCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
-}
-func (*UnimplementedDataCoordServer) CompleteCompaction(ctx context.Context, req *CompactionResult) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteCompaction not implemented")
-}
-func (*UnimplementedDataCoordServer) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
-}
-func (*UnimplementedDataCoordServer) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
-}
-func (*UnimplementedDataCoordServer) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
-}
-func (*UnimplementedDataCoordServer) WatchChannels(ctx context.Context, req *WatchChannelsRequest) (*WatchChannelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WatchChannels not implemented")
+func _DataCoord_RegisterDataNodeQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDataNodeQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).RegisterDataNodeQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/RegisterDataNodeQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).RegisterDataNodeQuota(ctx, req.(*RegisterDataNodeQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
-	s.RegisterService(&_DataCoord_serviceDesc, srv)
+func _DataCoord_StorageAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).StorageAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/StorageAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).StorageAudit(ctx, req.(*StorageAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.GetComponentStatesRequest)
+func _DataCoord_SampledSegmentInspector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SampleSegmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetComponentStates(ctx, in)
+		return srv.(DataCoordServer).SampledSegmentInspector(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetComponentStates",
+		FullMethod: "/milvus.proto.data.DataCoord/SampledSegmentInspector",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetComponentStates(ctx, req.(*internalpb.GetComponentStatesRequest))
+		return srv.(DataCoordServer).SampledSegmentInspector(ctx, req.(*SampleSegmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetTimeTickChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.GetTimeTickChannelRequest)
+func _DataCoord_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetTimeTickChannel(ctx, in)
+		return srv.(DataCoordServer).GetStorageUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetTimeTickChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/GetStorageUsage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetTimeTickChannel(ctx, req.(*internalpb.GetTimeTickChannelRequest))
+		return srv.(DataCoordServer).GetStorageUsage(ctx, req.(*GetStorageUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.GetStatisticsChannelRequest)
+func _DataCoord_CancelCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetStatisticsChannel(ctx, in)
+		return srv.(DataCoordServer).CancelCompaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetStatisticsChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/CancelCompaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+		return srv.(DataCoordServer).CancelCompaction(ctx, req.(*CancelCompactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FlushRequest)
+func _DataCoord_GetGCEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGCEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).Flush(ctx, in)
+		return srv.(DataCoordServer).GetGCEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/Flush",
+		FullMethod: "/milvus.proto.data.DataCoord/GetGCEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).Flush(ctx, req.(*FlushRequest))
+		return srv.(DataCoordServer).GetGCEvents(ctx, req.(*GetGCEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_AssignSegmentID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignSegmentIDRequest)
+func _DataCoord_ReportSegmentError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportSegmentErrorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).AssignSegmentID(ctx, in)
+		return srv.(DataCoordServer).ReportSegmentError(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/AssignSegmentID",
+		FullMethod: "/milvus.proto.data.DataCoord/ReportSegmentError",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).AssignSegmentID(ctx, req.(*AssignSegmentIDRequest))
+		return srv.(DataCoordServer).ReportSegmentError(ctx, req.(*ReportSegmentErrorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentInfoRequest)
+func _DataCoord_SetCollectionProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionPropertyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentInfo(ctx, in)
+		return srv.(DataCoordServer).SetCollectionProperty(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfo",
+		FullMethod: "/milvus.proto.data.DataCoord/SetCollectionProperty",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentInfo(ctx, req.(*GetSegmentInfoRequest))
+		return srv.(DataCoordServer).SetCollectionProperty(ctx, req.(*SetCollectionPropertyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentStatesRequest)
+func _DataCoord_ComputeSegmentOverlap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComputeSegmentOverlapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentStates(ctx, in)
+		return srv.(DataCoordServer).ComputeSegmentOverlap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentStates",
+		FullMethod: "/milvus.proto.data.DataCoord/ComputeSegmentOverlap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentStates(ctx, req.(*GetSegmentStatesRequest))
+		return srv.(DataCoordServer).ComputeSegmentOverlap(ctx, req.(*ComputeSegmentOverlapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetInsertBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInsertBinlogPathsRequest)
+func _DataCoord_GetCompactionROI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionROIRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, in)
+		return srv.(DataCoordServer).GetCompactionROI(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetInsertBinlogPaths",
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionROI",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, req.(*GetInsertBinlogPathsRequest))
+		return srv.(DataCoordServer).GetCompactionROI(ctx, req.(*GetCompactionROIRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionStatisticsRequest)
+func _DataCoord_FreezePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezePartitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetCollectionStatistics(ctx, in)
+		return srv.(DataCoordServer).FreezePartition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetCollectionStatistics",
+		FullMethod: "/milvus.proto.data.DataCoord/FreezePartition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+		return srv.(DataCoordServer).FreezePartition(ctx, req.(*FreezePartitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartitionStatisticsRequest)
+func _DataCoord_UnfreezePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezePartitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetPartitionStatistics(ctx, in)
+		return srv.(DataCoordServer).UnfreezePartition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetPartitionStatistics",
+		FullMethod: "/milvus.proto.data.DataCoord/UnfreezePartition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+		return srv.(DataCoordServer).UnfreezePartition(ctx, req.(*UnfreezePartitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentInfoChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentInfoChannelRequest)
+func _DataCoord_GetSegmentPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentPathRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, in)
+		return srv.(DataCoordServer).GetSegmentPath(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfoChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentPath",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, req.(*GetSegmentInfoChannelRequest))
+		return srv.(DataCoordServer).GetSegmentPath(ctx, req.(*GetSegmentPathRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_SaveBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveBinlogPathsRequest)
+func _DataCoord_GetSegmentsForCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentsForCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).SaveBinlogPaths(ctx, in)
+		return srv.(DataCoordServer).GetSegmentsForCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/SaveBinlogPaths",
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentsForCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).SaveBinlogPaths(ctx, req.(*SaveBinlogPathsRequest))
+		return srv.(DataCoordServer).GetSegmentsForCollection(ctx, req.(*GetSegmentsForCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRecoveryInfoRequest)
+func _DataCoord_ReportDataNodeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDataNodeHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetRecoveryInfo(ctx, in)
+		return srv.(DataCoordServer).ReportDataNodeHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetRecoveryInfo",
+		FullMethod: "/milvus.proto.data.DataCoord/ReportDataNodeHealth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetRecoveryInfo(ctx, req.(*GetRecoveryInfoRequest))
+		return srv.(DataCoordServer).ReportDataNodeHealth(ctx, req.(*ReportDataNodeHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetFlushedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFlushedSegmentsRequest)
+func _DataCoord_GetSegmentLineageDOT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentLineageDOTRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetFlushedSegments(ctx, in)
+		return srv.(DataCoordServer).GetSegmentLineageDOT(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetFlushedSegments",
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentLineageDOT",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetFlushedSegments(ctx, req.(*GetFlushedSegmentsRequest))
+		return srv.(DataCoordServer).GetSegmentLineageDOT(ctx, req.(*GetSegmentLineageDOTRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetMetricsRequest)
+func _DataCoord_ReadSegment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadSegmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataCoordServer).ReadSegment(m, &dataCoordReadSegmentServer{stream})
+}
+
+type DataCoord_ReadSegmentServer interface {
+	Send(*ReadSegmentResponse) error
+	grpc.ServerStream
+}
+
+type dataCoordReadSegmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *dataCoordReadSegmentServer) Send(m *ReadSegmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DataCoord_DiscardSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardSegmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetMetrics(ctx, in)
+		return srv.(DataCoordServer).DiscardSegment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetMetrics",
+		FullMethod: "/milvus.proto.data.DataCoord/DiscardSegment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+		return srv.(DataCoordServer).DiscardSegment(ctx, req.(*DiscardSegmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_CompleteCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CompactionResult)
+func _DataCoord_GetChannelSegmentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelSegmentStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).CompleteCompaction(ctx, in)
+		return srv.(DataCoordServer).GetChannelSegmentStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/CompleteCompaction",
+		FullMethod: "/milvus.proto.data.DataCoord/GetChannelSegmentStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).CompleteCompaction(ctx, req.(*CompactionResult))
+		return srv.(DataCoordServer).GetChannelSegmentStats(ctx, req.(*GetChannelSegmentStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_ManualCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.ManualCompactionRequest)
+func _DataCoord_GetFlushProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlushProgressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).ManualCompaction(ctx, in)
+		return srv.(DataCoordServer).GetFlushProgress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/ManualCompaction",
+		FullMethod: "/milvus.proto.data.DataCoord/GetFlushProgress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).ManualCompaction(ctx, req.(*milvuspb.ManualCompactionRequest))
+		return srv.(DataCoordServer).GetFlushProgress(ctx, req.(*GetFlushProgressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetCompactionStateRequest)
+func _DataCoord_CancelFlush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFlushRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetCompactionState(ctx, in)
+		return srv.(DataCoordServer).CancelFlush(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionState",
+		FullMethod: "/milvus.proto.data.DataCoord/CancelFlush",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetCompactionState(ctx, req.(*milvuspb.GetCompactionStateRequest))
+		return srv.(DataCoordServer).CancelFlush(ctx, req.(*CancelFlushRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetCompactionPlansRequest)
+func _DataCoord_PauseCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseCompactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, in)
+		return srv.(DataCoordServer).PauseCompaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans",
+		FullMethod: "/milvus.proto.data.DataCoord/PauseCompaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, req.(*milvuspb.GetCompactionPlansRequest))
+		return srv.(DataCoordServer).PauseCompaction(ctx, req.(*PauseCompactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_WatchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WatchChannelsRequest)
+func _DataCoord_ResumeCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeCompactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).WatchChannels(ctx, in)
+		return srv.(DataCoordServer).ResumeCompaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/WatchChannels",
+		FullMethod: "/milvus.proto.data.DataCoord/ResumeCompaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).WatchChannels(ctx, req.(*WatchChannelsRequest))
+		return srv.(DataCoordServer).ResumeCompaction(ctx, req.(*ResumeCompactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3738,8 +10080,150 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WatchChannels",
 			Handler:    _DataCoord_WatchChannels_Handler,
 		},
+		{
+			MethodName: "WatchChannelsV2",
+			Handler:    _DataCoord_WatchChannelsV2_Handler,
+		},
+		{
+			MethodName: "GetChannelHistory",
+			Handler:    _DataCoord_GetChannelHistory_Handler,
+		},
+		{
+			MethodName: "ImportSegmentManifest",
+			Handler:    _DataCoord_ImportSegmentManifest_Handler,
+		},
+		{
+			MethodName: "GetCompactionScoreCard",
+			Handler:    _DataCoord_GetCompactionScoreCard_Handler,
+		},
+		{
+			MethodName: "MigrateChannel",
+			Handler:    _DataCoord_MigrateChannel_Handler,
+		},
+		{
+			MethodName: "ListCompactionPlans",
+			Handler:    _DataCoord_ListCompactionPlans_Handler,
+		},
+		{
+			MethodName: "GetFlushedSegmentsV2",
+			Handler:    _DataCoord_GetFlushedSegmentsV2_Handler,
+		},
+		{
+			MethodName: "ReclaimFailedCompaction",
+			Handler:    _DataCoord_ReclaimFailedCompaction_Handler,
+		},
+		{
+			MethodName: "PinSegments",
+			Handler:    _DataCoord_PinSegments_Handler,
+		},
+		{
+			MethodName: "UnpinSegments",
+			Handler:    _DataCoord_UnpinSegments_Handler,
+		},
+		{
+			MethodName: "ListManagedCollections",
+			Handler:    _DataCoord_ListManagedCollections_Handler,
+		},
+		{
+			MethodName: "MigrateEtcdPrefix",
+			Handler:    _DataCoord_MigrateEtcdPrefix_Handler,
+		},
+		{
+			MethodName: "RegisterDataNodeQuota",
+			Handler:    _DataCoord_RegisterDataNodeQuota_Handler,
+		},
+		{
+			MethodName: "StorageAudit",
+			Handler:    _DataCoord_StorageAudit_Handler,
+		},
+		{
+			MethodName: "SampledSegmentInspector",
+			Handler:    _DataCoord_SampledSegmentInspector_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _DataCoord_GetStorageUsage_Handler,
+		},
+		{
+			MethodName: "CancelCompaction",
+			Handler:    _DataCoord_CancelCompaction_Handler,
+		},
+		{
+			MethodName: "GetGCEvents",
+			Handler:    _DataCoord_GetGCEvents_Handler,
+		},
+		{
+			MethodName: "ReportSegmentError",
+			Handler:    _DataCoord_ReportSegmentError_Handler,
+		},
+		{
+			MethodName: "SetCollectionProperty",
+			Handler:    _DataCoord_SetCollectionProperty_Handler,
+		},
+		{
+			MethodName: "ComputeSegmentOverlap",
+			Handler:    _DataCoord_ComputeSegmentOverlap_Handler,
+		},
+		{
+			MethodName: "GetCompactionROI",
+			Handler:    _DataCoord_GetCompactionROI_Handler,
+		},
+		{
+			MethodName: "FreezePartition",
+			Handler:    _DataCoord_FreezePartition_Handler,
+		},
+		{
+			MethodName: "UnfreezePartition",
+			Handler:    _DataCoord_UnfreezePartition_Handler,
+		},
+		{
+			MethodName: "GetSegmentPath",
+			Handler:    _DataCoord_GetSegmentPath_Handler,
+		},
+		{
+			MethodName: "GetSegmentsForCollection",
+			Handler:    _DataCoord_GetSegmentsForCollection_Handler,
+		},
+		{
+			MethodName: "ReportDataNodeHealth",
+			Handler:    _DataCoord_ReportDataNodeHealth_Handler,
+		},
+		{
+			MethodName: "GetSegmentLineageDOT",
+			Handler:    _DataCoord_GetSegmentLineageDOT_Handler,
+		},
+		{
+			MethodName: "DiscardSegment",
+			Handler:    _DataCoord_DiscardSegment_Handler,
+		},
+		{
+			MethodName: "GetChannelSegmentStats",
+			Handler:    _DataCoord_GetChannelSegmentStats_Handler,
+		},
+		{
+			MethodName: "GetFlushProgress",
+			Handler:    _DataCoord_GetFlushProgress_Handler,
+		},
+		{
+			MethodName: "CancelFlush",
+			Handler:    _DataCoord_CancelFlush_Handler,
+		},
+		{
+			MethodName: "PauseCompaction",
+			Handler:    _DataCoord_PauseCompaction_Handler,
+		},
+		{
+			MethodName: "ResumeCompaction",
+			Handler:    _DataCoord_ResumeCompaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReadSegment",
+			Handler:       _DataCoord_ReadSegment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
 }
 
@@ -3754,6 +10238,10 @@ type DataNodeClient interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 	Compaction(ctx context.Context, in *CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error)
+	FlushAll(ctx context.Context, in *FlushAllRequest, opts ...grpc.CallOption) (*FlushAllResponse, error)
+	SampleSegment(ctx context.Context, in *SampleSegmentRequest, opts ...grpc.CallOption) (*SampleSegmentResponse, error)
+	CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*CancelCompactionResponse, error)
+	RestoreSegmentFromSnapshot(ctx context.Context, in *RestoreSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataNodeClient struct {
@@ -3818,6 +10306,42 @@ func (c *dataNodeClient) Compaction(ctx context.Context, in *CompactionPlan, opt
 	return out, nil
 }
 
+func (c *dataNodeClient) FlushAll(ctx context.Context, in *FlushAllRequest, opts ...grpc.CallOption) (*FlushAllResponse, error) {
+	out := new(FlushAllResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/FlushAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) SampleSegment(ctx context.Context, in *SampleSegmentRequest, opts ...grpc.CallOption) (*SampleSegmentResponse, error) {
+	out := new(SampleSegmentResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/SampleSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*CancelCompactionResponse, error) {
+	out := new(CancelCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/CancelCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) RestoreSegmentFromSnapshot(ctx context.Context, in *RestoreSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/RestoreSegmentFromSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 type DataNodeServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -3827,6 +10351,10 @@ type DataNodeServer interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	Compaction(context.Context, *CompactionPlan) (*commonpb.Status, error)
+	FlushAll(context.Context, *FlushAllRequest) (*FlushAllResponse, error)
+	SampleSegment(context.Context, *SampleSegmentRequest) (*SampleSegmentResponse, error)
+	CancelCompaction(context.Context, *CancelCompactionRequest) (*CancelCompactionResponse, error)
+	RestoreSegmentFromSnapshot(context.Context, *RestoreSegmentRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataNodeServer can be embedded to have forward compatible implementations.
@@ -3851,6 +10379,18 @@ func (*UnimplementedDataNodeServer) GetMetrics(ctx context.Context, req *milvusp
 func (*UnimplementedDataNodeServer) Compaction(ctx context.Context, req *CompactionPlan) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Compaction not implemented")
 }
+func (*UnimplementedDataNodeServer) FlushAll(ctx context.Context, req *FlushAllRequest) (*FlushAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushAll not implemented")
+}
+func (*UnimplementedDataNodeServer) SampleSegment(ctx context.Context, req *SampleSegmentRequest) (*SampleSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SampleSegment not implemented")
+}
+func (*UnimplementedDataNodeServer) CancelCompaction(ctx context.Context, req *CancelCompactionRequest) (*CancelCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompaction not implemented")
+}
+func (*UnimplementedDataNodeServer) RestoreSegmentFromSnapshot(ctx context.Context, req *RestoreSegmentRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSegmentFromSnapshot not implemented")
+}
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
 	s.RegisterService(&_DataNode_serviceDesc, srv)
@@ -3964,6 +10504,78 @@ func _DataNode_Compaction_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_FlushAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).FlushAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/FlushAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).FlushAll(ctx, req.(*FlushAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_SampleSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SampleSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).SampleSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/SampleSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).SampleSegment(ctx, req.(*SampleSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_CancelCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).CancelCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/CancelCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).CancelCompaction(ctx, req.(*CancelCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_RestoreSegmentFromSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).RestoreSegmentFromSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/RestoreSegmentFromSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).RestoreSegmentFromSnapshot(ctx, req.(*RestoreSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -3992,6 +10604,22 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Compaction",
 			Handler:    _DataNode_Compaction_Handler,
 		},
+		{
+			MethodName: "FlushAll",
+			Handler:    _DataNode_FlushAll_Handler,
+		},
+		{
+			MethodName: "SampleSegment",
+			Handler:    _DataNode_SampleSegment_Handler,
+		},
+		{
+			MethodName: "CancelCompaction",
+			Handler:    _DataNode_CancelCompaction_Handler,
+		},
+		{
+			MethodName: "RestoreSegmentFromSnapshot",
+			Handler:    _DataNode_RestoreSegmentFromSnapshot_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -26,7 +26,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-//
 // This is for ShowCollectionsRequest type field.
 type ShowType int32
 
@@ -264,7 +263,7 @@ func (m *AlterAliasRequest) GetAlias() string {
 	return ""
 }
 
-//*
+// *
 // Create collection in milvus
 type CreateCollectionRequest struct {
 	// Not useful for now
@@ -343,7 +342,7 @@ func (m *CreateCollectionRequest) GetShardsNum() int32 {
 	return 0
 }
 
-//*
+// *
 // Drop collection in milvus, also will drop data in collection.
 type DropCollectionRequest struct {
 	// Not useful for now
@@ -403,7 +402,7 @@ func (m *DropCollectionRequest) GetCollectionName() string {
 	return ""
 }
 
-//*
+// *
 // Check collection exist in milvus or not.
 type HasCollectionRequest struct {
 	// Not useful for now
@@ -566,7 +565,7 @@ func (m *StringResponse) GetValue() string {
 	return ""
 }
 
-//*
+// *
 // Get collection meta datas like: schema, collectionID, shards number ...
 type DescribeCollectionRequest struct {
 	// Not useful for now
@@ -644,7 +643,7 @@ func (m *DescribeCollectionRequest) GetTimeStamp() uint64 {
 	return 0
 }
 
-//*
+// *
 // DescribeCollection Response
 type DescribeCollectionResponse struct {
 	// Contain error_code and reason
@@ -767,7 +766,7 @@ func (m *DescribeCollectionResponse) GetStartPositions() []*commonpb.KeyDataPair
 	return nil
 }
 
-//*
+// *
 // Load collection data into query nodes, then you can do vector search on this collection.
 type LoadCollectionRequest struct {
 	// Not useful for now
@@ -827,7 +826,7 @@ func (m *LoadCollectionRequest) GetCollectionName() string {
 	return ""
 }
 
-//*
+// *
 // Release collection data from query nodes, then you can't do vector search on this collection.
 type ReleaseCollectionRequest struct {
 	// Not useful for now
@@ -887,7 +886,7 @@ func (m *ReleaseCollectionRequest) GetCollectionName() string {
 	return ""
 }
 
-//*
+// *
 // Get collection statistics like row_count.
 type GetCollectionStatisticsRequest struct {
 	// Not useful for now
@@ -947,7 +946,7 @@ func (m *GetCollectionStatisticsRequest) GetCollectionName() string {
 	return ""
 }
 
-//*
+// *
 // Will return collection statistics in stats field like [{key:"row_count",value:"1"}]
 type GetCollectionStatisticsResponse struct {
 	// Contain error_code and reason
@@ -998,7 +997,6 @@ func (m *GetCollectionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	return nil
 }
 
-//
 // List collections
 type ShowCollectionsRequest struct {
 	// Not useful for now
@@ -1076,7 +1074,6 @@ func (m *ShowCollectionsRequest) GetCollectionNames() []string {
 	return nil
 }
 
-//
 // Return basic collection infos.
 type ShowCollectionsResponse struct {
 	// Contain error_code and reason
@@ -1163,7 +1160,6 @@ func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	return nil
 }
 
-//
 // Create partition in created collection.
 type CreatePartitionRequest struct {
 	// Not useful for now
@@ -1232,7 +1228,6 @@ func (m *CreatePartitionRequest) GetPartitionName() string {
 	return ""
 }
 
-//
 // Drop partition in created collection.
 type DropPartitionRequest struct {
 	// Not useful for now
@@ -1301,7 +1296,6 @@ func (m *DropPartitionRequest) GetPartitionName() string {
 	return ""
 }
 
-//
 // Check if partition exist in collection or not.
 type HasPartitionRequest struct {
 	// Not useful for now
@@ -1370,7 +1364,6 @@ func (m *HasPartitionRequest) GetPartitionName() string {
 	return ""
 }
 
-//
 // Load specific partitions data of one collection into query nodes
 // Then you can get these data as result when you do vector search on this collection.
 type LoadPartitionsRequest struct {
@@ -1440,7 +1433,6 @@ func (m *LoadPartitionsRequest) GetPartitionNames() []string {
 	return nil
 }
 
-//
 // Release specific partitions data of one collection from query nodes.
 // Then you can not get these data as result when you do vector search on this collection.
 type ReleasePartitionsRequest struct {
@@ -1510,7 +1502,6 @@ func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
 	return nil
 }
 
-//
 // Get partition statistics like row_count.
 type GetPartitionStatisticsRequest struct {
 	// Not useful for now
@@ -1626,7 +1617,6 @@ func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	return nil
 }
 
-//
 // List all partitions for particular collection
 type ShowPartitionsRequest struct {
 	// Not useful for now
@@ -1713,7 +1703,6 @@ func (m *ShowPartitionsRequest) GetType() ShowType {
 	return ShowType_All
 }
 
-//
 // List all partitions for particular collection response.
 // The returned datas are all rows, we can format to columns by therir index.
 type ShowPartitionsResponse struct {
@@ -2021,7 +2010,6 @@ func (m *ShowSegmentsResponse) GetSegmentIDs() []int64 {
 	return nil
 }
 
-//
 // Create index for vector datas
 type CreateIndexRequest struct {
 	// Not useful for now
@@ -2099,7 +2087,6 @@ func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
-//
 // Get created index information.
 // Current release of Milvus only supports showing latest built index.
 type DescribeIndexRequest struct {
@@ -2178,7 +2165,6 @@ func (m *DescribeIndexRequest) GetIndexName() string {
 	return ""
 }
 
-//
 // Index informations
 type IndexDescription struct {
 	// Index name
@@ -2247,7 +2233,6 @@ func (m *IndexDescription) GetFieldName() string {
 	return ""
 }
 
-//
 // Describe index response
 type DescribeIndexResponse struct {
 	// Response status
@@ -2298,8 +2283,7 @@ func (m *DescribeIndexResponse) GetIndexDescriptions() []*IndexDescription {
 	return nil
 }
 
-//
-//  Get index building progress
+// Get index building progress
 type GetIndexBuildProgressRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -4398,7 +4382,6 @@ func (m *GetMetricsResponse) GetComponentName() string {
 	return ""
 }
 
-//
 // Do load balancing operation from src_nodeID to dst_nodeID.
 type LoadBalanceRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -4464,8 +4447,10 @@ func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
 }
 
 type ManualCompactionRequest struct {
-	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	Timetravel           uint64   `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	CollectionID int64  `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Timetravel   uint64 `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	// returns the plans proposed without executing them
+	DryRun               bool     `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4510,12 +4495,25 @@ func (m *ManualCompactionRequest) GetTimetravel() uint64 {
 	return 0
 }
 
+func (m *ManualCompactionRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type ManualCompactionResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	CompactionID         int64            `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Status       *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CompactionID int64            `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	// plans proposed in dry run, target is 0 since no segment is allocated
+	MergeInfos []*CompactionMergeInfo `protobuf:"bytes,3,rep,name=mergeInfos,proto3" json:"mergeInfos,omitempty"`
+	// size in bytes of the source segments of the plans proposed
+	SourceSize int64 `protobuf:"varint,4,opt,name=sourceSize,proto3" json:"sourceSize,omitempty"`
+	// estimated size in bytes of the target segments, with the deleted entities removed
+	EstimatedTargetSize  int64    `protobuf:"varint,5,opt,name=estimatedTargetSize,proto3" json:"estimatedTargetSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionResponse{} }
@@ -4557,6 +4555,27 @@ func (m *ManualCompactionResponse) GetCompactionID() int64 {
 	return 0
 }
 
+func (m *ManualCompactionResponse) GetMergeInfos() []*CompactionMergeInfo {
+	if m != nil {
+		return m.MergeInfos
+	}
+	return nil
+}
+
+func (m *ManualCompactionResponse) GetSourceSize() int64 {
+	if m != nil {
+		return m.SourceSize
+	}
+	return 0
+}
+
+func (m *ManualCompactionResponse) GetEstimatedTargetSize() int64 {
+	if m != nil {
+		return m.EstimatedTargetSize
+	}
+	return 0
+}
+
 type GetCompactionStateRequest struct {
 	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4891,226 +4910,228 @@ func init() {
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 3498 bytes of a gzipped FileDescriptorProto
+	// 3523 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4d, 0x73, 0x1b, 0xc7,
-	0xb1, 0x5c, 0x80, 0x24, 0x80, 0x06, 0x40, 0x42, 0x43, 0x8a, 0x82, 0xa0, 0x2f, 0x6a, 0x6d, 0x59,
-	0x94, 0x64, 0x49, 0x16, 0x65, 0x3f, 0xfb, 0xc9, 0xef, 0x3d, 0x5b, 0x12, 0x9f, 0x25, 0x96, 0x25,
-	0x3d, 0x7a, 0x69, 0xfb, 0x95, 0x9f, 0x4b, 0x85, 0x1a, 0x62, 0x47, 0xe0, 0x96, 0x16, 0xbb, 0xf0,
-	0xce, 0x40, 0x12, 0x7d, 0x7a, 0x55, 0x7e, 0x1f, 0x95, 0xb2, 0x63, 0x57, 0x2a, 0xa9, 0xa4, 0x52,
-	0xa9, 0xe4, 0x90, 0xc4, 0x87, 0xdc, 0xe2, 0x38, 0x95, 0xa4, 0x72, 0xca, 0xc1, 0x87, 0x1c, 0x52,
-	0x95, 0x8f, 0x4b, 0x0e, 0xb9, 0xe4, 0x0f, 0xf8, 0x1f, 0xe4, 0x90, 0x9a, 0x8f, 0x5d, 0xec, 0x2e,
-	0x66, 0x41, 0x50, 0xb0, 0x42, 0xf2, 0xb6, 0xd3, 0xd3, 0xdd, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3,
-	0xd3, 0x0b, 0x95, 0x8e, 0xe3, 0x3e, 0xe8, 0xd1, 0x0b, 0xdd, 0xc0, 0x67, 0x3e, 0x9a, 0x8b, 0xb7,
-	0x2e, 0xc8, 0x46, 0xa3, 0xd2, 0xf2, 0x3b, 0x1d, 0xdf, 0x93, 0xc0, 0x46, 0x85, 0xb6, 0x36, 0x49,
-	0x07, 0xcb, 0x96, 0xf9, 0x03, 0x03, 0xd0, 0xf5, 0x80, 0x60, 0x46, 0xae, 0xba, 0x0e, 0xa6, 0x16,
-	0x79, 0xaf, 0x47, 0x28, 0x43, 0xcf, 0xc1, 0xe4, 0x06, 0xa6, 0xa4, 0x6e, 0x2c, 0x1a, 0x4b, 0xe5,
-	0xe5, 0xa3, 0x17, 0x12, 0x6c, 0x15, 0xbb, 0xdb, 0xb4, 0x7d, 0x0d, 0x53, 0x62, 0x09, 0x4c, 0x74,
-	0x08, 0x0a, 0xf6, 0x46, 0xd3, 0xc3, 0x1d, 0x52, 0xcf, 0x2d, 0x1a, 0x4b, 0x25, 0x6b, 0xda, 0xde,
-	0xb8, 0x83, 0x3b, 0x04, 0x9d, 0x86, 0xd9, 0x96, 0xef, 0xba, 0xa4, 0xc5, 0x1c, 0xdf, 0x93, 0x08,
-	0x79, 0x81, 0x30, 0xd3, 0x07, 0x0b, 0xc4, 0x79, 0x98, 0xc2, 0x5c, 0x86, 0xfa, 0xa4, 0xe8, 0x96,
-	0x0d, 0x93, 0x42, 0x6d, 0x25, 0xf0, 0xbb, 0x4f, 0x4a, 0xba, 0x68, 0xd0, 0x7c, 0x7c, 0xd0, 0xef,
-	0x1b, 0x70, 0xe0, 0xaa, 0xcb, 0x48, 0xb0, 0x47, 0x95, 0xf2, 0x85, 0x01, 0x87, 0xe4, 0xaa, 0x5d,
-	0x8f, 0xd0, 0x77, 0x53, 0xca, 0x05, 0x98, 0x96, 0x56, 0x25, 0xc4, 0xac, 0x58, 0xaa, 0x85, 0x8e,
-	0x01, 0xd0, 0x4d, 0x1c, 0xd8, 0xb4, 0xe9, 0xf5, 0x3a, 0xf5, 0xa9, 0x45, 0x63, 0x69, 0xca, 0x2a,
-	0x49, 0xc8, 0x9d, 0x5e, 0xc7, 0xfc, 0xd0, 0x80, 0x83, 0x7c, 0x71, 0xf7, 0xc4, 0x24, 0xcc, 0x9f,
-	0x18, 0x30, 0x7f, 0x13, 0xd3, 0xbd, 0xa1, 0xd1, 0x63, 0x00, 0xcc, 0xe9, 0x90, 0x26, 0x65, 0xb8,
-	0xd3, 0x15, 0x5a, 0x9d, 0xb4, 0x4a, 0x1c, 0xb2, 0xce, 0x01, 0xe6, 0x3b, 0x50, 0xb9, 0xe6, 0xfb,
-	0xae, 0x45, 0x68, 0xd7, 0xf7, 0x28, 0x41, 0x97, 0x61, 0x9a, 0x32, 0xcc, 0x7a, 0x54, 0x09, 0x79,
-	0x44, 0x2b, 0xe4, 0xba, 0x40, 0xb1, 0x14, 0x2a, 0xb7, 0xad, 0x07, 0xd8, 0xed, 0x49, 0x19, 0x8b,
-	0x96, 0x6c, 0x98, 0xef, 0xc2, 0xcc, 0x3a, 0x0b, 0x1c, 0xaf, 0xfd, 0x15, 0x32, 0x2f, 0x85, 0xcc,
-	0xff, 0x64, 0xc0, 0xe1, 0x15, 0x42, 0x5b, 0x81, 0xb3, 0xb1, 0x47, 0x4c, 0xd7, 0x84, 0x4a, 0x1f,
-	0xb2, 0xba, 0x22, 0x54, 0x9d, 0xb7, 0x12, 0xb0, 0xd4, 0x62, 0x4c, 0xa5, 0x17, 0xe3, 0x83, 0x49,
-	0x68, 0xe8, 0x26, 0x35, 0x8e, 0xfa, 0xfe, 0x35, 0xda, 0x51, 0x39, 0x41, 0x74, 0x2a, 0x49, 0xa4,
-	0x7c, 0x78, 0x7f, 0xb4, 0x75, 0x01, 0x88, 0x36, 0x5e, 0x7a, 0x56, 0x79, 0xcd, 0xac, 0x96, 0xe1,
-	0xe0, 0x03, 0x27, 0x60, 0x3d, 0xec, 0x36, 0x5b, 0x9b, 0xd8, 0xf3, 0x88, 0x2b, 0xf4, 0xc4, 0x5d,
-	0x4d, 0x7e, 0xa9, 0x64, 0xcd, 0xa9, 0xce, 0xeb, 0xb2, 0x8f, 0x2b, 0x8b, 0xa2, 0xe7, 0x61, 0xa1,
-	0xbb, 0xb9, 0x45, 0x9d, 0xd6, 0x00, 0xd1, 0x94, 0x20, 0x9a, 0x0f, 0x7b, 0x13, 0x54, 0xe7, 0xe0,
-	0x40, 0x4b, 0x78, 0x2b, 0xbb, 0xc9, 0xb5, 0x26, 0xd5, 0x38, 0x2d, 0xd4, 0x58, 0x53, 0x1d, 0x6f,
-	0x86, 0x70, 0x2e, 0x56, 0x88, 0xdc, 0x63, 0xad, 0x18, 0x41, 0x41, 0x10, 0xcc, 0xa9, 0xce, 0xb7,
-	0x58, 0xab, 0x4f, 0x93, 0xf4, 0x33, 0xc5, 0x94, 0x9f, 0x41, 0x75, 0x28, 0x08, 0xbf, 0x49, 0x68,
-	0xbd, 0x24, 0xc4, 0x0c, 0x9b, 0x68, 0x15, 0x66, 0x29, 0xc3, 0x01, 0x6b, 0x76, 0x7d, 0xea, 0x70,
-	0xbd, 0xd0, 0x3a, 0x2c, 0xe6, 0x97, 0xca, 0xcb, 0x8b, 0xda, 0x45, 0x7a, 0x9d, 0x6c, 0xad, 0x60,
-	0x86, 0xd7, 0xb0, 0x13, 0x58, 0x33, 0x82, 0x70, 0x2d, 0xa4, 0x13, 0xce, 0xec, 0x96, 0x8f, 0xed,
-	0xbd, 0xe1, 0xcc, 0x3e, 0x36, 0xa0, 0x6e, 0x11, 0x97, 0x60, 0xba, 0x37, 0xf6, 0x99, 0xf9, 0x2d,
-	0x03, 0x8e, 0xdf, 0x20, 0x2c, 0x66, 0xb1, 0x0c, 0x33, 0x87, 0x32, 0xa7, 0xb5, 0x9b, 0xe7, 0xab,
-	0xf9, 0x89, 0x01, 0x27, 0x32, 0xc5, 0x1a, 0x67, 0x03, 0xbf, 0x08, 0x53, 0xfc, 0x8b, 0xd6, 0x73,
-	0xc2, 0x9e, 0x4e, 0x66, 0xd9, 0xd3, 0xdb, 0xdc, 0x2f, 0x0a, 0x83, 0x92, 0xf8, 0xe6, 0x5f, 0x0d,
-	0x58, 0x58, 0xdf, 0xf4, 0x1f, 0xf6, 0x45, 0x7a, 0x12, 0x0a, 0x4a, 0xba, 0xb4, 0x7c, 0xca, 0xa5,
-	0xa1, 0x4b, 0x30, 0xc9, 0xb6, 0xba, 0x44, 0x78, 0xc3, 0x99, 0xe5, 0x63, 0x17, 0x34, 0x61, 0xe5,
-	0x05, 0x2e, 0xe4, 0x9b, 0x5b, 0x5d, 0x62, 0x09, 0x54, 0x74, 0x06, 0x6a, 0x29, 0x95, 0x87, 0x4e,
-	0x61, 0x36, 0xa9, 0x73, 0x6a, 0xfe, 0x2a, 0x07, 0x87, 0x06, 0xa6, 0x38, 0x8e, 0xb2, 0x75, 0x63,
-	0xe7, 0xb4, 0x63, 0xa3, 0x53, 0x10, 0x33, 0x81, 0xa6, 0x63, 0xf3, 0xc8, 0x2f, 0xbf, 0x94, 0xb7,
-	0xaa, 0x31, 0xdf, 0x68, 0x53, 0x74, 0x1e, 0xd0, 0x80, 0xcb, 0x92, 0x9e, 0x71, 0xd2, 0x3a, 0x90,
-	0xf6, 0x59, 0xc2, 0x2f, 0x6a, 0x9d, 0x96, 0x54, 0xc1, 0xa4, 0x35, 0xaf, 0xf1, 0x5a, 0x14, 0x5d,
-	0x82, 0x79, 0xc7, 0xbb, 0x4d, 0x3a, 0x7e, 0xb0, 0xd5, 0xec, 0x92, 0xa0, 0x45, 0x3c, 0x86, 0xdb,
-	0x84, 0xd6, 0xa7, 0x85, 0x44, 0x73, 0x61, 0xdf, 0x5a, 0xbf, 0xcb, 0xfc, 0xdc, 0x80, 0x05, 0x19,
-	0xf9, 0xad, 0xe1, 0x80, 0x39, 0xbb, 0x7d, 0x7a, 0x9e, 0x82, 0x99, 0x6e, 0x28, 0x87, 0xc4, 0x93,
-	0x71, 0x6a, 0x35, 0x82, 0x8a, 0x5d, 0xf6, 0x99, 0x01, 0xf3, 0x3c, 0xd0, 0xdb, 0x4f, 0x32, 0xff,
-	0xd4, 0x80, 0xb9, 0x9b, 0x98, 0xee, 0x27, 0x91, 0x7f, 0xae, 0x8e, 0xa0, 0x48, 0xe6, 0x5d, 0xbd,
-	0xba, 0x9c, 0x86, 0xd9, 0xa4, 0xd0, 0x61, 0x64, 0x31, 0x93, 0x90, 0x9a, 0x9a, 0xbf, 0xec, 0x9f,
-	0x55, 0xfb, 0x4c, 0xf2, 0x5f, 0x1b, 0x70, 0xec, 0x06, 0x61, 0x91, 0xd4, 0x7b, 0xe2, 0x4c, 0x1b,
-	0xd5, 0x5a, 0x3e, 0x96, 0x27, 0xb2, 0x56, 0xf8, 0x5d, 0x39, 0xf9, 0x3e, 0xcc, 0xc1, 0x41, 0x7e,
-	0x2c, 0xec, 0x0d, 0x23, 0x18, 0xe5, 0x62, 0xa0, 0x31, 0x94, 0x29, 0x9d, 0xa1, 0x44, 0xe7, 0xe9,
-	0xf4, 0xc8, 0xe7, 0xa9, 0xf9, 0xb3, 0x9c, 0x8c, 0x03, 0xe2, 0xda, 0x18, 0x67, 0x59, 0x34, 0xb2,
-	0xe6, 0xb4, 0xb2, 0x9a, 0x50, 0x89, 0x20, 0xab, 0x2b, 0xe1, 0xf9, 0x98, 0x80, 0xed, 0xd9, 0xe3,
-	0xf1, 0x23, 0x03, 0x16, 0xc2, 0xab, 0xd8, 0x3a, 0x69, 0x77, 0x88, 0xc7, 0x1e, 0xdf, 0x86, 0xd2,
-	0x16, 0x90, 0xd3, 0x58, 0xc0, 0x51, 0x28, 0x51, 0x39, 0x4e, 0x74, 0xcb, 0xea, 0x03, 0xcc, 0x4f,
-	0x0d, 0x38, 0x34, 0x20, 0xce, 0x38, 0x8b, 0x58, 0x87, 0x82, 0xe3, 0xd9, 0xe4, 0x51, 0x24, 0x4d,
-	0xd8, 0xe4, 0x3d, 0x1b, 0x3d, 0xc7, 0xb5, 0x23, 0x31, 0xc2, 0x26, 0x3a, 0x09, 0x15, 0xe2, 0xe1,
-	0x0d, 0x97, 0x34, 0x05, 0xae, 0x30, 0xe4, 0xa2, 0x55, 0x96, 0xb0, 0x55, 0x0e, 0x32, 0xbf, 0x6e,
-	0xc0, 0x1c, 0xb7, 0x35, 0x25, 0x23, 0x7d, 0xb2, 0x3a, 0x5b, 0x84, 0x72, 0xcc, 0x98, 0x94, 0xb8,
-	0x71, 0x90, 0x79, 0x1f, 0xe6, 0x93, 0xe2, 0x8c, 0xa3, 0xb3, 0xe3, 0x00, 0xd1, 0x8a, 0x48, 0x9b,
-	0xcf, 0x5b, 0x31, 0x88, 0xf9, 0x65, 0x94, 0x02, 0x15, 0xca, 0xd8, 0xe5, 0xac, 0xcf, 0x3d, 0x87,
-	0xb8, 0x76, 0xdc, 0x6b, 0x97, 0x04, 0x44, 0x74, 0xaf, 0x40, 0x85, 0x3c, 0x62, 0x01, 0x6e, 0x76,
-	0x71, 0x80, 0x3b, 0x72, 0xf3, 0x8c, 0xe4, 0x60, 0xcb, 0x82, 0x6c, 0x4d, 0x50, 0x99, 0xbf, 0xe5,
-	0xc1, 0x98, 0x32, 0xca, 0xbd, 0x3e, 0xe3, 0x63, 0x00, 0xc2, 0x68, 0x65, 0xf7, 0x94, 0xec, 0x16,
-	0x10, 0x71, 0x84, 0x7d, 0x6a, 0x40, 0x4d, 0x4c, 0x41, 0xce, 0xa7, 0xcb, 0xd9, 0xa6, 0x68, 0x8c,
-	0x14, 0xcd, 0x90, 0x2d, 0xf4, 0xcf, 0x30, 0xad, 0x14, 0x9b, 0x1f, 0x55, 0xb1, 0x8a, 0x60, 0x9b,
-	0x69, 0x98, 0x3f, 0x34, 0xe0, 0x60, 0x4a, 0xe5, 0xe3, 0x58, 0xf4, 0x9b, 0x80, 0xe4, 0x0c, 0xed,
-	0xfe, 0xb4, 0xc3, 0xe3, 0xf6, 0x94, 0xf6, 0x6c, 0x49, 0x2b, 0xc9, 0x3a, 0xe0, 0xa4, 0x20, 0xd4,
-	0xfc, 0x83, 0x01, 0x47, 0x6f, 0x10, 0x26, 0x50, 0xaf, 0x71, 0xdf, 0xb1, 0x16, 0xf8, 0xed, 0x80,
-	0x50, 0xba, 0x7f, 0xed, 0xe3, 0xdb, 0x32, 0x3e, 0xd3, 0x4d, 0x69, 0x1c, 0xfd, 0x9f, 0x84, 0x8a,
-	0x18, 0x83, 0xd8, 0xcd, 0xc0, 0x7f, 0x48, 0x95, 0x1d, 0x95, 0x15, 0xcc, 0xf2, 0x1f, 0x0a, 0x83,
-	0x60, 0x3e, 0xc3, 0xae, 0x44, 0x50, 0x07, 0x83, 0x80, 0xf0, 0x6e, 0xb1, 0x07, 0x43, 0xc1, 0x38,
-	0x73, 0xb2, 0x7f, 0x75, 0xfc, 0x63, 0x03, 0x0e, 0xa6, 0xa6, 0x32, 0x8e, 0x6e, 0x5f, 0x90, 0xd1,
-	0xa3, 0x9c, 0xcc, 0xcc, 0xf2, 0x09, 0x2d, 0x4d, 0x6c, 0x30, 0x89, 0x8d, 0x4e, 0x40, 0xf9, 0x1e,
-	0x76, 0xdc, 0x66, 0x40, 0x30, 0xf5, 0x3d, 0x35, 0x51, 0xe0, 0x20, 0x4b, 0x40, 0xcc, 0x2f, 0x0c,
-	0xf9, 0x90, 0xb4, 0xcf, 0x3d, 0xde, 0x8f, 0x72, 0x50, 0x5d, 0xf5, 0x28, 0x09, 0xd8, 0xde, 0xbf,
-	0x61, 0xa0, 0x57, 0xa0, 0x2c, 0x26, 0x46, 0x9b, 0x36, 0x66, 0x58, 0x1d, 0x57, 0xc7, 0xb5, 0x99,
-	0xec, 0xd7, 0x38, 0xde, 0x0a, 0x66, 0xd8, 0x92, 0xda, 0xa1, 0xfc, 0x1b, 0x1d, 0x81, 0xd2, 0x26,
-	0xa6, 0x9b, 0xcd, 0xfb, 0x64, 0x4b, 0x86, 0x7d, 0x55, 0xab, 0xc8, 0x01, 0xaf, 0x93, 0x2d, 0x8a,
-	0x0e, 0x43, 0xd1, 0xeb, 0x75, 0xe4, 0x06, 0x2b, 0x2c, 0x1a, 0x4b, 0x55, 0xab, 0xe0, 0xf5, 0x3a,
-	0x62, 0x7b, 0xfd, 0x2e, 0x07, 0x33, 0xb7, 0x7b, 0xfc, 0x3e, 0x23, 0xf2, 0xf0, 0x3d, 0x97, 0x3d,
-	0x9e, 0x31, 0x9e, 0x85, 0xbc, 0x8c, 0x19, 0x38, 0x45, 0x5d, 0x2b, 0xf8, 0xea, 0x0a, 0xb5, 0x38,
-	0x92, 0xc8, 0x41, 0xf7, 0x5a, 0x2d, 0x15, 0x64, 0xe5, 0x85, 0xb0, 0x25, 0x0e, 0x11, 0x16, 0xc7,
-	0xa7, 0x42, 0x82, 0x20, 0x0a, 0xc1, 0xc4, 0x54, 0x48, 0x10, 0xc8, 0x4e, 0x13, 0x2a, 0xb8, 0x75,
-	0xdf, 0xf3, 0x1f, 0xba, 0xc4, 0x6e, 0x13, 0x5b, 0x2c, 0x7b, 0xd1, 0x4a, 0xc0, 0xa4, 0x61, 0xf0,
-	0x85, 0x6f, 0xb6, 0x3c, 0x26, 0x2e, 0x12, 0x79, 0x6e, 0x18, 0x1c, 0x72, 0xdd, 0x63, 0xbc, 0xdb,
-	0x26, 0x2e, 0x61, 0x44, 0x74, 0x17, 0x64, 0xb7, 0x84, 0xa8, 0xee, 0x5e, 0x37, 0xa2, 0x2e, 0xca,
-	0x6e, 0x09, 0xe1, 0xdd, 0x47, 0xa1, 0xd4, 0x4f, 0xb4, 0x97, 0xfa, 0xd9, 0x40, 0x01, 0x30, 0xff,
-	0x62, 0x40, 0x75, 0x45, 0xb0, 0xda, 0x07, 0x46, 0x87, 0x60, 0x92, 0x3c, 0xea, 0x06, 0x6a, 0xeb,
-	0x88, 0xef, 0xa1, 0x76, 0x64, 0x3e, 0x80, 0xda, 0x9a, 0x8b, 0x5b, 0x64, 0xd3, 0x77, 0x6d, 0x12,
-	0x88, 0xb3, 0x1d, 0xd5, 0x20, 0xcf, 0x70, 0x5b, 0x05, 0x0f, 0xfc, 0x13, 0xbd, 0xa4, 0x6e, 0x70,
-	0xd2, 0x2d, 0x3d, 0xad, 0x3d, 0x65, 0x63, 0x6c, 0x62, 0x89, 0xd1, 0x05, 0x98, 0x16, 0x8f, 0x5f,
-	0x32, 0xac, 0xa8, 0x58, 0xaa, 0x65, 0xde, 0x4d, 0x8c, 0x7b, 0x23, 0xf0, 0x7b, 0x5d, 0xb4, 0x0a,
-	0x95, 0x6e, 0x1f, 0xc6, 0x6d, 0x35, 0xfb, 0x4c, 0x4f, 0x0b, 0x6d, 0x25, 0x48, 0xcd, 0x2f, 0xf3,
-	0x50, 0x5d, 0x27, 0x38, 0x68, 0x6d, 0xee, 0x87, 0x54, 0x0a, 0xd7, 0xb8, 0x4d, 0x5d, 0xb5, 0x6a,
-	0xfc, 0x13, 0x9d, 0x83, 0x03, 0xb1, 0x09, 0x35, 0xdb, 0x5c, 0x41, 0xc2, 0xee, 0x2b, 0x56, 0xad,
-	0x9b, 0x56, 0xdc, 0x8b, 0x50, 0xb4, 0xa9, 0xdb, 0x14, 0x4b, 0x54, 0x10, 0x4b, 0xa4, 0x9f, 0xdf,
-	0x0a, 0x75, 0xc5, 0xd2, 0x14, 0x6c, 0xf9, 0x81, 0x9e, 0x82, 0xaa, 0xdf, 0x63, 0xdd, 0x1e, 0x6b,
-	0x4a, 0xbf, 0x53, 0x2f, 0x0a, 0xf1, 0x2a, 0x12, 0x28, 0xdc, 0x12, 0x45, 0xaf, 0x41, 0x95, 0x0a,
-	0x55, 0x86, 0x91, 0x77, 0x69, 0xd4, 0x00, 0xb1, 0x22, 0xe9, 0x64, 0xe8, 0x8d, 0xce, 0x40, 0x8d,
-	0x05, 0xf8, 0x01, 0x71, 0x63, 0xcf, 0x5a, 0x20, 0x76, 0xdb, 0xac, 0x84, 0xf7, 0x9f, 0xb4, 0x2e,
-	0xc2, 0x5c, 0xbb, 0x87, 0x03, 0xec, 0x31, 0x42, 0x62, 0xd8, 0x65, 0x81, 0x8d, 0xa2, 0xae, 0x88,
-	0xc0, 0x7c, 0x1d, 0x26, 0x6f, 0x3a, 0x4c, 0x28, 0x92, 0xfb, 0x2c, 0x43, 0xdc, 0x73, 0x84, 0x67,
-	0x3a, 0x0c, 0xc5, 0xc0, 0x7f, 0x28, 0x7d, 0x70, 0x4e, 0x98, 0x60, 0x21, 0xf0, 0x1f, 0x0a, 0x07,
-	0x2b, 0x1e, 0xee, 0xfd, 0x40, 0xd9, 0x66, 0xce, 0x52, 0x2d, 0xf3, 0x7f, 0x8d, 0xbe, 0xf1, 0x70,
-	0xf7, 0x49, 0x1f, 0xcf, 0x7f, 0xbe, 0x02, 0x85, 0x40, 0xd2, 0x0f, 0x7d, 0xc6, 0x8c, 0x8f, 0x24,
-	0xce, 0x80, 0x90, 0xca, 0xfc, 0x1f, 0x03, 0x2a, 0xaf, 0xb9, 0x3d, 0xfa, 0x24, 0x6c, 0x58, 0xf7,
-	0x68, 0x90, 0xd7, 0x3f, 0x58, 0x7c, 0x23, 0x07, 0x55, 0x25, 0xc6, 0x38, 0xb1, 0x4d, 0xa6, 0x28,
-	0xeb, 0x50, 0xe6, 0x43, 0x36, 0x29, 0x69, 0x87, 0x19, 0x97, 0xf2, 0xf2, 0xb2, 0x76, 0xd7, 0x27,
-	0xc4, 0x10, 0x0f, 0xc0, 0xeb, 0x82, 0xe8, 0xdf, 0x3d, 0x16, 0x6c, 0x59, 0xd0, 0x8a, 0x00, 0x8d,
-	0xbb, 0x30, 0x9b, 0xea, 0xe6, 0xb6, 0x71, 0x9f, 0x6c, 0x85, 0x6e, 0xed, 0x3e, 0xd9, 0x42, 0xcf,
-	0xc7, 0x9f, 0xe9, 0xb3, 0x0e, 0xe7, 0x5b, 0xbe, 0xd7, 0xbe, 0x1a, 0x04, 0x78, 0x4b, 0x3d, 0xe3,
-	0x5f, 0xc9, 0xbd, 0x64, 0x98, 0xbf, 0xc9, 0x41, 0xe5, 0x8d, 0x1e, 0x09, 0xb6, 0x76, 0xd3, 0xbd,
-	0x84, 0xce, 0x7e, 0x32, 0xe6, 0xec, 0x07, 0x76, 0xf4, 0x94, 0x66, 0x47, 0x6b, 0xfc, 0xd2, 0xb4,
-	0xd6, 0x2f, 0xe9, 0xb6, 0x6c, 0x61, 0x47, 0x5b, 0xb6, 0x98, 0xb9, 0x65, 0xb9, 0x75, 0x2b, 0x15,
-	0x8e, 0xb5, 0xc9, 0x12, 0x51, 0x56, 0x6e, 0xa7, 0x51, 0x96, 0xf9, 0x99, 0x01, 0xa5, 0xb7, 0x49,
-	0x8b, 0xf9, 0x01, 0xf7, 0x16, 0x1a, 0xdd, 0x1b, 0x23, 0x04, 0xb2, 0xb9, 0x74, 0x20, 0x7b, 0x19,
-	0x8a, 0x8e, 0xdd, 0xc4, 0xdc, 0x6c, 0xc4, 0xe2, 0x0d, 0x0b, 0xa0, 0x0a, 0x8e, 0x2d, 0xec, 0x6b,
-	0xf4, 0xcc, 0xfb, 0x77, 0x0c, 0xa8, 0x48, 0x99, 0xa9, 0xa4, 0x7c, 0x39, 0x36, 0x9c, 0xa1, 0xb3,
-	0x65, 0xd5, 0x88, 0x26, 0x7a, 0x73, 0xa2, 0x3f, 0xec, 0x55, 0x00, 0xae, 0x3b, 0x45, 0x2e, 0xb7,
-	0xc2, 0xa2, 0x56, 0x5a, 0x49, 0x2e, 0xf4, 0x78, 0x73, 0xc2, 0x2a, 0x71, 0x2a, 0xc1, 0xe2, 0x5a,
-	0x01, 0xa6, 0x04, 0xb5, 0xf9, 0x37, 0x03, 0xe6, 0xae, 0x63, 0xb7, 0xb5, 0xe2, 0x50, 0x86, 0xbd,
-	0xd6, 0x18, 0x21, 0xd3, 0x15, 0x28, 0xf8, 0xdd, 0xa6, 0x4b, 0xee, 0x31, 0x25, 0xd2, 0xc9, 0x21,
-	0x33, 0x92, 0x6a, 0xb0, 0xa6, 0xfd, 0xee, 0x2d, 0x72, 0x8f, 0xa1, 0x7f, 0x81, 0xa2, 0xdf, 0x6d,
-	0x06, 0x4e, 0x7b, 0x93, 0x29, 0xed, 0x8f, 0x40, 0x5c, 0xf0, 0xbb, 0x16, 0xa7, 0x88, 0x65, 0x42,
-	0x26, 0x77, 0x98, 0x09, 0x31, 0xff, 0x38, 0x30, 0xfd, 0x31, 0x4c, 0xfb, 0x0a, 0x14, 0x1d, 0x8f,
-	0x35, 0x6d, 0x87, 0x86, 0x2a, 0x38, 0xa6, 0xb7, 0x21, 0x8f, 0x89, 0x19, 0x88, 0x35, 0xf5, 0x18,
-	0x1f, 0x1b, 0xbd, 0x0a, 0x70, 0xcf, 0xf5, 0xb1, 0xa2, 0x96, 0x3a, 0x38, 0xa1, 0xdf, 0x15, 0x1c,
-	0x2d, 0xa4, 0x2f, 0x09, 0x22, 0xce, 0xa1, 0xbf, 0xa4, 0xbf, 0x37, 0xe0, 0xe0, 0x1a, 0x09, 0xa8,
-	0x43, 0x19, 0xf1, 0x98, 0xca, 0x4a, 0xae, 0x7a, 0xf7, 0xfc, 0x64, 0xfa, 0xd7, 0x48, 0xa5, 0x7f,
-	0xbf, 0x9a, 0x64, 0x68, 0xe2, 0x9e, 0x23, 0x1f, 0x21, 0xc2, 0x7b, 0x4e, 0xf8, 0xd4, 0x22, 0xef,
-	0x89, 0x33, 0x19, 0xcb, 0xa4, 0xe4, 0x8d, 0x5f, 0x97, 0xcd, 0x6f, 0xca, 0xb2, 0x07, 0xed, 0xa4,
-	0x1e, 0xdf, 0x60, 0x17, 0x40, 0x39, 0xf0, 0x94, 0x3b, 0x7f, 0x06, 0x52, 0xbe, 0x23, 0xa3, 0x18,
-	0xe3, 0xbb, 0x06, 0x2c, 0x66, 0x4b, 0x35, 0xce, 0xc9, 0xfb, 0x2a, 0x4c, 0x39, 0xde, 0x3d, 0x3f,
-	0x4c, 0x92, 0x9d, 0xd5, 0x07, 0xd4, 0xda, 0x71, 0x25, 0xa1, 0xf9, 0x8b, 0x1c, 0xd4, 0x84, 0xaf,
-	0xde, 0x85, 0xe5, 0xef, 0x90, 0x4e, 0x93, 0x3a, 0xef, 0x93, 0x70, 0xf9, 0x3b, 0xa4, 0xb3, 0xee,
-	0xbc, 0x4f, 0x12, 0x96, 0x31, 0x95, 0xb4, 0x8c, 0x64, 0x1a, 0x61, 0x7a, 0x48, 0x12, 0xb4, 0x90,
-	0x4c, 0x82, 0x2e, 0xc0, 0xb4, 0xe7, 0xdb, 0x64, 0x75, 0x45, 0x5d, 0x12, 0x55, 0xab, 0x6f, 0x6a,
-	0xa5, 0x1d, 0x9a, 0xda, 0xc7, 0x06, 0x34, 0x6e, 0x10, 0x96, 0xd6, 0xdd, 0xee, 0x59, 0xd9, 0x27,
-	0x06, 0x1c, 0xd1, 0x0a, 0x34, 0x8e, 0x81, 0xbd, 0x9c, 0x34, 0x30, 0xfd, 0x8d, 0x6d, 0x60, 0x48,
-	0x65, 0x5b, 0x97, 0xa0, 0xb2, 0xd2, 0xeb, 0x74, 0xa2, 0x48, 0xea, 0x24, 0x54, 0x02, 0xf9, 0x29,
-	0x2f, 0x34, 0xf2, 0xfc, 0x2d, 0x2b, 0x18, 0xbf, 0xb6, 0x98, 0xe7, 0xa0, 0xaa, 0x48, 0x94, 0xd4,
-	0x0d, 0x28, 0x06, 0xea, 0x5b, 0xe1, 0x47, 0x6d, 0xf3, 0x20, 0xcc, 0x59, 0xa4, 0xcd, 0x4d, 0x3b,
-	0xb8, 0xe5, 0x78, 0xf7, 0xd5, 0x30, 0xe6, 0x07, 0x06, 0xcc, 0x27, 0xe1, 0x8a, 0xd7, 0x3f, 0x41,
-	0x01, 0xdb, 0x76, 0x40, 0x28, 0x1d, 0xba, 0x2c, 0x57, 0x25, 0x8e, 0x15, 0x22, 0xc7, 0x34, 0x97,
-	0x1b, 0x59, 0x73, 0x66, 0x13, 0x0e, 0xdc, 0x20, 0xec, 0x36, 0x61, 0xc1, 0x58, 0xcf, 0xe6, 0x75,
-	0x7e, 0xd5, 0x10, 0xc4, 0xca, 0x2c, 0xc2, 0xa6, 0xf9, 0x91, 0x01, 0x28, 0x3e, 0xc2, 0x38, 0xcb,
-	0x1c, 0xd7, 0x72, 0x2e, 0xa9, 0x65, 0x59, 0x59, 0xd4, 0xe9, 0xfa, 0x1e, 0xf1, 0x58, 0x3c, 0x66,
-	0xad, 0x46, 0x50, 0x61, 0x7e, 0x9f, 0x1b, 0x80, 0x6e, 0xf9, 0xd8, 0xbe, 0x86, 0xdd, 0xf1, 0xc2,
-	0x83, 0x63, 0x00, 0x34, 0x68, 0x35, 0xd5, 0x6e, 0xcd, 0x29, 0xef, 0x13, 0xb4, 0xee, 0xc8, 0x0d,
-	0x7b, 0x02, 0xca, 0x36, 0x65, 0xaa, 0x3b, 0x7c, 0xc5, 0x05, 0x9b, 0x32, 0xd9, 0x2f, 0xaa, 0x32,
-	0x29, 0xc1, 0x2e, 0xb1, 0x9b, 0xb1, 0xe7, 0xb1, 0x49, 0x81, 0x56, 0x93, 0x1d, 0xeb, 0xfd, 0x47,
-	0xb2, 0xbb, 0x70, 0xe8, 0x36, 0xf6, 0x7a, 0xd8, 0xbd, 0xee, 0x77, 0xba, 0x38, 0x51, 0x4d, 0x98,
-	0x76, 0x73, 0x86, 0xc6, 0xcd, 0x1d, 0x97, 0xe5, 0x66, 0x32, 0x62, 0x16, 0xb2, 0x4e, 0x5a, 0x31,
-	0x88, 0x49, 0xa1, 0x3e, 0xc8, 0x7e, 0x9c, 0x85, 0x12, 0x42, 0x85, 0xac, 0xe2, 0xbe, 0xb7, 0x0f,
-	0x33, 0x5f, 0x81, 0xc3, 0xa2, 0xf4, 0x2f, 0x04, 0x25, 0x12, 0xf1, 0x69, 0x06, 0x86, 0x86, 0xc1,
-	0xff, 0xe7, 0x84, 0x6b, 0x1b, 0xe0, 0x30, 0x8e, 0xe0, 0x57, 0x92, 0xf9, 0xef, 0xa7, 0xb5, 0x34,
-	0xe9, 0x11, 0x55, 0x12, 0x7c, 0x09, 0x66, 0xc9, 0x23, 0xd2, 0xea, 0x31, 0xc7, 0x6b, 0xaf, 0xb9,
-	0xd8, 0xbb, 0xe3, 0xab, 0x03, 0x25, 0x0d, 0x46, 0x4f, 0x43, 0x95, 0x6b, 0xdf, 0xef, 0x31, 0x85,
-	0x27, 0x4f, 0x96, 0x24, 0x90, 0xf3, 0xe3, 0xf3, 0x75, 0x09, 0x23, 0xb6, 0xc2, 0x93, 0xc7, 0x4c,
-	0x1a, 0x3c, 0xa0, 0x4a, 0x0e, 0xa6, 0x3b, 0x51, 0xe5, 0x9f, 0x8d, 0x94, 0x2a, 0x15, 0x87, 0xdd,
-	0x52, 0xe5, 0x4d, 0x80, 0x0e, 0x09, 0xda, 0x64, 0x55, 0x38, 0x75, 0x79, 0x21, 0x5f, 0xd2, 0x3a,
-	0xf5, 0x3e, 0x83, 0xdb, 0x21, 0x81, 0x15, 0xa3, 0x35, 0x6f, 0xc0, 0x9c, 0x06, 0x85, 0xfb, 0x2b,
-	0xea, 0xf7, 0x82, 0x16, 0x09, 0x53, 0x35, 0x61, 0x93, 0x9f, 0x6f, 0x0c, 0x07, 0x6d, 0xc2, 0x94,
-	0xd1, 0xaa, 0xd6, 0xd9, 0x93, 0x50, 0x0c, 0x4b, 0x44, 0x50, 0x01, 0xf2, 0x57, 0x5d, 0xb7, 0x36,
-	0x81, 0x2a, 0x50, 0x5c, 0x55, 0x75, 0x10, 0x35, 0xe3, 0xec, 0xbf, 0xc1, 0x6c, 0x2a, 0x07, 0x89,
-	0x8a, 0x30, 0x79, 0xc7, 0xf7, 0x48, 0x6d, 0x02, 0xd5, 0xa0, 0x72, 0xcd, 0xf1, 0x70, 0xb0, 0x25,
-	0x63, 0xfe, 0x9a, 0x8d, 0x66, 0xa1, 0x2c, 0x62, 0x5f, 0x05, 0x20, 0xcb, 0xdf, 0x3b, 0x0e, 0xd5,
-	0xdb, 0x62, 0x5a, 0xeb, 0x24, 0x78, 0xe0, 0xb4, 0x08, 0x6a, 0x42, 0x2d, 0xfd, 0xa3, 0x09, 0x7a,
-	0x56, 0xaf, 0x07, 0xfd, 0xff, 0x28, 0x8d, 0x61, 0x4b, 0x65, 0x4e, 0xa0, 0x77, 0x61, 0x26, 0xf9,
-	0x0b, 0x08, 0xd2, 0x07, 0x67, 0xda, 0xff, 0x44, 0xb6, 0x63, 0xde, 0x84, 0x6a, 0xe2, 0x8f, 0x0e,
-	0x74, 0x46, 0xcb, 0x5b, 0xf7, 0xd7, 0x47, 0x43, 0x7f, 0x5f, 0x8a, 0xff, 0x75, 0x21, 0xa5, 0x4f,
-	0xd6, 0x7c, 0x67, 0x48, 0xaf, 0x2d, 0x0c, 0xdf, 0x4e, 0x7a, 0x0c, 0x07, 0x06, 0x4a, 0xb8, 0xd1,
-	0x79, 0x2d, 0xff, 0xac, 0x52, 0xef, 0xed, 0x86, 0x78, 0x08, 0x68, 0xf0, 0xcf, 0x05, 0x74, 0x41,
-	0xbf, 0x02, 0x59, 0xff, 0x6d, 0x34, 0x2e, 0x8e, 0x8c, 0x1f, 0x29, 0xee, 0xff, 0x0c, 0x38, 0x94,
-	0x51, 0x77, 0x8d, 0x2e, 0x6b, 0xd9, 0x0d, 0x2f, 0x1e, 0x6f, 0x3c, 0xbf, 0x33, 0xa2, 0x48, 0x10,
-	0x0f, 0x66, 0x53, 0xa5, 0xc8, 0xe8, 0x5c, 0x66, 0x79, 0xd6, 0x60, 0x4d, 0x76, 0xe3, 0xd9, 0xd1,
-	0x90, 0xa3, 0xf1, 0xee, 0xc2, 0x6c, 0xaa, 0x7e, 0x37, 0x63, 0x3c, 0x7d, 0x95, 0xef, 0x76, 0x0b,
-	0xfa, 0x0e, 0x54, 0x13, 0x85, 0xb6, 0x19, 0x16, 0xaf, 0x2b, 0xc6, 0xdd, 0x8e, 0xf5, 0x5d, 0xa8,
-	0xc4, 0xeb, 0x61, 0xd1, 0x52, 0xd6, 0x5e, 0x1a, 0x60, 0xbc, 0x93, 0xad, 0xd4, 0x2f, 0x77, 0x1b,
-	0xb2, 0x95, 0x06, 0x2a, 0x04, 0x47, 0xdf, 0x4a, 0x31, 0xfe, 0x43, 0xb7, 0xd2, 0x8e, 0x87, 0xf8,
-	0xc0, 0x80, 0x05, 0x7d, 0x39, 0x25, 0x5a, 0xce, 0xb2, 0xcd, 0xec, 0xc2, 0xd1, 0xc6, 0xe5, 0x1d,
-	0xd1, 0x44, 0x5a, 0xbc, 0x0f, 0x33, 0xc9, 0xa2, 0xc1, 0x0c, 0x2d, 0x6a, 0xeb, 0x2c, 0x1b, 0xe7,
-	0x46, 0xc2, 0x8d, 0x06, 0x7b, 0x0b, 0xca, 0xb1, 0x7f, 0x47, 0xd1, 0xe9, 0x21, 0x76, 0x1c, 0xff,
-	0x91, 0x72, 0x3b, 0x4d, 0xbe, 0x01, 0xa5, 0xe8, 0x97, 0x4f, 0x74, 0x2a, 0xd3, 0x7e, 0x77, 0xc2,
-	0x72, 0x1d, 0xa0, 0xff, 0x3f, 0x27, 0x7a, 0x46, 0xcb, 0x73, 0xe0, 0x87, 0xcf, 0xed, 0x98, 0x46,
-	0xd3, 0x97, 0x8f, 0xb8, 0xc3, 0xa6, 0x1f, 0xaf, 0x3a, 0xd8, 0x8e, 0xed, 0x26, 0x54, 0x13, 0xb5,
-	0x42, 0x59, 0x5b, 0x58, 0x53, 0xc2, 0xd5, 0x38, 0x3b, 0x0a, 0x6a, 0xb4, 0x7e, 0x9b, 0x50, 0x4d,
-	0x54, 0x6e, 0x64, 0x8c, 0xa4, 0x2b, 0x54, 0xc9, 0x18, 0x49, 0x5b, 0x08, 0x62, 0x4e, 0xa0, 0xff,
-	0x8e, 0x15, 0x89, 0x24, 0x0a, 0x71, 0xd0, 0xa5, 0xa1, 0x7c, 0x74, 0x75, 0x48, 0x8d, 0xe5, 0x9d,
-	0x90, 0x44, 0x22, 0x28, 0xab, 0x92, 0x2a, 0xcd, 0xb6, 0xaa, 0x9d, 0xac, 0xd4, 0x3a, 0x4c, 0xcb,
-	0x5a, 0x0c, 0x64, 0x66, 0x54, 0x5d, 0xc5, 0x0a, 0x35, 0x1a, 0x4f, 0x69, 0x71, 0x92, 0x65, 0x0a,
-	0x92, 0xa9, 0x7c, 0x6b, 0xcf, 0x60, 0x9a, 0x78, 0x88, 0x1f, 0x95, 0xa9, 0x05, 0xd3, 0xf2, 0x91,
-	0x2d, 0x83, 0x69, 0xe2, 0xa1, 0xb8, 0x31, 0x1c, 0x47, 0xbe, 0xcc, 0x4d, 0xa0, 0x35, 0x98, 0x12,
-	0x8f, 0x51, 0xe8, 0xe4, 0xb0, 0x87, 0xaa, 0x61, 0x1c, 0x13, 0x6f, 0x59, 0xe6, 0x04, 0xfa, 0x0f,
-	0x98, 0x12, 0x29, 0x92, 0x0c, 0x8e, 0xf1, 0xd7, 0xa6, 0xc6, 0x50, 0x94, 0x50, 0x44, 0x1b, 0x2a,
-	0xf1, 0x5c, 0x74, 0xc6, 0x91, 0xa5, 0xc9, 0xd6, 0x37, 0x46, 0xc1, 0x0c, 0x47, 0xf9, 0x9a, 0x01,
-	0xf5, 0xac, 0xb4, 0x25, 0xca, 0x8c, 0x4b, 0x86, 0xe5, 0x5e, 0x1b, 0x2f, 0xec, 0x90, 0x2a, 0x52,
-	0xe1, 0xfb, 0x30, 0xa7, 0xc9, 0x6d, 0xa1, 0x8b, 0x59, 0xfc, 0x32, 0xd2, 0x72, 0x8d, 0xe7, 0x46,
-	0x27, 0x88, 0xc6, 0x5e, 0x83, 0x29, 0x91, 0x93, 0xca, 0x58, 0xbe, 0x78, 0x8a, 0x2b, 0xc3, 0x20,
-	0x12, 0x29, 0x2d, 0x73, 0x02, 0x11, 0xa8, 0xc4, 0x13, 0x54, 0x19, 0xeb, 0xa7, 0xc9, 0x6d, 0x35,
-	0xce, 0x8c, 0x80, 0x19, 0x0d, 0xd3, 0x04, 0xe8, 0x27, 0x88, 0x32, 0x4e, 0x87, 0x81, 0x1c, 0x55,
-	0xe3, 0xf4, 0xb6, 0x78, 0xf1, 0x83, 0x32, 0x96, 0xf2, 0xc9, 0x38, 0x29, 0x06, 0x93, 0x42, 0x23,
-	0x44, 0xef, 0x83, 0xe9, 0x87, 0x8c, 0xe8, 0x3d, 0x33, 0xd3, 0xd1, 0xb8, 0x38, 0x32, 0x7e, 0x34,
-	0x9f, 0xf7, 0xa0, 0x96, 0x4e, 0xd7, 0x64, 0xdc, 0x0a, 0x33, 0x92, 0x46, 0x8d, 0xf3, 0x23, 0x62,
-	0xc7, 0x4f, 0x90, 0x23, 0x83, 0x32, 0xfd, 0xa7, 0xc3, 0x36, 0x45, 0xa6, 0x60, 0x94, 0x59, 0xc7,
-	0x93, 0x12, 0xa3, 0xcc, 0x3a, 0x91, 0x82, 0x30, 0x27, 0x96, 0x7b, 0x50, 0x59, 0x0b, 0xfc, 0x47,
-	0x5b, 0xe1, 0xdd, 0xf8, 0x1f, 0x63, 0x9d, 0xd7, 0x5e, 0xf8, 0xaf, 0xcb, 0x6d, 0x87, 0x6d, 0xf6,
-	0x36, 0xf8, 0xfa, 0x5f, 0x94, 0xb8, 0xe7, 0x1d, 0x5f, 0x7d, 0x5d, 0x74, 0x3c, 0x46, 0x02, 0x0f,
-	0xbb, 0x17, 0x05, 0x2f, 0x05, 0xed, 0x6e, 0x6c, 0x4c, 0x8b, 0xf6, 0xe5, 0xbf, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x24, 0x45, 0x91, 0xd1, 0x21, 0x44, 0x00, 0x00,
+	0xb1, 0x5a, 0x80, 0x24, 0x80, 0x06, 0x40, 0x42, 0x43, 0x8a, 0x82, 0xa0, 0x2f, 0x6a, 0x6d, 0x59,
+	0x94, 0x64, 0x89, 0x16, 0x65, 0x3f, 0xfb, 0xc9, 0xef, 0x3d, 0x5b, 0x12, 0x9f, 0x25, 0x96, 0x25,
+	0x85, 0x5e, 0xd8, 0x4e, 0x39, 0x2e, 0xd7, 0xd6, 0x12, 0x3b, 0x02, 0xb7, 0xb4, 0xd8, 0x85, 0x77,
+	0x06, 0x92, 0xe0, 0x53, 0xaa, 0xec, 0x24, 0x95, 0x72, 0x62, 0x57, 0x2a, 0xa9, 0xa4, 0x52, 0xa9,
+	0xe4, 0x90, 0xc4, 0x87, 0xdc, 0x92, 0x38, 0x95, 0xa4, 0x72, 0xca, 0x21, 0x87, 0x1c, 0x52, 0x95,
+	0x8f, 0x4b, 0x0e, 0xb9, 0xe4, 0x0f, 0xf8, 0x1f, 0xe4, 0x90, 0x9a, 0x8f, 0x5d, 0xec, 0x2e, 0x66,
+	0x41, 0x50, 0xb0, 0x42, 0xf2, 0xb6, 0xd3, 0xd3, 0xdd, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3,
+	0x0b, 0x95, 0x8e, 0xe3, 0xde, 0xef, 0x91, 0x8b, 0xdd, 0xc0, 0xa7, 0x3e, 0x9a, 0x8f, 0xb7, 0x2e,
+	0x8a, 0x46, 0xa3, 0xd2, 0xf2, 0x3b, 0x1d, 0xdf, 0x13, 0xc0, 0x46, 0x85, 0xb4, 0xb6, 0x70, 0xc7,
+	0x12, 0x2d, 0xfd, 0x47, 0x1a, 0xa0, 0xeb, 0x01, 0xb6, 0x28, 0xbe, 0xea, 0x3a, 0x16, 0x31, 0xf0,
+	0xbb, 0x3d, 0x4c, 0x28, 0x7a, 0x06, 0xa6, 0x36, 0x2d, 0x82, 0xeb, 0xda, 0x92, 0xb6, 0x5c, 0x5e,
+	0x3d, 0x76, 0x31, 0xc1, 0x56, 0xb2, 0xbb, 0x4d, 0xda, 0xd7, 0x2c, 0x82, 0x0d, 0x8e, 0x89, 0x0e,
+	0x43, 0xc1, 0xde, 0x34, 0x3d, 0xab, 0x83, 0xeb, 0xb9, 0x25, 0x6d, 0xb9, 0x64, 0xcc, 0xd8, 0x9b,
+	0x77, 0xac, 0x0e, 0x46, 0x67, 0x60, 0xae, 0xe5, 0xbb, 0x2e, 0x6e, 0x51, 0xc7, 0xf7, 0x04, 0x42,
+	0x9e, 0x23, 0xcc, 0x0e, 0xc0, 0x1c, 0x71, 0x01, 0xa6, 0x2d, 0x26, 0x43, 0x7d, 0x8a, 0x77, 0x8b,
+	0x86, 0x4e, 0xa0, 0xb6, 0x16, 0xf8, 0xdd, 0xc7, 0x25, 0x5d, 0x34, 0x68, 0x3e, 0x3e, 0xe8, 0x0f,
+	0x35, 0x38, 0x78, 0xd5, 0xa5, 0x38, 0xd8, 0xa3, 0x4a, 0xf9, 0x83, 0x06, 0x87, 0xc5, 0xaa, 0x5d,
+	0x8f, 0xd0, 0x77, 0x53, 0xca, 0x45, 0x98, 0x11, 0x56, 0xc5, 0xc5, 0xac, 0x18, 0xb2, 0x85, 0x8e,
+	0x03, 0x90, 0x2d, 0x2b, 0xb0, 0x89, 0xe9, 0xf5, 0x3a, 0xf5, 0xe9, 0x25, 0x6d, 0x79, 0xda, 0x28,
+	0x09, 0xc8, 0x9d, 0x5e, 0x47, 0xff, 0x50, 0x83, 0x43, 0x6c, 0x71, 0xf7, 0xc4, 0x24, 0xf4, 0x9f,
+	0x69, 0xb0, 0x70, 0xd3, 0x22, 0x7b, 0x43, 0xa3, 0xc7, 0x01, 0xa8, 0xd3, 0xc1, 0x26, 0xa1, 0x56,
+	0xa7, 0xcb, 0xb5, 0x3a, 0x65, 0x94, 0x18, 0xa4, 0xc9, 0x00, 0xfa, 0x5b, 0x50, 0xb9, 0xe6, 0xfb,
+	0xae, 0x81, 0x49, 0xd7, 0xf7, 0x08, 0x46, 0x97, 0x61, 0x86, 0x50, 0x8b, 0xf6, 0x88, 0x14, 0xf2,
+	0xa8, 0x52, 0xc8, 0x26, 0x47, 0x31, 0x24, 0x2a, 0xb3, 0xad, 0xfb, 0x96, 0xdb, 0x13, 0x32, 0x16,
+	0x0d, 0xd1, 0xd0, 0xdf, 0x86, 0xd9, 0x26, 0x0d, 0x1c, 0xaf, 0xfd, 0x39, 0x32, 0x2f, 0x85, 0xcc,
+	0xff, 0xa6, 0xc1, 0x91, 0x35, 0x4c, 0x5a, 0x81, 0xb3, 0xb9, 0x47, 0x4c, 0x57, 0x87, 0xca, 0x00,
+	0xb2, 0xbe, 0xc6, 0x55, 0x9d, 0x37, 0x12, 0xb0, 0xd4, 0x62, 0x4c, 0xa7, 0x17, 0xe3, 0xfd, 0x29,
+	0x68, 0xa8, 0x26, 0x35, 0x89, 0xfa, 0xfe, 0x37, 0xda, 0x51, 0x39, 0x4e, 0x74, 0x3a, 0x49, 0x24,
+	0xfa, 0x2e, 0x0e, 0x46, 0x6b, 0x72, 0x40, 0xb4, 0xf1, 0xd2, 0xb3, 0xca, 0x2b, 0x66, 0xb5, 0x0a,
+	0x87, 0xee, 0x3b, 0x01, 0xed, 0x59, 0xae, 0xd9, 0xda, 0xb2, 0x3c, 0x0f, 0xbb, 0x5c, 0x4f, 0xcc,
+	0xd5, 0xe4, 0x97, 0x4b, 0xc6, 0xbc, 0xec, 0xbc, 0x2e, 0xfa, 0x98, 0xb2, 0x08, 0x7a, 0x16, 0x16,
+	0xbb, 0x5b, 0x7d, 0xe2, 0xb4, 0x86, 0x88, 0xa6, 0x39, 0xd1, 0x42, 0xd8, 0x9b, 0xa0, 0x3a, 0x0f,
+	0x07, 0x5b, 0xdc, 0x5b, 0xd9, 0x26, 0xd3, 0x9a, 0x50, 0xe3, 0x0c, 0x57, 0x63, 0x4d, 0x76, 0xbc,
+	0x1e, 0xc2, 0x99, 0x58, 0x21, 0x72, 0x8f, 0xb6, 0x62, 0x04, 0x05, 0x4e, 0x30, 0x2f, 0x3b, 0xdf,
+	0xa0, 0xad, 0x01, 0x4d, 0xd2, 0xcf, 0x14, 0x53, 0x7e, 0x06, 0xd5, 0xa1, 0xc0, 0xfd, 0x26, 0x26,
+	0xf5, 0x12, 0x17, 0x33, 0x6c, 0xa2, 0x75, 0x98, 0x23, 0xd4, 0x0a, 0xa8, 0xd9, 0xf5, 0x89, 0xc3,
+	0xf4, 0x42, 0xea, 0xb0, 0x94, 0x5f, 0x2e, 0xaf, 0x2e, 0x29, 0x17, 0xe9, 0x55, 0xdc, 0x5f, 0xb3,
+	0xa8, 0xb5, 0x61, 0x39, 0x81, 0x31, 0xcb, 0x09, 0x37, 0x42, 0x3a, 0xee, 0xcc, 0x6e, 0xf9, 0x96,
+	0xbd, 0x37, 0x9c, 0xd9, 0x47, 0x1a, 0xd4, 0x0d, 0xec, 0x62, 0x8b, 0xec, 0x8d, 0x7d, 0xa6, 0x7f,
+	0x47, 0x83, 0x13, 0x37, 0x30, 0x8d, 0x59, 0x2c, 0xb5, 0xa8, 0x43, 0xa8, 0xd3, 0xda, 0xcd, 0xf3,
+	0x55, 0xff, 0x58, 0x83, 0x93, 0x99, 0x62, 0x4d, 0xb2, 0x81, 0x9f, 0x87, 0x69, 0xf6, 0x45, 0xea,
+	0x39, 0x6e, 0x4f, 0xa7, 0xb2, 0xec, 0xe9, 0x4d, 0xe6, 0x17, 0xb9, 0x41, 0x09, 0x7c, 0xfd, 0x9f,
+	0x1a, 0x2c, 0x36, 0xb7, 0xfc, 0x07, 0x03, 0x91, 0x1e, 0x87, 0x82, 0x92, 0x2e, 0x2d, 0x9f, 0x72,
+	0x69, 0xe8, 0x12, 0x4c, 0xd1, 0x7e, 0x17, 0x73, 0x6f, 0x38, 0xbb, 0x7a, 0xfc, 0xa2, 0x22, 0xac,
+	0xbc, 0xc8, 0x84, 0x7c, 0xbd, 0xdf, 0xc5, 0x06, 0x47, 0x45, 0x67, 0xa1, 0x96, 0x52, 0x79, 0xe8,
+	0x14, 0xe6, 0x92, 0x3a, 0x27, 0xfa, 0x6f, 0x73, 0x70, 0x78, 0x68, 0x8a, 0x93, 0x28, 0x5b, 0x35,
+	0x76, 0x4e, 0x39, 0x36, 0x3a, 0x0d, 0x31, 0x13, 0x30, 0x1d, 0x9b, 0x45, 0x7e, 0xf9, 0xe5, 0xbc,
+	0x51, 0x1d, 0x40, 0xd7, 0x6d, 0x82, 0x2e, 0x00, 0x1a, 0x72, 0x59, 0xc2, 0x33, 0x4e, 0x19, 0x07,
+	0xd3, 0x3e, 0x8b, 0xfb, 0x45, 0xa5, 0xd3, 0x12, 0x2a, 0x98, 0x32, 0x16, 0x14, 0x5e, 0x8b, 0xa0,
+	0x4b, 0xb0, 0xe0, 0x78, 0xb7, 0x71, 0xc7, 0x0f, 0xfa, 0x66, 0x17, 0x07, 0x2d, 0xec, 0x51, 0xab,
+	0x8d, 0x49, 0x7d, 0x86, 0x4b, 0x34, 0x1f, 0xf6, 0x6d, 0x0c, 0xba, 0xf4, 0x4f, 0x35, 0x58, 0x14,
+	0x91, 0xdf, 0x86, 0x15, 0x50, 0x67, 0xb7, 0x4f, 0xcf, 0xd3, 0x30, 0xdb, 0x0d, 0xe5, 0x10, 0x78,
+	0x22, 0x4e, 0xad, 0x46, 0x50, 0xbe, 0xcb, 0x7e, 0xa1, 0xc1, 0x02, 0x0b, 0xf4, 0xf6, 0x93, 0xcc,
+	0x3f, 0xd7, 0x60, 0xfe, 0xa6, 0x45, 0xf6, 0x93, 0xc8, 0xbf, 0x92, 0x47, 0x50, 0x24, 0xf3, 0xae,
+	0x5e, 0x5d, 0xce, 0xc0, 0x5c, 0x52, 0xe8, 0x30, 0xb2, 0x98, 0x4d, 0x48, 0x4d, 0xf4, 0xdf, 0x0c,
+	0xce, 0xaa, 0x7d, 0x26, 0xf9, 0xef, 0x34, 0x38, 0x7e, 0x03, 0xd3, 0x48, 0xea, 0x3d, 0x71, 0xa6,
+	0x8d, 0x6b, 0x2d, 0x1f, 0x89, 0x13, 0x59, 0x29, 0xfc, 0xae, 0x9c, 0x7c, 0x1f, 0xe6, 0xe0, 0x10,
+	0x3b, 0x16, 0xf6, 0x86, 0x11, 0x8c, 0x73, 0x31, 0x50, 0x18, 0xca, 0xb4, 0xca, 0x50, 0xa2, 0xf3,
+	0x74, 0x66, 0xec, 0xf3, 0x54, 0xff, 0x65, 0x0e, 0x16, 0xd3, 0xda, 0x98, 0x64, 0x59, 0x14, 0xb2,
+	0xe6, 0x94, 0xb2, 0xea, 0x50, 0x89, 0x20, 0xeb, 0x6b, 0xe1, 0xf9, 0x98, 0x80, 0xed, 0xd9, 0xe3,
+	0xf1, 0x1b, 0x1a, 0x2c, 0x86, 0x57, 0xb1, 0x26, 0x6e, 0x77, 0xb0, 0x47, 0x1f, 0xdd, 0x86, 0xd2,
+	0x16, 0x90, 0x53, 0x58, 0xc0, 0x31, 0x28, 0x11, 0x31, 0x4e, 0x74, 0xcb, 0x1a, 0x00, 0xf4, 0x4f,
+	0x34, 0x38, 0x3c, 0x24, 0xce, 0x24, 0x8b, 0x58, 0x87, 0x82, 0xe3, 0xd9, 0xf8, 0x61, 0x24, 0x4d,
+	0xd8, 0x64, 0x3d, 0x9b, 0x3d, 0xc7, 0xb5, 0x23, 0x31, 0xc2, 0x26, 0x3a, 0x05, 0x15, 0xec, 0x59,
+	0x9b, 0x2e, 0x36, 0x39, 0x2e, 0x37, 0xe4, 0xa2, 0x51, 0x16, 0xb0, 0x75, 0x06, 0xd2, 0xbf, 0xa9,
+	0xc1, 0x3c, 0xb3, 0x35, 0x29, 0x23, 0x79, 0xbc, 0x3a, 0x5b, 0x82, 0x72, 0xcc, 0x98, 0xa4, 0xb8,
+	0x71, 0x90, 0x7e, 0x0f, 0x16, 0x92, 0xe2, 0x4c, 0xa2, 0xb3, 0x13, 0x00, 0xd1, 0x8a, 0x08, 0x9b,
+	0xcf, 0x1b, 0x31, 0x88, 0xfe, 0x59, 0x94, 0x02, 0xe5, 0xca, 0xd8, 0xe5, 0xac, 0xcf, 0x5d, 0x07,
+	0xbb, 0x76, 0xdc, 0x6b, 0x97, 0x38, 0x84, 0x77, 0xaf, 0x41, 0x05, 0x3f, 0xa4, 0x81, 0x65, 0x76,
+	0xad, 0xc0, 0xea, 0x88, 0xcd, 0x33, 0x96, 0x83, 0x2d, 0x73, 0xb2, 0x0d, 0x4e, 0xa5, 0xff, 0x91,
+	0x05, 0x63, 0xd2, 0x28, 0xf7, 0xfa, 0x8c, 0x8f, 0x03, 0x70, 0xa3, 0x15, 0xdd, 0xd3, 0xa2, 0x9b,
+	0x43, 0xf8, 0x11, 0xf6, 0x89, 0x06, 0x35, 0x3e, 0x05, 0x31, 0x9f, 0x2e, 0x63, 0x9b, 0xa2, 0xd1,
+	0x52, 0x34, 0x23, 0xb6, 0xd0, 0x7f, 0xc3, 0x8c, 0x54, 0x6c, 0x7e, 0x5c, 0xc5, 0x4a, 0x82, 0x6d,
+	0xa6, 0xa1, 0xff, 0x98, 0x25, 0x3a, 0x93, 0x2a, 0x9f, 0xc4, 0xa2, 0x5f, 0x07, 0x24, 0x66, 0x68,
+	0x0f, 0xa6, 0x1d, 0x1e, 0xb7, 0xa7, 0x95, 0x67, 0x4b, 0x5a, 0x49, 0xc6, 0x41, 0x27, 0x05, 0x21,
+	0xfa, 0x5f, 0x34, 0x38, 0x76, 0x03, 0x53, 0x8e, 0x7a, 0x8d, 0xf9, 0x8e, 0x8d, 0xc0, 0x6f, 0x07,
+	0x98, 0x90, 0xfd, 0x6b, 0x1f, 0xdf, 0x15, 0xf1, 0x99, 0x6a, 0x4a, 0x93, 0xe8, 0xff, 0x14, 0x54,
+	0xf8, 0x18, 0xd8, 0x36, 0x03, 0xff, 0x01, 0x91, 0x76, 0x54, 0x96, 0x30, 0xc3, 0x7f, 0xc0, 0x0d,
+	0x82, 0xfa, 0xd4, 0x72, 0x05, 0x82, 0x3c, 0x18, 0x38, 0x84, 0x75, 0xf3, 0x3d, 0x18, 0x0a, 0xc6,
+	0x98, 0xe3, 0xfd, 0xab, 0xe3, 0x9f, 0x6a, 0x70, 0x28, 0x35, 0x95, 0x49, 0x74, 0xfb, 0x9c, 0x88,
+	0x1e, 0xc5, 0x64, 0x66, 0x57, 0x4f, 0x2a, 0x69, 0x62, 0x83, 0x09, 0x6c, 0x74, 0x12, 0xca, 0x77,
+	0x2d, 0xc7, 0x35, 0x03, 0x6c, 0x11, 0xdf, 0x93, 0x13, 0x05, 0x06, 0x32, 0x38, 0x84, 0x3d, 0x99,
+	0xf0, 0x87, 0xa4, 0x7d, 0xee, 0xf1, 0x7e, 0x92, 0x83, 0xea, 0xba, 0x47, 0x70, 0x40, 0xf7, 0xfe,
+	0x0d, 0x03, 0xbd, 0x04, 0x65, 0x3e, 0x31, 0x62, 0xda, 0x16, 0xb5, 0xe4, 0x71, 0x75, 0x42, 0x99,
+	0xc9, 0x7e, 0x85, 0xe1, 0xb1, 0xdc, 0xaa, 0x21, 0xb4, 0x43, 0xd8, 0x37, 0x3a, 0x0a, 0xa5, 0x2d,
+	0x8b, 0x6c, 0x99, 0xf7, 0x70, 0x5f, 0x84, 0x7d, 0x55, 0xa3, 0xc8, 0x00, 0xaf, 0xe2, 0x3e, 0x41,
+	0x47, 0xa0, 0xe8, 0xf5, 0x3a, 0x62, 0x83, 0xb1, 0xdc, 0x70, 0xd5, 0x28, 0x78, 0xbd, 0x0e, 0xdf,
+	0x5e, 0x7f, 0xca, 0xc1, 0xec, 0xed, 0x1e, 0xb5, 0x64, 0x1e, 0xbe, 0xe7, 0xd2, 0x47, 0x33, 0xc6,
+	0x73, 0x90, 0x17, 0x31, 0x03, 0xa3, 0xa8, 0x2b, 0x05, 0x5f, 0x5f, 0x23, 0x06, 0x43, 0x62, 0x0b,
+	0x47, 0x7a, 0xad, 0x96, 0x0c, 0xb2, 0xf2, 0x5c, 0xd8, 0x12, 0x83, 0x70, 0x8b, 0x63, 0x53, 0xc1,
+	0x41, 0x10, 0x85, 0x60, 0x7c, 0x2a, 0x38, 0x08, 0x44, 0xa7, 0x0e, 0x15, 0xab, 0x75, 0xcf, 0xf3,
+	0x1f, 0xb8, 0xd8, 0x6e, 0x63, 0x9b, 0x2f, 0x7b, 0xd1, 0x48, 0xc0, 0x84, 0x61, 0xb0, 0x85, 0x37,
+	0x5b, 0x1e, 0xe5, 0x17, 0x89, 0xbc, 0x51, 0x12, 0x90, 0xeb, 0x1e, 0x65, 0xdd, 0x36, 0x76, 0x31,
+	0xc5, 0xbc, 0xbb, 0x20, 0xba, 0x05, 0x44, 0x76, 0xf7, 0xba, 0x11, 0x75, 0x51, 0x74, 0x0b, 0x08,
+	0xeb, 0x3e, 0x06, 0xa5, 0x41, 0xa2, 0xbd, 0x34, 0xc8, 0x06, 0x72, 0x80, 0xfe, 0x0f, 0x0d, 0xaa,
+	0x6b, 0x9c, 0xd5, 0x3e, 0x30, 0x3a, 0x04, 0x53, 0xf8, 0x61, 0x37, 0x90, 0x5b, 0x87, 0x7f, 0x8f,
+	0xb4, 0x23, 0xfd, 0x3e, 0xd4, 0x36, 0x5c, 0xab, 0x85, 0xb7, 0x7c, 0xd7, 0xc6, 0x01, 0x3f, 0xdb,
+	0x51, 0x0d, 0xf2, 0xd4, 0x6a, 0xcb, 0xe0, 0x81, 0x7d, 0xa2, 0x17, 0xe4, 0x0d, 0x4e, 0xb8, 0xa5,
+	0x27, 0x95, 0xa7, 0x6c, 0x8c, 0x4d, 0x2c, 0x31, 0xba, 0x08, 0x33, 0xfc, 0xf1, 0x4b, 0x84, 0x15,
+	0x15, 0x43, 0xb6, 0xf4, 0x77, 0x12, 0xe3, 0xde, 0x08, 0xfc, 0x5e, 0x17, 0xad, 0x43, 0xa5, 0x3b,
+	0x80, 0x31, 0x5b, 0xcd, 0x3e, 0xd3, 0xd3, 0x42, 0x1b, 0x09, 0x52, 0xfd, 0xb3, 0x3c, 0x54, 0x9b,
+	0xd8, 0x0a, 0x5a, 0x5b, 0xfb, 0x21, 0x95, 0xc2, 0x34, 0x6e, 0x13, 0x57, 0xae, 0x1a, 0xfb, 0x64,
+	0xaf, 0x46, 0xb1, 0x09, 0x99, 0x6d, 0xa6, 0x20, 0x6e, 0xf7, 0x15, 0xa3, 0xd6, 0x4d, 0x2b, 0xee,
+	0x79, 0x28, 0xda, 0xc4, 0x35, 0xf9, 0x12, 0x15, 0xf8, 0x12, 0xa9, 0xe7, 0xb7, 0x46, 0x5c, 0xbe,
+	0x34, 0x05, 0x5b, 0x7c, 0xa0, 0x27, 0xa0, 0xea, 0xf7, 0x68, 0xb7, 0x47, 0x4d, 0xe1, 0x77, 0xea,
+	0x45, 0x2e, 0x5e, 0x45, 0x00, 0xb9, 0x5b, 0x22, 0xe8, 0x15, 0xa8, 0x12, 0xae, 0xca, 0x30, 0xf2,
+	0x2e, 0x8d, 0x1b, 0x20, 0x56, 0x04, 0x9d, 0x08, 0xbd, 0x59, 0x9e, 0x9a, 0x06, 0xd6, 0x7d, 0xec,
+	0xc6, 0x9e, 0xb5, 0x80, 0xef, 0xb6, 0x39, 0x01, 0x1f, 0x3c, 0x69, 0xad, 0xc0, 0x7c, 0xbb, 0x67,
+	0x05, 0x96, 0x47, 0x31, 0x8e, 0x61, 0x97, 0x39, 0x36, 0x8a, 0xba, 0x22, 0x02, 0xfd, 0x55, 0x98,
+	0xba, 0xe9, 0x50, 0xae, 0xc8, 0xf5, 0x35, 0x61, 0x39, 0x79, 0xe1, 0x99, 0x8e, 0x40, 0x31, 0xf0,
+	0x1f, 0x08, 0x1f, 0x9c, 0xe3, 0x26, 0x58, 0x08, 0xfc, 0x07, 0xdc, 0xc1, 0xf2, 0x87, 0x7b, 0x3f,
+	0x90, 0xb6, 0x99, 0x33, 0x64, 0x4b, 0xff, 0x8a, 0x36, 0x30, 0x1e, 0xe6, 0x3e, 0xc9, 0xa3, 0xf9,
+	0xcf, 0x97, 0xa0, 0x10, 0x08, 0xfa, 0x91, 0xcf, 0x98, 0xf1, 0x91, 0xf8, 0x19, 0x10, 0x52, 0xe9,
+	0x1f, 0x68, 0x50, 0x79, 0xc5, 0xed, 0x91, 0xc7, 0x61, 0xc3, 0xaa, 0x47, 0x83, 0xbc, 0xfa, 0xc1,
+	0xe2, 0x5b, 0x39, 0xa8, 0x4a, 0x31, 0x26, 0x89, 0x6d, 0x32, 0x45, 0x69, 0x42, 0x99, 0x0d, 0x69,
+	0x12, 0xdc, 0x0e, 0x33, 0x2e, 0xe5, 0xd5, 0x55, 0xe5, 0xae, 0x4f, 0x88, 0xc1, 0x1f, 0x80, 0x9b,
+	0x9c, 0xe8, 0xff, 0x3d, 0x1a, 0xf4, 0x0d, 0x68, 0x45, 0x80, 0xc6, 0x3b, 0x30, 0x97, 0xea, 0x66,
+	0xb6, 0x71, 0x0f, 0xf7, 0x43, 0xb7, 0x76, 0x0f, 0xf7, 0xd1, 0xb3, 0xf1, 0x67, 0xfa, 0xac, 0xc3,
+	0xf9, 0x96, 0xef, 0xb5, 0xaf, 0x06, 0x81, 0xd5, 0x97, 0xcf, 0xf8, 0x57, 0x72, 0x2f, 0x68, 0xfa,
+	0xef, 0x73, 0x50, 0x79, 0xad, 0x87, 0x83, 0xfe, 0x6e, 0xba, 0x97, 0xd0, 0xd9, 0x4f, 0xc5, 0x9c,
+	0xfd, 0xd0, 0x8e, 0x9e, 0x56, 0xec, 0x68, 0x85, 0x5f, 0x9a, 0x51, 0xfa, 0x25, 0xd5, 0x96, 0x2d,
+	0xec, 0x68, 0xcb, 0x16, 0x33, 0xb7, 0xec, 0x07, 0x5a, 0xa4, 0xc2, 0x89, 0x36, 0x59, 0x22, 0xca,
+	0xca, 0xed, 0x34, 0xca, 0x62, 0xaf, 0x33, 0xa5, 0x37, 0x71, 0x8b, 0xfa, 0x01, 0xf3, 0x16, 0x0a,
+	0xdd, 0x6b, 0x63, 0x04, 0xb2, 0xb9, 0x74, 0x20, 0x7b, 0x19, 0x8a, 0x8e, 0x6d, 0x5a, 0xcc, 0x6c,
+	0xea, 0xf9, 0x6d, 0x02, 0xa8, 0x82, 0x63, 0x73, 0xfb, 0x1a, 0x3f, 0xf3, 0xfe, 0x3d, 0x0d, 0x2a,
+	0x42, 0x66, 0x22, 0x28, 0x5f, 0x8c, 0x0d, 0xa7, 0xa9, 0x6c, 0x59, 0x36, 0xa2, 0x89, 0xde, 0x3c,
+	0x30, 0x18, 0xf6, 0x2a, 0x00, 0xd3, 0x9d, 0x24, 0x17, 0x5b, 0x61, 0x49, 0x29, 0xad, 0x20, 0xe7,
+	0x7a, 0xbc, 0x79, 0xc0, 0x28, 0x31, 0x2a, 0xce, 0xe2, 0x5a, 0x01, 0xa6, 0x39, 0xb5, 0xfe, 0x2f,
+	0x0d, 0xe6, 0xaf, 0x5b, 0x6e, 0x6b, 0xcd, 0x21, 0xd4, 0xf2, 0x5a, 0x13, 0x84, 0x4c, 0x57, 0xa0,
+	0xe0, 0x77, 0x4d, 0x17, 0xdf, 0xa5, 0x52, 0xa4, 0x53, 0x23, 0x66, 0x24, 0xd4, 0x60, 0xcc, 0xf8,
+	0xdd, 0x5b, 0xf8, 0x2e, 0x45, 0xff, 0x03, 0x45, 0xbf, 0x6b, 0x06, 0x4e, 0x7b, 0x8b, 0xd6, 0xf3,
+	0xe3, 0x12, 0x17, 0xfc, 0xae, 0xc1, 0x28, 0x62, 0x99, 0x90, 0xa9, 0x1d, 0x66, 0x42, 0xf4, 0xbf,
+	0x0e, 0x4d, 0x7f, 0x02, 0xd3, 0xbe, 0x02, 0x45, 0xc7, 0xa3, 0xa6, 0xed, 0x90, 0x50, 0x05, 0xc7,
+	0xd5, 0x36, 0xe4, 0x51, 0x3e, 0x03, 0xbe, 0xa6, 0x1e, 0x65, 0x63, 0xa3, 0x97, 0x01, 0xee, 0xba,
+	0xbe, 0x25, 0xa9, 0x85, 0x0e, 0x4e, 0xaa, 0x77, 0x05, 0x43, 0x0b, 0xe9, 0x4b, 0x9c, 0x88, 0x71,
+	0x18, 0x2c, 0xe9, 0x9f, 0x35, 0x38, 0xb4, 0x81, 0x03, 0xe2, 0x10, 0x8a, 0x3d, 0x2a, 0xb3, 0x92,
+	0xeb, 0xde, 0x5d, 0x3f, 0x99, 0xfe, 0xd5, 0x52, 0xe9, 0xdf, 0xcf, 0x27, 0x19, 0x9a, 0xb8, 0xe7,
+	0x88, 0x47, 0x88, 0xf0, 0x9e, 0x13, 0x3e, 0xb5, 0x88, 0x7b, 0xe2, 0x6c, 0xc6, 0x32, 0x49, 0x79,
+	0xe3, 0xd7, 0x65, 0xfd, 0xdb, 0xa2, 0xec, 0x41, 0x39, 0xa9, 0x47, 0x37, 0xd8, 0x45, 0x90, 0x0e,
+	0x3c, 0xe5, 0xce, 0x9f, 0x82, 0x94, 0xef, 0xc8, 0x28, 0xc6, 0xf8, 0xbe, 0x06, 0x4b, 0xd9, 0x52,
+	0x4d, 0x72, 0xf2, 0xbe, 0x0c, 0xd3, 0x8e, 0x77, 0xd7, 0x0f, 0x93, 0x64, 0xe7, 0xd4, 0x01, 0xb5,
+	0x72, 0x5c, 0x41, 0xa8, 0xff, 0x3a, 0x07, 0x35, 0xee, 0xab, 0x77, 0x61, 0xf9, 0x3b, 0xb8, 0x63,
+	0x12, 0xe7, 0x3d, 0x1c, 0x2e, 0x7f, 0x07, 0x77, 0x9a, 0xce, 0x7b, 0x38, 0x61, 0x19, 0xd3, 0x49,
+	0xcb, 0x48, 0xa6, 0x11, 0x66, 0x46, 0x24, 0x41, 0x0b, 0xc9, 0x24, 0xe8, 0x22, 0xcc, 0x78, 0xbe,
+	0x8d, 0xd7, 0xd7, 0xe4, 0x25, 0x51, 0xb6, 0x06, 0xa6, 0x56, 0xda, 0xa1, 0xa9, 0x7d, 0xa4, 0x41,
+	0xe3, 0x06, 0xa6, 0x69, 0xdd, 0xed, 0x9e, 0x95, 0x7d, 0xac, 0xc1, 0x51, 0xa5, 0x40, 0x93, 0x18,
+	0xd8, 0x8b, 0x49, 0x03, 0x53, 0xdf, 0xd8, 0x86, 0x86, 0x94, 0xb6, 0x75, 0x09, 0x2a, 0x6b, 0xbd,
+	0x4e, 0x27, 0x8a, 0xa4, 0x4e, 0x41, 0x25, 0x10, 0x9f, 0xe2, 0x42, 0x23, 0xce, 0xdf, 0xb2, 0x84,
+	0xb1, 0x6b, 0x8b, 0x7e, 0x1e, 0xaa, 0x92, 0x44, 0x4a, 0xdd, 0x80, 0x62, 0x20, 0xbf, 0x25, 0x7e,
+	0xd4, 0xd6, 0x0f, 0xc1, 0xbc, 0x81, 0xdb, 0xcc, 0xb4, 0x83, 0x5b, 0x8e, 0x77, 0x4f, 0x0e, 0xa3,
+	0xbf, 0xaf, 0xc1, 0x42, 0x12, 0x2e, 0x79, 0xfd, 0x17, 0x14, 0x2c, 0xdb, 0x0e, 0x30, 0x21, 0x23,
+	0x97, 0xe5, 0xaa, 0xc0, 0x31, 0x42, 0xe4, 0x98, 0xe6, 0x72, 0x63, 0x6b, 0x4e, 0x37, 0xe1, 0xe0,
+	0x0d, 0x4c, 0x6f, 0x63, 0x1a, 0x4c, 0xf4, 0x6c, 0x5e, 0x67, 0x57, 0x0d, 0x4e, 0x2c, 0xcd, 0x22,
+	0x6c, 0xb2, 0x37, 0x41, 0x14, 0x1f, 0x61, 0x92, 0x65, 0x8e, 0x6b, 0x39, 0x97, 0xd4, 0xb2, 0xa8,
+	0x2c, 0xea, 0x74, 0x7d, 0x0f, 0x7b, 0x34, 0x1e, 0xb3, 0x56, 0x23, 0x28, 0x37, 0xbf, 0x4f, 0x35,
+	0x40, 0xac, 0x48, 0xe3, 0x9a, 0xe5, 0x4e, 0x16, 0x1e, 0xb0, 0x84, 0x53, 0xd0, 0x32, 0xe5, 0x6e,
+	0xcd, 0x49, 0xef, 0x13, 0xb4, 0xee, 0x88, 0x0d, 0x7b, 0x12, 0xca, 0x36, 0xa1, 0xb2, 0x3b, 0x7c,
+	0xc5, 0x05, 0x9b, 0x50, 0xd1, 0xcf, 0xab, 0x32, 0x09, 0xb6, 0x5c, 0x6c, 0x9b, 0xb1, 0xe7, 0xb1,
+	0x29, 0x8e, 0x56, 0x13, 0x1d, 0xcd, 0x08, 0xae, 0xf7, 0xe0, 0xf0, 0x6d, 0xcb, 0x63, 0xe5, 0xa0,
+	0x7e, 0xa7, 0x6b, 0x25, 0xaa, 0x09, 0xd3, 0x6e, 0x4e, 0x53, 0xb8, 0xb9, 0x13, 0xa2, 0xdc, 0x4c,
+	0x44, 0xcc, 0x5c, 0xd6, 0x29, 0x23, 0x06, 0xe1, 0x7b, 0x3a, 0xe8, 0x1b, 0x3d, 0x91, 0xb9, 0x2d,
+	0x1a, 0xb2, 0xa5, 0x7f, 0x9c, 0x83, 0xfa, 0xf0, 0xb8, 0x93, 0xac, 0x20, 0x97, 0x36, 0x64, 0x15,
+	0x77, 0xca, 0x03, 0x18, 0xba, 0x09, 0xd0, 0xc1, 0x41, 0x1b, 0xaf, 0xf3, 0x1d, 0x2d, 0x6e, 0x63,
+	0xcb, 0xca, 0x1d, 0x3d, 0x90, 0xea, 0x76, 0x48, 0x60, 0xc4, 0x68, 0xd9, 0xbc, 0x89, 0xdf, 0x0b,
+	0x5a, 0xb8, 0x39, 0x70, 0xdf, 0x31, 0x08, 0x7a, 0x06, 0xe6, 0x31, 0xa1, 0x4e, 0x87, 0xbf, 0x97,
+	0x5b, 0x41, 0x1b, 0x53, 0x8e, 0x28, 0x9c, 0xb9, 0xaa, 0x4b, 0x7f, 0x09, 0x8e, 0xf0, 0x7a, 0xc5,
+	0x70, 0xdc, 0xc4, 0xeb, 0x41, 0x7a, 0x72, 0xda, 0xf0, 0xe4, 0xf4, 0xaf, 0xe5, 0xa0, 0xa1, 0xe2,
+	0x30, 0x89, 0x52, 0xaf, 0x24, 0x93, 0xf6, 0x4f, 0x2a, 0x69, 0xd2, 0x23, 0x0a, 0x12, 0xb4, 0x0c,
+	0x73, 0xf8, 0x21, 0x6e, 0xf5, 0xa8, 0xe3, 0xb5, 0x37, 0x5c, 0xcb, 0xbb, 0xe3, 0xcb, 0x53, 0x30,
+	0x0d, 0x46, 0x4f, 0x42, 0x95, 0x99, 0x8c, 0xdf, 0xa3, 0x12, 0x4f, 0xe8, 0x33, 0x09, 0x64, 0xfc,
+	0xd8, 0x7c, 0x5d, 0x4c, 0xb1, 0x2d, 0xf1, 0x84, 0x3a, 0xd3, 0xe0, 0x21, 0x55, 0x32, 0x30, 0xd9,
+	0x89, 0x2a, 0xff, 0xae, 0x41, 0x43, 0xc5, 0x61, 0xb7, 0x54, 0xf9, 0xb9, 0xd9, 0xad, 0x7e, 0x03,
+	0xe6, 0x15, 0x28, 0xcc, 0xc9, 0x0a, 0xe3, 0x0d, 0xf3, 0x4b, 0x61, 0x93, 0x6d, 0x60, 0xca, 0x8d,
+	0x54, 0x6e, 0x28, 0xd9, 0x3a, 0x77, 0x0a, 0x8a, 0x61, 0x5d, 0x0b, 0x2a, 0x40, 0xfe, 0xaa, 0xeb,
+	0xd6, 0x0e, 0xa0, 0x0a, 0x14, 0xd7, 0x65, 0xf1, 0x46, 0x4d, 0x3b, 0xf7, 0x7f, 0x30, 0x97, 0x4a,
+	0x9c, 0xa2, 0x22, 0x4c, 0xdd, 0xf1, 0x3d, 0x5c, 0x3b, 0x80, 0x6a, 0x50, 0xb9, 0xe6, 0x78, 0x56,
+	0xd0, 0x17, 0x17, 0x95, 0x9a, 0x8d, 0xe6, 0xa0, 0xcc, 0x03, 0x76, 0x09, 0xc0, 0xab, 0x3f, 0x38,
+	0x01, 0xd5, 0xdb, 0x7c, 0x5a, 0x4d, 0x1c, 0xdc, 0x77, 0x5a, 0x18, 0x99, 0x50, 0x4b, 0xff, 0x1d,
+	0x83, 0x9e, 0x56, 0xeb, 0x41, 0xfd, 0x13, 0x4d, 0x63, 0xd4, 0x52, 0xe9, 0x07, 0xd0, 0xdb, 0x30,
+	0x9b, 0xfc, 0x6f, 0x05, 0xa9, 0x23, 0x4a, 0xe5, 0xcf, 0x2d, 0xdb, 0x31, 0x37, 0xa1, 0x9a, 0xf8,
+	0x0d, 0x05, 0x9d, 0x55, 0xf2, 0x56, 0xfd, 0xaa, 0xd2, 0x50, 0x5f, 0xf2, 0xe2, 0xbf, 0x8a, 0x08,
+	0xe9, 0x93, 0x85, 0xea, 0x19, 0xd2, 0x2b, 0xab, 0xd9, 0xb7, 0x93, 0xde, 0x82, 0x83, 0x43, 0x75,
+	0xe7, 0xe8, 0x82, 0x92, 0x7f, 0x56, 0x7d, 0xfa, 0x76, 0x43, 0x3c, 0x00, 0x34, 0xfc, 0xbb, 0x05,
+	0xba, 0xa8, 0x5e, 0x81, 0xac, 0x9f, 0x4d, 0x1a, 0x2b, 0x63, 0xe3, 0x47, 0x8a, 0xfb, 0xaa, 0x06,
+	0x87, 0x33, 0x8a, 0xc5, 0xd1, 0x65, 0x25, 0xbb, 0xd1, 0x15, 0xef, 0x8d, 0x67, 0x77, 0x46, 0x14,
+	0x09, 0xe2, 0xc1, 0x5c, 0xaa, 0x7e, 0x1a, 0x9d, 0xcf, 0xac, 0x29, 0x1b, 0x2e, 0x24, 0x6f, 0x3c,
+	0x3d, 0x1e, 0x72, 0x34, 0x1e, 0x4b, 0x25, 0x26, 0x8b, 0x8e, 0x33, 0xc6, 0x53, 0x97, 0x26, 0x6f,
+	0xb7, 0xa0, 0x6f, 0x41, 0x35, 0x51, 0x1d, 0x9c, 0x61, 0xf1, 0xaa, 0x0a, 0xe2, 0xed, 0x58, 0xbf,
+	0x03, 0x95, 0x78, 0x11, 0x2f, 0x5a, 0xce, 0xda, 0x4b, 0x43, 0x8c, 0x77, 0xb2, 0x95, 0x22, 0x62,
+	0x32, 0x62, 0x2b, 0x0d, 0x95, 0x35, 0x8e, 0xbf, 0x95, 0x62, 0xfc, 0x47, 0x6e, 0xa5, 0x1d, 0x0f,
+	0xf1, 0xbe, 0x06, 0x8b, 0xea, 0x1a, 0x50, 0xb4, 0x9a, 0x65, 0x9b, 0xd9, 0xd5, 0xae, 0x8d, 0xcb,
+	0x3b, 0xa2, 0x89, 0xb4, 0x78, 0x0f, 0x66, 0x93, 0x95, 0x8e, 0x19, 0x5a, 0x54, 0x16, 0x87, 0x36,
+	0xce, 0x8f, 0x85, 0x1b, 0x0d, 0xf6, 0x06, 0x94, 0x63, 0x3f, 0xbc, 0xa2, 0x33, 0x23, 0xec, 0x38,
+	0xfe, 0xf7, 0xe7, 0x76, 0x9a, 0x7c, 0x0d, 0x4a, 0xd1, 0x7f, 0xaa, 0xe8, 0x74, 0xa6, 0xfd, 0xee,
+	0x84, 0x65, 0x13, 0x60, 0xf0, 0x13, 0x2a, 0x7a, 0x4a, 0xc9, 0x73, 0xe8, 0x2f, 0xd5, 0xed, 0x98,
+	0x46, 0xd3, 0x17, 0x2f, 0xcf, 0xa3, 0xa6, 0x1f, 0x2f, 0x95, 0xd8, 0x8e, 0xed, 0x16, 0x54, 0x43,
+	0xd7, 0x29, 0x18, 0x9f, 0x1d, 0xe9, 0x5e, 0x13, 0xac, 0xcf, 0x8d, 0x83, 0x1a, 0xad, 0xdf, 0x16,
+	0x54, 0x13, 0xe5, 0x26, 0x19, 0x23, 0xa9, 0xaa, 0x6b, 0x1a, 0xe7, 0xc6, 0x41, 0x8d, 0x46, 0xfa,
+	0x72, 0xac, 0xb2, 0x25, 0x51, 0x3d, 0x84, 0x2e, 0x8d, 0xe4, 0xa3, 0x2a, 0x9e, 0x6a, 0xac, 0xee,
+	0x84, 0x24, 0x12, 0x41, 0x5a, 0x95, 0x50, 0x69, 0xb6, 0x55, 0xed, 0x64, 0xa5, 0x9a, 0x30, 0x23,
+	0x0a, 0x48, 0x90, 0x9e, 0x51, 0x2a, 0x16, 0xab, 0x2e, 0x69, 0x3c, 0xa1, 0xc4, 0x49, 0xd6, 0x56,
+	0x08, 0xa6, 0xa2, 0x40, 0x20, 0x83, 0x69, 0xa2, 0x7a, 0x60, 0x5c, 0xa6, 0x06, 0xcc, 0x88, 0x97,
+	0xc1, 0x0c, 0xa6, 0x89, 0xd7, 0xed, 0xc6, 0x68, 0x1c, 0xf1, 0x9c, 0x78, 0x00, 0x6d, 0xc0, 0x34,
+	0x7f, 0x41, 0x43, 0xa7, 0x46, 0xbd, 0xae, 0x8d, 0xe2, 0x98, 0x78, 0x80, 0xd3, 0x0f, 0xa0, 0x2f,
+	0xc0, 0x34, 0xcf, 0xeb, 0x64, 0x70, 0x8c, 0x3f, 0x91, 0x35, 0x46, 0xa2, 0x84, 0x22, 0xda, 0x50,
+	0x89, 0x27, 0xd0, 0x33, 0x8e, 0x2c, 0xc5, 0x13, 0x43, 0x63, 0x1c, 0xcc, 0x70, 0x94, 0xaf, 0x6b,
+	0x50, 0xcf, 0xca, 0xb5, 0xa2, 0xcc, 0xb8, 0x64, 0x54, 0xc2, 0xb8, 0xf1, 0xdc, 0x0e, 0xa9, 0x22,
+	0x15, 0xbe, 0x07, 0xf3, 0x8a, 0x84, 0x1c, 0x5a, 0xc9, 0xe2, 0x97, 0x91, 0x4b, 0x6c, 0x3c, 0x33,
+	0x3e, 0x41, 0x34, 0xf6, 0x06, 0x4c, 0xf3, 0x44, 0x5a, 0xc6, 0xf2, 0xc5, 0xf3, 0x72, 0x0d, 0x7d,
+	0x14, 0x4a, 0xc4, 0x11, 0x43, 0x25, 0x9e, 0x55, 0xcb, 0x58, 0x3f, 0x45, 0x42, 0xae, 0x71, 0x76,
+	0x0c, 0xcc, 0x68, 0x18, 0x13, 0x60, 0x90, 0xd5, 0xca, 0x38, 0x1d, 0x86, 0x12, 0x6b, 0x8d, 0x33,
+	0xdb, 0xe2, 0xc5, 0x0f, 0xca, 0x58, 0x9e, 0x2a, 0xe3, 0xa4, 0x18, 0xce, 0x64, 0x8d, 0x11, 0xbd,
+	0x0f, 0xa7, 0x1f, 0x32, 0xa2, 0xf7, 0xcc, 0x4c, 0x47, 0x63, 0x65, 0x6c, 0xfc, 0x68, 0x3e, 0xef,
+	0x42, 0x2d, 0x9d, 0x4a, 0xca, 0xb8, 0x15, 0x66, 0x64, 0xba, 0x1a, 0x17, 0xc6, 0xc4, 0x8e, 0x9f,
+	0x20, 0x47, 0x87, 0x65, 0xfa, 0xa2, 0x43, 0xb7, 0x78, 0xa6, 0x60, 0x9c, 0x59, 0xc7, 0x93, 0x12,
+	0x8d, 0x95, 0xb1, 0xf1, 0x43, 0x11, 0x56, 0x7b, 0x50, 0xd9, 0x08, 0xfc, 0x87, 0xfd, 0xf0, 0x6e,
+	0xfc, 0x9f, 0xb1, 0xce, 0x6b, 0xcf, 0x7d, 0xe9, 0x72, 0xdb, 0xa1, 0x5b, 0xbd, 0x4d, 0xb6, 0xfe,
+	0x2b, 0x02, 0xf7, 0x82, 0xe3, 0xcb, 0xaf, 0x15, 0xc7, 0xa3, 0x38, 0xf0, 0x2c, 0x77, 0x85, 0xf3,
+	0x92, 0xd0, 0xee, 0xe6, 0xe6, 0x0c, 0x6f, 0x5f, 0xfe, 0xf7, 0x00, 0xad, 0x7f, 0xb1, 0xcc, 0xd6,
+	0x44, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -77,6 +77,7 @@ const (
 	TriggerCondition_loadBalance TriggerCondition = 1
 	TriggerCondition_grpcRequest TriggerCondition = 2
 	TriggerCondition_nodeDown    TriggerCondition = 3
+	TriggerCondition_preFetch    TriggerCondition = 4
 )
 
 var TriggerCondition_name = map[int32]string{
@@ -84,6 +85,7 @@ var TriggerCondition_name = map[int32]string{
 	1: "loadBalance",
 	2: "grpcRequest",
 	3: "nodeDown",
+	4: "preFetch",
 }
 
 var TriggerCondition_value = map[string]int32{
@@ -91,6 +93,7 @@ var TriggerCondition_value = map[string]int32{
 	"loadBalance": 1,
 	"grpcRequest": 2,
 	"nodeDown":    3,
+	"preFetch":    4,
 }
 
 func (x TriggerCondition) String() string {
@@ -101,7 +104,7 @@ func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{1}
 }
 
-//----------------etcd-----------------
+// ----------------etcd-----------------
 type SegmentState int32
 
 const (
@@ -161,7 +164,7 @@ func (LoadType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
 }
 
-//--------------------query coordinator proto------------------
+// --------------------query coordinator proto------------------
 type ShowCollectionsRequest struct {
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
@@ -997,6 +1000,7 @@ type SegmentInfo struct {
 	State                commonpb.SegmentState        `protobuf:"varint,13,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
 	EnableIndex          bool                         `protobuf:"varint,14,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
 	IndexPathInfos       []*indexpb.IndexFilePathInfo `protobuf:"bytes,15,rep,name=index_path_infos,json=indexPathInfos,proto3" json:"index_path_infos,omitempty"`
+	AccessCount          int64                        `protobuf:"varint,16,opt,name=access_count,json=accessCount,proto3" json:"access_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -1132,6 +1136,13 @@ func (m *SegmentInfo) GetIndexPathInfos() []*indexpb.IndexFilePathInfo {
 	return nil
 }
 
+func (m *SegmentInfo) GetAccessCount() int64 {
+	if m != nil {
+		return m.AccessCount
+	}
+	return 0
+}
+
 type GetSegmentInfoResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Infos                []*SegmentInfo   `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
@@ -1179,7 +1190,7 @@ func (m *GetSegmentInfoResponse) GetInfos() []*SegmentInfo {
 	return nil
 }
 
-//-----------------query node proto----------------
+// -----------------query node proto----------------
 type AddQueryChannelRequest struct {
 	Base                  *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID                int64                   `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1496,7 +1507,7 @@ func (m *WatchDeltaChannelsRequest) GetInfos() []*datapb.VchannelInfo {
 	return nil
 }
 
-//used for handoff task
+// used for handoff task
 type SegmentLoadInfo struct {
 	SegmentID            int64                        `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	PartitionID          int64                        `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -2217,7 +2228,266 @@ func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
 	return nil
 }
 
-//---------------- common query proto -----------------
+type PreFetchSegmentInfo struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	StorageHint          string   `protobuf:"bytes,2,opt,name=storage_hint,json=storageHint,proto3" json:"storage_hint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreFetchSegmentInfo) Reset()         { *m = PreFetchSegmentInfo{} }
+func (m *PreFetchSegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*PreFetchSegmentInfo) ProtoMessage()    {}
+func (*PreFetchSegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+}
+
+func (m *PreFetchSegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreFetchSegmentInfo.Unmarshal(m, b)
+}
+func (m *PreFetchSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreFetchSegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *PreFetchSegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreFetchSegmentInfo.Merge(m, src)
+}
+func (m *PreFetchSegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_PreFetchSegmentInfo.Size(m)
+}
+func (m *PreFetchSegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreFetchSegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreFetchSegmentInfo proto.InternalMessageInfo
+
+func (m *PreFetchSegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *PreFetchSegmentInfo) GetStorageHint() string {
+	if m != nil {
+		return m.StorageHint
+	}
+	return ""
+}
+
+type PreFetchSegmentsRequest struct {
+	Base                 *commonpb.MsgBase      `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64                  `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Segments             []*PreFetchSegmentInfo `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *PreFetchSegmentsRequest) Reset()         { *m = PreFetchSegmentsRequest{} }
+func (m *PreFetchSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*PreFetchSegmentsRequest) ProtoMessage()    {}
+func (*PreFetchSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
+}
+
+func (m *PreFetchSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreFetchSegmentsRequest.Unmarshal(m, b)
+}
+func (m *PreFetchSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreFetchSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *PreFetchSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreFetchSegmentsRequest.Merge(m, src)
+}
+func (m *PreFetchSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_PreFetchSegmentsRequest.Size(m)
+}
+func (m *PreFetchSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreFetchSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreFetchSegmentsRequest proto.InternalMessageInfo
+
+func (m *PreFetchSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PreFetchSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PreFetchSegmentsRequest) GetSegments() []*PreFetchSegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type PreFetchSegmentsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	BatchID              int64            `protobuf:"varint,2,opt,name=batchID,proto3" json:"batchID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PreFetchSegmentsResponse) Reset()         { *m = PreFetchSegmentsResponse{} }
+func (m *PreFetchSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*PreFetchSegmentsResponse) ProtoMessage()    {}
+func (*PreFetchSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
+}
+
+func (m *PreFetchSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreFetchSegmentsResponse.Unmarshal(m, b)
+}
+func (m *PreFetchSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreFetchSegmentsResponse.Marshal(b, m, deterministic)
+}
+func (m *PreFetchSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreFetchSegmentsResponse.Merge(m, src)
+}
+func (m *PreFetchSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_PreFetchSegmentsResponse.Size(m)
+}
+func (m *PreFetchSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreFetchSegmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreFetchSegmentsResponse proto.InternalMessageInfo
+
+func (m *PreFetchSegmentsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *PreFetchSegmentsResponse) GetBatchID() int64 {
+	if m != nil {
+		return m.BatchID
+	}
+	return 0
+}
+
+type GetLoadProgressRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BatchID              int64             `protobuf:"varint,2,opt,name=batchID,proto3" json:"batchID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetLoadProgressRequest) Reset()         { *m = GetLoadProgressRequest{} }
+func (m *GetLoadProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoadProgressRequest) ProtoMessage()    {}
+func (*GetLoadProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
+}
+
+func (m *GetLoadProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadProgressRequest.Unmarshal(m, b)
+}
+func (m *GetLoadProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLoadProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadProgressRequest.Merge(m, src)
+}
+func (m *GetLoadProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoadProgressRequest.Size(m)
+}
+func (m *GetLoadProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadProgressRequest proto.InternalMessageInfo
+
+func (m *GetLoadProgressRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetLoadProgressRequest) GetBatchID() int64 {
+	if m != nil {
+		return m.BatchID
+	}
+	return 0
+}
+
+type GetLoadProgressResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NumOfSegments        int64            `protobuf:"varint,2,opt,name=num_of_segments,json=numOfSegments,proto3" json:"num_of_segments,omitempty"`
+	NumOfLoadedSegments  int64            `protobuf:"varint,3,opt,name=num_of_loaded_segments,json=numOfLoadedSegments,proto3" json:"num_of_loaded_segments,omitempty"`
+	Completed            bool             `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetLoadProgressResponse) Reset()         { *m = GetLoadProgressResponse{} }
+func (m *GetLoadProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoadProgressResponse) ProtoMessage()    {}
+func (*GetLoadProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
+}
+
+func (m *GetLoadProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadProgressResponse.Unmarshal(m, b)
+}
+func (m *GetLoadProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLoadProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadProgressResponse.Merge(m, src)
+}
+func (m *GetLoadProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoadProgressResponse.Size(m)
+}
+func (m *GetLoadProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadProgressResponse proto.InternalMessageInfo
+
+func (m *GetLoadProgressResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetLoadProgressResponse) GetNumOfSegments() int64 {
+	if m != nil {
+		return m.NumOfSegments
+	}
+	return 0
+}
+
+func (m *GetLoadProgressResponse) GetNumOfLoadedSegments() int64 {
+	if m != nil {
+		return m.NumOfLoadedSegments
+	}
+	return 0
+}
+
+func (m *GetLoadProgressResponse) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+// ---------------- common query proto -----------------
 type SegmentChangeInfo struct {
 	OnlineNodeID         int64          `protobuf:"varint,1,opt,name=online_nodeID,json=onlineNodeID,proto3" json:"online_nodeID,omitempty"`
 	OnlineSegments       []*SegmentInfo `protobuf:"bytes,2,rep,name=online_segments,json=onlineSegments,proto3" json:"online_segments,omitempty"`
@@ -2232,7 +2502,7 @@ func (m *SegmentChangeInfo) Reset()         { *m = SegmentChangeInfo{} }
 func (m *SegmentChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentChangeInfo) ProtoMessage()    {}
 func (*SegmentChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
 }
 
 func (m *SegmentChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -2293,7 +2563,7 @@ func (m *SealedSegmentsChangeInfo) Reset()         { *m = SealedSegmentsChangeIn
 func (m *SealedSegmentsChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SealedSegmentsChangeInfo) ProtoMessage()    {}
 func (*SealedSegmentsChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
 }
 
 func (m *SealedSegmentsChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -2362,6 +2632,11 @@ func init() {
 	proto.RegisterType((*LoadBalanceSegmentInfo)(nil), "milvus.proto.query.LoadBalanceSegmentInfo")
 	proto.RegisterType((*HandoffSegmentsRequest)(nil), "milvus.proto.query.HandoffSegmentsRequest")
 	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.query.LoadBalanceRequest")
+	proto.RegisterType((*PreFetchSegmentInfo)(nil), "milvus.proto.query.PreFetchSegmentInfo")
+	proto.RegisterType((*PreFetchSegmentsRequest)(nil), "milvus.proto.query.PreFetchSegmentsRequest")
+	proto.RegisterType((*PreFetchSegmentsResponse)(nil), "milvus.proto.query.PreFetchSegmentsResponse")
+	proto.RegisterType((*GetLoadProgressRequest)(nil), "milvus.proto.query.GetLoadProgressRequest")
+	proto.RegisterType((*GetLoadProgressResponse)(nil), "milvus.proto.query.GetLoadProgressResponse")
 	proto.RegisterType((*SegmentChangeInfo)(nil), "milvus.proto.query.SegmentChangeInfo")
 	proto.RegisterType((*SealedSegmentsChangeInfo)(nil), "milvus.proto.query.SealedSegmentsChangeInfo")
 }
@@ -2369,158 +2644,171 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 2413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0x4d, 0x6f, 0xdc, 0xc6,
-	0x55, 0xdc, 0x0f, 0x69, 0xf7, 0xed, 0x17, 0x35, 0xb6, 0x94, 0xf5, 0x36, 0x76, 0x64, 0x3a, 0xfe,
-	0x88, 0xd2, 0xc8, 0x8e, 0x9c, 0x7e, 0x04, 0x6d, 0x0e, 0xb1, 0x36, 0x56, 0x36, 0xb5, 0x65, 0x95,
-	0x52, 0x52, 0xd4, 0x30, 0xb0, 0xe5, 0x2e, 0x47, 0x2b, 0xc2, 0x24, 0x67, 0xcd, 0xe1, 0x5a, 0x96,
-	0xcf, 0x3d, 0xb4, 0x87, 0xa2, 0x3f, 0xa0, 0x45, 0x81, 0x02, 0x29, 0x8a, 0x1c, 0x7a, 0x6c, 0xcf,
-	0xbe, 0xf4, 0xde, 0x5f, 0x50, 0xa0, 0x68, 0xfe, 0x42, 0x7b, 0x2e, 0xe6, 0x83, 0x5c, 0x92, 0x3b,
-	0x2b, 0xad, 0xa4, 0x3a, 0x36, 0x8a, 0xde, 0xc8, 0x37, 0x6f, 0xde, 0x7b, 0xf3, 0x3e, 0xe7, 0xbd,
-	0x81, 0xc5, 0x27, 0x23, 0x1c, 0x1c, 0x76, 0xfb, 0x84, 0x04, 0xf6, 0xda, 0x30, 0x20, 0x21, 0x41,
-	0xc8, 0x73, 0xdc, 0xa7, 0x23, 0x2a, 0xfe, 0xd6, 0xf8, 0x7a, 0xab, 0xda, 0x27, 0x9e, 0x47, 0x7c,
-	0x01, 0x6b, 0x55, 0x93, 0x18, 0xad, 0xba, 0xe3, 0x87, 0x38, 0xf0, 0x2d, 0x37, 0x5a, 0xa5, 0xfd,
-	0x7d, 0xec, 0x59, 0xf2, 0x4f, 0xb7, 0xad, 0xd0, 0x4a, 0xd2, 0x6f, 0x2d, 0x3a, 0xbe, 0x8d, 0x9f,
-	0x25, 0x41, 0xc6, 0xcf, 0x35, 0x58, 0xde, 0xd9, 0x27, 0x07, 0x1b, 0xc4, 0x75, 0x71, 0x3f, 0x74,
-	0x88, 0x4f, 0x4d, 0xfc, 0x64, 0x84, 0x69, 0x88, 0x6e, 0x41, 0xa1, 0x67, 0x51, 0xdc, 0xd4, 0x56,
-	0xb4, 0x1b, 0x95, 0xf5, 0x37, 0xd7, 0x52, 0xc2, 0x49, 0xa9, 0xee, 0xd3, 0xc1, 0x1d, 0x8b, 0x62,
-	0x93, 0x63, 0x22, 0x04, 0x05, 0xbb, 0xd7, 0x69, 0x37, 0x73, 0x2b, 0xda, 0x8d, 0xbc, 0xc9, 0xbf,
-	0xd1, 0xdb, 0x50, 0xeb, 0xc7, 0xb4, 0x3b, 0x6d, 0xda, 0xcc, 0xaf, 0xe4, 0x6f, 0xe4, 0xcd, 0x34,
-	0xd0, 0xf8, 0xa3, 0x06, 0x6f, 0x4c, 0x88, 0x41, 0x87, 0xc4, 0xa7, 0x18, 0xdd, 0x86, 0x79, 0x1a,
-	0x5a, 0xe1, 0x88, 0x4a, 0x49, 0xbe, 0xa5, 0x94, 0x64, 0x87, 0xa3, 0x98, 0x12, 0x75, 0x92, 0x6d,
-	0x4e, 0xc1, 0x16, 0xbd, 0x0f, 0xe7, 0x1d, 0xff, 0x3e, 0xf6, 0x48, 0x70, 0xd8, 0x1d, 0xe2, 0xa0,
-	0x8f, 0xfd, 0xd0, 0x1a, 0xe0, 0x48, 0xc6, 0x73, 0xd1, 0xda, 0xf6, 0x78, 0xc9, 0xf8, 0x83, 0x06,
-	0x4b, 0x4c, 0xd2, 0x6d, 0x2b, 0x08, 0x9d, 0x97, 0xa0, 0x2f, 0x03, 0xaa, 0x49, 0x19, 0x9b, 0x79,
-	0xbe, 0x96, 0x82, 0x31, 0x9c, 0x61, 0xc4, 0x9e, 0x9d, 0xad, 0xc0, 0xc5, 0x4d, 0xc1, 0x8c, 0x2f,
-	0xa5, 0x61, 0x93, 0x72, 0x9e, 0x45, 0xa1, 0x59, 0x9e, 0xb9, 0x49, 0x9e, 0xa7, 0x51, 0xe7, 0x0b,
-	0x0d, 0x96, 0xee, 0x11, 0xcb, 0x1e, 0x1b, 0xfe, 0x9b, 0x57, 0xe7, 0x47, 0x30, 0x2f, 0x02, 0xa7,
-	0x59, 0xe0, 0xbc, 0xae, 0xa6, 0x79, 0xc9, 0xa0, 0x1a, 0x4b, 0xb8, 0xc3, 0x01, 0xa6, 0xdc, 0x64,
-	0xfc, 0x56, 0x83, 0xa6, 0x89, 0x5d, 0x6c, 0x51, 0xfc, 0x2a, 0x4f, 0xb1, 0x0c, 0xf3, 0x3e, 0xb1,
-	0x71, 0xa7, 0xcd, 0x4f, 0x91, 0x37, 0xe5, 0x9f, 0xf1, 0xb5, 0xd4, 0xf0, 0x6b, 0xee, 0xb0, 0x09,
-	0x2b, 0x14, 0x4f, 0x63, 0x85, 0x17, 0x63, 0x2b, 0xbc, 0xee, 0x27, 0x1d, 0x5b, 0xaa, 0x98, 0xb2,
-	0xd4, 0x4f, 0xe1, 0xc2, 0x46, 0x80, 0xad, 0x10, 0xff, 0x98, 0x65, 0xfe, 0x8d, 0x7d, 0xcb, 0xf7,
-	0xb1, 0x1b, 0x1d, 0x21, 0xcb, 0x5c, 0x53, 0x30, 0x6f, 0xc2, 0xc2, 0x30, 0x20, 0xcf, 0x0e, 0x63,
-	0xb9, 0xa3, 0x5f, 0xe3, 0xf7, 0x1a, 0xb4, 0x54, 0xb4, 0xcf, 0x92, 0x11, 0xae, 0x43, 0x23, 0x10,
-	0xc2, 0x75, 0xfb, 0x82, 0x1e, 0xe7, 0x5a, 0x36, 0xeb, 0x12, 0x2c, 0xb9, 0xa0, 0xab, 0x50, 0x0f,
-	0x30, 0x1d, 0xb9, 0x63, 0xbc, 0x3c, 0xc7, 0xab, 0x09, 0xa8, 0x44, 0x33, 0xbe, 0xd2, 0xe0, 0xc2,
-	0x26, 0x0e, 0x63, 0xeb, 0x31, 0x76, 0xf8, 0x35, 0xcd, 0xae, 0xbf, 0xd3, 0xa0, 0x91, 0x11, 0x14,
-	0xad, 0x40, 0x25, 0x81, 0x23, 0x0d, 0x94, 0x04, 0xa1, 0xef, 0x43, 0x91, 0xe9, 0x0e, 0x73, 0x91,
-	0xea, 0xeb, 0xc6, 0xda, 0x64, 0xbd, 0x5f, 0x4b, 0x53, 0x35, 0xc5, 0x06, 0x74, 0x13, 0xce, 0x29,
-	0x32, 0xab, 0x14, 0x1f, 0x4d, 0x26, 0x56, 0xe3, 0x4f, 0x1a, 0xb4, 0x54, 0xca, 0x3c, 0x8b, 0xc1,
-	0x1f, 0xc2, 0x72, 0x7c, 0x9a, 0xae, 0x8d, 0x69, 0x3f, 0x70, 0x86, 0x3c, 0xcc, 0x78, 0x31, 0xa8,
-	0xac, 0x5f, 0x39, 0xfe, 0x3c, 0xd4, 0x5c, 0x8a, 0x49, 0xb4, 0x13, 0x14, 0x8c, 0x5f, 0x69, 0xb0,
-	0xb4, 0x89, 0xc3, 0x1d, 0x3c, 0xf0, 0xb0, 0x1f, 0x76, 0xfc, 0x3d, 0x72, 0x7a, 0xc3, 0x5f, 0x02,
-	0xa0, 0x92, 0x4e, 0x5c, 0xa8, 0x12, 0x90, 0x59, 0x9c, 0xc0, 0xf8, 0xba, 0x00, 0x95, 0x84, 0x30,
-	0xe8, 0x4d, 0x28, 0xc7, 0x14, 0xa4, 0x69, 0xc7, 0x80, 0x09, 0x8a, 0x39, 0x85, 0x5b, 0x65, 0xdc,
-	0x23, 0x3f, 0xe9, 0x1e, 0x53, 0x32, 0x38, 0xba, 0x00, 0x25, 0x0f, 0x7b, 0x5d, 0xea, 0x3c, 0xc7,
-	0x32, 0x63, 0x2c, 0x78, 0xd8, 0xdb, 0x71, 0x9e, 0x63, 0xb6, 0xe4, 0x8f, 0xbc, 0x6e, 0x40, 0x0e,
-	0x68, 0x73, 0x5e, 0x2c, 0xf9, 0x23, 0xcf, 0x24, 0x07, 0x14, 0x5d, 0x04, 0x10, 0xd7, 0x3d, 0xdf,
-	0xf2, 0x70, 0x73, 0x81, 0x47, 0x5c, 0x99, 0x43, 0xb6, 0x2c, 0x0f, 0xb3, 0x5c, 0xc1, 0x7f, 0x3a,
-	0xed, 0x66, 0x49, 0x6c, 0x94, 0xbf, 0xec, 0xa8, 0x32, 0x4e, 0x3b, 0xed, 0x66, 0x59, 0xec, 0x8b,
-	0x01, 0xe8, 0x13, 0xa8, 0xc9, 0x73, 0x77, 0x85, 0x2f, 0x03, 0xf7, 0xe5, 0x15, 0x95, 0xed, 0xa5,
-	0x02, 0x85, 0x27, 0x57, 0x69, 0xe2, 0x0f, 0x5d, 0x83, 0x7a, 0x9f, 0x78, 0x43, 0x8b, 0x6b, 0xe7,
-	0x6e, 0x40, 0xbc, 0x66, 0x85, 0xdb, 0x29, 0x03, 0x45, 0xb7, 0xe0, 0x5c, 0x9f, 0xe7, 0x2d, 0xfb,
-	0xce, 0xe1, 0x46, 0xbc, 0xd4, 0xac, 0xae, 0x68, 0x37, 0x4a, 0xa6, 0x6a, 0x09, 0x7d, 0x2f, 0x0a,
-	0xb2, 0x1a, 0x17, 0xec, 0xb2, 0xda, 0xb3, 0x93, 0x92, 0xc9, 0x18, 0xbb, 0x0c, 0x55, 0xec, 0x5b,
-	0x3d, 0x17, 0x77, 0xb9, 0x26, 0x9a, 0x75, 0xce, 0xa3, 0x22, 0x60, 0x1d, 0x06, 0x42, 0x0f, 0x40,
-	0x17, 0x3a, 0x1d, 0x5a, 0xe1, 0x7e, 0xd7, 0xf1, 0xf7, 0x08, 0x6d, 0x36, 0xb8, 0xef, 0x67, 0xaa,
-	0x15, 0xc7, 0x5a, 0xe3, 0x9b, 0xee, 0x3a, 0x2e, 0xde, 0xb6, 0xc2, 0x7d, 0xee, 0xd3, 0x75, 0xbe,
-	0x10, 0xfd, 0x52, 0x7e, 0xfd, 0xce, 0xba, 0xfd, 0x59, 0x42, 0xf4, 0x3b, 0x50, 0x14, 0x52, 0x89,
-	0x88, 0x7c, 0xeb, 0x08, 0xab, 0x70, 0x66, 0x02, 0xdb, 0xf8, 0x4b, 0x1e, 0x96, 0x3f, 0xb6, 0x6d,
-	0x55, 0xdd, 0x39, 0x79, 0xf8, 0x8d, 0xdd, 0x38, 0x97, 0x72, 0xe3, 0x59, 0x72, 0xef, 0xbb, 0xb0,
-	0x98, 0xa9, 0x29, 0x32, 0x1a, 0xca, 0xa6, 0x9e, 0xae, 0x2a, 0x9d, 0x36, 0x7a, 0x07, 0xf4, 0x74,
-	0x5d, 0x91, 0x15, 0xb5, 0x6c, 0x36, 0x52, 0x95, 0xa5, 0xd3, 0x46, 0xdf, 0x85, 0x37, 0x06, 0x2e,
-	0xe9, 0x59, 0x6e, 0x97, 0x62, 0xcb, 0xc5, 0x76, 0x77, 0x1c, 0xcc, 0xf3, 0xdc, 0xef, 0x96, 0xc4,
-	0xf2, 0x0e, 0x5f, 0xdd, 0x89, 0x03, 0x7b, 0x93, 0x79, 0x3b, 0x7e, 0xdc, 0x1d, 0x12, 0xca, 0xa3,
-	0x94, 0xc7, 0x51, 0x25, 0x9b, 0xb9, 0xe3, 0x36, 0xec, 0x3e, 0x1d, 0x6c, 0x4b, 0x4c, 0xe6, 0xef,
-	0xf8, 0x71, 0xf4, 0x87, 0x3e, 0x87, 0x65, 0xa5, 0x00, 0xb4, 0x59, 0x9a, 0xcd, 0x52, 0xe7, 0x15,
-	0x02, 0x52, 0xe3, 0x1f, 0x1a, 0x5c, 0x30, 0xb1, 0x47, 0x9e, 0xe2, 0xff, 0x59, 0xdb, 0x19, 0xff,
-	0xcc, 0xc1, 0xf2, 0x4f, 0xac, 0xb0, 0xbf, 0xdf, 0xf6, 0x24, 0x90, 0xbe, 0x9a, 0x03, 0x66, 0x32,
-	0x78, 0x61, 0x32, 0x83, 0xc7, 0xe1, 0x57, 0x54, 0x19, 0x95, 0xf5, 0xe3, 0x6b, 0x5f, 0x44, 0xe7,
-	0x1d, 0x87, 0x5f, 0xe2, 0xea, 0x3b, 0x7f, 0x8a, 0xab, 0x2f, 0xda, 0x80, 0x1a, 0x7e, 0xd6, 0x77,
-	0x47, 0x36, 0x96, 0x29, 0x69, 0x81, 0x73, 0xbf, 0xa4, 0xe0, 0x9e, 0xf4, 0xa8, 0xaa, 0xdc, 0x24,
-	0x32, 0xd1, 0x0b, 0x0d, 0x2e, 0x08, 0x2d, 0x63, 0x37, 0xb4, 0x5e, 0xad, 0xa2, 0x63, 0x35, 0x16,
-	0x4e, 0xa2, 0x46, 0xe3, 0xcb, 0x02, 0x34, 0xe4, 0x01, 0x59, 0xc3, 0x33, 0x43, 0xdd, 0xce, 0x58,
-	0x34, 0x37, 0x69, 0xd1, 0x59, 0xc4, 0x8d, 0x2e, 0x9a, 0x85, 0xc4, 0x45, 0xf3, 0x22, 0xc0, 0x9e,
-	0x3b, 0xa2, 0xfb, 0xdd, 0xd0, 0xf1, 0xa2, 0xaa, 0x5d, 0xe6, 0x90, 0x5d, 0xc7, 0xc3, 0xe8, 0x63,
-	0xa8, 0xf6, 0x1c, 0xdf, 0x25, 0x03, 0x5e, 0x49, 0x28, 0x4f, 0x42, 0x6a, 0x8b, 0xdd, 0x75, 0xb0,
-	0x6b, 0xdf, 0xe1, 0xb8, 0x66, 0x45, 0xec, 0x61, 0xe5, 0x83, 0xa2, 0x4b, 0x50, 0x61, 0xa5, 0x9f,
-	0xec, 0x89, 0xea, 0xbf, 0x20, 0x58, 0xf8, 0x23, 0xef, 0xc1, 0x1e, 0xaf, 0xff, 0x3f, 0x84, 0x32,
-	0x2b, 0x0a, 0xd4, 0x25, 0x83, 0x28, 0xc9, 0x1c, 0x47, 0x7f, 0xbc, 0x01, 0x7d, 0x04, 0x65, 0x9b,
-	0x39, 0x02, 0xdf, 0x5d, 0x9e, 0x6a, 0x06, 0xee, 0x2c, 0xf7, 0xc8, 0x80, 0x9b, 0x61, 0xbc, 0x43,
-	0x51, 0xde, 0x41, 0x59, 0xde, 0xb3, 0x35, 0xb7, 0x32, 0x5b, 0xcd, 0xad, 0x9e, 0xa5, 0xe6, 0xfe,
-	0x3b, 0x07, 0xe7, 0x98, 0x7f, 0x44, 0x49, 0xf4, 0xf4, 0x3e, 0x7e, 0x11, 0xc0, 0xa6, 0x61, 0x37,
-	0xe5, 0xe7, 0x65, 0x9b, 0x86, 0x5b, 0xc2, 0xd5, 0x3f, 0x8c, 0xdc, 0x38, 0x3f, 0xfd, 0x7a, 0x9c,
-	0xf1, 0xd7, 0xc9, 0x8c, 0x70, 0x9a, 0x91, 0x04, 0xfa, 0x11, 0xd4, 0x5d, 0x62, 0xd9, 0xdd, 0x3e,
-	0xf1, 0x6d, 0x51, 0xb7, 0x8a, 0xfc, 0x32, 0xf4, 0xb6, 0x4a, 0x84, 0xdd, 0xc0, 0x19, 0x0c, 0x70,
-	0xb0, 0x11, 0xe1, 0x9a, 0x35, 0x97, 0x0f, 0x64, 0xe4, 0x2f, 0xba, 0x02, 0x35, 0x4a, 0x46, 0x41,
-	0x1f, 0x47, 0x07, 0x15, 0x17, 0xcd, 0xaa, 0x00, 0x6e, 0xa9, 0xc3, 0x7a, 0x41, 0x71, 0xa7, 0xfe,
-	0xbb, 0x06, 0xcb, 0xb2, 0x45, 0x3f, 0xbb, 0xee, 0xa7, 0xe5, 0x97, 0x28, 0x18, 0xf3, 0x47, 0x74,
-	0x7d, 0x85, 0x19, 0xba, 0xbe, 0xa2, 0xa2, 0x71, 0x4f, 0x37, 0x16, 0xf3, 0xd9, 0xc6, 0xc2, 0xd8,
-	0x85, 0x5a, 0x5c, 0xa3, 0x78, 0xf6, 0xb9, 0x02, 0x35, 0x21, 0x56, 0x97, 0xa9, 0x14, 0xdb, 0x51,
-	0xd7, 0x2e, 0x80, 0xf7, 0x38, 0x8c, 0x51, 0x8d, 0x6b, 0xa0, 0xb8, 0xb8, 0x95, 0xcd, 0x04, 0xc4,
-	0xf8, 0x73, 0x0e, 0xf4, 0x64, 0x75, 0xe7, 0x94, 0x67, 0x19, 0x07, 0x5c, 0x87, 0x86, 0x9c, 0x31,
-	0xc7, 0x25, 0x56, 0x36, 0xe8, 0x4f, 0x92, 0xe4, 0xda, 0xe8, 0x03, 0x58, 0x16, 0x88, 0x13, 0x25,
-	0x59, 0x34, 0xea, 0xe7, 0xf9, 0xaa, 0x99, 0xb9, 0x53, 0x4d, 0xbf, 0xd2, 0x14, 0xce, 0x70, 0xa5,
-	0x99, 0xbc, 0x72, 0x15, 0x4f, 0x77, 0xe5, 0x32, 0xfe, 0x96, 0x87, 0xfa, 0x38, 0x42, 0x66, 0xd6,
-	0xda, 0x2c, 0x83, 0xce, 0x2d, 0xd0, 0xc7, 0x9d, 0x30, 0xef, 0x1e, 0x8e, 0x0c, 0xf2, 0x6c, 0x0f,
-	0xdc, 0x18, 0x66, 0x46, 0x07, 0x77, 0xa1, 0x26, 0x75, 0xde, 0x4d, 0x16, 0xbe, 0xcb, 0x2a, 0x62,
-	0x29, 0x0f, 0x33, 0xab, 0x89, 0x3a, 0x48, 0xd1, 0x87, 0x50, 0xe6, 0x71, 0x1f, 0x1e, 0x0e, 0xb1,
-	0x0c, 0xf9, 0x37, 0x55, 0x34, 0x98, 0xe7, 0xed, 0x1e, 0x0e, 0xb1, 0x59, 0x72, 0xe5, 0xd7, 0x59,
-	0xef, 0x20, 0xb7, 0x61, 0x29, 0x10, 0xa1, 0x6d, 0x77, 0x53, 0xea, 0x5b, 0xe0, 0xea, 0x3b, 0x1f,
-	0x2d, 0x6e, 0x27, 0xd5, 0x38, 0x65, 0xaa, 0x51, 0x9a, 0x3a, 0xd5, 0xf8, 0x4d, 0x0e, 0x96, 0x99,
-	0xec, 0x77, 0x2c, 0xd7, 0xf2, 0xfb, 0x78, 0xf6, 0x06, 0xfd, 0xbf, 0x53, 0xe8, 0x27, 0x32, 0x61,
-	0x41, 0x91, 0x09, 0xd3, 0x45, 0xa1, 0x98, 0x2d, 0x0a, 0x6f, 0x41, 0x45, 0xd2, 0xb0, 0x89, 0x8f,
-	0xb9, 0xb2, 0x4b, 0x26, 0x08, 0x50, 0x9b, 0xf8, 0xbc, 0xa5, 0x67, 0xfb, 0xf9, 0xea, 0x02, 0x5f,
-	0x5d, 0xb0, 0x69, 0xc8, 0x97, 0x2e, 0x02, 0x3c, 0xb5, 0x5c, 0xc7, 0xe6, 0x4e, 0xc2, 0xd5, 0x54,
-	0x32, 0xcb, 0x1c, 0xc2, 0x54, 0x60, 0xfc, 0x5a, 0x83, 0xe5, 0x4f, 0x2d, 0xdf, 0x26, 0x7b, 0x7b,
-	0x67, 0xcf, 0xaf, 0x1b, 0x10, 0x35, 0xec, 0x9d, 0x93, 0x34, 0x94, 0xa9, 0x4d, 0xc6, 0x2f, 0x72,
-	0x80, 0x12, 0xf6, 0x3a, 0xbd, 0x34, 0x57, 0xa1, 0x9e, 0xd2, 0x7c, 0xfc, 0x9e, 0x93, 0x54, 0x3d,
-	0x65, 0x75, 0xaf, 0x27, 0x58, 0x75, 0x03, 0x6c, 0x51, 0xe2, 0x73, 0x33, 0xce, 0x5c, 0xf7, 0x7a,
-	0x91, 0x98, 0x6c, 0x2b, 0xb3, 0xd4, 0xd8, 0x90, 0xd1, 0x18, 0x10, 0x62, 0x4b, 0x52, 0xd6, 0xf0,
-	0x64, 0xbb, 0xc9, 0xa8, 0x6e, 0xe8, 0x34, 0xdd, 0x48, 0x52, 0xe3, 0x5f, 0x1a, 0x2c, 0xca, 0x5f,
-	0x16, 0xbf, 0x03, 0x1c, 0x15, 0x08, 0xe2, 0xbb, 0x8e, 0x1f, 0x7b, 0x94, 0xcc, 0x48, 0x02, 0x28,
-	0x5d, 0xe6, 0x53, 0x68, 0x48, 0xa4, 0x38, 0xc3, 0xce, 0x68, 0x8d, 0xba, 0xd8, 0x17, 0xe7, 0xd6,
-	0xab, 0x50, 0x27, 0x7b, 0x7b, 0x49, 0x7e, 0xc2, 0xcd, 0x6b, 0x12, 0x2a, 0x19, 0x7e, 0x06, 0x7a,
-	0x84, 0x76, 0xd2, 0x9c, 0xde, 0x90, 0x1b, 0xe3, 0x0e, 0xf5, 0x97, 0x1a, 0x34, 0xd3, 0x19, 0x3e,
-	0x71, 0xfc, 0x93, 0x3b, 0xc2, 0x0f, 0xd2, 0x03, 0x8e, 0xab, 0x47, 0xc8, 0x33, 0xe6, 0x23, 0x6f,
-	0x55, 0xab, 0xcf, 0xa1, 0x9e, 0x4e, 0xc5, 0xa8, 0x0a, 0xa5, 0x2d, 0x12, 0x7e, 0xf2, 0xcc, 0xa1,
-	0xa1, 0x3e, 0x87, 0xea, 0x00, 0x5b, 0x24, 0xdc, 0x0e, 0x30, 0xc5, 0x7e, 0xa8, 0x6b, 0x08, 0x60,
-	0xfe, 0x81, 0xdf, 0x76, 0xe8, 0x63, 0x3d, 0x87, 0xce, 0xc9, 0x81, 0xaf, 0xe5, 0x76, 0x64, 0x5e,
-	0xd2, 0xf3, 0x6c, 0x7b, 0xfc, 0x57, 0x40, 0x3a, 0x54, 0x63, 0x94, 0xcd, 0xed, 0xcf, 0xf5, 0x22,
-	0x2a, 0x43, 0x51, 0x7c, 0xce, 0xaf, 0x3e, 0x00, 0x3d, 0xeb, 0x70, 0xa8, 0x02, 0x0b, 0xfb, 0x22,
-	0x5e, 0xf5, 0x39, 0xd4, 0x80, 0x8a, 0x3b, 0x0e, 0x15, 0x5d, 0x63, 0x80, 0x41, 0x30, 0xec, 0xcb,
-	0xa0, 0xd1, 0x73, 0x8c, 0x1b, 0xb3, 0x5a, 0x9b, 0x1c, 0xf8, 0x7a, 0x7e, 0xf5, 0x33, 0xa8, 0x26,
-	0xa7, 0x58, 0xa8, 0x04, 0x85, 0x2d, 0xe2, 0x63, 0x7d, 0x8e, 0x91, 0xdd, 0x0c, 0xc8, 0x81, 0xe3,
-	0x0f, 0xc4, 0x19, 0xee, 0x06, 0xe4, 0x39, 0xf6, 0xf5, 0x1c, 0x5b, 0x60, 0x7e, 0xc9, 0x16, 0xf2,
-	0x6c, 0x41, 0x38, 0xa9, 0x5e, 0x58, 0x7d, 0x1f, 0x4a, 0x51, 0x49, 0x40, 0x8b, 0x50, 0x4b, 0x3d,
-	0x17, 0xe9, 0x73, 0x08, 0x89, 0xeb, 0xe4, 0x38, 0xf9, 0xeb, 0xda, 0xfa, 0x5f, 0x2b, 0x00, 0xe2,
-	0x56, 0x42, 0x48, 0x60, 0xa3, 0x21, 0xa0, 0x4d, 0x1c, 0x6e, 0x10, 0x6f, 0x48, 0xfc, 0x48, 0x24,
-	0x8a, 0x6e, 0x4d, 0x29, 0xda, 0x93, 0xa8, 0xf2, 0x94, 0xad, 0x6b, 0x53, 0x76, 0x64, 0xd0, 0x8d,
-	0x39, 0xe4, 0x71, 0x8e, 0xac, 0x9b, 0xda, 0x75, 0xfa, 0x8f, 0xa3, 0xb7, 0x86, 0x23, 0x38, 0x66,
-	0x50, 0x23, 0x8e, 0x99, 0x8a, 0x2d, 0x7f, 0x76, 0xc2, 0xc0, 0xf1, 0x07, 0xd1, 0x30, 0xce, 0x98,
-	0x43, 0x4f, 0xe0, 0xfc, 0x26, 0xe6, 0xdc, 0x1d, 0x1a, 0x3a, 0x7d, 0x1a, 0x31, 0x5c, 0x9f, 0xce,
-	0x70, 0x02, 0xf9, 0x84, 0x2c, 0x5d, 0x68, 0x64, 0xde, 0xc4, 0xd1, 0xaa, 0xd2, 0xdf, 0x95, 0xef,
-	0xf7, 0xad, 0x77, 0x67, 0xc2, 0x8d, 0xb9, 0x39, 0x50, 0x4f, 0xbf, 0x17, 0xa3, 0x77, 0xa6, 0x11,
-	0x98, 0x78, 0x60, 0x6b, 0xad, 0xce, 0x82, 0x1a, 0xb3, 0x7a, 0x08, 0xf5, 0xf4, 0x8b, 0xa4, 0x9a,
-	0x95, 0xf2, 0xd5, 0xb2, 0x75, 0xd4, 0x1c, 0xd4, 0x98, 0x43, 0x3f, 0x83, 0xc5, 0x89, 0x67, 0x40,
-	0xf4, 0x6d, 0x15, 0xf9, 0x69, 0xaf, 0x85, 0xc7, 0x71, 0x90, 0xd2, 0x8f, 0xb5, 0x38, 0x5d, 0xfa,
-	0x89, 0xf7, 0xe0, 0xd9, 0xa5, 0x4f, 0x90, 0x3f, 0x4a, 0xfa, 0x13, 0x73, 0x18, 0x01, 0x9a, 0x7c,
-	0x08, 0x44, 0xef, 0xa9, 0x58, 0x4c, 0x7d, 0x8c, 0x6c, 0xad, 0xcd, 0x8a, 0x1e, 0x9b, 0x7c, 0xc4,
-	0xa3, 0x35, 0xfb, 0x64, 0xa6, 0x64, 0x3b, 0xf5, 0x0d, 0x50, 0xcd, 0x76, 0xfa, 0x2b, 0x97, 0x70,
-	0xea, 0xf4, 0x78, 0x5d, 0x6d, 0x2b, 0xe5, 0xcb, 0x93, 0xda, 0xa9, 0xd5, 0xd3, 0x7a, 0x63, 0x0e,
-	0xed, 0x42, 0x25, 0x71, 0xd5, 0x41, 0xd7, 0xa6, 0xf9, 0x44, 0xfa, 0x2e, 0x74, 0x9c, 0xb9, 0xba,
-	0x00, 0x9b, 0x38, 0xbc, 0x8f, 0xc3, 0xc0, 0xe9, 0xd3, 0x2c, 0x51, 0xf9, 0x33, 0x46, 0x88, 0x88,
-	0x5e, 0x3f, 0x16, 0x2f, 0x12, 0x7b, 0xfd, 0x2b, 0x80, 0x32, 0xb7, 0x19, 0xab, 0xfd, 0xff, 0x4f,
-	0xe3, 0x2f, 0x21, 0x8d, 0x3f, 0x82, 0x46, 0xe6, 0x6d, 0x45, 0x9d, 0xc6, 0xd5, 0x0f, 0x30, 0xc7,
-	0x39, 0x48, 0x0f, 0xd0, 0xe4, 0x03, 0x80, 0x3a, 0xb0, 0xa6, 0x3e, 0x14, 0x1c, 0xc7, 0xe3, 0x11,
-	0x34, 0x32, 0x03, 0x78, 0xf5, 0x09, 0xd4, 0x53, 0xfa, 0x19, 0x4e, 0x30, 0x39, 0x78, 0x56, 0x9f,
-	0x60, 0xea, 0x80, 0xfa, 0x38, 0x1e, 0x5f, 0x40, 0x35, 0x39, 0xf2, 0x43, 0xd7, 0xa7, 0x45, 0x67,
-	0xa6, 0x71, 0x7a, 0xf5, 0xf9, 0xfa, 0xe5, 0xd7, 0xb3, 0x47, 0xd0, 0xc8, 0x4c, 0xe5, 0xd4, 0xd6,
-	0x55, 0x8f, 0xee, 0x8e, 0xa3, 0xfe, 0x0d, 0x66, 0xe0, 0x97, 0x9d, 0x2b, 0xef, 0x7c, 0xf0, 0x70,
-	0x7d, 0xe0, 0x84, 0xfb, 0xa3, 0x1e, 0x3b, 0xe5, 0x4d, 0x81, 0xf9, 0x9e, 0x43, 0xe4, 0xd7, 0xcd,
-	0x28, 0x69, 0xdc, 0xe4, 0x94, 0x6e, 0x72, 0x69, 0x87, 0xbd, 0xde, 0x3c, 0xff, 0xbd, 0xfd, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0xe8, 0x29, 0x4c, 0xf1, 0x29, 0x00, 0x00,
+	// 2620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x5b, 0x6f, 0x1c, 0x49,
+	0xd5, 0xee, 0xb9, 0xd8, 0x33, 0x67, 0x6e, 0xed, 0x72, 0xec, 0x4c, 0xe6, 0xdb, 0xec, 0x3a, 0xbd,
+	0x9b, 0xcb, 0x3a, 0xbb, 0xce, 0xae, 0xb3, 0x1f, 0xb0, 0x82, 0x7d, 0xd8, 0x78, 0xd6, 0xde, 0x59,
+	0x12, 0xc7, 0xb4, 0xbd, 0x41, 0x44, 0x41, 0x43, 0xcf, 0x74, 0x79, 0xdc, 0x4a, 0x4f, 0xd7, 0xa4,
+	0xab, 0x27, 0x89, 0xf3, 0x8c, 0x04, 0x3c, 0x20, 0x7e, 0x00, 0x08, 0x09, 0x29, 0x08, 0xed, 0x03,
+	0x6f, 0xc0, 0x73, 0x5e, 0x79, 0xe3, 0x17, 0x20, 0x21, 0xf8, 0x0b, 0xf0, 0x8c, 0xea, 0xd2, 0x3d,
+	0x7d, 0x1b, 0xbb, 0xed, 0x21, 0x9b, 0x08, 0xf1, 0xd6, 0x75, 0xea, 0x54, 0x9d, 0x53, 0xe7, 0x5e,
+	0x75, 0x1a, 0x16, 0x1f, 0x8d, 0xb1, 0x7b, 0xd4, 0xed, 0x13, 0xe2, 0x9a, 0xeb, 0x23, 0x97, 0x78,
+	0x04, 0xa1, 0xa1, 0x65, 0x3f, 0x1e, 0x53, 0x31, 0x5a, 0xe7, 0xf3, 0xad, 0x6a, 0x9f, 0x0c, 0x87,
+	0xc4, 0x11, 0xb0, 0x56, 0x35, 0x8c, 0xd1, 0xaa, 0x5b, 0x8e, 0x87, 0x5d, 0xc7, 0xb0, 0xfd, 0x59,
+	0xda, 0x3f, 0xc4, 0x43, 0x43, 0x8e, 0x54, 0xd3, 0xf0, 0x8c, 0xf0, 0xfe, 0xad, 0x45, 0xcb, 0x31,
+	0xf1, 0xd3, 0x30, 0x48, 0xfb, 0xb1, 0x02, 0x2b, 0x7b, 0x87, 0xe4, 0xc9, 0x26, 0xb1, 0x6d, 0xdc,
+	0xf7, 0x2c, 0xe2, 0x50, 0x1d, 0x3f, 0x1a, 0x63, 0xea, 0xa1, 0x0f, 0xa0, 0xd0, 0x33, 0x28, 0x6e,
+	0x2a, 0xab, 0xca, 0xb5, 0xca, 0xc6, 0x1b, 0xeb, 0x11, 0xe6, 0x24, 0x57, 0x77, 0xe8, 0xe0, 0x96,
+	0x41, 0xb1, 0xce, 0x31, 0x11, 0x82, 0x82, 0xd9, 0xeb, 0xb4, 0x9b, 0xb9, 0x55, 0xe5, 0x5a, 0x5e,
+	0xe7, 0xdf, 0xe8, 0x1d, 0xa8, 0xf5, 0x83, 0xbd, 0x3b, 0x6d, 0xda, 0xcc, 0xaf, 0xe6, 0xaf, 0xe5,
+	0xf5, 0x28, 0x50, 0xfb, 0x9d, 0x02, 0xe7, 0x13, 0x6c, 0xd0, 0x11, 0x71, 0x28, 0x46, 0x37, 0x61,
+	0x9e, 0x7a, 0x86, 0x37, 0xa6, 0x92, 0x93, 0xff, 0x4b, 0xe5, 0x64, 0x8f, 0xa3, 0xe8, 0x12, 0x35,
+	0x49, 0x36, 0x97, 0x42, 0x16, 0x7d, 0x08, 0xe7, 0x2c, 0xe7, 0x0e, 0x1e, 0x12, 0xf7, 0xa8, 0x3b,
+	0xc2, 0x6e, 0x1f, 0x3b, 0x9e, 0x31, 0xc0, 0x3e, 0x8f, 0x4b, 0xfe, 0xdc, 0xee, 0x64, 0x4a, 0xfb,
+	0xad, 0x02, 0xcb, 0x8c, 0xd3, 0x5d, 0xc3, 0xf5, 0xac, 0x97, 0x20, 0x2f, 0x0d, 0xaa, 0x61, 0x1e,
+	0x9b, 0x79, 0x3e, 0x17, 0x81, 0x31, 0x9c, 0x91, 0x4f, 0x9e, 0x9d, 0xad, 0xc0, 0xd9, 0x8d, 0xc0,
+	0xb4, 0xe7, 0x52, 0xb1, 0x61, 0x3e, 0x67, 0x11, 0x68, 0x9c, 0x66, 0x2e, 0x49, 0xf3, 0x2c, 0xe2,
+	0x7c, 0xa1, 0xc0, 0xf2, 0x6d, 0x62, 0x98, 0x13, 0xc5, 0x7f, 0xfd, 0xe2, 0xfc, 0x04, 0xe6, 0x85,
+	0xe3, 0x34, 0x0b, 0x9c, 0xd6, 0xe5, 0x28, 0x2d, 0x31, 0xb7, 0x3e, 0xe1, 0x70, 0x8f, 0x03, 0x74,
+	0xb9, 0x48, 0xfb, 0x95, 0x02, 0x4d, 0x1d, 0xdb, 0xd8, 0xa0, 0xf8, 0x55, 0x9e, 0x62, 0x05, 0xe6,
+	0x1d, 0x62, 0xe2, 0x4e, 0x9b, 0x9f, 0x22, 0xaf, 0xcb, 0x91, 0xf6, 0x0f, 0x29, 0xe1, 0xd7, 0xdc,
+	0x60, 0x43, 0x5a, 0x28, 0x9e, 0x45, 0x0b, 0x2f, 0x26, 0x5a, 0x78, 0xdd, 0x4f, 0x3a, 0xd1, 0x54,
+	0x31, 0xa2, 0xa9, 0x1f, 0xc0, 0x85, 0x4d, 0x17, 0x1b, 0x1e, 0xfe, 0x1e, 0x8b, 0xfc, 0x9b, 0x87,
+	0x86, 0xe3, 0x60, 0xdb, 0x3f, 0x42, 0x9c, 0xb8, 0x92, 0x42, 0xbc, 0x09, 0x0b, 0x23, 0x97, 0x3c,
+	0x3d, 0x0a, 0xf8, 0xf6, 0x87, 0xda, 0x6f, 0x14, 0x68, 0xa5, 0xed, 0x3d, 0x4b, 0x44, 0xb8, 0x0a,
+	0x0d, 0x57, 0x30, 0xd7, 0xed, 0x8b, 0xfd, 0x38, 0xd5, 0xb2, 0x5e, 0x97, 0x60, 0x49, 0x05, 0x5d,
+	0x86, 0xba, 0x8b, 0xe9, 0xd8, 0x9e, 0xe0, 0xe5, 0x39, 0x5e, 0x4d, 0x40, 0x25, 0x9a, 0xf6, 0x95,
+	0x02, 0x17, 0xb6, 0xb1, 0x17, 0x68, 0x8f, 0x91, 0xc3, 0xaf, 0x69, 0x74, 0xfd, 0xb5, 0x02, 0x8d,
+	0x18, 0xa3, 0x68, 0x15, 0x2a, 0x21, 0x1c, 0xa9, 0xa0, 0x30, 0x08, 0x7d, 0x0b, 0x8a, 0x4c, 0x76,
+	0x98, 0xb3, 0x54, 0xdf, 0xd0, 0xd6, 0x93, 0xf9, 0x7e, 0x3d, 0xba, 0xab, 0x2e, 0x16, 0xa0, 0x1b,
+	0xb0, 0x94, 0x12, 0x59, 0x25, 0xfb, 0x28, 0x19, 0x58, 0xb5, 0xdf, 0x2b, 0xd0, 0x4a, 0x13, 0xe6,
+	0x2c, 0x0a, 0xbf, 0x0f, 0x2b, 0xc1, 0x69, 0xba, 0x26, 0xa6, 0x7d, 0xd7, 0x1a, 0xb1, 0x6f, 0x91,
+	0x0c, 0x2a, 0x1b, 0x6f, 0x9f, 0x7c, 0x1e, 0xaa, 0x2f, 0x07, 0x5b, 0xb4, 0x43, 0x3b, 0x68, 0x3f,
+	0x57, 0x60, 0x79, 0x1b, 0x7b, 0x7b, 0x78, 0x30, 0xc4, 0x8e, 0xd7, 0x71, 0x0e, 0xc8, 0xd9, 0x15,
+	0xff, 0x26, 0x00, 0x95, 0xfb, 0x04, 0x89, 0x2a, 0x04, 0xc9, 0x62, 0x04, 0xda, 0x4f, 0x8a, 0x50,
+	0x09, 0x31, 0x83, 0xde, 0x80, 0x72, 0xb0, 0x83, 0x54, 0xed, 0x04, 0x90, 0xd8, 0x31, 0x97, 0x62,
+	0x56, 0x31, 0xf3, 0xc8, 0x27, 0xcd, 0x63, 0x4a, 0x04, 0x47, 0x17, 0xa0, 0x34, 0xc4, 0xc3, 0x2e,
+	0xb5, 0x9e, 0x61, 0x19, 0x31, 0x16, 0x86, 0x78, 0xb8, 0x67, 0x3d, 0xc3, 0x6c, 0xca, 0x19, 0x0f,
+	0xbb, 0x2e, 0x79, 0x42, 0x9b, 0xf3, 0x62, 0xca, 0x19, 0x0f, 0x75, 0xf2, 0x84, 0xa2, 0x8b, 0x00,
+	0xa2, 0xdc, 0x73, 0x8c, 0x21, 0x6e, 0x2e, 0x70, 0x8f, 0x2b, 0x73, 0xc8, 0x8e, 0x31, 0xc4, 0x2c,
+	0x56, 0xf0, 0x41, 0xa7, 0xdd, 0x2c, 0x89, 0x85, 0x72, 0xc8, 0x8e, 0x2a, 0xfd, 0xb4, 0xd3, 0x6e,
+	0x96, 0xc5, 0xba, 0x00, 0x80, 0x3e, 0x83, 0x9a, 0x3c, 0x77, 0x57, 0xd8, 0x32, 0x70, 0x5b, 0x5e,
+	0x4d, 0xd3, 0xbd, 0x14, 0xa0, 0xb0, 0xe4, 0x2a, 0x0d, 0x8d, 0xd0, 0x15, 0xa8, 0xf7, 0xc9, 0x70,
+	0x64, 0x70, 0xe9, 0x6c, 0xb9, 0x64, 0xd8, 0xac, 0x70, 0x3d, 0xc5, 0xa0, 0xe8, 0x03, 0x58, 0xea,
+	0xf3, 0xb8, 0x65, 0xde, 0x3a, 0xda, 0x0c, 0xa6, 0x9a, 0xd5, 0x55, 0xe5, 0x5a, 0x49, 0x4f, 0x9b,
+	0x42, 0xdf, 0xf4, 0x9d, 0xac, 0xc6, 0x19, 0xbb, 0x94, 0x6e, 0xd9, 0x61, 0xce, 0xa4, 0x8f, 0x5d,
+	0x82, 0x2a, 0x76, 0x8c, 0x9e, 0x8d, 0xbb, 0x5c, 0x12, 0xcd, 0x3a, 0xa7, 0x51, 0x11, 0xb0, 0x0e,
+	0x03, 0xa1, 0xbb, 0xa0, 0x0a, 0x99, 0x8e, 0x0c, 0xef, 0xb0, 0x6b, 0x39, 0x07, 0x84, 0x36, 0x1b,
+	0xab, 0xf9, 0x64, 0xb6, 0xe2, 0x58, 0xeb, 0x7c, 0xd1, 0x96, 0x65, 0xe3, 0x5d, 0xc3, 0x3b, 0xe4,
+	0x36, 0x5d, 0xe7, 0x13, 0xfe, 0x90, 0x32, 0x9a, 0x46, 0xbf, 0x8f, 0x29, 0xed, 0xf6, 0xc9, 0xd8,
+	0xf1, 0x9a, 0xaa, 0xb0, 0x0a, 0x01, 0xdb, 0x64, 0x20, 0x5e, 0xa1, 0xc7, 0x3d, 0x63, 0x16, 0x2f,
+	0xfe, 0x7f, 0x28, 0x0a, 0xc6, 0x85, 0xd3, 0xbe, 0x75, 0x8c, 0xe2, 0x38, 0x31, 0x81, 0xad, 0xfd,
+	0x29, 0x0f, 0x2b, 0x9f, 0x9a, 0x66, 0x5a, 0x6a, 0x3a, 0xbd, 0x87, 0x4e, 0x2c, 0x3d, 0x17, 0xb1,
+	0xf4, 0x2c, 0xe1, 0xf9, 0x3a, 0x2c, 0xc6, 0xd2, 0x8e, 0x74, 0x98, 0xb2, 0xae, 0x46, 0x13, 0x4f,
+	0xa7, 0x8d, 0xde, 0x05, 0x35, 0x9a, 0x7a, 0x64, 0xd2, 0x2d, 0xeb, 0x8d, 0x48, 0xf2, 0xe9, 0xb4,
+	0xd1, 0x37, 0xe0, 0xfc, 0xc0, 0x26, 0x3d, 0xc3, 0xee, 0x52, 0x6c, 0xd8, 0xd8, 0xec, 0x4e, 0xfc,
+	0x7d, 0x9e, 0x9b, 0xe6, 0xb2, 0x98, 0xde, 0xe3, 0xb3, 0xbe, 0x84, 0xda, 0x68, 0x9b, 0x39, 0x04,
+	0x7e, 0xd8, 0x1d, 0x11, 0xca, 0x1d, 0x99, 0xbb, 0x5a, 0x25, 0x1e, 0xdc, 0x83, 0x9b, 0xda, 0x1d,
+	0x3a, 0xd8, 0x95, 0x98, 0xcc, 0x25, 0xf0, 0x43, 0x7f, 0x84, 0xbe, 0x84, 0x95, 0x54, 0x06, 0x68,
+	0xb3, 0x94, 0x4d, 0x53, 0xe7, 0x52, 0x18, 0xa4, 0xda, 0xdf, 0x14, 0xb8, 0xa0, 0xe3, 0x21, 0x79,
+	0x8c, 0xff, 0x6b, 0x75, 0xa7, 0xfd, 0x3d, 0x07, 0x2b, 0xdf, 0x37, 0xbc, 0xfe, 0x61, 0x7b, 0x28,
+	0x81, 0xf4, 0xd5, 0x1c, 0x30, 0x16, 0xe4, 0x0b, 0xc9, 0x20, 0x1f, 0xb8, 0x5f, 0x31, 0x4d, 0xa9,
+	0xec, 0xca, 0xbe, 0x7e, 0xcf, 0x3f, 0xef, 0xc4, 0xfd, 0x42, 0xd5, 0xf1, 0xfc, 0x19, 0xaa, 0x63,
+	0xb4, 0x09, 0x35, 0xfc, 0xb4, 0x6f, 0x8f, 0x4d, 0x2c, 0xa3, 0xd6, 0x02, 0xa7, 0xfe, 0x66, 0x0a,
+	0xf5, 0xb0, 0x45, 0x55, 0xe5, 0xa2, 0x0e, 0x0f, 0x01, 0x2f, 0x14, 0xb8, 0x20, 0xa4, 0x8c, 0x6d,
+	0xcf, 0x78, 0xb5, 0x82, 0x0e, 0xc4, 0x58, 0x38, 0x8d, 0x18, 0xb5, 0xe7, 0x05, 0x68, 0xc8, 0x03,
+	0xb2, 0x3b, 0x51, 0x86, 0xd4, 0x1e, 0xd3, 0x68, 0x2e, 0xa9, 0xd1, 0x2c, 0xec, 0xfa, 0xb5, 0x68,
+	0x21, 0x54, 0x8b, 0x5e, 0x04, 0x38, 0xb0, 0xc7, 0xf4, 0xb0, 0xeb, 0x59, 0x43, 0x3f, 0xb1, 0x97,
+	0x39, 0x64, 0xdf, 0x1a, 0x62, 0xf4, 0x29, 0x54, 0x7b, 0x96, 0x63, 0x93, 0x01, 0x4f, 0x36, 0xb4,
+	0x39, 0x3f, 0x55, 0x63, 0x5b, 0x16, 0xb6, 0xcd, 0x5b, 0x1c, 0x57, 0xaf, 0x88, 0x35, 0x2c, 0xc3,
+	0x50, 0xf4, 0x26, 0x54, 0x58, 0x75, 0x40, 0x0e, 0x44, 0x81, 0xb0, 0x20, 0x48, 0x38, 0xe3, 0xe1,
+	0xdd, 0x03, 0x5e, 0x22, 0x7c, 0x07, 0xca, 0x2c, 0x29, 0x50, 0x9b, 0x0c, 0xfc, 0x20, 0x73, 0xd2,
+	0xfe, 0x93, 0x05, 0xe8, 0x13, 0x28, 0x9b, 0xcc, 0x10, 0xf8, 0xea, 0xf2, 0x54, 0x35, 0x70, 0x63,
+	0xb9, 0x4d, 0x06, 0x5c, 0x0d, 0x93, 0x15, 0x29, 0x15, 0x00, 0xa4, 0x56, 0x00, 0xf1, 0xb4, 0x5c,
+	0xc9, 0x96, 0x96, 0xab, 0x33, 0xa4, 0x65, 0xed, 0x5f, 0x39, 0x58, 0x62, 0xf6, 0xe1, 0x07, 0xd1,
+	0xb3, 0xdb, 0xf8, 0x45, 0x00, 0x93, 0x7a, 0xdd, 0x88, 0x9d, 0x97, 0x4d, 0xea, 0xed, 0x70, 0x00,
+	0xfa, 0xd8, 0x37, 0xe3, 0xfc, 0xf4, 0x0a, 0x3a, 0x66, 0xaf, 0xc9, 0x88, 0x70, 0x96, 0x57, 0x0b,
+	0xf4, 0x5d, 0xa8, 0xdb, 0xc4, 0x30, 0xbb, 0x7d, 0xe2, 0x98, 0x22, 0x6f, 0x15, 0x79, 0xbd, 0xf4,
+	0x4e, 0x1a, 0x0b, 0xfb, 0xae, 0x35, 0x18, 0x60, 0x77, 0xd3, 0xc7, 0xd5, 0x6b, 0x36, 0x7f, 0xb3,
+	0x91, 0x43, 0xf4, 0x36, 0xd4, 0x28, 0x19, 0xbb, 0x7d, 0xec, 0x1f, 0x54, 0xd4, 0xa2, 0x55, 0x01,
+	0xdc, 0x49, 0x77, 0xeb, 0x85, 0x94, 0xb2, 0xfb, 0xaf, 0x0a, 0xac, 0xc8, 0x5b, 0xfc, 0xec, 0xb2,
+	0x9f, 0x16, 0x5f, 0x7c, 0x67, 0xcc, 0x1f, 0x73, 0x31, 0x2c, 0x64, 0xb8, 0x18, 0x16, 0x53, 0xee,
+	0xf6, 0xd1, 0xbb, 0xc7, 0x7c, 0xfc, 0xee, 0xa1, 0xed, 0x43, 0x2d, 0xc8, 0x51, 0x3c, 0xfa, 0xbc,
+	0x0d, 0x35, 0xc1, 0x56, 0x97, 0x89, 0x14, 0x9b, 0xfe, 0xc5, 0x5e, 0x00, 0x6f, 0x73, 0x18, 0xdb,
+	0x35, 0xc8, 0x81, 0xa2, 0x70, 0x2b, 0xeb, 0x21, 0x88, 0xf6, 0xc7, 0x1c, 0xa8, 0xe1, 0xec, 0xce,
+	0x77, 0xce, 0xf2, 0x62, 0x70, 0x15, 0x1a, 0xf2, 0x19, 0x3a, 0x48, 0xb1, 0xf2, 0x0e, 0xff, 0x28,
+	0xbc, 0x5d, 0x1b, 0x7d, 0x04, 0x2b, 0x02, 0x31, 0x91, 0x92, 0xc5, 0x5d, 0xfe, 0x1c, 0x9f, 0xd5,
+	0x63, 0x35, 0xd5, 0xf4, 0x92, 0xa6, 0x30, 0x43, 0x49, 0x93, 0x2c, 0xb9, 0x8a, 0x67, 0x2b, 0xb9,
+	0xb4, 0xbf, 0xe4, 0xa1, 0x3e, 0xf1, 0x90, 0xcc, 0x52, 0xcb, 0xf2, 0x16, 0xba, 0x03, 0x6a, 0x30,
+	0x16, 0x37, 0xa5, 0x63, 0x9d, 0x3c, 0x7e, 0x4d, 0x6e, 0x8c, 0xa2, 0x00, 0xb4, 0x05, 0x35, 0x29,
+	0xf3, 0x6e, 0x38, 0xf1, 0x5d, 0x4a, 0xdb, 0x2c, 0x62, 0x61, 0x7a, 0x35, 0x94, 0x07, 0x29, 0xfa,
+	0x18, 0xca, 0xdc, 0xef, 0xbd, 0xa3, 0x11, 0x96, 0x2e, 0xff, 0x46, 0xda, 0x1e, 0xcc, 0xf2, 0xf6,
+	0x8f, 0x46, 0x58, 0x2f, 0xd9, 0xf2, 0x6b, 0xd6, 0x1a, 0xe4, 0x26, 0x2c, 0xbb, 0xc2, 0xb5, 0xcd,
+	0x6e, 0x44, 0x7c, 0x0b, 0x5c, 0x7c, 0xe7, 0xfc, 0xc9, 0xdd, 0xb0, 0x18, 0xa7, 0x3c, 0x7c, 0x94,
+	0xa6, 0x3e, 0x7c, 0xfc, 0x32, 0x07, 0x2b, 0x8c, 0xf7, 0x5b, 0x86, 0x6d, 0x38, 0x7d, 0x9c, 0xfd,
+	0x0e, 0xff, 0x9f, 0x49, 0xf4, 0x89, 0x48, 0x58, 0x48, 0x89, 0x84, 0xd1, 0xa4, 0x50, 0x8c, 0x27,
+	0x85, 0xb7, 0xa0, 0x22, 0xf7, 0x30, 0x89, 0x83, 0xb9, 0xb0, 0x4b, 0x3a, 0x08, 0x50, 0x9b, 0x38,
+	0xfc, 0xd6, 0xcf, 0xd6, 0xf3, 0xd9, 0x05, 0x3e, 0xbb, 0x60, 0x52, 0x8f, 0x4f, 0x5d, 0x04, 0x78,
+	0x6c, 0xd8, 0x96, 0xc9, 0x8d, 0x84, 0x8b, 0xa9, 0xa4, 0x97, 0x39, 0x84, 0x89, 0x40, 0xfb, 0x85,
+	0x02, 0x2b, 0x9f, 0x1b, 0x8e, 0x49, 0x0e, 0x0e, 0x66, 0x8f, 0xaf, 0x9b, 0xe0, 0xdf, 0xe9, 0x3b,
+	0xa7, 0xb9, 0x50, 0x46, 0x16, 0x69, 0x3f, 0xcd, 0x01, 0x0a, 0xe9, 0xeb, 0xec, 0xdc, 0x5c, 0x86,
+	0x7a, 0x44, 0xf2, 0x41, 0xcb, 0x27, 0x2c, 0x7a, 0xca, 0xf2, 0x5e, 0x4f, 0x90, 0xea, 0xba, 0xd8,
+	0xa0, 0xc4, 0x69, 0xe6, 0x4f, 0x93, 0xf7, 0x7a, 0x3e, 0x9b, 0x6c, 0x29, 0xd3, 0xd4, 0x44, 0x91,
+	0xfe, 0x4b, 0x21, 0x04, 0x9a, 0xa4, 0xec, 0xc2, 0x13, 0xbf, 0x4d, 0xfa, 0x79, 0x43, 0xa5, 0xd1,
+	0x8b, 0x24, 0xd5, 0xee, 0xc1, 0xd2, 0xae, 0x8b, 0xb7, 0xb0, 0xd7, 0x3f, 0xcc, 0x6e, 0xb6, 0x97,
+	0xa0, 0x4a, 0x3d, 0xe2, 0x1a, 0x03, 0xdc, 0x3d, 0xb4, 0x1c, 0x4f, 0x86, 0xef, 0x8a, 0x84, 0x7d,
+	0x6e, 0x39, 0x9e, 0xf6, 0x07, 0x05, 0xce, 0xc7, 0x36, 0x9e, 0x41, 0xeb, 0x59, 0xde, 0xba, 0x36,
+	0xa1, 0x14, 0x44, 0x7a, 0x11, 0xf4, 0xae, 0xa6, 0x06, 0xbd, 0xe4, 0x69, 0xf5, 0x60, 0xa1, 0x66,
+	0x41, 0x33, 0xc9, 0xf5, 0x2c, 0x2f, 0x1f, 0x4d, 0x58, 0xe8, 0xb1, 0xeb, 0xcb, 0xe4, 0x79, 0x5c,
+	0x0e, 0x35, 0x93, 0x3f, 0xb1, 0xf0, 0x2e, 0x89, 0x4b, 0x06, 0x2e, 0xa6, 0x33, 0xc8, 0x67, 0x3a,
+	0x95, 0x3f, 0x2b, 0x70, 0x3e, 0x41, 0x66, 0x96, 0x03, 0x5d, 0x81, 0x86, 0xac, 0xef, 0x03, 0x69,
+	0x0b, 0x92, 0x35, 0x5e, 0xe3, 0x07, 0xf9, 0xf2, 0x26, 0xac, 0x48, 0x3c, 0x51, 0x63, 0x74, 0x43,
+	0xca, 0x61, 0xe8, 0x4b, 0x1c, 0x5d, 0xd4, 0x1a, 0xc1, 0x22, 0xf6, 0x0c, 0x48, 0x86, 0x23, 0x1b,
+	0x7b, 0xd8, 0xe4, 0x51, 0xac, 0xa4, 0x4f, 0x00, 0xda, 0x3f, 0x15, 0x58, 0x94, 0xa8, 0x2c, 0xd7,
+	0x0c, 0xb0, 0x5f, 0xcc, 0x10, 0xc7, 0xb6, 0x9c, 0x20, 0xfa, 0xc9, 0xec, 0x29, 0x80, 0x32, 0xbc,
+	0x7d, 0x0e, 0x0d, 0x89, 0x14, 0xe2, 0x3a, 0x53, 0xe4, 0xa8, 0x8b, 0x75, 0x01, 0x8b, 0x97, 0xa1,
+	0x4e, 0x0e, 0x0e, 0xc2, 0xf4, 0xc4, 0x79, 0x6a, 0x12, 0x2a, 0x09, 0x7e, 0x01, 0xaa, 0x8f, 0x76,
+	0xda, 0xfa, 0xa3, 0x21, 0x17, 0x06, 0xaf, 0x29, 0x3f, 0x53, 0xa0, 0x19, 0xad, 0x46, 0x42, 0xc7,
+	0x3f, 0xbd, 0xb1, 0x7c, 0x3b, 0xfa, 0x18, 0x77, 0xf9, 0x18, 0x7e, 0x26, 0x74, 0xe4, 0x0d, 0x60,
+	0xed, 0x19, 0xd4, 0xa3, 0x65, 0x03, 0xaa, 0x42, 0x69, 0x87, 0x78, 0x9f, 0x3d, 0xb5, 0xa8, 0xa7,
+	0xce, 0xa1, 0x3a, 0xc0, 0x0e, 0xf1, 0x76, 0x5d, 0x4c, 0xb1, 0xe3, 0xa9, 0x0a, 0x02, 0x98, 0xbf,
+	0xeb, 0xb4, 0x2d, 0xfa, 0x50, 0xcd, 0xa1, 0x25, 0xd9, 0xbf, 0x30, 0xec, 0x8e, 0xcc, 0xa1, 0x6a,
+	0x9e, 0x2d, 0x0f, 0x46, 0x05, 0xa4, 0x42, 0x35, 0x40, 0xd9, 0xde, 0xfd, 0x52, 0x2d, 0xa2, 0x32,
+	0x14, 0xc5, 0xe7, 0xfc, 0xda, 0x0f, 0x41, 0x8d, 0x07, 0x47, 0x54, 0x81, 0x85, 0x43, 0x91, 0x5b,
+	0xd4, 0x39, 0xd4, 0x80, 0x8a, 0x3d, 0x09, 0xeb, 0xaa, 0xc2, 0x00, 0x03, 0x77, 0xd4, 0x97, 0x8e,
+	0xa5, 0xe6, 0x18, 0x35, 0xa6, 0xb5, 0x36, 0x79, 0xe2, 0x08, 0xda, 0x23, 0xe9, 0xed, 0x6a, 0x61,
+	0xed, 0x0b, 0xa8, 0x86, 0x9f, 0x68, 0x51, 0x09, 0x0a, 0x3b, 0xc4, 0xc1, 0xea, 0x1c, 0x23, 0xb2,
+	0xed, 0x92, 0x27, 0x96, 0x33, 0x10, 0x27, 0xda, 0x72, 0xc9, 0x33, 0xec, 0xa8, 0x39, 0x36, 0xc1,
+	0x22, 0x2a, 0x9b, 0xc8, 0xb3, 0x09, 0x11, 0x5e, 0xd5, 0xc2, 0xda, 0x87, 0x50, 0xf2, 0x8b, 0x19,
+	0xb4, 0x08, 0xb5, 0x48, 0x2f, 0x54, 0x9d, 0x43, 0x48, 0x5c, 0x84, 0x26, 0x65, 0x8b, 0xaa, 0x6c,
+	0x3c, 0xaf, 0x01, 0x88, 0x7a, 0x9a, 0x10, 0xd7, 0x44, 0x23, 0x40, 0xdb, 0xd8, 0x63, 0x6f, 0xcc,
+	0xc4, 0xf1, 0x59, 0xa2, 0xe8, 0x83, 0x29, 0xe5, 0x66, 0x12, 0x55, 0x9e, 0xb9, 0x75, 0x65, 0xca,
+	0x8a, 0x18, 0xba, 0x36, 0x87, 0x86, 0x9c, 0x22, 0x7b, 0x07, 0xd8, 0xb7, 0xfa, 0x0f, 0xfd, 0x46,
+	0xda, 0x31, 0x14, 0x63, 0xa8, 0x3e, 0xc5, 0x58, 0xad, 0x29, 0x07, 0x7b, 0x9e, 0x6b, 0x39, 0x03,
+	0x3f, 0xf6, 0x68, 0x73, 0xe8, 0x11, 0x9c, 0x63, 0x4f, 0xcc, 0x9e, 0xe1, 0x59, 0xd4, 0xb3, 0xfa,
+	0xd4, 0x27, 0xb8, 0x31, 0x9d, 0x60, 0x02, 0xf9, 0x94, 0x24, 0x6d, 0x68, 0xc4, 0x7e, 0xf8, 0x40,
+	0x6b, 0xa9, 0xd6, 0x9f, 0xfa, 0x73, 0x4a, 0xeb, 0x7a, 0x26, 0xdc, 0x80, 0x9a, 0x05, 0xf5, 0xe8,
+	0xcf, 0x10, 0xe8, 0xdd, 0x69, 0x1b, 0x24, 0xba, 0xc7, 0xad, 0xb5, 0x2c, 0xa8, 0x01, 0xa9, 0xfb,
+	0x50, 0x8f, 0xb6, 0xdb, 0xd3, 0x49, 0xa5, 0xb6, 0xe4, 0x5b, 0xc7, 0x85, 0x7d, 0x6d, 0x0e, 0xfd,
+	0x08, 0x16, 0x13, 0x3d, 0x6e, 0xf4, 0x5e, 0xda, 0xf6, 0xd3, 0x5a, 0xe1, 0x27, 0x51, 0x90, 0xdc,
+	0x4f, 0xa4, 0x38, 0x9d, 0xfb, 0xc4, 0xcf, 0x0e, 0xd9, 0xb9, 0x0f, 0x6d, 0x7f, 0x1c, 0xf7, 0xa7,
+	0xa6, 0x30, 0x06, 0x94, 0xec, 0x72, 0xa3, 0xf7, 0xd3, 0x48, 0x4c, 0xed, 0xb4, 0xb7, 0xd6, 0xb3,
+	0xa2, 0x07, 0x2a, 0x1f, 0x73, 0x6f, 0x8d, 0xf7, 0x83, 0x53, 0xc9, 0x4e, 0x6d, 0x70, 0xb7, 0xd6,
+	0xb3, 0xa2, 0x87, 0x8d, 0x3a, 0xda, 0x18, 0x4a, 0xd7, 0x55, 0x6a, 0x5b, 0xb5, 0xb5, 0x96, 0x05,
+	0x35, 0x20, 0xb5, 0x0f, 0x95, 0x50, 0x91, 0x8e, 0xae, 0x4c, 0xb3, 0x89, 0x68, 0x15, 0x7f, 0x92,
+	0xba, 0x08, 0xa8, 0xf1, 0x0a, 0x0f, 0x5d, 0xcf, 0x50, 0x28, 0x06, 0x32, 0x7b, 0x2f, 0x1b, 0x72,
+	0x38, 0xe8, 0xc4, 0x0a, 0x30, 0x34, 0x4d, 0x0e, 0x29, 0xc5, 0x60, 0xeb, 0x7a, 0x26, 0xdc, 0x80,
+	0x5a, 0x17, 0x60, 0x1b, 0x7b, 0x77, 0xb0, 0xe7, 0x5a, 0x7d, 0x1a, 0x97, 0x99, 0x1c, 0x4c, 0x10,
+	0x7c, 0x22, 0x57, 0x4f, 0xc4, 0xf3, 0x09, 0x6c, 0x7c, 0x05, 0x50, 0xe6, 0x26, 0xc9, 0x0a, 0x9d,
+	0xff, 0x65, 0xa9, 0x97, 0x90, 0xa5, 0x1e, 0x40, 0x23, 0xd6, 0xf4, 0x4c, 0x37, 0x98, 0xf4, 0xce,
+	0xe8, 0x49, 0xf6, 0xdf, 0x03, 0x94, 0xec, 0xcc, 0xa5, 0xc7, 0x8d, 0xa9, 0x1d, 0xbc, 0x93, 0x68,
+	0x3c, 0x80, 0x46, 0xac, 0x33, 0x96, 0x7e, 0x82, 0xf4, 0xf6, 0x59, 0x86, 0x13, 0x24, 0x3b, 0x42,
+	0xe9, 0x27, 0x98, 0xda, 0x39, 0x3a, 0x89, 0xc6, 0x3d, 0xa8, 0x86, 0xdf, 0xe2, 0xd1, 0xd5, 0x69,
+	0xc1, 0x27, 0x1e, 0x1d, 0x5e, 0x79, 0x3a, 0x7a, 0xf9, 0xe9, 0xfa, 0x01, 0x34, 0x62, 0xcf, 0xe5,
+	0xe9, 0xda, 0x4d, 0x7f, 0x53, 0x3f, 0x69, 0xf7, 0xaf, 0x31, 0xc1, 0xbc, 0xec, 0x58, 0x79, 0xeb,
+	0xa3, 0xfb, 0x1b, 0x03, 0xcb, 0x3b, 0x1c, 0xf7, 0xd8, 0x29, 0x6f, 0x08, 0xcc, 0xf7, 0x2d, 0x22,
+	0xbf, 0x6e, 0xf8, 0x41, 0xe3, 0x06, 0xdf, 0xe9, 0x06, 0xe7, 0x76, 0xd4, 0xeb, 0xcd, 0xf3, 0xe1,
+	0xcd, 0x7f, 0x0f, 0x00, 0x71, 0xdf, 0x45, 0x37, 0xad, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2548,6 +2836,8 @@ type QueryCoordClient interface {
 	GetPartitionStates(ctx context.Context, in *GetPartitionStatesRequest, opts ...grpc.CallOption) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
 	LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	PreFetchSegments(ctx context.Context, in *PreFetchSegmentsRequest, opts ...grpc.CallOption) (*PreFetchSegmentsResponse, error)
+	GetLoadProgress(ctx context.Context, in *GetLoadProgressRequest, opts ...grpc.CallOption) (*GetLoadProgressResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2677,6 +2967,24 @@ func (c *queryCoordClient) LoadBalance(ctx context.Context, in *LoadBalanceReque
 	return out, nil
 }
 
+func (c *queryCoordClient) PreFetchSegments(ctx context.Context, in *PreFetchSegmentsRequest, opts ...grpc.CallOption) (*PreFetchSegmentsResponse, error) {
+	out := new(PreFetchSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/PreFetchSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetLoadProgress(ctx context.Context, in *GetLoadProgressRequest, opts ...grpc.CallOption) (*GetLoadProgressResponse, error) {
+	out := new(GetLoadProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetLoadProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetMetrics", in, out, opts...)
@@ -2701,6 +3009,8 @@ type QueryCoordServer interface {
 	GetPartitionStates(context.Context, *GetPartitionStatesRequest) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
 	LoadBalance(context.Context, *LoadBalanceRequest) (*commonpb.Status, error)
+	PreFetchSegments(context.Context, *PreFetchSegmentsRequest) (*PreFetchSegmentsResponse, error)
+	GetLoadProgress(context.Context, *GetLoadProgressRequest) (*GetLoadProgressResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2748,6 +3058,12 @@ func (*UnimplementedQueryCoordServer) GetSegmentInfo(ctx context.Context, req *G
 func (*UnimplementedQueryCoordServer) LoadBalance(ctx context.Context, req *LoadBalanceRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadBalance not implemented")
 }
+func (*UnimplementedQueryCoordServer) PreFetchSegments(ctx context.Context, req *PreFetchSegmentsRequest) (*PreFetchSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreFetchSegments not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetLoadProgress(ctx context.Context, req *GetLoadProgressRequest) (*GetLoadProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadProgress not implemented")
+}
 func (*UnimplementedQueryCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -2990,6 +3306,42 @@ func _QueryCoord_LoadBalance_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_PreFetchSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreFetchSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).PreFetchSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/PreFetchSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).PreFetchSegments(ctx, req.(*PreFetchSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetLoadProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetLoadProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetLoadProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetLoadProgress(ctx, req.(*GetLoadProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -3064,6 +3416,14 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LoadBalance",
 			Handler:    _QueryCoord_LoadBalance_Handler,
 		},
+		{
+			MethodName: "PreFetchSegments",
+			Handler:    _QueryCoord_PreFetchSegments_Handler,
+		},
+		{
+			MethodName: "GetLoadProgress",
+			Handler:    _QueryCoord_GetLoadProgress_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _QueryCoord_GetMetrics_Handler,
@@ -308,6 +308,32 @@ func (coord *QueryCoordMock) LoadBalance(ctx context.Context, req *querypb.LoadB
 	panic("implement me")
 }
 
+func (coord *QueryCoordMock) PreFetchSegments(ctx context.Context, req *querypb.PreFetchSegmentsRequest) (*querypb.PreFetchSegmentsResponse, error) {
+	if !coord.healthy() {
+		return &querypb.PreFetchSegmentsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+
+	panic("implement me")
+}
+
+func (coord *QueryCoordMock) GetLoadProgress(ctx context.Context, req *querypb.GetLoadProgressRequest) (*querypb.GetLoadProgressResponse, error) {
+	if !coord.healthy() {
+		return &querypb.GetLoadProgressResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+
+	panic("implement me")
+}
+
 func (coord *QueryCoordMock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	if !coord.healthy() {
 		return &milvuspb.GetMetricsResponse{
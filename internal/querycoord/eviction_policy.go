@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// AutoEvictionPolicy selects segments to release from a query node whose memory usage is too high
+type AutoEvictionPolicy interface {
+	// SelectSegmentsToEvict returns the segments to release from the query node,
+	// segmentReplicaNum records the number of online query nodes serving each segment,
+	// and is updated for the selected segments
+	SelectSegmentsToEvict(memUsage uint64, totalMem uint64, segmentInfos []*querypb.SegmentInfo, segmentReplicaNum map[UniqueID]int) []*querypb.SegmentInfo
+}
+
+// LFUEvictionPolicy evicts the least frequently accessed segments until the memory usage drops below low watermark,
+// the last replica of a segment is never evicted
+type LFUEvictionPolicy struct {
+	lowWatermark float64
+}
+
+// NewLFUEvictionPolicy creates a LFUEvictionPolicy with the low watermark of memory usage rate
+func NewLFUEvictionPolicy(lowWatermark float64) *LFUEvictionPolicy {
+	return &LFUEvictionPolicy{
+		lowWatermark: lowWatermark,
+	}
+}
+
+// SelectSegmentsToEvict implements AutoEvictionPolicy
+func (p *LFUEvictionPolicy) SelectSegmentsToEvict(memUsage uint64, totalMem uint64, segmentInfos []*querypb.SegmentInfo, segmentReplicaNum map[UniqueID]int) []*querypb.SegmentInfo {
+	target := uint64(p.lowWatermark * float64(totalMem))
+	if memUsage <= target {
+		return nil
+	}
+
+	candidates := make([]*querypb.SegmentInfo, 0, len(segmentInfos))
+	for _, info := range segmentInfos {
+		if segmentReplicaNum[info.SegmentID] > 1 {
+			candidates = append(candidates, info)
+		}
+	}
+	// the least accessed first, and the larger one first if access counts are equal
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].AccessCount != candidates[j].AccessCount {
+			return candidates[i].AccessCount < candidates[j].AccessCount
+		}
+		if candidates[i].MemSize != candidates[j].MemSize {
+			return candidates[i].MemSize > candidates[j].MemSize
+		}
+		return candidates[i].SegmentID < candidates[j].SegmentID
+	})
+
+	selected := make([]*querypb.SegmentInfo, 0)
+	for _, info := range candidates {
+		if memUsage <= target {
+			break
+		}
+		// replica num may be changed by segments selected earlier
+		if segmentReplicaNum[info.SegmentID] <= 1 {
+			continue
+		}
+		selected = append(selected, info)
+		segmentReplicaNum[info.SegmentID]--
+		if uint64(info.MemSize) >= memUsage {
+			memUsage = 0
+		} else {
+			memUsage -= uint64(info.MemSize)
+		}
+	}
+	return selected
+}
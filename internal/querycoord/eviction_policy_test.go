@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/stretchr/testify/assert"
+)
+
+func getSegmentIDs(infos []*querypb.SegmentInfo) []UniqueID {
+	segmentIDs := make([]UniqueID, 0, len(infos))
+	for _, info := range infos {
+		segmentIDs = append(segmentIDs, info.SegmentID)
+	}
+	return segmentIDs
+}
+
+func TestLFUEvictionPolicy(t *testing.T) {
+	policy := NewLFUEvictionPolicy(0.7)
+	segmentInfos := []*querypb.SegmentInfo{
+		{SegmentID: 1, MemSize: 10, AccessCount: 100},
+		{SegmentID: 2, MemSize: 10, AccessCount: 1},
+		{SegmentID: 3, MemSize: 10, AccessCount: 5},
+		{SegmentID: 4, MemSize: 20, AccessCount: 5},
+		{SegmentID: 5, MemSize: 50, AccessCount: 0},
+	}
+
+	t.Run("test memory usage below low watermark", func(t *testing.T) {
+		replicaNum := map[UniqueID]int{1: 2, 2: 2, 3: 2, 4: 2, 5: 2}
+		selected := policy.SelectSegmentsToEvict(70, 100, segmentInfos, replicaNum)
+		assert.Equal(t, 0, len(selected))
+	})
+
+	t.Run("test evict least frequently accessed segments", func(t *testing.T) {
+		// segment 5 is the last replica
+		replicaNum := map[UniqueID]int{1: 2, 2: 2, 3: 2, 4: 2, 5: 1}
+		selected := policy.SelectSegmentsToEvict(100, 100, segmentInfos, replicaNum)
+		assert.Equal(t, []UniqueID{2, 4}, getSegmentIDs(selected))
+		assert.Equal(t, map[UniqueID]int{1: 2, 2: 1, 3: 2, 4: 1, 5: 1}, replicaNum)
+	})
+
+	t.Run("test not enough segments to evict", func(t *testing.T) {
+		replicaNum := map[UniqueID]int{1: 1, 2: 1, 3: 2, 4: 1, 5: 1}
+		selected := policy.SelectSegmentsToEvict(100, 100, segmentInfos, replicaNum)
+		assert.Equal(t, []UniqueID{3}, getSegmentIDs(selected))
+	})
+
+	t.Run("test replica evicted from other node", func(t *testing.T) {
+		replicaNum := map[UniqueID]int{1: 2, 2: 2, 3: 2, 4: 2, 5: 2}
+		// evict on node A
+		selectedA := policy.SelectSegmentsToEvict(100, 100, segmentInfos, replicaNum)
+		assert.Equal(t, []UniqueID{5}, getSegmentIDs(selectedA))
+		// node B serving the same segments shall not evict the last replica of segment 5
+		selectedB := policy.SelectSegmentsToEvict(100, 100, segmentInfos, replicaNum)
+		assert.Equal(t, []UniqueID{2, 4}, getSegmentIDs(selectedB))
+	})
+}
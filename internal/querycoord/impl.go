@@ -14,6 +14,7 @@ package querycoord
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"go.uber.org/zap"
 
@@ -596,6 +597,111 @@ func (qc *QueryCoord) LoadBalance(ctx context.Context, req *querypb.LoadBalanceR
 	return status, nil
 }
 
+// PreFetchSegments loads segments restored in cold storage into QueryNodes ahead of queries,
+// the returned batch id is used to track the loading progress with GetLoadProgress
+func (qc *QueryCoord) PreFetchSegments(ctx context.Context, req *querypb.PreFetchSegmentsRequest) (*querypb.PreFetchSegmentsResponse, error) {
+	log.Debug("PreFetchSegmentsRequest received",
+		zap.String("role", Params.RoleName),
+		zap.Int64("collectionID", req.CollectionID),
+		zap.Int("numOfSegments", len(req.Segments)))
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("PreFetchSegments failed", zap.Error(err))
+		return &querypb.PreFetchSegmentsResponse{
+			Status: status,
+		}, nil
+	}
+
+	if len(req.Segments) == 0 {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = "no segment to pre-fetch"
+		return &querypb.PreFetchSegmentsResponse{
+			Status: status,
+		}, nil
+	}
+
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_PreFetchSegments
+	baseTask := newBaseTask(qc.loopCtx, querypb.TriggerCondition_preFetch)
+	preFetchTask := &preFetchSegmentsTask{
+		baseTask:                baseTask,
+		PreFetchSegmentsRequest: req,
+		dataCoord:               qc.dataCoordClient,
+		cluster:                 qc.cluster,
+		meta:                    qc.meta,
+	}
+	err := qc.scheduler.Enqueue(preFetchTask)
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		return &querypb.PreFetchSegmentsResponse{
+			Status: status,
+		}, nil
+	}
+	qc.registerPreFetchBatch(preFetchTask)
+
+	log.Debug("PreFetchSegmentsRequest enqueued",
+		zap.String("role", Params.RoleName),
+		zap.Int64("collectionID", req.CollectionID),
+		zap.Int64("batchID", preFetchTask.getTaskID()))
+	return &querypb.PreFetchSegmentsResponse{
+		Status:  status,
+		BatchID: preFetchTask.getTaskID(),
+	}, nil
+}
+
+// GetLoadProgress returns the number of segments loaded in a pre-fetch batch
+func (qc *QueryCoord) GetLoadProgress(ctx context.Context, req *querypb.GetLoadProgressRequest) (*querypb.GetLoadProgressResponse, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("GetLoadProgress failed", zap.Error(err))
+		return &querypb.GetLoadProgressResponse{
+			Status: status,
+		}, nil
+	}
+
+	v, ok := qc.preFetchBatches.Load(req.BatchID)
+	if !ok {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = fmt.Sprintf("pre-fetch batch %d is not found", req.BatchID)
+		return &querypb.GetLoadProgressResponse{
+			Status: status,
+		}, nil
+	}
+	preFetchTask := v.(*preFetchBatch).task
+
+	segmentIDs := preFetchTask.segmentIDs()
+	loaded := 0
+	for _, segmentID := range segmentIDs {
+		if _, err := qc.meta.getSegmentInfoByID(segmentID); err == nil {
+			loaded++
+		}
+	}
+	// the reason of failure is returned if the batch failed to load
+	if result := preFetchTask.getResultInfo(); result.ErrorCode != commonpb.ErrorCode_Success {
+		status = result
+	}
+
+	return &querypb.GetLoadProgressResponse{
+		Status:              status,
+		NumOfSegments:       int64(len(segmentIDs)),
+		NumOfLoadedSegments: int64(loaded),
+		Completed:           loaded == len(segmentIDs),
+	}, nil
+}
+
 func (qc *QueryCoord) isHealthy() bool {
 	code := qc.stateCode.Load().(internalpb.StateCode)
 	return code == internalpb.StateCode_Healthy
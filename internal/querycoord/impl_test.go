@@ -427,6 +427,25 @@ func TestGrpcTaskEnqueueFail(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
 	})
 
+	t.Run("Test PreFetchEmptySegments", func(t *testing.T) {
+		res, err := queryCoord.PreFetchSegments(ctx, &querypb.PreFetchSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_PreFetchSegments,
+			},
+			CollectionID: defaultCollectionID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, res.Status.ErrorCode)
+	})
+
+	t.Run("Test GetLoadProgressBatchNotExist", func(t *testing.T) {
+		res, err := queryCoord.GetLoadProgress(ctx, &querypb.GetLoadProgressRequest{
+			BatchID: -1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, res.Status.ErrorCode)
+	})
+
 	queryCoord.Stop()
 	err = removeAllSession()
 	assert.Nil(t, err)
@@ -612,6 +631,24 @@ func TestGrpcTaskBeforeHealthy(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, res.ErrorCode)
 	})
 
+	t.Run("Test PreFetchSegments", func(t *testing.T) {
+		res, err := unHealthyCoord.PreFetchSegments(ctx, &querypb.PreFetchSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_PreFetchSegments,
+			},
+			CollectionID: defaultCollectionID,
+			Segments:     []*querypb.PreFetchSegmentInfo{{SegmentID: defaultSegmentID}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, res.Status.ErrorCode)
+	})
+
+	t.Run("Test GetLoadProgress", func(t *testing.T) {
+		res, err := unHealthyCoord.GetLoadProgress(ctx, &querypb.GetLoadProgressRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, res.Status.ErrorCode)
+	})
+
 	t.Run("Test ReleasePartition", func(t *testing.T) {
 		status, err := unHealthyCoord.ReleasePartitions(ctx, &querypb.ReleasePartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -294,6 +294,7 @@ type dataCoordMock struct {
 	col2DmChannels      map[UniqueID][]*datapb.VchannelInfo
 	partitionID2Segment map[UniqueID][]UniqueID
 	Segment2Binlog      map[UniqueID]*datapb.SegmentBinlogs
+	segment2Collection  map[UniqueID]UniqueID
 	baseSegmentID       UniqueID
 	channelNumPerCol    int
 }
@@ -324,6 +325,7 @@ func newDataCoordMock(ctx context.Context) (*dataCoordMock, error) {
 		col2DmChannels:      col2DmChannels,
 		partitionID2Segment: partitionID2Segments,
 		Segment2Binlog:      segment2Binglog,
+		segment2Collection:  make(map[UniqueID]UniqueID),
 		baseSegmentID:       defaultSegmentID,
 		channelNumPerCol:    defaultChannelNum,
 	}, nil
@@ -356,6 +358,7 @@ func (data *dataCoordMock) GetRecoveryInfo(ctx context.Context, req *datapb.GetR
 					NumOfRows:    defaultNumRowPerSegment,
 				}
 				data.Segment2Binlog[segmentID] = segmentBinlog
+				data.segment2Collection[segmentID] = collectionID
 			}
 			segmentIDs = append(segmentIDs, segmentID)
 			data.baseSegmentID++
@@ -396,6 +399,43 @@ func (data *dataCoordMock) GetRecoveryInfo(ctx context.Context, req *datapb.GetR
 	}, nil
 }
 
+func (data *dataCoordMock) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	infos := make([]*datapb.SegmentInfo, 0)
+	for _, segmentID := range req.SegmentIDs {
+		segmentBinlog, ok := data.Segment2Binlog[segmentID]
+		if !ok {
+			return &datapb.GetSegmentInfoResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    fmt.Sprintf("segment %d not found", segmentID),
+				},
+			}, nil
+		}
+		var partitionID UniqueID
+		for id, segmentIDs := range data.partitionID2Segment {
+			for _, id2 := range segmentIDs {
+				if id2 == segmentID {
+					partitionID = id
+				}
+			}
+		}
+		infos = append(infos, &datapb.SegmentInfo{
+			ID:           segmentID,
+			CollectionID: data.segment2Collection[segmentID],
+			PartitionID:  partitionID,
+			State:        commonpb.SegmentState_Flushed,
+			NumOfRows:    segmentBinlog.NumOfRows,
+			Binlogs:      segmentBinlog.FieldBinlogs,
+		})
+	}
+	return &datapb.GetSegmentInfoResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Infos: infos,
+	}, nil
+}
+
 type indexCoordMock struct {
 	types.IndexCoord
 	returnIndexFile bool
@@ -76,6 +76,12 @@ type ParamTable struct {
 	OverloadedMemoryThresholdPercentage float64
 	BalanceIntervalSeconds              int64
 	MemoryUsageMaxDifferencePercentage  float64
+
+	//---- Eviction ---
+	AutoEviction                 bool
+	QueryNodeMemoryHighWatermark float64
+	QueryNodeMemoryLowWatermark  float64
+	EvictionIntervalSeconds      int64
 }
 
 // Params are variables of the ParamTable type
@@ -128,6 +134,12 @@ func (p *ParamTable) Init() {
 	p.initOverloadedMemoryThresholdPercentage()
 	p.initBalanceIntervalSeconds()
 	p.initMemoryUsageMaxDifferencePercentage()
+
+	//---- Eviction ---
+	p.initAutoEviction()
+	p.initQueryNodeMemoryHighWatermark()
+	p.initQueryNodeMemoryLowWatermark()
+	p.initEvictionIntervalSeconds()
 }
 
 func (p *ParamTable) initClusterMsgChannelPrefix() {
@@ -310,6 +322,42 @@ func (p *ParamTable) initMemoryUsageMaxDifferencePercentage() {
 	p.MemoryUsageMaxDifferencePercentage = float64(diffPercentage) / 100
 }
 
+func (p *ParamTable) initAutoEviction() {
+	evictionStr := p.LoadWithDefault("queryCoord.autoEviction", "false")
+	autoEviction, err := strconv.ParseBool(evictionStr)
+	if err != nil {
+		panic(err)
+	}
+	p.AutoEviction = autoEviction
+}
+
+func (p *ParamTable) initQueryNodeMemoryHighWatermark() {
+	highWatermark := p.LoadWithDefault("queryCoord.queryNodeMemoryHighWatermark", "85")
+	watermarkPercentage, err := strconv.ParseInt(highWatermark, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	p.QueryNodeMemoryHighWatermark = float64(watermarkPercentage) / 100
+}
+
+func (p *ParamTable) initQueryNodeMemoryLowWatermark() {
+	lowWatermark := p.LoadWithDefault("queryCoord.queryNodeMemoryLowWatermark", "70")
+	watermarkPercentage, err := strconv.ParseInt(lowWatermark, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	p.QueryNodeMemoryLowWatermark = float64(watermarkPercentage) / 100
+}
+
+func (p *ParamTable) initEvictionIntervalSeconds() {
+	evictionInterval := p.LoadWithDefault("queryCoord.evictionIntervalSeconds", "60")
+	interval, err := strconv.ParseInt(evictionInterval, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	p.EvictionIntervalSeconds = interval
+}
+
 func (p *ParamTable) initDmlChannelName() {
 	config, err := p.Load("msgChannel.chanNamePrefix.rootCoordDml")
 	if err != nil {
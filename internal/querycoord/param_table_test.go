@@ -32,4 +32,9 @@ func TestParamTable(t *testing.T) {
 
 	assert.Equal(t, Params.TimeTickChannelName, "by-dev-queryTimeTick")
 	t.Logf("query coord  time tick channel = %s", Params.TimeTickChannelName)
+
+	assert.False(t, Params.AutoEviction)
+	assert.Equal(t, 0.85, Params.QueryNodeMemoryHighWatermark)
+	assert.Equal(t, 0.7, Params.QueryNodeMemoryLowWatermark)
+	assert.Equal(t, int64(60), Params.EvictionIntervalSeconds)
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -45,6 +46,9 @@ import (
 
 const (
 	handoffSegmentPrefix = "querycoord-handoff"
+
+	// preFetchBatchRetention is how long a finished pre-fetch batch is kept for querying its progress
+	preFetchBatchRetention = time.Hour
 )
 
 // Timestamp is an alias for the Int64 type
@@ -72,6 +76,11 @@ type QueryCoord struct {
 	idAllocator  func() (UniqueID, error)
 	indexChecker *IndexChecker
 
+	// batch id => *preFetchBatch, batches are kept in memory for tracking progress only
+	preFetchBatches sync.Map
+
+	evictionPolicy AutoEvictionPolicy
+
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
 	dataCoordClient  types.DataCoord
@@ -156,6 +165,11 @@ func (qc *QueryCoord) Init() error {
 			return
 		}
 
+		// pre-fetch tasks recovered are tracked as if they were just submitted
+		for _, t := range qc.scheduler.reloadedPreFetchTasks {
+			qc.registerPreFetchBatch(t)
+		}
+
 		// init index checker
 		qc.indexChecker, initError = newIndexChecker(qc.loopCtx, qc.kvClient, qc.meta, qc.cluster, qc.scheduler, qc.rootCoordClient, qc.indexCoordClient, qc.dataCoordClient)
 		if initError != nil {
@@ -199,6 +213,14 @@ func (qc *QueryCoord) Start() error {
 	qc.loopWg.Add(1)
 	go qc.loadBalanceSegmentLoop()
 
+	if Params.AutoEviction {
+		if qc.evictionPolicy == nil {
+			qc.evictionPolicy = NewLFUEvictionPolicy(Params.QueryNodeMemoryLowWatermark)
+		}
+		qc.loopWg.Add(1)
+		go qc.autoEvictionLoop()
+	}
+
 	go qc.session.LivenessCheck(qc.loopCtx, func() {
 		log.Error("Query Coord disconnected from etcd, process will exit", zap.Int64("Server Id", qc.session.ServerID))
 		if err := qc.Stop(); err != nil {
@@ -561,6 +583,127 @@ func (qc *QueryCoord) loadBalanceSegmentLoop() {
 	}
 }
 
+func (qc *QueryCoord) autoEvictionLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Debug("query coordinator start auto eviction loop")
+
+	timer := time.NewTicker(time.Duration(Params.EvictionIntervalSeconds) * time.Second)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			qc.evictSegments(ctx)
+		}
+	}
+}
+
+// evictSegments releases segments selected by evictionPolicy from query nodes
+// whose memory usage rate is higher than QueryNodeMemoryHighWatermark
+func (qc *QueryCoord) evictSegments(ctx context.Context) {
+	onlineNodes, err := qc.cluster.onlineNodes()
+	if err != nil {
+		log.Warn("evictSegments: there are no online query node", zap.Error(err))
+		return
+	}
+
+	// collect sealed segments and the access counts on every online node
+	nodeID2SegmentInfos := make(map[int64][]*querypb.SegmentInfo)
+	segment2NodeIDs := make(map[UniqueID][]int64)
+	collectionInfos := qc.meta.showCollections()
+	for nodeID := range onlineNodes {
+		for _, collectionInfo := range collectionInfos {
+			infos, err := qc.cluster.getSegmentInfoByNode(ctx, nodeID, &querypb.GetSegmentInfoRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_SegmentInfo,
+				},
+				CollectionID: collectionInfo.CollectionID,
+			})
+			if err != nil {
+				log.Warn("evictSegments: get segment info from query node failed", zap.Int64("nodeID", nodeID),
+					zap.Int64("collectionID", collectionInfo.CollectionID), zap.Error(err))
+				continue
+			}
+			for _, info := range infos {
+				if info.State != commonpb.SegmentState_Sealed {
+					continue
+				}
+				nodeID2SegmentInfos[nodeID] = append(nodeID2SegmentInfos[nodeID], info)
+				segment2NodeIDs[info.SegmentID] = append(segment2NodeIDs[info.SegmentID], nodeID)
+			}
+		}
+	}
+	segmentReplicaNum := make(map[UniqueID]int)
+	for segmentID, nodeIDs := range segment2NodeIDs {
+		segmentReplicaNum[segmentID] = len(nodeIDs)
+	}
+
+	for nodeID := range onlineNodes {
+		nodeInfo, err := qc.cluster.getNodeInfoByID(nodeID)
+		if err != nil {
+			log.Warn("evictSegments: get node info from query node failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+			continue
+		}
+		node := nodeInfo.(*queryNode)
+		if node.memUsageRate <= Params.QueryNodeMemoryHighWatermark {
+			continue
+		}
+
+		selected := qc.evictionPolicy.SelectSegmentsToEvict(node.memUsage, node.totalMem, nodeID2SegmentInfos[nodeID], segmentReplicaNum)
+		log.Debug("evictSegments: query node memory usage exceeds high watermark", zap.Int64("nodeID", nodeID),
+			zap.Float64("memUsageRate", node.memUsageRate), zap.Int("selected segments", len(selected)))
+		for _, info := range selected {
+			err = qc.evictSegment(ctx, nodeID, info, segment2NodeIDs)
+			if err != nil {
+				log.Warn("evictSegments: evict segment failed", zap.Int64("nodeID", nodeID), zap.Int64("segmentID", info.SegmentID), zap.Error(err))
+				segmentReplicaNum[info.SegmentID]++
+				continue
+			}
+			log.Debug("evictSegments: segment evicted", zap.Int64("nodeID", nodeID), zap.Int64("segmentID", info.SegmentID),
+				zap.Int64("accessCount", info.AccessCount), zap.Int64("memSize", info.MemSize))
+			metrics.QueryCoordEvictedSegmentCounter.WithLabelValues(strconv.FormatInt(nodeID, 10)).Inc()
+		}
+	}
+}
+
+// evictSegment releases the segment from the query node,
+// if the meta records the segment is served by the node, switch it to another replica
+func (qc *QueryCoord) evictSegment(ctx context.Context, nodeID int64, info *querypb.SegmentInfo, segment2NodeIDs map[UniqueID][]int64) error {
+	req := &querypb.ReleaseSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_ReleaseSegments,
+		},
+		NodeID:       nodeID,
+		CollectionID: info.CollectionID,
+		PartitionIDs: []UniqueID{info.PartitionID},
+		SegmentIDs:   []UniqueID{info.SegmentID},
+	}
+	err := qc.cluster.releaseSegments(ctx, nodeID, req)
+	if err != nil {
+		return err
+	}
+
+	replicaNodeIDs := make([]int64, 0)
+	for _, id := range segment2NodeIDs[info.SegmentID] {
+		if id != nodeID {
+			replicaNodeIDs = append(replicaNodeIDs, id)
+		}
+	}
+	segment2NodeIDs[info.SegmentID] = replicaNodeIDs
+
+	segmentInfo, err := qc.meta.getSegmentInfoByID(info.SegmentID)
+	if err != nil || segmentInfo.NodeID != nodeID || len(replicaNodeIDs) == 0 {
+		return nil
+	}
+	segmentInfo = proto.Clone(segmentInfo).(*querypb.SegmentInfo)
+	segmentInfo.NodeID = replicaNodeIDs[0]
+	return qc.meta.setSegmentInfos(map[UniqueID]*querypb.SegmentInfo{info.SegmentID: segmentInfo})
+}
+
 func chooseSegmentToBalance(sourceNodeID int64, dstNodeID int64,
 	segmentInfos map[UniqueID]*querypb.SegmentInfo,
 	nodeID2MemUsage map[int64]uint64,
@@ -595,3 +738,28 @@ func chooseSegmentToBalance(sourceNodeID int64, dstNodeID int64,
 
 	return selectedSegmentInfo, nil
 }
+
+// preFetchBatch is a pre-fetch task tracked for its loading progress
+type preFetchBatch struct {
+	task         *preFetchSegmentsTask
+	registeredAt time.Time
+}
+
+func (b *preFetchBatch) finished() bool {
+	state := b.task.getState()
+	return state == taskExpired || state == taskFailed
+}
+
+// registerPreFetchBatch tracks the pre-fetch task as a batch, and evicts the batches finished for longer than
+// preFetchBatchRetention
+func (qc *QueryCoord) registerPreFetchBatch(t *preFetchSegmentsTask) {
+	now := time.Now()
+	qc.preFetchBatches.Range(func(key, value interface{}) bool {
+		batch := value.(*preFetchBatch)
+		if batch.finished() && now.Sub(batch.registeredAt) > preFetchBatchRetention {
+			qc.preFetchBatches.Delete(key)
+		}
+		return true
+	})
+	qc.preFetchBatches.Store(t.getTaskID(), &preFetchBatch{task: t, registeredAt: now})
+}
@@ -567,3 +567,34 @@ func TestLoadBalanceSegmentLoop(t *testing.T) {
 	err = removeAllSession()
 	assert.Nil(t, err)
 }
+
+func TestRegisterPreFetchBatch(t *testing.T) {
+	ctx := context.Background()
+	newTask := func(taskID UniqueID, state taskState) *preFetchSegmentsTask {
+		task := &preFetchSegmentsTask{baseTask: newBaseTask(ctx, querypb.TriggerCondition_preFetch)}
+		task.setTaskID(taskID)
+		task.setState(state)
+		return task
+	}
+
+	qc := &QueryCoord{}
+	qc.registerPreFetchBatch(newTask(1, taskExpired))
+	qc.registerPreFetchBatch(newTask(2, taskFailed))
+	qc.registerPreFetchBatch(newTask(3, taskDoing))
+	for _, batchID := range []UniqueID{1, 2, 3} {
+		v, ok := qc.preFetchBatches.Load(batchID)
+		assert.True(t, ok)
+		v.(*preFetchBatch).registeredAt = time.Now().Add(-preFetchBatchRetention - time.Second)
+	}
+
+	// finished batches are evicted once retained long enough, while running ones are kept
+	qc.registerPreFetchBatch(newTask(4, taskUndo))
+	_, ok := qc.preFetchBatches.Load(UniqueID(1))
+	assert.False(t, ok)
+	_, ok = qc.preFetchBatches.Load(UniqueID(2))
+	assert.False(t, ok)
+	_, ok = qc.preFetchBatches.Load(UniqueID(3))
+	assert.True(t, ok)
+	_, ok = qc.preFetchBatches.Load(UniqueID(4))
+	assert.True(t, ok)
+}
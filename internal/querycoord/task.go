@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -1645,6 +1646,181 @@ func (ht *handoffTask) rollBack(ctx context.Context) []task {
 	return resultTasks
 }
 
+// preFetchSegmentsTask loads segments restored in cold storage into query nodes ahead of queries,
+// the segments are assumed to be indexed, so that they are loaded without checking index
+type preFetchSegmentsTask struct {
+	*baseTask
+	*querypb.PreFetchSegmentsRequest
+	dataCoord types.DataCoord
+	cluster   Cluster
+	meta      Meta
+}
+
+func (pft *preFetchSegmentsTask) msgBase() *commonpb.MsgBase {
+	return pft.Base
+}
+
+func (pft *preFetchSegmentsTask) marshal() ([]byte, error) {
+	return proto.Marshal(pft.PreFetchSegmentsRequest)
+}
+
+func (pft *preFetchSegmentsTask) msgType() commonpb.MsgType {
+	return pft.Base.MsgType
+}
+
+func (pft *preFetchSegmentsTask) timestamp() Timestamp {
+	return pft.Base.Timestamp
+}
+
+// segmentIDs returns the IDs of segments to pre-fetch
+func (pft *preFetchSegmentsTask) segmentIDs() []UniqueID {
+	segmentIDs := make([]UniqueID, 0, len(pft.Segments))
+	for _, segment := range pft.Segments {
+		segmentIDs = append(segmentIDs, segment.SegmentID)
+	}
+	return segmentIDs
+}
+
+func (pft *preFetchSegmentsTask) preExecute(context.Context) error {
+	pft.setResultInfo(nil)
+	log.Debug("start do preFetchSegmentsTask",
+		zap.Int64("collectionID", pft.CollectionID),
+		zap.Int64s("segmentIDs", pft.segmentIDs()))
+	return nil
+}
+
+func (pft *preFetchSegmentsTask) execute(ctx context.Context) error {
+	collectionID := pft.CollectionID
+	collectionInfo, err := pft.meta.getCollectionInfoByID(collectionID)
+	if err != nil {
+		log.Error("preFetchSegmentsTask: collection has not been loaded into memory", zap.Int64("collectionID", collectionID))
+		pft.setResultInfo(err)
+		return err
+	}
+
+	storageHints := make(map[UniqueID]string)
+	segmentIDs := make([]UniqueID, 0, len(pft.Segments))
+	for _, segment := range pft.Segments {
+		// segment which has been loaded needs not to be fetched again
+		if _, err := pft.meta.getSegmentInfoByID(segment.SegmentID); err == nil {
+			continue
+		}
+		storageHints[segment.SegmentID] = segment.StorageHint
+		segmentIDs = append(segmentIDs, segment.SegmentID)
+	}
+	if len(segmentIDs) == 0 {
+		log.Debug("preFetchSegmentsTask: all the segments have been loaded", zap.Int64("taskID", pft.getTaskID()))
+		return nil
+	}
+
+	segmentInfoResp, err := pft.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base:       pft.Base,
+		SegmentIDs: segmentIDs,
+	})
+	if err == nil && segmentInfoResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(segmentInfoResp.Status.Reason)
+	}
+	if err != nil {
+		pft.setResultInfo(err)
+		return err
+	}
+
+	deltaChannelInfos, err := pft.meta.getDeltaChannelsByCollectionID(collectionID)
+	if err != nil {
+		pft.setResultInfo(err)
+		return err
+	}
+
+	loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0, len(segmentInfoResp.Infos))
+	for _, info := range segmentInfoResp.Infos {
+		if info.CollectionID != collectionID || info.State != commonpb.SegmentState_Flushed {
+			err = fmt.Errorf("segment %d is not a flushed segment of collection %d", info.ID, collectionID)
+			pft.setResultInfo(err)
+			return err
+		}
+		if err = checkStorageHint(info, storageHints[info.ID]); err != nil {
+			pft.setResultInfo(err)
+			return err
+		}
+
+		// index check is skipped since pre-fetched segments are assumed to be indexed
+		segmentLoadInfo := &querypb.SegmentLoadInfo{
+			SegmentID:    info.ID,
+			PartitionID:  info.PartitionID,
+			CollectionID: collectionID,
+			BinlogPaths:  info.Binlogs,
+			NumOfRows:    info.NumOfRows,
+			Statslogs:    info.Statslogs,
+			Deltalogs:    info.Deltalogs,
+		}
+		msgBase := proto.Clone(pft.Base).(*commonpb.MsgBase)
+		msgBase.MsgType = commonpb.MsgType_LoadSegments
+		loadSegmentReqs = append(loadSegmentReqs, &querypb.LoadSegmentsRequest{
+			Base:          msgBase,
+			Infos:         []*querypb.SegmentLoadInfo{segmentLoadInfo},
+			Schema:        collectionInfo.Schema,
+			LoadCondition: querypb.TriggerCondition_preFetch,
+			CollectionID:  collectionID,
+		})
+	}
+
+	msgBase := proto.Clone(pft.Base).(*commonpb.MsgBase)
+	msgBase.MsgType = commonpb.MsgType_WatchDeltaChannels
+	watchDeltaChannelReq := &querypb.WatchDeltaChannelsRequest{
+		Base:         msgBase,
+		CollectionID: collectionID,
+		Infos:        deltaChannelInfos,
+	}
+	internalTasks, err := assignInternalTask(ctx, collectionID, pft, pft.meta, pft.cluster, loadSegmentReqs, nil, watchDeltaChannelReq, false, nil, nil)
+	if err != nil {
+		log.Error("preFetchSegmentsTask: assign child task failed", zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		pft.setResultInfo(err)
+		return err
+	}
+	for _, internalTask := range internalTasks {
+		pft.addChildTask(internalTask)
+		log.Debug("preFetchSegmentsTask: add a childTask", zap.Int32("task type", int32(internalTask.msgType())), zap.Any("task", internalTask))
+	}
+
+	log.Debug("preFetchSegmentsTask Execute done",
+		zap.Int64("taskID", pft.getTaskID()))
+	return nil
+}
+
+func (pft *preFetchSegmentsTask) postExecute(context.Context) error {
+	if pft.result.ErrorCode != commonpb.ErrorCode_Success {
+		pft.childTasks = []task{}
+	}
+
+	log.Debug("preFetchSegmentsTask postExecute done",
+		zap.Int64("taskID", pft.getTaskID()))
+	return nil
+}
+
+// checkStorageHint checks that all the binlogs of the segment are under the storage hint prefix,
+// so that segments are never loaded from a location other than the one restored
+func checkStorageHint(info *datapb.SegmentInfo, storageHint string) error {
+	if storageHint == "" {
+		return nil
+	}
+	paths := make([]string, 0)
+	for _, fieldBinlog := range info.Binlogs {
+		paths = append(paths, fieldBinlog.Binlogs...)
+	}
+	for _, fieldBinlog := range info.Statslogs {
+		paths = append(paths, fieldBinlog.Binlogs...)
+	}
+	for _, deltalog := range info.Deltalogs {
+		paths = append(paths, deltalog.DeltaLogPath)
+	}
+	for _, path := range paths {
+		if !strings.HasPrefix(path, storageHint) {
+			return fmt.Errorf("binlog %s of segment %d is not under storage hint %s", path, info.ID, storageHint)
+		}
+	}
+	return nil
+}
+
 type loadBalanceTask struct {
 	*baseTask
 	*querypb.LoadBalanceRequest
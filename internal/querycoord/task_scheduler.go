@@ -133,6 +133,9 @@ type TaskScheduler struct {
 	dataCoord  types.DataCoord
 	indexCoord types.IndexCoord
 
+	// pre-fetch tasks reloaded from kv, QueryCoord tracks their progress as batches
+	reloadedPreFetchTasks []*preFetchSegmentsTask
+
 	wg     sync.WaitGroup
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -199,6 +202,9 @@ func (scheduler *TaskScheduler) reloadFromKV() error {
 			return err
 		}
 		triggerTasks[taskID] = t
+		if preFetchTask, ok := t.(*preFetchSegmentsTask); ok {
+			scheduler.reloadedPreFetchTasks = append(scheduler.reloadedPreFetchTasks, preFetchTask)
+		}
 	}
 
 	activeTasks := make(map[int64]task)
@@ -423,6 +429,20 @@ func (scheduler *TaskScheduler) unmarshalTask(taskID UniqueID, t string) (task,
 			meta:                   scheduler.meta,
 		}
 		newTask = handoffTask
+	case commonpb.MsgType_PreFetchSegments:
+		preFetchReq := querypb.PreFetchSegmentsRequest{}
+		err = proto.Unmarshal([]byte(t), &preFetchReq)
+		if err != nil {
+			return nil, err
+		}
+		preFetchTask := &preFetchSegmentsTask{
+			baseTask:                newBaseTask(scheduler.ctx, querypb.TriggerCondition_preFetch),
+			PreFetchSegmentsRequest: &preFetchReq,
+			dataCoord:               scheduler.dataCoord,
+			cluster:                 scheduler.cluster,
+			meta:                    scheduler.meta,
+		}
+		newTask = preFetchTask
 	default:
 		err = errors.New("inValid msg type when unMarshal task")
 		log.Error(err.Error())
@@ -797,6 +797,89 @@ func Test_handoffSegmentFail(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_preFetchSegmentsTask(t *testing.T) {
+	refreshParams()
+	ctx := context.Background()
+	queryCoord, err := startQueryCoord(ctx)
+	assert.Nil(t, err)
+
+	node1, err := startQueryNodeServer(ctx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, node1.queryNodeID)
+
+	loadCollectionTask := genLoadCollectionTask(ctx, queryCoord)
+	err = queryCoord.scheduler.Enqueue(loadCollectionTask)
+	assert.Nil(t, err)
+	waitTaskFinalState(loadCollectionTask, taskExpired)
+
+	// segments of a partition not loaded yet
+	recoveryInfo, err := queryCoord.dataCoordClient.GetRecoveryInfo(ctx, &datapb.GetRecoveryInfoRequest{
+		CollectionID: defaultCollectionID,
+		PartitionID:  defaultPartitionID + 1,
+	})
+	assert.Nil(t, err)
+	segments := make([]*querypb.PreFetchSegmentInfo, 0)
+	for _, binlog := range recoveryInfo.Binlogs {
+		segments = append(segments, &querypb.PreFetchSegmentInfo{SegmentID: binlog.SegmentID})
+	}
+	assert.NotEqual(t, 0, len(segments))
+
+	genPreFetchTask := func(segments []*querypb.PreFetchSegmentInfo) *preFetchSegmentsTask {
+		return &preFetchSegmentsTask{
+			baseTask: newBaseTask(ctx, querypb.TriggerCondition_preFetch),
+			PreFetchSegmentsRequest: &querypb.PreFetchSegmentsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_PreFetchSegments,
+				},
+				CollectionID: defaultCollectionID,
+				Segments:     segments,
+			},
+			dataCoord: queryCoord.dataCoordClient,
+			cluster:   queryCoord.cluster,
+			meta:      queryCoord.meta,
+		}
+	}
+
+	t.Run("storage hint mismatch", func(t *testing.T) {
+		mismatched := []*querypb.PreFetchSegmentInfo{{SegmentID: segments[0].SegmentID, StorageHint: "not-exist/"}}
+		preFetchTask := genPreFetchTask(mismatched)
+		err = queryCoord.scheduler.Enqueue(preFetchTask)
+		assert.Nil(t, err)
+		waitTaskFinalState(preFetchTask, taskFailed)
+	})
+
+	t.Run("pre-fetch segments", func(t *testing.T) {
+		preFetchTask := genPreFetchTask(segments)
+		err = queryCoord.scheduler.Enqueue(preFetchTask)
+		assert.Nil(t, err)
+		waitTaskFinalState(preFetchTask, taskExpired)
+		for _, segment := range segments {
+			_, err = queryCoord.meta.getSegmentInfoByID(segment.SegmentID)
+			assert.Nil(t, err)
+		}
+	})
+
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
+
+func Test_checkStorageHint(t *testing.T) {
+	info := &datapb.SegmentInfo{
+		ID: defaultSegmentID,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []string{"backup/insert_log/1", "backup/insert_log/2"}},
+		},
+		Statslogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []string{"backup/stats_log/1"}},
+		},
+	}
+	assert.Nil(t, checkStorageHint(info, ""))
+	assert.Nil(t, checkStorageHint(info, "backup/"))
+	assert.NotNil(t, checkStorageHint(info, "backup/insert_log/"))
+	assert.NotNil(t, checkStorageHint(info, "other/"))
+}
+
 func TestLoadBalanceSegmentsTask(t *testing.T) {
 	refreshParams()
 	ctx := context.Background()
@@ -710,6 +710,7 @@ func getSegmentInfo(segment *Segment) *querypb.SegmentInfo {
 		IndexID:      indexID,
 		ChannelID:    segment.vChannelID,
 		State:        getSegmentStateBySegmentType(segment.segmentType),
+		AccessCount:  segment.getAccessCount(),
 	}
 	return info
 }
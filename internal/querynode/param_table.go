@@ -82,6 +82,11 @@ type ParamTable struct {
 	ChunkRows int64
 	SimdType  string
 
+	// Comma separated collectionID/fieldID:keyID of the fields whose insert binlogs are encrypted with the column key
+	ColumnEncryptionFieldKeys string
+	// Comma separated keyID:base64 encoded AES-256 key of the column keys
+	ColumnEncryptionKeys string
+
 	CreatedTime time.Time
 	UpdatedTime time.Time
 
@@ -145,6 +150,8 @@ func (p *ParamTable) Init() {
 
 	p.initSegcoreChunkRows()
 	p.initKnowhereSimdType()
+	p.initColumnEncryptionFieldKeys()
+	p.initColumnEncryptionKeys()
 
 	p.initRoleName()
 
@@ -343,6 +350,14 @@ func (p *ParamTable) initKnowhereSimdType() {
 	log.Debug("initialize the knowhere simd type", zap.String("simd_type", p.SimdType))
 }
 
+func (p *ParamTable) initColumnEncryptionFieldKeys() {
+	p.ColumnEncryptionFieldKeys = p.LoadWithDefault("encryption.fieldKeys", "")
+}
+
+func (p *ParamTable) initColumnEncryptionKeys() {
+	p.ColumnEncryptionKeys = p.LoadWithDefault("encryption.keys", "")
+}
+
 func (p *ParamTable) initRoleName() {
 	p.RoleName = "querynode"
 }
@@ -45,6 +45,7 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -166,6 +167,12 @@ func (node *QueryNode) Init() error {
 	var initError error = nil
 	node.initOnce.Do(func() {
 		//ctx := context.Background()
+		// sealed segments with encrypted fields are loaded from binlogs
+		if err := storage.InitColumnKeyManager(Params.ColumnEncryptionFieldKeys, Params.ColumnEncryptionKeys); err != nil {
+			log.Error("invalid column encryption keys", zap.Error(err))
+			initError = err
+			return
+		}
 		connectEtcdFn := func() error {
 			etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
 			if err != nil {
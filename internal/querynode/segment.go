@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"unsafe"
 
 	"github.com/bits-and-blooms/bloom/v3"
@@ -101,6 +102,8 @@ type Segment struct {
 	vectorFieldInfos map[UniqueID]*VectorFieldInfo
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
+
+	accessCount int64 // number of searches and retrieves executed on the segment, accessed atomically
 }
 
 // ID returns the identity number.
@@ -108,6 +111,11 @@ func (s *Segment) ID() UniqueID {
 	return s.segmentID
 }
 
+// getAccessCount returns the number of searches and retrieves executed on the segment
+func (s *Segment) getAccessCount() int64 {
+	return atomic.LoadInt64(&s.accessCount)
+}
+
 func (s *Segment) setEnableIndex(enable bool) {
 	setOnce := func() {
 		s.enableIndex = enable
@@ -293,6 +301,7 @@ func (s *Segment) search(plan *SearchPlan,
 	if s.segmentPtr == nil {
 		return nil, errors.New("null seg core pointer")
 	}
+	atomic.AddInt64(&s.accessCount, 1)
 	cPlaceholderGroups := make([]C.CPlaceholderGroup, 0)
 	for _, pg := range searchRequests {
 		cPlaceholderGroups = append(cPlaceholderGroups, (*pg).cPlaceholderGroup)
@@ -328,6 +337,7 @@ func (s *Segment) retrieve(plan *RetrievePlan) (*segcorepb.RetrieveResults, erro
 		return nil, errors.New("null seg core pointer")
 	}
 
+	atomic.AddInt64(&s.accessCount, 1)
 	var retrieveResult RetrieveResult
 	ts := C.uint64_t(plan.Timestamp)
 	status := C.Retrieve(s.segmentPtr, plan.cRetrievePlan, ts, &retrieveResult.cRetrieveResult)
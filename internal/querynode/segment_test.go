@@ -229,6 +229,8 @@ func TestSegment_retrieve(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, res.GetFieldsData()[0].GetScalars().Data.(*schemapb.ScalarField_IntData).IntData.Data, []int32{1, 2, 3})
+	assert.Equal(t, int64(1), segment.getAccessCount())
+	assert.Equal(t, int64(1), getSegmentInfo(segment).GetAccessCount())
 }
 
 func TestSegment_getDeletedCount(t *testing.T) {
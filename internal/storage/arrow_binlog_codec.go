@@ -0,0 +1,621 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
+)
+
+// formats of insert binlogs
+const (
+	// BinlogFormatCustom is the Milvus binlog format written by InsertCodec
+	BinlogFormatCustom = "existing_custom"
+	// BinlogFormatArrowIPC is the Apache Arrow IPC stream format written by ArrowBinlogCodec
+	BinlogFormatArrowIPC = "arrow_ipc"
+)
+
+// arrowBinlogMagicByte is the first byte of arrow binlogs, i.e. the continuation marker of the first message,
+// while binlogs of the custom format start with MagicNumber in little endian, whose first byte is 0xbc
+const arrowBinlogMagicByte byte = 0xFF
+
+// keys of the schema metadata of arrow binlogs
+const (
+	arrowMetaCollectionID   = "milvus.collection_id"
+	arrowMetaPartitionID    = "milvus.partition_id"
+	arrowMetaSegmentID      = "milvus.segment_id"
+	arrowMetaFieldID        = "milvus.field_id"
+	arrowMetaDataType       = "milvus.data_type"
+	arrowMetaStartTimestamp = "milvus.start_timestamp"
+	arrowMetaEndTimestamp   = "milvus.end_timestamp"
+	arrowMetaOriginalSize   = "milvus." + originalSizeKey
+	arrowMetaDynamicField   = "milvus." + dynamicFieldKey
+)
+
+// IsArrowBinlog returns true if the binlog is written by ArrowBinlogCodec
+func IsArrowBinlog(data []byte) bool {
+	return len(data) > 0 && data[0] == arrowBinlogMagicByte
+}
+
+// BinlogInsertCodec serializes insert data into binlogs of a format,
+// binlogs of all formats are deserialized by InsertCodec
+type BinlogInsertCodec interface {
+	Serialize(partitionID UniqueID, segmentID UniqueID, data *InsertData) ([]*Blob, []*Blob, error)
+	SerializeFieldStats(data *InsertData) ([]*Blob, error)
+	SerializeSketches(data *InsertData) ([]*Blob, error)
+	Deserialize(blobs []*Blob) (UniqueID, UniqueID, *InsertData, error)
+	Close() error
+}
+
+// NewBinlogInsertCodec returns the codec writing insert binlogs of the format
+func NewBinlogInsertCodec(schema *etcdpb.CollectionMeta, format string) (BinlogInsertCodec, error) {
+	switch format {
+	case BinlogFormatCustom:
+		return NewInsertCodec(schema), nil
+	case BinlogFormatArrowIPC:
+		return NewArrowBinlogCodec(schema), nil
+	default:
+		return nil, fmt.Errorf("unknown binlog format %s", format)
+	}
+}
+
+// ArrowBinlogCodec serializes each field of insert data as an Arrow IPC stream of single column,
+// which is readable by the tools of arrow ecosystem. The ids and timestamps kept in the descriptor event
+// of custom binlogs are kept in the schema metadata.
+// Stats, sketches and deserialization are the same as InsertCodec
+type ArrowBinlogCodec struct {
+	*InsertCodec
+}
+
+// NewArrowBinlogCodec creates an ArrowBinlogCodec
+func NewArrowBinlogCodec(schema *etcdpb.CollectionMeta) *ArrowBinlogCodec {
+	return &ArrowBinlogCodec{InsertCodec: NewInsertCodec(schema)}
+}
+
+// Serialize transfers insert data to arrow binlogs sorted by timestamp, blobs are keyed by field id like InsertCodec
+func (codec *ArrowBinlogCodec) Serialize(partitionID UniqueID, segmentID UniqueID, data *InsertData) ([]*Blob, []*Blob, error) {
+	blobs := make([]*Blob, 0)
+	statsBlobs := make([]*Blob, 0)
+	timeFieldData, ok := data.Data[rootcoord.TimeStampField]
+	if !ok {
+		return nil, nil, fmt.Errorf("data doesn't contains timestamp field")
+	}
+	ts := timeFieldData.(*Int64FieldData).Data
+	startTs := ts[0]
+	endTs := ts[len(ts)-1]
+
+	sort.Sort(&DataSorter{
+		InsertCodec: codec.InsertCodec,
+		InsertData:  data,
+	})
+
+	newMetadata := func(fieldID FieldID, dataType schemapb.DataType, originalSize int) map[string]string {
+		return map[string]string{
+			arrowMetaCollectionID:   strconv.FormatInt(codec.Schema.ID, 10),
+			arrowMetaPartitionID:    strconv.FormatInt(partitionID, 10),
+			arrowMetaSegmentID:      strconv.FormatInt(segmentID, 10),
+			arrowMetaFieldID:        strconv.FormatInt(fieldID, 10),
+			arrowMetaDataType:       strconv.Itoa(int(dataType)),
+			arrowMetaStartTimestamp: strconv.FormatUint(uint64(startTs), 10),
+			arrowMetaEndTimestamp:   strconv.FormatUint(uint64(endTs), 10),
+			arrowMetaOriginalSize:   strconv.Itoa(originalSize),
+		}
+	}
+
+	for _, field := range codec.Schema.Schema.Fields {
+		singleData, ok := data.Data[field.FieldID]
+		if !ok {
+			return nil, nil, fmt.Errorf("data doesn't contains field %d", field.FieldID)
+		}
+		name := field.Name
+		if name == "" {
+			name = strconv.FormatInt(field.FieldID, 10)
+		}
+		array, err := fieldDataToArrowArray(name, field.DataType, singleData)
+		if err != nil {
+			return nil, nil, err
+		}
+		metadata := newMetadata(field.FieldID, field.DataType, singleData.GetMemorySize())
+		blobKey := fmt.Sprintf("%d", field.FieldID)
+		blobs = append(blobs, &Blob{
+			Key:   blobKey,
+			Value: writeArrowStream(array, metadata),
+		})
+
+		// stats fields
+		if field.DataType == schemapb.DataType_Int64 {
+			statsWriter := &StatsWriter{}
+			err = statsWriter.StatsInt64(field.FieldID, field.IsPrimaryKey, singleData.(*Int64FieldData).Data)
+			if err != nil {
+				return nil, nil, err
+			}
+			statsBlobs = append(statsBlobs, &Blob{
+				Key:   blobKey,
+				Value: statsWriter.GetBuffer(),
+			})
+		}
+	}
+
+	// schema-less dynamic fields are columns of JSON strings
+	for _, fieldID := range dynamicFieldIDs(data) {
+		dynamicData, ok := data.Data[fieldID].(*DynamicFieldData)
+		if !ok {
+			return nil, nil, fmt.Errorf("data of dynamic field %d is %T", fieldID, data.Data[fieldID])
+		}
+		rows := &StringFieldData{Data: make([]string, 0, len(dynamicData.Data))}
+		originalSize := 0
+		for _, row := range dynamicData.Data {
+			bs, err := json.Marshal(row)
+			if err != nil {
+				return nil, nil, err
+			}
+			rows.Data = append(rows.Data, string(bs))
+			originalSize += len(bs)
+		}
+		array, err := fieldDataToArrowArray(strconv.FormatInt(fieldID, 10), schemapb.DataType_String, rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		metadata := newMetadata(fieldID, schemapb.DataType_String, originalSize)
+		metadata[arrowMetaDynamicField] = "true"
+		blobs = append(blobs, &Blob{
+			Key:   fmt.Sprintf("%d", fieldID),
+			Value: writeArrowStream(array, metadata),
+		})
+	}
+
+	if err := encryptFieldBlobs(DefaultColumnKeyManager, codec.Schema.ID, segmentID, blobs); err != nil {
+		return nil, nil, err
+	}
+	return blobs, statsBlobs, nil
+}
+
+// arrowBinlogMeta is the metadata of an arrow binlog
+type arrowBinlogMeta struct {
+	collectionID UniqueID
+	partitionID  UniqueID
+	segmentID    UniqueID
+	fieldID      FieldID
+	dataType     schemapb.DataType
+	dynamic      bool
+}
+
+// readArrowBinlog reads the field data of an arrow binlog, NumRows of the data are the lengths of record batches
+func readArrowBinlog(data []byte) (*arrowBinlogMeta, FieldData, error) {
+	metadata, batches, err := readArrowStream(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta := &arrowBinlogMeta{dynamic: metadata[arrowMetaDynamicField] == "true"}
+	for key, id := range map[string]*int64{
+		arrowMetaCollectionID: &meta.collectionID,
+		arrowMetaPartitionID:  &meta.partitionID,
+		arrowMetaSegmentID:    &meta.segmentID,
+		arrowMetaFieldID:      &meta.fieldID,
+	} {
+		if *id, err = strconv.ParseInt(metadata[key], 10, 64); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s of arrow binlog: %w", key, err)
+		}
+	}
+	dataType, err := strconv.Atoi(metadata[arrowMetaDataType])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid %s of arrow binlog: %w", arrowMetaDataType, err)
+	}
+	meta.dataType = schemapb.DataType(dataType)
+
+	var fieldData FieldData
+	for _, batch := range batches {
+		batchData, err := arrowArrayToFieldData(meta.dataType, batch)
+		if err != nil {
+			return nil, nil, err
+		}
+		if meta.dynamic {
+			if batchData, err = stringsToDynamicFieldData(batchData); err != nil {
+				return nil, nil, err
+			}
+		}
+		if fieldData, err = appendFieldData(fieldData, batchData); err != nil {
+			return nil, nil, err
+		}
+	}
+	if fieldData == nil {
+		// a stream without record batch
+		if fieldData, err = appendFieldData(nil, newEmptyFieldData(meta.dataType, meta.dynamic)); err != nil {
+			return nil, nil, err
+		}
+	}
+	return meta, fieldData, nil
+}
+
+func stringsToDynamicFieldData(data FieldData) (FieldData, error) {
+	strs, ok := data.(*StringFieldData)
+	if !ok {
+		return nil, fmt.Errorf("dynamic field of %T", data)
+	}
+	rows := make([]map[string]interface{}, 0, len(strs.Data))
+	for _, str := range strs.Data {
+		var row map[string]interface{}
+		if err := json.Unmarshal([]byte(str), &row); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return &DynamicFieldData{NumRows: strs.NumRows, Data: rows}, nil
+}
+
+func newEmptyFieldData(dataType schemapb.DataType, dynamic bool) FieldData {
+	if dynamic {
+		return &DynamicFieldData{NumRows: []int64{0}}
+	}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return &BoolFieldData{NumRows: []int64{0}}
+	case schemapb.DataType_Int8:
+		return &Int8FieldData{NumRows: []int64{0}}
+	case schemapb.DataType_Int16:
+		return &Int16FieldData{NumRows: []int64{0}}
+	case schemapb.DataType_Int32:
+		return &Int32FieldData{NumRows: []int64{0}}
+	case schemapb.DataType_Int64:
+		return &Int64FieldData{NumRows: []int64{0}}
+	case schemapb.DataType_Float:
+		return &FloatFieldData{NumRows: []int64{0}}
+	case schemapb.DataType_Double:
+		return &DoubleFieldData{NumRows: []int64{0}}
+	case schemapb.DataType_String:
+		return &StringFieldData{NumRows: []int64{0}}
+	case schemapb.DataType_BinaryVector:
+		return &BinaryVectorFieldData{NumRows: []int64{0}}
+	default:
+		return &FloatVectorFieldData{NumRows: []int64{0}}
+	}
+}
+
+// appendFieldData appends src to dst of the same type, returns src if dst is nil
+func appendFieldData(dst, src FieldData) (FieldData, error) {
+	if dst == nil {
+		return src, nil
+	}
+	mismatch := func() error { return fmt.Errorf("cannot append field data %T to %T", src, dst) }
+	switch dst := dst.(type) {
+	case *BoolFieldData:
+		src, ok := src.(*BoolFieldData)
+		if !ok {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...)
+	case *Int8FieldData:
+		src, ok := src.(*Int8FieldData)
+		if !ok {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...)
+	case *Int16FieldData:
+		src, ok := src.(*Int16FieldData)
+		if !ok {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...)
+	case *Int32FieldData:
+		src, ok := src.(*Int32FieldData)
+		if !ok {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...)
+	case *Int64FieldData:
+		src, ok := src.(*Int64FieldData)
+		if !ok {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...)
+	case *FloatFieldData:
+		src, ok := src.(*FloatFieldData)
+		if !ok {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...)
+	case *DoubleFieldData:
+		src, ok := src.(*DoubleFieldData)
+		if !ok {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...)
+	case *StringFieldData:
+		src, ok := src.(*StringFieldData)
+		if !ok {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...)
+	case *DynamicFieldData:
+		src, ok := src.(*DynamicFieldData)
+		if !ok {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...)
+	case *BinaryVectorFieldData:
+		src, ok := src.(*BinaryVectorFieldData)
+		if !ok || (len(dst.Data) > 0 && dst.Dim != src.Dim) {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows, dst.Dim = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...), src.Dim
+	case *FloatVectorFieldData:
+		src, ok := src.(*FloatVectorFieldData)
+		if !ok || (len(dst.Data) > 0 && dst.Dim != src.Dim) {
+			return nil, mismatch()
+		}
+		dst.Data, dst.NumRows, dst.Dim = append(dst.Data, src.Data...), append(dst.NumRows, src.NumRows...), src.Dim
+	default:
+		return nil, fmt.Errorf("unsupported field data %T", dst)
+	}
+	return dst, nil
+}
+
+// fieldDataToArrowArray converts the field data into an arrow array:
+// scalars are arrays of the same type, string is Utf8, binary vector is FixedSizeBinary of dim/8 bytes,
+// and float vector is FixedSizeList of dim float32
+func fieldDataToArrowArray(name string, dataType schemapb.DataType, data FieldData) (*arrowArray, error) {
+	array := &arrowArray{name: name}
+	var ok bool
+	switch dataType {
+	case schemapb.DataType_Bool:
+		var d *BoolFieldData
+		if d, ok = data.(*BoolFieldData); ok {
+			bits := make([]byte, (len(d.Data)+7)/8)
+			for i, v := range d.Data {
+				if v {
+					bits[i/8] |= 1 << (i % 8)
+				}
+			}
+			array.typeID, array.length, array.buffers = arrowTypeBool, len(d.Data), [][]byte{bits}
+		}
+	case schemapb.DataType_Int8:
+		var d *Int8FieldData
+		if d, ok = data.(*Int8FieldData); ok {
+			values := make([]byte, len(d.Data))
+			for i, v := range d.Data {
+				values[i] = byte(v)
+			}
+			array.typeID, array.param, array.length, array.buffers = arrowTypeInt, 8, len(d.Data), [][]byte{values}
+		}
+	case schemapb.DataType_Int16:
+		var d *Int16FieldData
+		if d, ok = data.(*Int16FieldData); ok {
+			values := make([]byte, 2*len(d.Data))
+			for i, v := range d.Data {
+				binary.LittleEndian.PutUint16(values[2*i:], uint16(v))
+			}
+			array.typeID, array.param, array.length, array.buffers = arrowTypeInt, 16, len(d.Data), [][]byte{values}
+		}
+	case schemapb.DataType_Int32:
+		var d *Int32FieldData
+		if d, ok = data.(*Int32FieldData); ok {
+			values := make([]byte, 4*len(d.Data))
+			for i, v := range d.Data {
+				binary.LittleEndian.PutUint32(values[4*i:], uint32(v))
+			}
+			array.typeID, array.param, array.length, array.buffers = arrowTypeInt, 32, len(d.Data), [][]byte{values}
+		}
+	case schemapb.DataType_Int64:
+		var d *Int64FieldData
+		if d, ok = data.(*Int64FieldData); ok {
+			array.typeID, array.param, array.length, array.buffers = arrowTypeInt, 64, len(d.Data), [][]byte{appendInt64s(nil, d.Data...)}
+		}
+	case schemapb.DataType_Float:
+		var d *FloatFieldData
+		if d, ok = data.(*FloatFieldData); ok {
+			array.typeID, array.param, array.length, array.buffers = arrowTypeFloatingPoint, arrowPrecisionSingle, len(d.Data), [][]byte{float32sToBytes(d.Data)}
+		}
+	case schemapb.DataType_Double:
+		var d *DoubleFieldData
+		if d, ok = data.(*DoubleFieldData); ok {
+			values := make([]byte, 8*len(d.Data))
+			for i, v := range d.Data {
+				binary.LittleEndian.PutUint64(values[8*i:], math.Float64bits(v))
+			}
+			array.typeID, array.param, array.length, array.buffers = arrowTypeFloatingPoint, arrowPrecisionDouble, len(d.Data), [][]byte{values}
+		}
+	case schemapb.DataType_String:
+		var d *StringFieldData
+		if d, ok = data.(*StringFieldData); ok {
+			offsets := make([]byte, 4*(len(d.Data)+1))
+			var values []byte
+			for i, v := range d.Data {
+				values = append(values, v...)
+				if len(values) > math.MaxInt32 {
+					return nil, fmt.Errorf("strings of field %s exceed %d bytes", name, math.MaxInt32)
+				}
+				binary.LittleEndian.PutUint32(offsets[4*(i+1):], uint32(len(values)))
+			}
+			array.typeID, array.length, array.buffers = arrowTypeUtf8, len(d.Data), [][]byte{offsets, values}
+		}
+	case schemapb.DataType_BinaryVector:
+		var d *BinaryVectorFieldData
+		if d, ok = data.(*BinaryVectorFieldData); ok {
+			if d.Dim <= 0 || d.Dim%8 != 0 {
+				return nil, fmt.Errorf("invalid dim %d of binary vector field %s", d.Dim, name)
+			}
+			array.typeID, array.param, array.length, array.buffers = arrowTypeFixedSizeBinary, int32(d.Dim/8), d.RowNum(), [][]byte{d.Data}
+		}
+	case schemapb.DataType_FloatVector:
+		var d *FloatVectorFieldData
+		if d, ok = data.(*FloatVectorFieldData); ok {
+			if d.Dim <= 0 {
+				return nil, fmt.Errorf("invalid dim %d of float vector field %s", d.Dim, name)
+			}
+			array.typeID, array.param, array.length = arrowTypeFixedSizeList, int32(d.Dim), d.RowNum()
+			array.child = &arrowArray{
+				name:    "item",
+				typeID:  arrowTypeFloatingPoint,
+				param:   arrowPrecisionSingle,
+				length:  len(d.Data),
+				buffers: [][]byte{float32sToBytes(d.Data)},
+			}
+		}
+	default:
+		return nil, fmt.Errorf("undefined data type %d", dataType)
+	}
+	if !ok {
+		return nil, fmt.Errorf("data of field %s is %T, expect %s", name, data, dataType.String())
+	}
+	return array, nil
+}
+
+// arrowArrayToFieldData converts an arrow array written by fieldDataToArrowArray back to the field data
+func arrowArrayToFieldData(dataType schemapb.DataType, array *arrowArray) (FieldData, error) {
+	n := array.length
+	numRows := []int64{int64(n)}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		values, err := arrowValues(array, arrowTypeBool, 0, (n+7)/8)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]bool, n)
+		for i := range data {
+			data[i] = values[i/8]&(1<<(i%8)) != 0
+		}
+		return &BoolFieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Int8:
+		values, err := arrowValues(array, arrowTypeInt, 8, n)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]int8, n)
+		for i := range data {
+			data[i] = int8(values[i])
+		}
+		return &Int8FieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Int16:
+		values, err := arrowValues(array, arrowTypeInt, 16, 2*n)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]int16, n)
+		for i := range data {
+			data[i] = int16(binary.LittleEndian.Uint16(values[2*i:]))
+		}
+		return &Int16FieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Int32:
+		values, err := arrowValues(array, arrowTypeInt, 32, 4*n)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]int32, n)
+		for i := range data {
+			data[i] = int32(binary.LittleEndian.Uint32(values[4*i:]))
+		}
+		return &Int32FieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Int64:
+		values, err := arrowValues(array, arrowTypeInt, 64, 8*n)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]int64, n)
+		for i := range data {
+			data[i] = int64(binary.LittleEndian.Uint64(values[8*i:]))
+		}
+		return &Int64FieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Float:
+		values, err := arrowValues(array, arrowTypeFloatingPoint, arrowPrecisionSingle, 4*n)
+		if err != nil {
+			return nil, err
+		}
+		return &FloatFieldData{NumRows: numRows, Data: bytesToFloat32s(values, n)}, nil
+	case schemapb.DataType_Double:
+		values, err := arrowValues(array, arrowTypeFloatingPoint, arrowPrecisionDouble, 8*n)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]float64, n)
+		for i := range data {
+			data[i] = math.Float64frombits(binary.LittleEndian.Uint64(values[8*i:]))
+		}
+		return &DoubleFieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_String:
+		offsets, err := arrowValues(array, arrowTypeUtf8, 0, 4*(n+1))
+		if err != nil {
+			return nil, err
+		}
+		values := array.buffers[1]
+		data := make([]string, n)
+		for i := range data {
+			start, end := binary.LittleEndian.Uint32(offsets[4*i:]), binary.LittleEndian.Uint32(offsets[4*(i+1):])
+			if start > end || int(end) > len(values) {
+				return nil, errInvalidArrowIPC
+			}
+			data[i] = string(values[start:end])
+		}
+		return &StringFieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_BinaryVector:
+		if array.typeID != arrowTypeFixedSizeBinary || array.param <= 0 {
+			return nil, fmt.Errorf("arrow type %d of binary vector field %s", array.typeID, array.name)
+		}
+		values, err := arrowValues(array, arrowTypeFixedSizeBinary, array.param, int(array.param)*n)
+		if err != nil {
+			return nil, err
+		}
+		return &BinaryVectorFieldData{NumRows: numRows, Data: append([]byte(nil), values...), Dim: 8 * int(array.param)}, nil
+	case schemapb.DataType_FloatVector:
+		if array.typeID != arrowTypeFixedSizeList || array.param <= 0 || array.child == nil ||
+			array.child.length != int(array.param)*n {
+			return nil, fmt.Errorf("arrow type %d of float vector field %s", array.typeID, array.name)
+		}
+		values, err := arrowValues(array.child, arrowTypeFloatingPoint, arrowPrecisionSingle, 4*array.child.length)
+		if err != nil {
+			return nil, err
+		}
+		return &FloatVectorFieldData{NumRows: numRows, Data: bytesToFloat32s(values, array.child.length), Dim: int(array.param)}, nil
+	default:
+		return nil, fmt.Errorf("undefined data type %d", dataType)
+	}
+}
+
+// arrowValues checks the type of the array, returns the first buffer which has at least size bytes
+func arrowValues(array *arrowArray, typeID uint8, param int32, size int) ([]byte, error) {
+	if array.typeID != typeID || array.param != param {
+		return nil, fmt.Errorf("unexpected arrow type %d(%d) of field %s, expect %d(%d)",
+			array.typeID, array.param, array.name, typeID, param)
+	}
+	if len(array.buffers) == 0 || len(array.buffers[0]) < size {
+		return nil, errInvalidArrowIPC
+	}
+	return array.buffers[0], nil
+}
+
+func float32sToBytes(data []float32) []byte {
+	values := make([]byte, 4*len(data))
+	for i, v := range data {
+		binary.LittleEndian.PutUint32(values[4*i:], math.Float32bits(v))
+	}
+	return values
+}
+
+func bytesToFloat32s(values []byte, n int) []float32 {
+	data := make([]float32, n)
+	for i := range data {
+		data[i] = math.Float32frombits(binary.LittleEndian.Uint32(values[4*i:]))
+	}
+	return data
+}
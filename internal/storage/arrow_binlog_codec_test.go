@@ -0,0 +1,270 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newArrowTestSchema() *etcdpb.CollectionMeta {
+	return &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: BoolField, Name: "field_bool", DataType: schemapb.DataType_Bool},
+				{FieldID: Int8Field, Name: "field_int8", DataType: schemapb.DataType_Int8},
+				{FieldID: Int16Field, Name: "field_int16", DataType: schemapb.DataType_Int16},
+				{FieldID: Int32Field, Name: "field_int32", DataType: schemapb.DataType_Int32},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: FloatField, Name: "field_float", DataType: schemapb.DataType_Float},
+				{FieldID: DoubleField, Name: "field_double", DataType: schemapb.DataType_Double},
+				{FieldID: StringField, Name: "field_string", DataType: schemapb.DataType_String},
+				{FieldID: BinaryVectorField, Name: "field_binary_vector", DataType: schemapb.DataType_BinaryVector},
+				{FieldID: FloatVectorField, Name: "field_float_vector", DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	}
+}
+
+func newArrowTestInsertData(rowIDs []int64) *InsertData {
+	n := len(rowIDs)
+	data := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:        &Int64FieldData{NumRows: []int64{int64(n)}},
+			TimestampField:    &Int64FieldData{NumRows: []int64{int64(n)}},
+			BoolField:         &BoolFieldData{NumRows: []int64{int64(n)}},
+			Int8Field:         &Int8FieldData{NumRows: []int64{int64(n)}},
+			Int16Field:        &Int16FieldData{NumRows: []int64{int64(n)}},
+			Int32Field:        &Int32FieldData{NumRows: []int64{int64(n)}},
+			Int64Field:        &Int64FieldData{NumRows: []int64{int64(n)}},
+			FloatField:        &FloatFieldData{NumRows: []int64{int64(n)}},
+			DoubleField:       &DoubleFieldData{NumRows: []int64{int64(n)}},
+			StringField:       &StringFieldData{NumRows: []int64{int64(n)}},
+			BinaryVectorField: &BinaryVectorFieldData{NumRows: []int64{int64(n)}, Dim: 16},
+			FloatVectorField:  &FloatVectorFieldData{NumRows: []int64{int64(n)}, Dim: 3},
+		},
+	}
+	for _, id := range rowIDs {
+		data.Data[RowIDField].(*Int64FieldData).Data = append(data.Data[RowIDField].(*Int64FieldData).Data, id)
+		data.Data[TimestampField].(*Int64FieldData).Data = append(data.Data[TimestampField].(*Int64FieldData).Data, 100+id)
+		data.Data[BoolField].(*BoolFieldData).Data = append(data.Data[BoolField].(*BoolFieldData).Data, id%3 == 0)
+		data.Data[Int8Field].(*Int8FieldData).Data = append(data.Data[Int8Field].(*Int8FieldData).Data, int8(-id))
+		data.Data[Int16Field].(*Int16FieldData).Data = append(data.Data[Int16Field].(*Int16FieldData).Data, int16(-1000*id))
+		data.Data[Int32Field].(*Int32FieldData).Data = append(data.Data[Int32Field].(*Int32FieldData).Data, int32(math.MinInt32+id))
+		data.Data[Int64Field].(*Int64FieldData).Data = append(data.Data[Int64Field].(*Int64FieldData).Data, math.MaxInt64-id)
+		data.Data[FloatField].(*FloatFieldData).Data = append(data.Data[FloatField].(*FloatFieldData).Data, float32(id)+0.5)
+		data.Data[DoubleField].(*DoubleFieldData).Data = append(data.Data[DoubleField].(*DoubleFieldData).Data, -float64(id)/3)
+		// strings of different lengths including empty ones
+		data.Data[StringField].(*StringFieldData).Data = append(data.Data[StringField].(*StringFieldData).Data, strings.Repeat("字", int(id%4)))
+		data.Data[BinaryVectorField].(*BinaryVectorFieldData).Data = append(data.Data[BinaryVectorField].(*BinaryVectorFieldData).Data, byte(id), 255-byte(id))
+		data.Data[FloatVectorField].(*FloatVectorFieldData).Data = append(data.Data[FloatVectorField].(*FloatVectorFieldData).Data, float32(id), -float32(id), float32(id)/7)
+	}
+	return data
+}
+
+// setTestBlobLogIdx sets the keys of blobs, which are sorted by log idx in deserialization
+func setTestBlobLogIdx(blobs []*Blob, logIdx int) {
+	for _, blob := range blobs {
+		blob.Key = fmt.Sprintf("1/insert_log/2/3/4/5/%d", logIdx)
+	}
+}
+
+// appendTestInsertData appends src to dst of the same fields
+func appendTestInsertData(t *testing.T, dst, src *InsertData) {
+	for fieldID, data := range src.Data {
+		merged, err := appendFieldData(dst.Data[fieldID], data)
+		require.NoError(t, err)
+		dst.Data[fieldID] = merged
+	}
+}
+
+func TestArrowBinlogCodec(t *testing.T) {
+	schema := newArrowTestSchema()
+	codec := NewArrowBinlogCodec(schema)
+
+	// 9 rows cover the bit-packed bools across bytes
+	blobs1, statsBlobs1, err := codec.Serialize(PartitionID, SegmentID, newArrowTestInsertData([]int64{11, 12, 13, 14, 15, 16, 17, 18, 19}))
+	require.NoError(t, err)
+	assert.Equal(t, len(schema.Schema.Fields), len(blobs1))
+	for _, blob := range blobs1 {
+		assert.True(t, IsArrowBinlog(blob.Value))
+		// ends with end-of-stream marker
+		assert.Equal(t, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0}, blob.Value[len(blob.Value)-8:])
+	}
+	setTestBlobLogIdx(blobs1, 100)
+	// stats of int64 fields are the same as InsertCodec
+	assert.Equal(t, 3, len(statsBlobs1))
+	_, err = DeserializeStats(statsBlobs1)
+	assert.NoError(t, err)
+
+	// unsorted rows are sorted by row id
+	blobs2, _, err := codec.Serialize(PartitionID, SegmentID, newArrowTestInsertData([]int64{2, 1}))
+	require.NoError(t, err)
+	setTestBlobLogIdx(blobs2, 99)
+
+	partitionID, segmentID, data, err := codec.Deserialize(append(blobs2, blobs1...))
+	require.NoError(t, err)
+	assert.EqualValues(t, PartitionID, partitionID)
+	assert.EqualValues(t, SegmentID, segmentID)
+
+	expected := newArrowTestInsertData([]int64{1, 2})
+	appendTestInsertData(t, expected, newArrowTestInsertData([]int64{11, 12, 13, 14, 15, 16, 17, 18, 19}))
+	assert.Equal(t, expected.Data, data.Data)
+	assert.Equal(t, []BlobInfo{{Length: 2}, {Length: 9}}, data.Infos)
+	assert.NoError(t, codec.Close())
+
+	t.Run("mixed formats", func(t *testing.T) {
+		customBlobs, _, err := NewInsertCodec(schema).Serialize(PartitionID, SegmentID, newArrowTestInsertData([]int64{1, 2}))
+		require.NoError(t, err)
+		setTestBlobLogIdx(customBlobs, 99)
+		for _, blob := range customBlobs {
+			assert.False(t, IsArrowBinlog(blob.Value))
+		}
+
+		insertCodec := NewInsertCodec(schema)
+		collectionID, _, _, data, err := insertCodec.DeserializeAll(append(customBlobs, blobs1...))
+		require.NoError(t, err)
+		assert.EqualValues(t, CollectionID, collectionID)
+		assert.Equal(t, expected.Data, data.Data)
+		assert.NoError(t, insertCodec.Close())
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		data := newArrowTestInsertData([]int64{1})
+		data.Data[BoolField] = &Int8FieldData{NumRows: []int64{1}, Data: []int8{1}}
+		_, _, err := codec.Serialize(PartitionID, SegmentID, data)
+		assert.Error(t, err)
+
+		data = newArrowTestInsertData([]int64{1})
+		delete(data.Data, StringField)
+		_, _, err = codec.Serialize(PartitionID, SegmentID, data)
+		assert.Error(t, err)
+	})
+}
+
+func TestArrowBinlogCodec_DynamicField(t *testing.T) {
+	dynamicField := DynamicFieldIDBase + 1
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, DataType: schemapb.DataType_Int64},
+			},
+		},
+	}
+	rows := []map[string]interface{}{
+		{"name": "row 2", "tags": []interface{}{"a", "b"}},
+		{"name": "row 1", "level": 1.0},
+	}
+	codec := NewArrowBinlogCodec(schema)
+	blobs, _, err := codec.Serialize(PartitionID, SegmentID, &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{2, 1}},
+			TimestampField: &Int64FieldData{NumRows: []int64{2}, Data: []int64{2, 1}},
+			dynamicField:   &DynamicFieldData{NumRows: []int64{2}, Data: []map[string]interface{}{rows[0], rows[1]}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(blobs))
+	assert.Equal(t, "65537", blobs[2].Key)
+
+	_, _, data, err := codec.Deserialize(blobs)
+	require.NoError(t, err)
+	assert.Equal(t, &DynamicFieldData{NumRows: []int64{2}, Data: []map[string]interface{}{rows[1], rows[0]}}, data.Data[dynamicField])
+}
+
+func TestNewBinlogInsertCodec(t *testing.T) {
+	codec, err := NewBinlogInsertCodec(newArrowTestSchema(), BinlogFormatCustom)
+	assert.NoError(t, err)
+	assert.IsType(t, &InsertCodec{}, codec)
+
+	codec, err = NewBinlogInsertCodec(newArrowTestSchema(), BinlogFormatArrowIPC)
+	assert.NoError(t, err)
+	assert.IsType(t, &ArrowBinlogCodec{}, codec)
+
+	_, err = NewBinlogInsertCodec(newArrowTestSchema(), "parquet")
+	assert.Error(t, err)
+}
+
+func TestArrowIPCStream(t *testing.T) {
+	array, err := fieldDataToArrowArray("vector", schemapb.DataType_FloatVector,
+		&FloatVectorFieldData{Data: []float32{1, 2, 3, 4}, Dim: 2})
+	require.NoError(t, err)
+	stream := writeArrowStream(array, map[string]string{"key": "value"})
+
+	// the schema message starts with continuation marker and metadata size, and is aligned to 8 bytes
+	assert.Equal(t, arrowContinuation, binary.LittleEndian.Uint32(stream))
+	assert.Zero(t, binary.LittleEndian.Uint32(stream[4:])%8)
+
+	metadata, batches, err := readArrowStream(stream)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key": "value"}, metadata)
+	require.Equal(t, 1, len(batches))
+	assert.Equal(t, "vector", batches[0].name)
+	assert.Equal(t, 2, batches[0].length)
+	assert.Equal(t, "item", batches[0].child.name)
+	assert.Equal(t, 4, batches[0].child.length)
+
+	data, err := arrowArrayToFieldData(schemapb.DataType_FloatVector, batches[0])
+	require.NoError(t, err)
+	assert.Equal(t, &FloatVectorFieldData{NumRows: []int64{2}, Data: []float32{1, 2, 3, 4}, Dim: 2}, data)
+
+	_, err = arrowArrayToFieldData(schemapb.DataType_Float, batches[0])
+	assert.Error(t, err)
+
+	t.Run("truncated", func(t *testing.T) {
+		// stream without end-of-stream marker is valid
+		_, batches, err := readArrowStream(stream[:len(stream)-8])
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(batches))
+
+		for size := 0; size < len(stream)-8; size++ {
+			_, batches, err := readArrowStream(stream[:size])
+			if err == nil {
+				// truncated right after the schema message
+				assert.Empty(t, batches)
+			}
+		}
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		for i := range stream {
+			corrupted := append([]byte(nil), stream...)
+			corrupted[i] ^= 0xA5
+			assert.NotPanics(t, func() {
+				metadata, batches, err := readArrowStream(corrupted)
+				if err == nil && len(batches) == 1 {
+					_, _ = arrowArrayToFieldData(schemapb.DataType_FloatVector, batches[0])
+				}
+				_ = metadata
+			})
+		}
+	})
+}
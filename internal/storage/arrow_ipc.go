@@ -0,0 +1,649 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// This file implements the subset of Apache Arrow IPC streaming format used by arrow binlogs,
+// see https://arrow.apache.org/docs/format/Columnar.html#serialization-and-interprocess-communication-ipc
+// A stream is a schema message followed by record batch messages and the end-of-stream marker,
+// each message is a flatbuffers Message (Message.fbs, Schema.fbs) followed by the body holding the buffers.
+// Only non-nullable arrays of the types in arrowType* are supported.
+
+// errInvalidArrowIPC is returned when the arrow stream is truncated or malformed
+var errInvalidArrowIPC = errors.New("invalid arrow ipc stream")
+
+const (
+	// arrowContinuation starts each encapsulated message
+	arrowContinuation uint32 = 0xFFFFFFFF
+	// arrowMetadataV5 is the MetadataVersion of messages written
+	arrowMetadataV5 int16 = 4
+
+	// MessageHeader union
+	arrowHeaderSchema      uint8 = 1
+	arrowHeaderRecordBatch uint8 = 3
+
+	// Type union
+	arrowTypeInt             uint8 = 2
+	arrowTypeFloatingPoint   uint8 = 3
+	arrowTypeUtf8            uint8 = 5
+	arrowTypeBool            uint8 = 6
+	arrowTypeFixedSizeBinary uint8 = 15
+	arrowTypeFixedSizeList   uint8 = 16
+
+	// Precision of FloatingPoint
+	arrowPrecisionSingle int32 = 1
+	arrowPrecisionDouble int32 = 2
+)
+
+// arrowArray is an array without nulls, the schema of a field and the data of a record batch
+type arrowArray struct {
+	name   string
+	typeID uint8
+	// bitWidth of Int, precision of FloatingPoint, byteWidth of FixedSizeBinary, listSize of FixedSizeList
+	param   int32
+	length  int
+	buffers [][]byte // buffers following the validity bitmap, which is always empty
+	child   *arrowArray
+}
+
+// numBuffers returns the number of buffers of the array following the validity bitmap
+func (a *arrowArray) numBuffers() int {
+	switch a.typeID {
+	case arrowTypeUtf8:
+		return 2
+	case arrowTypeFixedSizeList:
+		return 0
+	default:
+		return 1
+	}
+}
+
+func (a *arrowArray) typeTable() fbTable {
+	switch a.typeID {
+	case arrowTypeInt:
+		return fbTable{fbInt32(a.param), fbBool(true)}
+	case arrowTypeFloatingPoint:
+		return fbTable{fbInt16(int16(a.param))}
+	case arrowTypeFixedSizeBinary, arrowTypeFixedSizeList:
+		return fbTable{fbInt32(a.param)}
+	default:
+		return fbTable{}
+	}
+}
+
+// fieldTable returns the flatbuffers Field of the array
+func (a *arrowArray) fieldTable() fbTable {
+	children := fbVector{}
+	if a.child != nil {
+		children = append(children, a.child.fieldTable())
+	}
+	return fbTable{
+		fbRef(fbString(a.name)),
+		fbBool(false), // nullable
+		fbUint8(a.typeID),
+		fbRef(a.typeTable()),
+		{}, // dictionary
+		fbRef(children),
+	}
+}
+
+// writeArrowStream writes the array as a record batch of a stream with single field,
+// the metadata is kept in the custom metadata of the schema
+func writeArrowStream(array *arrowArray, metadata map[string]string) []byte {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	kvs := make(fbVector, 0, len(keys))
+	for _, key := range keys {
+		kvs = append(kvs, fbTable{fbRef(fbString(key)), fbRef(fbString(metadata[key]))})
+	}
+	schema := fbTable{
+		fbInt16(0), // little endian
+		fbRef(fbVector{array.fieldTable()}),
+		fbRef(kvs),
+	}
+
+	// nodes and buffers are in depth-first pre-order, buffers in the body are aligned to 8 bytes
+	var nodes, buffers, body []byte
+	numNodes, numBuffers := 0, 0
+	appendBuffer := func(buf []byte) {
+		buffers = appendInt64s(buffers, int64(len(body)), int64(len(buf)))
+		numBuffers++
+		body = append(body, buf...)
+		body = append(body, make([]byte, padding(len(body), 8))...)
+	}
+	for a := array; a != nil; a = a.child {
+		nodes = appendInt64s(nodes, int64(a.length), 0)
+		numNodes++
+		appendBuffer(nil)
+		for _, buf := range a.buffers {
+			appendBuffer(buf)
+		}
+	}
+	batch := fbTable{
+		fbInt64(int64(array.length)),
+		fbRef(fbStructVector{n: numNodes, data: nodes}),
+		fbRef(fbStructVector{n: numBuffers, data: buffers}),
+	}
+
+	var buffer bytes.Buffer
+	writeArrowMessage(&buffer, arrowHeaderSchema, schema, nil)
+	writeArrowMessage(&buffer, arrowHeaderRecordBatch, batch, body)
+	// end-of-stream
+	buffer.Write(appendUint32s(nil, arrowContinuation, 0))
+	return buffer.Bytes()
+}
+
+func writeArrowMessage(buffer *bytes.Buffer, headerType uint8, header fbTable, body []byte) {
+	metadata := fbFinish(fbTable{
+		fbInt16(arrowMetadataV5),
+		fbUint8(headerType),
+		fbRef(header),
+		fbInt64(int64(len(body))),
+	})
+	buffer.Write(appendUint32s(nil, arrowContinuation, uint32(len(metadata))))
+	buffer.Write(metadata)
+	buffer.Write(body)
+}
+
+// readArrowStream reads the record batches of a stream with single field, returns the metadata of the schema
+func readArrowStream(data []byte) (map[string]string, []*arrowArray, error) {
+	var schema *arrowArray
+	var metadata map[string]string
+	var batches []*arrowArray
+	for pos := 0; pos < len(data); {
+		if len(data)-pos < 8 || binary.LittleEndian.Uint32(data[pos:]) != arrowContinuation {
+			return nil, nil, errInvalidArrowIPC
+		}
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		pos += 8
+		if size == 0 {
+			break // end-of-stream
+		}
+		if size > len(data)-pos {
+			return nil, nil, errInvalidArrowIPC
+		}
+		r := &fbReader{buf: data[pos : pos+size]}
+		pos += size
+
+		message := r.root()
+		headerType := r.uint8Field(message, 1)
+		header := r.ref(message, 2)
+		bodyLength := r.int64Field(message, 3)
+		if r.err != nil || header == 0 || bodyLength < 0 || bodyLength > int64(len(data)-pos) {
+			return nil, nil, errInvalidArrowIPC
+		}
+		body := data[pos : pos+int(bodyLength)]
+		pos += int(bodyLength)
+
+		switch headerType {
+		case arrowHeaderSchema:
+			var err error
+			if schema, metadata, err = readArrowSchema(r, header); err != nil {
+				return nil, nil, err
+			}
+		case arrowHeaderRecordBatch:
+			if schema == nil {
+				return nil, nil, fmt.Errorf("arrow record batch before schema")
+			}
+			batch, err := readArrowRecordBatch(r, header, schema, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			batches = append(batches, batch)
+		default:
+			return nil, nil, fmt.Errorf("unsupported arrow message header type %d", headerType)
+		}
+	}
+	if schema == nil {
+		return nil, nil, errInvalidArrowIPC
+	}
+	return metadata, batches, nil
+}
+
+func readArrowSchema(r *fbReader, schema int) (*arrowArray, map[string]string, error) {
+	if r.int16Field(schema, 0) != 0 {
+		return nil, nil, fmt.Errorf("big endian arrow stream is not supported")
+	}
+	fields, numFields := r.vector(schema, 1)
+	if numFields != 1 {
+		return nil, nil, fmt.Errorf("arrow stream of %d fields, expect single field", numFields)
+	}
+	array, err := readArrowField(r, r.element(fields, 0), 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kvs, numKvs := r.vector(schema, 2)
+	metadata := make(map[string]string, numKvs)
+	for i := 0; i < numKvs; i++ {
+		kv := r.element(kvs, i)
+		metadata[r.string(kv, 0)] = r.string(kv, 1)
+	}
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+	return array, metadata, nil
+}
+
+func readArrowField(r *fbReader, field int, depth int) (*arrowArray, error) {
+	if depth > 1 {
+		return nil, fmt.Errorf("nested arrow type is not supported")
+	}
+	// nullable fields are read as well, record batches with nulls are rejected
+	array := &arrowArray{
+		name:   r.string(field, 0),
+		typeID: r.uint8Field(field, 2),
+	}
+	typ := r.ref(field, 3)
+	if typ == 0 {
+		return nil, errInvalidArrowIPC
+	}
+	switch array.typeID {
+	case arrowTypeInt:
+		array.param = r.int32Field(typ, 0)
+		if !r.boolField(typ, 1) {
+			return nil, fmt.Errorf("unsigned arrow integer is not supported")
+		}
+	case arrowTypeFloatingPoint:
+		array.param = int32(r.int16Field(typ, 0))
+	case arrowTypeFixedSizeBinary, arrowTypeFixedSizeList:
+		array.param = r.int32Field(typ, 0)
+	case arrowTypeUtf8, arrowTypeBool:
+	default:
+		return nil, fmt.Errorf("unsupported arrow type %d", array.typeID)
+	}
+
+	children, numChildren := r.vector(field, 5)
+	if array.typeID == arrowTypeFixedSizeList {
+		if numChildren != 1 {
+			return nil, errInvalidArrowIPC
+		}
+		child, err := readArrowField(r, r.element(children, 0), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		array.child = child
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return array, nil
+}
+
+func readArrowRecordBatch(r *fbReader, batch int, schema *arrowArray, body []byte) (*arrowArray, error) {
+	nodes, numNodes := r.structVector(batch, 1, 16)
+	buffers, numBuffers := r.structVector(batch, 2, 16)
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.ref(batch, 3) != 0 {
+		return nil, fmt.Errorf("compressed arrow record batch is not supported")
+	}
+
+	var root, parent *arrowArray
+	nodeIdx, bufferIdx := 0, 0
+	for s := schema; s != nil; s = s.child {
+		if nodeIdx >= numNodes || bufferIdx+1+s.numBuffers() > numBuffers {
+			return nil, errInvalidArrowIPC
+		}
+		length := r.int64(nodes + 16*nodeIdx)
+		if nullCount := r.int64(nodes + 16*nodeIdx + 8); nullCount != 0 {
+			return nil, fmt.Errorf("arrow array with %d nulls is not supported", nullCount)
+		}
+		nodeIdx++
+		array := &arrowArray{name: s.name, typeID: s.typeID, param: s.param, length: int(length)}
+		// skip the validity bitmap
+		bufferIdx++
+		for i := 0; i < s.numBuffers(); i++ {
+			offset, size := r.int64(buffers+16*bufferIdx), r.int64(buffers+16*bufferIdx+8)
+			bufferIdx++
+			if offset < 0 || size < 0 || offset > int64(len(body)) || size > int64(len(body))-offset {
+				return nil, errInvalidArrowIPC
+			}
+			array.buffers = append(array.buffers, body[offset:offset+size])
+		}
+		if length < 0 || r.err != nil {
+			return nil, errInvalidArrowIPC
+		}
+		if root == nil {
+			root = array
+		} else {
+			parent.child = array
+		}
+		parent = array
+	}
+	return root, nil
+}
+
+func padding(size, align int) int {
+	return (align - size%align) % align
+}
+
+func appendUint32s(buf []byte, values ...uint32) []byte {
+	for _, v := range values {
+		buf = append(buf, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint32(buf[len(buf)-4:], v)
+	}
+	return buf
+}
+
+func appendInt64s(buf []byte, values ...int64) []byte {
+	for _, v := range values {
+		buf = append(buf, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint64(buf[len(buf)-8:], uint64(v))
+	}
+	return buf
+}
+
+// fbObject is a flatbuffers table, vector or string
+type fbObject interface {
+	// write appends the object followed by the objects it references, returns the position of the object
+	write(b *fbBuilder) int
+}
+
+// fbBuilder writes flatbuffers front to back, so that the objects referenced by unsigned offsets are
+// always behind the referencing ones
+type fbBuilder struct {
+	buf []byte
+}
+
+func (b *fbBuilder) pad(align int) {
+	b.buf = append(b.buf, make([]byte, padding(len(b.buf), align))...)
+}
+
+// patch sets the unsigned offset at pos to the target
+func (b *fbBuilder) patch(pos, target int) {
+	binary.LittleEndian.PutUint32(b.buf[pos:], uint32(target-pos))
+}
+
+// fbFinish returns the flatbuffer of the root table, padded to 8 bytes
+func fbFinish(root fbObject) []byte {
+	b := &fbBuilder{buf: make([]byte, 4)}
+	b.patch(0, root.write(b))
+	b.pad(8)
+	return b.buf
+}
+
+// fbField is a field of table, either a little endian scalar or a reference, zero value means absent
+type fbField struct {
+	scalar []byte
+	ref    fbObject
+}
+
+func (f fbField) size() int {
+	if f.ref != nil {
+		return 4
+	}
+	return len(f.scalar)
+}
+
+func fbUint8(v uint8) fbField { return fbField{scalar: []byte{v}} }
+
+func fbBool(v bool) fbField {
+	if v {
+		return fbUint8(1)
+	}
+	return fbUint8(0)
+}
+
+func fbInt16(v int16) fbField {
+	scalar := make([]byte, 2)
+	binary.LittleEndian.PutUint16(scalar, uint16(v))
+	return fbField{scalar: scalar}
+}
+
+func fbInt32(v int32) fbField { return fbField{scalar: appendUint32s(nil, uint32(v))} }
+
+func fbInt64(v int64) fbField { return fbField{scalar: appendInt64s(nil, v)} }
+
+func fbRef(obj fbObject) fbField { return fbField{ref: obj} }
+
+// fbTable is a table with fields indexed by slot
+type fbTable []fbField
+
+func (t fbTable) write(b *fbBuilder) int {
+	// the offset to vtable is followed by the fields, larger ones first to keep them aligned
+	offsets := make([]int, len(t))
+	size := 4
+	for _, fieldSize := range []int{8, 4, 2, 1} {
+		for i, f := range t {
+			if f.size() == fieldSize {
+				size += padding(size, fieldSize)
+				offsets[i] = size
+				size += fieldSize
+			}
+		}
+	}
+
+	b.pad(2)
+	vtable := len(b.buf)
+	b.buf = append(b.buf, make([]byte, 4+2*len(t))...)
+	binary.LittleEndian.PutUint16(b.buf[vtable:], uint16(4+2*len(t)))
+	binary.LittleEndian.PutUint16(b.buf[vtable+2:], uint16(size))
+	for i, offset := range offsets {
+		binary.LittleEndian.PutUint16(b.buf[vtable+4+2*i:], uint16(offset))
+	}
+
+	b.pad(8)
+	table := len(b.buf)
+	b.buf = append(b.buf, make([]byte, size)...)
+	binary.LittleEndian.PutUint32(b.buf[table:], uint32(table-vtable))
+	for i, f := range t {
+		copy(b.buf[table+offsets[i]:], f.scalar)
+	}
+	for i, f := range t {
+		if f.ref != nil {
+			b.patch(table+offsets[i], f.ref.write(b))
+		}
+	}
+	return table
+}
+
+// fbVector is a vector of tables
+type fbVector []fbObject
+
+func (v fbVector) write(b *fbBuilder) int {
+	b.pad(4)
+	pos := len(b.buf)
+	b.buf = appendUint32s(b.buf, uint32(len(v)))
+	elements := len(b.buf)
+	b.buf = append(b.buf, make([]byte, 4*len(v))...)
+	for i, obj := range v {
+		b.patch(elements+4*i, obj.write(b))
+	}
+	return pos
+}
+
+// fbStructVector is a vector of n structs aligned to 8 bytes
+type fbStructVector struct {
+	n    int
+	data []byte
+}
+
+func (v fbStructVector) write(b *fbBuilder) int {
+	b.buf = append(b.buf, make([]byte, padding(len(b.buf)+4, 8))...)
+	pos := len(b.buf)
+	b.buf = appendUint32s(b.buf, uint32(v.n))
+	b.buf = append(b.buf, v.data...)
+	return pos
+}
+
+type fbString string
+
+func (s fbString) write(b *fbBuilder) int {
+	b.pad(4)
+	pos := len(b.buf)
+	b.buf = appendUint32s(b.buf, uint32(len(s)))
+	b.buf = append(b.buf, s...)
+	b.buf = append(b.buf, 0)
+	return pos
+}
+
+// fbReader reads flatbuffers with bounds checked, the first out of bounds read sets err
+// and all reads return zero values afterwards
+type fbReader struct {
+	buf []byte
+	err error
+}
+
+func (r *fbReader) check(pos, size int) bool {
+	if r.err == nil && (pos < 0 || size < 0 || pos > len(r.buf)-size) {
+		r.err = errInvalidArrowIPC
+	}
+	return r.err == nil
+}
+
+func (r *fbReader) uint16(pos int) uint16 {
+	if !r.check(pos, 2) {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(r.buf[pos:])
+}
+
+func (r *fbReader) uint32(pos int) uint32 {
+	if !r.check(pos, 4) {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(r.buf[pos:])
+}
+
+func (r *fbReader) int64(pos int) int64 {
+	if !r.check(pos, 8) {
+		return 0
+	}
+	return int64(binary.LittleEndian.Uint64(r.buf[pos:]))
+}
+
+// deref returns the target of the unsigned offset at pos
+func (r *fbReader) deref(pos int) int {
+	return pos + int(r.uint32(pos))
+}
+
+func (r *fbReader) root() int {
+	return r.deref(0)
+}
+
+// field returns the position of the field at slot of the table, 0 if the field is absent
+func (r *fbReader) field(table, slot int) int {
+	vtable := table - int(int32(r.uint32(table)))
+	if int(r.uint16(vtable)) < 4+2*(slot+1) {
+		return 0
+	}
+	offset := int(r.uint16(vtable + 4 + 2*slot))
+	if offset == 0 || r.err != nil {
+		return 0
+	}
+	return table + offset
+}
+
+func (r *fbReader) uint8Field(table, slot int) uint8 {
+	pos := r.field(table, slot)
+	if pos == 0 || !r.check(pos, 1) {
+		return 0
+	}
+	return r.buf[pos]
+}
+
+func (r *fbReader) boolField(table, slot int) bool {
+	return r.uint8Field(table, slot) != 0
+}
+
+func (r *fbReader) int16Field(table, slot int) int16 {
+	pos := r.field(table, slot)
+	if pos == 0 {
+		return 0
+	}
+	return int16(r.uint16(pos))
+}
+
+func (r *fbReader) int32Field(table, slot int) int32 {
+	pos := r.field(table, slot)
+	if pos == 0 {
+		return 0
+	}
+	return int32(r.uint32(pos))
+}
+
+func (r *fbReader) int64Field(table, slot int) int64 {
+	pos := r.field(table, slot)
+	if pos == 0 {
+		return 0
+	}
+	return r.int64(pos)
+}
+
+// ref returns the position of the object referenced by the field, 0 if the field is absent
+func (r *fbReader) ref(table, slot int) int {
+	pos := r.field(table, slot)
+	if pos == 0 {
+		return 0
+	}
+	return r.deref(pos)
+}
+
+// vector returns the position of the first element and the length of the vector field
+func (r *fbReader) vector(table, slot int) (int, int) {
+	pos := r.ref(table, slot)
+	if pos == 0 {
+		return 0, 0
+	}
+	n := int(r.uint32(pos))
+	if !r.check(pos+4, 4*n) {
+		return 0, 0
+	}
+	return pos + 4, n
+}
+
+// structVector is vector for structs of the size
+func (r *fbReader) structVector(table, slot int, size int) (int, int) {
+	pos := r.ref(table, slot)
+	if pos == 0 {
+		return 0, 0
+	}
+	n := int(r.uint32(pos))
+	if !r.check(pos+4, size*n) {
+		return 0, 0
+	}
+	return pos + 4, n
+}
+
+// element returns the table at index i of the vector of tables
+func (r *fbReader) element(vector, i int) int {
+	return r.deref(vector + 4*i)
+}
+
+func (r *fbReader) string(table, slot int) string {
+	pos := r.ref(table, slot)
+	if pos == 0 {
+		return ""
+	}
+	n := int(r.uint32(pos))
+	if !r.check(pos+4, n) {
+		return ""
+	}
+	return string(r.buf[pos+4 : pos+4+n])
+}
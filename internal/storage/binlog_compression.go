@@ -0,0 +1,215 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+)
+
+// codecs insert binlogs are compressed with
+const (
+	// BinlogCompressionNone means binlogs are not compressed
+	BinlogCompressionNone = "none"
+	// BinlogCompressionLZ4 compresses binlogs as LZ4 blocks, which is fast to decode
+	BinlogCompressionLZ4 = "lz4"
+	// BinlogCompressionZstd compresses binlogs as Zstandard frames, which is smaller than LZ4
+	BinlogCompressionZstd = "zstd"
+)
+
+// compressedBinlogMagic starts compressed binlogs, whose first byte differs from the custom, arrow and encrypted binlogs.
+// A compressed binlog is laid out as magic | codec (uint8) | uncompressed size (uint64) | compressed binlog
+var compressedBinlogMagic = []byte("MVSCMPR1")
+
+const compressedBinlogHeaderSize = 8 + 1 + 8
+
+// ids of the codecs in the header of compressed binlogs
+const (
+	compressionCodecLZ4  byte = 1
+	compressionCodecZstd byte = 2
+)
+
+// IsCompressedBinlog returns true if the binlog is compressed
+func IsCompressedBinlog(data []byte) bool {
+	return bytes.HasPrefix(data, compressedBinlogMagic)
+}
+
+// ValidateBinlogCompression returns an error if the codec is not supported
+func ValidateBinlogCompression(codec string) error {
+	switch codec {
+	case BinlogCompressionNone, BinlogCompressionLZ4, BinlogCompressionZstd:
+		return nil
+	default:
+		return fmt.Errorf("unknown binlog compression codec %s", codec)
+	}
+}
+
+var (
+	zstdEncodersMu sync.Mutex
+	zstdEncoders   = make(map[zstd.EncoderLevel]*zstd.Encoder)
+
+	zstdDecoderOnce sync.Once
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+)
+
+// getZstdEncoder returns the encoder of the level shared by all binlogs, EncodeAll of an encoder is goroutine safe
+func getZstdEncoder(level zstd.EncoderLevel) (*zstd.Encoder, error) {
+	zstdEncodersMu.Lock()
+	defer zstdEncodersMu.Unlock()
+	if encoder, ok := zstdEncoders[level]; ok {
+		return encoder, nil
+	}
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(level))
+	if err != nil {
+		return nil, err
+	}
+	zstdEncoders[level] = encoder
+	return encoder, nil
+}
+
+func getZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil)
+	})
+	return zstdDecoder, zstdDecoderErr
+}
+
+// CompressBinlog compresses the binlog with the codec at its default level
+func CompressBinlog(codec string, data []byte) ([]byte, error) {
+	return compressBinlog(codec, 0, data)
+}
+
+// compressBinlog compresses the binlog with the codec at the level, 0 means the default level. The level of LZ4 is
+// the search depth of LZ4 HC, and the level of Zstandard is zstd.EncoderLevel. The binlog is returned as it is
+// if it's not compressed smaller
+func compressBinlog(codec string, level int, data []byte) ([]byte, error) {
+	header := make([]byte, compressedBinlogHeaderSize)
+	copy(header, compressedBinlogMagic)
+	binary.LittleEndian.PutUint64(header[len(compressedBinlogMagic)+1:], uint64(len(data)))
+
+	var out []byte
+	switch codec {
+	case BinlogCompressionNone:
+		return data, nil
+	case BinlogCompressionLZ4:
+		header[len(compressedBinlogMagic)] = compressionCodecLZ4
+		out = make([]byte, compressedBinlogHeaderSize+lz4.CompressBlockBound(len(data)))
+		copy(out, header)
+		var n int
+		var err error
+		if level > 0 {
+			n, err = lz4.CompressBlockHC(data, out[compressedBinlogHeaderSize:], level)
+		} else {
+			n, err = lz4.CompressBlock(data, out[compressedBinlogHeaderSize:], nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		// 0 is returned if the data is not compressible
+		if n == 0 {
+			return data, nil
+		}
+		out = out[:compressedBinlogHeaderSize+n]
+	case BinlogCompressionZstd:
+		header[len(compressedBinlogMagic)] = compressionCodecZstd
+		if level <= 0 {
+			level = int(zstd.SpeedDefault)
+		}
+		encoder, err := getZstdEncoder(zstd.EncoderLevel(level))
+		if err != nil {
+			return nil, err
+		}
+		out = encoder.EncodeAll(data, header)
+	default:
+		return nil, fmt.Errorf("unknown binlog compression codec %s", codec)
+	}
+
+	if len(out) >= len(data) {
+		return data, nil
+	}
+	return out, nil
+}
+
+// uncompressedBinlogSize returns the size of the compressed binlog before compressed, data is the binlog or its prefix
+func uncompressedBinlogSize(data []byte) (int64, error) {
+	if len(data) < compressedBinlogHeaderSize || !IsCompressedBinlog(data) {
+		return 0, errors.New("compressed binlog header truncated")
+	}
+	return int64(binary.LittleEndian.Uint64(data[len(compressedBinlogMagic)+1:])), nil
+}
+
+// DecompressBinlog decompresses the binlog compressed by CompressBinlog
+func DecompressBinlog(data []byte) ([]byte, error) {
+	size, err := uncompressedBinlogSize(data)
+	if err != nil {
+		return nil, err
+	}
+	payload := data[compressedBinlogHeaderSize:]
+	switch data[len(compressedBinlogMagic)] {
+	case compressionCodecLZ4:
+		out := make([]byte, size)
+		n, err := lz4.UncompressBlock(payload, out)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress lz4 binlog: %w", err)
+		}
+		if int64(n) != size {
+			return nil, fmt.Errorf("lz4 binlog decompressed to %d bytes, expected %d", n, size)
+		}
+		return out, nil
+	case compressionCodecZstd:
+		decoder, err := getZstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		out, err := decoder.DecodeAll(payload, make([]byte, 0, size))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress zstd binlog: %w", err)
+		}
+		if int64(len(out)) != size {
+			return nil, fmt.Errorf("zstd binlog decompressed to %d bytes, expected %d", len(out), size)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unknown compression codec %d of binlog", data[len(compressedBinlogMagic)])
+	}
+}
+
+// CompressFieldBlobs compresses the insert binlogs in place with the codec, binlogs encrypted are left as they are
+// since they are not compressible
+func CompressFieldBlobs(codec string, blobs []*Blob) error {
+	if codec == BinlogCompressionNone || codec == "" {
+		return nil
+	}
+	for _, blob := range blobs {
+		if IsEncryptedBinlog(blob.Value) {
+			continue
+		}
+		value, err := CompressBinlog(codec, blob.Value)
+		if err != nil {
+			return err
+		}
+		blob.Value = value
+	}
+	return nil
+}
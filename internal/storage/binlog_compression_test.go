@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCompressionTestVectors returns float vectors of the dim in little endian, values are quantized like embeddings
+func newCompressionTestVectors(rows, dim int) []byte {
+	r := rand.New(rand.NewSource(int64(rows)))
+	data := make([]byte, rows*dim*4)
+	for i := 0; i < rows*dim; i++ {
+		v := float32(math.Round(r.NormFloat64()*256) / 256)
+		binary.LittleEndian.PutUint32(data[i*4:], math.Float32bits(v))
+	}
+	return data
+}
+
+func TestCompressBinlog(t *testing.T) {
+	assert.NoError(t, ValidateBinlogCompression(BinlogCompressionNone))
+	assert.NoError(t, ValidateBinlogCompression(BinlogCompressionLZ4))
+	assert.NoError(t, ValidateBinlogCompression(BinlogCompressionZstd))
+	assert.Error(t, ValidateBinlogCompression("gzip"))
+	_, err := CompressBinlog("gzip", []byte("binlog"))
+	assert.Error(t, err)
+
+	binlog := newCompressionTestVectors(100, 128)
+	out, err := CompressBinlog(BinlogCompressionNone, binlog)
+	require.NoError(t, err)
+	assert.Equal(t, binlog, out)
+
+	for _, codec := range []string{BinlogCompressionLZ4, BinlogCompressionZstd} {
+		compressed, err := CompressBinlog(codec, binlog)
+		require.NoError(t, err, codec)
+		assert.True(t, IsCompressedBinlog(compressed), codec)
+		assert.Less(t, len(compressed), len(binlog), codec)
+		size, err := uncompressedBinlogSize(compressed)
+		require.NoError(t, err)
+		assert.EqualValues(t, len(binlog), size)
+
+		decompressed, err := DecompressBinlog(compressed)
+		require.NoError(t, err, codec)
+		assert.Equal(t, binlog, decompressed, codec)
+
+		// data not compressible is kept as it is
+		random := make([]byte, 1024)
+		rand.Read(random)
+		out, err := CompressBinlog(codec, random)
+		require.NoError(t, err)
+		assert.Equal(t, random, out)
+
+		_, err = DecompressBinlog(compressed[:compressedBinlogHeaderSize-1])
+		assert.Error(t, err)
+		_, err = DecompressBinlog(compressed[:len(compressed)-8])
+		assert.Error(t, err)
+	}
+
+	compressed, err := CompressBinlog(BinlogCompressionLZ4, binlog)
+	require.NoError(t, err)
+	compressed[len(compressedBinlogMagic)] = 0xFF
+	_, err = DecompressBinlog(compressed)
+	assert.Error(t, err)
+}
+
+func TestInsertCodec_BinlogCompression(t *testing.T) {
+	defer func(km ColumnKeyManager) { DefaultColumnKeyManager = km }(DefaultColumnKeyManager)
+
+	schema := newArrowTestSchema()
+	rowIDs := make([]int64, 1000)
+	for i := range rowIDs {
+		rowIDs[i] = int64(i)
+	}
+	for _, codec := range []BinlogInsertCodec{NewInsertCodec(schema), NewArrowBinlogCodec(schema)} {
+		for _, compression := range []string{BinlogCompressionNone, BinlogCompressionLZ4, BinlogCompressionZstd} {
+			DefaultColumnKeyManager = nil
+			blobs, _, err := codec.Serialize(PartitionID, SegmentID, newArrowTestInsertData(rowIDs))
+			require.NoError(t, err)
+			require.NoError(t, CompressFieldBlobs(compression, blobs))
+			compressed := 0
+			for _, blob := range blobs {
+				if IsCompressedBinlog(blob.Value) {
+					compressed++
+				}
+			}
+			if compression == BinlogCompressionNone {
+				assert.Equal(t, 0, compressed)
+			} else {
+				assert.NotEqual(t, 0, compressed, compression)
+			}
+
+			setTestBlobLogIdx(blobs, 1)
+			_, _, data, err := codec.Deserialize(blobs)
+			require.NoError(t, err, compression)
+			assert.Equal(t, newArrowTestInsertData(rowIDs).Data, data.Data, compression)
+		}
+
+		// binlogs encrypted are not compressed
+		DefaultColumnKeyManager = newTestColumnKeyManager(t, StringField, FloatVectorField)
+		blobs, _, err := codec.Serialize(PartitionID, SegmentID, newArrowTestInsertData(rowIDs))
+		require.NoError(t, err)
+		require.NoError(t, CompressFieldBlobs(BinlogCompressionZstd, blobs))
+		for _, blob := range blobs {
+			assert.False(t, IsEncryptedBinlog(blob.Value) && IsCompressedBinlog(blob.Value))
+		}
+		setTestBlobLogIdx(blobs, 1)
+		_, _, data, err := codec.Deserialize(blobs)
+		require.NoError(t, err)
+		assert.Equal(t, newArrowTestInsertData(rowIDs).Data, data.Data)
+	}
+}
+
+func TestEstimateMemorySize_Compressed(t *testing.T) {
+	schema := newArrowTestSchema()
+	blobs, _, err := NewInsertCodec(schema).Serialize(PartitionID, SegmentID, newArrowTestInsertData([]int64{1, 2, 3}))
+	require.NoError(t, err)
+
+	memoryKV := memkv.NewMemoryKV()
+	for _, blob := range blobs {
+		require.NoError(t, memoryKV.Save(blob.Key, string(blob.Value)))
+		expected, err := EstimateMemorySize(memoryKV, blob.Key)
+		require.NoError(t, err)
+
+		compressed, err := CompressBinlog(BinlogCompressionLZ4, blob.Value)
+		require.NoError(t, err)
+		key := blob.Key + "_compressed"
+		require.NoError(t, memoryKV.Save(key, string(compressed)))
+		size, err := EstimateMemorySize(memoryKV, key)
+		require.NoError(t, err)
+		assert.Equal(t, expected, size)
+	}
+}
+
+func BenchmarkCompressBinlog(b *testing.B) {
+	binlog := newCompressionTestVectors(1000, 128)
+	cases := []struct {
+		codec string
+		level int
+	}{
+		{BinlogCompressionLZ4, 0},
+		{BinlogCompressionLZ4, 4},
+		{BinlogCompressionLZ4, 16},
+		{BinlogCompressionZstd, 1}, // SpeedFastest
+		{BinlogCompressionZstd, 2}, // SpeedDefault
+		{BinlogCompressionZstd, 3}, // SpeedBetterCompression
+	}
+	for _, c := range cases {
+		compressed, err := compressBinlog(c.codec, c.level, binlog)
+		require.NoError(b, err)
+		ratio := float64(len(binlog)) / float64(len(compressed))
+
+		b.Run(fmt.Sprintf("%s-%d/encode", c.codec, c.level), func(b *testing.B) {
+			b.SetBytes(int64(len(binlog)))
+			for i := 0; i < b.N; i++ {
+				_, _ = compressBinlog(c.codec, c.level, binlog)
+			}
+			b.ReportMetric(ratio, "ratio")
+		})
+		b.Run(fmt.Sprintf("%s-%d/decode", c.codec, c.level), func(b *testing.B) {
+			b.SetBytes(int64(len(binlog)))
+			for i := 0; i < b.N; i++ {
+				_, _ = DecompressBinlog(compressed)
+			}
+		})
+	}
+}
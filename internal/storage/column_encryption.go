@@ -0,0 +1,277 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ColumnKeyManager maps fields to the AES-256 keys their insert binlogs are encrypted with, so that sensitive fields
+// are protected by keys different from the others. Implementations may keep keys in process or fetch them from
+// a key management service like AWS KMS or HashiCorp Vault
+type ColumnKeyManager interface {
+	// FieldKey returns the id and the key to encrypt the binlogs of the field with, empty key id means not encrypted
+	FieldKey(collectionID UniqueID, fieldID FieldID) (keyID string, key []byte, err error)
+	// Key returns the key of the key id to decrypt binlogs with
+	Key(keyID string) ([]byte, error)
+}
+
+// DefaultColumnKeyManager encrypts the insert binlogs serialized and decrypts the binlogs deserialized by InsertCodec,
+// it's set by InitColumnKeyManager at startup of every component reading or writing binlogs. Binlogs are not
+// encrypted if nil
+var DefaultColumnKeyManager ColumnKeyManager
+
+// InitColumnKeyManager sets DefaultColumnKeyManager to the StaticColumnKeyManager of the column keys configured in
+// the encryption section, see ParseStaticColumnKeyManager. It's kept nil if no key is configured
+func InitColumnKeyManager(fieldKeys, keys string) error {
+	if fieldKeys == "" && keys == "" {
+		return nil
+	}
+	km, err := ParseStaticColumnKeyManager(fieldKeys, keys)
+	if err != nil {
+		return err
+	}
+	DefaultColumnKeyManager = km
+	return nil
+}
+
+// encryptedBinlogMagic starts encrypted binlogs, whose first byte differs from the custom and arrow binlogs.
+// An encrypted binlog is laid out as magic | collection id | segment id | field id (int64 each) |
+// key id length (uint16) | key id | nonce | AES-256-GCM sealed binlog. The header before the nonce is authenticated
+// as additional data, so that a binlog sealed for a field of a segment is not accepted as another one
+var encryptedBinlogMagic = []byte("MVSCOLK1")
+
+// sealedBinlogOwner is the binlog an encrypted binlog is sealed for
+type sealedBinlogOwner struct {
+	collectionID UniqueID
+	segmentID    UniqueID
+	fieldID      FieldID
+}
+
+// verify checks the ids read from the decrypted binlog against the ones it's sealed for
+func (o sealedBinlogOwner) verify(collectionID, segmentID UniqueID, fieldID FieldID) error {
+	if o != (sealedBinlogOwner{collectionID, segmentID, fieldID}) {
+		return fmt.Errorf("binlog of collection %d segment %d field %d is sealed for collection %d segment %d field %d",
+			collectionID, segmentID, fieldID, o.collectionID, o.segmentID, o.fieldID)
+	}
+	return nil
+}
+
+const columnKeySize = 32
+
+// ErrColumnKeyManagerNotSet is returned when an encrypted binlog is read without DefaultColumnKeyManager
+var ErrColumnKeyManagerNotSet = errors.New("column key manager not set to decrypt binlog")
+
+// IsEncryptedBinlog returns true if the binlog is encrypted with a column key
+func IsEncryptedBinlog(data []byte) bool {
+	return bytes.HasPrefix(data, encryptedBinlogMagic)
+}
+
+func newColumnCipher(key []byte) (cipher.AEAD, error) {
+	if len(key) != columnKeySize {
+		return nil, fmt.Errorf("column key of %d bytes, expected %d", len(key), columnKeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptBinlog seals the binlog of the owner with the key, the owner and the key id are kept in the header
+func encryptBinlog(keyID string, key []byte, owner sealedBinlogOwner, binlog []byte) ([]byte, error) {
+	aead, err := newColumnCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, len(encryptedBinlogMagic)+3*8+2, len(encryptedBinlogMagic)+3*8+2+len(keyID))
+	offset := copy(header, encryptedBinlogMagic)
+	for _, id := range []int64{owner.collectionID, owner.segmentID, owner.fieldID} {
+		binary.LittleEndian.PutUint64(header[offset:], uint64(id))
+		offset += 8
+	}
+	binary.LittleEndian.PutUint16(header[offset:], uint16(len(keyID)))
+	header = append(header, keyID...)
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, len(header)+len(nonce)+len(binlog)+aead.Overhead())
+	out = append(out, header...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, binlog, header), nil
+}
+
+// decryptBinlog opens the encrypted binlog with the key of the key id in its header, returns the binlog and the
+// owner it's sealed for, which the ids read from the binlog are verified against
+func decryptBinlog(km ColumnKeyManager, data []byte) ([]byte, sealedBinlogOwner, error) {
+	var owner sealedBinlogOwner
+	if km == nil {
+		return nil, owner, ErrColumnKeyManagerNotSet
+	}
+	offset := len(encryptedBinlogMagic)
+	if len(data) < offset+3*8+2 {
+		return nil, owner, errors.New("encrypted binlog header truncated")
+	}
+	owner.collectionID = int64(binary.LittleEndian.Uint64(data[offset:]))
+	owner.segmentID = int64(binary.LittleEndian.Uint64(data[offset+8:]))
+	owner.fieldID = int64(binary.LittleEndian.Uint64(data[offset+16:]))
+	offset += 3 * 8
+	keyIDLen := int(binary.LittleEndian.Uint16(data[offset:]))
+	offset += 2
+	if len(data) < offset+keyIDLen {
+		return nil, owner, errors.New("encrypted binlog header truncated")
+	}
+	keyID := string(data[offset : offset+keyIDLen])
+	offset += keyIDLen
+
+	key, err := km.Key(keyID)
+	if err != nil {
+		return nil, owner, fmt.Errorf("failed to get column key %s: %w", keyID, err)
+	}
+	aead, err := newColumnCipher(key)
+	if err != nil {
+		return nil, owner, err
+	}
+	if len(data) < offset+aead.NonceSize() {
+		return nil, owner, errors.New("encrypted binlog nonce truncated")
+	}
+	nonce := data[offset : offset+aead.NonceSize()]
+	binlog, err := aead.Open(nil, nonce, data[offset+aead.NonceSize():], data[:offset])
+	if err != nil {
+		return nil, owner, fmt.Errorf("failed to decrypt binlog with column key %s: %w", keyID, err)
+	}
+	return binlog, owner, nil
+}
+
+// encryptFieldBlobs encrypts the insert binlogs of the segment keyed by field id in place with the keys of their fields
+func encryptFieldBlobs(km ColumnKeyManager, collectionID, segmentID UniqueID, blobs []*Blob) error {
+	if km == nil {
+		return nil
+	}
+	for _, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.Key, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid field id of binlog %s: %w", blob.Key, err)
+		}
+		keyID, key, err := km.FieldKey(collectionID, fieldID)
+		if err != nil {
+			return err
+		}
+		if keyID == "" {
+			continue
+		}
+		blob.Value, err = encryptBinlog(keyID, key, sealedBinlogOwner{collectionID, segmentID, fieldID}, blob.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type columnKey struct {
+	collectionID UniqueID
+	fieldID      FieldID
+}
+
+// StaticColumnKeyManager keeps the column keys in process
+type StaticColumnKeyManager struct {
+	fieldKeyIDs map[columnKey]string
+	keys        map[string][]byte
+}
+
+// ParseStaticColumnKeyManager creates a StaticColumnKeyManager from the comma separated field keys in the form of
+// collectionID/fieldID:keyID, and the comma separated keys in the form of keyID:base64 encoded AES-256 key
+func ParseStaticColumnKeyManager(fieldKeys, keys string) (*StaticColumnKeyManager, error) {
+	km := &StaticColumnKeyManager{
+		fieldKeyIDs: make(map[columnKey]string),
+		keys:        make(map[string][]byte),
+	}
+	for _, entry := range splitNonEmpty(keys) {
+		kv := strings.SplitN(entry, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid column key %q", entry)
+		}
+		key, err := base64.StdEncoding.DecodeString(kv[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid column key %s: %w", kv[0], err)
+		}
+		if len(key) != columnKeySize {
+			return nil, fmt.Errorf("column key %s of %d bytes, expected %d", kv[0], len(key), columnKeySize)
+		}
+		km.keys[kv[0]] = key
+	}
+	for _, entry := range splitNonEmpty(fieldKeys) {
+		kv := strings.SplitN(entry, ":", 2)
+		ids := strings.SplitN(kv[0], "/", 2)
+		if len(kv) != 2 || len(ids) != 2 {
+			return nil, fmt.Errorf("invalid field key %q", entry)
+		}
+		collectionID, err := strconv.ParseInt(ids[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field key %q: %w", entry, err)
+		}
+		fieldID, err := strconv.ParseInt(ids[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field key %q: %w", entry, err)
+		}
+		if _, ok := km.keys[kv[1]]; !ok {
+			return nil, fmt.Errorf("unknown column key %s of field key %q", kv[1], entry)
+		}
+		km.fieldKeyIDs[columnKey{collectionID, fieldID}] = kv[1]
+	}
+	return km, nil
+}
+
+func splitNonEmpty(s string) []string {
+	ret := make([]string, 0)
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			ret = append(ret, part)
+		}
+	}
+	return ret
+}
+
+// FieldKey implements ColumnKeyManager
+func (km *StaticColumnKeyManager) FieldKey(collectionID UniqueID, fieldID FieldID) (string, []byte, error) {
+	keyID, ok := km.fieldKeyIDs[columnKey{collectionID, fieldID}]
+	if !ok {
+		return "", nil, nil
+	}
+	return keyID, km.keys[keyID], nil
+}
+
+// Key implements ColumnKeyManager
+func (km *StaticColumnKeyManager) Key(keyID string) ([]byte, error) {
+	key, ok := km.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("column key %s not found", keyID)
+	}
+	return key, nil
+}
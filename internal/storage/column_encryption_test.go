@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestColumnKeyManager(t *testing.T, fieldIDs ...FieldID) *StaticColumnKeyManager {
+	pii := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	analytics := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32))
+	fieldKeys := ""
+	for i, fieldID := range fieldIDs {
+		keyID := "pii"
+		if i%2 == 1 {
+			keyID = "analytics"
+		}
+		fieldKeys += fmt.Sprintf("%d/%d:%s,", CollectionID, fieldID, keyID)
+	}
+	km, err := ParseStaticColumnKeyManager(fieldKeys, "pii:"+pii+", analytics:"+analytics)
+	require.NoError(t, err)
+	return km
+}
+
+func TestParseStaticColumnKeyManager(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	km, err := ParseStaticColumnKeyManager("1/100:k1", "k1:"+key)
+	require.NoError(t, err)
+	keyID, fieldKey, err := km.FieldKey(1, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "k1", keyID)
+	assert.Equal(t, bytes.Repeat([]byte{1}, 32), fieldKey)
+
+	// same field id of another collection
+	keyID, _, err = km.FieldKey(2, 100)
+	assert.NoError(t, err)
+	assert.Empty(t, keyID)
+
+	_, err = km.Key("k2")
+	assert.Error(t, err)
+
+	km, err = ParseStaticColumnKeyManager("", "")
+	assert.NoError(t, err)
+	keyID, _, _ = km.FieldKey(1, 100)
+	assert.Empty(t, keyID)
+
+	for _, c := range []struct{ fieldKeys, keys string }{
+		{"1/100:k1", "k1"},
+		{"1/100:k1", "k1:not-base64"},
+		{"1/100:k1", "k1:" + base64.StdEncoding.EncodeToString([]byte("short"))},
+		{"1/100:k2", "k1:" + key},
+		{"100:k1", "k1:" + key},
+		{"a/100:k1", "k1:" + key},
+		{"1/b:k1", "k1:" + key},
+	} {
+		_, err = ParseStaticColumnKeyManager(c.fieldKeys, c.keys)
+		assert.Error(t, err, c)
+	}
+}
+
+func TestEncryptBinlog(t *testing.T) {
+	km := newTestColumnKeyManager(t)
+	key, err := km.Key("pii")
+	require.NoError(t, err)
+
+	binlog := []byte("binlog of a sensitive field")
+	owner := sealedBinlogOwner{CollectionID, SegmentID, StringField}
+	encrypted, err := encryptBinlog("pii", key, owner, binlog)
+	require.NoError(t, err)
+	assert.True(t, IsEncryptedBinlog(encrypted))
+	assert.False(t, IsEncryptedBinlog(binlog))
+	assert.False(t, bytes.Contains(encrypted, binlog))
+
+	decrypted, sealedFor, err := decryptBinlog(km, encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, binlog, decrypted)
+	assert.Equal(t, owner, sealedFor)
+	assert.NoError(t, sealedFor.verify(CollectionID, SegmentID, StringField))
+	assert.Error(t, sealedFor.verify(CollectionID, SegmentID+1, StringField))
+	assert.Error(t, sealedFor.verify(CollectionID, SegmentID, StringField+1))
+
+	_, _, err = decryptBinlog(nil, encrypted)
+	assert.ErrorIs(t, err, ErrColumnKeyManagerNotSet)
+
+	// the owner and the key id in header are authenticated
+	headerLen := len(encryptedBinlogMagic) + 3*8 + 2
+	for _, offset := range []int{len(encryptedBinlogMagic), len(encryptedBinlogMagic) + 8, len(encryptedBinlogMagic) + 16} {
+		tampered := append([]byte{}, encrypted...)
+		tampered[offset] ^= 0x01
+		_, _, err = decryptBinlog(km, tampered)
+		assert.Error(t, err)
+	}
+	tampered := append([]byte{}, encrypted...)
+	copy(tampered[headerLen:], "xxx")
+	_, _, err = decryptBinlog(km, tampered)
+	assert.Error(t, err)
+
+	tampered = append([]byte{}, encrypted...)
+	tampered[len(tampered)-1] ^= 0xFF
+	_, _, err = decryptBinlog(km, tampered)
+	assert.Error(t, err)
+
+	for _, n := range []int{len(encryptedBinlogMagic) + 1, headerLen - 1, headerLen + 2, headerLen + len("pii") + 4} {
+		_, _, err = decryptBinlog(km, encrypted[:n])
+		assert.Error(t, err)
+	}
+
+	_, err = encryptBinlog("short", []byte("short"), owner, binlog)
+	assert.Error(t, err)
+}
+
+func TestInitColumnKeyManager(t *testing.T) {
+	defer func(km ColumnKeyManager) { DefaultColumnKeyManager = km }(DefaultColumnKeyManager)
+	DefaultColumnKeyManager = nil
+
+	assert.NoError(t, InitColumnKeyManager("", ""))
+	assert.Nil(t, DefaultColumnKeyManager)
+
+	assert.Error(t, InitColumnKeyManager("1/100:k1", ""))
+	assert.Nil(t, DefaultColumnKeyManager)
+
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	assert.NoError(t, InitColumnKeyManager("1/100:k1", "k1:"+key))
+	assert.NotNil(t, DefaultColumnKeyManager)
+}
+
+func TestInsertCodec_ColumnEncryption(t *testing.T) {
+	defer func(km ColumnKeyManager) { DefaultColumnKeyManager = km }(DefaultColumnKeyManager)
+
+	schema := newArrowTestSchema()
+	encryptedFields := map[FieldID]bool{StringField: true, FloatVectorField: true}
+	for _, codec := range []BinlogInsertCodec{NewInsertCodec(schema), NewArrowBinlogCodec(schema)} {
+		DefaultColumnKeyManager = newTestColumnKeyManager(t, StringField, FloatVectorField)
+		blobs, statsBlobs, err := codec.Serialize(PartitionID, SegmentID, newArrowTestInsertData([]int64{1, 2, 3}))
+		require.NoError(t, err)
+		for _, blob := range blobs {
+			fieldID, err := strconv.ParseInt(blob.Key, 10, 64)
+			require.NoError(t, err)
+			assert.Equal(t, encryptedFields[fieldID], IsEncryptedBinlog(blob.Value), fieldID)
+		}
+		_, err = DeserializeStats(statsBlobs)
+		assert.NoError(t, err)
+
+		setTestBlobLogIdx(blobs, 1)
+		_, _, data, err := codec.Deserialize(blobs)
+		require.NoError(t, err)
+		assert.Equal(t, newArrowTestInsertData([]int64{1, 2, 3}).Data, data.Data)
+
+		// a binlog sealed for another segment is rejected
+		otherBlobs, _, err := codec.Serialize(PartitionID, SegmentID+1, newArrowTestInsertData([]int64{1, 2, 3}))
+		require.NoError(t, err)
+		setTestBlobLogIdx(otherBlobs, 1)
+		swapped := make([]*Blob, 0, len(blobs))
+		for i, blob := range blobs {
+			if IsEncryptedBinlog(blob.Value) {
+				blob = otherBlobs[i]
+			}
+			swapped = append(swapped, blob)
+		}
+		_, _, _, err = codec.Deserialize(swapped)
+		assert.Error(t, err)
+
+		DefaultColumnKeyManager = nil
+		_, _, _, err = codec.Deserialize(blobs)
+		assert.ErrorIs(t, err, ErrColumnKeyManagerNotSet)
+	}
+}
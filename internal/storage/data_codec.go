@@ -24,7 +24,9 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
@@ -271,7 +273,7 @@ func NewInsertCodec(schema *etcdpb.CollectionMeta) *InsertCodec {
 // Serialize transfer insert data to blob. It will sort insert data by timestamp.
 // From schema, it get all fields.
 // For each field, it will create a binlog writer, and write a event to the binlog.
-// It returns binlog buffer in the end.
+// It returns binlog buffer in the end, binlogs of fields with column keys in DefaultColumnKeyManager are encrypted.
 func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID UniqueID, data *InsertData) ([]*Blob, []*Blob, error) {
 	blobs := make([]*Blob, 0)
 	statsBlobs := make([]*Blob, 0)
@@ -376,9 +378,96 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		}
 	}
 
+	// schema-less dynamic fields
+	dynamicCodec := NewDynamicFieldCodec()
+	for _, fieldID := range dynamicFieldIDs(data) {
+		dynamicData, ok := data.Data[fieldID].(*DynamicFieldData)
+		if !ok {
+			return nil, nil, fmt.Errorf("data of dynamic field %d is %T", fieldID, data.Data[fieldID])
+		}
+		blob, err := dynamicCodec.Serialize(insertCodec.Schema.ID, partitionID, segmentID, fieldID,
+			typeutil.Timestamp(startTs), typeutil.Timestamp(endTs), dynamicData)
+		if err != nil {
+			return nil, nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+
+	// stats binlogs are kept in plain to be read without column keys
+	if err := encryptFieldBlobs(DefaultColumnKeyManager, insertCodec.Schema.ID, segmentID, blobs); err != nil {
+		return nil, nil, err
+	}
 	return blobs, statsBlobs, nil
 }
 
+// SerializeSketches builds HyperLogLog sketches of user-defined non-vector numeric fields in data,
+// sketch blobs are keyed by field id like stats blobs
+func (insertCodec *InsertCodec) SerializeSketches(data *InsertData) ([]*Blob, error) {
+	sketchBlobs := make([]*Blob, 0)
+	for _, field := range insertCodec.Schema.Schema.Fields {
+		if field.FieldID < common.StartOfUserFieldID || !IsSketchDataType(field.DataType) {
+			continue
+		}
+		singleData, ok := data.Data[field.FieldID]
+		if !ok {
+			continue
+		}
+		sketchWriter := &SketchWriter{}
+		if err := sketchWriter.SketchFieldData(field.FieldID, singleData); err != nil {
+			return nil, err
+		}
+		sketchBlobs = append(sketchBlobs, &Blob{
+			Key:   fmt.Sprintf("%d", field.FieldID),
+			Value: sketchWriter.GetBuffer(),
+		})
+	}
+	return sketchBlobs, nil
+}
+
+// SerializeFieldStats generates stats blobs of scalar fields whose stats are not generated by Serialize,
+// the stats of fields are generated concurrently, one goroutine per field.
+// Stats blobs are keyed by field id, and ordered as fields in the schema
+func (insertCodec *InsertCodec) SerializeFieldStats(data *InsertData) ([]*Blob, error) {
+	fields := make([]*schemapb.FieldSchema, 0, len(insertCodec.Schema.Schema.Fields))
+	for _, field := range insertCodec.Schema.Schema.Fields {
+		if field.FieldID < common.StartOfUserFieldID || !IsFieldStatsDataType(field.DataType) {
+			continue
+		}
+		if _, ok := data.Data[field.FieldID]; ok {
+			fields = append(fields, field)
+		}
+	}
+
+	statsBlobs := make([]*Blob, len(fields))
+	var g errgroup.Group
+	for i, field := range fields {
+		i, field := i, field
+		g.Go(func() error {
+			statsWriter := &StatsWriter{}
+			if err := statsWriter.StatsFieldData(field.FieldID, data.Data[field.FieldID]); err != nil {
+				return err
+			}
+			statsBlobs[i] = &Blob{
+				Key:   fmt.Sprintf("%d", field.FieldID),
+				Value: statsWriter.GetBuffer(),
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	// empty field data has no stats
+	ret := statsBlobs[:0]
+	for _, blob := range statsBlobs {
+		if blob.Value != nil {
+			ret = append(ret, blob)
+		}
+	}
+	return ret, nil
+}
+
 func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 	collectionID UniqueID,
 	partitionID UniqueID,
@@ -401,7 +490,53 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 	var sID UniqueID
 	resultData := &InsertData{}
 	resultData.Data = make(map[FieldID]FieldData)
+	// encrypted binlogs are verified to be sealed for the segment deserialized
+	var owners []*sealedBinlogOwner
+	segmentIDs := make(map[UniqueID]struct{})
 	for _, blob := range blobList {
+		// binlogs compressed by the DataNode flushing them
+		if IsCompressedBinlog(blob.Value) {
+			value, err := DecompressBinlog(blob.Value)
+			if err != nil {
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+			blob = &Blob{Key: blob.Key, Value: value}
+		}
+
+		// binlogs encrypted with column keys
+		var owner *sealedBinlogOwner
+		if IsEncryptedBinlog(blob.Value) {
+			value, sealedFor, err := decryptBinlog(DefaultColumnKeyManager, blob.Value)
+			if err != nil {
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+			blob, owner = &Blob{Key: blob.Key, Value: value}, &sealedFor
+			owners = append(owners, owner)
+		}
+
+		// binlogs written by ArrowBinlogCodec
+		if IsArrowBinlog(blob.Value) {
+			meta, fieldData, err := readArrowBinlog(blob.Value)
+			if err != nil {
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+			cID, pID, sID = meta.collectionID, meta.partitionID, meta.segmentID
+			segmentIDs[sID] = struct{}{}
+			if owner != nil {
+				if err := owner.verify(cID, sID, meta.fieldID); err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+			}
+			resultData.Data[meta.fieldID], err = appendFieldData(resultData.Data[meta.fieldID], fieldData)
+			if err != nil {
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+			if meta.fieldID == rootcoord.TimeStampField {
+				resultData.Infos = append(resultData.Infos, BlobInfo{Length: fieldData.RowNum()})
+			}
+			continue
+		}
+
 		binlogReader, err := NewBinlogReader(blob.Value)
 		if err != nil {
 			return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
@@ -409,9 +544,15 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 
 		// read partitionID and SegmentID
 		cID, pID, sID = binlogReader.CollectionID, binlogReader.PartitionID, binlogReader.SegmentID
+		segmentIDs[sID] = struct{}{}
 
 		dataType := binlogReader.PayloadDataType
 		fieldID := binlogReader.FieldID
+		if owner != nil {
+			if err := owner.verify(cID, sID, fieldID); err != nil {
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+		}
 		totalLength := 0
 		for {
 			eventReader, err := binlogReader.NextEventReader()
@@ -421,6 +562,21 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 			if eventReader == nil {
 				break
 			}
+			if isDynamicFieldBinlog(binlogReader) {
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &DynamicFieldData{}
+				}
+				dynamicFieldData := resultData.Data[fieldID].(*DynamicFieldData)
+				rows, err := NewDynamicFieldCodec().Deserialize(eventReader)
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				dynamicFieldData.Data = append(dynamicFieldData.Data, rows...)
+				totalLength += len(rows)
+				dynamicFieldData.NumRows = append(dynamicFieldData.NumRows, int64(len(rows)))
+				resultData.Data[fieldID] = dynamicFieldData
+				continue
+			}
 			switch dataType {
 			case schemapb.DataType_Bool:
 				if resultData.Data[fieldID] == nil {
@@ -609,6 +765,16 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 		insertCodec.readerCloseFunc = append(insertCodec.readerCloseFunc, readerClose(binlogReader))
 	}
 
+	if len(owners) > 0 && len(segmentIDs) > 1 {
+		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil,
+			fmt.Errorf("encrypted binlogs are mixed with binlogs of %d segments", len(segmentIDs))
+	}
+	for _, owner := range owners {
+		if owner.collectionID != cID || owner.segmentID != sID {
+			return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil,
+				fmt.Errorf("binlog of segment %d is sealed for collection %d segment %d", sID, owner.collectionID, owner.segmentID)
+		}
+	}
 	return cID, pID, sID, resultData, nil
 }
 
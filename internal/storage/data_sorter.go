@@ -92,6 +92,13 @@ func (ds *DataSorter) Swap(i, j int) {
 			panic(errMsg)
 		}
 	}
+	// dynamic fields are not in schema
+	for fieldID, singleData := range ds.InsertData.Data {
+		if dynamicData, ok := singleData.(*DynamicFieldData); ok && IsDynamicField(fieldID) {
+			data := dynamicData.Data
+			data[i], data[j] = data[j], data[i]
+		}
+	}
 }
 
 // Less returns whether i-th entry is less than j-th entry, using ID field comparison result
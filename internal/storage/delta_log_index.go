@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+// DeltaLogIndexSuffix is appended to the path of a delta log to get the path of its index file
+const DeltaLogIndexSuffix = ".idx"
+
+// deltaLogIndexEntrySize is the bytes of a (pk, offset) pair in an index file
+const deltaLogIndexEntrySize = 16
+
+// DeltaLogIndex maps the primary keys of a delta log to the row offsets of their deletions,
+// the entries are sorted by primary key so that a key can be located by binary search.
+// It's serialized as a flat array of (pk, offset) int64 pairs without any header
+type DeltaLogIndex struct {
+	pks     []int64
+	offsets []int64
+}
+
+// NewDeltaLogIndex builds the index of the delete data serialized into a delta log
+func NewDeltaLogIndex(data *DeleteData) *DeltaLogIndex {
+	idx := &DeltaLogIndex{
+		pks:     make([]int64, len(data.Pks)),
+		offsets: make([]int64, len(data.Pks)),
+	}
+	for i := range data.Pks {
+		idx.offsets[i] = int64(i)
+	}
+	// offsets of the same pk keep their order in the delta log
+	sort.SliceStable(idx.offsets, func(i, j int) bool {
+		return data.Pks[idx.offsets[i]] < data.Pks[idx.offsets[j]]
+	})
+	for i, offset := range idx.offsets {
+		idx.pks[i] = data.Pks[offset]
+	}
+	return idx
+}
+
+// Len returns the number of entries in the index
+func (idx *DeltaLogIndex) Len() int {
+	return len(idx.pks)
+}
+
+// Lookup returns the row offsets of the deletions of pk in the delta log, nil if pk is not deleted
+func (idx *DeltaLogIndex) Lookup(pk int64) []int64 {
+	begin := sort.Search(len(idx.pks), func(i int) bool { return idx.pks[i] >= pk })
+	end := begin
+	for end < len(idx.pks) && idx.pks[end] == pk {
+		end++
+	}
+	if begin == end {
+		return nil
+	}
+	return idx.offsets[begin:end]
+}
+
+// Marshal serializes the index into the content of an index file
+func (idx *DeltaLogIndex) Marshal() []byte {
+	buf := make([]byte, len(idx.pks)*deltaLogIndexEntrySize)
+	for i, pk := range idx.pks {
+		common.Endian.PutUint64(buf[i*deltaLogIndexEntrySize:], uint64(pk))
+		common.Endian.PutUint64(buf[i*deltaLogIndexEntrySize+8:], uint64(idx.offsets[i]))
+	}
+	return buf
+}
+
+// UnmarshalDeltaLogIndex deserializes the content of an index file
+func UnmarshalDeltaLogIndex(data []byte) (*DeltaLogIndex, error) {
+	if len(data)%deltaLogIndexEntrySize != 0 {
+		return nil, fmt.Errorf("invalid delta log index size %d", len(data))
+	}
+	n := len(data) / deltaLogIndexEntrySize
+	idx := &DeltaLogIndex{
+		pks:     make([]int64, n),
+		offsets: make([]int64, n),
+	}
+	for i := 0; i < n; i++ {
+		idx.pks[i] = int64(common.Endian.Uint64(data[i*deltaLogIndexEntrySize:]))
+		idx.offsets[i] = int64(common.Endian.Uint64(data[i*deltaLogIndexEntrySize+8:]))
+		if i > 0 && idx.pks[i] < idx.pks[i-1] {
+			return nil, fmt.Errorf("delta log index is not sorted at entry %d", i)
+		}
+	}
+	return idx, nil
+}
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeltaLogIndex(t *testing.T) {
+	data := &DeleteData{}
+	for i, pk := range []int64{5, 3, 9, 3, -1} {
+		data.Append(pk, Timestamp(i+1))
+	}
+	idx := NewDeltaLogIndex(data)
+	assert.Equal(t, 5, idx.Len())
+	assert.Equal(t, []int64{1, 3}, idx.Lookup(3))
+	assert.Equal(t, []int64{0}, idx.Lookup(5))
+	assert.Equal(t, []int64{4}, idx.Lookup(-1))
+	assert.Nil(t, idx.Lookup(4))
+	assert.Nil(t, idx.Lookup(10))
+
+	blob := idx.Marshal()
+	assert.Equal(t, 5*deltaLogIndexEntrySize, len(blob))
+	restored, err := UnmarshalDeltaLogIndex(blob)
+	require.NoError(t, err)
+	assert.Equal(t, idx, restored)
+
+	empty, err := UnmarshalDeltaLogIndex(NewDeltaLogIndex(&DeleteData{}).Marshal())
+	require.NoError(t, err)
+	assert.Equal(t, 0, empty.Len())
+	assert.Nil(t, empty.Lookup(1))
+}
+
+func TestUnmarshalDeltaLogIndex_invalid(t *testing.T) {
+	_, err := UnmarshalDeltaLogIndex(make([]byte, deltaLogIndexEntrySize+1))
+	assert.Error(t, err)
+
+	data := &DeleteData{}
+	data.Append(1, 1)
+	data.Append(2, 2)
+	blob := NewDeltaLogIndex(data).Marshal()
+	// swap the entries
+	blob = append(blob[deltaLogIndexEntrySize:], blob[:deltaLogIndexEntrySize]...)
+	_, err = UnmarshalDeltaLogIndex(blob)
+	assert.Error(t, err)
+}
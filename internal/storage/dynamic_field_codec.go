@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// DynamicFieldIDBase is the minimal id of dynamic fields. Fields with id not less than it are not defined
+// in collection schema, and are serialized as JSON by DynamicFieldCodec
+var DynamicFieldIDBase int64 = 65536
+
+// dynamicFieldKey marks the binlog of a dynamic field in the extras of descriptor event
+const dynamicFieldKey = "dynamic_field"
+
+// IsDynamicField returns true if the field is a schema-less dynamic field
+func IsDynamicField(fieldID FieldID) bool {
+	return fieldID >= DynamicFieldIDBase
+}
+
+// DynamicFieldData holds rows of a schema-less field, each row is an arbitrary JSON object
+type DynamicFieldData struct {
+	NumRows []int64
+	Data    []map[string]interface{}
+}
+
+func (data *DynamicFieldData) Length() int              { return len(data.Data) }
+func (data *DynamicFieldData) Get(i int) interface{}    { return data.Data[i] }
+func (data *DynamicFieldData) RowNum() int              { return len(data.Data) }
+func (data *DynamicFieldData) GetRow(i int) interface{} { return data.Data[i] }
+
+// GetMemorySize returns the size of rows encoded in JSON
+func (data *DynamicFieldData) GetMemorySize() int {
+	size := 0
+	for _, row := range data.Data {
+		bs, _ := json.Marshal(row)
+		size += len(bs)
+	}
+	return size
+}
+
+// DynamicFieldCodec serializes a dynamic field as a column of JSON strings
+type DynamicFieldCodec struct{}
+
+// NewDynamicFieldCodec creates a DynamicFieldCodec
+func NewDynamicFieldCodec() *DynamicFieldCodec {
+	return &DynamicFieldCodec{}
+}
+
+// Serialize writes the rows into an insert binlog of string payload, which is marked as dynamic field
+func (codec *DynamicFieldCodec) Serialize(collectionID, partitionID, segmentID UniqueID, fieldID FieldID,
+	startTs, endTs typeutil.Timestamp, data *DynamicFieldData) (*Blob, error) {
+	writer := NewInsertBinlogWriter(schemapb.DataType_String, collectionID, partitionID, segmentID, fieldID)
+	eventWriter, err := writer.NextInsertEventWriter()
+	if err != nil {
+		return nil, err
+	}
+	eventWriter.SetEventTimestamp(startTs, endTs)
+
+	originalSize := 0
+	for _, row := range data.Data {
+		bs, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		if err = eventWriter.AddOneStringToPayload(string(bs)); err != nil {
+			return nil, err
+		}
+		originalSize += len(bs)
+	}
+	writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", originalSize))
+	writer.AddExtra(dynamicFieldKey, "true")
+	writer.SetEventTimeStamp(startTs, endTs)
+
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	buffer, err := writer.GetBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{
+		Key:   fmt.Sprintf("%d", fieldID),
+		Value: buffer,
+	}, nil
+}
+
+// Deserialize reads the rows from an insert event of dynamic field binlog
+func (codec *DynamicFieldCodec) Deserialize(eventReader *EventReader) ([]map[string]interface{}, error) {
+	length, err := eventReader.GetPayloadLengthFromReader()
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]map[string]interface{}, 0, length)
+	for i := 0; i < length; i++ {
+		str, err := eventReader.GetOneStringFromPayload(i)
+		if err != nil {
+			return nil, err
+		}
+		var row map[string]interface{}
+		if err = json.Unmarshal([]byte(str), &row); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// isDynamicFieldBinlog returns true if the binlog is serialized by DynamicFieldCodec
+func isDynamicFieldBinlog(reader *BinlogReader) bool {
+	marker, ok := reader.Extras[dynamicFieldKey]
+	return ok && marker == "true"
+}
+
+// dynamicFieldIDs returns ids of the dynamic fields in data in ascending order
+func dynamicFieldIDs(data *InsertData) []FieldID {
+	fieldIDs := make([]FieldID, 0)
+	for fieldID := range data.Data {
+		if IsDynamicField(fieldID) {
+			fieldIDs = append(fieldIDs, fieldID)
+		}
+	}
+	sort.Slice(fieldIDs, func(i, j int) bool { return fieldIDs[i] < fieldIDs[j] })
+	return fieldIDs
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDynamicFieldCodec(t *testing.T) {
+	dynamicField := DynamicFieldIDBase + 1
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:  RowIDField,
+					Name:     "row_id",
+					DataType: schemapb.DataType_Int64,
+				},
+				{
+					FieldID:  TimestampField,
+					Name:     "Timestamp",
+					DataType: schemapb.DataType_Int64,
+				},
+				{
+					FieldID:      Int64Field,
+					Name:         "field_int64",
+					IsPrimaryKey: true,
+					DataType:     schemapb.DataType_Int64,
+				},
+			},
+		},
+	}
+	// numbers are decoded as float64 from JSON
+	rows := []map[string]interface{}{
+		{
+			"name": "row 2",
+			"tags": []interface{}{"a", "b"},
+			"nested": map[string]interface{}{
+				"level": 2.0,
+				"inner": map[string]interface{}{"ok": true, "values": []interface{}{1.0, 2.5}},
+			},
+		},
+		{
+			"name":   "row 1",
+			"nested": map[string]interface{}{"level": 1.0, "empty": map[string]interface{}{}},
+			"null":   nil,
+		},
+	}
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{2, 1}},
+			TimestampField: &Int64FieldData{NumRows: []int64{2}, Data: []int64{2, 1}},
+			Int64Field:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{20, 10}},
+			dynamicField:   &DynamicFieldData{NumRows: []int64{2}, Data: []map[string]interface{}{rows[0], rows[1]}},
+		},
+	}
+
+	codec := NewInsertCodec(schema)
+	blobs, _, err := codec.Serialize(PartitionID, SegmentID, insertData)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(blobs))
+	assert.Equal(t, "65537", blobs[3].Key)
+
+	reader, err := NewBinlogReader(blobs[3].Value)
+	assert.Nil(t, err)
+	assert.Equal(t, schemapb.DataType_String, reader.PayloadDataType)
+	assert.True(t, isDynamicFieldBinlog(reader))
+	assert.Nil(t, reader.Close())
+
+	partitionID, segmentID, resultData, err := codec.Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(PartitionID), partitionID)
+	assert.Equal(t, int64(SegmentID), segmentID)
+	// rows are sorted by row id together with fields in schema
+	assert.Equal(t, []int64{10, 20}, resultData.Data[Int64Field].(*Int64FieldData).Data)
+	dynamicData, ok := resultData.Data[dynamicField].(*DynamicFieldData)
+	assert.True(t, ok)
+	assert.Equal(t, []int64{2}, dynamicData.NumRows)
+	assert.Equal(t, []map[string]interface{}{rows[1], rows[0]}, dynamicData.Data)
+	assert.Nil(t, codec.Close())
+}
+
+func TestDynamicFieldCodec_TypeError(t *testing.T) {
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, DataType: schemapb.DataType_Int64},
+			},
+		},
+	}
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:         &Int64FieldData{NumRows: []int64{1}, Data: []int64{1}},
+			TimestampField:     &Int64FieldData{NumRows: []int64{1}, Data: []int64{1}},
+			DynamicFieldIDBase: &StringFieldData{NumRows: []int64{1}, Data: []string{"{}"}},
+		},
+	}
+	_, _, err := NewInsertCodec(schema).Serialize(PartitionID, SegmentID, insertData)
+	assert.NotNil(t, err)
+}
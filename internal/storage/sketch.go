@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/spaolacci/murmur3"
+)
+
+// sketchPrecision is the number of hash bits indexing HyperLogLog registers,
+// 2^14 registers give about 0.8% standard error
+const sketchPrecision uint8 = 14
+
+// HyperLogLog estimates the number of distinct values added to it
+type HyperLogLog struct {
+	Precision uint8   `json:"precision"`
+	Registers []uint8 `json:"registers"`
+}
+
+// NewHyperLogLog creates a HyperLogLog with 2^precision registers
+func NewHyperLogLog(precision uint8) *HyperLogLog {
+	return &HyperLogLog{
+		Precision: precision,
+		Registers: make([]uint8, 1<<precision),
+	}
+}
+
+// Add adds the value to the sketch
+func (h *HyperLogLog) Add(value []byte) {
+	hash := murmur3.Sum64(value)
+	idx := hash >> (64 - h.Precision)
+	// the guard bit limits rank to 64 - precision + 1
+	w := hash<<h.Precision | 1<<(h.Precision-1)
+	rank := uint8(bits.LeadingZeros64(w)) + 1
+	if rank > h.Registers[idx] {
+		h.Registers[idx] = rank
+	}
+}
+
+// Merge merges other into h, the sketches must have the same precision
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if h.Precision != other.Precision || len(h.Registers) != len(other.Registers) {
+		return fmt.Errorf("merge HyperLogLog of precision %d into precision %d", other.Precision, h.Precision)
+	}
+	for i, r := range other.Registers {
+		if r > h.Registers[i] {
+			h.Registers[i] = r
+		}
+	}
+	return nil
+}
+
+// Estimate returns the estimated number of distinct values
+func (h *HyperLogLog) Estimate() uint64 {
+	m := float64(len(h.Registers))
+	alpha := 0.7213 / (1 + 1.079/m)
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.Registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := alpha * m * m / sum
+	// linear counting is more accurate for small cardinality
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+// FieldSketch is the HyperLogLog sketch of a field stored in sketch binlog
+type FieldSketch struct {
+	FieldID int64        `json:"fieldID"`
+	HLL     *HyperLogLog `json:"hll"`
+}
+
+// IsSketchDataType returns true if sketches are built for fields of the data type, i.e. non-vector numeric types
+func IsSketchDataType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double:
+		return true
+	default:
+		return false
+	}
+}
+
+type SketchWriter struct {
+	buffer []byte
+}
+
+func (sw *SketchWriter) GetBuffer() []byte {
+	return sw.buffer
+}
+
+// SketchFieldData builds the HyperLogLog sketch of numeric field data
+func (sw *SketchWriter) SketchFieldData(fieldID int64, data FieldData) error {
+	hll := NewHyperLogLog(sketchPrecision)
+	b := make([]byte, 8)
+	add := func(v uint64) {
+		common.Endian.PutUint64(b, v)
+		hll.Add(b)
+	}
+	switch fieldData := data.(type) {
+	case *Int8FieldData:
+		for _, v := range fieldData.Data {
+			add(uint64(v))
+		}
+	case *Int16FieldData:
+		for _, v := range fieldData.Data {
+			add(uint64(v))
+		}
+	case *Int32FieldData:
+		for _, v := range fieldData.Data {
+			add(uint64(v))
+		}
+	case *Int64FieldData:
+		for _, v := range fieldData.Data {
+			add(uint64(v))
+		}
+	case *FloatFieldData:
+		for _, v := range fieldData.Data {
+			add(math.Float64bits(float64(v)))
+		}
+	case *DoubleFieldData:
+		for _, v := range fieldData.Data {
+			add(math.Float64bits(v))
+		}
+	default:
+		return fmt.Errorf("sketch of field data %T is not supported", data)
+	}
+
+	b, err := json.Marshal(&FieldSketch{FieldID: fieldID, HLL: hll})
+	if err != nil {
+		return err
+	}
+	sw.buffer = b
+	return nil
+}
+
+type SketchReader struct {
+	buffer []byte
+}
+
+func (sr *SketchReader) SetBuffer(buffer []byte) {
+	sr.buffer = buffer
+}
+
+func (sr *SketchReader) GetFieldSketch() (*FieldSketch, error) {
+	sketch := &FieldSketch{}
+	err := json.Unmarshal(sr.buffer, sketch)
+	if err != nil {
+		return nil, err
+	}
+	return sketch, nil
+}
+
+// DeserializeSketches reads field sketches from sketch binlogs
+func DeserializeSketches(blobs []*Blob) ([]*FieldSketch, error) {
+	results := make([]*FieldSketch, 0, len(blobs))
+	for _, blob := range blobs {
+		if blob.Value == nil {
+			continue
+		}
+		sr := &SketchReader{}
+		sr.SetBuffer(blob.Value)
+		sketch, err := sr.GetFieldSketch()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, sketch)
+	}
+	return results, nil
+}
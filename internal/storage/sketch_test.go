@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	b := make([]byte, 8)
+	for _, n := range []int{0, 1, 100, 10000, 1000000} {
+		hll := NewHyperLogLog(sketchPrecision)
+		for i := 0; i < n; i++ {
+			binary.LittleEndian.PutUint64(b, uint64(i))
+			// duplicates are not counted
+			hll.Add(b)
+			hll.Add(b)
+		}
+		assert.InDelta(t, n, hll.Estimate(), float64(n)*0.03+1, "cardinality %d", n)
+	}
+}
+
+func TestHyperLogLog_Merge(t *testing.T) {
+	b := make([]byte, 8)
+	h1 := NewHyperLogLog(sketchPrecision)
+	h2 := NewHyperLogLog(sketchPrecision)
+	for i := 0; i < 20000; i++ {
+		binary.LittleEndian.PutUint64(b, uint64(i))
+		h1.Add(b)
+		binary.LittleEndian.PutUint64(b, uint64(i+10000))
+		h2.Add(b)
+	}
+	require.NoError(t, h1.Merge(h2))
+	assert.InDelta(t, 30000, h1.Estimate(), 30000*0.03)
+
+	assert.Error(t, h1.Merge(NewHyperLogLog(sketchPrecision-1)))
+}
+
+func TestSketchWriter_SketchFieldData(t *testing.T) {
+	cases := []FieldData{
+		&Int8FieldData{Data: []int8{1, 2, 2, 3}},
+		&Int16FieldData{Data: []int16{1, 2, 2, 3}},
+		&Int32FieldData{Data: []int32{1, 2, 2, 3}},
+		&Int64FieldData{Data: []int64{1, 2, 2, 3}},
+		&FloatFieldData{Data: []float32{1.5, 2.5, 2.5, 3.5}},
+		&DoubleFieldData{Data: []float64{1.5, 2.5, 2.5, 3.5}},
+	}
+	for _, data := range cases {
+		sw := &SketchWriter{}
+		require.NoError(t, sw.SketchFieldData(100, data))
+
+		sketches, err := DeserializeSketches([]*Blob{{Value: sw.GetBuffer()}, {Value: nil}})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(sketches))
+		assert.EqualValues(t, 100, sketches[0].FieldID)
+		assert.EqualValues(t, 3, sketches[0].HLL.Estimate())
+	}
+
+	sw := &SketchWriter{}
+	assert.Error(t, sw.SketchFieldData(100, &StringFieldData{Data: []string{"a"}}))
+
+	_, err := DeserializeSketches([]*Blob{{Value: []byte("not json")}})
+	assert.Error(t, err)
+}
+
+func TestInsertCodec_SerializeSketches(t *testing.T) {
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, DataType: schemapb.DataType_Int64},
+				{FieldID: BoolField, DataType: schemapb.DataType_Bool},
+				{FieldID: Int64Field, DataType: schemapb.DataType_Int64},
+				{FieldID: DoubleField, DataType: schemapb.DataType_Double},
+				{FieldID: FloatVectorField, DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	}
+	data := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:       &Int64FieldData{Data: []int64{1, 2, 3}},
+			TimestampField:   &Int64FieldData{Data: []int64{1, 2, 3}},
+			BoolField:        &BoolFieldData{Data: []bool{true, false, true}},
+			Int64Field:       &Int64FieldData{Data: []int64{7, 7, 8}},
+			DoubleField:      &DoubleFieldData{Data: []float64{1, 2, 3}},
+			FloatVectorField: &FloatVectorFieldData{Data: []float32{1, 2, 3}, Dim: 1},
+		},
+	}
+	blobs, err := NewInsertCodec(schema).SerializeSketches(data)
+	require.NoError(t, err)
+	// only user-defined numeric fields are sketched
+	require.Equal(t, 2, len(blobs))
+	assert.Equal(t, "104", blobs[0].GetKey())
+	assert.Equal(t, "106", blobs[1].GetKey())
+
+	sketches, err := DeserializeSketches(blobs)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sketches[0].HLL.Estimate())
+	assert.EqualValues(t, 3, sketches[1].HLL.Estimate())
+}
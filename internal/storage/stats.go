@@ -13,9 +13,12 @@ package storage
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 const (
@@ -70,6 +73,103 @@ func (sw *StatsWriter) StatsInt64(fieldID int64, isPrimaryKey bool, msgs []int64
 	return nil
 }
 
+// FieldStats is the statistics of a scalar field other than int64, whose stats are Int64Stats.
+// Bool values are measured as 0 and 1, and Min & Max of string fields are in MinString & MaxString
+type FieldStats struct {
+	FieldID   int64   `json:"fieldID"`
+	Max       float64 `json:"max"`
+	Min       float64 `json:"min"`
+	MaxString string  `json:"maxString,omitempty"`
+	MinString string  `json:"minString,omitempty"`
+	// NullCount is always 0 for now since fields are not nullable
+	NullCount int64 `json:"nullCount"`
+	// Distinct is the number of distinct values estimated with HyperLogLog
+	Distinct uint64 `json:"distinct"`
+}
+
+// IsFieldStatsDataType returns whether FieldStats are generated for fields of dataType
+func IsFieldStatsDataType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String:
+		return true
+	default:
+		return false
+	}
+}
+
+// StatsFieldData generates the FieldStats of data, nothing is generated if data is empty
+func (sw *StatsWriter) StatsFieldData(fieldID int64, data FieldData) error {
+	if data.RowNum() < 1 {
+		return nil
+	}
+	stats := &FieldStats{
+		FieldID: fieldID,
+		Max:     math.Inf(-1),
+		Min:     math.Inf(1),
+	}
+	hll := NewHyperLogLog(sketchPrecision)
+	b := make([]byte, 8)
+	addNumber := func(v float64) {
+		stats.Max = math.Max(stats.Max, v)
+		stats.Min = math.Min(stats.Min, v)
+		common.Endian.PutUint64(b, math.Float64bits(v))
+		hll.Add(b)
+	}
+	switch fieldData := data.(type) {
+	case *BoolFieldData:
+		for _, v := range fieldData.Data {
+			if v {
+				addNumber(1)
+			} else {
+				addNumber(0)
+			}
+		}
+	case *Int8FieldData:
+		for _, v := range fieldData.Data {
+			addNumber(float64(v))
+		}
+	case *Int16FieldData:
+		for _, v := range fieldData.Data {
+			addNumber(float64(v))
+		}
+	case *Int32FieldData:
+		for _, v := range fieldData.Data {
+			addNumber(float64(v))
+		}
+	case *FloatFieldData:
+		for _, v := range fieldData.Data {
+			addNumber(float64(v))
+		}
+	case *DoubleFieldData:
+		for _, v := range fieldData.Data {
+			addNumber(v)
+		}
+	case *StringFieldData:
+		stats.Max, stats.Min = 0, 0
+		stats.MaxString, stats.MinString = fieldData.Data[0], fieldData.Data[0]
+		for _, v := range fieldData.Data {
+			if v > stats.MaxString {
+				stats.MaxString = v
+			}
+			if v < stats.MinString {
+				stats.MinString = v
+			}
+			hll.Add([]byte(v))
+		}
+	default:
+		return fmt.Errorf("field stats of %T are not supported", data)
+	}
+	stats.Distinct = hll.Estimate()
+
+	b, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	sw.buffer = b
+	return nil
+}
+
 type StatsReader struct {
 	buffer []byte
 }
@@ -87,6 +187,16 @@ func (sr *StatsReader) GetInt64Stats() (*Int64Stats, error) {
 	return stats, nil
 }
 
+// GetFieldStats returns the FieldStats in the buffer
+func (sr *StatsReader) GetFieldStats() (*FieldStats, error) {
+	stats := &FieldStats{}
+	err := json.Unmarshal(sr.buffer, &stats)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 func DeserializeStats(blobs []*Blob) ([]*Int64Stats, error) {
 	results := make([]*Int64Stats, 0, len(blobs))
 	for _, blob := range blobs {
@@ -15,8 +15,11 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStatsWriter_StatsInt64(t *testing.T) {
@@ -42,3 +45,94 @@ func TestStatsWriter_StatsInt64(t *testing.T) {
 	err = sw.StatsInt64(rootcoord.RowIDField, true, msgs)
 	assert.Nil(t, err)
 }
+
+func TestStatsWriter_StatsFieldData(t *testing.T) {
+	cases := []struct {
+		data FieldData
+		max  float64
+		min  float64
+	}{
+		{&BoolFieldData{Data: []bool{true, false, true}}, 1, 0},
+		{&Int8FieldData{Data: []int8{3, -1, 3}}, 3, -1},
+		{&Int16FieldData{Data: []int16{3, -1, 3}}, 3, -1},
+		{&Int32FieldData{Data: []int32{3, -1, 3}}, 3, -1},
+		{&FloatFieldData{Data: []float32{1.5, -1.5, 1.5}}, 1.5, -1.5},
+		{&DoubleFieldData{Data: []float64{1.5, -1.5, 1.5}}, 1.5, -1.5},
+	}
+	for _, c := range cases {
+		sw := &StatsWriter{}
+		require.NoError(t, sw.StatsFieldData(100, c.data))
+		sr := &StatsReader{}
+		sr.SetBuffer(sw.GetBuffer())
+		stats, err := sr.GetFieldStats()
+		require.NoError(t, err)
+		assert.EqualValues(t, 100, stats.FieldID)
+		assert.Equal(t, c.max, stats.Max)
+		assert.Equal(t, c.min, stats.Min)
+		assert.EqualValues(t, 0, stats.NullCount)
+		assert.EqualValues(t, 2, stats.Distinct)
+	}
+
+	sw := &StatsWriter{}
+	require.NoError(t, sw.StatsFieldData(100, &StringFieldData{Data: []string{"b", "c", "a", "b"}}))
+	sr := &StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err := sr.GetFieldStats()
+	require.NoError(t, err)
+	assert.Equal(t, "c", stats.MaxString)
+	assert.Equal(t, "a", stats.MinString)
+	assert.EqualValues(t, 3, stats.Distinct)
+
+	// empty data has no stats
+	sw = &StatsWriter{}
+	assert.NoError(t, sw.StatsFieldData(100, &Int8FieldData{}))
+	assert.Nil(t, sw.GetBuffer())
+
+	assert.Error(t, sw.StatsFieldData(100, &FloatVectorFieldData{Data: []float32{1}, Dim: 1}))
+}
+
+func TestInsertCodec_SerializeFieldStats(t *testing.T) {
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, DataType: schemapb.DataType_Int64},
+				{FieldID: BoolField, DataType: schemapb.DataType_Bool},
+				{FieldID: Int8Field, DataType: schemapb.DataType_Int8},
+				{FieldID: Int64Field, DataType: schemapb.DataType_Int64},
+				{FieldID: DoubleField, DataType: schemapb.DataType_Double},
+				{FieldID: StringField, DataType: schemapb.DataType_String},
+				{FieldID: FloatVectorField, DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	}
+	data := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:       &Int64FieldData{Data: []int64{1, 2, 3}},
+			TimestampField:   &Int64FieldData{Data: []int64{1, 2, 3}},
+			BoolField:        &BoolFieldData{Data: []bool{true, false, true}},
+			Int8Field:        &Int8FieldData{},
+			Int64Field:       &Int64FieldData{Data: []int64{7, 7, 8}},
+			DoubleField:      &DoubleFieldData{Data: []float64{1, 2, 3}},
+			StringField:      &StringFieldData{Data: []string{"a", "b", "c"}},
+			FloatVectorField: &FloatVectorFieldData{Data: []float32{1, 2, 3}, Dim: 1},
+		},
+	}
+	blobs, err := NewInsertCodec(schema).SerializeFieldStats(data)
+	require.NoError(t, err)
+	// int64 fields are covered by Serialize, vector fields and empty data have no stats
+	require.Equal(t, 3, len(blobs))
+	assert.Equal(t, "100", blobs[0].GetKey())
+	assert.Equal(t, "106", blobs[1].GetKey())
+	assert.Equal(t, "107", blobs[2].GetKey())
+
+	sr := &StatsReader{}
+	sr.SetBuffer(blobs[1].GetValue())
+	stats, err := sr.GetFieldStats()
+	require.NoError(t, err)
+	assert.EqualValues(t, DoubleField, stats.FieldID)
+	assert.Equal(t, float64(3), stats.Max)
+	assert.Equal(t, float64(1), stats.Min)
+	assert.EqualValues(t, 3, stats.Distinct)
+}
@@ -44,7 +44,37 @@ func GetBinlogSize(kv kv.DataKV, key string) (int64, error) {
 //		5, original_size not in extra, size = 0, error != nil;
 //		6, original_size not in int format, size = 0, error != nil;
 //		7, normal binlog with original_size, return original_size, error = nil;
+//		8, compressed binlog, return original_size of the binlog decompressed;
 func EstimateMemorySize(kv kv.DataKV, key string) (int64, error) {
+	size, err := estimateBinlogMemorySize(key, func(start, end int64) ([]byte, error) {
+		return kv.LoadPartial(key, start, end)
+	})
+	if err == nil {
+		return size, nil
+	}
+	// compressed binlogs have no descriptor event until decompressed
+	prefix, loadErr := kv.LoadPartial(key, 0, compressedBinlogHeaderSize)
+	if loadErr != nil || !IsCompressedBinlog(prefix) {
+		return size, err
+	}
+	value, err := kv.Load(key)
+	if err != nil {
+		return 0, err
+	}
+	binlog, err := DecompressBinlog([]byte(value))
+	if err != nil {
+		return 0, err
+	}
+	return estimateBinlogMemorySize(key, func(start, end int64) ([]byte, error) {
+		if start < 0 || end > int64(len(binlog)) || start > end {
+			return nil, fmt.Errorf("range [%d, %d) out of binlog %v of %d bytes", start, end, key, len(binlog))
+		}
+		return binlog[start:end], nil
+	})
+}
+
+// estimateBinlogMemorySize reads original_size in the descriptor event of the binlog, loadPartial reads the range of it
+func estimateBinlogMemorySize(key string, loadPartial func(start, end int64) ([]byte, error)) (int64, error) {
 	total := int64(0)
 
 	header := &eventHeader{}
@@ -54,7 +84,7 @@ func EstimateMemorySize(kv kv.DataKV, key string) (int64, error) {
 	endPos := startPos + headerSize
 
 	// get header
-	headerContent, err := kv.LoadPartial(key, int64(startPos), int64(endPos))
+	headerContent, err := loadPartial(int64(startPos), int64(endPos))
 	if err != nil {
 		return total, err
 	}
@@ -72,7 +102,7 @@ func EstimateMemorySize(kv kv.DataKV, key string) (int64, error) {
 
 	desc := &descriptorEvent{}
 	endPos = startPos + int(header.EventLength)
-	descContent, err := kv.LoadPartial(key, int64(startPos), int64(endPos))
+	descContent, err := loadPartial(int64(startPos), int64(endPos))
 	if err != nil {
 		return total, err
 	}
@@ -60,6 +60,20 @@ type DataNode interface {
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	// Compaction will add a compaction task according to the request plan
 	Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error)
+
+	// FlushAll seals and flushes all the active segments in DataNode, it returns after the binlog paths of
+	//  the segments are saved by DataCoord, or fails when the flush times out
+	FlushAll(ctx context.Context, req *datapb.FlushAllRequest) (*datapb.FlushAllResponse, error)
+
+	// SampleSegment reads a random sample of rows in the binlogs of the segment and returns the statistics of fields
+	SampleSegment(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error)
+
+	// CancelCompaction stops the executing compaction plan, the state replied is PlanCancelled if it's stopped
+	CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error)
+
+	// RestoreSegmentFromSnapshot restores binlogs of the segment to their object versions at the timestamp,
+	// blob storage must keep object versions
+	RestoreSegmentFromSnapshot(ctx context.Context, req *datapb.RestoreSegmentRequest) (*commonpb.Status, error)
 }
 
 // DataNodeComponent is used by grpc server of DataNode
@@ -230,6 +244,111 @@ type DataCoord interface {
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
 
 	WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error)
+
+	// GetChannelHistory returns the watcher assignment events of a vchannel
+	GetChannelHistory(ctx context.Context, req *datapb.GetChannelHistoryRequest) (*datapb.GetChannelHistoryResponse, error)
+
+	// ImportSegmentManifest registers flushed segments described by an external manifest
+	ImportSegmentManifest(ctx context.Context, req *datapb.ImportManifestRequest) (*datapb.ImportManifestResponse, error)
+
+	// GetCompactionScoreCard explains whether a segment is eligible for each compaction policy
+	GetCompactionScoreCard(ctx context.Context, req *datapb.GetCompactionScoreCardRequest) (*datapb.GetCompactionScoreCardResponse, error)
+
+	// WatchChannelsV2 notifies DataCoord to watch vchannels of a collection from the specified seek positions
+	WatchChannelsV2(ctx context.Context, req *datapb.WatchChannelsV2Request) (*datapb.WatchChannelsResponse, error)
+
+	// MigrateChannel moves a channel from the DataNode watching it to another one
+	MigrateChannel(ctx context.Context, req *datapb.MigrateChannelRequest) (*datapb.MigrateChannelResponse, error)
+
+	// ListCompactionPlans lists compaction plans of all collections, filtered and paged by the request
+	ListCompactionPlans(ctx context.Context, req *datapb.ListCompactionPlansRequest) (*datapb.ListCompactionPlansResponse, error)
+
+	// GetFlushedSegmentsV2 returns segments of the collection in the requested states page by page
+	GetFlushedSegmentsV2(ctx context.Context, req *datapb.GetFlushedSegmentsV2Request) (*datapb.GetFlushedSegmentsV2Response, error)
+
+	// ReclaimFailedCompaction removes output binlogs of a failed compaction plan
+	ReclaimFailedCompaction(ctx context.Context, req *datapb.ReclaimFailedCompactionRequest) (*commonpb.Status, error)
+
+	// PinSegments marks segments pinned so that compaction never merges them with other segments
+	PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*commonpb.Status, error)
+
+	// UnpinSegments clears the pinned flag of segments
+	UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error)
+
+	// ListManagedCollections lists the collections in DataCoord meta with a summary of their segments
+	ListManagedCollections(ctx context.Context, req *datapb.ListManagedCollectionsRequest) (*datapb.ListManagedCollectionsResponse, error)
+
+	// MigrateEtcdPrefix moves all etcd keys under the old prefix to the new prefix
+	MigrateEtcdPrefix(ctx context.Context, req *datapb.MigrateEtcdPrefixRequest) (*datapb.MigrateEtcdPrefixResponse, error)
+
+	// RegisterDataNodeQuota registers the resource quota of a DataNode, which limits the channels assigned to it
+	RegisterDataNodeQuota(ctx context.Context, req *datapb.RegisterDataNodeQuotaRequest) (*commonpb.Status, error)
+
+	// StorageAudit reports the binlog objects not referenced by segment meta, and removes them unless it's a dry run
+	StorageAudit(ctx context.Context, req *datapb.StorageAuditRequest) (*datapb.StorageAuditResponse, error)
+
+	// SampledSegmentInspector returns the statistics of fields over a random sample of rows in a segment
+	SampledSegmentInspector(ctx context.Context, req *datapb.SampleSegmentRequest) (*datapb.SampleSegmentResponse, error)
+
+	// GetStorageUsage returns the storage usage of collections grouped by field and log type
+	GetStorageUsage(ctx context.Context, req *datapb.GetStorageUsageRequest) (*datapb.GetStorageUsageResponse, error)
+
+	// CancelCompaction stops an executing compaction plan on DataNode and returns the final state of the plan
+	CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*datapb.CancelCompactionResponse, error)
+
+	// GetGCEvents returns the latest objects removed by garbage collection and storage audit
+	GetGCEvents(ctx context.Context, req *datapb.GetGCEventsRequest) (*datapb.GetGCEventsResponse, error)
+
+	// ReportSegmentError is called by DataNode once it stops saving binlog paths of a segment after consecutive failures
+	ReportSegmentError(ctx context.Context, req *datapb.ReportSegmentErrorRequest) (*commonpb.Status, error)
+
+	// SetCollectionProperty sets properties of a collection managed by DataCoord, e.g. the data retention policy
+	SetCollectionProperty(ctx context.Context, req *datapb.SetCollectionPropertyRequest) (*commonpb.Status, error)
+
+	// ComputeSegmentOverlap quantifies the primary key range overlap between flushed segments of a partition
+	ComputeSegmentOverlap(ctx context.Context, req *datapb.ComputeSegmentOverlapRequest) (*datapb.ComputeSegmentOverlapResponse, error)
+
+	// GetCompactionROI returns the bytes read and written by the compaction plans completed
+	GetCompactionROI(ctx context.Context, req *datapb.GetCompactionROIRequest) (*datapb.GetCompactionROIResponse, error)
+
+	// FreezePartition rejects new segment allocations of a partition until it's unfrozen or the freeze times out
+	FreezePartition(ctx context.Context, req *datapb.FreezePartitionRequest) (*commonpb.Status, error)
+
+	// UnfreezePartition resumes segment allocations of a frozen partition
+	UnfreezePartition(ctx context.Context, req *datapb.UnfreezePartitionRequest) (*commonpb.Status, error)
+
+	// GetSegmentPath returns the object storage paths of all binlogs of a segment, with the endpoint and bucket to access them
+	GetSegmentPath(ctx context.Context, req *datapb.GetSegmentPathRequest) (*datapb.GetSegmentPathResponse, error)
+
+	// GetSegmentsForCollection returns all segments of the collection grouped by state, in pages of segments sorted by ID
+	GetSegmentsForCollection(ctx context.Context, req *datapb.GetSegmentsForCollectionRequest) (*datapb.GetSegmentsForCollectionResponse, error)
+
+	// ReportDataNodeHealth reports the vchannels of a DataNode failing to start
+	ReportDataNodeHealth(ctx context.Context, req *datapb.ReportDataNodeHealthRequest) (*commonpb.Status, error)
+
+	// GetSegmentLineageDOT returns the compaction lineage of segments of the collection as a Graphviz DOT graph
+	GetSegmentLineageDOT(ctx context.Context, req *datapb.GetSegmentLineageDOTRequest) (*datapb.GetSegmentLineageDOTResponse, error)
+
+	// ReadSegment streams a sample of rows of a flushed segment for debugging, only admin requests are served
+	ReadSegment(req *datapb.ReadSegmentRequest, stream datapb.DataCoord_ReadSegmentServer) error
+
+	// DiscardSegment drops an empty growing segment allocated ahead of time by a DataNode and never used
+	DiscardSegment(ctx context.Context, req *datapb.DiscardSegmentRequest) (*commonpb.Status, error)
+
+	// GetChannelSegmentStats returns the compaction related statistics of segments of a vchannel
+	GetChannelSegmentStats(ctx context.Context, req *datapb.GetChannelSegmentStatsRequest) (*datapb.GetChannelSegmentStatsResponse, error)
+
+	// GetFlushProgress returns the flush progress of the segments of a collection
+	GetFlushProgress(ctx context.Context, req *datapb.GetFlushProgressRequest) (*datapb.FlushProgressResponse, error)
+
+	// CancelFlush reopens the segments sealed by Flush unless they start flushing
+	CancelFlush(ctx context.Context, req *datapb.CancelFlushRequest) (*datapb.CancelFlushResponse, error)
+
+	// PauseCompaction stops dispatching new compaction plans until ResumeCompaction is called
+	PauseCompaction(ctx context.Context, req *datapb.PauseCompactionRequest) (*commonpb.Status, error)
+
+	// ResumeCompaction dispatches compaction plans again after PauseCompaction
+	ResumeCompaction(ctx context.Context, req *datapb.ResumeCompactionRequest) (*commonpb.Status, error)
 }
 
 // IndexNode is the interface `indexnode` package implements
@@ -1038,6 +1157,12 @@ type QueryCoord interface {
 	GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error)
 
+	// GetLoadProgress returns the loading progress of a pre-fetch batch
+	GetLoadProgress(ctx context.Context, req *querypb.GetLoadProgressRequest) (*querypb.GetLoadProgressResponse, error)
+
+	// PreFetchSegments loads segments restored in cold storage into QueryNodes ahead of queries
+	PreFetchSegments(ctx context.Context, req *querypb.PreFetchSegmentsRequest) (*querypb.PreFetchSegmentsResponse, error)
+
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
 
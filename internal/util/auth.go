@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// AdminTokenKey is the key of the admin token in the grpc metadata of admin only requests
+const AdminTokenKey = "admin-token"
+
+var (
+	// ErrAdminTokenNotSet is returned for admin only requests if no admin token is configured
+	ErrAdminTokenNotSet = errors.New("admin only request rejected, admin token is not configured")
+	// ErrNotAdmin is returned for admin only requests not carrying the admin token configured
+	ErrNotAdmin = errors.New("admin only request rejected, admin token is missing or mismatched")
+)
+
+// CheckAdmin returns an error unless the incoming metadata of ctx carries adminToken,
+// all admin only requests are rejected if adminToken is empty
+func CheckAdmin(ctx context.Context, adminToken string) error {
+	if adminToken == "" {
+		return ErrAdminTokenNotSet
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ErrNotAdmin
+	}
+	for _, token := range md.Get(AdminTokenKey) {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1 {
+			return nil
+		}
+	}
+	return ErrNotAdmin
+}
+
+// ForwardAdminToken returns a context whose outgoing metadata carries the admin token of the incoming request,
+// so that an admin only request made on behalf of it is authorized as well
+func ForwardAdminToken(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	tokens := md.Get(AdminTokenKey)
+	if len(tokens) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, AdminTokenKey, tokens[0])
+}
@@ -0,0 +1,48 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCheckAdmin(t *testing.T) {
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(AdminTokenKey, token))
+	}
+
+	assert.ErrorIs(t, CheckAdmin(withToken(""), ""), ErrAdminTokenNotSet)
+	assert.NoError(t, CheckAdmin(withToken("secret"), "secret"))
+	assert.ErrorIs(t, CheckAdmin(withToken("wrong"), "secret"), ErrNotAdmin)
+	assert.ErrorIs(t, CheckAdmin(context.Background(), "secret"), ErrNotAdmin)
+}
+
+func TestForwardAdminToken(t *testing.T) {
+	ctx := ForwardAdminToken(context.Background())
+	_, ok := metadata.FromOutgoingContext(ctx)
+	assert.False(t, ok)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(AdminTokenKey, "secret"))
+	md, ok := metadata.FromOutgoingContext(ForwardAdminToken(ctx))
+	require.True(t, ok)
+	assert.Equal(t, []string{"secret"}, md.Get(AdminTokenKey))
+}
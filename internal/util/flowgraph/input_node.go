@@ -56,6 +56,11 @@ func (inNode *InputNode) InStream() msgstream.MsgStream {
 	return inNode.inStream
 }
 
+// SetInStream replaces the internal MsgStream, e.g. by a stream recreated after the consumer got stuck
+func (inNode *InputNode) SetInStream(inStream msgstream.MsgStream) {
+	inNode.inStream = inStream
+}
+
 // Operate consume a message pack from msgstream and return
 func (inNode *InputNode) Operate(in []Msg) []Msg {
 	msgPack := inNode.inStream.Consume()
@@ -150,10 +150,34 @@ type DataNodeConfiguration struct {
 	FlushInsertBufferSize int64 `json:"flush_insert_buffer_size"`
 }
 
+// DataNodeFlushLatency summarizes the latency of segment flushes of an outcome since the data node started
+type DataNodeFlushLatency struct {
+	Count   uint64  `json:"count"`
+	TotalMs float64 `json:"total_ms"`
+	MaxMs   float64 `json:"max_ms"`
+}
+
+// DataNodeChannelStatus is the status of the flowgraph of a vchannel watched by the data node
+type DataNodeChannelStatus struct {
+	FlowGraphRunning        bool   `json:"flow_graph_running"`
+	TailMsgTimestamp        uint64 `json:"tail_msg_timestamp"`         // end timestamp of the last message pack processed
+	InsertBufferPendingRows int64  `json:"insert_buffer_pending_rows"` // rows buffered but not flushed yet
+	PendingFlushCount       int    `json:"pending_flush_count"`
+	LastCheckpointTimestamp uint64 `json:"last_checkpoint_timestamp"` // 0 if the flow graph checkpoint is disabled
+}
+
 // DataNodeInfos implements ComponentInfos
 type DataNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations DataNodeConfiguration `json:"system_configurations"`
+	// vchannel => error of the vchannel failing to start, reported to DataCoord by the DataNode
+	ChannelErrors map[string]string `json:"channel_errors,omitempty"`
+	// flush outcome => latency of segment flushes, outcomes are flushed, dropped, synced and failed
+	FlushLatencies map[string]DataNodeFlushLatency `json:"flush_latencies,omitempty"`
+	// number of flush tasks running or waiting in the flush queues of all vchannels
+	PendingFlushCount int `json:"pending_flush_count"`
+	// vchannel => status of the flowgraph of the vchannel
+	Channels map[string]DataNodeChannelStatus `json:"channels,omitempty"`
 }
 
 // DataCoordConfiguration records the configuration of data coordinator.
@@ -161,10 +185,16 @@ type DataCoordConfiguration struct {
 	SegmentMaxSize float64 `json:"segment_max_size"`
 }
 
+// DataCoordCompactionOverview records the compaction related state of data coordinator.
+type DataCoordCompactionOverview struct {
+	PinnedSegments []int64 `json:"pinned_segments"`
+}
+
 // DataCoordInfos implements ComponentInfos
 type DataCoordInfos struct {
 	BaseComponentInfos
-	SystemConfigurations DataCoordConfiguration `json:"system_configurations"`
+	SystemConfigurations DataCoordConfiguration      `json:"system_configurations"`
+	CompactionOverview   DataCoordCompactionOverview `json:"compaction_overview"`
 }
 
 // RootCoordConfiguration records the configuration of root coordinator.
@@ -245,6 +245,15 @@ func TestDataNodeInfos_Codec(t *testing.T) {
 		SystemConfigurations: DataNodeConfiguration{
 			FlushInsertBufferSize: 1024,
 		},
+		Channels: map[string]DataNodeChannelStatus{
+			"by-dev-rootcoord-dml_0": {
+				FlowGraphRunning:        true,
+				TailMsgTimestamp:        100,
+				InsertBufferPendingRows: 10,
+				PendingFlushCount:       1,
+				LastCheckpointTimestamp: 90,
+			},
+		},
 	}
 	s, err := MarshalComponentInfos(infos1)
 	assert.Equal(t, nil, err)
@@ -283,6 +292,9 @@ func TestDataCoordInfos_Codec(t *testing.T) {
 		SystemConfigurations: DataCoordConfiguration{
 			SegmentMaxSize: 1024 * 1024,
 		},
+		CompactionOverview: DataCoordCompactionOverview{
+			PinnedSegments: []int64{1, 2},
+		},
 	}
 	s, err := MarshalComponentInfos(infos1)
 	assert.Equal(t, nil, err)
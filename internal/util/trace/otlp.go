@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"io"
+
+	"github.com/opentracing/opentracing-go"
+	otbridge "go.opentelemetry.io/otel/bridge/opentracing"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlphttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+type tracerProviderCloser struct {
+	provider *sdktrace.TracerProvider
+}
+
+// Close flushes the pending spans and shuts down the tracer provider
+func (c *tracerProviderCloser) Close() error {
+	return c.provider.Shutdown(context.Background())
+}
+
+// InitOTLPTracing sets the global tracer to an OpenTelemetry tracer which exports spans to
+// the OTLP/HTTP endpoint (host:port). Spans are still created via the opentracing API,
+// which is bridged to OpenTelemetry.
+func InitOTLPTracing(serviceName string, endpoint string) (io.Closer, error) {
+	driver := otlphttp.NewDriver(otlphttp.WithEndpoint(endpoint), otlphttp.WithInsecure())
+	exporter, err := otlp.NewExporter(context.Background(), driver)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.ServiceNameKey.String(serviceName))),
+	)
+	bridgeTracer, _ := otbridge.NewTracerPair(provider.Tracer(serviceName))
+	opentracing.SetGlobalTracer(bridgeTracer)
+
+	return &tracerProviderCloser{provider: provider}, nil
+}